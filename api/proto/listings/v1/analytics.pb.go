@@ -139,6 +139,274 @@ func (ConversionFunnelStage) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{1}
 }
 
+// SearchQueryReportType selects the queries of a search quality report
+type SearchQueryReportType int32
+
+const (
+	SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED  SearchQueryReportType = 0
+	SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_ZERO_RESULTS SearchQueryReportType = 1 // Searches that returned nothing
+	SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_LOW_RESULTS  SearchQueryReportType = 2 // Searches with at most max_results results
+	SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_TOP_QUERIES  SearchQueryReportType = 3 // Most frequent queries with CTR
+)
+
+// Enum value maps for SearchQueryReportType.
+var (
+	SearchQueryReportType_name = map[int32]string{
+		0: "SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED",
+		1: "SEARCH_QUERY_REPORT_TYPE_ZERO_RESULTS",
+		2: "SEARCH_QUERY_REPORT_TYPE_LOW_RESULTS",
+		3: "SEARCH_QUERY_REPORT_TYPE_TOP_QUERIES",
+	}
+	SearchQueryReportType_value = map[string]int32{
+		"SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED":  0,
+		"SEARCH_QUERY_REPORT_TYPE_ZERO_RESULTS": 1,
+		"SEARCH_QUERY_REPORT_TYPE_LOW_RESULTS":  2,
+		"SEARCH_QUERY_REPORT_TYPE_TOP_QUERIES":  3,
+	}
+)
+
+func (x SearchQueryReportType) Enum() *SearchQueryReportType {
+	p := new(SearchQueryReportType)
+	*p = x
+	return p
+}
+
+func (x SearchQueryReportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchQueryReportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_analytics_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchQueryReportType) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_analytics_proto_enumTypes[2]
+}
+
+func (x SearchQueryReportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchQueryReportType.Descriptor instead.
+func (SearchQueryReportType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+// ReportExportType selects the data of a report export
+type ReportExportType int32
+
+const (
+	ReportExportType_REPORT_EXPORT_TYPE_UNSPECIFIED         ReportExportType = 0
+	ReportExportType_REPORT_EXPORT_TYPE_ORDERS              ReportExportType = 1 // One row per order
+	ReportExportType_REPORT_EXPORT_TYPE_PRODUCT_PERFORMANCE ReportExportType = 2 // One row per product: views, favorites, sales
+	ReportExportType_REPORT_EXPORT_TYPE_STOCK_MOVEMENTS     ReportExportType = 3 // One row per inventory movement
+)
+
+// Enum value maps for ReportExportType.
+var (
+	ReportExportType_name = map[int32]string{
+		0: "REPORT_EXPORT_TYPE_UNSPECIFIED",
+		1: "REPORT_EXPORT_TYPE_ORDERS",
+		2: "REPORT_EXPORT_TYPE_PRODUCT_PERFORMANCE",
+		3: "REPORT_EXPORT_TYPE_STOCK_MOVEMENTS",
+	}
+	ReportExportType_value = map[string]int32{
+		"REPORT_EXPORT_TYPE_UNSPECIFIED":         0,
+		"REPORT_EXPORT_TYPE_ORDERS":              1,
+		"REPORT_EXPORT_TYPE_PRODUCT_PERFORMANCE": 2,
+		"REPORT_EXPORT_TYPE_STOCK_MOVEMENTS":     3,
+	}
+)
+
+func (x ReportExportType) Enum() *ReportExportType {
+	p := new(ReportExportType)
+	*p = x
+	return p
+}
+
+func (x ReportExportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportExportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_analytics_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportExportType) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_analytics_proto_enumTypes[3]
+}
+
+func (x ReportExportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportExportType.Descriptor instead.
+func (ReportExportType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+// ReportExportFormat is the file format of a report export
+type ReportExportFormat int32
+
+const (
+	ReportExportFormat_REPORT_EXPORT_FORMAT_UNSPECIFIED ReportExportFormat = 0 // Defaults to CSV
+	ReportExportFormat_REPORT_EXPORT_FORMAT_CSV         ReportExportFormat = 1
+	ReportExportFormat_REPORT_EXPORT_FORMAT_JSONL       ReportExportFormat = 2 // JSON Lines: one JSON object per row
+)
+
+// Enum value maps for ReportExportFormat.
+var (
+	ReportExportFormat_name = map[int32]string{
+		0: "REPORT_EXPORT_FORMAT_UNSPECIFIED",
+		1: "REPORT_EXPORT_FORMAT_CSV",
+		2: "REPORT_EXPORT_FORMAT_JSONL",
+	}
+	ReportExportFormat_value = map[string]int32{
+		"REPORT_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"REPORT_EXPORT_FORMAT_CSV":         1,
+		"REPORT_EXPORT_FORMAT_JSONL":       2,
+	}
+)
+
+func (x ReportExportFormat) Enum() *ReportExportFormat {
+	p := new(ReportExportFormat)
+	*p = x
+	return p
+}
+
+func (x ReportExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_analytics_proto_enumTypes[4].Descriptor()
+}
+
+func (ReportExportFormat) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_analytics_proto_enumTypes[4]
+}
+
+func (x ReportExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportExportFormat.Descriptor instead.
+func (ReportExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+// ReportExportStatus is the processing status of a report export
+type ReportExportStatus int32
+
+const (
+	ReportExportStatus_REPORT_EXPORT_STATUS_UNSPECIFIED ReportExportStatus = 0
+	ReportExportStatus_REPORT_EXPORT_STATUS_PENDING     ReportExportStatus = 1 // Waiting for a worker
+	ReportExportStatus_REPORT_EXPORT_STATUS_PROCESSING  ReportExportStatus = 2 // Being rendered
+	ReportExportStatus_REPORT_EXPORT_STATUS_COMPLETED   ReportExportStatus = 3 // File ready for download
+	ReportExportStatus_REPORT_EXPORT_STATUS_FAILED      ReportExportStatus = 4 // Retries exhausted or report too large
+	ReportExportStatus_REPORT_EXPORT_STATUS_EXPIRED     ReportExportStatus = 5 // File no longer available
+)
+
+// Enum value maps for ReportExportStatus.
+var (
+	ReportExportStatus_name = map[int32]string{
+		0: "REPORT_EXPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_EXPORT_STATUS_PENDING",
+		2: "REPORT_EXPORT_STATUS_PROCESSING",
+		3: "REPORT_EXPORT_STATUS_COMPLETED",
+		4: "REPORT_EXPORT_STATUS_FAILED",
+		5: "REPORT_EXPORT_STATUS_EXPIRED",
+	}
+	ReportExportStatus_value = map[string]int32{
+		"REPORT_EXPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_EXPORT_STATUS_PENDING":     1,
+		"REPORT_EXPORT_STATUS_PROCESSING":  2,
+		"REPORT_EXPORT_STATUS_COMPLETED":   3,
+		"REPORT_EXPORT_STATUS_FAILED":      4,
+		"REPORT_EXPORT_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x ReportExportStatus) Enum() *ReportExportStatus {
+	p := new(ReportExportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_analytics_proto_enumTypes[5].Descriptor()
+}
+
+func (ReportExportStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_analytics_proto_enumTypes[5]
+}
+
+func (x ReportExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportExportStatus.Descriptor instead.
+func (ReportExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+// SearchExperimentStatus is the lifecycle state of a search ranking experiment
+type SearchExperimentStatus int32
+
+const (
+	SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_UNSPECIFIED SearchExperimentStatus = 0
+	SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_DRAFT       SearchExperimentStatus = 1 // Defined, not serving traffic
+	SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_RUNNING     SearchExperimentStatus = 2 // Assigning searchers to its variants
+	SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_STOPPED     SearchExperimentStatus = 3 // Finished; results stay available
+)
+
+// Enum value maps for SearchExperimentStatus.
+var (
+	SearchExperimentStatus_name = map[int32]string{
+		0: "SEARCH_EXPERIMENT_STATUS_UNSPECIFIED",
+		1: "SEARCH_EXPERIMENT_STATUS_DRAFT",
+		2: "SEARCH_EXPERIMENT_STATUS_RUNNING",
+		3: "SEARCH_EXPERIMENT_STATUS_STOPPED",
+	}
+	SearchExperimentStatus_value = map[string]int32{
+		"SEARCH_EXPERIMENT_STATUS_UNSPECIFIED": 0,
+		"SEARCH_EXPERIMENT_STATUS_DRAFT":       1,
+		"SEARCH_EXPERIMENT_STATUS_RUNNING":     2,
+		"SEARCH_EXPERIMENT_STATUS_STOPPED":     3,
+	}
+)
+
+func (x SearchExperimentStatus) Enum() *SearchExperimentStatus {
+	p := new(SearchExperimentStatus)
+	*p = x
+	return p
+}
+
+func (x SearchExperimentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchExperimentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_analytics_proto_enumTypes[6].Descriptor()
+}
+
+func (SearchExperimentStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_analytics_proto_enumTypes[6]
+}
+
+func (x SearchExperimentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchExperimentStatus.Descriptor instead.
+func (SearchExperimentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{6}
+}
+
 // GetOverviewStatsRequest retrieves platform-wide analytics
 type GetOverviewStatsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -582,11 +850,14 @@ type GetListingStatsResponse struct {
 	OriginalPrice   *float64 `protobuf:"fixed64,23,opt,name=original_price,json=originalPrice,proto3,oneof" json:"original_price,omitempty"` // Original price (if on discount)
 	DiscountPercent float64  `protobuf:"fixed64,24,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"` // Discount % (0 if no discount)
 	// === Timestamps ===
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Listing creation date
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // Last update date
-	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"` // When stats were generated
-	DataFrom      *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=data_from,json=dataFrom,proto3" json:"data_from,omitempty"`          // Actual start date used
-	DataTo        *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=data_to,json=dataTo,proto3" json:"data_to,omitempty"`                // Actual end date used
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Listing creation date
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // Last update date
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"` // When stats were generated
+	DataFrom    *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=data_from,json=dataFrom,proto3" json:"data_from,omitempty"`          // Actual start date used
+	DataTo      *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=data_to,json=dataTo,proto3" json:"data_to,omitempty"`                // Actual end date used
+	// === Category ===
+	CategoryId    int64  `protobuf:"varint,30,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`      // Listing category
+	CategoryName  string `protobuf:"bytes,31,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // Category display name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -824,6 +1095,20 @@ func (x *GetListingStatsResponse) GetDataTo() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetListingStatsResponse) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetListingStatsResponse) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
 // ListingsStats represents statistics about listings on the platform
 type ListingsStats struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -2928,123 +3213,2438 @@ func (x *GetTrendingStatsResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_api_proto_listings_v1_analytics_proto protoreflect.FileDescriptor
+// TrackedEvent is a single client-side analytics event
+type TrackedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                                                        // "impression", "view", "inquiry" or "search"
+	EntityId      int64                  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                                                          // Listing ID (category ID or 0 for searches)
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional context (max 20 keys)
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3,oneof" json:"occurred_at,omitempty"`                                               // Client time (within last 24h, default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_proto_listings_v1_analytics_proto_rawDesc = "" +
-	"\n" +
-	"%api/proto/listings/v1/analytics.proto\x12\x0elistingssvc.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x9c\x04\n" +
-	"\x17GetOverviewStatsRequest\x127\n" +
-	"\tdate_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
-	"\adate_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12B\n" +
-	"\fcompare_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vcompareFrom\x88\x01\x01\x12>\n" +
-	"\n" +
-	"compare_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tcompareTo\x88\x01\x01\x129\n" +
-	"\x06period\x18\x05 \x01(\x0e2\x1c.listingssvc.v1.MetricPeriodH\x02R\x06period\x88\x01\x01\x12(\n" +
-	"\rstorefront_id\x18\x06 \x01(\x03H\x03R\fstorefrontId\x88\x01\x01\x12$\n" +
-	"\vcategory_id\x18\a \x01(\x03H\x04R\n" +
-	"categoryId\x88\x01\x01\x12&\n" +
-	"\flisting_type\x18\b \x01(\tH\x05R\vlistingType\x88\x01\x01B\x0f\n" +
-	"\r_compare_fromB\r\n" +
-	"\v_compare_toB\t\n" +
-	"\a_periodB\x10\n" +
-	"\x0e_storefront_idB\x0e\n" +
-	"\f_category_idB\x0f\n" +
-	"\r_listing_type\"\xd0\x05\n" +
-	"\x18GetOverviewStatsResponse\x129\n" +
-	"\blistings\x18\x01 \x01(\v2\x1d.listingssvc.v1.ListingsStatsR\blistings\x126\n" +
-	"\arevenue\x18\x02 \x01(\v2\x1c.listingssvc.v1.RevenueStatsR\arevenue\x120\n" +
-	"\x05users\x18\x03 \x01(\v2\x1a.listingssvc.v1.UsersStatsR\x05users\x123\n" +
-	"\x06orders\x18\x04 \x01(\v2\x1b.listingssvc.v1.OrdersStatsR\x06orders\x12A\n" +
-	"\n" +
-	"engagement\x18\x05 \x01(\v2!.listingssvc.v1.EngagementMetricsR\n" +
-	"engagement\x12@\n" +
-	"\vtime_series\x18\x06 \x03(\v2\x1f.listingssvc.v1.TimeSeriesPointR\n" +
-	"timeSeries\x12J\n" +
-	"\n" +
-	"comparison\x18\a \x01(\v2%.listingssvc.v1.PerformanceComparisonH\x00R\n" +
-	"comparison\x88\x01\x01\x12M\n" +
-	"\x11conversion_funnel\x18\b \x01(\v2 .listingssvc.v1.ConversionFunnelR\x10conversionFunnel\x12=\n" +
-	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x127\n" +
-	"\tdata_from\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
-	"\adata_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06dataToB\r\n" +
-	"\v_comparison\"\x92\x05\n" +
-	"\x16GetListingStatsRequest\x12\x1f\n" +
-	"\n" +
-	"listing_id\x18\x01 \x01(\x03H\x00R\tlistingId\x12\x1f\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03H\x00R\tproductId\x127\n" +
-	"\tdate_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
-	"\adate_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12B\n" +
-	"\fcompare_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vcompareFrom\x88\x01\x01\x12>\n" +
-	"\n" +
-	"compare_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tcompareTo\x88\x01\x01\x129\n" +
-	"\x06period\x18\a \x01(\x0e2\x1c.listingssvc.v1.MetricPeriodH\x03R\x06period\x88\x01\x01\x12.\n" +
-	"\x10include_variants\x18\b \x01(\bH\x04R\x0fincludeVariants\x88\x01\x01\x12$\n" +
-	"\vinclude_geo\x18\t \x01(\bH\x05R\n" +
-	"includeGeo\x88\x01\x01\x12\x1c\n" +
-	"\auser_id\x18\n" +
-	" \x01(\x03H\x06R\x06userId\x88\x01\x01\x12\x1e\n" +
-	"\bis_admin\x18\v \x01(\bH\aR\aisAdmin\x88\x01\x01B\f\n" +
-	"\n" +
-	"identifierB\x0f\n" +
-	"\r_compare_fromB\r\n" +
-	"\v_compare_toB\t\n" +
-	"\a_periodB\x13\n" +
-	"\x11_include_variantsB\x0e\n" +
-	"\f_include_geoB\n" +
-	"\n" +
-	"\b_user_idB\v\n" +
-	"\t_is_admin\"\xa4\v\n" +
-	"\x17GetListingStatsResponse\x12\x1d\n" +
-	"\n" +
-	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12!\n" +
-	"\flisting_name\x18\x02 \x01(\tR\vlistingName\x12!\n" +
-	"\flisting_type\x18\x03 \x01(\tR\vlistingType\x12(\n" +
-	"\rstorefront_id\x18\x04 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x12\x1f\n" +
-	"\vtotal_views\x18\x05 \x01(\x05R\n" +
-	"totalViews\x12'\n" +
-	"\x0funique_visitors\x18\x06 \x01(\x05R\x0euniqueVisitors\x12%\n" +
-	"\x0efavorite_count\x18\a \x01(\x05R\rfavoriteCount\x12\x1b\n" +
-	"\tcart_adds\x18\b \x01(\x05R\bcartAdds\x12\x1f\n" +
-	"\vtotal_sales\x18\t \x01(\x05R\n" +
-	"totalSales\x12#\n" +
-	"\rtotal_revenue\x18\n" +
-	" \x01(\x01R\ftotalRevenue\x12&\n" +
-	"\x0favg_order_value\x18\v \x01(\x01R\ravgOrderValue\x12'\n" +
-	"\x0fconversion_rate\x18\f \x01(\x01R\x0econversionRate\x12#\n" +
-	"\rcurrent_stock\x18\r \x01(\x05R\fcurrentStock\x12\x1d\n" +
-	"\n" +
-	"stock_sold\x18\x0e \x01(\x05R\tstockSold\x12*\n" +
-	"\x0estock_reserved\x18\x0f \x01(\x05H\x01R\rstockReserved\x88\x01\x01\x12-\n" +
-	"\x12inventory_turnover\x18\x10 \x01(\x01R\x11inventoryTurnover\x12A\n" +
-	"\n" +
-	"engagement\x18\x11 \x01(\v2!.listingssvc.v1.EngagementMetricsR\n" +
-	"engagement\x12A\n" +
-	"\rvariant_stats\x18\x12 \x03(\v2\x1c.listingssvc.v1.VariantStatsR\fvariantStats\x125\n" +
-	"\tgeo_stats\x18\x13 \x03(\v2\x18.listingssvc.v1.GeoStatsR\bgeoStats\x12G\n" +
-	"\vtime_series\x18\x14 \x03(\v2&.listingssvc.v1.ListingTimeSeriesPointR\n" +
-	"timeSeries\x12J\n" +
-	"\n" +
-	"comparison\x18\x15 \x01(\v2%.listingssvc.v1.PerformanceComparisonH\x02R\n" +
-	"comparison\x88\x01\x01\x12#\n" +
-	"\rcurrent_price\x18\x16 \x01(\x01R\fcurrentPrice\x12*\n" +
-	"\x0eoriginal_price\x18\x17 \x01(\x01H\x03R\roriginalPrice\x88\x01\x01\x12)\n" +
-	"\x10discount_percent\x18\x18 \x01(\x01R\x0fdiscountPercent\x129\n" +
-	"\n" +
-	"created_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
-	"\fgenerated_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x127\n" +
-	"\tdata_from\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
-	"\adata_to\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\x06dataToB\x10\n" +
-	"\x0e_storefront_idB\x11\n" +
-	"\x0f_stock_reservedB\r\n" +
-	"\v_comparisonB\x11\n" +
-	"\x0f_original_price\"\x96\x05\n" +
-	"\rListingsStats\x12%\n" +
-	"\x0etotal_listings\x18\x01 \x01(\x05R\rtotalListings\x12'\n" +
-	"\x0factive_listings\x18\x02 \x01(\x05R\x0eactiveListings\x12!\n" +
+func (x *TrackedEvent) Reset() {
+	*x = TrackedEvent{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedEvent) ProtoMessage() {}
+
+func (x *TrackedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedEvent.ProtoReflect.Descriptor instead.
+func (*TrackedEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TrackedEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *TrackedEvent) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *TrackedEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TrackedEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// TrackEventsRequest records a batch of events
+type TrackEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*TrackedEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                              // 1-100 events
+	SessionId     *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // Required for anonymous callers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackEventsRequest) Reset() {
+	*x = TrackEventsRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackEventsRequest) ProtoMessage() {}
+
+func (x *TrackEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackEventsRequest.ProtoReflect.Descriptor instead.
+func (*TrackEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TrackEventsRequest) GetEvents() []*TrackedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TrackEventsRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+// TrackEventsResponse reports how the batch was handled
+type TrackEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // Events queued for writing
+	Rejected      int32                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"` // Events that failed validation
+	Dropped       int32                  `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`   // Valid events dropped because the server is overloaded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackEventsResponse) Reset() {
+	*x = TrackEventsResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackEventsResponse) ProtoMessage() {}
+
+func (x *TrackEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackEventsResponse.ProtoReflect.Descriptor instead.
+func (*TrackEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TrackEventsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *TrackEventsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *TrackEventsResponse) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// GetStorefrontFunnelRequest retrieves the purchase funnel of a storefront
+type GetStorefrontFunnelRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId    int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`          // Storefront (required)
+	ListingId       *int64                 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3,oneof" json:"listing_id,omitempty"`             // Restrict to one product of the storefront
+	DateFrom        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                       // Period start (required)
+	DateTo          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                             // Period end, exclusive (required)
+	ComparePrevious bool                   `protobuf:"varint,5,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"` // Also return the preceding period of equal length
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetStorefrontFunnelRequest) Reset() {
+	*x = GetStorefrontFunnelRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontFunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontFunnelRequest) ProtoMessage() {}
+
+func (x *GetStorefrontFunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontFunnelRequest.ProtoReflect.Descriptor instead.
+func (*GetStorefrontFunnelRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetStorefrontFunnelRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetStorefrontFunnelRequest) GetListingId() int64 {
+	if x != nil && x.ListingId != nil {
+		return *x.ListingId
+	}
+	return 0
+}
+
+func (x *GetStorefrontFunnelRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetStorefrontFunnelRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *GetStorefrontFunnelRequest) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
+}
+
+// FunnelStages counts distinct visitors reaching each funnel stage
+type FunnelStages struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Impressions int32                  `protobuf:"varint,1,opt,name=impressions,proto3" json:"impressions,omitempty"` // Saw the product in results or feeds
+	Views       int32                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`             // Opened the product page
+	Engaged     int32                  `protobuf:"varint,3,opt,name=engaged,proto3" json:"engaged,omitempty"`         // Favorited or added to cart
+	Ordered     int32                  `protobuf:"varint,4,opt,name=ordered,proto3" json:"ordered,omitempty"`         // Placed an order (buyers)
+	Delivered   int32                  `protobuf:"varint,5,opt,name=delivered,proto3" json:"delivered,omitempty"`     // Received an order (buyers)
+	// Stage-to-stage rates in percent (may exceed 100: visitors can skip stages)
+	ViewRate       float64                `protobuf:"fixed64,6,opt,name=view_rate,json=viewRate,proto3" json:"view_rate,omitempty"`                    // views / impressions
+	EngagementRate float64                `protobuf:"fixed64,7,opt,name=engagement_rate,json=engagementRate,proto3" json:"engagement_rate,omitempty"`  // engaged / views
+	OrderRate      float64                `protobuf:"fixed64,8,opt,name=order_rate,json=orderRate,proto3" json:"order_rate,omitempty"`                 // ordered / engaged
+	DeliveryRate   float64                `protobuf:"fixed64,9,opt,name=delivery_rate,json=deliveryRate,proto3" json:"delivery_rate,omitempty"`        // delivered / ordered
+	ConversionRate float64                `protobuf:"fixed64,10,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"` // ordered / views
+	DataFrom       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=data_from,json=dataFrom,proto3" json:"data_from,omitempty"`
+	DataTo         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=data_to,json=dataTo,proto3" json:"data_to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FunnelStages) Reset() {
+	*x = FunnelStages{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunnelStages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunnelStages) ProtoMessage() {}
+
+func (x *FunnelStages) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunnelStages.ProtoReflect.Descriptor instead.
+func (*FunnelStages) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FunnelStages) GetImpressions() int32 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetEngaged() int32 {
+	if x != nil {
+		return x.Engaged
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetOrdered() int32 {
+	if x != nil {
+		return x.Ordered
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetDelivered() int32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetViewRate() float64 {
+	if x != nil {
+		return x.ViewRate
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetOrderRate() float64 {
+	if x != nil {
+		return x.OrderRate
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetDeliveryRate() float64 {
+	if x != nil {
+		return x.DeliveryRate
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetDataFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataFrom
+	}
+	return nil
+}
+
+func (x *FunnelStages) GetDataTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataTo
+	}
+	return nil
+}
+
+// FunnelComparison compares a funnel to the previous period
+type FunnelComparison struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ImpressionsGrowthPercent float64                `protobuf:"fixed64,1,opt,name=impressions_growth_percent,json=impressionsGrowthPercent,proto3" json:"impressions_growth_percent,omitempty"`
+	ViewsGrowthPercent       float64                `protobuf:"fixed64,2,opt,name=views_growth_percent,json=viewsGrowthPercent,proto3" json:"views_growth_percent,omitempty"`
+	EngagedGrowthPercent     float64                `protobuf:"fixed64,3,opt,name=engaged_growth_percent,json=engagedGrowthPercent,proto3" json:"engaged_growth_percent,omitempty"`
+	OrderedGrowthPercent     float64                `protobuf:"fixed64,4,opt,name=ordered_growth_percent,json=orderedGrowthPercent,proto3" json:"ordered_growth_percent,omitempty"`
+	DeliveredGrowthPercent   float64                `protobuf:"fixed64,5,opt,name=delivered_growth_percent,json=deliveredGrowthPercent,proto3" json:"delivered_growth_percent,omitempty"`
+	ConversionRateChange     float64                `protobuf:"fixed64,6,opt,name=conversion_rate_change,json=conversionRateChange,proto3" json:"conversion_rate_change,omitempty"` // Percentage points
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *FunnelComparison) Reset() {
+	*x = FunnelComparison{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunnelComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunnelComparison) ProtoMessage() {}
+
+func (x *FunnelComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunnelComparison.ProtoReflect.Descriptor instead.
+func (*FunnelComparison) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FunnelComparison) GetImpressionsGrowthPercent() float64 {
+	if x != nil {
+		return x.ImpressionsGrowthPercent
+	}
+	return 0
+}
+
+func (x *FunnelComparison) GetViewsGrowthPercent() float64 {
+	if x != nil {
+		return x.ViewsGrowthPercent
+	}
+	return 0
+}
+
+func (x *FunnelComparison) GetEngagedGrowthPercent() float64 {
+	if x != nil {
+		return x.EngagedGrowthPercent
+	}
+	return 0
+}
+
+func (x *FunnelComparison) GetOrderedGrowthPercent() float64 {
+	if x != nil {
+		return x.OrderedGrowthPercent
+	}
+	return 0
+}
+
+func (x *FunnelComparison) GetDeliveredGrowthPercent() float64 {
+	if x != nil {
+		return x.DeliveredGrowthPercent
+	}
+	return 0
+}
+
+func (x *FunnelComparison) GetConversionRateChange() float64 {
+	if x != nil {
+		return x.ConversionRateChange
+	}
+	return 0
+}
+
+// GetStorefrontFunnelResponse returns the funnel and the optional comparison
+type GetStorefrontFunnelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	ListingId     *int64                 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3,oneof" json:"listing_id,omitempty"`
+	Current       *FunnelStages          `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      *FunnelStages          `protobuf:"bytes,4,opt,name=previous,proto3,oneof" json:"previous,omitempty"`     // Set when compare_previous is true
+	Comparison    *FunnelComparison      `protobuf:"bytes,5,opt,name=comparison,proto3,oneof" json:"comparison,omitempty"` // Set when compare_previous is true
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorefrontFunnelResponse) Reset() {
+	*x = GetStorefrontFunnelResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontFunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontFunnelResponse) ProtoMessage() {}
+
+func (x *GetStorefrontFunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontFunnelResponse.ProtoReflect.Descriptor instead.
+func (*GetStorefrontFunnelResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStorefrontFunnelResponse) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetStorefrontFunnelResponse) GetListingId() int64 {
+	if x != nil && x.ListingId != nil {
+		return *x.ListingId
+	}
+	return 0
+}
+
+func (x *GetStorefrontFunnelResponse) GetCurrent() *FunnelStages {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GetStorefrontFunnelResponse) GetPrevious() *FunnelStages {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *GetStorefrontFunnelResponse) GetComparison() *FunnelComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *GetStorefrontFunnelResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// GetBuyerCohortsRequest retrieves weekly repeat-buyer cohorts of a storefront
+type GetBuyerCohortsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId    int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`          // Storefront (required)
+	Weeks           *int32                 `protobuf:"varint,2,opt,name=weeks,proto3,oneof" json:"weeks,omitempty"`                                      // Number of cohorts (default 8, max 26)
+	DateTo          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`                       // Any time in the newest cohort week (default: now)
+	ComparePrevious bool                   `protobuf:"varint,4,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"` // Also summarize the preceding weeks
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBuyerCohortsRequest) Reset() {
+	*x = GetBuyerCohortsRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuyerCohortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyerCohortsRequest) ProtoMessage() {}
+
+func (x *GetBuyerCohortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyerCohortsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuyerCohortsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBuyerCohortsRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetBuyerCohortsRequest) GetWeeks() int32 {
+	if x != nil && x.Weeks != nil {
+		return *x.Weeks
+	}
+	return 0
+}
+
+func (x *GetBuyerCohortsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *GetBuyerCohortsRequest) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
+}
+
+// BuyerCohort is the set of buyers whose first order was placed in the same week
+type BuyerCohort struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CohortWeek       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cohort_week,json=cohortWeek,proto3" json:"cohort_week,omitempty"`                            // Monday 00:00 UTC
+	Buyers           int32                  `protobuf:"varint,2,opt,name=buyers,proto3" json:"buyers,omitempty"`                                                     // New buyers in the cohort week
+	Returning        []int32                `protobuf:"varint,3,rep,packed,name=returning,proto3" json:"returning,omitempty"`                                        // [k] = buyers who ordered again in week k+1
+	RetentionPercent []float64              `protobuf:"fixed64,4,rep,packed,name=retention_percent,json=retentionPercent,proto3" json:"retention_percent,omitempty"` // returning / buyers, per week
+	RepeatBuyers     int32                  `protobuf:"varint,5,opt,name=repeat_buyers,json=repeatBuyers,proto3" json:"repeat_buyers,omitempty"`                     // Buyers with more than one order
+	RepeatRate       float64                `protobuf:"fixed64,6,opt,name=repeat_rate,json=repeatRate,proto3" json:"repeat_rate,omitempty"`                          // repeat_buyers / buyers %
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BuyerCohort) Reset() {
+	*x = BuyerCohort{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyerCohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyerCohort) ProtoMessage() {}
+
+func (x *BuyerCohort) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyerCohort.ProtoReflect.Descriptor instead.
+func (*BuyerCohort) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BuyerCohort) GetCohortWeek() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CohortWeek
+	}
+	return nil
+}
+
+func (x *BuyerCohort) GetBuyers() int32 {
+	if x != nil {
+		return x.Buyers
+	}
+	return 0
+}
+
+func (x *BuyerCohort) GetReturning() []int32 {
+	if x != nil {
+		return x.Returning
+	}
+	return nil
+}
+
+func (x *BuyerCohort) GetRetentionPercent() []float64 {
+	if x != nil {
+		return x.RetentionPercent
+	}
+	return nil
+}
+
+func (x *BuyerCohort) GetRepeatBuyers() int32 {
+	if x != nil {
+		return x.RepeatBuyers
+	}
+	return 0
+}
+
+func (x *BuyerCohort) GetRepeatRate() float64 {
+	if x != nil {
+		return x.RepeatRate
+	}
+	return 0
+}
+
+// CohortSummary totals a set of cohorts
+type CohortSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Buyers          int32                  `protobuf:"varint,1,opt,name=buyers,proto3" json:"buyers,omitempty"`
+	RepeatBuyers    int32                  `protobuf:"varint,2,opt,name=repeat_buyers,json=repeatBuyers,proto3" json:"repeat_buyers,omitempty"`
+	RepeatBuyerRate float64                `protobuf:"fixed64,3,opt,name=repeat_buyer_rate,json=repeatBuyerRate,proto3" json:"repeat_buyer_rate,omitempty"` // %
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CohortSummary) Reset() {
+	*x = CohortSummary{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortSummary) ProtoMessage() {}
+
+func (x *CohortSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortSummary.ProtoReflect.Descriptor instead.
+func (*CohortSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CohortSummary) GetBuyers() int32 {
+	if x != nil {
+		return x.Buyers
+	}
+	return 0
+}
+
+func (x *CohortSummary) GetRepeatBuyers() int32 {
+	if x != nil {
+		return x.RepeatBuyers
+	}
+	return 0
+}
+
+func (x *CohortSummary) GetRepeatBuyerRate() float64 {
+	if x != nil {
+		return x.RepeatBuyerRate
+	}
+	return 0
+}
+
+// GetBuyerCohortsResponse returns the cohorts, oldest first
+type GetBuyerCohortsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId          int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Cohorts               []*BuyerCohort         `protobuf:"bytes,2,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	Summary               *CohortSummary         `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Previous              *CohortSummary         `protobuf:"bytes,4,opt,name=previous,proto3,oneof" json:"previous,omitempty"`                                                              // Set when compare_previous is true
+	RepeatBuyerRateChange *float64               `protobuf:"fixed64,5,opt,name=repeat_buyer_rate_change,json=repeatBuyerRateChange,proto3,oneof" json:"repeat_buyer_rate_change,omitempty"` // Percentage points vs previous
+	GeneratedAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetBuyerCohortsResponse) Reset() {
+	*x = GetBuyerCohortsResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuyerCohortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyerCohortsResponse) ProtoMessage() {}
+
+func (x *GetBuyerCohortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyerCohortsResponse.ProtoReflect.Descriptor instead.
+func (*GetBuyerCohortsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetBuyerCohortsResponse) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetBuyerCohortsResponse) GetCohorts() []*BuyerCohort {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+func (x *GetBuyerCohortsResponse) GetSummary() *CohortSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetBuyerCohortsResponse) GetPrevious() *CohortSummary {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *GetBuyerCohortsResponse) GetRepeatBuyerRateChange() float64 {
+	if x != nil && x.RepeatBuyerRateChange != nil {
+		return *x.RepeatBuyerRateChange
+	}
+	return 0
+}
+
+func (x *GetBuyerCohortsResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// GetSearchQueryReportRequest selects a search quality report
+type GetSearchQueryReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SearchQueryReportType  `protobuf:"varint,1,opt,name=type,proto3,enum=listingssvc.v1.SearchQueryReportType" json:"type,omitempty"` // Report type (required)
+	MaxResults    *int32                 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3,oneof" json:"max_results,omitempty"`       // Low-results threshold (default 5, max 100)
+	CategoryId    *int64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`       // Searches in this category only
+	Days          *int32                 `protobuf:"varint,4,opt,name=days,proto3,oneof" json:"days,omitempty"`                                     // Last N days (default 7, max 90)
+	MinSearches   *int64                 `protobuf:"varint,5,opt,name=min_searches,json=minSearches,proto3,oneof" json:"min_searches,omitempty"`    // Skip rarer queries (default 1)
+	Limit         *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                   // Max queries (default 50, max 100)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchQueryReportRequest) Reset() {
+	*x = GetSearchQueryReportRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchQueryReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchQueryReportRequest) ProtoMessage() {}
+
+func (x *GetSearchQueryReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchQueryReportRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchQueryReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSearchQueryReportRequest) GetType() SearchQueryReportType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *GetSearchQueryReportRequest) GetMaxResults() int32 {
+	if x != nil && x.MaxResults != nil {
+		return *x.MaxResults
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportRequest) GetMinSearches() int64 {
+	if x != nil && x.MinSearches != nil {
+		return *x.MinSearches
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// SearchQueryStats aggregates the searches of one query (case-insensitive)
+type SearchQueryStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	QueryText          string                 `protobuf:"bytes,1,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	TotalSearches      int64                  `protobuf:"varint,2,opt,name=total_searches,json=totalSearches,proto3" json:"total_searches,omitempty"`
+	ZeroResultSearches int64                  `protobuf:"varint,3,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	AvgResultsCount    float64                `protobuf:"fixed64,4,opt,name=avg_results_count,json=avgResultsCount,proto3" json:"avg_results_count,omitempty"`
+	ClickedSearches    int64                  `protobuf:"varint,5,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"` // Searches with at least one click
+	TotalClicks        int64                  `protobuf:"varint,6,opt,name=total_clicks,json=totalClicks,proto3" json:"total_clicks,omitempty"`
+	AvgClickPosition   float64                `protobuf:"fixed64,7,opt,name=avg_click_position,json=avgClickPosition,proto3" json:"avg_click_position,omitempty"` // 0 without clicks
+	CtrPercent         float64                `protobuf:"fixed64,8,opt,name=ctr_percent,json=ctrPercent,proto3" json:"ctr_percent,omitempty"`                     // clicked_searches / total_searches %
+	LastSearched       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_searched,json=lastSearched,proto3" json:"last_searched,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchQueryStats) Reset() {
+	*x = SearchQueryStats{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQueryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQueryStats) ProtoMessage() {}
+
+func (x *SearchQueryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQueryStats.ProtoReflect.Descriptor instead.
+func (*SearchQueryStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchQueryStats) GetQueryText() string {
+	if x != nil {
+		return x.QueryText
+	}
+	return ""
+}
+
+func (x *SearchQueryStats) GetTotalSearches() int64 {
+	if x != nil {
+		return x.TotalSearches
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetZeroResultSearches() int64 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetAvgResultsCount() float64 {
+	if x != nil {
+		return x.AvgResultsCount
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetTotalClicks() int64 {
+	if x != nil {
+		return x.TotalClicks
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetAvgClickPosition() float64 {
+	if x != nil {
+		return x.AvgClickPosition
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetCtrPercent() float64 {
+	if x != nil {
+		return x.CtrPercent
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetLastSearched() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSearched
+	}
+	return nil
+}
+
+// GetSearchQueryReportResponse returns the queries, most searched first
+type GetSearchQueryReportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Type    SearchQueryReportType  `protobuf:"varint,1,opt,name=type,proto3,enum=listingssvc.v1.SearchQueryReportType" json:"type,omitempty"`
+	Queries []*SearchQueryStats    `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
+	// Totals over all searches of the period
+	TotalSearches      int64                  `protobuf:"varint,3,opt,name=total_searches,json=totalSearches,proto3" json:"total_searches,omitempty"`
+	ZeroResultSearches int64                  `protobuf:"varint,4,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	ZeroResultRate     float64                `protobuf:"fixed64,5,opt,name=zero_result_rate,json=zeroResultRate,proto3" json:"zero_result_rate,omitempty"` // %
+	CtrPercent         float64                `protobuf:"fixed64,6,opt,name=ctr_percent,json=ctrPercent,proto3" json:"ctr_percent,omitempty"`               // %
+	DataFrom           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=data_from,json=dataFrom,proto3" json:"data_from,omitempty"`
+	DataTo             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=data_to,json=dataTo,proto3" json:"data_to,omitempty"`
+	GeneratedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSearchQueryReportResponse) Reset() {
+	*x = GetSearchQueryReportResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchQueryReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchQueryReportResponse) ProtoMessage() {}
+
+func (x *GetSearchQueryReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchQueryReportResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchQueryReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSearchQueryReportResponse) GetType() SearchQueryReportType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *GetSearchQueryReportResponse) GetQueries() []*SearchQueryStats {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *GetSearchQueryReportResponse) GetTotalSearches() int64 {
+	if x != nil {
+		return x.TotalSearches
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportResponse) GetZeroResultSearches() int64 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportResponse) GetZeroResultRate() float64 {
+	if x != nil {
+		return x.ZeroResultRate
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportResponse) GetCtrPercent() float64 {
+	if x != nil {
+		return x.CtrPercent
+	}
+	return 0
+}
+
+func (x *GetSearchQueryReportResponse) GetDataFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataFrom
+	}
+	return nil
+}
+
+func (x *GetSearchQueryReportResponse) GetDataTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataTo
+	}
+	return nil
+}
+
+func (x *GetSearchQueryReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// RequestReportExportRequest requests a report export
+type RequestReportExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ReportExportType       `protobuf:"varint,1,opt,name=type,proto3,enum=listingssvc.v1.ReportExportType" json:"type,omitempty"`       // Report type (required)
+	Format        ReportExportFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=listingssvc.v1.ReportExportFormat" json:"format,omitempty"` // File format (default CSV)
+	StorefrontId  *int64                 `protobuf:"varint,3,opt,name=storefront_id,json=storefrontId,proto3,oneof" json:"storefront_id,omitempty"`  // Storefront (required unless admin)
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                     // Period start (required)
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                           // Period end, exclusive (required, max 366 days)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReportExportRequest) Reset() {
+	*x = RequestReportExportRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReportExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReportExportRequest) ProtoMessage() {}
+
+func (x *RequestReportExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReportExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestReportExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestReportExportRequest) GetType() ReportExportType {
+	if x != nil {
+		return x.Type
+	}
+	return ReportExportType_REPORT_EXPORT_TYPE_UNSPECIFIED
+}
+
+func (x *RequestReportExportRequest) GetFormat() ReportExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportExportFormat_REPORT_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *RequestReportExportRequest) GetStorefrontId() int64 {
+	if x != nil && x.StorefrontId != nil {
+		return *x.StorefrontId
+	}
+	return 0
+}
+
+func (x *RequestReportExportRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *RequestReportExportRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+// RequestReportExportResponse returns the queued export
+type RequestReportExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *ReportExport          `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReportExportResponse) Reset() {
+	*x = RequestReportExportResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReportExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReportExportResponse) ProtoMessage() {}
+
+func (x *RequestReportExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReportExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestReportExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RequestReportExportResponse) GetExport() *ReportExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// GetExportStatusRequest polls an export
+type GetExportStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportStatusRequest) Reset() {
+	*x = GetExportStatusRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportStatusRequest) ProtoMessage() {}
+
+func (x *GetExportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetExportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetExportStatusRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+// GetExportStatusResponse returns the export and its download URL when completed
+type GetExportStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *ReportExport          `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	DownloadUrl   *string                `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"` // Presigned URL (completed exports)
+	UrlExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=url_expires_at,json=urlExpiresAt,proto3,oneof" json:"url_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportStatusResponse) Reset() {
+	*x = GetExportStatusResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportStatusResponse) ProtoMessage() {}
+
+func (x *GetExportStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetExportStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetExportStatusResponse) GetExport() *ReportExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *GetExportStatusResponse) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *GetExportStatusResponse) GetUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return nil
+}
+
+// ReportExport describes a report export
+type ReportExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          ReportExportType       `protobuf:"varint,2,opt,name=type,proto3,enum=listingssvc.v1.ReportExportType" json:"type,omitempty"`
+	Format        ReportExportFormat     `protobuf:"varint,3,opt,name=format,proto3,enum=listingssvc.v1.ReportExportFormat" json:"format,omitempty"`
+	StorefrontId  *int64                 `protobuf:"varint,4,opt,name=storefront_id,json=storefrontId,proto3,oneof" json:"storefront_id,omitempty"` // Not set for platform-wide exports
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Status        ReportExportStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=listingssvc.v1.ReportExportStatus" json:"status,omitempty"`
+	ErrorMessage  *string                `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Last failure
+	FileName      *string                `protobuf:"bytes,9,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`             // Completed exports
+	RowCount      *int64                 `protobuf:"varint,10,opt,name=row_count,json=rowCount,proto3,oneof" json:"row_count,omitempty"`
+	FileSize      *int64                 `protobuf:"varint,11,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"` // Bytes
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // File is deleted after this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportExport) Reset() {
+	*x = ReportExport{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportExport) ProtoMessage() {}
+
+func (x *ReportExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportExport.ProtoReflect.Descriptor instead.
+func (*ReportExport) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReportExport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportExport) GetType() ReportExportType {
+	if x != nil {
+		return x.Type
+	}
+	return ReportExportType_REPORT_EXPORT_TYPE_UNSPECIFIED
+}
+
+func (x *ReportExport) GetFormat() ReportExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportExportFormat_REPORT_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ReportExport) GetStorefrontId() int64 {
+	if x != nil && x.StorefrontId != nil {
+		return *x.StorefrontId
+	}
+	return 0
+}
+
+func (x *ReportExport) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ReportExport) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ReportExport) GetStatus() ReportExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportExportStatus_REPORT_EXPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ReportExport) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ReportExport) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ReportExport) GetRowCount() int64 {
+	if x != nil && x.RowCount != nil {
+		return *x.RowCount
+	}
+	return 0
+}
+
+func (x *ReportExport) GetFileSize() int64 {
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
+	}
+	return 0
+}
+
+func (x *ReportExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReportExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ReportExport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// SearchRanking tunes the relevance scoring of the search text query (0 = default)
+type SearchRanking struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TitleBoost       float64                `protobuf:"fixed64,1,opt,name=title_boost,json=titleBoost,proto3" json:"title_boost,omitempty"`                   // Default 3
+	DescriptionBoost float64                `protobuf:"fixed64,2,opt,name=description_boost,json=descriptionBoost,proto3" json:"description_boost,omitempty"` // Default 1
+	ViewsWeight      float64                `protobuf:"fixed64,3,opt,name=views_weight,json=viewsWeight,proto3" json:"views_weight,omitempty"`                // function_score factor of log1p(views_count)
+	FavoritesWeight  float64                `protobuf:"fixed64,4,opt,name=favorites_weight,json=favoritesWeight,proto3" json:"favorites_weight,omitempty"`    // function_score factor of log1p(favorites_count)
+	RatingWeight     float64                `protobuf:"fixed64,5,opt,name=rating_weight,json=ratingWeight,proto3" json:"rating_weight,omitempty"`             // function_score factor of rating
+	BoostMode        string                 `protobuf:"bytes,6,opt,name=boost_mode,json=boostMode,proto3" json:"boost_mode,omitempty"`                        // "multiply" (default) or "sum"
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchRanking) Reset() {
+	*x = SearchRanking{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRanking) ProtoMessage() {}
+
+func (x *SearchRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRanking.ProtoReflect.Descriptor instead.
+func (*SearchRanking) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchRanking) GetTitleBoost() float64 {
+	if x != nil {
+		return x.TitleBoost
+	}
+	return 0
+}
+
+func (x *SearchRanking) GetDescriptionBoost() float64 {
+	if x != nil {
+		return x.DescriptionBoost
+	}
+	return 0
+}
+
+func (x *SearchRanking) GetViewsWeight() float64 {
+	if x != nil {
+		return x.ViewsWeight
+	}
+	return 0
+}
+
+func (x *SearchRanking) GetFavoritesWeight() float64 {
+	if x != nil {
+		return x.FavoritesWeight
+	}
+	return 0
+}
+
+func (x *SearchRanking) GetRatingWeight() float64 {
+	if x != nil {
+		return x.RatingWeight
+	}
+	return 0
+}
+
+func (x *SearchRanking) GetBoostMode() string {
+	if x != nil {
+		return x.BoostMode
+	}
+	return ""
+}
+
+// SearchExperimentVariant is one arm of an experiment
+type SearchExperimentVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // Lowercase letters, digits, '-' or '_'
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`        // Relative share of the experiment traffic (default 1)
+	Ranking       *SearchRanking         `protobuf:"bytes,3,opt,name=ranking,proto3,oneof" json:"ranking,omitempty"` // Not set = control (default ranking)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchExperimentVariant) Reset() {
+	*x = SearchExperimentVariant{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExperimentVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExperimentVariant) ProtoMessage() {}
+
+func (x *SearchExperimentVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExperimentVariant.ProtoReflect.Descriptor instead.
+func (*SearchExperimentVariant) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchExperimentVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchExperimentVariant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SearchExperimentVariant) GetRanking() *SearchRanking {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+// SearchExperiment describes a search ranking experiment
+type SearchExperiment struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Id             string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description    string                     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Status         SearchExperimentStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=listingssvc.v1.SearchExperimentStatus" json:"status,omitempty"`
+	TrafficPercent int32                      `protobuf:"varint,4,opt,name=traffic_percent,json=trafficPercent,proto3" json:"traffic_percent,omitempty"` // Share of searchers enrolled
+	Variants       []*SearchExperimentVariant `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`                                    // The first one is the baseline
+	StartedAt      *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	StoppedAt      *timestamppb.Timestamp     `protobuf:"bytes,7,opt,name=stopped_at,json=stoppedAt,proto3,oneof" json:"stopped_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchExperiment) Reset() {
+	*x = SearchExperiment{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExperiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExperiment) ProtoMessage() {}
+
+func (x *SearchExperiment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExperiment.ProtoReflect.Descriptor instead.
+func (*SearchExperiment) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchExperiment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchExperiment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SearchExperiment) GetStatus() SearchExperimentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_UNSPECIFIED
+}
+
+func (x *SearchExperiment) GetTrafficPercent() int32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+func (x *SearchExperiment) GetVariants() []*SearchExperimentVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *SearchExperiment) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SearchExperiment) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *SearchExperiment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SearchExperiment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateSearchExperimentRequest defines a new experiment
+type CreateSearchExperimentRequest struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Id             string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Slug, e.g. "popularity-boost-v1"
+	Description    string                     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TrafficPercent int32                      `protobuf:"varint,3,opt,name=traffic_percent,json=trafficPercent,proto3" json:"traffic_percent,omitempty"` // 1-100 (default 100)
+	Variants       []*SearchExperimentVariant `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`                                    // 2-5 variants, baseline first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSearchExperimentRequest) Reset() {
+	*x = CreateSearchExperimentRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSearchExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSearchExperimentRequest) ProtoMessage() {}
+
+func (x *CreateSearchExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSearchExperimentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSearchExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateSearchExperimentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSearchExperimentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSearchExperimentRequest) GetTrafficPercent() int32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+func (x *CreateSearchExperimentRequest) GetVariants() []*SearchExperimentVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// UpdateSearchExperimentStatusRequest starts or stops an experiment
+type UpdateSearchExperimentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        SearchExperimentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=listingssvc.v1.SearchExperimentStatus" json:"status,omitempty"` // RUNNING or STOPPED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSearchExperimentStatusRequest) Reset() {
+	*x = UpdateSearchExperimentStatusRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSearchExperimentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSearchExperimentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateSearchExperimentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSearchExperimentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSearchExperimentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateSearchExperimentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSearchExperimentStatusRequest) GetStatus() SearchExperimentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_UNSPECIFIED
+}
+
+// SearchExperimentResponse returns the created or updated experiment
+type SearchExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *SearchExperiment      `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchExperimentResponse) Reset() {
+	*x = SearchExperimentResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExperimentResponse) ProtoMessage() {}
+
+func (x *SearchExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExperimentResponse.ProtoReflect.Descriptor instead.
+func (*SearchExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchExperimentResponse) GetExperiment() *SearchExperiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+// ListSearchExperimentsRequest filters experiments
+type ListSearchExperimentsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        *SearchExperimentStatus `protobuf:"varint,1,opt,name=status,proto3,enum=listingssvc.v1.SearchExperimentStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchExperimentsRequest) Reset() {
+	*x = ListSearchExperimentsRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchExperimentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchExperimentsRequest) ProtoMessage() {}
+
+func (x *ListSearchExperimentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchExperimentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchExperimentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListSearchExperimentsRequest) GetStatus() SearchExperimentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_UNSPECIFIED
+}
+
+// ListSearchExperimentsResponse returns the experiments
+type ListSearchExperimentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiments   []*SearchExperiment    `protobuf:"bytes,1,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchExperimentsResponse) Reset() {
+	*x = ListSearchExperimentsResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchExperimentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchExperimentsResponse) ProtoMessage() {}
+
+func (x *ListSearchExperimentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchExperimentsResponse.ProtoReflect.Descriptor instead.
+func (*ListSearchExperimentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListSearchExperimentsResponse) GetExperiments() []*SearchExperiment {
+	if x != nil {
+		return x.Experiments
+	}
+	return nil
+}
+
+// GetSearchExperimentResultsRequest selects an experiment
+type GetSearchExperimentResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExperimentId  string                 `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchExperimentResultsRequest) Reset() {
+	*x = GetSearchExperimentResultsRequest{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchExperimentResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchExperimentResultsRequest) ProtoMessage() {}
+
+func (x *GetSearchExperimentResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchExperimentResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchExperimentResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSearchExperimentResultsRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+// SearchExperimentArmStats aggregates the recorded searches served by one variant
+type SearchExperimentArmStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Variant               string                 `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	Searches              int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	ClickedSearches       int64                  `protobuf:"varint,3,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"` // Searches with at least one click
+	Clicks                int64                  `protobuf:"varint,4,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	ConvertedSearches     int64                  `protobuf:"varint,5,opt,name=converted_searches,json=convertedSearches,proto3" json:"converted_searches,omitempty"`                 // A clicked listing was ordered within 7 days
+	AvgClickPosition      float64                `protobuf:"fixed64,6,opt,name=avg_click_position,json=avgClickPosition,proto3" json:"avg_click_position,omitempty"`                 // 0 without clicks
+	CtrPercent            float64                `protobuf:"fixed64,7,opt,name=ctr_percent,json=ctrPercent,proto3" json:"ctr_percent,omitempty"`                                     // clicked_searches / searches %
+	ConversionPercent     float64                `protobuf:"fixed64,8,opt,name=conversion_percent,json=conversionPercent,proto3" json:"conversion_percent,omitempty"`                // converted_searches / searches %
+	CtrLiftPercent        float64                `protobuf:"fixed64,9,opt,name=ctr_lift_percent,json=ctrLiftPercent,proto3" json:"ctr_lift_percent,omitempty"`                       // Relative to the baseline arm
+	ConversionLiftPercent float64                `protobuf:"fixed64,10,opt,name=conversion_lift_percent,json=conversionLiftPercent,proto3" json:"conversion_lift_percent,omitempty"` // Relative to the baseline arm
+	CtrPValue             float64                `protobuf:"fixed64,11,opt,name=ctr_p_value,json=ctrPValue,proto3" json:"ctr_p_value,omitempty"`                                     // Two-proportion z-test vs baseline (1 for the baseline)
+	ConversionPValue      float64                `protobuf:"fixed64,12,opt,name=conversion_p_value,json=conversionPValue,proto3" json:"conversion_p_value,omitempty"`                // Two-proportion z-test vs baseline (1 for the baseline)
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchExperimentArmStats) Reset() {
+	*x = SearchExperimentArmStats{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExperimentArmStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExperimentArmStats) ProtoMessage() {}
+
+func (x *SearchExperimentArmStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExperimentArmStats.ProtoReflect.Descriptor instead.
+func (*SearchExperimentArmStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchExperimentArmStats) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *SearchExperimentArmStats) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetConvertedSearches() int64 {
+	if x != nil {
+		return x.ConvertedSearches
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetAvgClickPosition() float64 {
+	if x != nil {
+		return x.AvgClickPosition
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetCtrPercent() float64 {
+	if x != nil {
+		return x.CtrPercent
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetConversionPercent() float64 {
+	if x != nil {
+		return x.ConversionPercent
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetCtrLiftPercent() float64 {
+	if x != nil {
+		return x.CtrLiftPercent
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetConversionLiftPercent() float64 {
+	if x != nil {
+		return x.ConversionLiftPercent
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetCtrPValue() float64 {
+	if x != nil {
+		return x.CtrPValue
+	}
+	return 0
+}
+
+func (x *SearchExperimentArmStats) GetConversionPValue() float64 {
+	if x != nil {
+		return x.ConversionPValue
+	}
+	return 0
+}
+
+// GetSearchExperimentResultsResponse compares the arms, baseline first
+type GetSearchExperimentResultsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Experiment    *SearchExperiment           `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	Arms          []*SearchExperimentArmStats `protobuf:"bytes,2,rep,name=arms,proto3" json:"arms,omitempty"`
+	DataFrom      *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=data_from,json=dataFrom,proto3" json:"data_from,omitempty"` // Experiment start
+	DataTo        *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=data_to,json=dataTo,proto3" json:"data_to,omitempty"`       // Experiment stop or now
+	GeneratedAt   *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchExperimentResultsResponse) Reset() {
+	*x = GetSearchExperimentResultsResponse{}
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchExperimentResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchExperimentResultsResponse) ProtoMessage() {}
+
+func (x *GetSearchExperimentResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_analytics_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchExperimentResultsResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchExperimentResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_analytics_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSearchExperimentResultsResponse) GetExperiment() *SearchExperiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *GetSearchExperimentResultsResponse) GetArms() []*SearchExperimentArmStats {
+	if x != nil {
+		return x.Arms
+	}
+	return nil
+}
+
+func (x *GetSearchExperimentResultsResponse) GetDataFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataFrom
+	}
+	return nil
+}
+
+func (x *GetSearchExperimentResultsResponse) GetDataTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DataTo
+	}
+	return nil
+}
+
+func (x *GetSearchExperimentResultsResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+var File_api_proto_listings_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_api_proto_listings_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"%api/proto/listings/v1/analytics.proto\x12\x0elistingssvc.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x9c\x04\n" +
+	"\x17GetOverviewStatsRequest\x127\n" +
+	"\tdate_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12B\n" +
+	"\fcompare_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vcompareFrom\x88\x01\x01\x12>\n" +
+	"\n" +
+	"compare_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tcompareTo\x88\x01\x01\x129\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x1c.listingssvc.v1.MetricPeriodH\x02R\x06period\x88\x01\x01\x12(\n" +
+	"\rstorefront_id\x18\x06 \x01(\x03H\x03R\fstorefrontId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x04R\n" +
+	"categoryId\x88\x01\x01\x12&\n" +
+	"\flisting_type\x18\b \x01(\tH\x05R\vlistingType\x88\x01\x01B\x0f\n" +
+	"\r_compare_fromB\r\n" +
+	"\v_compare_toB\t\n" +
+	"\a_periodB\x10\n" +
+	"\x0e_storefront_idB\x0e\n" +
+	"\f_category_idB\x0f\n" +
+	"\r_listing_type\"\xd0\x05\n" +
+	"\x18GetOverviewStatsResponse\x129\n" +
+	"\blistings\x18\x01 \x01(\v2\x1d.listingssvc.v1.ListingsStatsR\blistings\x126\n" +
+	"\arevenue\x18\x02 \x01(\v2\x1c.listingssvc.v1.RevenueStatsR\arevenue\x120\n" +
+	"\x05users\x18\x03 \x01(\v2\x1a.listingssvc.v1.UsersStatsR\x05users\x123\n" +
+	"\x06orders\x18\x04 \x01(\v2\x1b.listingssvc.v1.OrdersStatsR\x06orders\x12A\n" +
+	"\n" +
+	"engagement\x18\x05 \x01(\v2!.listingssvc.v1.EngagementMetricsR\n" +
+	"engagement\x12@\n" +
+	"\vtime_series\x18\x06 \x03(\v2\x1f.listingssvc.v1.TimeSeriesPointR\n" +
+	"timeSeries\x12J\n" +
+	"\n" +
+	"comparison\x18\a \x01(\v2%.listingssvc.v1.PerformanceComparisonH\x00R\n" +
+	"comparison\x88\x01\x01\x12M\n" +
+	"\x11conversion_funnel\x18\b \x01(\v2 .listingssvc.v1.ConversionFunnelR\x10conversionFunnel\x12=\n" +
+	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x127\n" +
+	"\tdata_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
+	"\adata_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06dataToB\r\n" +
+	"\v_comparison\"\x92\x05\n" +
+	"\x16GetListingStatsRequest\x12\x1f\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03H\x00R\tlistingId\x12\x1f\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03H\x00R\tproductId\x127\n" +
+	"\tdate_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12B\n" +
+	"\fcompare_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vcompareFrom\x88\x01\x01\x12>\n" +
+	"\n" +
+	"compare_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tcompareTo\x88\x01\x01\x129\n" +
+	"\x06period\x18\a \x01(\x0e2\x1c.listingssvc.v1.MetricPeriodH\x03R\x06period\x88\x01\x01\x12.\n" +
+	"\x10include_variants\x18\b \x01(\bH\x04R\x0fincludeVariants\x88\x01\x01\x12$\n" +
+	"\vinclude_geo\x18\t \x01(\bH\x05R\n" +
+	"includeGeo\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\n" +
+	" \x01(\x03H\x06R\x06userId\x88\x01\x01\x12\x1e\n" +
+	"\bis_admin\x18\v \x01(\bH\aR\aisAdmin\x88\x01\x01B\f\n" +
+	"\n" +
+	"identifierB\x0f\n" +
+	"\r_compare_fromB\r\n" +
+	"\v_compare_toB\t\n" +
+	"\a_periodB\x13\n" +
+	"\x11_include_variantsB\x0e\n" +
+	"\f_include_geoB\n" +
+	"\n" +
+	"\b_user_idB\v\n" +
+	"\t_is_admin\"\xea\v\n" +
+	"\x17GetListingStatsResponse\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12!\n" +
+	"\flisting_name\x18\x02 \x01(\tR\vlistingName\x12!\n" +
+	"\flisting_type\x18\x03 \x01(\tR\vlistingType\x12(\n" +
+	"\rstorefront_id\x18\x04 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x12\x1f\n" +
+	"\vtotal_views\x18\x05 \x01(\x05R\n" +
+	"totalViews\x12'\n" +
+	"\x0funique_visitors\x18\x06 \x01(\x05R\x0euniqueVisitors\x12%\n" +
+	"\x0efavorite_count\x18\a \x01(\x05R\rfavoriteCount\x12\x1b\n" +
+	"\tcart_adds\x18\b \x01(\x05R\bcartAdds\x12\x1f\n" +
+	"\vtotal_sales\x18\t \x01(\x05R\n" +
+	"totalSales\x12#\n" +
+	"\rtotal_revenue\x18\n" +
+	" \x01(\x01R\ftotalRevenue\x12&\n" +
+	"\x0favg_order_value\x18\v \x01(\x01R\ravgOrderValue\x12'\n" +
+	"\x0fconversion_rate\x18\f \x01(\x01R\x0econversionRate\x12#\n" +
+	"\rcurrent_stock\x18\r \x01(\x05R\fcurrentStock\x12\x1d\n" +
+	"\n" +
+	"stock_sold\x18\x0e \x01(\x05R\tstockSold\x12*\n" +
+	"\x0estock_reserved\x18\x0f \x01(\x05H\x01R\rstockReserved\x88\x01\x01\x12-\n" +
+	"\x12inventory_turnover\x18\x10 \x01(\x01R\x11inventoryTurnover\x12A\n" +
+	"\n" +
+	"engagement\x18\x11 \x01(\v2!.listingssvc.v1.EngagementMetricsR\n" +
+	"engagement\x12A\n" +
+	"\rvariant_stats\x18\x12 \x03(\v2\x1c.listingssvc.v1.VariantStatsR\fvariantStats\x125\n" +
+	"\tgeo_stats\x18\x13 \x03(\v2\x18.listingssvc.v1.GeoStatsR\bgeoStats\x12G\n" +
+	"\vtime_series\x18\x14 \x03(\v2&.listingssvc.v1.ListingTimeSeriesPointR\n" +
+	"timeSeries\x12J\n" +
+	"\n" +
+	"comparison\x18\x15 \x01(\v2%.listingssvc.v1.PerformanceComparisonH\x02R\n" +
+	"comparison\x88\x01\x01\x12#\n" +
+	"\rcurrent_price\x18\x16 \x01(\x01R\fcurrentPrice\x12*\n" +
+	"\x0eoriginal_price\x18\x17 \x01(\x01H\x03R\roriginalPrice\x88\x01\x01\x12)\n" +
+	"\x10discount_percent\x18\x18 \x01(\x01R\x0fdiscountPercent\x129\n" +
+	"\n" +
+	"created_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fgenerated_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x127\n" +
+	"\tdata_from\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
+	"\adata_to\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\x06dataTo\x12\x1f\n" +
+	"\vcategory_id\x18\x1e \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x1f \x01(\tR\fcategoryNameB\x10\n" +
+	"\x0e_storefront_idB\x11\n" +
+	"\x0f_stock_reservedB\r\n" +
+	"\v_comparisonB\x11\n" +
+	"\x0f_original_price\"\x96\x05\n" +
+	"\rListingsStats\x12%\n" +
+	"\x0etotal_listings\x18\x01 \x01(\x05R\rtotalListings\x12'\n" +
+	"\x0factive_listings\x18\x02 \x01(\x05R\x0eactiveListings\x12!\n" +
 	"\fnew_listings\x18\x03 \x01(\x05R\vnewListings\x12)\n" +
 	"\x10deleted_listings\x18\x04 \x01(\x05R\x0fdeletedListings\x12 \n" +
 	"\fout_of_stock\x18\x05 \x01(\x05R\n" +
@@ -3289,7 +5889,255 @@ const file_api_proto_listings_v1_analytics_proto_rawDesc = "" +
 	"\x13trending_categories\x18\x01 \x03(\v2 .listingssvc.v1.TrendingCategoryR\x12trendingCategories\x12=\n" +
 	"\fhot_listings\x18\x02 \x03(\v2\x1a.listingssvc.v1.HotListingR\vhotListings\x12H\n" +
 	"\x10popular_searches\x18\x03 \x03(\v2\x1d.listingssvc.v1.PopularSearchR\x0fpopularSearches\x12=\n" +
-	"\fgenerated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt*\x95\x01\n" +
+	"\fgenerated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xa1\x02\n" +
+	"\fTrackedEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\x03R\bentityId\x12F\n" +
+	"\bmetadata\x18\x03 \x03(\v2*.listingssvc.v1.TrackedEvent.MetadataEntryR\bmetadata\x12@\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"occurredAt\x88\x01\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_occurred_at\"}\n" +
+	"\x12TrackEventsRequest\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.listingssvc.v1.TrackedEventR\x06events\x12\"\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tH\x00R\tsessionId\x88\x01\x01B\r\n" +
+	"\v_session_id\"g\n" +
+	"\x13TrackEventsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x05R\adropped\"\x8d\x02\n" +
+	"\x1aGetStorefrontFunnelRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\"\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03H\x00R\tlistingId\x88\x01\x01\x127\n" +
+	"\tdate_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12)\n" +
+	"\x10compare_previous\x18\x05 \x01(\bR\x0fcomparePreviousB\r\n" +
+	"\v_listing_id\"\xb9\x03\n" +
+	"\fFunnelStages\x12 \n" +
+	"\vimpressions\x18\x01 \x01(\x05R\vimpressions\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x05R\x05views\x12\x18\n" +
+	"\aengaged\x18\x03 \x01(\x05R\aengaged\x12\x18\n" +
+	"\aordered\x18\x04 \x01(\x05R\aordered\x12\x1c\n" +
+	"\tdelivered\x18\x05 \x01(\x05R\tdelivered\x12\x1b\n" +
+	"\tview_rate\x18\x06 \x01(\x01R\bviewRate\x12'\n" +
+	"\x0fengagement_rate\x18\a \x01(\x01R\x0eengagementRate\x12\x1d\n" +
+	"\n" +
+	"order_rate\x18\b \x01(\x01R\torderRate\x12#\n" +
+	"\rdelivery_rate\x18\t \x01(\x01R\fdeliveryRate\x12'\n" +
+	"\x0fconversion_rate\x18\n" +
+	" \x01(\x01R\x0econversionRate\x127\n" +
+	"\tdata_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
+	"\adata_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06dataTo\"\xde\x02\n" +
+	"\x10FunnelComparison\x12<\n" +
+	"\x1aimpressions_growth_percent\x18\x01 \x01(\x01R\x18impressionsGrowthPercent\x120\n" +
+	"\x14views_growth_percent\x18\x02 \x01(\x01R\x12viewsGrowthPercent\x124\n" +
+	"\x16engaged_growth_percent\x18\x03 \x01(\x01R\x14engagedGrowthPercent\x124\n" +
+	"\x16ordered_growth_percent\x18\x04 \x01(\x01R\x14orderedGrowthPercent\x128\n" +
+	"\x18delivered_growth_percent\x18\x05 \x01(\x01R\x16deliveredGrowthPercent\x124\n" +
+	"\x16conversion_rate_change\x18\x06 \x01(\x01R\x14conversionRateChange\"\x8e\x03\n" +
+	"\x1bGetStorefrontFunnelResponse\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\"\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03H\x00R\tlistingId\x88\x01\x01\x126\n" +
+	"\acurrent\x18\x03 \x01(\v2\x1c.listingssvc.v1.FunnelStagesR\acurrent\x12=\n" +
+	"\bprevious\x18\x04 \x01(\v2\x1c.listingssvc.v1.FunnelStagesH\x01R\bprevious\x88\x01\x01\x12E\n" +
+	"\n" +
+	"comparison\x18\x05 \x01(\v2 .listingssvc.v1.FunnelComparisonH\x02R\n" +
+	"comparison\x88\x01\x01\x12=\n" +
+	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB\r\n" +
+	"\v_listing_idB\v\n" +
+	"\t_previousB\r\n" +
+	"\v_comparison\"\xd3\x01\n" +
+	"\x16GetBuyerCohortsRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x19\n" +
+	"\x05weeks\x18\x02 \x01(\x05H\x00R\x05weeks\x88\x01\x01\x128\n" +
+	"\adate_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06dateTo\x88\x01\x01\x12)\n" +
+	"\x10compare_previous\x18\x04 \x01(\bR\x0fcomparePreviousB\b\n" +
+	"\x06_weeksB\n" +
+	"\n" +
+	"\b_date_to\"\xf3\x01\n" +
+	"\vBuyerCohort\x12;\n" +
+	"\vcohort_week\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"cohortWeek\x12\x16\n" +
+	"\x06buyers\x18\x02 \x01(\x05R\x06buyers\x12\x1c\n" +
+	"\treturning\x18\x03 \x03(\x05R\treturning\x12+\n" +
+	"\x11retention_percent\x18\x04 \x03(\x01R\x10retentionPercent\x12#\n" +
+	"\rrepeat_buyers\x18\x05 \x01(\x05R\frepeatBuyers\x12\x1f\n" +
+	"\vrepeat_rate\x18\x06 \x01(\x01R\n" +
+	"repeatRate\"x\n" +
+	"\rCohortSummary\x12\x16\n" +
+	"\x06buyers\x18\x01 \x01(\x05R\x06buyers\x12#\n" +
+	"\rrepeat_buyers\x18\x02 \x01(\x05R\frepeatBuyers\x12*\n" +
+	"\x11repeat_buyer_rate\x18\x03 \x01(\x01R\x0frepeatBuyerRate\"\x95\x03\n" +
+	"\x17GetBuyerCohortsResponse\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x125\n" +
+	"\acohorts\x18\x02 \x03(\v2\x1b.listingssvc.v1.BuyerCohortR\acohorts\x127\n" +
+	"\asummary\x18\x03 \x01(\v2\x1d.listingssvc.v1.CohortSummaryR\asummary\x12>\n" +
+	"\bprevious\x18\x04 \x01(\v2\x1d.listingssvc.v1.CohortSummaryH\x00R\bprevious\x88\x01\x01\x12<\n" +
+	"\x18repeat_buyer_rate_change\x18\x05 \x01(\x01H\x01R\x15repeatBuyerRateChange\x88\x01\x01\x12=\n" +
+	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB\v\n" +
+	"\t_previousB\x1b\n" +
+	"\x19_repeat_buyer_rate_change\"\xc4\x02\n" +
+	"\x1bGetSearchQueryReportRequest\x129\n" +
+	"\x04type\x18\x01 \x01(\x0e2%.listingssvc.v1.SearchQueryReportTypeR\x04type\x12$\n" +
+	"\vmax_results\x18\x02 \x01(\x05H\x00R\n" +
+	"maxResults\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\x03H\x01R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04days\x18\x04 \x01(\x05H\x02R\x04days\x88\x01\x01\x12&\n" +
+	"\fmin_searches\x18\x05 \x01(\x03H\x03R\vminSearches\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x05H\x04R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_max_resultsB\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_daysB\x0f\n" +
+	"\r_min_searchesB\b\n" +
+	"\x06_limit\"\x94\x03\n" +
+	"\x10SearchQueryStats\x12\x1d\n" +
+	"\n" +
+	"query_text\x18\x01 \x01(\tR\tqueryText\x12%\n" +
+	"\x0etotal_searches\x18\x02 \x01(\x03R\rtotalSearches\x120\n" +
+	"\x14zero_result_searches\x18\x03 \x01(\x03R\x12zeroResultSearches\x12*\n" +
+	"\x11avg_results_count\x18\x04 \x01(\x01R\x0favgResultsCount\x12)\n" +
+	"\x10clicked_searches\x18\x05 \x01(\x03R\x0fclickedSearches\x12!\n" +
+	"\ftotal_clicks\x18\x06 \x01(\x03R\vtotalClicks\x12,\n" +
+	"\x12avg_click_position\x18\a \x01(\x01R\x10avgClickPosition\x12\x1f\n" +
+	"\vctr_percent\x18\b \x01(\x01R\n" +
+	"ctrPercent\x12?\n" +
+	"\rlast_searched\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastSearched\"\xe6\x03\n" +
+	"\x1cGetSearchQueryReportResponse\x129\n" +
+	"\x04type\x18\x01 \x01(\x0e2%.listingssvc.v1.SearchQueryReportTypeR\x04type\x12:\n" +
+	"\aqueries\x18\x02 \x03(\v2 .listingssvc.v1.SearchQueryStatsR\aqueries\x12%\n" +
+	"\x0etotal_searches\x18\x03 \x01(\x03R\rtotalSearches\x120\n" +
+	"\x14zero_result_searches\x18\x04 \x01(\x03R\x12zeroResultSearches\x12(\n" +
+	"\x10zero_result_rate\x18\x05 \x01(\x01R\x0ezeroResultRate\x12\x1f\n" +
+	"\vctr_percent\x18\x06 \x01(\x01R\n" +
+	"ctrPercent\x127\n" +
+	"\tdata_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
+	"\adata_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06dataTo\x12=\n" +
+	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xb8\x02\n" +
+	"\x1aRequestReportExportRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .listingssvc.v1.ReportExportTypeR\x04type\x12:\n" +
+	"\x06format\x18\x02 \x01(\x0e2\".listingssvc.v1.ReportExportFormatR\x06format\x12(\n" +
+	"\rstorefront_id\x18\x03 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateToB\x10\n" +
+	"\x0e_storefront_id\"S\n" +
+	"\x1bRequestReportExportResponse\x124\n" +
+	"\x06export\x18\x01 \x01(\v2\x1c.listingssvc.v1.ReportExportR\x06export\"5\n" +
+	"\x16GetExportStatusRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\x03R\bexportId\"\xe2\x01\n" +
+	"\x17GetExportStatusResponse\x124\n" +
+	"\x06export\x18\x01 \x01(\v2\x1c.listingssvc.v1.ReportExportR\x06export\x12&\n" +
+	"\fdownload_url\x18\x02 \x01(\tH\x00R\vdownloadUrl\x88\x01\x01\x12E\n" +
+	"\x0eurl_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\furlExpiresAt\x88\x01\x01B\x0f\n" +
+	"\r_download_urlB\x11\n" +
+	"\x0f_url_expires_at\"\xa1\x06\n" +
+	"\fReportExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .listingssvc.v1.ReportExportTypeR\x04type\x12:\n" +
+	"\x06format\x18\x03 \x01(\x0e2\".listingssvc.v1.ReportExportFormatR\x06format\x12(\n" +
+	"\rstorefront_id\x18\x04 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x127\n" +
+	"\tdate_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12:\n" +
+	"\x06status\x18\a \x01(\x0e2\".listingssvc.v1.ReportExportStatusR\x06status\x12(\n" +
+	"\rerror_message\x18\b \x01(\tH\x01R\ferrorMessage\x88\x01\x01\x12 \n" +
+	"\tfile_name\x18\t \x01(\tH\x02R\bfileName\x88\x01\x01\x12 \n" +
+	"\trow_count\x18\n" +
+	" \x01(\x03H\x03R\browCount\x88\x01\x01\x12 \n" +
+	"\tfile_size\x18\v \x01(\x03H\x04R\bfileSize\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x05R\vcompletedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x06R\texpiresAt\x88\x01\x01B\x10\n" +
+	"\x0e_storefront_idB\x10\n" +
+	"\x0e_error_messageB\f\n" +
+	"\n" +
+	"_file_nameB\f\n" +
+	"\n" +
+	"_row_countB\f\n" +
+	"\n" +
+	"_file_sizeB\x0f\n" +
+	"\r_completed_atB\r\n" +
+	"\v_expires_at\"\xef\x01\n" +
+	"\rSearchRanking\x12\x1f\n" +
+	"\vtitle_boost\x18\x01 \x01(\x01R\n" +
+	"titleBoost\x12+\n" +
+	"\x11description_boost\x18\x02 \x01(\x01R\x10descriptionBoost\x12!\n" +
+	"\fviews_weight\x18\x03 \x01(\x01R\vviewsWeight\x12)\n" +
+	"\x10favorites_weight\x18\x04 \x01(\x01R\x0ffavoritesWeight\x12#\n" +
+	"\rrating_weight\x18\x05 \x01(\x01R\fratingWeight\x12\x1d\n" +
+	"\n" +
+	"boost_mode\x18\x06 \x01(\tR\tboostMode\"\x8f\x01\n" +
+	"\x17SearchExperimentVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\x12<\n" +
+	"\aranking\x18\x03 \x01(\v2\x1d.listingssvc.v1.SearchRankingH\x00R\aranking\x88\x01\x01B\n" +
+	"\n" +
+	"\b_ranking\"\x86\x04\n" +
+	"\x10SearchExperiment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12>\n" +
+	"\x06status\x18\x03 \x01(\x0e2&.listingssvc.v1.SearchExperimentStatusR\x06status\x12'\n" +
+	"\x0ftraffic_percent\x18\x04 \x01(\x05R\x0etrafficPercent\x12C\n" +
+	"\bvariants\x18\x05 \x03(\v2'.listingssvc.v1.SearchExperimentVariantR\bvariants\x12>\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"stopped_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstoppedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_started_atB\r\n" +
+	"\v_stopped_at\"\xbf\x01\n" +
+	"\x1dCreateSearchExperimentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0ftraffic_percent\x18\x03 \x01(\x05R\x0etrafficPercent\x12C\n" +
+	"\bvariants\x18\x04 \x03(\v2'.listingssvc.v1.SearchExperimentVariantR\bvariants\"u\n" +
+	"#UpdateSearchExperimentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.listingssvc.v1.SearchExperimentStatusR\x06status\"\\\n" +
+	"\x18SearchExperimentResponse\x12@\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2 .listingssvc.v1.SearchExperimentR\n" +
+	"experiment\"n\n" +
+	"\x1cListSearchExperimentsRequest\x12C\n" +
+	"\x06status\x18\x01 \x01(\x0e2&.listingssvc.v1.SearchExperimentStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"c\n" +
+	"\x1dListSearchExperimentsResponse\x12B\n" +
+	"\vexperiments\x18\x01 \x03(\v2 .listingssvc.v1.SearchExperimentR\vexperiments\"H\n" +
+	"!GetSearchExperimentResultsRequest\x12#\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\"\xf0\x03\n" +
+	"\x18SearchExperimentArmStats\x12\x18\n" +
+	"\avariant\x18\x01 \x01(\tR\avariant\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x03R\bsearches\x12)\n" +
+	"\x10clicked_searches\x18\x03 \x01(\x03R\x0fclickedSearches\x12\x16\n" +
+	"\x06clicks\x18\x04 \x01(\x03R\x06clicks\x12-\n" +
+	"\x12converted_searches\x18\x05 \x01(\x03R\x11convertedSearches\x12,\n" +
+	"\x12avg_click_position\x18\x06 \x01(\x01R\x10avgClickPosition\x12\x1f\n" +
+	"\vctr_percent\x18\a \x01(\x01R\n" +
+	"ctrPercent\x12-\n" +
+	"\x12conversion_percent\x18\b \x01(\x01R\x11conversionPercent\x12(\n" +
+	"\x10ctr_lift_percent\x18\t \x01(\x01R\x0ectrLiftPercent\x126\n" +
+	"\x17conversion_lift_percent\x18\n" +
+	" \x01(\x01R\x15conversionLiftPercent\x12\x1e\n" +
+	"\vctr_p_value\x18\v \x01(\x01R\tctrPValue\x12,\n" +
+	"\x12conversion_p_value\x18\f \x01(\x01R\x10conversionPValue\"\xd1\x02\n" +
+	"\"GetSearchExperimentResultsResponse\x12@\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2 .listingssvc.v1.SearchExperimentR\n" +
+	"experiment\x12<\n" +
+	"\x04arms\x18\x02 \x03(\v2(.listingssvc.v1.SearchExperimentArmStatsR\x04arms\x127\n" +
+	"\tdata_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdataFrom\x123\n" +
+	"\adata_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06dataTo\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt*\x95\x01\n" +
 	"\fMetricPeriod\x12\x1d\n" +
 	"\x19METRIC_PERIOD_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14METRIC_PERIOD_HOURLY\x10\x01\x12\x17\n" +
@@ -3302,12 +6150,48 @@ const file_api_proto_listings_v1_analytics_proto_rawDesc = "" +
 	"\x1cCONVERSION_FUNNEL_STAGE_CART\x10\x02\x12$\n" +
 	" CONVERSION_FUNNEL_STAGE_CHECKOUT\x10\x03\x12#\n" +
 	"\x1fCONVERSION_FUNNEL_STAGE_PAYMENT\x10\x04\x12%\n" +
-	"!CONVERSION_FUNNEL_STAGE_COMPLETED\x10\x052\xb1\x03\n" +
+	"!CONVERSION_FUNNEL_STAGE_COMPLETED\x10\x05*\xc0\x01\n" +
+	"\x15SearchQueryReportType\x12(\n" +
+	"$SEARCH_QUERY_REPORT_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
+	"%SEARCH_QUERY_REPORT_TYPE_ZERO_RESULTS\x10\x01\x12(\n" +
+	"$SEARCH_QUERY_REPORT_TYPE_LOW_RESULTS\x10\x02\x12(\n" +
+	"$SEARCH_QUERY_REPORT_TYPE_TOP_QUERIES\x10\x03*\xa9\x01\n" +
+	"\x10ReportExportType\x12\"\n" +
+	"\x1eREPORT_EXPORT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19REPORT_EXPORT_TYPE_ORDERS\x10\x01\x12*\n" +
+	"&REPORT_EXPORT_TYPE_PRODUCT_PERFORMANCE\x10\x02\x12&\n" +
+	"\"REPORT_EXPORT_TYPE_STOCK_MOVEMENTS\x10\x03*x\n" +
+	"\x12ReportExportFormat\x12$\n" +
+	" REPORT_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18REPORT_EXPORT_FORMAT_CSV\x10\x01\x12\x1e\n" +
+	"\x1aREPORT_EXPORT_FORMAT_JSONL\x10\x02*\xe8\x01\n" +
+	"\x12ReportExportStatus\x12$\n" +
+	" REPORT_EXPORT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cREPORT_EXPORT_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fREPORT_EXPORT_STATUS_PROCESSING\x10\x02\x12\"\n" +
+	"\x1eREPORT_EXPORT_STATUS_COMPLETED\x10\x03\x12\x1f\n" +
+	"\x1bREPORT_EXPORT_STATUS_FAILED\x10\x04\x12 \n" +
+	"\x1cREPORT_EXPORT_STATUS_EXPIRED\x10\x05*\xb2\x01\n" +
+	"\x16SearchExperimentStatus\x12(\n" +
+	"$SEARCH_EXPERIMENT_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSEARCH_EXPERIMENT_STATUS_DRAFT\x10\x01\x12$\n" +
+	" SEARCH_EXPERIMENT_STATUS_RUNNING\x10\x02\x12$\n" +
+	" SEARCH_EXPERIMENT_STATUS_STOPPED\x10\x032\x92\f\n" +
 	"\x10AnalyticsService\x12e\n" +
 	"\x10GetOverviewStats\x12'.listingssvc.v1.GetOverviewStatsRequest\x1a(.listingssvc.v1.GetOverviewStatsResponse\x12b\n" +
 	"\x0fGetListingStats\x12&.listingssvc.v1.GetListingStatsRequest\x1a'.listingssvc.v1.GetListingStatsResponse\x12k\n" +
 	"\x12GetStorefrontStats\x12).listingssvc.v1.GetStorefrontStatsRequest\x1a*.listingssvc.v1.GetStorefrontStatsResponse\x12e\n" +
-	"\x10GetTrendingStats\x12'.listingssvc.v1.GetTrendingStatsRequest\x1a(.listingssvc.v1.GetTrendingStatsResponseBAZ?github.com/sveturs/listings/api/proto/listings/v1;listingssvcv1b\x06proto3"
+	"\x10GetTrendingStats\x12'.listingssvc.v1.GetTrendingStatsRequest\x1a(.listingssvc.v1.GetTrendingStatsResponse\x12V\n" +
+	"\vTrackEvents\x12\".listingssvc.v1.TrackEventsRequest\x1a#.listingssvc.v1.TrackEventsResponse\x12n\n" +
+	"\x13GetStorefrontFunnel\x12*.listingssvc.v1.GetStorefrontFunnelRequest\x1a+.listingssvc.v1.GetStorefrontFunnelResponse\x12b\n" +
+	"\x0fGetBuyerCohorts\x12&.listingssvc.v1.GetBuyerCohortsRequest\x1a'.listingssvc.v1.GetBuyerCohortsResponse\x12q\n" +
+	"\x14GetSearchQueryReport\x12+.listingssvc.v1.GetSearchQueryReportRequest\x1a,.listingssvc.v1.GetSearchQueryReportResponse\x12n\n" +
+	"\x13RequestReportExport\x12*.listingssvc.v1.RequestReportExportRequest\x1a+.listingssvc.v1.RequestReportExportResponse\x12b\n" +
+	"\x0fGetExportStatus\x12&.listingssvc.v1.GetExportStatusRequest\x1a'.listingssvc.v1.GetExportStatusResponse\x12q\n" +
+	"\x16CreateSearchExperiment\x12-.listingssvc.v1.CreateSearchExperimentRequest\x1a(.listingssvc.v1.SearchExperimentResponse\x12}\n" +
+	"\x1cUpdateSearchExperimentStatus\x123.listingssvc.v1.UpdateSearchExperimentStatusRequest\x1a(.listingssvc.v1.SearchExperimentResponse\x12t\n" +
+	"\x15ListSearchExperiments\x12,.listingssvc.v1.ListSearchExperimentsRequest\x1a-.listingssvc.v1.ListSearchExperimentsResponse\x12\x83\x01\n" +
+	"\x1aGetSearchExperimentResults\x121.listingssvc.v1.GetSearchExperimentResultsRequest\x1a2.listingssvc.v1.GetSearchExperimentResultsResponseBAZ?github.com/sveturs/listings/api/proto/listings/v1;listingssvcv1b\x06proto3"
 
 var (
 	file_api_proto_listings_v1_analytics_proto_rawDescOnce sync.Once
@@ -3321,101 +6205,213 @@ func file_api_proto_listings_v1_analytics_proto_rawDescGZIP() []byte {
 	return file_api_proto_listings_v1_analytics_proto_rawDescData
 }
 
-var file_api_proto_listings_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_proto_listings_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_api_proto_listings_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_proto_listings_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_api_proto_listings_v1_analytics_proto_goTypes = []any{
-	(MetricPeriod)(0),                  // 0: listingssvc.v1.MetricPeriod
-	(ConversionFunnelStage)(0),         // 1: listingssvc.v1.ConversionFunnelStage
-	(*GetOverviewStatsRequest)(nil),    // 2: listingssvc.v1.GetOverviewStatsRequest
-	(*GetOverviewStatsResponse)(nil),   // 3: listingssvc.v1.GetOverviewStatsResponse
-	(*GetListingStatsRequest)(nil),     // 4: listingssvc.v1.GetListingStatsRequest
-	(*GetListingStatsResponse)(nil),    // 5: listingssvc.v1.GetListingStatsResponse
-	(*ListingsStats)(nil),              // 6: listingssvc.v1.ListingsStats
-	(*RevenueStats)(nil),               // 7: listingssvc.v1.RevenueStats
-	(*UsersStats)(nil),                 // 8: listingssvc.v1.UsersStats
-	(*OrdersStats)(nil),                // 9: listingssvc.v1.OrdersStats
-	(*EngagementMetrics)(nil),          // 10: listingssvc.v1.EngagementMetrics
-	(*ConversionFunnel)(nil),           // 11: listingssvc.v1.ConversionFunnel
-	(*TimeSeriesPoint)(nil),            // 12: listingssvc.v1.TimeSeriesPoint
-	(*ListingTimeSeriesPoint)(nil),     // 13: listingssvc.v1.ListingTimeSeriesPoint
-	(*PerformanceComparison)(nil),      // 14: listingssvc.v1.PerformanceComparison
-	(*VariantStats)(nil),               // 15: listingssvc.v1.VariantStats
-	(*GeoStats)(nil),                   // 16: listingssvc.v1.GeoStats
-	(*MetricSnapshot)(nil),             // 17: listingssvc.v1.MetricSnapshot
-	(*GetStorefrontStatsRequest)(nil),  // 18: listingssvc.v1.GetStorefrontStatsRequest
-	(*TopListingInfo)(nil),             // 19: listingssvc.v1.TopListingInfo
-	(*GetStorefrontStatsResponse)(nil), // 20: listingssvc.v1.GetStorefrontStatsResponse
-	(*GetTrendingStatsRequest)(nil),    // 21: listingssvc.v1.GetTrendingStatsRequest
-	(*TrendingCategory)(nil),           // 22: listingssvc.v1.TrendingCategory
-	(*HotListing)(nil),                 // 23: listingssvc.v1.HotListing
-	(*PopularSearch)(nil),              // 24: listingssvc.v1.PopularSearch
-	(*GetTrendingStatsResponse)(nil),   // 25: listingssvc.v1.GetTrendingStatsResponse
-	nil,                                // 26: listingssvc.v1.ListingsStats.ListingsByCategoryEntry
-	nil,                                // 27: listingssvc.v1.RevenueStats.RevenueByPaymentMethodEntry
-	nil,                                // 28: listingssvc.v1.RevenueStats.RevenueByStorefrontEntry
-	nil,                                // 29: listingssvc.v1.OrdersStats.OrdersByStatusEntry
-	nil,                                // 30: listingssvc.v1.EngagementMetrics.TopSearchTermsEntry
-	(*timestamppb.Timestamp)(nil),      // 31: google.protobuf.Timestamp
+	(MetricPeriod)(0),                           // 0: listingssvc.v1.MetricPeriod
+	(ConversionFunnelStage)(0),                  // 1: listingssvc.v1.ConversionFunnelStage
+	(SearchQueryReportType)(0),                  // 2: listingssvc.v1.SearchQueryReportType
+	(ReportExportType)(0),                       // 3: listingssvc.v1.ReportExportType
+	(ReportExportFormat)(0),                     // 4: listingssvc.v1.ReportExportFormat
+	(ReportExportStatus)(0),                     // 5: listingssvc.v1.ReportExportStatus
+	(SearchExperimentStatus)(0),                 // 6: listingssvc.v1.SearchExperimentStatus
+	(*GetOverviewStatsRequest)(nil),             // 7: listingssvc.v1.GetOverviewStatsRequest
+	(*GetOverviewStatsResponse)(nil),            // 8: listingssvc.v1.GetOverviewStatsResponse
+	(*GetListingStatsRequest)(nil),              // 9: listingssvc.v1.GetListingStatsRequest
+	(*GetListingStatsResponse)(nil),             // 10: listingssvc.v1.GetListingStatsResponse
+	(*ListingsStats)(nil),                       // 11: listingssvc.v1.ListingsStats
+	(*RevenueStats)(nil),                        // 12: listingssvc.v1.RevenueStats
+	(*UsersStats)(nil),                          // 13: listingssvc.v1.UsersStats
+	(*OrdersStats)(nil),                         // 14: listingssvc.v1.OrdersStats
+	(*EngagementMetrics)(nil),                   // 15: listingssvc.v1.EngagementMetrics
+	(*ConversionFunnel)(nil),                    // 16: listingssvc.v1.ConversionFunnel
+	(*TimeSeriesPoint)(nil),                     // 17: listingssvc.v1.TimeSeriesPoint
+	(*ListingTimeSeriesPoint)(nil),              // 18: listingssvc.v1.ListingTimeSeriesPoint
+	(*PerformanceComparison)(nil),               // 19: listingssvc.v1.PerformanceComparison
+	(*VariantStats)(nil),                        // 20: listingssvc.v1.VariantStats
+	(*GeoStats)(nil),                            // 21: listingssvc.v1.GeoStats
+	(*MetricSnapshot)(nil),                      // 22: listingssvc.v1.MetricSnapshot
+	(*GetStorefrontStatsRequest)(nil),           // 23: listingssvc.v1.GetStorefrontStatsRequest
+	(*TopListingInfo)(nil),                      // 24: listingssvc.v1.TopListingInfo
+	(*GetStorefrontStatsResponse)(nil),          // 25: listingssvc.v1.GetStorefrontStatsResponse
+	(*GetTrendingStatsRequest)(nil),             // 26: listingssvc.v1.GetTrendingStatsRequest
+	(*TrendingCategory)(nil),                    // 27: listingssvc.v1.TrendingCategory
+	(*HotListing)(nil),                          // 28: listingssvc.v1.HotListing
+	(*PopularSearch)(nil),                       // 29: listingssvc.v1.PopularSearch
+	(*GetTrendingStatsResponse)(nil),            // 30: listingssvc.v1.GetTrendingStatsResponse
+	(*TrackedEvent)(nil),                        // 31: listingssvc.v1.TrackedEvent
+	(*TrackEventsRequest)(nil),                  // 32: listingssvc.v1.TrackEventsRequest
+	(*TrackEventsResponse)(nil),                 // 33: listingssvc.v1.TrackEventsResponse
+	(*GetStorefrontFunnelRequest)(nil),          // 34: listingssvc.v1.GetStorefrontFunnelRequest
+	(*FunnelStages)(nil),                        // 35: listingssvc.v1.FunnelStages
+	(*FunnelComparison)(nil),                    // 36: listingssvc.v1.FunnelComparison
+	(*GetStorefrontFunnelResponse)(nil),         // 37: listingssvc.v1.GetStorefrontFunnelResponse
+	(*GetBuyerCohortsRequest)(nil),              // 38: listingssvc.v1.GetBuyerCohortsRequest
+	(*BuyerCohort)(nil),                         // 39: listingssvc.v1.BuyerCohort
+	(*CohortSummary)(nil),                       // 40: listingssvc.v1.CohortSummary
+	(*GetBuyerCohortsResponse)(nil),             // 41: listingssvc.v1.GetBuyerCohortsResponse
+	(*GetSearchQueryReportRequest)(nil),         // 42: listingssvc.v1.GetSearchQueryReportRequest
+	(*SearchQueryStats)(nil),                    // 43: listingssvc.v1.SearchQueryStats
+	(*GetSearchQueryReportResponse)(nil),        // 44: listingssvc.v1.GetSearchQueryReportResponse
+	(*RequestReportExportRequest)(nil),          // 45: listingssvc.v1.RequestReportExportRequest
+	(*RequestReportExportResponse)(nil),         // 46: listingssvc.v1.RequestReportExportResponse
+	(*GetExportStatusRequest)(nil),              // 47: listingssvc.v1.GetExportStatusRequest
+	(*GetExportStatusResponse)(nil),             // 48: listingssvc.v1.GetExportStatusResponse
+	(*ReportExport)(nil),                        // 49: listingssvc.v1.ReportExport
+	(*SearchRanking)(nil),                       // 50: listingssvc.v1.SearchRanking
+	(*SearchExperimentVariant)(nil),             // 51: listingssvc.v1.SearchExperimentVariant
+	(*SearchExperiment)(nil),                    // 52: listingssvc.v1.SearchExperiment
+	(*CreateSearchExperimentRequest)(nil),       // 53: listingssvc.v1.CreateSearchExperimentRequest
+	(*UpdateSearchExperimentStatusRequest)(nil), // 54: listingssvc.v1.UpdateSearchExperimentStatusRequest
+	(*SearchExperimentResponse)(nil),            // 55: listingssvc.v1.SearchExperimentResponse
+	(*ListSearchExperimentsRequest)(nil),        // 56: listingssvc.v1.ListSearchExperimentsRequest
+	(*ListSearchExperimentsResponse)(nil),       // 57: listingssvc.v1.ListSearchExperimentsResponse
+	(*GetSearchExperimentResultsRequest)(nil),   // 58: listingssvc.v1.GetSearchExperimentResultsRequest
+	(*SearchExperimentArmStats)(nil),            // 59: listingssvc.v1.SearchExperimentArmStats
+	(*GetSearchExperimentResultsResponse)(nil),  // 60: listingssvc.v1.GetSearchExperimentResultsResponse
+	nil,                           // 61: listingssvc.v1.ListingsStats.ListingsByCategoryEntry
+	nil,                           // 62: listingssvc.v1.RevenueStats.RevenueByPaymentMethodEntry
+	nil,                           // 63: listingssvc.v1.RevenueStats.RevenueByStorefrontEntry
+	nil,                           // 64: listingssvc.v1.OrdersStats.OrdersByStatusEntry
+	nil,                           // 65: listingssvc.v1.EngagementMetrics.TopSearchTermsEntry
+	nil,                           // 66: listingssvc.v1.TrackedEvent.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 67: google.protobuf.Timestamp
 }
 var file_api_proto_listings_v1_analytics_proto_depIdxs = []int32{
-	31, // 0: listingssvc.v1.GetOverviewStatsRequest.date_from:type_name -> google.protobuf.Timestamp
-	31, // 1: listingssvc.v1.GetOverviewStatsRequest.date_to:type_name -> google.protobuf.Timestamp
-	31, // 2: listingssvc.v1.GetOverviewStatsRequest.compare_from:type_name -> google.protobuf.Timestamp
-	31, // 3: listingssvc.v1.GetOverviewStatsRequest.compare_to:type_name -> google.protobuf.Timestamp
-	0,  // 4: listingssvc.v1.GetOverviewStatsRequest.period:type_name -> listingssvc.v1.MetricPeriod
-	6,  // 5: listingssvc.v1.GetOverviewStatsResponse.listings:type_name -> listingssvc.v1.ListingsStats
-	7,  // 6: listingssvc.v1.GetOverviewStatsResponse.revenue:type_name -> listingssvc.v1.RevenueStats
-	8,  // 7: listingssvc.v1.GetOverviewStatsResponse.users:type_name -> listingssvc.v1.UsersStats
-	9,  // 8: listingssvc.v1.GetOverviewStatsResponse.orders:type_name -> listingssvc.v1.OrdersStats
-	10, // 9: listingssvc.v1.GetOverviewStatsResponse.engagement:type_name -> listingssvc.v1.EngagementMetrics
-	12, // 10: listingssvc.v1.GetOverviewStatsResponse.time_series:type_name -> listingssvc.v1.TimeSeriesPoint
-	14, // 11: listingssvc.v1.GetOverviewStatsResponse.comparison:type_name -> listingssvc.v1.PerformanceComparison
-	11, // 12: listingssvc.v1.GetOverviewStatsResponse.conversion_funnel:type_name -> listingssvc.v1.ConversionFunnel
-	31, // 13: listingssvc.v1.GetOverviewStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
-	31, // 14: listingssvc.v1.GetOverviewStatsResponse.data_from:type_name -> google.protobuf.Timestamp
-	31, // 15: listingssvc.v1.GetOverviewStatsResponse.data_to:type_name -> google.protobuf.Timestamp
-	31, // 16: listingssvc.v1.GetListingStatsRequest.date_from:type_name -> google.protobuf.Timestamp
-	31, // 17: listingssvc.v1.GetListingStatsRequest.date_to:type_name -> google.protobuf.Timestamp
-	31, // 18: listingssvc.v1.GetListingStatsRequest.compare_from:type_name -> google.protobuf.Timestamp
-	31, // 19: listingssvc.v1.GetListingStatsRequest.compare_to:type_name -> google.protobuf.Timestamp
-	0,  // 20: listingssvc.v1.GetListingStatsRequest.period:type_name -> listingssvc.v1.MetricPeriod
-	10, // 21: listingssvc.v1.GetListingStatsResponse.engagement:type_name -> listingssvc.v1.EngagementMetrics
-	15, // 22: listingssvc.v1.GetListingStatsResponse.variant_stats:type_name -> listingssvc.v1.VariantStats
-	16, // 23: listingssvc.v1.GetListingStatsResponse.geo_stats:type_name -> listingssvc.v1.GeoStats
-	13, // 24: listingssvc.v1.GetListingStatsResponse.time_series:type_name -> listingssvc.v1.ListingTimeSeriesPoint
-	14, // 25: listingssvc.v1.GetListingStatsResponse.comparison:type_name -> listingssvc.v1.PerformanceComparison
-	31, // 26: listingssvc.v1.GetListingStatsResponse.created_at:type_name -> google.protobuf.Timestamp
-	31, // 27: listingssvc.v1.GetListingStatsResponse.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 28: listingssvc.v1.GetListingStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
-	31, // 29: listingssvc.v1.GetListingStatsResponse.data_from:type_name -> google.protobuf.Timestamp
-	31, // 30: listingssvc.v1.GetListingStatsResponse.data_to:type_name -> google.protobuf.Timestamp
-	26, // 31: listingssvc.v1.ListingsStats.listings_by_category:type_name -> listingssvc.v1.ListingsStats.ListingsByCategoryEntry
-	27, // 32: listingssvc.v1.RevenueStats.revenue_by_payment_method:type_name -> listingssvc.v1.RevenueStats.RevenueByPaymentMethodEntry
-	28, // 33: listingssvc.v1.RevenueStats.revenue_by_storefront:type_name -> listingssvc.v1.RevenueStats.RevenueByStorefrontEntry
-	14, // 34: listingssvc.v1.RevenueStats.comparison:type_name -> listingssvc.v1.PerformanceComparison
-	29, // 35: listingssvc.v1.OrdersStats.orders_by_status:type_name -> listingssvc.v1.OrdersStats.OrdersByStatusEntry
-	30, // 36: listingssvc.v1.EngagementMetrics.top_search_terms:type_name -> listingssvc.v1.EngagementMetrics.TopSearchTermsEntry
-	31, // 37: listingssvc.v1.TimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
-	31, // 38: listingssvc.v1.ListingTimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
-	19, // 39: listingssvc.v1.GetStorefrontStatsResponse.top_listings:type_name -> listingssvc.v1.TopListingInfo
-	31, // 40: listingssvc.v1.GetStorefrontStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
-	22, // 41: listingssvc.v1.GetTrendingStatsResponse.trending_categories:type_name -> listingssvc.v1.TrendingCategory
-	23, // 42: listingssvc.v1.GetTrendingStatsResponse.hot_listings:type_name -> listingssvc.v1.HotListing
-	24, // 43: listingssvc.v1.GetTrendingStatsResponse.popular_searches:type_name -> listingssvc.v1.PopularSearch
-	31, // 44: listingssvc.v1.GetTrendingStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
-	2,  // 45: listingssvc.v1.AnalyticsService.GetOverviewStats:input_type -> listingssvc.v1.GetOverviewStatsRequest
-	4,  // 46: listingssvc.v1.AnalyticsService.GetListingStats:input_type -> listingssvc.v1.GetListingStatsRequest
-	18, // 47: listingssvc.v1.AnalyticsService.GetStorefrontStats:input_type -> listingssvc.v1.GetStorefrontStatsRequest
-	21, // 48: listingssvc.v1.AnalyticsService.GetTrendingStats:input_type -> listingssvc.v1.GetTrendingStatsRequest
-	3,  // 49: listingssvc.v1.AnalyticsService.GetOverviewStats:output_type -> listingssvc.v1.GetOverviewStatsResponse
-	5,  // 50: listingssvc.v1.AnalyticsService.GetListingStats:output_type -> listingssvc.v1.GetListingStatsResponse
-	20, // 51: listingssvc.v1.AnalyticsService.GetStorefrontStats:output_type -> listingssvc.v1.GetStorefrontStatsResponse
-	25, // 52: listingssvc.v1.AnalyticsService.GetTrendingStats:output_type -> listingssvc.v1.GetTrendingStatsResponse
-	49, // [49:53] is the sub-list for method output_type
-	45, // [45:49] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	67,  // 0: listingssvc.v1.GetOverviewStatsRequest.date_from:type_name -> google.protobuf.Timestamp
+	67,  // 1: listingssvc.v1.GetOverviewStatsRequest.date_to:type_name -> google.protobuf.Timestamp
+	67,  // 2: listingssvc.v1.GetOverviewStatsRequest.compare_from:type_name -> google.protobuf.Timestamp
+	67,  // 3: listingssvc.v1.GetOverviewStatsRequest.compare_to:type_name -> google.protobuf.Timestamp
+	0,   // 4: listingssvc.v1.GetOverviewStatsRequest.period:type_name -> listingssvc.v1.MetricPeriod
+	11,  // 5: listingssvc.v1.GetOverviewStatsResponse.listings:type_name -> listingssvc.v1.ListingsStats
+	12,  // 6: listingssvc.v1.GetOverviewStatsResponse.revenue:type_name -> listingssvc.v1.RevenueStats
+	13,  // 7: listingssvc.v1.GetOverviewStatsResponse.users:type_name -> listingssvc.v1.UsersStats
+	14,  // 8: listingssvc.v1.GetOverviewStatsResponse.orders:type_name -> listingssvc.v1.OrdersStats
+	15,  // 9: listingssvc.v1.GetOverviewStatsResponse.engagement:type_name -> listingssvc.v1.EngagementMetrics
+	17,  // 10: listingssvc.v1.GetOverviewStatsResponse.time_series:type_name -> listingssvc.v1.TimeSeriesPoint
+	19,  // 11: listingssvc.v1.GetOverviewStatsResponse.comparison:type_name -> listingssvc.v1.PerformanceComparison
+	16,  // 12: listingssvc.v1.GetOverviewStatsResponse.conversion_funnel:type_name -> listingssvc.v1.ConversionFunnel
+	67,  // 13: listingssvc.v1.GetOverviewStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	67,  // 14: listingssvc.v1.GetOverviewStatsResponse.data_from:type_name -> google.protobuf.Timestamp
+	67,  // 15: listingssvc.v1.GetOverviewStatsResponse.data_to:type_name -> google.protobuf.Timestamp
+	67,  // 16: listingssvc.v1.GetListingStatsRequest.date_from:type_name -> google.protobuf.Timestamp
+	67,  // 17: listingssvc.v1.GetListingStatsRequest.date_to:type_name -> google.protobuf.Timestamp
+	67,  // 18: listingssvc.v1.GetListingStatsRequest.compare_from:type_name -> google.protobuf.Timestamp
+	67,  // 19: listingssvc.v1.GetListingStatsRequest.compare_to:type_name -> google.protobuf.Timestamp
+	0,   // 20: listingssvc.v1.GetListingStatsRequest.period:type_name -> listingssvc.v1.MetricPeriod
+	15,  // 21: listingssvc.v1.GetListingStatsResponse.engagement:type_name -> listingssvc.v1.EngagementMetrics
+	20,  // 22: listingssvc.v1.GetListingStatsResponse.variant_stats:type_name -> listingssvc.v1.VariantStats
+	21,  // 23: listingssvc.v1.GetListingStatsResponse.geo_stats:type_name -> listingssvc.v1.GeoStats
+	18,  // 24: listingssvc.v1.GetListingStatsResponse.time_series:type_name -> listingssvc.v1.ListingTimeSeriesPoint
+	19,  // 25: listingssvc.v1.GetListingStatsResponse.comparison:type_name -> listingssvc.v1.PerformanceComparison
+	67,  // 26: listingssvc.v1.GetListingStatsResponse.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 27: listingssvc.v1.GetListingStatsResponse.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 28: listingssvc.v1.GetListingStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	67,  // 29: listingssvc.v1.GetListingStatsResponse.data_from:type_name -> google.protobuf.Timestamp
+	67,  // 30: listingssvc.v1.GetListingStatsResponse.data_to:type_name -> google.protobuf.Timestamp
+	61,  // 31: listingssvc.v1.ListingsStats.listings_by_category:type_name -> listingssvc.v1.ListingsStats.ListingsByCategoryEntry
+	62,  // 32: listingssvc.v1.RevenueStats.revenue_by_payment_method:type_name -> listingssvc.v1.RevenueStats.RevenueByPaymentMethodEntry
+	63,  // 33: listingssvc.v1.RevenueStats.revenue_by_storefront:type_name -> listingssvc.v1.RevenueStats.RevenueByStorefrontEntry
+	19,  // 34: listingssvc.v1.RevenueStats.comparison:type_name -> listingssvc.v1.PerformanceComparison
+	64,  // 35: listingssvc.v1.OrdersStats.orders_by_status:type_name -> listingssvc.v1.OrdersStats.OrdersByStatusEntry
+	65,  // 36: listingssvc.v1.EngagementMetrics.top_search_terms:type_name -> listingssvc.v1.EngagementMetrics.TopSearchTermsEntry
+	67,  // 37: listingssvc.v1.TimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 38: listingssvc.v1.ListingTimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
+	24,  // 39: listingssvc.v1.GetStorefrontStatsResponse.top_listings:type_name -> listingssvc.v1.TopListingInfo
+	67,  // 40: listingssvc.v1.GetStorefrontStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	27,  // 41: listingssvc.v1.GetTrendingStatsResponse.trending_categories:type_name -> listingssvc.v1.TrendingCategory
+	28,  // 42: listingssvc.v1.GetTrendingStatsResponse.hot_listings:type_name -> listingssvc.v1.HotListing
+	29,  // 43: listingssvc.v1.GetTrendingStatsResponse.popular_searches:type_name -> listingssvc.v1.PopularSearch
+	67,  // 44: listingssvc.v1.GetTrendingStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	66,  // 45: listingssvc.v1.TrackedEvent.metadata:type_name -> listingssvc.v1.TrackedEvent.MetadataEntry
+	67,  // 46: listingssvc.v1.TrackedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	31,  // 47: listingssvc.v1.TrackEventsRequest.events:type_name -> listingssvc.v1.TrackedEvent
+	67,  // 48: listingssvc.v1.GetStorefrontFunnelRequest.date_from:type_name -> google.protobuf.Timestamp
+	67,  // 49: listingssvc.v1.GetStorefrontFunnelRequest.date_to:type_name -> google.protobuf.Timestamp
+	67,  // 50: listingssvc.v1.FunnelStages.data_from:type_name -> google.protobuf.Timestamp
+	67,  // 51: listingssvc.v1.FunnelStages.data_to:type_name -> google.protobuf.Timestamp
+	35,  // 52: listingssvc.v1.GetStorefrontFunnelResponse.current:type_name -> listingssvc.v1.FunnelStages
+	35,  // 53: listingssvc.v1.GetStorefrontFunnelResponse.previous:type_name -> listingssvc.v1.FunnelStages
+	36,  // 54: listingssvc.v1.GetStorefrontFunnelResponse.comparison:type_name -> listingssvc.v1.FunnelComparison
+	67,  // 55: listingssvc.v1.GetStorefrontFunnelResponse.generated_at:type_name -> google.protobuf.Timestamp
+	67,  // 56: listingssvc.v1.GetBuyerCohortsRequest.date_to:type_name -> google.protobuf.Timestamp
+	67,  // 57: listingssvc.v1.BuyerCohort.cohort_week:type_name -> google.protobuf.Timestamp
+	39,  // 58: listingssvc.v1.GetBuyerCohortsResponse.cohorts:type_name -> listingssvc.v1.BuyerCohort
+	40,  // 59: listingssvc.v1.GetBuyerCohortsResponse.summary:type_name -> listingssvc.v1.CohortSummary
+	40,  // 60: listingssvc.v1.GetBuyerCohortsResponse.previous:type_name -> listingssvc.v1.CohortSummary
+	67,  // 61: listingssvc.v1.GetBuyerCohortsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	2,   // 62: listingssvc.v1.GetSearchQueryReportRequest.type:type_name -> listingssvc.v1.SearchQueryReportType
+	67,  // 63: listingssvc.v1.SearchQueryStats.last_searched:type_name -> google.protobuf.Timestamp
+	2,   // 64: listingssvc.v1.GetSearchQueryReportResponse.type:type_name -> listingssvc.v1.SearchQueryReportType
+	43,  // 65: listingssvc.v1.GetSearchQueryReportResponse.queries:type_name -> listingssvc.v1.SearchQueryStats
+	67,  // 66: listingssvc.v1.GetSearchQueryReportResponse.data_from:type_name -> google.protobuf.Timestamp
+	67,  // 67: listingssvc.v1.GetSearchQueryReportResponse.data_to:type_name -> google.protobuf.Timestamp
+	67,  // 68: listingssvc.v1.GetSearchQueryReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	3,   // 69: listingssvc.v1.RequestReportExportRequest.type:type_name -> listingssvc.v1.ReportExportType
+	4,   // 70: listingssvc.v1.RequestReportExportRequest.format:type_name -> listingssvc.v1.ReportExportFormat
+	67,  // 71: listingssvc.v1.RequestReportExportRequest.date_from:type_name -> google.protobuf.Timestamp
+	67,  // 72: listingssvc.v1.RequestReportExportRequest.date_to:type_name -> google.protobuf.Timestamp
+	49,  // 73: listingssvc.v1.RequestReportExportResponse.export:type_name -> listingssvc.v1.ReportExport
+	49,  // 74: listingssvc.v1.GetExportStatusResponse.export:type_name -> listingssvc.v1.ReportExport
+	67,  // 75: listingssvc.v1.GetExportStatusResponse.url_expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 76: listingssvc.v1.ReportExport.type:type_name -> listingssvc.v1.ReportExportType
+	4,   // 77: listingssvc.v1.ReportExport.format:type_name -> listingssvc.v1.ReportExportFormat
+	67,  // 78: listingssvc.v1.ReportExport.date_from:type_name -> google.protobuf.Timestamp
+	67,  // 79: listingssvc.v1.ReportExport.date_to:type_name -> google.protobuf.Timestamp
+	5,   // 80: listingssvc.v1.ReportExport.status:type_name -> listingssvc.v1.ReportExportStatus
+	67,  // 81: listingssvc.v1.ReportExport.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 82: listingssvc.v1.ReportExport.completed_at:type_name -> google.protobuf.Timestamp
+	67,  // 83: listingssvc.v1.ReportExport.expires_at:type_name -> google.protobuf.Timestamp
+	50,  // 84: listingssvc.v1.SearchExperimentVariant.ranking:type_name -> listingssvc.v1.SearchRanking
+	6,   // 85: listingssvc.v1.SearchExperiment.status:type_name -> listingssvc.v1.SearchExperimentStatus
+	51,  // 86: listingssvc.v1.SearchExperiment.variants:type_name -> listingssvc.v1.SearchExperimentVariant
+	67,  // 87: listingssvc.v1.SearchExperiment.started_at:type_name -> google.protobuf.Timestamp
+	67,  // 88: listingssvc.v1.SearchExperiment.stopped_at:type_name -> google.protobuf.Timestamp
+	67,  // 89: listingssvc.v1.SearchExperiment.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 90: listingssvc.v1.SearchExperiment.updated_at:type_name -> google.protobuf.Timestamp
+	51,  // 91: listingssvc.v1.CreateSearchExperimentRequest.variants:type_name -> listingssvc.v1.SearchExperimentVariant
+	6,   // 92: listingssvc.v1.UpdateSearchExperimentStatusRequest.status:type_name -> listingssvc.v1.SearchExperimentStatus
+	52,  // 93: listingssvc.v1.SearchExperimentResponse.experiment:type_name -> listingssvc.v1.SearchExperiment
+	6,   // 94: listingssvc.v1.ListSearchExperimentsRequest.status:type_name -> listingssvc.v1.SearchExperimentStatus
+	52,  // 95: listingssvc.v1.ListSearchExperimentsResponse.experiments:type_name -> listingssvc.v1.SearchExperiment
+	52,  // 96: listingssvc.v1.GetSearchExperimentResultsResponse.experiment:type_name -> listingssvc.v1.SearchExperiment
+	59,  // 97: listingssvc.v1.GetSearchExperimentResultsResponse.arms:type_name -> listingssvc.v1.SearchExperimentArmStats
+	67,  // 98: listingssvc.v1.GetSearchExperimentResultsResponse.data_from:type_name -> google.protobuf.Timestamp
+	67,  // 99: listingssvc.v1.GetSearchExperimentResultsResponse.data_to:type_name -> google.protobuf.Timestamp
+	67,  // 100: listingssvc.v1.GetSearchExperimentResultsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	7,   // 101: listingssvc.v1.AnalyticsService.GetOverviewStats:input_type -> listingssvc.v1.GetOverviewStatsRequest
+	9,   // 102: listingssvc.v1.AnalyticsService.GetListingStats:input_type -> listingssvc.v1.GetListingStatsRequest
+	23,  // 103: listingssvc.v1.AnalyticsService.GetStorefrontStats:input_type -> listingssvc.v1.GetStorefrontStatsRequest
+	26,  // 104: listingssvc.v1.AnalyticsService.GetTrendingStats:input_type -> listingssvc.v1.GetTrendingStatsRequest
+	32,  // 105: listingssvc.v1.AnalyticsService.TrackEvents:input_type -> listingssvc.v1.TrackEventsRequest
+	34,  // 106: listingssvc.v1.AnalyticsService.GetStorefrontFunnel:input_type -> listingssvc.v1.GetStorefrontFunnelRequest
+	38,  // 107: listingssvc.v1.AnalyticsService.GetBuyerCohorts:input_type -> listingssvc.v1.GetBuyerCohortsRequest
+	42,  // 108: listingssvc.v1.AnalyticsService.GetSearchQueryReport:input_type -> listingssvc.v1.GetSearchQueryReportRequest
+	45,  // 109: listingssvc.v1.AnalyticsService.RequestReportExport:input_type -> listingssvc.v1.RequestReportExportRequest
+	47,  // 110: listingssvc.v1.AnalyticsService.GetExportStatus:input_type -> listingssvc.v1.GetExportStatusRequest
+	53,  // 111: listingssvc.v1.AnalyticsService.CreateSearchExperiment:input_type -> listingssvc.v1.CreateSearchExperimentRequest
+	54,  // 112: listingssvc.v1.AnalyticsService.UpdateSearchExperimentStatus:input_type -> listingssvc.v1.UpdateSearchExperimentStatusRequest
+	56,  // 113: listingssvc.v1.AnalyticsService.ListSearchExperiments:input_type -> listingssvc.v1.ListSearchExperimentsRequest
+	58,  // 114: listingssvc.v1.AnalyticsService.GetSearchExperimentResults:input_type -> listingssvc.v1.GetSearchExperimentResultsRequest
+	8,   // 115: listingssvc.v1.AnalyticsService.GetOverviewStats:output_type -> listingssvc.v1.GetOverviewStatsResponse
+	10,  // 116: listingssvc.v1.AnalyticsService.GetListingStats:output_type -> listingssvc.v1.GetListingStatsResponse
+	25,  // 117: listingssvc.v1.AnalyticsService.GetStorefrontStats:output_type -> listingssvc.v1.GetStorefrontStatsResponse
+	30,  // 118: listingssvc.v1.AnalyticsService.GetTrendingStats:output_type -> listingssvc.v1.GetTrendingStatsResponse
+	33,  // 119: listingssvc.v1.AnalyticsService.TrackEvents:output_type -> listingssvc.v1.TrackEventsResponse
+	37,  // 120: listingssvc.v1.AnalyticsService.GetStorefrontFunnel:output_type -> listingssvc.v1.GetStorefrontFunnelResponse
+	41,  // 121: listingssvc.v1.AnalyticsService.GetBuyerCohorts:output_type -> listingssvc.v1.GetBuyerCohortsResponse
+	44,  // 122: listingssvc.v1.AnalyticsService.GetSearchQueryReport:output_type -> listingssvc.v1.GetSearchQueryReportResponse
+	46,  // 123: listingssvc.v1.AnalyticsService.RequestReportExport:output_type -> listingssvc.v1.RequestReportExportResponse
+	48,  // 124: listingssvc.v1.AnalyticsService.GetExportStatus:output_type -> listingssvc.v1.GetExportStatusResponse
+	55,  // 125: listingssvc.v1.AnalyticsService.CreateSearchExperiment:output_type -> listingssvc.v1.SearchExperimentResponse
+	55,  // 126: listingssvc.v1.AnalyticsService.UpdateSearchExperimentStatus:output_type -> listingssvc.v1.SearchExperimentResponse
+	57,  // 127: listingssvc.v1.AnalyticsService.ListSearchExperiments:output_type -> listingssvc.v1.ListSearchExperimentsResponse
+	60,  // 128: listingssvc.v1.AnalyticsService.GetSearchExperimentResults:output_type -> listingssvc.v1.GetSearchExperimentResultsResponse
+	115, // [115:129] is the sub-list for method output_type
+	101, // [101:115] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_listings_v1_analytics_proto_init() }
@@ -3434,13 +6430,26 @@ func file_api_proto_listings_v1_analytics_proto_init() {
 	file_api_proto_listings_v1_analytics_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_proto_listings_v1_analytics_proto_msgTypes[15].OneofWrappers = []any{}
 	file_api_proto_listings_v1_analytics_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[31].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[38].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[41].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[44].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[45].OneofWrappers = []any{}
+	file_api_proto_listings_v1_analytics_proto_msgTypes[49].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_listings_v1_analytics_proto_rawDesc), len(file_api_proto_listings_v1_analytics_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   29,
+			NumEnums:      7,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalyticsService_GetOverviewStats_FullMethodName   = "/listingssvc.v1.AnalyticsService/GetOverviewStats"
-	AnalyticsService_GetListingStats_FullMethodName    = "/listingssvc.v1.AnalyticsService/GetListingStats"
-	AnalyticsService_GetStorefrontStats_FullMethodName = "/listingssvc.v1.AnalyticsService/GetStorefrontStats"
-	AnalyticsService_GetTrendingStats_FullMethodName   = "/listingssvc.v1.AnalyticsService/GetTrendingStats"
+	AnalyticsService_GetOverviewStats_FullMethodName             = "/listingssvc.v1.AnalyticsService/GetOverviewStats"
+	AnalyticsService_GetListingStats_FullMethodName              = "/listingssvc.v1.AnalyticsService/GetListingStats"
+	AnalyticsService_GetStorefrontStats_FullMethodName           = "/listingssvc.v1.AnalyticsService/GetStorefrontStats"
+	AnalyticsService_GetTrendingStats_FullMethodName             = "/listingssvc.v1.AnalyticsService/GetTrendingStats"
+	AnalyticsService_TrackEvents_FullMethodName                  = "/listingssvc.v1.AnalyticsService/TrackEvents"
+	AnalyticsService_GetStorefrontFunnel_FullMethodName          = "/listingssvc.v1.AnalyticsService/GetStorefrontFunnel"
+	AnalyticsService_GetBuyerCohorts_FullMethodName              = "/listingssvc.v1.AnalyticsService/GetBuyerCohorts"
+	AnalyticsService_GetSearchQueryReport_FullMethodName         = "/listingssvc.v1.AnalyticsService/GetSearchQueryReport"
+	AnalyticsService_RequestReportExport_FullMethodName          = "/listingssvc.v1.AnalyticsService/RequestReportExport"
+	AnalyticsService_GetExportStatus_FullMethodName              = "/listingssvc.v1.AnalyticsService/GetExportStatus"
+	AnalyticsService_CreateSearchExperiment_FullMethodName       = "/listingssvc.v1.AnalyticsService/CreateSearchExperiment"
+	AnalyticsService_UpdateSearchExperimentStatus_FullMethodName = "/listingssvc.v1.AnalyticsService/UpdateSearchExperimentStatus"
+	AnalyticsService_ListSearchExperiments_FullMethodName        = "/listingssvc.v1.AnalyticsService/ListSearchExperiments"
+	AnalyticsService_GetSearchExperimentResults_FullMethodName   = "/listingssvc.v1.AnalyticsService/GetSearchExperimentResults"
 )
 
 // AnalyticsServiceClient is the client API for AnalyticsService service.
@@ -51,6 +61,48 @@ type AnalyticsServiceClient interface {
 	// Authorization: Admin only
 	// Cache: 1 hour (trending data doesn't need real-time)
 	GetTrendingStats(ctx context.Context, in *GetTrendingStatsRequest, opts ...grpc.CallOption) (*GetTrendingStatsResponse, error)
+	// TrackEvents records a batch of client-side events (impressions, views, inquiries, searches)
+	// Favorites and orders are recorded by the server and cannot be reported by clients
+	// Authorization: Public (anonymous events require session_id)
+	// Events are buffered and written asynchronously; under load some may be dropped
+	TrackEvents(ctx context.Context, in *TrackEventsRequest, opts ...grpc.CallOption) (*TrackEventsResponse, error)
+	// GetStorefrontFunnel retrieves the purchase funnel of a storefront or one of its products
+	// Stages: impression → view → favorite/add to cart → order → delivered (distinct visitors)
+	// Authorization: Admin, storefront owner or staff with analytics.read
+	// Cache: 15 minutes
+	GetStorefrontFunnel(ctx context.Context, in *GetStorefrontFunnelRequest, opts ...grpc.CallOption) (*GetStorefrontFunnelResponse, error)
+	// GetBuyerCohorts retrieves weekly repeat-buyer cohorts of a storefront
+	// Buyers are grouped by the week of their first order; guest orders are not included
+	// Authorization: Admin, storefront owner or staff with analytics.read
+	// Cache: 15 minutes
+	GetBuyerCohorts(ctx context.Context, in *GetBuyerCohortsRequest, opts ...grpc.CallOption) (*GetBuyerCohortsResponse, error)
+	// GetSearchQueryReport lists zero-result, low-result or top search queries with CTR
+	// Helps merchandisers find queries that need synonyms or better categorization
+	// Authorization: Admin only
+	// Cache: 15 minutes
+	GetSearchQueryReport(ctx context.Context, in *GetSearchQueryReportRequest, opts ...grpc.CallOption) (*GetSearchQueryReportResponse, error)
+	// RequestReportExport queues an export of orders, product performance or stock movements
+	// The file is rendered in the background; poll GetExportStatus for the download URL
+	// Authorization: Admin, storefront owner or staff with orders.read (orders) or
+	// analytics.read (other reports); platform-wide exports are admin only
+	RequestReportExport(ctx context.Context, in *RequestReportExportRequest, opts ...grpc.CallOption) (*RequestReportExportResponse, error)
+	// GetExportStatus returns the status of an export and, once completed, a download URL
+	// Authorization: The requester or admin
+	GetExportStatus(ctx context.Context, in *GetExportStatusRequest, opts ...grpc.CallOption) (*GetExportStatusResponse, error)
+	// CreateSearchExperiment defines a search ranking A/B experiment in draft status
+	// Variants are immutable; define a new experiment to try other ranking parameters
+	// Authorization: Admin only
+	CreateSearchExperiment(ctx context.Context, in *CreateSearchExperimentRequest, opts ...grpc.CallOption) (*SearchExperimentResponse, error)
+	// UpdateSearchExperimentStatus starts (draft -> running) or stops an experiment
+	// Authorization: Admin only
+	UpdateSearchExperimentStatus(ctx context.Context, in *UpdateSearchExperimentStatusRequest, opts ...grpc.CallOption) (*SearchExperimentResponse, error)
+	// ListSearchExperiments lists experiments, oldest started first
+	// Authorization: Admin only
+	ListSearchExperiments(ctx context.Context, in *ListSearchExperimentsRequest, opts ...grpc.CallOption) (*ListSearchExperimentsResponse, error)
+	// GetSearchExperimentResults compares CTR and conversion between the arms of an experiment
+	// Authorization: Admin only
+	// Cache: 5 minutes
+	GetSearchExperimentResults(ctx context.Context, in *GetSearchExperimentResultsRequest, opts ...grpc.CallOption) (*GetSearchExperimentResultsResponse, error)
 }
 
 type analyticsServiceClient struct {
@@ -101,6 +153,106 @@ func (c *analyticsServiceClient) GetTrendingStats(ctx context.Context, in *GetTr
 	return out, nil
 }
 
+func (c *analyticsServiceClient) TrackEvents(ctx context.Context, in *TrackEventsRequest, opts ...grpc.CallOption) (*TrackEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackEventsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_TrackEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetStorefrontFunnel(ctx context.Context, in *GetStorefrontFunnelRequest, opts ...grpc.CallOption) (*GetStorefrontFunnelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorefrontFunnelResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetStorefrontFunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetBuyerCohorts(ctx context.Context, in *GetBuyerCohortsRequest, opts ...grpc.CallOption) (*GetBuyerCohortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBuyerCohortsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetBuyerCohorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetSearchQueryReport(ctx context.Context, in *GetSearchQueryReportRequest, opts ...grpc.CallOption) (*GetSearchQueryReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchQueryReportResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetSearchQueryReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) RequestReportExport(ctx context.Context, in *RequestReportExportRequest, opts ...grpc.CallOption) (*RequestReportExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestReportExportResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_RequestReportExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetExportStatus(ctx context.Context, in *GetExportStatusRequest, opts ...grpc.CallOption) (*GetExportStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportStatusResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetExportStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateSearchExperiment(ctx context.Context, in *CreateSearchExperimentRequest, opts ...grpc.CallOption) (*SearchExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchExperimentResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateSearchExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateSearchExperimentStatus(ctx context.Context, in *UpdateSearchExperimentStatusRequest, opts ...grpc.CallOption) (*SearchExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchExperimentResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateSearchExperimentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListSearchExperiments(ctx context.Context, in *ListSearchExperimentsRequest, opts ...grpc.CallOption) (*ListSearchExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchExperimentsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListSearchExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetSearchExperimentResults(ctx context.Context, in *GetSearchExperimentResultsRequest, opts ...grpc.CallOption) (*GetSearchExperimentResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchExperimentResultsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetSearchExperimentResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServiceServer is the server API for AnalyticsService service.
 // All implementations must embed UnimplementedAnalyticsServiceServer
 // for forward compatibility.
@@ -127,6 +279,48 @@ type AnalyticsServiceServer interface {
 	// Authorization: Admin only
 	// Cache: 1 hour (trending data doesn't need real-time)
 	GetTrendingStats(context.Context, *GetTrendingStatsRequest) (*GetTrendingStatsResponse, error)
+	// TrackEvents records a batch of client-side events (impressions, views, inquiries, searches)
+	// Favorites and orders are recorded by the server and cannot be reported by clients
+	// Authorization: Public (anonymous events require session_id)
+	// Events are buffered and written asynchronously; under load some may be dropped
+	TrackEvents(context.Context, *TrackEventsRequest) (*TrackEventsResponse, error)
+	// GetStorefrontFunnel retrieves the purchase funnel of a storefront or one of its products
+	// Stages: impression → view → favorite/add to cart → order → delivered (distinct visitors)
+	// Authorization: Admin, storefront owner or staff with analytics.read
+	// Cache: 15 minutes
+	GetStorefrontFunnel(context.Context, *GetStorefrontFunnelRequest) (*GetStorefrontFunnelResponse, error)
+	// GetBuyerCohorts retrieves weekly repeat-buyer cohorts of a storefront
+	// Buyers are grouped by the week of their first order; guest orders are not included
+	// Authorization: Admin, storefront owner or staff with analytics.read
+	// Cache: 15 minutes
+	GetBuyerCohorts(context.Context, *GetBuyerCohortsRequest) (*GetBuyerCohortsResponse, error)
+	// GetSearchQueryReport lists zero-result, low-result or top search queries with CTR
+	// Helps merchandisers find queries that need synonyms or better categorization
+	// Authorization: Admin only
+	// Cache: 15 minutes
+	GetSearchQueryReport(context.Context, *GetSearchQueryReportRequest) (*GetSearchQueryReportResponse, error)
+	// RequestReportExport queues an export of orders, product performance or stock movements
+	// The file is rendered in the background; poll GetExportStatus for the download URL
+	// Authorization: Admin, storefront owner or staff with orders.read (orders) or
+	// analytics.read (other reports); platform-wide exports are admin only
+	RequestReportExport(context.Context, *RequestReportExportRequest) (*RequestReportExportResponse, error)
+	// GetExportStatus returns the status of an export and, once completed, a download URL
+	// Authorization: The requester or admin
+	GetExportStatus(context.Context, *GetExportStatusRequest) (*GetExportStatusResponse, error)
+	// CreateSearchExperiment defines a search ranking A/B experiment in draft status
+	// Variants are immutable; define a new experiment to try other ranking parameters
+	// Authorization: Admin only
+	CreateSearchExperiment(context.Context, *CreateSearchExperimentRequest) (*SearchExperimentResponse, error)
+	// UpdateSearchExperimentStatus starts (draft -> running) or stops an experiment
+	// Authorization: Admin only
+	UpdateSearchExperimentStatus(context.Context, *UpdateSearchExperimentStatusRequest) (*SearchExperimentResponse, error)
+	// ListSearchExperiments lists experiments, oldest started first
+	// Authorization: Admin only
+	ListSearchExperiments(context.Context, *ListSearchExperimentsRequest) (*ListSearchExperimentsResponse, error)
+	// GetSearchExperimentResults compares CTR and conversion between the arms of an experiment
+	// Authorization: Admin only
+	// Cache: 5 minutes
+	GetSearchExperimentResults(context.Context, *GetSearchExperimentResultsRequest) (*GetSearchExperimentResultsResponse, error)
 	mustEmbedUnimplementedAnalyticsServiceServer()
 }
 
@@ -149,6 +343,36 @@ func (UnimplementedAnalyticsServiceServer) GetStorefrontStats(context.Context, *
 func (UnimplementedAnalyticsServiceServer) GetTrendingStats(context.Context, *GetTrendingStatsRequest) (*GetTrendingStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingStats not implemented")
 }
+func (UnimplementedAnalyticsServiceServer) TrackEvents(context.Context, *TrackEventsRequest) (*TrackEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrackEvents not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetStorefrontFunnel(context.Context, *GetStorefrontFunnelRequest) (*GetStorefrontFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefrontFunnel not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetBuyerCohorts(context.Context, *GetBuyerCohortsRequest) (*GetBuyerCohortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuyerCohorts not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetSearchQueryReport(context.Context, *GetSearchQueryReportRequest) (*GetSearchQueryReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchQueryReport not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) RequestReportExport(context.Context, *RequestReportExportRequest) (*RequestReportExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReportExport not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetExportStatus(context.Context, *GetExportStatusRequest) (*GetExportStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportStatus not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateSearchExperiment(context.Context, *CreateSearchExperimentRequest) (*SearchExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSearchExperiment not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateSearchExperimentStatus(context.Context, *UpdateSearchExperimentStatusRequest) (*SearchExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSearchExperimentStatus not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListSearchExperiments(context.Context, *ListSearchExperimentsRequest) (*ListSearchExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSearchExperiments not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetSearchExperimentResults(context.Context, *GetSearchExperimentResultsRequest) (*GetSearchExperimentResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchExperimentResults not implemented")
+}
 func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
 func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
 
@@ -242,6 +466,186 @@ func _AnalyticsService_GetTrendingStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyticsService_TrackEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).TrackEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_TrackEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).TrackEvents(ctx, req.(*TrackEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetStorefrontFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontFunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetStorefrontFunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetStorefrontFunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetStorefrontFunnel(ctx, req.(*GetStorefrontFunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetBuyerCohorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuyerCohortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetBuyerCohorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetBuyerCohorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetBuyerCohorts(ctx, req.(*GetBuyerCohortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetSearchQueryReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchQueryReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetSearchQueryReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetSearchQueryReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetSearchQueryReport(ctx, req.(*GetSearchQueryReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_RequestReportExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReportExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).RequestReportExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_RequestReportExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).RequestReportExport(ctx, req.(*RequestReportExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetExportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetExportStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetExportStatus(ctx, req.(*GetExportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateSearchExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSearchExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateSearchExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateSearchExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateSearchExperiment(ctx, req.(*CreateSearchExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateSearchExperimentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSearchExperimentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateSearchExperimentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateSearchExperimentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateSearchExperimentStatus(ctx, req.(*UpdateSearchExperimentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListSearchExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSearchExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListSearchExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListSearchExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListSearchExperiments(ctx, req.(*ListSearchExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetSearchExperimentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchExperimentResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetSearchExperimentResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetSearchExperimentResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetSearchExperimentResults(ctx, req.(*GetSearchExperimentResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +669,46 @@ var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrendingStats",
 			Handler:    _AnalyticsService_GetTrendingStats_Handler,
 		},
+		{
+			MethodName: "TrackEvents",
+			Handler:    _AnalyticsService_TrackEvents_Handler,
+		},
+		{
+			MethodName: "GetStorefrontFunnel",
+			Handler:    _AnalyticsService_GetStorefrontFunnel_Handler,
+		},
+		{
+			MethodName: "GetBuyerCohorts",
+			Handler:    _AnalyticsService_GetBuyerCohorts_Handler,
+		},
+		{
+			MethodName: "GetSearchQueryReport",
+			Handler:    _AnalyticsService_GetSearchQueryReport_Handler,
+		},
+		{
+			MethodName: "RequestReportExport",
+			Handler:    _AnalyticsService_RequestReportExport_Handler,
+		},
+		{
+			MethodName: "GetExportStatus",
+			Handler:    _AnalyticsService_GetExportStatus_Handler,
+		},
+		{
+			MethodName: "CreateSearchExperiment",
+			Handler:    _AnalyticsService_CreateSearchExperiment_Handler,
+		},
+		{
+			MethodName: "UpdateSearchExperimentStatus",
+			Handler:    _AnalyticsService_UpdateSearchExperimentStatus_Handler,
+		},
+		{
+			MethodName: "ListSearchExperiments",
+			Handler:    _AnalyticsService_ListSearchExperiments_Handler,
+		},
+		{
+			MethodName: "GetSearchExperimentResults",
+			Handler:    _AnalyticsService_GetSearchExperimentResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/listings/v1/analytics.proto",
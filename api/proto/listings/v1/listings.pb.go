@@ -246,6 +246,115 @@ func (StaffRole) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{3}
 }
 
+// StaffInvitationStatus staff invitation lifecycle
+type StaffInvitationStatus int32
+
+const (
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_UNSPECIFIED StaffInvitationStatus = 0
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_PENDING     StaffInvitationStatus = 1
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_ACCEPTED    StaffInvitationStatus = 2
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_DECLINED    StaffInvitationStatus = 3
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_REVOKED     StaffInvitationStatus = 4
+	StaffInvitationStatus_STAFF_INVITATION_STATUS_EXPIRED     StaffInvitationStatus = 5
+)
+
+// Enum value maps for StaffInvitationStatus.
+var (
+	StaffInvitationStatus_name = map[int32]string{
+		0: "STAFF_INVITATION_STATUS_UNSPECIFIED",
+		1: "STAFF_INVITATION_STATUS_PENDING",
+		2: "STAFF_INVITATION_STATUS_ACCEPTED",
+		3: "STAFF_INVITATION_STATUS_DECLINED",
+		4: "STAFF_INVITATION_STATUS_REVOKED",
+		5: "STAFF_INVITATION_STATUS_EXPIRED",
+	}
+	StaffInvitationStatus_value = map[string]int32{
+		"STAFF_INVITATION_STATUS_UNSPECIFIED": 0,
+		"STAFF_INVITATION_STATUS_PENDING":     1,
+		"STAFF_INVITATION_STATUS_ACCEPTED":    2,
+		"STAFF_INVITATION_STATUS_DECLINED":    3,
+		"STAFF_INVITATION_STATUS_REVOKED":     4,
+		"STAFF_INVITATION_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x StaffInvitationStatus) Enum() *StaffInvitationStatus {
+	p := new(StaffInvitationStatus)
+	*p = x
+	return p
+}
+
+func (x StaffInvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffInvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_listings_proto_enumTypes[4].Descriptor()
+}
+
+func (StaffInvitationStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_listings_proto_enumTypes[4]
+}
+
+func (x StaffInvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffInvitationStatus.Descriptor instead.
+func (StaffInvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{4}
+}
+
+// ReviewSubjectType what a review rates
+type ReviewSubjectType int32
+
+const (
+	ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED ReviewSubjectType = 0
+	ReviewSubjectType_REVIEW_SUBJECT_TYPE_PRODUCT     ReviewSubjectType = 1 // Storefront product
+	ReviewSubjectType_REVIEW_SUBJECT_TYPE_STOREFRONT  ReviewSubjectType = 2 // Storefront
+)
+
+// Enum value maps for ReviewSubjectType.
+var (
+	ReviewSubjectType_name = map[int32]string{
+		0: "REVIEW_SUBJECT_TYPE_UNSPECIFIED",
+		1: "REVIEW_SUBJECT_TYPE_PRODUCT",
+		2: "REVIEW_SUBJECT_TYPE_STOREFRONT",
+	}
+	ReviewSubjectType_value = map[string]int32{
+		"REVIEW_SUBJECT_TYPE_UNSPECIFIED": 0,
+		"REVIEW_SUBJECT_TYPE_PRODUCT":     1,
+		"REVIEW_SUBJECT_TYPE_STOREFRONT":  2,
+	}
+)
+
+func (x ReviewSubjectType) Enum() *ReviewSubjectType {
+	p := new(ReviewSubjectType)
+	*p = x
+	return p
+}
+
+func (x ReviewSubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewSubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_listings_proto_enumTypes[5].Descriptor()
+}
+
+func (ReviewSubjectType) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_listings_proto_enumTypes[5]
+}
+
+func (x ReviewSubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewSubjectType.Descriptor instead.
+func (ReviewSubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{5}
+}
+
 // PaymentMethodType payment method types
 type PaymentMethodType int32
 
@@ -301,11 +410,11 @@ func (x PaymentMethodType) String() string {
 }
 
 func (PaymentMethodType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_listings_v1_listings_proto_enumTypes[4].Descriptor()
+	return file_api_proto_listings_v1_listings_proto_enumTypes[6].Descriptor()
 }
 
 func (PaymentMethodType) Type() protoreflect.EnumType {
-	return &file_api_proto_listings_v1_listings_proto_enumTypes[4]
+	return &file_api_proto_listings_v1_listings_proto_enumTypes[6]
 }
 
 func (x PaymentMethodType) Number() protoreflect.EnumNumber {
@@ -314,7 +423,7 @@ func (x PaymentMethodType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentMethodType.Descriptor instead.
 func (PaymentMethodType) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{6}
 }
 
 // DeliveryProvider delivery provider types
@@ -366,11 +475,11 @@ func (x DeliveryProvider) String() string {
 }
 
 func (DeliveryProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_listings_v1_listings_proto_enumTypes[5].Descriptor()
+	return file_api_proto_listings_v1_listings_proto_enumTypes[7].Descriptor()
 }
 
 func (DeliveryProvider) Type() protoreflect.EnumType {
-	return &file_api_proto_listings_v1_listings_proto_enumTypes[5]
+	return &file_api_proto_listings_v1_listings_proto_enumTypes[7]
 }
 
 func (x DeliveryProvider) Number() protoreflect.EnumNumber {
@@ -379,7 +488,63 @@ func (x DeliveryProvider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeliveryProvider.Descriptor instead.
 func (DeliveryProvider) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{7}
+}
+
+// RecommendationSource explains why a listing is recommended
+type RecommendationSource int32
+
+const (
+	RecommendationSource_RECOMMENDATION_SOURCE_UNSPECIFIED     RecommendationSource = 0
+	RecommendationSource_RECOMMENDATION_SOURCE_PERSONALIZED    RecommendationSource = 1 // Related to the visitor's own interactions
+	RecommendationSource_RECOMMENDATION_SOURCE_BOUGHT_TOGETHER RecommendationSource = 2 // Bought in the same orders as the listing
+	RecommendationSource_RECOMMENDATION_SOURCE_ALSO_ENGAGED    RecommendationSource = 3 // Engaged with by visitors of the listing
+	RecommendationSource_RECOMMENDATION_SOURCE_TRENDING        RecommendationSource = 4 // Fallback when there is not enough history
+)
+
+// Enum value maps for RecommendationSource.
+var (
+	RecommendationSource_name = map[int32]string{
+		0: "RECOMMENDATION_SOURCE_UNSPECIFIED",
+		1: "RECOMMENDATION_SOURCE_PERSONALIZED",
+		2: "RECOMMENDATION_SOURCE_BOUGHT_TOGETHER",
+		3: "RECOMMENDATION_SOURCE_ALSO_ENGAGED",
+		4: "RECOMMENDATION_SOURCE_TRENDING",
+	}
+	RecommendationSource_value = map[string]int32{
+		"RECOMMENDATION_SOURCE_UNSPECIFIED":     0,
+		"RECOMMENDATION_SOURCE_PERSONALIZED":    1,
+		"RECOMMENDATION_SOURCE_BOUGHT_TOGETHER": 2,
+		"RECOMMENDATION_SOURCE_ALSO_ENGAGED":    3,
+		"RECOMMENDATION_SOURCE_TRENDING":        4,
+	}
+)
+
+func (x RecommendationSource) Enum() *RecommendationSource {
+	p := new(RecommendationSource)
+	*p = x
+	return p
+}
+
+func (x RecommendationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_listings_v1_listings_proto_enumTypes[8].Descriptor()
+}
+
+func (RecommendationSource) Type() protoreflect.EnumType {
+	return &file_api_proto_listings_v1_listings_proto_enumTypes[8]
+}
+
+func (x RecommendationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationSource.Descriptor instead.
+func (RecommendationSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{8}
 }
 
 // ListingFieldTranslations represents translations for individual fields of a listing
@@ -491,8 +656,17 @@ type Listing struct {
 	// Translations
 	Translations     map[string]*ListingFieldTranslations `protobuf:"bytes,30,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // {"en": {...}, "ru": {...}, "sr": {...}}
 	OriginalLanguage string                               `protobuf:"bytes,31,opt,name=original_language,json=originalLanguage,proto3" json:"original_language,omitempty"`                                           // "sr", "en", "ru"
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Lifecycle
+	ExpiresAt          *string `protobuf:"bytes,32,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`                              // C2C listings only (RFC3339)
+	ScheduledPublishAt *string `protobuf:"bytes,33,opt,name=scheduled_publish_at,json=scheduledPublishAt,proto3,oneof" json:"scheduled_publish_at,omitempty"` // Requested publish time for listings in review (RFC3339)
+	// Reviews (storefront products)
+	Rating       float64 `protobuf:"fixed64,34,opt,name=rating,proto3" json:"rating,omitempty"` // Average rating of published reviews (0 without reviews)
+	ReviewsCount int32   `protobuf:"varint,35,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`
+	// Availability (storefront products)
+	Unavailable        bool    `protobuf:"varint,36,opt,name=unavailable,proto3" json:"unavailable,omitempty"`                                              // Storefront on vacation and not taking orders
+	AvailabilityNotice *string `protobuf:"bytes,37,opt,name=availability_notice,json=availabilityNotice,proto3,oneof" json:"availability_notice,omitempty"` // Vacation notice, also set when orders ship delayed
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Listing) Reset() {
@@ -714,24 +888,71 @@ func (x *Listing) GetOriginalLanguage() string {
 	return ""
 }
 
+func (x *Listing) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Listing) GetScheduledPublishAt() string {
+	if x != nil && x.ScheduledPublishAt != nil {
+		return *x.ScheduledPublishAt
+	}
+	return ""
+}
+
+func (x *Listing) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Listing) GetReviewsCount() int32 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *Listing) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *Listing) GetAvailabilityNotice() string {
+	if x != nil && x.AvailabilityNotice != nil {
+		return *x.AvailabilityNotice
+	}
+	return ""
+}
+
 // ListingImage represents an image associated with a listing
 type ListingImage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	StoragePath   *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
-	DisplayOrder  int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	FileSize      *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
-	MimeType      *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListingId        int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Url              string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"` // Empty until the image is processed (ready)
+	StoragePath      *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
+	ThumbnailUrl     *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	DisplayOrder     int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsPrimary        bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Width            *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height           *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	FileSize         *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
+	MimeType         *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Blurhash         *string                `protobuf:"bytes,14,opt,name=blurhash,proto3,oneof" json:"blurhash,omitempty"`                                      // Placeholder shown while the image loads
+	Renditions       []*ImageRendition      `protobuf:"bytes,15,rep,name=renditions,proto3" json:"renditions,omitempty"`                                        // Resized JPEG copies, smallest first
+	ProcessingStatus string                 `protobuf:"bytes,16,opt,name=processing_status,json=processingStatus,proto3" json:"processing_status,omitempty"`    // pending, processing, ready, failed
+	ProcessingError  *string                `protobuf:"bytes,17,opt,name=processing_error,json=processingError,proto3,oneof" json:"processing_error,omitempty"` // Why processing failed (invalid or duplicate image)
+	VariantId        *int64                 `protobuf:"varint,18,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"`                  // Set for product variant images
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListingImage) Reset() {
@@ -855,6 +1076,118 @@ func (x *ListingImage) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *ListingImage) GetBlurhash() string {
+	if x != nil && x.Blurhash != nil {
+		return *x.Blurhash
+	}
+	return ""
+}
+
+func (x *ListingImage) GetRenditions() []*ImageRendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *ListingImage) GetProcessingStatus() string {
+	if x != nil {
+		return x.ProcessingStatus
+	}
+	return ""
+}
+
+func (x *ListingImage) GetProcessingError() string {
+	if x != nil && x.ProcessingError != nil {
+		return *x.ProcessingError
+	}
+	return ""
+}
+
+func (x *ListingImage) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return 0
+}
+
+// ImageRendition is a resized copy of a listing image (200/400/800/1600 wide, never upscaled)
+type ImageRendition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	StoragePath   string                 `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	FileSize      int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageRendition) Reset() {
+	*x = ImageRendition{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageRendition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageRendition) ProtoMessage() {}
+
+func (x *ImageRendition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageRendition.ProtoReflect.Descriptor instead.
+func (*ImageRendition) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImageRendition) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageRendition) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageRendition) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageRendition) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
+}
+
+func (x *ImageRendition) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
 // ListingAttribute represents flexible key-value attributes
 type ListingAttribute struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -869,7 +1202,7 @@ type ListingAttribute struct {
 
 func (x *ListingAttribute) Reset() {
 	*x = ListingAttribute{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[3]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1214,7 @@ func (x *ListingAttribute) String() string {
 func (*ListingAttribute) ProtoMessage() {}
 
 func (x *ListingAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[3]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1227,7 @@ func (x *ListingAttribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingAttribute.ProtoReflect.Descriptor instead.
 func (*ListingAttribute) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListingAttribute) GetId() int64 {
@@ -952,7 +1285,7 @@ type ListingLocation struct {
 
 func (x *ListingLocation) Reset() {
 	*x = ListingLocation{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[4]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1297,7 @@ func (x *ListingLocation) String() string {
 func (*ListingLocation) ProtoMessage() {}
 
 func (x *ListingLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[4]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1310,7 @@ func (x *ListingLocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingLocation.ProtoReflect.Descriptor instead.
 func (*ListingLocation) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListingLocation) GetId() int64 {
@@ -1076,7 +1409,7 @@ type ListingVariant struct {
 
 func (x *ListingVariant) Reset() {
 	*x = ListingVariant{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[5]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1421,7 @@ func (x *ListingVariant) String() string {
 func (*ListingVariant) ProtoMessage() {}
 
 func (x *ListingVariant) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[5]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1434,7 @@ func (x *ListingVariant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingVariant.ProtoReflect.Descriptor instead.
 func (*ListingVariant) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListingVariant) GetId() int64 {
@@ -1197,7 +1530,7 @@ type Category struct {
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[6]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1542,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[6]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1555,7 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Category.ProtoReflect.Descriptor instead.
 func (*Category) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Category) GetId() int64 {
@@ -1346,7 +1679,7 @@ type CategoryTreeNode struct {
 
 func (x *CategoryTreeNode) Reset() {
 	*x = CategoryTreeNode{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[7]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +1691,7 @@ func (x *CategoryTreeNode) String() string {
 func (*CategoryTreeNode) ProtoMessage() {}
 
 func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[7]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1704,7 @@ func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
 func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CategoryTreeNode) GetId() int64 {
@@ -1501,13 +1834,15 @@ type Product struct {
 	HasVariants           bool                   `protobuf:"varint,24,opt,name=has_variants,json=hasVariants,proto3" json:"has_variants,omitempty"`
 	Variants              []*ProductVariant      `protobuf:"bytes,25,rep,name=variants,proto3" json:"variants,omitempty"`
 	Images                []*ProductImage        `protobuf:"bytes,26,rep,name=images,proto3" json:"images,omitempty"`
+	Unavailable           bool                   `protobuf:"varint,27,opt,name=unavailable,proto3" json:"unavailable,omitempty"`                                              // Storefront on vacation and not taking orders
+	AvailabilityNotice    *string                `protobuf:"bytes,28,opt,name=availability_notice,json=availabilityNotice,proto3,oneof" json:"availability_notice,omitempty"` // Vacation notice, also set when orders ship delayed
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[8]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1519,7 +1854,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[8]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,7 +1867,7 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product.ProtoReflect.Descriptor instead.
 func (*Product) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Product) GetId() int64 {
@@ -1717,7 +2052,21 @@ func (x *Product) GetImages() []*ProductImage {
 	return nil
 }
 
-// ProductVariant represents a product variant with specific attributes
+func (x *Product) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *Product) GetAvailabilityNotice() string {
+	if x != nil && x.AvailabilityNotice != nil {
+		return *x.AvailabilityNotice
+	}
+	return ""
+}
+
+// ProductVariant represents a product variant with specific attributes
 type ProductVariant struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1739,13 +2088,14 @@ type ProductVariant struct {
 	SoldCount         int32                  `protobuf:"varint,17,opt,name=sold_count,json=soldCount,proto3" json:"sold_count,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Images            []*ProductImage        `protobuf:"bytes,20,rep,name=images,proto3" json:"images,omitempty"` // Variant-level images
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ProductVariant) Reset() {
 	*x = ProductVariant{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[9]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1757,7 +2107,7 @@ func (x *ProductVariant) String() string {
 func (*ProductVariant) ProtoMessage() {}
 
 func (x *ProductVariant) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[9]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,7 +2120,7 @@ func (x *ProductVariant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
 func (*ProductVariant) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProductVariant) GetId() int64 {
@@ -1906,6 +2256,13 @@ func (x *ProductVariant) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProductVariant) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 // GetListing messages
 type GetListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1917,7 +2274,7 @@ type GetListingRequest struct {
 
 func (x *GetListingRequest) Reset() {
 	*x = GetListingRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[10]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1929,7 +2286,7 @@ func (x *GetListingRequest) String() string {
 func (*GetListingRequest) ProtoMessage() {}
 
 func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[10]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2299,7 @@ func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListingRequest.ProtoReflect.Descriptor instead.
 func (*GetListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetListingRequest) GetId() int64 {
@@ -1968,7 +2325,7 @@ type GetListingResponse struct {
 
 func (x *GetListingResponse) Reset() {
 	*x = GetListingResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[11]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1980,7 +2337,7 @@ func (x *GetListingResponse) String() string {
 func (*GetListingResponse) ProtoMessage() {}
 
 func (x *GetListingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[11]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +2350,7 @@ func (x *GetListingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListingResponse.ProtoReflect.Descriptor instead.
 func (*GetListingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetListingResponse) GetListing() *Listing {
@@ -2024,7 +2381,7 @@ type CreateListingRequest struct {
 
 func (x *CreateListingRequest) Reset() {
 	*x = CreateListingRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[12]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2036,7 +2393,7 @@ func (x *CreateListingRequest) String() string {
 func (*CreateListingRequest) ProtoMessage() {}
 
 func (x *CreateListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[12]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,7 +2406,7 @@ func (x *CreateListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateListingRequest.ProtoReflect.Descriptor instead.
 func (*CreateListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateListingRequest) GetUserId() int64 {
@@ -2132,13 +2489,14 @@ func (x *CreateListingRequest) GetOriginalLanguage() string {
 type CreateListingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Duplicates    *DuplicateReport       `protobuf:"bytes,2,opt,name=duplicates,proto3,oneof" json:"duplicates,omitempty"` // Set when duplicate detection is enabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateListingResponse) Reset() {
 	*x = CreateListingResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[13]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2150,7 +2508,7 @@ func (x *CreateListingResponse) String() string {
 func (*CreateListingResponse) ProtoMessage() {}
 
 func (x *CreateListingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[13]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,7 +2521,7 @@ func (x *CreateListingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateListingResponse.ProtoReflect.Descriptor instead.
 func (*CreateListingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateListingResponse) GetListing() *Listing {
@@ -2173,6 +2531,13 @@ func (x *CreateListingResponse) GetListing() *Listing {
 	return nil
 }
 
+func (x *CreateListingResponse) GetDuplicates() *DuplicateReport {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
 // UpdateListing messages
 type UpdateListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2189,7 +2554,7 @@ type UpdateListingRequest struct {
 
 func (x *UpdateListingRequest) Reset() {
 	*x = UpdateListingRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[14]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +2566,7 @@ func (x *UpdateListingRequest) String() string {
 func (*UpdateListingRequest) ProtoMessage() {}
 
 func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[14]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +2579,7 @@ func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateListingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateListingRequest) GetId() int64 {
@@ -2269,13 +2634,14 @@ func (x *UpdateListingRequest) GetStatus() string {
 type UpdateListingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Duplicates    *DuplicateReport       `protobuf:"bytes,2,opt,name=duplicates,proto3,oneof" json:"duplicates,omitempty"` // Set when title/description changed and duplicate detection is enabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateListingResponse) Reset() {
 	*x = UpdateListingResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[15]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2287,7 +2653,7 @@ func (x *UpdateListingResponse) String() string {
 func (*UpdateListingResponse) ProtoMessage() {}
 
 func (x *UpdateListingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[15]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2300,7 +2666,7 @@ func (x *UpdateListingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateListingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateListingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateListingResponse) GetListing() *Listing {
@@ -2310,6 +2676,110 @@ func (x *UpdateListingResponse) GetListing() *Listing {
 	return nil
 }
 
+func (x *UpdateListingResponse) GetDuplicates() *DuplicateReport {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// RenewListingRequest renews a C2C listing for its category renewal period
+type RenewListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Required for ownership check
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewListingRequest) Reset() {
+	*x = RenewListingRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewListingRequest) ProtoMessage() {}
+
+func (x *RenewListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewListingRequest.ProtoReflect.Descriptor instead.
+func (*RenewListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenewListingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenewListingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RenewListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewListingResponse) Reset() {
+	*x = RenewListingResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewListingResponse) ProtoMessage() {}
+
+func (x *RenewListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewListingResponse.ProtoReflect.Descriptor instead.
+func (*RenewListingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenewListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
 // DeleteListing messages
 type DeleteListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2322,7 +2792,7 @@ type DeleteListingRequest struct {
 
 func (x *DeleteListingRequest) Reset() {
 	*x = DeleteListingRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[16]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2334,7 +2804,7 @@ func (x *DeleteListingRequest) String() string {
 func (*DeleteListingRequest) ProtoMessage() {}
 
 func (x *DeleteListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[16]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,7 +2817,7 @@ func (x *DeleteListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteListingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteListingRequest) GetId() int64 {
@@ -2380,7 +2850,7 @@ type DeleteListingResponse struct {
 
 func (x *DeleteListingResponse) Reset() {
 	*x = DeleteListingResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[17]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2392,7 +2862,7 @@ func (x *DeleteListingResponse) String() string {
 func (*DeleteListingResponse) ProtoMessage() {}
 
 func (x *DeleteListingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[17]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2405,7 +2875,7 @@ func (x *DeleteListingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteListingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteListingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteListingResponse) GetSuccess() bool {
@@ -2430,7 +2900,7 @@ type SearchListingsRequest struct {
 
 func (x *SearchListingsRequest) Reset() {
 	*x = SearchListingsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[18]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2442,7 +2912,7 @@ func (x *SearchListingsRequest) String() string {
 func (*SearchListingsRequest) ProtoMessage() {}
 
 func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[18]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,7 +2925,7 @@ func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchListingsRequest.ProtoReflect.Descriptor instead.
 func (*SearchListingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SearchListingsRequest) GetQuery() string {
@@ -2510,7 +2980,7 @@ type SearchListingsResponse struct {
 
 func (x *SearchListingsResponse) Reset() {
 	*x = SearchListingsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[19]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2522,7 +2992,7 @@ func (x *SearchListingsResponse) String() string {
 func (*SearchListingsResponse) ProtoMessage() {}
 
 func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[19]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,7 +3005,7 @@ func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchListingsResponse.ProtoReflect.Descriptor instead.
 func (*SearchListingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SearchListingsResponse) GetListings() []*Listing {
@@ -2569,7 +3039,7 @@ type ListListingsRequest struct {
 
 func (x *ListListingsRequest) Reset() {
 	*x = ListListingsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[20]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2581,7 +3051,7 @@ func (x *ListListingsRequest) String() string {
 func (*ListListingsRequest) ProtoMessage() {}
 
 func (x *ListListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[20]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,7 +3064,7 @@ func (x *ListListingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListListingsRequest.ProtoReflect.Descriptor instead.
 func (*ListListingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListListingsRequest) GetUserId() int64 {
@@ -2663,7 +3133,7 @@ type ListListingsResponse struct {
 
 func (x *ListListingsResponse) Reset() {
 	*x = ListListingsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[21]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2675,7 +3145,7 @@ func (x *ListListingsResponse) String() string {
 func (*ListListingsResponse) ProtoMessage() {}
 
 func (x *ListListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[21]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,7 +3158,7 @@ func (x *ListListingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListListingsResponse.ProtoReflect.Descriptor instead.
 func (*ListListingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListListingsResponse) GetListings() []*Listing {
@@ -2716,7 +3186,7 @@ type GetSimilarListingsRequest struct {
 
 func (x *GetSimilarListingsRequest) Reset() {
 	*x = GetSimilarListingsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[22]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2728,7 +3198,7 @@ func (x *GetSimilarListingsRequest) String() string {
 func (*GetSimilarListingsRequest) ProtoMessage() {}
 
 func (x *GetSimilarListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[22]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,7 +3211,7 @@ func (x *GetSimilarListingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSimilarListingsRequest.ProtoReflect.Descriptor instead.
 func (*GetSimilarListingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetSimilarListingsRequest) GetListingId() int64 {
@@ -2768,7 +3238,7 @@ type GetSimilarListingsResponse struct {
 
 func (x *GetSimilarListingsResponse) Reset() {
 	*x = GetSimilarListingsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[23]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2780,7 +3250,7 @@ func (x *GetSimilarListingsResponse) String() string {
 func (*GetSimilarListingsResponse) ProtoMessage() {}
 
 func (x *GetSimilarListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[23]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2793,7 +3263,7 @@ func (x *GetSimilarListingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSimilarListingsResponse.ProtoReflect.Descriptor instead.
 func (*GetSimilarListingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetSimilarListingsResponse) GetListings() []*Listing {
@@ -2810,29 +3280,31 @@ func (x *GetSimilarListingsResponse) GetTotal() int32 {
 	return 0
 }
 
-// ImageIDRequest requests an image by ID
-type ImageIDRequest struct {
+// RecommendedListing is a listing with the reason it is recommended
+type RecommendedListing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageId       int64                  `protobuf:"varint,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Source        RecommendationSource   `protobuf:"varint,2,opt,name=source,proto3,enum=listingssvc.v1.RecommendationSource" json:"source,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"` // Relative strength within one response
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageIDRequest) Reset() {
-	*x = ImageIDRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[24]
+func (x *RecommendedListing) Reset() {
+	*x = RecommendedListing{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageIDRequest) String() string {
+func (x *RecommendedListing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageIDRequest) ProtoMessage() {}
+func (*RecommendedListing) ProtoMessage() {}
 
-func (x *ImageIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[24]
+func (x *RecommendedListing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,41 +3315,55 @@ func (x *ImageIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageIDRequest.ProtoReflect.Descriptor instead.
-func (*ImageIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RecommendedListing.ProtoReflect.Descriptor instead.
+func (*RecommendedListing) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ImageIDRequest) GetImageId() int64 {
+func (x *RecommendedListing) GetListing() *Listing {
 	if x != nil {
-		return x.ImageId
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *RecommendedListing) GetSource() RecommendationSource {
+	if x != nil {
+		return x.Source
+	}
+	return RecommendationSource_RECOMMENDATION_SOURCE_UNSPECIFIED
+}
+
+func (x *RecommendedListing) GetScore() float64 {
+	if x != nil {
+		return x.Score
 	}
 	return 0
 }
 
-// ImageResponse returns a single image
-type ImageResponse struct {
+type GetRecommendationsForUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ListingImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // Identifies anonymous visitors (ignored when authenticated)
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Default 10, max 20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageResponse) Reset() {
-	*x = ImageResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[25]
+func (x *GetRecommendationsForUserRequest) Reset() {
+	*x = GetRecommendationsForUserRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageResponse) String() string {
+func (x *GetRecommendationsForUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageResponse) ProtoMessage() {}
+func (*GetRecommendationsForUserRequest) ProtoMessage() {}
 
-func (x *ImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[25]
+func (x *GetRecommendationsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,38 +3374,275 @@ func (x *ImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageResponse.ProtoReflect.Descriptor instead.
-func (*ImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetRecommendationsForUserRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ImageResponse) GetImage() *ListingImage {
-	if x != nil {
-		return x.Image
+func (x *GetRecommendationsForUserRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
 	}
-	return nil
+	return ""
 }
 
-// AddImageRequest adds a new image to a listing
-type AddImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	StoragePath   *string                `protobuf:"bytes,3,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
-	DisplayOrder  int32                  `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	Width         *int32                 `protobuf:"varint,7,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Height        *int32                 `protobuf:"varint,8,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	FileSize      *int64                 `protobuf:"varint,9,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
-	MimeType      *string                `protobuf:"bytes,10,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+func (x *GetRecommendationsForUserRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetRecommendationsForUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*RecommendedListing  `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsForUserResponse) Reset() {
+	*x = GetRecommendationsForUserResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsForUserResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsForUserResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsForUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRecommendationsForUserResponse) GetRecommendations() []*RecommendedListing {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type GetFrequentlyBoughtTogetherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // Default 10, max 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrequentlyBoughtTogetherRequest) Reset() {
+	*x = GetFrequentlyBoughtTogetherRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequentlyBoughtTogetherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequentlyBoughtTogetherRequest) ProtoMessage() {}
+
+func (x *GetFrequentlyBoughtTogetherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequentlyBoughtTogetherRequest.ProtoReflect.Descriptor instead.
+func (*GetFrequentlyBoughtTogetherRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetFrequentlyBoughtTogetherRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *GetFrequentlyBoughtTogetherRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetFrequentlyBoughtTogetherResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*RecommendedListing  `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetFrequentlyBoughtTogetherResponse) Reset() {
+	*x = GetFrequentlyBoughtTogetherResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequentlyBoughtTogetherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequentlyBoughtTogetherResponse) ProtoMessage() {}
+
+func (x *GetFrequentlyBoughtTogetherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequentlyBoughtTogetherResponse.ProtoReflect.Descriptor instead.
+func (*GetFrequentlyBoughtTogetherResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetFrequentlyBoughtTogetherResponse) GetRecommendations() []*RecommendedListing {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+// ImageIDRequest requests an image by ID
+type ImageIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       int64                  `protobuf:"varint,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageIDRequest) Reset() {
+	*x = ImageIDRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageIDRequest) ProtoMessage() {}
+
+func (x *ImageIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageIDRequest.ProtoReflect.Descriptor instead.
+func (*ImageIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImageIDRequest) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+// ImageResponse returns a single image
+type ImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ListingImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageResponse) Reset() {
+	*x = ImageResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageResponse) ProtoMessage() {}
+
+func (x *ImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageResponse.ProtoReflect.Descriptor instead.
+func (*ImageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImageResponse) GetImage() *ListingImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// AddImageRequest adds a new image to a listing
+type AddImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	StoragePath   *string                `protobuf:"bytes,3,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
+	ThumbnailUrl  *string                `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Width         *int32                 `protobuf:"varint,7,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,8,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	FileSize      *int64                 `protobuf:"varint,9,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
+	MimeType      *string                `protobuf:"bytes,10,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddImageRequest) Reset() {
 	*x = AddImageRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[26]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2931,7 +3654,7 @@ func (x *AddImageRequest) String() string {
 func (*AddImageRequest) ProtoMessage() {}
 
 func (x *AddImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[26]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +3667,7 @@ func (x *AddImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddImageRequest.ProtoReflect.Descriptor instead.
 func (*AddImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AddImageRequest) GetListingId() int64 {
@@ -3027,7 +3750,7 @@ type ListingIDRequest struct {
 
 func (x *ListingIDRequest) Reset() {
 	*x = ListingIDRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[27]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3039,7 +3762,7 @@ func (x *ListingIDRequest) String() string {
 func (*ListingIDRequest) ProtoMessage() {}
 
 func (x *ListingIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[27]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3052,7 +3775,7 @@ func (x *ListingIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingIDRequest.ProtoReflect.Descriptor instead.
 func (*ListingIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListingIDRequest) GetListingId() int64 {
@@ -3072,7 +3795,7 @@ type ImagesResponse struct {
 
 func (x *ImagesResponse) Reset() {
 	*x = ImagesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[28]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3084,7 +3807,7 @@ func (x *ImagesResponse) String() string {
 func (*ImagesResponse) ProtoMessage() {}
 
 func (x *ImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[28]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3097,7 +3820,7 @@ func (x *ImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagesResponse.ProtoReflect.Descriptor instead.
 func (*ImagesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ImagesResponse) GetImages() []*ListingImage {
@@ -3119,7 +3842,7 @@ type ReorderImagesRequest struct {
 
 func (x *ReorderImagesRequest) Reset() {
 	*x = ReorderImagesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[29]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3131,7 +3854,7 @@ func (x *ReorderImagesRequest) String() string {
 func (*ReorderImagesRequest) ProtoMessage() {}
 
 func (x *ReorderImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[29]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,7 +3867,7 @@ func (x *ReorderImagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderImagesRequest.ProtoReflect.Descriptor instead.
 func (*ReorderImagesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ReorderImagesRequest) GetListingId() int64 {
@@ -3178,7 +3901,7 @@ type ReorderImagesResponse struct {
 
 func (x *ReorderImagesResponse) Reset() {
 	*x = ReorderImagesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[30]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3190,7 +3913,7 @@ func (x *ReorderImagesResponse) String() string {
 func (*ReorderImagesResponse) ProtoMessage() {}
 
 func (x *ReorderImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[30]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,7 +3926,7 @@ func (x *ReorderImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderImagesResponse.ProtoReflect.Descriptor instead.
 func (*ReorderImagesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ReorderImagesResponse) GetSuccess() bool {
@@ -3224,7 +3947,7 @@ type ImageOrder struct {
 
 func (x *ImageOrder) Reset() {
 	*x = ImageOrder{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[31]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3236,7 +3959,7 @@ func (x *ImageOrder) String() string {
 func (*ImageOrder) ProtoMessage() {}
 
 func (x *ImageOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[31]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3249,7 +3972,7 @@ func (x *ImageOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageOrder.ProtoReflect.Descriptor instead.
 func (*ImageOrder) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ImageOrder) GetImageId() int64 {
@@ -3278,7 +4001,7 @@ type DeleteListingImageRequest struct {
 
 func (x *DeleteListingImageRequest) Reset() {
 	*x = DeleteListingImageRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[32]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3290,7 +4013,7 @@ func (x *DeleteListingImageRequest) String() string {
 func (*DeleteListingImageRequest) ProtoMessage() {}
 
 func (x *DeleteListingImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[32]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +4026,7 @@ func (x *DeleteListingImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteListingImageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteListingImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteListingImageRequest) GetListingId() int64 {
@@ -3338,7 +4061,7 @@ type DeleteListingImageResponse struct {
 
 func (x *DeleteListingImageResponse) Reset() {
 	*x = DeleteListingImageResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[33]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3350,7 +4073,7 @@ func (x *DeleteListingImageResponse) String() string {
 func (*DeleteListingImageResponse) ProtoMessage() {}
 
 func (x *DeleteListingImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[33]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3363,7 +4086,7 @@ func (x *DeleteListingImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteListingImageResponse.ProtoReflect.Descriptor instead.
 func (*DeleteListingImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{33}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteListingImageResponse) GetSuccess() bool {
@@ -3395,7 +4118,7 @@ type UploadImageChunkRequest struct {
 
 func (x *UploadImageChunkRequest) Reset() {
 	*x = UploadImageChunkRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[34]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3407,7 +4130,7 @@ func (x *UploadImageChunkRequest) String() string {
 func (*UploadImageChunkRequest) ProtoMessage() {}
 
 func (x *UploadImageChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[34]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,7 +4143,7 @@ func (x *UploadImageChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageChunkRequest.ProtoReflect.Descriptor instead.
 func (*UploadImageChunkRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{34}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UploadImageChunkRequest) GetData() isUploadImageChunkRequest_Data {
@@ -3480,7 +4203,7 @@ type UploadImageMetadata struct {
 
 func (x *UploadImageMetadata) Reset() {
 	*x = UploadImageMetadata{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[35]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3492,7 +4215,7 @@ func (x *UploadImageMetadata) String() string {
 func (*UploadImageMetadata) ProtoMessage() {}
 
 func (x *UploadImageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[35]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3505,7 +4228,7 @@ func (x *UploadImageMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageMetadata.ProtoReflect.Descriptor instead.
 func (*UploadImageMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{35}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UploadImageMetadata) GetListingId() int64 {
@@ -3570,7 +4293,7 @@ type UploadImagesResponse struct {
 
 func (x *UploadImagesResponse) Reset() {
 	*x = UploadImagesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[36]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3582,7 +4305,7 @@ func (x *UploadImagesResponse) String() string {
 func (*UploadImagesResponse) ProtoMessage() {}
 
 func (x *UploadImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[36]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,7 +4318,7 @@ func (x *UploadImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImagesResponse.ProtoReflect.Descriptor instead.
 func (*UploadImagesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{36}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UploadImagesResponse) GetImages() []*ListingImage {
@@ -3626,29 +4349,31 @@ func (x *UploadImagesResponse) GetErrors() []string {
 	return nil
 }
 
-// PopularCategoriesRequest requests popular categories
-type PopularCategoriesRequest struct {
+// ImageUploadFile describes a file the client wants to upload directly
+type ImageUploadFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum number of categories to return
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // Original filename (extension must be jpg, jpeg, png or gif)
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME type; the upload must use the same Content-Type header
+	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`         // File size in bytes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PopularCategoriesRequest) Reset() {
-	*x = PopularCategoriesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[37]
+func (x *ImageUploadFile) Reset() {
+	*x = ImageUploadFile{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PopularCategoriesRequest) String() string {
+func (x *ImageUploadFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PopularCategoriesRequest) ProtoMessage() {}
+func (*ImageUploadFile) ProtoMessage() {}
 
-func (x *PopularCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[37]
+func (x *ImageUploadFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3659,41 +4384,58 @@ func (x *PopularCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PopularCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*PopularCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ImageUploadFile.ProtoReflect.Descriptor instead.
+func (*ImageUploadFile) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *PopularCategoriesRequest) GetLimit() int32 {
+func (x *ImageUploadFile) GetFilename() string {
 	if x != nil {
-		return x.Limit
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImageUploadFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageUploadFile) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
 	}
 	return 0
 }
 
-// CategoriesResponse returns multiple categories
-type CategoriesResponse struct {
+// RequestImageUploadURLsRequest requests presigned upload URLs
+type RequestImageUploadURLsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`                // Required: listing ID (product ID when storefront_id is set)
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // Required for authorization of listing uploads
+	StorefrontId  *int64                 `protobuf:"varint,3,opt,name=storefront_id,json=storefrontId,proto3,oneof" json:"storefront_id,omitempty"` // Set for product images: product must belong to the storefront
+	Files         []*ImageUploadFile     `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                                          // Files to upload (max 10)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoriesResponse) Reset() {
-	*x = CategoriesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[38]
+func (x *RequestImageUploadURLsRequest) Reset() {
+	*x = RequestImageUploadURLsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoriesResponse) String() string {
+func (x *RequestImageUploadURLsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoriesResponse) ProtoMessage() {}
+func (*RequestImageUploadURLsRequest) ProtoMessage() {}
 
-func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[38]
+func (x *RequestImageUploadURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3704,41 +4446,65 @@ func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoriesResponse.ProtoReflect.Descriptor instead.
-func (*CategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RequestImageUploadURLsRequest.ProtoReflect.Descriptor instead.
+func (*RequestImageUploadURLsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CategoriesResponse) GetCategories() []*Category {
+func (x *RequestImageUploadURLsRequest) GetListingId() int64 {
 	if x != nil {
-		return x.Categories
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *RequestImageUploadURLsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RequestImageUploadURLsRequest) GetStorefrontId() int64 {
+	if x != nil && x.StorefrontId != nil {
+		return *x.StorefrontId
+	}
+	return 0
+}
+
+func (x *RequestImageUploadURLsRequest) GetFiles() []*ImageUploadFile {
+	if x != nil {
+		return x.Files
 	}
 	return nil
 }
 
-// CategoryIDRequest requests a category by ID
-type CategoryIDRequest struct {
+// PresignedImageUpload is a presigned PUT URL for one file
+type PresignedImageUpload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	StorageKey    string                 `protobuf:"bytes,2,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"` // Object key to pass to ConfirmImageUpload
+	UploadUrl     string                 `protobuf:"bytes,3,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`    // Presigned PUT URL
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryIDRequest) Reset() {
-	*x = CategoryIDRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[39]
+func (x *PresignedImageUpload) Reset() {
+	*x = PresignedImageUpload{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryIDRequest) String() string {
+func (x *PresignedImageUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryIDRequest) ProtoMessage() {}
+func (*PresignedImageUpload) ProtoMessage() {}
 
-func (x *CategoryIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[39]
+func (x *PresignedImageUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,41 +4515,62 @@ func (x *CategoryIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryIDRequest.ProtoReflect.Descriptor instead.
-func (*CategoryIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use PresignedImageUpload.ProtoReflect.Descriptor instead.
+func (*PresignedImageUpload) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CategoryIDRequest) GetCategoryId() int64 {
+func (x *PresignedImageUpload) GetFilename() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-// CategoryResponse returns a single category
-type CategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+func (x *PresignedImageUpload) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *PresignedImageUpload) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *PresignedImageUpload) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// RequestImageUploadURLsResponse returns one upload URL per requested file
+type RequestImageUploadURLsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Uploads       []*PresignedImageUpload `protobuf:"bytes,1,rep,name=uploads,proto3" json:"uploads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryResponse) Reset() {
-	*x = CategoryResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[40]
+func (x *RequestImageUploadURLsResponse) Reset() {
+	*x = RequestImageUploadURLsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryResponse) String() string {
+func (x *RequestImageUploadURLsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryResponse) ProtoMessage() {}
+func (*RequestImageUploadURLsResponse) ProtoMessage() {}
 
-func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[40]
+func (x *RequestImageUploadURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,41 +4581,47 @@ func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
-func (*CategoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RequestImageUploadURLsResponse.ProtoReflect.Descriptor instead.
+func (*RequestImageUploadURLsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CategoryResponse) GetCategory() *Category {
+func (x *RequestImageUploadURLsResponse) GetUploads() []*PresignedImageUpload {
 	if x != nil {
-		return x.Category
+		return x.Uploads
 	}
 	return nil
 }
 
-// CategoryTreeResponse returns category tree
-type CategoryTreeResponse struct {
+// ConfirmImageUploadRequest registers a directly uploaded image
+type ConfirmImageUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tree          *CategoryTreeNode      `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`                // Required: listing ID (product ID when storefront_id is set)
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // Required for authorization of listing uploads
+	StorefrontId  *int64                 `protobuf:"varint,3,opt,name=storefront_id,json=storefrontId,proto3,oneof" json:"storefront_id,omitempty"` // Set for product images
+	StorageKey    string                 `protobuf:"bytes,4,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`              // Key returned by RequestImageUploadURLs
+	DisplayOrder  int32                  `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	VariantId     *int64                 `protobuf:"varint,7,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"` // Product images only: attach to a variant of the product
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryTreeResponse) Reset() {
-	*x = CategoryTreeResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[41]
+func (x *ConfirmImageUploadRequest) Reset() {
+	*x = ConfirmImageUploadRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryTreeResponse) String() string {
+func (x *ConfirmImageUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryTreeResponse) ProtoMessage() {}
+func (*ConfirmImageUploadRequest) ProtoMessage() {}
 
-func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[41]
+func (x *ConfirmImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3839,87 +4632,83 @@ func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryTreeResponse.ProtoReflect.Descriptor instead.
-func (*CategoryTreeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ConfirmImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CategoryTreeResponse) GetTree() *CategoryTreeNode {
+func (x *ConfirmImageUploadRequest) GetListingId() int64 {
 	if x != nil {
-		return x.Tree
+		return x.ListingId
 	}
-	return nil
+	return 0
 }
 
-// UserIDsResponse returns list of user IDs
-type UserIDsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConfirmImageUploadRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *UserIDsResponse) Reset() {
-	*x = UserIDsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ConfirmImageUploadRequest) GetStorefrontId() int64 {
+	if x != nil && x.StorefrontId != nil {
+		return *x.StorefrontId
+	}
+	return 0
 }
 
-func (x *UserIDsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ConfirmImageUploadRequest) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
 }
 
-func (*UserIDsResponse) ProtoMessage() {}
-
-func (x *UserIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[42]
+func (x *ConfirmImageUploadRequest) GetDisplayOrder() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DisplayOrder
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UserIDsResponse.ProtoReflect.Descriptor instead.
-func (*UserIDsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{42}
+func (x *ConfirmImageUploadRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
 }
 
-func (x *UserIDsResponse) GetUserIds() []int64 {
-	if x != nil {
-		return x.UserIds
+func (x *ConfirmImageUploadRequest) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
 	}
-	return nil
+	return 0
 }
 
-// AddToFavoritesRequest adds a listing to favorites
-type AddToFavoritesRequest struct {
+// PopularCategoriesRequest requests popular categories
+type PopularCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum number of categories to return
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToFavoritesRequest) Reset() {
-	*x = AddToFavoritesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[43]
+func (x *PopularCategoriesRequest) Reset() {
+	*x = PopularCategoriesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToFavoritesRequest) String() string {
+func (x *PopularCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToFavoritesRequest) ProtoMessage() {}
+func (*PopularCategoriesRequest) ProtoMessage() {}
 
-func (x *AddToFavoritesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[43]
+func (x *PopularCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3930,49 +4719,41 @@ func (x *AddToFavoritesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToFavoritesRequest.ProtoReflect.Descriptor instead.
-func (*AddToFavoritesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use PopularCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*PopularCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AddToFavoritesRequest) GetUserId() int64 {
+func (x *PopularCategoriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *AddToFavoritesRequest) GetListingId() int64 {
-	if x != nil {
-		return x.ListingId
-	}
-	return 0
-}
-
-// RemoveFromFavoritesRequest removes a listing from favorites
-type RemoveFromFavoritesRequest struct {
+// CategoriesResponse returns multiple categories
+type CategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFromFavoritesRequest) Reset() {
-	*x = RemoveFromFavoritesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[44]
+func (x *CategoriesResponse) Reset() {
+	*x = CategoriesResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromFavoritesRequest) String() string {
+func (x *CategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromFavoritesRequest) ProtoMessage() {}
+func (*CategoriesResponse) ProtoMessage() {}
 
-func (x *RemoveFromFavoritesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[44]
+func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3983,50 +4764,41 @@ func (x *RemoveFromFavoritesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromFavoritesRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFromFavoritesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *RemoveFromFavoritesRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use CategoriesResponse.ProtoReflect.Descriptor instead.
+func (*CategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RemoveFromFavoritesRequest) GetListingId() int64 {
+func (x *CategoriesResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.ListingId
+		return x.Categories
 	}
-	return 0
+	return nil
 }
 
-// GetUserFavoritesRequest retrieves user's favorites
-type GetUserFavoritesRequest struct {
+// CategoryIDRequest requests a category by ID
+type CategoryIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserFavoritesRequest) Reset() {
-	*x = GetUserFavoritesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[45]
+func (x *CategoryIDRequest) Reset() {
+	*x = CategoryIDRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserFavoritesRequest) String() string {
+func (x *CategoryIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFavoritesRequest) ProtoMessage() {}
+func (*CategoryIDRequest) ProtoMessage() {}
 
-func (x *GetUserFavoritesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[45]
+func (x *CategoryIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4037,56 +4809,86 @@ func (x *GetUserFavoritesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFavoritesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFavoritesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CategoryIDRequest.ProtoReflect.Descriptor instead.
+func (*CategoryIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetUserFavoritesRequest) GetUserId() int64 {
+func (x *CategoryIDRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *GetUserFavoritesRequest) GetLimit() int32 {
+// CategoryResponse returns a single category
+type CategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryResponse) Reset() {
+	*x = CategoryResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryResponse) ProtoMessage() {}
+
+func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[53]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserFavoritesRequest) GetOffset() int32 {
+// Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
+func (*CategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Offset
+		return x.Category
 	}
-	return 0
+	return nil
 }
 
-// GetUserFavoritesResponse returns list of favorited listing IDs
-type GetUserFavoritesResponse struct {
+// CategoryTreeResponse returns category tree
+type CategoryTreeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ListingIds    []int64                `protobuf:"varint,1,rep,packed,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Tree          *CategoryTreeNode      `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserFavoritesResponse) Reset() {
-	*x = GetUserFavoritesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[46]
+func (x *CategoryTreeResponse) Reset() {
+	*x = CategoryTreeResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserFavoritesResponse) String() string {
+func (x *CategoryTreeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFavoritesResponse) ProtoMessage() {}
+func (*CategoryTreeResponse) ProtoMessage() {}
 
-func (x *GetUserFavoritesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[46]
+func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4097,27 +4899,65 @@ func (x *GetUserFavoritesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFavoritesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFavoritesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*CategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetUserFavoritesResponse) GetListingIds() []int64 {
+func (x *CategoryTreeResponse) GetTree() *CategoryTreeNode {
 	if x != nil {
-		return x.ListingIds
+		return x.Tree
 	}
 	return nil
 }
 
-func (x *GetUserFavoritesResponse) GetTotal() int32 {
+// UserIDsResponse returns list of user IDs
+type UserIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIDsResponse) Reset() {
+	*x = UserIDsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDsResponse) ProtoMessage() {}
+
+func (x *UserIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[55]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// IsFavoriteRequest checks if listing is favorited
-type IsFavoriteRequest struct {
+// Deprecated: Use UserIDsResponse.ProtoReflect.Descriptor instead.
+func (*UserIDsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UserIDsResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// AddToFavoritesRequest adds a listing to favorites
+type AddToFavoritesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
@@ -4125,21 +4965,21 @@ type IsFavoriteRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsFavoriteRequest) Reset() {
-	*x = IsFavoriteRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[47]
+func (x *AddToFavoritesRequest) Reset() {
+	*x = AddToFavoritesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsFavoriteRequest) String() string {
+func (x *AddToFavoritesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsFavoriteRequest) ProtoMessage() {}
+func (*AddToFavoritesRequest) ProtoMessage() {}
 
-func (x *IsFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[47]
+func (x *AddToFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4150,48 +4990,49 @@ func (x *IsFavoriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsFavoriteRequest.ProtoReflect.Descriptor instead.
-func (*IsFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AddToFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*AddToFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *IsFavoriteRequest) GetUserId() int64 {
+func (x *AddToFavoritesRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *IsFavoriteRequest) GetListingId() int64 {
+func (x *AddToFavoritesRequest) GetListingId() int64 {
 	if x != nil {
 		return x.ListingId
 	}
 	return 0
 }
 
-// IsFavoriteResponse returns favorite status
-type IsFavoriteResponse struct {
+// RemoveFromFavoritesRequest removes a listing from favorites
+type RemoveFromFavoritesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFavorite    bool                   `protobuf:"varint,1,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsFavoriteResponse) Reset() {
-	*x = IsFavoriteResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[48]
+func (x *RemoveFromFavoritesRequest) Reset() {
+	*x = RemoveFromFavoritesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsFavoriteResponse) String() string {
+func (x *RemoveFromFavoritesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsFavoriteResponse) ProtoMessage() {}
+func (*RemoveFromFavoritesRequest) ProtoMessage() {}
 
-func (x *IsFavoriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[48]
+func (x *RemoveFromFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4202,19 +5043,238 @@ func (x *IsFavoriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsFavoriteResponse.ProtoReflect.Descriptor instead.
-func (*IsFavoriteResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RemoveFromFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *IsFavoriteResponse) GetIsFavorite() bool {
+func (x *RemoveFromFavoritesRequest) GetUserId() int64 {
 	if x != nil {
-		return x.IsFavorite
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-// Storefront represents a B2C store
+func (x *RemoveFromFavoritesRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+// GetUserFavoritesRequest retrieves user's favorites
+type GetUserFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserFavoritesRequest) Reset() {
+	*x = GetUserFavoritesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFavoritesRequest) ProtoMessage() {}
+
+func (x *GetUserFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUserFavoritesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserFavoritesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserFavoritesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetUserFavoritesResponse returns list of favorited listing IDs
+type GetUserFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingIds    []int64                `protobuf:"varint,1,rep,packed,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserFavoritesResponse) Reset() {
+	*x = GetUserFavoritesResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFavoritesResponse) ProtoMessage() {}
+
+func (x *GetUserFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetUserFavoritesResponse) GetListingIds() []int64 {
+	if x != nil {
+		return x.ListingIds
+	}
+	return nil
+}
+
+func (x *GetUserFavoritesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// IsFavoriteRequest checks if listing is favorited
+type IsFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFavoriteRequest) Reset() {
+	*x = IsFavoriteRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFavoriteRequest) ProtoMessage() {}
+
+func (x *IsFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*IsFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IsFavoriteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IsFavoriteRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+// IsFavoriteResponse returns favorite status
+type IsFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFavorite    bool                   `protobuf:"varint,1,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFavoriteResponse) Reset() {
+	*x = IsFavoriteResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFavoriteResponse) ProtoMessage() {}
+
+func (x *IsFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*IsFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *IsFavoriteResponse) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+// Storefront represents a B2C store
 type Storefront struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -4249,7 +5309,7 @@ type Storefront struct {
 
 func (x *Storefront) Reset() {
 	*x = Storefront{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[49]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4261,7 +5321,7 @@ func (x *Storefront) String() string {
 func (*Storefront) ProtoMessage() {}
 
 func (x *Storefront) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[49]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4274,7 +5334,7 @@ func (x *Storefront) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Storefront.ProtoReflect.Descriptor instead.
 func (*Storefront) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{49}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Storefront) GetId() int64 {
@@ -4477,7 +5537,7 @@ type GetStorefrontRequest struct {
 
 func (x *GetStorefrontRequest) Reset() {
 	*x = GetStorefrontRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[50]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4489,7 +5549,7 @@ func (x *GetStorefrontRequest) String() string {
 func (*GetStorefrontRequest) ProtoMessage() {}
 
 func (x *GetStorefrontRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[50]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4502,7 +5562,7 @@ func (x *GetStorefrontRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStorefrontRequest.ProtoReflect.Descriptor instead.
 func (*GetStorefrontRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{50}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetStorefrontRequest) GetIdentifier() isGetStorefrontRequest_Identifier {
@@ -4584,7 +5644,7 @@ type GetStorefrontBySlugRequest struct {
 
 func (x *GetStorefrontBySlugRequest) Reset() {
 	*x = GetStorefrontBySlugRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[51]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4596,7 +5656,7 @@ func (x *GetStorefrontBySlugRequest) String() string {
 func (*GetStorefrontBySlugRequest) ProtoMessage() {}
 
 func (x *GetStorefrontBySlugRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[51]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4609,7 +5669,7 @@ func (x *GetStorefrontBySlugRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStorefrontBySlugRequest.ProtoReflect.Descriptor instead.
 func (*GetStorefrontBySlugRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{51}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetStorefrontBySlugRequest) GetSlug() string {
@@ -4629,7 +5689,7 @@ type StorefrontResponse struct {
 
 func (x *StorefrontResponse) Reset() {
 	*x = StorefrontResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[52]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4641,7 +5701,7 @@ func (x *StorefrontResponse) String() string {
 func (*StorefrontResponse) ProtoMessage() {}
 
 func (x *StorefrontResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[52]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4654,7 +5714,7 @@ func (x *StorefrontResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorefrontResponse.ProtoReflect.Descriptor instead.
 func (*StorefrontResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{52}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *StorefrontResponse) GetStorefront() *Storefront {
@@ -4674,7 +5734,7 @@ type GetStorefrontResponse struct {
 
 func (x *GetStorefrontResponse) Reset() {
 	*x = GetStorefrontResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[53]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4686,7 +5746,7 @@ func (x *GetStorefrontResponse) String() string {
 func (*GetStorefrontResponse) ProtoMessage() {}
 
 func (x *GetStorefrontResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[53]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4699,7 +5759,7 @@ func (x *GetStorefrontResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStorefrontResponse.ProtoReflect.Descriptor instead.
 func (*GetStorefrontResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{53}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *GetStorefrontResponse) GetStorefront() *StorefrontFull {
@@ -4738,7 +5798,7 @@ type ListStorefrontsRequest struct {
 
 func (x *ListStorefrontsRequest) Reset() {
 	*x = ListStorefrontsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[54]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4750,7 +5810,7 @@ func (x *ListStorefrontsRequest) String() string {
 func (*ListStorefrontsRequest) ProtoMessage() {}
 
 func (x *ListStorefrontsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[54]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4763,7 +5823,7 @@ func (x *ListStorefrontsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStorefrontsRequest.ProtoReflect.Descriptor instead.
 func (*ListStorefrontsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{54}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListStorefrontsRequest) GetUserId() int64 {
@@ -4917,7 +5977,7 @@ type ListStorefrontsResponse struct {
 
 func (x *ListStorefrontsResponse) Reset() {
 	*x = ListStorefrontsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[55]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4929,7 +5989,7 @@ func (x *ListStorefrontsResponse) String() string {
 func (*ListStorefrontsResponse) ProtoMessage() {}
 
 func (x *ListStorefrontsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[55]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,7 +6002,7 @@ func (x *ListStorefrontsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStorefrontsResponse.ProtoReflect.Descriptor instead.
 func (*ListStorefrontsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{55}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListStorefrontsResponse) GetStorefronts() []*StorefrontFull {
@@ -4970,7 +6030,7 @@ type CreateVariantsRequest struct {
 
 func (x *CreateVariantsRequest) Reset() {
 	*x = CreateVariantsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[56]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4982,7 +6042,7 @@ func (x *CreateVariantsRequest) String() string {
 func (*CreateVariantsRequest) ProtoMessage() {}
 
 func (x *CreateVariantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[56]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4995,7 +6055,7 @@ func (x *CreateVariantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVariantsRequest.ProtoReflect.Descriptor instead.
 func (*CreateVariantsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{56}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CreateVariantsRequest) GetListingId() int64 {
@@ -5027,7 +6087,7 @@ type VariantInput struct {
 
 func (x *VariantInput) Reset() {
 	*x = VariantInput{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[57]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5039,7 +6099,7 @@ func (x *VariantInput) String() string {
 func (*VariantInput) ProtoMessage() {}
 
 func (x *VariantInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[57]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5052,7 +6112,7 @@ func (x *VariantInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariantInput.ProtoReflect.Descriptor instead.
 func (*VariantInput) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{57}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *VariantInput) GetSku() string {
@@ -5107,7 +6167,7 @@ type VariantsResponse struct {
 
 func (x *VariantsResponse) Reset() {
 	*x = VariantsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[58]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5119,7 +6179,7 @@ func (x *VariantsResponse) String() string {
 func (*VariantsResponse) ProtoMessage() {}
 
 func (x *VariantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[58]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5132,7 +6192,7 @@ func (x *VariantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariantsResponse.ProtoReflect.Descriptor instead.
 func (*VariantsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{58}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *VariantsResponse) GetVariants() []*ListingVariant {
@@ -5158,7 +6218,7 @@ type UpdateVariantRequest struct {
 
 func (x *UpdateVariantRequest) Reset() {
 	*x = UpdateVariantRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[59]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5170,7 +6230,7 @@ func (x *UpdateVariantRequest) String() string {
 func (*UpdateVariantRequest) ProtoMessage() {}
 
 func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[59]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5183,7 +6243,7 @@ func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{59}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *UpdateVariantRequest) GetVariantId() int64 {
@@ -5245,7 +6305,7 @@ type VariantIDRequest struct {
 
 func (x *VariantIDRequest) Reset() {
 	*x = VariantIDRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[60]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5257,7 +6317,7 @@ func (x *VariantIDRequest) String() string {
 func (*VariantIDRequest) ProtoMessage() {}
 
 func (x *VariantIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[60]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5270,7 +6330,7 @@ func (x *VariantIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariantIDRequest.ProtoReflect.Descriptor instead.
 func (*VariantIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{60}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *VariantIDRequest) GetVariantId() int64 {
@@ -5291,7 +6351,7 @@ type ReindexRequest struct {
 
 func (x *ReindexRequest) Reset() {
 	*x = ReindexRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[61]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5303,7 +6363,7 @@ func (x *ReindexRequest) String() string {
 func (*ReindexRequest) ProtoMessage() {}
 
 func (x *ReindexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[61]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5316,7 +6376,7 @@ func (x *ReindexRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexRequest.ProtoReflect.Descriptor instead.
 func (*ReindexRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{61}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ReindexRequest) GetBatchSize() int32 {
@@ -5345,7 +6405,7 @@ type ListingsResponse struct {
 
 func (x *ListingsResponse) Reset() {
 	*x = ListingsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[62]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5357,7 +6417,7 @@ func (x *ListingsResponse) String() string {
 func (*ListingsResponse) ProtoMessage() {}
 
 func (x *ListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[62]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5370,7 +6430,7 @@ func (x *ListingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingsResponse.ProtoReflect.Descriptor instead.
 func (*ListingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{62}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ListingsResponse) GetListings() []*Listing {
@@ -5404,7 +6464,7 @@ type ResetFlagsRequest struct {
 
 func (x *ResetFlagsRequest) Reset() {
 	*x = ResetFlagsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[63]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5416,7 +6476,7 @@ func (x *ResetFlagsRequest) String() string {
 func (*ResetFlagsRequest) ProtoMessage() {}
 
 func (x *ResetFlagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[63]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5429,7 +6489,7 @@ func (x *ResetFlagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetFlagsRequest.ProtoReflect.Descriptor instead.
 func (*ResetFlagsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{63}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ResetFlagsRequest) GetListingIds() []int64 {
@@ -5451,7 +6511,7 @@ type GetProductRequest struct {
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[64]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5463,7 +6523,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[64]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5476,7 +6536,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{64}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetProductRequest) GetProductId() int64 {
@@ -5510,7 +6570,7 @@ type ProductResponse struct {
 
 func (x *ProductResponse) Reset() {
 	*x = ProductResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[65]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5522,7 +6582,7 @@ func (x *ProductResponse) String() string {
 func (*ProductResponse) ProtoMessage() {}
 
 func (x *ProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[65]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5535,7 +6595,7 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
 func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{65}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ProductResponse) GetProduct() *Product {
@@ -5557,7 +6617,7 @@ type GetProductsBySKUsRequest struct {
 
 func (x *GetProductsBySKUsRequest) Reset() {
 	*x = GetProductsBySKUsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[66]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5569,7 +6629,7 @@ func (x *GetProductsBySKUsRequest) String() string {
 func (*GetProductsBySKUsRequest) ProtoMessage() {}
 
 func (x *GetProductsBySKUsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[66]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5582,7 +6642,7 @@ func (x *GetProductsBySKUsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsBySKUsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsBySKUsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{66}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetProductsBySKUsRequest) GetSkus() []string {
@@ -5617,7 +6677,7 @@ type ProductsResponse struct {
 
 func (x *ProductsResponse) Reset() {
 	*x = ProductsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[67]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5629,7 +6689,7 @@ func (x *ProductsResponse) String() string {
 func (*ProductsResponse) ProtoMessage() {}
 
 func (x *ProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[67]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5642,7 +6702,7 @@ func (x *ProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductsResponse.ProtoReflect.Descriptor instead.
 func (*ProductsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{67}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ProductsResponse) GetProducts() []*Product {
@@ -5671,7 +6731,7 @@ type GetProductsByIDsRequest struct {
 
 func (x *GetProductsByIDsRequest) Reset() {
 	*x = GetProductsByIDsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[68]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5683,7 +6743,7 @@ func (x *GetProductsByIDsRequest) String() string {
 func (*GetProductsByIDsRequest) ProtoMessage() {}
 
 func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[68]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5696,7 +6756,7 @@ func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsByIDsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{68}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetProductsByIDsRequest) GetProductIds() []int64 {
@@ -5733,7 +6793,7 @@ type ListProductsRequest struct {
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[69]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5745,7 +6805,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[69]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5758,7 +6818,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{69}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ListProductsRequest) GetStorefrontId() int64 {
@@ -5800,7 +6860,7 @@ type GetVariantRequest struct {
 
 func (x *GetVariantRequest) Reset() {
 	*x = GetVariantRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[70]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5812,7 +6872,7 @@ func (x *GetVariantRequest) String() string {
 func (*GetVariantRequest) ProtoMessage() {}
 
 func (x *GetVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[70]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5825,7 +6885,7 @@ func (x *GetVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVariantRequest.ProtoReflect.Descriptor instead.
 func (*GetVariantRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{70}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetVariantRequest) GetVariantId() int64 {
@@ -5852,7 +6912,7 @@ type VariantResponse struct {
 
 func (x *VariantResponse) Reset() {
 	*x = VariantResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[71]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5864,7 +6924,7 @@ func (x *VariantResponse) String() string {
 func (*VariantResponse) ProtoMessage() {}
 
 func (x *VariantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[71]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5877,7 +6937,7 @@ func (x *VariantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariantResponse.ProtoReflect.Descriptor instead.
 func (*VariantResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{71}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *VariantResponse) GetVariant() *ProductVariant {
@@ -5898,7 +6958,7 @@ type GetVariantsByProductIDRequest struct {
 
 func (x *GetVariantsByProductIDRequest) Reset() {
 	*x = GetVariantsByProductIDRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[72]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5910,7 +6970,7 @@ func (x *GetVariantsByProductIDRequest) String() string {
 func (*GetVariantsByProductIDRequest) ProtoMessage() {}
 
 func (x *GetVariantsByProductIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[72]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5923,7 +6983,7 @@ func (x *GetVariantsByProductIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVariantsByProductIDRequest.ProtoReflect.Descriptor instead.
 func (*GetVariantsByProductIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{72}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetVariantsByProductIDRequest) GetProductId() int64 {
@@ -5950,7 +7010,7 @@ type ProductVariantsResponse struct {
 
 func (x *ProductVariantsResponse) Reset() {
 	*x = ProductVariantsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[73]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5962,7 +7022,7 @@ func (x *ProductVariantsResponse) String() string {
 func (*ProductVariantsResponse) ProtoMessage() {}
 
 func (x *ProductVariantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[73]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5975,7 +7035,7 @@ func (x *ProductVariantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductVariantsResponse.ProtoReflect.Descriptor instead.
 func (*ProductVariantsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{73}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ProductVariantsResponse) GetVariants() []*ProductVariant {
@@ -5997,7 +7057,7 @@ type StockItem struct {
 
 func (x *StockItem) Reset() {
 	*x = StockItem{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[74]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6009,7 +7069,7 @@ func (x *StockItem) String() string {
 func (*StockItem) ProtoMessage() {}
 
 func (x *StockItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[74]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6022,7 +7082,7 @@ func (x *StockItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
 func (*StockItem) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{74}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *StockItem) GetProductId() int64 {
@@ -6061,7 +7121,7 @@ type StockResult struct {
 
 func (x *StockResult) Reset() {
 	*x = StockResult{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[75]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6073,7 +7133,7 @@ func (x *StockResult) String() string {
 func (*StockResult) ProtoMessage() {}
 
 func (x *StockResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[75]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6086,7 +7146,7 @@ func (x *StockResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockResult.ProtoReflect.Descriptor instead.
 func (*StockResult) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{75}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *StockResult) GetProductId() int64 {
@@ -6142,7 +7202,7 @@ type DecrementStockRequest struct {
 
 func (x *DecrementStockRequest) Reset() {
 	*x = DecrementStockRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[76]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6154,7 +7214,7 @@ func (x *DecrementStockRequest) String() string {
 func (*DecrementStockRequest) ProtoMessage() {}
 
 func (x *DecrementStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[76]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6167,7 +7227,7 @@ func (x *DecrementStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecrementStockRequest.ProtoReflect.Descriptor instead.
 func (*DecrementStockRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{76}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *DecrementStockRequest) GetItems() []*StockItem {
@@ -6196,7 +7256,7 @@ type DecrementStockResponse struct {
 
 func (x *DecrementStockResponse) Reset() {
 	*x = DecrementStockResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[77]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6208,7 +7268,7 @@ func (x *DecrementStockResponse) String() string {
 func (*DecrementStockResponse) ProtoMessage() {}
 
 func (x *DecrementStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[77]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6221,7 +7281,7 @@ func (x *DecrementStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecrementStockResponse.ProtoReflect.Descriptor instead.
 func (*DecrementStockResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{77}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *DecrementStockResponse) GetSuccess() bool {
@@ -6256,7 +7316,7 @@ type RollbackStockRequest struct {
 
 func (x *RollbackStockRequest) Reset() {
 	*x = RollbackStockRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[78]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6268,7 +7328,7 @@ func (x *RollbackStockRequest) String() string {
 func (*RollbackStockRequest) ProtoMessage() {}
 
 func (x *RollbackStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[78]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6281,7 +7341,7 @@ func (x *RollbackStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackStockRequest.ProtoReflect.Descriptor instead.
 func (*RollbackStockRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{78}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *RollbackStockRequest) GetItems() []*StockItem {
@@ -6310,7 +7370,7 @@ type RollbackStockResponse struct {
 
 func (x *RollbackStockResponse) Reset() {
 	*x = RollbackStockResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[79]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6322,7 +7382,7 @@ func (x *RollbackStockResponse) String() string {
 func (*RollbackStockResponse) ProtoMessage() {}
 
 func (x *RollbackStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[79]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6335,7 +7395,7 @@ func (x *RollbackStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackStockResponse.ProtoReflect.Descriptor instead.
 func (*RollbackStockResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{79}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RollbackStockResponse) GetSuccess() bool {
@@ -6369,7 +7429,7 @@ type CheckStockAvailabilityRequest struct {
 
 func (x *CheckStockAvailabilityRequest) Reset() {
 	*x = CheckStockAvailabilityRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[80]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6381,7 +7441,7 @@ func (x *CheckStockAvailabilityRequest) String() string {
 func (*CheckStockAvailabilityRequest) ProtoMessage() {}
 
 func (x *CheckStockAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[80]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6394,7 +7454,7 @@ func (x *CheckStockAvailabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStockAvailabilityRequest.ProtoReflect.Descriptor instead.
 func (*CheckStockAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{80}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CheckStockAvailabilityRequest) GetItems() []*StockItem {
@@ -6418,7 +7478,7 @@ type StockAvailability struct {
 
 func (x *StockAvailability) Reset() {
 	*x = StockAvailability{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[81]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6430,7 +7490,7 @@ func (x *StockAvailability) String() string {
 func (*StockAvailability) ProtoMessage() {}
 
 func (x *StockAvailability) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[81]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6443,7 +7503,7 @@ func (x *StockAvailability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockAvailability.ProtoReflect.Descriptor instead.
 func (*StockAvailability) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{81}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *StockAvailability) GetProductId() int64 {
@@ -6492,7 +7552,7 @@ type CheckStockAvailabilityResponse struct {
 
 func (x *CheckStockAvailabilityResponse) Reset() {
 	*x = CheckStockAvailabilityResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[82]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6504,7 +7564,7 @@ func (x *CheckStockAvailabilityResponse) String() string {
 func (*CheckStockAvailabilityResponse) ProtoMessage() {}
 
 func (x *CheckStockAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[82]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6517,7 +7577,7 @@ func (x *CheckStockAvailabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStockAvailabilityResponse.ProtoReflect.Descriptor instead.
 func (*CheckStockAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{82}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *CheckStockAvailabilityResponse) GetAllAvailable() bool {
@@ -6562,7 +7622,7 @@ type CreateProductRequest struct {
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[83]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6574,7 +7634,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[83]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6587,7 +7647,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{83}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *CreateProductRequest) GetStorefrontId() int64 {
@@ -6746,7 +7806,7 @@ type UpdateProductRequest struct {
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[84]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6758,7 +7818,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[84]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6771,7 +7831,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{84}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *UpdateProductRequest) GetProductId() int64 {
@@ -6912,7 +7972,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[85]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6924,7 +7984,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[85]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6937,7 +7997,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{85}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *DeleteProductRequest) GetProductId() int64 {
@@ -6973,7 +8033,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[86]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6985,7 +8045,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[86]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6998,7 +8058,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{86}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *DeleteProductResponse) GetSuccess() bool {
@@ -7049,7 +8109,7 @@ type ProductInput struct {
 
 func (x *ProductInput) Reset() {
 	*x = ProductInput{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[87]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7061,7 +8121,7 @@ func (x *ProductInput) String() string {
 func (*ProductInput) ProtoMessage() {}
 
 func (x *ProductInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[87]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7074,7 +8134,7 @@ func (x *ProductInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductInput.ProtoReflect.Descriptor instead.
 func (*ProductInput) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{87}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ProductInput) GetName() string {
@@ -7207,7 +8267,7 @@ type BulkCreateProductsRequest struct {
 
 func (x *BulkCreateProductsRequest) Reset() {
 	*x = BulkCreateProductsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[88]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7219,7 +8279,7 @@ func (x *BulkCreateProductsRequest) String() string {
 func (*BulkCreateProductsRequest) ProtoMessage() {}
 
 func (x *BulkCreateProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[88]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7232,7 +8292,7 @@ func (x *BulkCreateProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateProductsRequest.ProtoReflect.Descriptor instead.
 func (*BulkCreateProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{88}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *BulkCreateProductsRequest) GetStorefrontId() int64 {
@@ -7262,7 +8322,7 @@ type BulkCreateProductsResponse struct {
 
 func (x *BulkCreateProductsResponse) Reset() {
 	*x = BulkCreateProductsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[89]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7274,7 +8334,7 @@ func (x *BulkCreateProductsResponse) String() string {
 func (*BulkCreateProductsResponse) ProtoMessage() {}
 
 func (x *BulkCreateProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[89]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7287,7 +8347,7 @@ func (x *BulkCreateProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateProductsResponse.ProtoReflect.Descriptor instead.
 func (*BulkCreateProductsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{89}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *BulkCreateProductsResponse) GetProducts() []*Product {
@@ -7338,7 +8398,7 @@ type ProductUpdateInput struct {
 
 func (x *ProductUpdateInput) Reset() {
 	*x = ProductUpdateInput{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[90]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7350,7 +8410,7 @@ func (x *ProductUpdateInput) String() string {
 func (*ProductUpdateInput) ProtoMessage() {}
 
 func (x *ProductUpdateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[90]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7363,7 +8423,7 @@ func (x *ProductUpdateInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductUpdateInput.ProtoReflect.Descriptor instead.
 func (*ProductUpdateInput) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{90}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ProductUpdateInput) GetProductId() int64 {
@@ -7454,7 +8514,7 @@ type BulkUpdateProductsRequest struct {
 
 func (x *BulkUpdateProductsRequest) Reset() {
 	*x = BulkUpdateProductsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[91]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7466,7 +8526,7 @@ func (x *BulkUpdateProductsRequest) String() string {
 func (*BulkUpdateProductsRequest) ProtoMessage() {}
 
 func (x *BulkUpdateProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[91]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7479,7 +8539,7 @@ func (x *BulkUpdateProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkUpdateProductsRequest.ProtoReflect.Descriptor instead.
 func (*BulkUpdateProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{91}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *BulkUpdateProductsRequest) GetStorefrontId() int64 {
@@ -7509,7 +8569,7 @@ type BulkUpdateProductsResponse struct {
 
 func (x *BulkUpdateProductsResponse) Reset() {
 	*x = BulkUpdateProductsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[92]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7521,7 +8581,7 @@ func (x *BulkUpdateProductsResponse) String() string {
 func (*BulkUpdateProductsResponse) ProtoMessage() {}
 
 func (x *BulkUpdateProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[92]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7534,7 +8594,7 @@ func (x *BulkUpdateProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkUpdateProductsResponse.ProtoReflect.Descriptor instead.
 func (*BulkUpdateProductsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{92}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *BulkUpdateProductsResponse) GetProducts() []*Product {
@@ -7577,7 +8637,7 @@ type BulkDeleteProductsRequest struct {
 
 func (x *BulkDeleteProductsRequest) Reset() {
 	*x = BulkDeleteProductsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[93]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7589,7 +8649,7 @@ func (x *BulkDeleteProductsRequest) String() string {
 func (*BulkDeleteProductsRequest) ProtoMessage() {}
 
 func (x *BulkDeleteProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[93]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7602,7 +8662,7 @@ func (x *BulkDeleteProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkDeleteProductsRequest.ProtoReflect.Descriptor instead.
 func (*BulkDeleteProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{93}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *BulkDeleteProductsRequest) GetStorefrontId() int64 {
@@ -7639,7 +8699,7 @@ type BulkDeleteProductsResponse struct {
 
 func (x *BulkDeleteProductsResponse) Reset() {
 	*x = BulkDeleteProductsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[94]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7651,7 +8711,7 @@ func (x *BulkDeleteProductsResponse) String() string {
 func (*BulkDeleteProductsResponse) ProtoMessage() {}
 
 func (x *BulkDeleteProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[94]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7664,7 +8724,7 @@ func (x *BulkDeleteProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkDeleteProductsResponse.ProtoReflect.Descriptor instead.
 func (*BulkDeleteProductsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{94}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *BulkDeleteProductsResponse) GetSuccessfulCount() int32 {
@@ -7708,7 +8768,7 @@ type BulkOperationError struct {
 
 func (x *BulkOperationError) Reset() {
 	*x = BulkOperationError{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[95]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7720,7 +8780,7 @@ func (x *BulkOperationError) String() string {
 func (*BulkOperationError) ProtoMessage() {}
 
 func (x *BulkOperationError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[95]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7733,7 +8793,7 @@ func (x *BulkOperationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkOperationError.ProtoReflect.Descriptor instead.
 func (*BulkOperationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{95}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *BulkOperationError) GetIndex() int32 {
@@ -7787,7 +8847,7 @@ type CreateProductVariantRequest struct {
 
 func (x *CreateProductVariantRequest) Reset() {
 	*x = CreateProductVariantRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[96]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7799,7 +8859,7 @@ func (x *CreateProductVariantRequest) String() string {
 func (*CreateProductVariantRequest) ProtoMessage() {}
 
 func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[96]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7812,7 +8872,7 @@ func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{96}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *CreateProductVariantRequest) GetProductId() int64 {
@@ -7938,7 +8998,7 @@ type UpdateProductVariantRequest struct {
 
 func (x *UpdateProductVariantRequest) Reset() {
 	*x = UpdateProductVariantRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[97]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7950,7 +9010,7 @@ func (x *UpdateProductVariantRequest) String() string {
 func (*UpdateProductVariantRequest) ProtoMessage() {}
 
 func (x *UpdateProductVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[97]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7963,7 +9023,7 @@ func (x *UpdateProductVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductVariantRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductVariantRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{97}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *UpdateProductVariantRequest) GetVariantId() int64 {
@@ -8090,7 +9150,7 @@ type DeleteProductVariantRequest struct {
 
 func (x *DeleteProductVariantRequest) Reset() {
 	*x = DeleteProductVariantRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[98]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8102,7 +9162,7 @@ func (x *DeleteProductVariantRequest) String() string {
 func (*DeleteProductVariantRequest) ProtoMessage() {}
 
 func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[98]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8115,7 +9175,7 @@ func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductVariantRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductVariantRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{98}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *DeleteProductVariantRequest) GetVariantId() int64 {
@@ -8150,7 +9210,7 @@ type DeleteProductVariantResponse struct {
 
 func (x *DeleteProductVariantResponse) Reset() {
 	*x = DeleteProductVariantResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[99]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8162,7 +9222,7 @@ func (x *DeleteProductVariantResponse) String() string {
 func (*DeleteProductVariantResponse) ProtoMessage() {}
 
 func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[99]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8175,7 +9235,7 @@ func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductVariantResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductVariantResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{99}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *DeleteProductVariantResponse) GetSuccess() bool {
@@ -8213,7 +9273,7 @@ type ProductVariantInput struct {
 
 func (x *ProductVariantInput) Reset() {
 	*x = ProductVariantInput{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[100]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8225,7 +9285,7 @@ func (x *ProductVariantInput) String() string {
 func (*ProductVariantInput) ProtoMessage() {}
 
 func (x *ProductVariantInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[100]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8238,7 +9298,7 @@ func (x *ProductVariantInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductVariantInput.ProtoReflect.Descriptor instead.
 func (*ProductVariantInput) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{100}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ProductVariantInput) GetSku() string {
@@ -8337,7 +9397,7 @@ type BulkCreateProductVariantsRequest struct {
 
 func (x *BulkCreateProductVariantsRequest) Reset() {
 	*x = BulkCreateProductVariantsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[101]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8349,7 +9409,7 @@ func (x *BulkCreateProductVariantsRequest) String() string {
 func (*BulkCreateProductVariantsRequest) ProtoMessage() {}
 
 func (x *BulkCreateProductVariantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[101]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8362,7 +9422,7 @@ func (x *BulkCreateProductVariantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateProductVariantsRequest.ProtoReflect.Descriptor instead.
 func (*BulkCreateProductVariantsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{101}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *BulkCreateProductVariantsRequest) GetProductId() int64 {
@@ -8399,7 +9459,7 @@ type BulkCreateProductVariantsResponse struct {
 
 func (x *BulkCreateProductVariantsResponse) Reset() {
 	*x = BulkCreateProductVariantsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[102]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8411,7 +9471,7 @@ func (x *BulkCreateProductVariantsResponse) String() string {
 func (*BulkCreateProductVariantsResponse) ProtoMessage() {}
 
 func (x *BulkCreateProductVariantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[102]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8424,7 +9484,7 @@ func (x *BulkCreateProductVariantsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BulkCreateProductVariantsResponse.ProtoReflect.Descriptor instead.
 func (*BulkCreateProductVariantsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{102}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *BulkCreateProductVariantsResponse) GetVariants() []*ProductVariant {
@@ -8472,7 +9532,7 @@ type RecordInventoryMovementRequest struct {
 
 func (x *RecordInventoryMovementRequest) Reset() {
 	*x = RecordInventoryMovementRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[103]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8484,7 +9544,7 @@ func (x *RecordInventoryMovementRequest) String() string {
 func (*RecordInventoryMovementRequest) ProtoMessage() {}
 
 func (x *RecordInventoryMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[103]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8497,7 +9557,7 @@ func (x *RecordInventoryMovementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordInventoryMovementRequest.ProtoReflect.Descriptor instead.
 func (*RecordInventoryMovementRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{103}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *RecordInventoryMovementRequest) GetStorefrontId() int64 {
@@ -8569,7 +9629,7 @@ type RecordInventoryMovementResponse struct {
 
 func (x *RecordInventoryMovementResponse) Reset() {
 	*x = RecordInventoryMovementResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[104]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8581,7 +9641,7 @@ func (x *RecordInventoryMovementResponse) String() string {
 func (*RecordInventoryMovementResponse) ProtoMessage() {}
 
 func (x *RecordInventoryMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[104]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8594,7 +9654,7 @@ func (x *RecordInventoryMovementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordInventoryMovementResponse.ProtoReflect.Descriptor instead.
 func (*RecordInventoryMovementResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{104}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *RecordInventoryMovementResponse) GetSuccess() bool {
@@ -8638,7 +9698,7 @@ type StockUpdateItem struct {
 
 func (x *StockUpdateItem) Reset() {
 	*x = StockUpdateItem{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[105]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8650,7 +9710,7 @@ func (x *StockUpdateItem) String() string {
 func (*StockUpdateItem) ProtoMessage() {}
 
 func (x *StockUpdateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[105]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8663,7 +9723,7 @@ func (x *StockUpdateItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockUpdateItem.ProtoReflect.Descriptor instead.
 func (*StockUpdateItem) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{105}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *StockUpdateItem) GetProductId() int64 {
@@ -8707,7 +9767,7 @@ type BatchUpdateStockRequest struct {
 
 func (x *BatchUpdateStockRequest) Reset() {
 	*x = BatchUpdateStockRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[106]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8719,7 +9779,7 @@ func (x *BatchUpdateStockRequest) String() string {
 func (*BatchUpdateStockRequest) ProtoMessage() {}
 
 func (x *BatchUpdateStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[106]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8732,7 +9792,7 @@ func (x *BatchUpdateStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUpdateStockRequest.ProtoReflect.Descriptor instead.
 func (*BatchUpdateStockRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{106}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *BatchUpdateStockRequest) GetStorefrontId() int64 {
@@ -8778,7 +9838,7 @@ type StockUpdateResult struct {
 
 func (x *StockUpdateResult) Reset() {
 	*x = StockUpdateResult{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[107]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8790,7 +9850,7 @@ func (x *StockUpdateResult) String() string {
 func (*StockUpdateResult) ProtoMessage() {}
 
 func (x *StockUpdateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[107]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8803,7 +9863,7 @@ func (x *StockUpdateResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockUpdateResult.ProtoReflect.Descriptor instead.
 func (*StockUpdateResult) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{107}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *StockUpdateResult) GetProductId() int64 {
@@ -8860,7 +9920,7 @@ type BatchUpdateStockResponse struct {
 
 func (x *BatchUpdateStockResponse) Reset() {
 	*x = BatchUpdateStockResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[108]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8872,7 +9932,7 @@ func (x *BatchUpdateStockResponse) String() string {
 func (*BatchUpdateStockResponse) ProtoMessage() {}
 
 func (x *BatchUpdateStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[108]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8885,7 +9945,7 @@ func (x *BatchUpdateStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUpdateStockResponse.ProtoReflect.Descriptor instead.
 func (*BatchUpdateStockResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{108}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *BatchUpdateStockResponse) GetSuccessfulCount() int32 {
@@ -8919,7 +9979,7 @@ type GetProductStatsRequest struct {
 
 func (x *GetProductStatsRequest) Reset() {
 	*x = GetProductStatsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[109]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8931,7 +9991,7 @@ func (x *GetProductStatsRequest) String() string {
 func (*GetProductStatsRequest) ProtoMessage() {}
 
 func (x *GetProductStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[109]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8944,7 +10004,7 @@ func (x *GetProductStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{109}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *GetProductStatsRequest) GetStorefrontId() int64 {
@@ -8961,15 +10021,17 @@ type ProductStats struct {
 	ActiveProducts int32                  `protobuf:"varint,2,opt,name=active_products,json=activeProducts,proto3" json:"active_products,omitempty"`
 	OutOfStock     int32                  `protobuf:"varint,3,opt,name=out_of_stock,json=outOfStock,proto3" json:"out_of_stock,omitempty"`
 	LowStock       int32                  `protobuf:"varint,4,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
-	TotalValue     float64                `protobuf:"fixed64,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"` // Sum of (price * stock_quantity) for all products
-	TotalSold      int32                  `protobuf:"varint,6,opt,name=total_sold,json=totalSold,proto3" json:"total_sold,omitempty"`     // Sum of sold_count for all products
+	TotalValue     float64                `protobuf:"fixed64,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`   // Sum of (price * stock_quantity) for all products
+	TotalSold      int32                  `protobuf:"varint,6,opt,name=total_sold,json=totalSold,proto3" json:"total_sold,omitempty"`       // Sum of sold_count for all products
+	UniqueViews    int64                  `protobuf:"varint,7,opt,name=unique_views,json=uniqueViews,proto3" json:"unique_views,omitempty"` // Deduplicated views (one per viewer per window, crawlers excluded)
+	TotalViews     int64                  `protobuf:"varint,8,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`    // All human views including repeats
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProductStats) Reset() {
 	*x = ProductStats{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[110]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8981,7 +10043,7 @@ func (x *ProductStats) String() string {
 func (*ProductStats) ProtoMessage() {}
 
 func (x *ProductStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[110]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8994,7 +10056,7 @@ func (x *ProductStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductStats.ProtoReflect.Descriptor instead.
 func (*ProductStats) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{110}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *ProductStats) GetTotalProducts() int32 {
@@ -9039,6 +10101,20 @@ func (x *ProductStats) GetTotalSold() int32 {
 	return 0
 }
 
+func (x *ProductStats) GetUniqueViews() int64 {
+	if x != nil {
+		return x.UniqueViews
+	}
+	return 0
+}
+
+func (x *ProductStats) GetTotalViews() int64 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
 // GetProductStatsResponse returns product statistics
 type GetProductStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -9049,7 +10125,7 @@ type GetProductStatsResponse struct {
 
 func (x *GetProductStatsResponse) Reset() {
 	*x = GetProductStatsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[111]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9061,7 +10137,7 @@ func (x *GetProductStatsResponse) String() string {
 func (*GetProductStatsResponse) ProtoMessage() {}
 
 func (x *GetProductStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[111]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9074,7 +10150,7 @@ func (x *GetProductStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetProductStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{111}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *GetProductStatsResponse) GetStats() *ProductStats {
@@ -9087,14 +10163,16 @@ func (x *GetProductStatsResponse) GetStats() *ProductStats {
 // IncrementProductViewsRequest increments view counter
 type IncrementProductViewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Required
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`      // Required
+	SessionId     *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // Anonymous viewers: deduplication and recently viewed list
+	UserAgent     *string                `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"` // Viewer's browser user agent (crawlers are not counted)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IncrementProductViewsRequest) Reset() {
 	*x = IncrementProductViewsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[112]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9106,7 +10184,7 @@ func (x *IncrementProductViewsRequest) String() string {
 func (*IncrementProductViewsRequest) ProtoMessage() {}
 
 func (x *IncrementProductViewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[112]
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9119,7 +10197,7 @@ func (x *IncrementProductViewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrementProductViewsRequest.ProtoReflect.Descriptor instead.
 func (*IncrementProductViewsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{112}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *IncrementProductViewsRequest) GetProductId() int64 {
@@ -9129,30 +10207,43 @@ func (x *IncrementProductViewsRequest) GetProductId() int64 {
 	return 0
 }
 
-// ReindexAllRequest requests full reindexing of products
-type ReindexAllRequest struct {
+func (x *IncrementProductViewsRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *IncrementProductViewsRequest) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+type GetRecentlyViewedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceType    *string                `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3,oneof" json:"source_type,omitempty"` // Filter: "c2c", "b2c", or empty for all
-	BatchSize     *int32                 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`   // Batch size for processing (default: 1000)
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // Required for anonymous callers
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Default 20, max 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReindexAllRequest) Reset() {
-	*x = ReindexAllRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[113]
+func (x *GetRecentlyViewedRequest) Reset() {
+	*x = GetRecentlyViewedRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexAllRequest) String() string {
+func (x *GetRecentlyViewedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexAllRequest) ProtoMessage() {}
+func (*GetRecentlyViewedRequest) ProtoMessage() {}
 
-func (x *ReindexAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[113]
+func (x *GetRecentlyViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9163,51 +10254,49 @@ func (x *ReindexAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexAllRequest.ProtoReflect.Descriptor instead.
-func (*ReindexAllRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use GetRecentlyViewedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *ReindexAllRequest) GetSourceType() string {
-	if x != nil && x.SourceType != nil {
-		return *x.SourceType
+func (x *GetRecentlyViewedRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
 	}
 	return ""
 }
 
-func (x *ReindexAllRequest) GetBatchSize() int32 {
-	if x != nil && x.BatchSize != nil {
-		return *x.BatchSize
+func (x *GetRecentlyViewedRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-// ReindexAllResponse returns reindexing statistics
-type ReindexAllResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TotalIndexed    int32                  `protobuf:"varint,1,opt,name=total_indexed,json=totalIndexed,proto3" json:"total_indexed,omitempty"`          // Total successfully indexed products
-	TotalFailed     int32                  `protobuf:"varint,2,opt,name=total_failed,json=totalFailed,proto3" json:"total_failed,omitempty"`             // Total failed products
-	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Total operation duration in seconds
-	Errors          []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                                           // Sample error messages (max 10)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RecentlyViewedListing is a listing with the time the caller last viewed it
+type RecentlyViewedListing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	ViewedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReindexAllResponse) Reset() {
-	*x = ReindexAllResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[114]
+func (x *RecentlyViewedListing) Reset() {
+	*x = RecentlyViewedListing{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexAllResponse) String() string {
+func (x *RecentlyViewedListing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexAllResponse) ProtoMessage() {}
+func (*RecentlyViewedListing) ProtoMessage() {}
 
-func (x *ReindexAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[114]
+func (x *RecentlyViewedListing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9218,119 +10307,331 @@ func (x *ReindexAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexAllResponse.ProtoReflect.Descriptor instead.
-func (*ReindexAllResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *ReindexAllResponse) GetTotalIndexed() int32 {
-	if x != nil {
-		return x.TotalIndexed
-	}
-	return 0
-}
-
-func (x *ReindexAllResponse) GetTotalFailed() int32 {
-	if x != nil {
-		return x.TotalFailed
-	}
-	return 0
+// Deprecated: Use RecentlyViewedListing.ProtoReflect.Descriptor instead.
+func (*RecentlyViewedListing) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *ReindexAllResponse) GetDurationSeconds() int32 {
+func (x *RecentlyViewedListing) GetListing() *Listing {
 	if x != nil {
-		return x.DurationSeconds
+		return x.Listing
 	}
-	return 0
+	return nil
 }
 
-func (x *ReindexAllResponse) GetErrors() []string {
+func (x *RecentlyViewedListing) GetViewedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Errors
+		return x.ViewedAt
 	}
 	return nil
 }
 
-// StorefrontFull represents complete storefront entity (45 fields from b2c_stores)
-type StorefrontFull struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Identification
-	Id          int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId      int64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner
-	Slug        string  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                    // Unique URL-slug
-	Name        string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Branding
-	LogoUrl   *string          `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
-	BannerUrl *string          `protobuf:"bytes,7,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
-	Theme     *structpb.Struct `protobuf:"bytes,8,opt,name=theme,proto3,oneof" json:"theme,omitempty"` // JSON: {"layout": "grid", "primaryColor": "#1976d2"}
-	// Contact information
-	Phone   *string `protobuf:"bytes,9,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email   *string `protobuf:"bytes,10,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Website *string `protobuf:"bytes,11,opt,name=website,proto3,oneof" json:"website,omitempty"`
-	// Location (address)
-	Address             *string               `protobuf:"bytes,12,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	City                *string               `protobuf:"bytes,13,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	PostalCode          *string               `protobuf:"bytes,14,opt,name=postal_code,json=postalCode,proto3,oneof" json:"postal_code,omitempty"`
-	Country             *string               `protobuf:"bytes,15,opt,name=country,proto3,oneof" json:"country,omitempty"`       // VARCHAR(100), default 'RS'
-	Latitude            *float64              `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`   // NUMERIC(10,8)
-	Longitude           *float64              `protobuf:"fixed64,17,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"` // NUMERIC(11,8)
-	FormattedAddress    *string               `protobuf:"bytes,18,opt,name=formatted_address,json=formattedAddress,proto3,oneof" json:"formatted_address,omitempty"`
-	GeoStrategy         StorefrontGeoStrategy `protobuf:"varint,19,opt,name=geo_strategy,json=geoStrategy,proto3,enum=listingssvc.v1.StorefrontGeoStrategy" json:"geo_strategy,omitempty"`                          // default: storefront_location
-	DefaultPrivacyLevel LocationPrivacyLevel  `protobuf:"varint,20,opt,name=default_privacy_level,json=defaultPrivacyLevel,proto3,enum=listingssvc.v1.LocationPrivacyLevel" json:"default_privacy_level,omitempty"` // default: exact
-	AddressVerified     bool                  `protobuf:"varint,21,opt,name=address_verified,json=addressVerified,proto3" json:"address_verified,omitempty"`                                                        // default: false
-	// Settings
-	Settings *structpb.Struct `protobuf:"bytes,22,opt,name=settings,proto3,oneof" json:"settings,omitempty"`              // JSON
-	SeoMeta  *structpb.Struct `protobuf:"bytes,23,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"` // JSON
-	// Status and statistics
-	IsActive         bool                   `protobuf:"varint,24,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`       // default: true
-	IsVerified       bool                   `protobuf:"varint,25,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"` // default: false
-	VerificationDate *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=verification_date,json=verificationDate,proto3,oneof" json:"verification_date,omitempty"`
-	Rating           float64                `protobuf:"fixed64,27,opt,name=rating,proto3" json:"rating,omitempty"`                                   // NUMERIC(3,2), default: 0.00
-	ReviewsCount     int32                  `protobuf:"varint,28,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`    // default: 0
-	ProductsCount    int32                  `protobuf:"varint,29,opt,name=products_count,json=productsCount,proto3" json:"products_count,omitempty"` // default: 0
-	SalesCount       int32                  `protobuf:"varint,30,opt,name=sales_count,json=salesCount,proto3" json:"sales_count,omitempty"`          // default: 0
-	ViewsCount       int32                  `protobuf:"varint,31,opt,name=views_count,json=viewsCount,proto3" json:"views_count,omitempty"`          // default: 0
-	// Subscription (monetization)
-	SubscriptionPlan      SubscriptionPlanType   `protobuf:"varint,32,opt,name=subscription_plan,json=subscriptionPlan,proto3,enum=listingssvc.v1.SubscriptionPlanType" json:"subscription_plan,omitempty"` // default: starter
-	SubscriptionExpiresAt *timestamppb.Timestamp `protobuf:"bytes,33,opt,name=subscription_expires_at,json=subscriptionExpiresAt,proto3,oneof" json:"subscription_expires_at,omitempty"`
-	CommissionRate        float64                `protobuf:"fixed64,34,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"` // NUMERIC(5,2), default: 3.00
-	SubscriptionId        *int64                 `protobuf:"varint,35,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
-	IsSubscriptionActive  bool                   `protobuf:"varint,36,opt,name=is_subscription_active,json=isSubscriptionActive,proto3" json:"is_subscription_active,omitempty"` // default: true
-	// AI and killer features
-	AiAgentEnabled      bool             `protobuf:"varint,37,opt,name=ai_agent_enabled,json=aiAgentEnabled,proto3" json:"ai_agent_enabled,omitempty"`                // default: false
-	AiAgentConfig       *structpb.Struct `protobuf:"bytes,38,opt,name=ai_agent_config,json=aiAgentConfig,proto3,oneof" json:"ai_agent_config,omitempty"`              // JSON
-	LiveShoppingEnabled bool             `protobuf:"varint,39,opt,name=live_shopping_enabled,json=liveShoppingEnabled,proto3" json:"live_shopping_enabled,omitempty"` // default: false
-	GroupBuyingEnabled  bool             `protobuf:"varint,40,opt,name=group_buying_enabled,json=groupBuyingEnabled,proto3" json:"group_buying_enabled,omitempty"`    // default: false
-	// Social features
-	FollowersCount int32 `protobuf:"varint,41,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"` // default: 0
-	// Timestamps
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,42,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,43,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Related entities (optionally loaded)
-	Staff           []*StorefrontStaff          `protobuf:"bytes,44,rep,name=staff,proto3" json:"staff,omitempty"`
-	Hours           []*StorefrontHours          `protobuf:"bytes,45,rep,name=hours,proto3" json:"hours,omitempty"`
-	PaymentMethods  []*StorefrontPaymentMethod  `protobuf:"bytes,46,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
-	DeliveryOptions []*StorefrontDeliveryOption `protobuf:"bytes,47,rep,name=delivery_options,json=deliveryOptions,proto3" json:"delivery_options,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetRecentlyViewedResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*RecentlyViewedListing `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontFull) Reset() {
-	*x = StorefrontFull{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[115]
+func (x *GetRecentlyViewedResponse) Reset() {
+	*x = GetRecentlyViewedResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontFull) String() string {
+func (x *GetRecentlyViewedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorefrontFull) ProtoMessage() {}
+func (*GetRecentlyViewedResponse) ProtoMessage() {}
 
-func (x *StorefrontFull) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[115]
+func (x *GetRecentlyViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetRecentlyViewedResponse) GetItems() []*RecentlyViewedListing {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClearRecentlyViewedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`  // Required for anonymous callers
+	ListingId     *int64                 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3,oneof" json:"listing_id,omitempty"` // Remove a single listing; unset clears the whole history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearRecentlyViewedRequest) Reset() {
+	*x = ClearRecentlyViewedRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearRecentlyViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentlyViewedRequest) ProtoMessage() {}
+
+func (x *ClearRecentlyViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentlyViewedRequest.ProtoReflect.Descriptor instead.
+func (*ClearRecentlyViewedRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ClearRecentlyViewedRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *ClearRecentlyViewedRequest) GetListingId() int64 {
+	if x != nil && x.ListingId != nil {
+		return *x.ListingId
+	}
+	return 0
+}
+
+// ReindexAllRequest requests full reindexing of products
+type ReindexAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceType    *string                `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3,oneof" json:"source_type,omitempty"` // Filter: "c2c", "b2c", or empty for all
+	BatchSize     *int32                 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`   // Batch size for processing (default: 1000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexAllRequest) Reset() {
+	*x = ReindexAllRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexAllRequest) ProtoMessage() {}
+
+func (x *ReindexAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexAllRequest.ProtoReflect.Descriptor instead.
+func (*ReindexAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ReindexAllRequest) GetSourceType() string {
+	if x != nil && x.SourceType != nil {
+		return *x.SourceType
+	}
+	return ""
+}
+
+func (x *ReindexAllRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+// ReindexAllResponse returns reindexing statistics
+type ReindexAllResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalIndexed    int32                  `protobuf:"varint,1,opt,name=total_indexed,json=totalIndexed,proto3" json:"total_indexed,omitempty"`          // Total successfully indexed products
+	TotalFailed     int32                  `protobuf:"varint,2,opt,name=total_failed,json=totalFailed,proto3" json:"total_failed,omitempty"`             // Total failed products
+	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Total operation duration in seconds
+	Errors          []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                                           // Sample error messages (max 10)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReindexAllResponse) Reset() {
+	*x = ReindexAllResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexAllResponse) ProtoMessage() {}
+
+func (x *ReindexAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexAllResponse.ProtoReflect.Descriptor instead.
+func (*ReindexAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ReindexAllResponse) GetTotalIndexed() int32 {
+	if x != nil {
+		return x.TotalIndexed
+	}
+	return 0
+}
+
+func (x *ReindexAllResponse) GetTotalFailed() int32 {
+	if x != nil {
+		return x.TotalFailed
+	}
+	return 0
+}
+
+func (x *ReindexAllResponse) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ReindexAllResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// StorefrontFull represents complete storefront entity (45 fields from b2c_stores)
+type StorefrontFull struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identification
+	Id          int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner
+	Slug        string  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                    // Unique URL-slug
+	Name        string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Branding
+	LogoUrl   *string          `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	BannerUrl *string          `protobuf:"bytes,7,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
+	Theme     *structpb.Struct `protobuf:"bytes,8,opt,name=theme,proto3,oneof" json:"theme,omitempty"` // JSON: {"layout": "grid", "primaryColor": "#1976d2"}
+	// Contact information
+	Phone   *string `protobuf:"bytes,9,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email   *string `protobuf:"bytes,10,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Website *string `protobuf:"bytes,11,opt,name=website,proto3,oneof" json:"website,omitempty"`
+	// Location (address)
+	Address             *string               `protobuf:"bytes,12,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	City                *string               `protobuf:"bytes,13,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PostalCode          *string               `protobuf:"bytes,14,opt,name=postal_code,json=postalCode,proto3,oneof" json:"postal_code,omitempty"`
+	Country             *string               `protobuf:"bytes,15,opt,name=country,proto3,oneof" json:"country,omitempty"`       // VARCHAR(100), default 'RS'
+	Latitude            *float64              `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`   // NUMERIC(10,8)
+	Longitude           *float64              `protobuf:"fixed64,17,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"` // NUMERIC(11,8)
+	FormattedAddress    *string               `protobuf:"bytes,18,opt,name=formatted_address,json=formattedAddress,proto3,oneof" json:"formatted_address,omitempty"`
+	GeoStrategy         StorefrontGeoStrategy `protobuf:"varint,19,opt,name=geo_strategy,json=geoStrategy,proto3,enum=listingssvc.v1.StorefrontGeoStrategy" json:"geo_strategy,omitempty"`                          // default: storefront_location
+	DefaultPrivacyLevel LocationPrivacyLevel  `protobuf:"varint,20,opt,name=default_privacy_level,json=defaultPrivacyLevel,proto3,enum=listingssvc.v1.LocationPrivacyLevel" json:"default_privacy_level,omitempty"` // default: exact
+	AddressVerified     bool                  `protobuf:"varint,21,opt,name=address_verified,json=addressVerified,proto3" json:"address_verified,omitempty"`                                                        // default: false
+	// Settings
+	Settings *structpb.Struct `protobuf:"bytes,22,opt,name=settings,proto3,oneof" json:"settings,omitempty"`              // JSON
+	SeoMeta  *structpb.Struct `protobuf:"bytes,23,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"` // JSON
+	// Status and statistics
+	IsActive         bool                   `protobuf:"varint,24,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`       // default: true
+	IsVerified       bool                   `protobuf:"varint,25,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"` // default: false
+	VerificationDate *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=verification_date,json=verificationDate,proto3,oneof" json:"verification_date,omitempty"`
+	Rating           float64                `protobuf:"fixed64,27,opt,name=rating,proto3" json:"rating,omitempty"`                                   // NUMERIC(3,2), default: 0.00
+	ReviewsCount     int32                  `protobuf:"varint,28,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`    // default: 0
+	ProductsCount    int32                  `protobuf:"varint,29,opt,name=products_count,json=productsCount,proto3" json:"products_count,omitempty"` // default: 0
+	SalesCount       int32                  `protobuf:"varint,30,opt,name=sales_count,json=salesCount,proto3" json:"sales_count,omitempty"`          // default: 0
+	ViewsCount       int32                  `protobuf:"varint,31,opt,name=views_count,json=viewsCount,proto3" json:"views_count,omitempty"`          // default: 0
+	// Subscription (monetization)
+	SubscriptionPlan      SubscriptionPlanType   `protobuf:"varint,32,opt,name=subscription_plan,json=subscriptionPlan,proto3,enum=listingssvc.v1.SubscriptionPlanType" json:"subscription_plan,omitempty"` // default: starter
+	SubscriptionExpiresAt *timestamppb.Timestamp `protobuf:"bytes,33,opt,name=subscription_expires_at,json=subscriptionExpiresAt,proto3,oneof" json:"subscription_expires_at,omitempty"`
+	CommissionRate        float64                `protobuf:"fixed64,34,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"` // NUMERIC(5,2), default: 3.00
+	SubscriptionId        *int64                 `protobuf:"varint,35,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	IsSubscriptionActive  bool                   `protobuf:"varint,36,opt,name=is_subscription_active,json=isSubscriptionActive,proto3" json:"is_subscription_active,omitempty"` // default: true
+	// AI and killer features
+	AiAgentEnabled      bool             `protobuf:"varint,37,opt,name=ai_agent_enabled,json=aiAgentEnabled,proto3" json:"ai_agent_enabled,omitempty"`                // default: false
+	AiAgentConfig       *structpb.Struct `protobuf:"bytes,38,opt,name=ai_agent_config,json=aiAgentConfig,proto3,oneof" json:"ai_agent_config,omitempty"`              // JSON
+	LiveShoppingEnabled bool             `protobuf:"varint,39,opt,name=live_shopping_enabled,json=liveShoppingEnabled,proto3" json:"live_shopping_enabled,omitempty"` // default: false
+	GroupBuyingEnabled  bool             `protobuf:"varint,40,opt,name=group_buying_enabled,json=groupBuyingEnabled,proto3" json:"group_buying_enabled,omitempty"`    // default: false
+	// Social features
+	FollowersCount int32 `protobuf:"varint,41,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"` // default: 0
+	// Timestamps
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,42,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,43,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Related entities (optionally loaded)
+	Staff           []*StorefrontStaff          `protobuf:"bytes,44,rep,name=staff,proto3" json:"staff,omitempty"`
+	Hours           []*StorefrontHours          `protobuf:"bytes,45,rep,name=hours,proto3" json:"hours,omitempty"`
+	PaymentMethods  []*StorefrontPaymentMethod  `protobuf:"bytes,46,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	DeliveryOptions []*StorefrontDeliveryOption `protobuf:"bytes,47,rep,name=delivery_options,json=deliveryOptions,proto3" json:"delivery_options,omitempty"`
+	// Vacation and opening hours
+	VacationMode        bool                   `protobuf:"varint,48,opt,name=vacation_mode,json=vacationMode,proto3" json:"vacation_mode,omitempty"` // Vacation switch, see vacation window
+	VacationStartsAt    *timestamppb.Timestamp `protobuf:"bytes,49,opt,name=vacation_starts_at,json=vacationStartsAt,proto3,oneof" json:"vacation_starts_at,omitempty"`
+	VacationEndsAt      *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=vacation_ends_at,json=vacationEndsAt,proto3,oneof" json:"vacation_ends_at,omitempty"`
+	VacationMessage     *string                `protobuf:"bytes,51,opt,name=vacation_message,json=vacationMessage,proto3,oneof" json:"vacation_message,omitempty"`
+	VacationOrderPolicy string                 `protobuf:"bytes,52,opt,name=vacation_order_policy,json=vacationOrderPolicy,proto3" json:"vacation_order_policy,omitempty"` // "block" or "delay"
+	OnVacation          bool                   `protobuf:"varint,53,opt,name=on_vacation,json=onVacation,proto3" json:"on_vacation,omitempty"`                             // Vacation mode applies right now
+	Timezone            string                 `protobuf:"bytes,54,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                    // Effective IANA timezone of the working hours
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StorefrontFull) Reset() {
+	*x = StorefrontFull{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontFull) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontFull) ProtoMessage() {}
+
+func (x *StorefrontFull) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9343,369 +10644,4823 @@ func (x *StorefrontFull) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorefrontFull.ProtoReflect.Descriptor instead.
 func (*StorefrontFull) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{115}
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *StorefrontFull) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetBannerUrl() string {
+	if x != nil && x.BannerUrl != nil {
+		return *x.BannerUrl
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetTheme() *structpb.Struct {
+	if x != nil {
+		return x.Theme
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetPostalCode() string {
+	if x != nil && x.PostalCode != nil {
+		return *x.PostalCode
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetFormattedAddress() string {
+	if x != nil && x.FormattedAddress != nil {
+		return *x.FormattedAddress
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetGeoStrategy() StorefrontGeoStrategy {
+	if x != nil {
+		return x.GeoStrategy
+	}
+	return StorefrontGeoStrategy_STOREFRONT_GEO_STRATEGY_UNSPECIFIED
+}
+
+func (x *StorefrontFull) GetDefaultPrivacyLevel() LocationPrivacyLevel {
+	if x != nil {
+		return x.DefaultPrivacyLevel
+	}
+	return LocationPrivacyLevel_LOCATION_PRIVACY_LEVEL_UNSPECIFIED
+}
+
+func (x *StorefrontFull) GetAddressVerified() bool {
+	if x != nil {
+		return x.AddressVerified
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetSeoMeta() *structpb.Struct {
+	if x != nil {
+		return x.SeoMeta
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetVerificationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerificationDate
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetReviewsCount() int32 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetProductsCount() int32 {
+	if x != nil {
+		return x.ProductsCount
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetSalesCount() int32 {
+	if x != nil {
+		return x.SalesCount
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetViewsCount() int32 {
+	if x != nil {
+		return x.ViewsCount
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetSubscriptionPlan() SubscriptionPlanType {
+	if x != nil {
+		return x.SubscriptionPlan
+	}
+	return SubscriptionPlanType_SUBSCRIPTION_PLAN_TYPE_UNSPECIFIED
+}
+
+func (x *StorefrontFull) GetSubscriptionExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubscriptionExpiresAt
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetCommissionRate() float64 {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetSubscriptionId() int64 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetIsSubscriptionActive() bool {
+	if x != nil {
+		return x.IsSubscriptionActive
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetAiAgentEnabled() bool {
+	if x != nil {
+		return x.AiAgentEnabled
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetAiAgentConfig() *structpb.Struct {
+	if x != nil {
+		return x.AiAgentConfig
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetLiveShoppingEnabled() bool {
+	if x != nil {
+		return x.LiveShoppingEnabled
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetGroupBuyingEnabled() bool {
+	if x != nil {
+		return x.GroupBuyingEnabled
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetFollowersCount() int32 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+func (x *StorefrontFull) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetStaff() []*StorefrontStaff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetHours() []*StorefrontHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetPaymentMethods() []*StorefrontPaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetDeliveryOptions() []*StorefrontDeliveryOption {
+	if x != nil {
+		return x.DeliveryOptions
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetVacationMode() bool {
+	if x != nil {
+		return x.VacationMode
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetVacationStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VacationStartsAt
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetVacationEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VacationEndsAt
+	}
+	return nil
+}
+
+func (x *StorefrontFull) GetVacationMessage() string {
+	if x != nil && x.VacationMessage != nil {
+		return *x.VacationMessage
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetVacationOrderPolicy() string {
+	if x != nil {
+		return x.VacationOrderPolicy
+	}
+	return ""
+}
+
+func (x *StorefrontFull) GetOnVacation() bool {
+	if x != nil {
+		return x.OnVacation
+	}
+	return false
+}
+
+func (x *StorefrontFull) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// StorefrontStaff represents staff member (b2c_store_staff table)
+type StorefrontStaff struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId         int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId               int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role                 StaffRole              `protobuf:"varint,4,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"` // default: staff
+	Permissions          *structpb.Struct       `protobuf:"bytes,5,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`            // Overrides: {"orders.fulfill": true, ...}
+	LastActiveAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_active_at,json=lastActiveAt,proto3,oneof" json:"last_active_at,omitempty"`
+	ActionsCount         int32                  `protobuf:"varint,7,opt,name=actions_count,json=actionsCount,proto3" json:"actions_count,omitempty"` // default: 0
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EffectivePermissions []string               `protobuf:"bytes,10,rep,name=effective_permissions,json=effectivePermissions,proto3" json:"effective_permissions,omitempty"` // Role preset adjusted by permissions, e.g. "orders.fulfill"
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StorefrontStaff) Reset() {
+	*x = StorefrontStaff{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontStaff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontStaff) ProtoMessage() {}
+
+func (x *StorefrontStaff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontStaff.ProtoReflect.Descriptor instead.
+func (*StorefrontStaff) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StorefrontStaff) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontStaff) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontStaff) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StorefrontStaff) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *StorefrontStaff) GetPermissions() *structpb.Struct {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *StorefrontStaff) GetLastActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return nil
+}
+
+func (x *StorefrontStaff) GetActionsCount() int32 {
+	if x != nil {
+		return x.ActionsCount
+	}
+	return 0
+}
+
+func (x *StorefrontStaff) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontStaff) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontStaff) GetEffectivePermissions() []string {
+	if x != nil {
+		return x.EffectivePermissions
+	}
+	return nil
+}
+
+// StorefrontHours represents working hours (b2c_store_hours table)
+type StorefrontHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	DayOfWeek     int32                  `protobuf:"varint,3,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`          // 0=Sunday, 6=Saturday (CHECK: 0-6)
+	OpenTime      *string                `protobuf:"bytes,4,opt,name=open_time,json=openTime,proto3,oneof" json:"open_time,omitempty"`          // TIME (HH:MM:SS format)
+	CloseTime     *string                `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3,oneof" json:"close_time,omitempty"`       // TIME (HH:MM:SS format)
+	IsClosed      bool                   `protobuf:"varint,6,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`               // default: false
+	SpecialDate   *string                `protobuf:"bytes,7,opt,name=special_date,json=specialDate,proto3,oneof" json:"special_date,omitempty"` // DATE (YYYY-MM-DD format)
+	SpecialNote   *string                `protobuf:"bytes,8,opt,name=special_note,json=specialNote,proto3,oneof" json:"special_note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorefrontHours) Reset() {
+	*x = StorefrontHours{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontHours) ProtoMessage() {}
+
+func (x *StorefrontHours) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontHours.ProtoReflect.Descriptor instead.
+func (*StorefrontHours) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *StorefrontHours) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontHours) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontHours) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *StorefrontHours) GetOpenTime() string {
+	if x != nil && x.OpenTime != nil {
+		return *x.OpenTime
+	}
+	return ""
+}
+
+func (x *StorefrontHours) GetCloseTime() string {
+	if x != nil && x.CloseTime != nil {
+		return *x.CloseTime
+	}
+	return ""
+}
+
+func (x *StorefrontHours) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *StorefrontHours) GetSpecialDate() string {
+	if x != nil && x.SpecialDate != nil {
+		return *x.SpecialDate
+	}
+	return ""
+}
+
+func (x *StorefrontHours) GetSpecialNote() string {
+	if x != nil && x.SpecialNote != nil {
+		return *x.SpecialNote
+	}
+	return ""
+}
+
+// StorefrontPaymentMethod represents payment method (b2c_payment_methods table)
+type StorefrontPaymentMethod struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId   int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	MethodType     PaymentMethodType      `protobuf:"varint,3,opt,name=method_type,json=methodType,proto3,enum=listingssvc.v1.PaymentMethodType" json:"method_type,omitempty"`
+	IsEnabled      bool                   `protobuf:"varint,4,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"` // default: true
+	Provider       *string                `protobuf:"bytes,5,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Settings       *structpb.Struct       `protobuf:"bytes,6,opt,name=settings,proto3,oneof" json:"settings,omitempty"`                               // JSON
+	TransactionFee float64                `protobuf:"fixed64,7,opt,name=transaction_fee,json=transactionFee,proto3" json:"transaction_fee,omitempty"` // NUMERIC(5,2), default: 0.00
+	MinAmount      *float64               `protobuf:"fixed64,8,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`          // NUMERIC(10,2)
+	MaxAmount      *float64               `protobuf:"fixed64,9,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`          // NUMERIC(10,2)
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StorefrontPaymentMethod) Reset() {
+	*x = StorefrontPaymentMethod{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontPaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontPaymentMethod) ProtoMessage() {}
+
+func (x *StorefrontPaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontPaymentMethod.ProtoReflect.Descriptor instead.
+func (*StorefrontPaymentMethod) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *StorefrontPaymentMethod) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontPaymentMethod) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontPaymentMethod) GetMethodType() PaymentMethodType {
+	if x != nil {
+		return x.MethodType
+	}
+	return PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED
+}
+
+func (x *StorefrontPaymentMethod) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *StorefrontPaymentMethod) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *StorefrontPaymentMethod) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *StorefrontPaymentMethod) GetTransactionFee() float64 {
+	if x != nil {
+		return x.TransactionFee
+	}
+	return 0
+}
+
+func (x *StorefrontPaymentMethod) GetMinAmount() float64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *StorefrontPaymentMethod) GetMaxAmount() float64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *StorefrontPaymentMethod) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// StorefrontDeliveryOption represents delivery option (b2c_delivery_options table)
+type StorefrontDeliveryOption struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId     int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	BasePrice        float64                `protobuf:"fixed64,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`                           // NUMERIC(10,2), default: 0.00
+	PricePerKm       float64                `protobuf:"fixed64,6,opt,name=price_per_km,json=pricePerKm,proto3" json:"price_per_km,omitempty"`                      // NUMERIC(10,2), default: 0.00
+	PricePerKg       float64                `protobuf:"fixed64,7,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`                      // NUMERIC(10,2), default: 0.00
+	FreeAboveAmount  *float64               `protobuf:"fixed64,8,opt,name=free_above_amount,json=freeAboveAmount,proto3,oneof" json:"free_above_amount,omitempty"` // NUMERIC(10,2)
+	MinOrderAmount   *float64               `protobuf:"fixed64,9,opt,name=min_order_amount,json=minOrderAmount,proto3,oneof" json:"min_order_amount,omitempty"`    // NUMERIC(10,2)
+	MaxWeightKg      *float64               `protobuf:"fixed64,10,opt,name=max_weight_kg,json=maxWeightKg,proto3,oneof" json:"max_weight_kg,omitempty"`            // NUMERIC(10,2)
+	MaxDistanceKm    *float64               `protobuf:"fixed64,11,opt,name=max_distance_km,json=maxDistanceKm,proto3,oneof" json:"max_distance_km,omitempty"`      // NUMERIC(10,2)
+	EstimatedDaysMin int32                  `protobuf:"varint,12,opt,name=estimated_days_min,json=estimatedDaysMin,proto3" json:"estimated_days_min,omitempty"`    // default: 1
+	EstimatedDaysMax int32                  `protobuf:"varint,13,opt,name=estimated_days_max,json=estimatedDaysMax,proto3" json:"estimated_days_max,omitempty"`    // default: 3
+	Zones            *structpb.Struct       `protobuf:"bytes,14,opt,name=zones,proto3,oneof" json:"zones,omitempty"`                                               // JSON array
+	AvailableDays    *structpb.Struct       `protobuf:"bytes,15,opt,name=available_days,json=availableDays,proto3,oneof" json:"available_days,omitempty"`          // JSON array: [1,2,3,4,5]
+	CutoffTime       *string                `protobuf:"bytes,16,opt,name=cutoff_time,json=cutoffTime,proto3,oneof" json:"cutoff_time,omitempty"`                   // TIME (HH:MM:SS)
+	Provider         *string                `protobuf:"bytes,17,opt,name=provider,proto3,oneof" json:"provider,omitempty"`                                         // DeliveryProvider as string
+	ProviderConfig   *structpb.Struct       `protobuf:"bytes,18,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`       // JSON
+	IsActive         bool                   `protobuf:"varint,19,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                              // default: true
+	DisplayOrder     int32                  `protobuf:"varint,20,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`                  // default: 0
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StorefrontDeliveryOption) Reset() {
+	*x = StorefrontDeliveryOption{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontDeliveryOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontDeliveryOption) ProtoMessage() {}
+
+func (x *StorefrontDeliveryOption) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontDeliveryOption.ProtoReflect.Descriptor instead.
+func (*StorefrontDeliveryOption) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *StorefrontDeliveryOption) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StorefrontDeliveryOption) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *StorefrontDeliveryOption) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetPricePerKm() float64 {
+	if x != nil {
+		return x.PricePerKm
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetPricePerKg() float64 {
+	if x != nil {
+		return x.PricePerKg
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetFreeAboveAmount() float64 {
+	if x != nil && x.FreeAboveAmount != nil {
+		return *x.FreeAboveAmount
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetMinOrderAmount() float64 {
+	if x != nil && x.MinOrderAmount != nil {
+		return *x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetMaxWeightKg() float64 {
+	if x != nil && x.MaxWeightKg != nil {
+		return *x.MaxWeightKg
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetMaxDistanceKm() float64 {
+	if x != nil && x.MaxDistanceKm != nil {
+		return *x.MaxDistanceKm
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetEstimatedDaysMin() int32 {
+	if x != nil {
+		return x.EstimatedDaysMin
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetEstimatedDaysMax() int32 {
+	if x != nil {
+		return x.EstimatedDaysMax
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetZones() *structpb.Struct {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *StorefrontDeliveryOption) GetAvailableDays() *structpb.Struct {
+	if x != nil {
+		return x.AvailableDays
+	}
+	return nil
+}
+
+func (x *StorefrontDeliveryOption) GetCutoffTime() string {
+	if x != nil && x.CutoffTime != nil {
+		return *x.CutoffTime
+	}
+	return ""
+}
+
+func (x *StorefrontDeliveryOption) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *StorefrontDeliveryOption) GetProviderConfig() *structpb.Struct {
+	if x != nil {
+		return x.ProviderConfig
+	}
+	return nil
+}
+
+func (x *StorefrontDeliveryOption) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *StorefrontDeliveryOption) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *StorefrontDeliveryOption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontDeliveryOption) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Location helper structure for address
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserLat       float64                `protobuf:"fixed64,1,opt,name=user_lat,json=userLat,proto3" json:"user_lat,omitempty"` // latitude
+	UserLng       float64                `protobuf:"fixed64,2,opt,name=user_lng,json=userLng,proto3" json:"user_lng,omitempty"` // longitude
+	FullAddress   string                 `protobuf:"bytes,3,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	PostalCode    *string                `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3,oneof" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"` // default: "RS"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *Location) GetUserLat() float64 {
+	if x != nil {
+		return x.UserLat
+	}
+	return 0
+}
+
+func (x *Location) GetUserLng() float64 {
+	if x != nil {
+		return x.UserLng
+	}
+	return 0
+}
+
+func (x *Location) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
+}
+
+func (x *Location) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Location) GetPostalCode() string {
+	if x != nil && x.PostalCode != nil {
+		return *x.PostalCode
+	}
+	return ""
+}
+
+func (x *Location) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// CreateStorefrontRequest creates a new storefront
+type CreateStorefrontRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner (from JWT token)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // Required
+	Slug          *string                `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`              // Auto-generated if empty
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Logo          []byte                 `protobuf:"bytes,5,opt,name=logo,proto3,oneof" json:"logo,omitempty"`     // File content for upload
+	Banner        []byte                 `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"` // File content for upload
+	Theme         *structpb.Struct       `protobuf:"bytes,7,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	Phone         *string                `protobuf:"bytes,8,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email         *string                `protobuf:"bytes,9,opt,name=email,proto3,oneof" json:"email,omitempty"`      // Validated
+	Website       *string                `protobuf:"bytes,10,opt,name=website,proto3,oneof" json:"website,omitempty"` // Validated
+	Location      *Location              `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`     // Required
+	Settings      *structpb.Struct       `protobuf:"bytes,12,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
+	SeoMeta       *structpb.Struct       `protobuf:"bytes,13,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorefrontRequest) Reset() {
+	*x = CreateStorefrontRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorefrontRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorefrontRequest) ProtoMessage() {}
+
+func (x *CreateStorefrontRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorefrontRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorefrontRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CreateStorefrontRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateStorefrontRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetLogo() []byte {
+	if x != nil {
+		return x.Logo
+	}
+	return nil
+}
+
+func (x *CreateStorefrontRequest) GetBanner() []byte {
+	if x != nil {
+		return x.Banner
+	}
+	return nil
+}
+
+func (x *CreateStorefrontRequest) GetTheme() *structpb.Struct {
+	if x != nil {
+		return x.Theme
+	}
+	return nil
+}
+
+func (x *CreateStorefrontRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
+func (x *CreateStorefrontRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateStorefrontRequest) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateStorefrontRequest) GetSeoMeta() *structpb.Struct {
+	if x != nil {
+		return x.SeoMeta
+	}
+	return nil
+}
+
+// UpdateStorefrontRequest updates an existing storefront
+type UpdateStorefrontRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Required
+	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive            *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	LogoUrl             *string                `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	BannerUrl           *string                `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
+	Theme               *structpb.Struct       `protobuf:"bytes,7,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	Phone               *string                `protobuf:"bytes,8,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email               *string                `protobuf:"bytes,9,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Website             *string                `protobuf:"bytes,10,opt,name=website,proto3,oneof" json:"website,omitempty"`
+	Location            *Location              `protobuf:"bytes,11,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Settings            *structpb.Struct       `protobuf:"bytes,12,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
+	SeoMeta             *structpb.Struct       `protobuf:"bytes,13,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"`
+	AiAgentEnabled      *bool                  `protobuf:"varint,14,opt,name=ai_agent_enabled,json=aiAgentEnabled,proto3,oneof" json:"ai_agent_enabled,omitempty"`
+	LiveShoppingEnabled *bool                  `protobuf:"varint,15,opt,name=live_shopping_enabled,json=liveShoppingEnabled,proto3,oneof" json:"live_shopping_enabled,omitempty"`
+	GroupBuyingEnabled  *bool                  `protobuf:"varint,16,opt,name=group_buying_enabled,json=groupBuyingEnabled,proto3,oneof" json:"group_buying_enabled,omitempty"`
+	Timezone            *string                `protobuf:"bytes,17,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"` // IANA name; empty resets to the timezone of the country
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateStorefrontRequest) Reset() {
+	*x = UpdateStorefrontRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStorefrontRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStorefrontRequest) ProtoMessage() {}
+
+func (x *UpdateStorefrontRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStorefrontRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStorefrontRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *UpdateStorefrontRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStorefrontRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateStorefrontRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetBannerUrl() string {
+	if x != nil && x.BannerUrl != nil {
+		return *x.BannerUrl
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetTheme() *structpb.Struct {
+	if x != nil {
+		return x.Theme
+	}
+	return nil
+}
+
+func (x *UpdateStorefrontRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
+func (x *UpdateStorefrontRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateStorefrontRequest) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateStorefrontRequest) GetSeoMeta() *structpb.Struct {
+	if x != nil {
+		return x.SeoMeta
+	}
+	return nil
+}
+
+func (x *UpdateStorefrontRequest) GetAiAgentEnabled() bool {
+	if x != nil && x.AiAgentEnabled != nil {
+		return *x.AiAgentEnabled
+	}
+	return false
+}
+
+func (x *UpdateStorefrontRequest) GetLiveShoppingEnabled() bool {
+	if x != nil && x.LiveShoppingEnabled != nil {
+		return *x.LiveShoppingEnabled
+	}
+	return false
+}
+
+func (x *UpdateStorefrontRequest) GetGroupBuyingEnabled() bool {
+	if x != nil && x.GroupBuyingEnabled != nil {
+		return *x.GroupBuyingEnabled
+	}
+	return false
+}
+
+func (x *UpdateStorefrontRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// ImageCrop is a crop rectangle in source image pixels
+type ImageCrop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageCrop) Reset() {
+	*x = ImageCrop{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageCrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageCrop) ProtoMessage() {}
+
+func (x *ImageCrop) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageCrop.ProtoReflect.Descriptor instead.
+func (*ImageCrop) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ImageCrop) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ImageCrop) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ImageCrop) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageCrop) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// UploadStorefrontImageRequest uploads a storefront logo (square) or banner (4:1)
+type UploadStorefrontImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // Required: owner or staff with settings.write
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                      // "logo" or "banner"
+	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`                              // Original filename (jpg, jpeg, png or gif)
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`       // Image file (max 10MB)
+	Crop          *ImageCrop             `protobuf:"bytes,7,opt,name=crop,proto3,oneof" json:"crop,omitempty"` // Defaults to a centered crop with the target aspect ratio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadStorefrontImageRequest) Reset() {
+	*x = UploadStorefrontImageRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadStorefrontImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStorefrontImageRequest) ProtoMessage() {}
+
+func (x *UploadStorefrontImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStorefrontImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadStorefrontImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UploadStorefrontImageRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *UploadStorefrontImageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadStorefrontImageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UploadStorefrontImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadStorefrontImageRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadStorefrontImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadStorefrontImageRequest) GetCrop() *ImageCrop {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+// DeleteStorefrontRequest deletes a storefront
+type DeleteStorefrontRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HardDelete    bool                   `protobuf:"varint,2,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"` // false = soft delete (is_active=false), true = hard delete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorefrontRequest) Reset() {
+	*x = DeleteStorefrontRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorefrontRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorefrontRequest) ProtoMessage() {}
+
+func (x *DeleteStorefrontRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorefrontRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStorefrontRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DeleteStorefrontRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteStorefrontRequest) GetHardDelete() bool {
+	if x != nil {
+		return x.HardDelete
+	}
+	return false
+}
+
+// DeleteStorefrontResponse confirms deletion
+type DeleteStorefrontResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorefrontResponse) Reset() {
+	*x = DeleteStorefrontResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorefrontResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorefrontResponse) ProtoMessage() {}
+
+func (x *DeleteStorefrontResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorefrontResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStorefrontResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DeleteStorefrontResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteStorefrontResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AddStaffRequest adds a staff member
+type AddStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"`
+	Permissions   *structpb.Struct       `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffRequest) Reset() {
+	*x = AddStaffRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffRequest) ProtoMessage() {}
+
+func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffRequest.ProtoReflect.Descriptor instead.
+func (*AddStaffRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *AddStaffRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *AddStaffRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddStaffRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *AddStaffRequest) GetPermissions() *structpb.Struct {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// UpdateStaffRequest updates staff member
+type UpdateStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          *StaffRole             `protobuf:"varint,2,opt,name=role,proto3,enum=listingssvc.v1.StaffRole,oneof" json:"role,omitempty"`
+	Permissions   *structpb.Struct       `protobuf:"bytes,3,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`                    // Overrides: {"orders.fulfill": true, "inventory.adjust": false}
+	ActingUserId  int64                  `protobuf:"varint,4,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffRequest) Reset() {
+	*x = UpdateStaffRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffRequest) ProtoMessage() {}
+
+func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStaffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *UpdateStaffRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStaffRequest) GetRole() StaffRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateStaffRequest) GetPermissions() *structpb.Struct {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UpdateStaffRequest) GetActingUserId() int64 {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return 0
+}
+
+// RemoveStaffRequest removes staff member
+type RemoveStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActingUserId  int64                  `protobuf:"varint,3,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffRequest) Reset() {
+	*x = RemoveStaffRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffRequest) ProtoMessage() {}
+
+func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RemoveStaffRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *RemoveStaffRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveStaffRequest) GetActingUserId() int64 {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return 0
+}
+
+// GetStaffRequest retrieves staff list
+type GetStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	ActingUserId  int64                  `protobuf:"varint,2,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffRequest) Reset() {
+	*x = GetStaffRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffRequest) ProtoMessage() {}
+
+func (x *GetStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffRequest.ProtoReflect.Descriptor instead.
+func (*GetStaffRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetStaffRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetStaffRequest) GetActingUserId() int64 {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return 0
+}
+
+// GetStaffResponse returns staff list
+type GetStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*StorefrontStaff     `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffResponse) Reset() {
+	*x = GetStaffResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffResponse) ProtoMessage() {}
+
+func (x *GetStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffResponse.ProtoReflect.Descriptor instead.
+func (*GetStaffResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetStaffResponse) GetStaff() []*StorefrontStaff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+// StaffInvitation invites a user to join a storefront staff
+type StaffInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Invited user
+	Role          StaffRole              `protobuf:"varint,4,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"`
+	Permissions   *structpb.Struct       `protobuf:"bytes,5,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	InvitedBy     int64                  `protobuf:"varint,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	Status        StaffInvitationStatus  `protobuf:"varint,7,opt,name=status,proto3,enum=listingssvc.v1.StaffInvitationStatus" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=responded_at,json=respondedAt,proto3,oneof" json:"responded_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffInvitation) Reset() {
+	*x = StaffInvitation{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffInvitation) ProtoMessage() {}
+
+func (x *StaffInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffInvitation.ProtoReflect.Descriptor instead.
+func (*StaffInvitation) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *StaffInvitation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *StaffInvitation) GetPermissions() *structpb.Struct {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *StaffInvitation) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetStatus() StaffInvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StaffInvitationStatus_STAFF_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *StaffInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *StaffInvitation) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *StaffInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InviteStaffRequest invites a user to the storefront staff
+type InviteStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // Invited user
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"`         // Owner role cannot be granted by invitation
+	Permissions   *structpb.Struct       `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`                    // Overrides applied on top of the role preset
+	ActingUserId  int64                  `protobuf:"varint,5,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteStaffRequest) Reset() {
+	*x = InviteStaffRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteStaffRequest) ProtoMessage() {}
+
+func (x *InviteStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteStaffRequest.ProtoReflect.Descriptor instead.
+func (*InviteStaffRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *InviteStaffRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *InviteStaffRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteStaffRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *InviteStaffRequest) GetPermissions() *structpb.Struct {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *InviteStaffRequest) GetActingUserId() int64 {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return 0
+}
+
+// StaffInvitationActionRequest accepts, declines or revokes an invitation
+type StaffInvitationActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  int64                  `protobuf:"varint,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Acting user, used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffInvitationActionRequest) Reset() {
+	*x = StaffInvitationActionRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffInvitationActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffInvitationActionRequest) ProtoMessage() {}
+
+func (x *StaffInvitationActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffInvitationActionRequest.ProtoReflect.Descriptor instead.
+func (*StaffInvitationActionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *StaffInvitationActionRequest) GetInvitationId() int64 {
+	if x != nil {
+		return x.InvitationId
+	}
+	return 0
+}
+
+func (x *StaffInvitationActionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ListStaffInvitationsRequest lists a storefront's invitations (storefront_id set)
+// or the invitations addressed to the user
+type ListStaffInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  *int64                 `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3,oneof" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Acting user, used when the call carries no authenticated user
+	PendingOnly   bool                   `protobuf:"varint,3,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffInvitationsRequest) Reset() {
+	*x = ListStaffInvitationsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffInvitationsRequest) ProtoMessage() {}
+
+func (x *ListStaffInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListStaffInvitationsRequest) GetStorefrontId() int64 {
+	if x != nil && x.StorefrontId != nil {
+		return *x.StorefrontId
+	}
+	return 0
+}
+
+func (x *ListStaffInvitationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListStaffInvitationsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+// ListStaffInvitationsResponse returns invitations, newest first
+type ListStaffInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*StaffInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffInvitationsResponse) Reset() {
+	*x = ListStaffInvitationsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffInvitationsResponse) ProtoMessage() {}
+
+func (x *ListStaffInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ListStaffInvitationsResponse) GetInvitations() []*StaffInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// FollowStorefrontRequest follows or unfollows a storefront
+type FollowStorefrontRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowStorefrontRequest) Reset() {
+	*x = FollowStorefrontRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowStorefrontRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowStorefrontRequest) ProtoMessage() {}
+
+func (x *FollowStorefrontRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowStorefrontRequest.ProtoReflect.Descriptor instead.
+func (*FollowStorefrontRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *FollowStorefrontRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *FollowStorefrontRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// FollowStorefrontResponse returns the follow state after the call
+type FollowStorefrontResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Following      bool                   `protobuf:"varint,1,opt,name=following,proto3" json:"following,omitempty"`
+	FollowersCount int32                  `protobuf:"varint,2,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FollowStorefrontResponse) Reset() {
+	*x = FollowStorefrontResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowStorefrontResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowStorefrontResponse) ProtoMessage() {}
+
+func (x *FollowStorefrontResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowStorefrontResponse.ProtoReflect.Descriptor instead.
+func (*FollowStorefrontResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *FollowStorefrontResponse) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *FollowStorefrontResponse) GetFollowersCount() int32 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+// StorefrontFollower is a user following a storefront
+type StorefrontFollower struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorefrontFollower) Reset() {
+	*x = StorefrontFollower{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontFollower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontFollower) ProtoMessage() {}
+
+func (x *StorefrontFollower) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontFollower.ProtoReflect.Descriptor instead.
+func (*StorefrontFollower) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *StorefrontFollower) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StorefrontFollower) GetFollowedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return nil
+}
+
+// GetStorefrontFollowersRequest lists a storefront's followers, most recent first
+type GetStorefrontFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 50, max: 200
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ActingUserId  int64                  `protobuf:"varint,4,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorefrontFollowersRequest) Reset() {
+	*x = GetStorefrontFollowersRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontFollowersRequest) ProtoMessage() {}
+
+func (x *GetStorefrontFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontFollowersRequest.ProtoReflect.Descriptor instead.
+func (*GetStorefrontFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetStorefrontFollowersRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *GetStorefrontFollowersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetStorefrontFollowersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetStorefrontFollowersRequest) GetActingUserId() int64 {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return 0
+}
+
+// GetStorefrontFollowersResponse returns followers and the total count
+type GetStorefrontFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followers     []*StorefrontFollower  `protobuf:"bytes,1,rep,name=followers,proto3" json:"followers,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorefrontFollowersResponse) Reset() {
+	*x = GetStorefrontFollowersResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontFollowersResponse) ProtoMessage() {}
+
+func (x *GetStorefrontFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontFollowersResponse.ProtoReflect.Descriptor instead.
+func (*GetStorefrontFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetStorefrontFollowersResponse) GetFollowers() []*StorefrontFollower {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *GetStorefrontFollowersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetFollowingFeedRequest pages through new products of followed storefronts.
+// Pass the previous response's next_cursor to get the next page.
+type GetFollowingFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                 // Default: 20, max: 100
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingFeedRequest) Reset() {
+	*x = GetFollowingFeedRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingFeedRequest) ProtoMessage() {}
+
+func (x *GetFollowingFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetFollowingFeedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowingFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowingFeedRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// GetFollowingFeedResponse returns products ordered by creation time, newest first
+type GetFollowingFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Unset on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingFeedResponse) Reset() {
+	*x = GetFollowingFeedResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingFeedResponse) ProtoMessage() {}
+
+func (x *GetFollowingFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingFeedResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetFollowingFeedResponse) GetListings() []*Listing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *GetFollowingFeedResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// SetWorkingHoursRequest sets working hours
+type SetWorkingHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Hours         []*StorefrontHours     `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorkingHoursRequest) Reset() {
+	*x = SetWorkingHoursRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkingHoursRequest) ProtoMessage() {}
+
+func (x *SetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkingHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SetWorkingHoursRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *SetWorkingHoursRequest) GetHours() []*StorefrontHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+// GetWorkingHoursRequest retrieves working hours
+type GetWorkingHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingHoursRequest) Reset() {
+	*x = GetWorkingHoursRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingHoursRequest) ProtoMessage() {}
+
+func (x *GetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetWorkingHoursRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// GetWorkingHoursResponse returns working hours
+type GetWorkingHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         []*StorefrontHours     `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingHoursResponse) Reset() {
+	*x = GetWorkingHoursResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingHoursResponse) ProtoMessage() {}
+
+func (x *GetWorkingHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingHoursResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetWorkingHoursResponse) GetHours() []*StorefrontHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+// IsOpenNowRequest checks if storefront is open
+type IsOpenNowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsOpenNowRequest) Reset() {
+	*x = IsOpenNowRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsOpenNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOpenNowRequest) ProtoMessage() {}
+
+func (x *IsOpenNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOpenNowRequest.ProtoReflect.Descriptor instead.
+func (*IsOpenNowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *IsOpenNowRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// IsOpenNowResponse returns open status
+type IsOpenNowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsOpen        bool                   `protobuf:"varint,1,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	NextOpenTime  *string                `protobuf:"bytes,2,opt,name=next_open_time,json=nextOpenTime,proto3,oneof" json:"next_open_time,omitempty"`    // If closed, when will it open (local HH:MM:SS)
+	NextCloseTime *string                `protobuf:"bytes,3,opt,name=next_close_time,json=nextCloseTime,proto3,oneof" json:"next_close_time,omitempty"` // If open, when will it close (local HH:MM:SS)
+	ClosedReason  *string                `protobuf:"bytes,4,opt,name=closed_reason,json=closedReason,proto3,oneof" json:"closed_reason,omitempty"`      // "schedule", "holiday" or "vacation" when closed
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`                                          // Holiday name, special date note or vacation message
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                                        // Timezone the hours were evaluated in
+	NextOpenAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_open_at,json=nextOpenAt,proto3,oneof" json:"next_open_at,omitempty"`
+	NextCloseAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_close_at,json=nextCloseAt,proto3,oneof" json:"next_close_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsOpenNowResponse) Reset() {
+	*x = IsOpenNowResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsOpenNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOpenNowResponse) ProtoMessage() {}
+
+func (x *IsOpenNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOpenNowResponse.ProtoReflect.Descriptor instead.
+func (*IsOpenNowResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *IsOpenNowResponse) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *IsOpenNowResponse) GetNextOpenTime() string {
+	if x != nil && x.NextOpenTime != nil {
+		return *x.NextOpenTime
+	}
+	return ""
+}
+
+func (x *IsOpenNowResponse) GetNextCloseTime() string {
+	if x != nil && x.NextCloseTime != nil {
+		return *x.NextCloseTime
+	}
+	return ""
+}
+
+func (x *IsOpenNowResponse) GetClosedReason() string {
+	if x != nil && x.ClosedReason != nil {
+		return *x.ClosedReason
+	}
+	return ""
+}
+
+func (x *IsOpenNowResponse) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *IsOpenNowResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *IsOpenNowResponse) GetNextOpenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpenAt
+	}
+	return nil
+}
+
+func (x *IsOpenNowResponse) GetNextCloseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCloseAt
+	}
+	return nil
+}
+
+// SetVacationModeRequest turns vacation mode on or off
+type SetVacationModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3,oneof" json:"starts_at,omitempty"`    // Vacation starts immediately if unset
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`          // Open-ended if unset
+	Message       *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`                      // Shown to buyers
+	OrderPolicy   string                 `protobuf:"bytes,6,opt,name=order_policy,json=orderPolicy,proto3" json:"order_policy,omitempty"` // "block" (default) or "delay"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVacationModeRequest) Reset() {
+	*x = SetVacationModeRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVacationModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVacationModeRequest) ProtoMessage() {}
+
+func (x *SetVacationModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVacationModeRequest.ProtoReflect.Descriptor instead.
+func (*SetVacationModeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *SetVacationModeRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *SetVacationModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetVacationModeRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SetVacationModeRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SetVacationModeRequest) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *SetVacationModeRequest) GetOrderPolicy() string {
+	if x != nil {
+		return x.OrderPolicy
+	}
+	return ""
+}
+
+// StorefrontHoliday is a date-range closure (storefront_holidays table)
+type StorefrontHoliday struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId    int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate       string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // DATE (YYYY-MM-DD format)
+	EndDate         string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // DATE (YYYY-MM-DD format), inclusive
+	RecurringYearly bool                   `protobuf:"varint,6,opt,name=recurring_yearly,json=recurringYearly,proto3" json:"recurring_yearly,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StorefrontHoliday) Reset() {
+	*x = StorefrontHoliday{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontHoliday) ProtoMessage() {}
+
+func (x *StorefrontHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontHoliday.ProtoReflect.Descriptor instead.
+func (*StorefrontHoliday) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *StorefrontHoliday) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontHoliday) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StorefrontHoliday) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *StorefrontHoliday) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *StorefrontHoliday) GetRecurringYearly() bool {
+	if x != nil {
+		return x.RecurringYearly
+	}
+	return false
+}
+
+// SetHolidaysRequest replaces the holiday calendar
+type SetHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Holidays      []*StorefrontHoliday   `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHolidaysRequest) Reset() {
+	*x = SetHolidaysRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHolidaysRequest) ProtoMessage() {}
+
+func (x *SetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*SetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *SetHolidaysRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *SetHolidaysRequest) GetHolidays() []*StorefrontHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// GetHolidaysRequest retrieves the holiday calendar
+type GetHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysRequest) Reset() {
+	*x = GetHolidaysRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysRequest) ProtoMessage() {}
+
+func (x *GetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetHolidaysRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// GetHolidaysResponse returns the holiday calendar
+type GetHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*StorefrontHoliday   `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysResponse) Reset() {
+	*x = GetHolidaysResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysResponse) ProtoMessage() {}
+
+func (x *GetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetHolidaysResponse) GetHolidays() []*StorefrontHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// StorefrontDomain is a custom domain of a storefront (storefront_domains table)
+type StorefrontDomain struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId       int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Domain             string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsPrimary          bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	IsVerified         bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerifiedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=verified_at,json=verifiedAt,proto3,oneof" json:"verified_at,omitempty"`
+	VerificationRecord string                 `protobuf:"bytes,7,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"` // DNS name of the TXT record, e.g. _svetu-verify.shop.example.rs
+	VerificationToken  string                 `protobuf:"bytes,8,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`    // Value the TXT record must contain
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StorefrontDomain) Reset() {
+	*x = StorefrontDomain{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontDomain) ProtoMessage() {}
+
+func (x *StorefrontDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontDomain.ProtoReflect.Descriptor instead.
+func (*StorefrontDomain) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *StorefrontDomain) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontDomain) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *StorefrontDomain) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *StorefrontDomain) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *StorefrontDomain) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *StorefrontDomain) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *StorefrontDomain) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *StorefrontDomain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddStorefrontDomainRequest registers a custom domain
+type AddStorefrontDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStorefrontDomainRequest) Reset() {
+	*x = AddStorefrontDomainRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStorefrontDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStorefrontDomainRequest) ProtoMessage() {}
+
+func (x *AddStorefrontDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStorefrontDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddStorefrontDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *AddStorefrontDomainRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *AddStorefrontDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// StorefrontDomainRequest addresses a custom domain of a storefront
+type StorefrontDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorefrontDomainRequest) Reset() {
+	*x = StorefrontDomainRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontDomainRequest) ProtoMessage() {}
+
+func (x *StorefrontDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontDomainRequest.ProtoReflect.Descriptor instead.
+func (*StorefrontDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *StorefrontDomainRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontDomainRequest) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+// ListStorefrontDomainsRequest lists the custom domains of a storefront
+type ListStorefrontDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorefrontDomainsRequest) Reset() {
+	*x = ListStorefrontDomainsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorefrontDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorefrontDomainsRequest) ProtoMessage() {}
+
+func (x *ListStorefrontDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorefrontDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorefrontDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ListStorefrontDomainsRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// ListStorefrontDomainsResponse returns the custom domains, primary first
+type ListStorefrontDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*StorefrontDomain    `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorefrontDomainsResponse) Reset() {
+	*x = ListStorefrontDomainsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorefrontDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorefrontDomainsResponse) ProtoMessage() {}
+
+func (x *ListStorefrontDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorefrontDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListStorefrontDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ListStorefrontDomainsResponse) GetDomains() []*StorefrontDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// ResolveStorefrontDomainRequest resolves a host name
+type ResolveStorefrontDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveStorefrontDomainRequest) Reset() {
+	*x = ResolveStorefrontDomainRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStorefrontDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStorefrontDomainRequest) ProtoMessage() {}
+
+func (x *ResolveStorefrontDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStorefrontDomainRequest.ProtoReflect.Descriptor instead.
+func (*ResolveStorefrontDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ResolveStorefrontDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// ResolveStorefrontDomainResponse identifies the storefront a domain points to
+type ResolveStorefrontDomainResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId   int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	StorefrontSlug string                 `protobuf:"bytes,2,opt,name=storefront_slug,json=storefrontSlug,proto3" json:"storefront_slug,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsPrimary      bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveStorefrontDomainResponse) Reset() {
+	*x = ResolveStorefrontDomainResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStorefrontDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStorefrontDomainResponse) ProtoMessage() {}
+
+func (x *ResolveStorefrontDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStorefrontDomainResponse.ProtoReflect.Descriptor instead.
+func (*ResolveStorefrontDomainResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ResolveStorefrontDomainResponse) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *ResolveStorefrontDomainResponse) GetStorefrontSlug() string {
+	if x != nil {
+		return x.StorefrontSlug
+	}
+	return ""
+}
+
+func (x *ResolveStorefrontDomainResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ResolveStorefrontDomainResponse) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+// VerificationDocumentUpload is a business document submitted for verification
+type VerificationDocumentUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentType  string                 `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"` // business_registration, tax_certificate, identity, address_proof, other
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // application/pdf, image/jpeg or image/png
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`                                  // Max 10MB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationDocumentUpload) Reset() {
+	*x = VerificationDocumentUpload{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationDocumentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationDocumentUpload) ProtoMessage() {}
+
+func (x *VerificationDocumentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationDocumentUpload.ProtoReflect.Descriptor instead.
+func (*VerificationDocumentUpload) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *VerificationDocumentUpload) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *VerificationDocumentUpload) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *VerificationDocumentUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *VerificationDocumentUpload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// StorefrontVerificationDocument is a stored verification document
+type StorefrontVerificationDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileSize      int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"` // Short-lived presigned download URL
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorefrontVerificationDocument) Reset() {
+	*x = StorefrontVerificationDocument{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontVerificationDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontVerificationDocument) ProtoMessage() {}
+
+func (x *StorefrontVerificationDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontVerificationDocument.ProtoReflect.Descriptor instead.
+func (*StorefrontVerificationDocument) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *StorefrontVerificationDocument) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontVerificationDocument) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationDocument) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *StorefrontVerificationDocument) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationDocument) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// StorefrontVerification is a verification request (storefront_verification_requests table)
+type StorefrontVerification struct {
+	state              protoimpl.MessageState            `protogen:"open.v1"`
+	Id                 int64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorefrontId       int64                             `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	SubmittedBy        int64                             `protobuf:"varint,3,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	Status             string                            `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, approved, rejected, cancelled
+	BusinessName       string                            `protobuf:"bytes,5,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	RegistrationNumber *string                           `protobuf:"bytes,6,opt,name=registration_number,json=registrationNumber,proto3,oneof" json:"registration_number,omitempty"` // MB
+	TaxId              *string                           `protobuf:"bytes,7,opt,name=tax_id,json=taxId,proto3,oneof" json:"tax_id,omitempty"`                                        // PIB
+	Notes              *string                           `protobuf:"bytes,8,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	ReviewedBy         *int64                            `protobuf:"varint,9,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewedAt         *timestamppb.Timestamp            `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	RejectionReason    *string                           `protobuf:"bytes,11,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	Documents          []*StorefrontVerificationDocument `protobuf:"bytes,12,rep,name=documents,proto3" json:"documents,omitempty"`
+	CreatedAt          *timestamppb.Timestamp            `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp            `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StorefrontVerification) Reset() {
+	*x = StorefrontVerification{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontVerification) ProtoMessage() {}
+
+func (x *StorefrontVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontVerification.ProtoReflect.Descriptor instead.
+func (*StorefrontVerification) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *StorefrontVerification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontVerification) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *StorefrontVerification) GetSubmittedBy() int64 {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return 0
+}
+
+func (x *StorefrontVerification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetRegistrationNumber() string {
+	if x != nil && x.RegistrationNumber != nil {
+		return *x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetTaxId() string {
+	if x != nil && x.TaxId != nil {
+		return *x.TaxId
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetReviewedBy() int64 {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *StorefrontVerification) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *StorefrontVerification) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *StorefrontVerification) GetDocuments() []*StorefrontVerificationDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *StorefrontVerification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StorefrontVerification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// StorefrontVerificationEvent is an audit record of the verification workflow
+type StorefrontVerificationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestId     *int64                 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // submit, approve, reject, cancel, revoke
+	ActorId       int64                  `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorefrontVerificationEvent) Reset() {
+	*x = StorefrontVerificationEvent{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontVerificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontVerificationEvent) ProtoMessage() {}
+
+func (x *StorefrontVerificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontVerificationEvent.ProtoReflect.Descriptor instead.
+func (*StorefrontVerificationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *StorefrontVerificationEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorefrontVerificationEvent) GetRequestId() int64 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+func (x *StorefrontVerificationEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationEvent) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *StorefrontVerificationEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *StorefrontVerificationEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SubmitStorefrontVerificationRequest submits a verification request
+type SubmitStorefrontVerificationRequest struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	StorefrontId       int64                         `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	BusinessName       string                        `protobuf:"bytes,2,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	RegistrationNumber *string                       `protobuf:"bytes,3,opt,name=registration_number,json=registrationNumber,proto3,oneof" json:"registration_number,omitempty"` // 8 digits
+	TaxId              *string                       `protobuf:"bytes,4,opt,name=tax_id,json=taxId,proto3,oneof" json:"tax_id,omitempty"`                                        // 9 digits
+	Notes              *string                       `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Documents          []*VerificationDocumentUpload `protobuf:"bytes,6,rep,name=documents,proto3" json:"documents,omitempty"` // 1-10 documents
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubmitStorefrontVerificationRequest) Reset() {
+	*x = SubmitStorefrontVerificationRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitStorefrontVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStorefrontVerificationRequest) ProtoMessage() {}
+
+func (x *SubmitStorefrontVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStorefrontVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStorefrontVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetRegistrationNumber() string {
+	if x != nil && x.RegistrationNumber != nil {
+		return *x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetTaxId() string {
+	if x != nil && x.TaxId != nil {
+		return *x.TaxId
+	}
+	return ""
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *SubmitStorefrontVerificationRequest) GetDocuments() []*VerificationDocumentUpload {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// GetStorefrontVerificationRequest addresses a storefront's verification
+type GetStorefrontVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorefrontVerificationRequest) Reset() {
+	*x = GetStorefrontVerificationRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontVerificationRequest) ProtoMessage() {}
+
+func (x *GetStorefrontVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontVerificationRequest.ProtoReflect.Descriptor instead.
+func (*GetStorefrontVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *GetStorefrontVerificationRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// GetStorefrontVerificationResponse returns the verification state of a storefront
+type GetStorefrontVerificationResponse struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	IsVerified       bool                           `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerificationDate *timestamppb.Timestamp         `protobuf:"bytes,2,opt,name=verification_date,json=verificationDate,proto3,oneof" json:"verification_date,omitempty"`
+	AddressVerified  bool                           `protobuf:"varint,3,opt,name=address_verified,json=addressVerified,proto3" json:"address_verified,omitempty"`
+	LatestRequest    *StorefrontVerification        `protobuf:"bytes,4,opt,name=latest_request,json=latestRequest,proto3,oneof" json:"latest_request,omitempty"`
+	History          []*StorefrontVerificationEvent `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"` // Newest first
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetStorefrontVerificationResponse) Reset() {
+	*x = GetStorefrontVerificationResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontVerificationResponse) ProtoMessage() {}
+
+func (x *GetStorefrontVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetId() int64 {
+// Deprecated: Use GetStorefrontVerificationResponse.ProtoReflect.Descriptor instead.
+func (*GetStorefrontVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *GetStorefrontVerificationResponse) GetIsVerified() bool {
 	if x != nil {
-		return x.Id
+		return x.IsVerified
 	}
-	return 0
+	return false
 }
 
-func (x *StorefrontFull) GetUserId() int64 {
+func (x *GetStorefrontVerificationResponse) GetVerificationDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.VerificationDate
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontFull) GetSlug() string {
+func (x *GetStorefrontVerificationResponse) GetAddressVerified() bool {
 	if x != nil {
-		return x.Slug
+		return x.AddressVerified
 	}
-	return ""
+	return false
 }
 
-func (x *StorefrontFull) GetName() string {
+func (x *GetStorefrontVerificationResponse) GetLatestRequest() *StorefrontVerification {
 	if x != nil {
-		return x.Name
+		return x.LatestRequest
 	}
-	return ""
+	return nil
 }
 
-func (x *StorefrontFull) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *GetStorefrontVerificationResponse) GetHistory() []*StorefrontVerificationEvent {
+	if x != nil {
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-func (x *StorefrontFull) GetLogoUrl() string {
-	if x != nil && x.LogoUrl != nil {
-		return *x.LogoUrl
+// ListVerificationRequestsRequest pages through the verification review queue
+type ListVerificationRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Default: pending
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Default: 50, max: 200
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVerificationRequestsRequest) Reset() {
+	*x = ListVerificationRequestsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVerificationRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVerificationRequestsRequest) ProtoMessage() {}
+
+func (x *ListVerificationRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetBannerUrl() string {
-	if x != nil && x.BannerUrl != nil {
-		return *x.BannerUrl
+// Deprecated: Use ListVerificationRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListVerificationRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ListVerificationRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *StorefrontFull) GetTheme() *structpb.Struct {
+func (x *ListVerificationRequestsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Theme
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontFull) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+func (x *ListVerificationRequestsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontFull) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+type ListVerificationRequestsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Requests      []*StorefrontVerification `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int32                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVerificationRequestsResponse) Reset() {
+	*x = ListVerificationRequestsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVerificationRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVerificationRequestsResponse) ProtoMessage() {}
+
+func (x *ListVerificationRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetWebsite() string {
-	if x != nil && x.Website != nil {
-		return *x.Website
+// Deprecated: Use ListVerificationRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListVerificationRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ListVerificationRequestsResponse) GetRequests() []*StorefrontVerification {
+	if x != nil {
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-func (x *StorefrontFull) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
+func (x *ListVerificationRequestsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontFull) GetCity() string {
-	if x != nil && x.City != nil {
-		return *x.City
+// ReviewStorefrontVerificationRequest records an admin decision
+type ReviewStorefrontVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Required when rejecting
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewStorefrontVerificationRequest) Reset() {
+	*x = ReviewStorefrontVerificationRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStorefrontVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStorefrontVerificationRequest) ProtoMessage() {}
+
+func (x *ReviewStorefrontVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetPostalCode() string {
-	if x != nil && x.PostalCode != nil {
-		return *x.PostalCode
+// Deprecated: Use ReviewStorefrontVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewStorefrontVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ReviewStorefrontVerificationRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontFull) GetCountry() string {
-	if x != nil && x.Country != nil {
-		return *x.Country
+func (x *ReviewStorefrontVerificationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewStorefrontVerificationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *StorefrontFull) GetLatitude() float64 {
-	if x != nil && x.Latitude != nil {
-		return *x.Latitude
+// RevokeStorefrontVerificationRequest removes a storefront's verified status
+type RevokeStorefrontVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeStorefrontVerificationRequest) Reset() {
+	*x = RevokeStorefrontVerificationRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeStorefrontVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeStorefrontVerificationRequest) ProtoMessage() {}
+
+func (x *RevokeStorefrontVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetLongitude() float64 {
-	if x != nil && x.Longitude != nil {
-		return *x.Longitude
+// Deprecated: Use RevokeStorefrontVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeStorefrontVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *RevokeStorefrontVerificationRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *StorefrontFull) GetFormattedAddress() string {
-	if x != nil && x.FormattedAddress != nil {
-		return *x.FormattedAddress
+func (x *RevokeStorefrontVerificationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *StorefrontFull) GetGeoStrategy() StorefrontGeoStrategy {
+// SetPaymentMethodsRequest sets payment methods
+type SetPaymentMethodsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	StorefrontId  int64                      `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Methods       []*StorefrontPaymentMethod `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPaymentMethodsRequest) Reset() {
+	*x = SetPaymentMethodsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *SetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[189]
 	if x != nil {
-		return x.GeoStrategy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return StorefrontGeoStrategy_STOREFRONT_GEO_STRATEGY_UNSPECIFIED
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*SetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *SetPaymentMethodsRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+func (x *SetPaymentMethodsRequest) GetMethods() []*StorefrontPaymentMethod {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+// GetPaymentMethodsRequest retrieves payment methods
+type GetPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentMethodsRequest) Reset() {
+	*x = GetPaymentMethodsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontFull) GetDefaultPrivacyLevel() LocationPrivacyLevel {
+func (*GetPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[190]
 	if x != nil {
-		return x.DefaultPrivacyLevel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return LocationPrivacyLevel_LOCATION_PRIVACY_LEVEL_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetAddressVerified() bool {
-	if x != nil {
-		return x.AddressVerified
-	}
-	return false
+// Deprecated: Use GetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *StorefrontFull) GetSettings() *structpb.Struct {
+func (x *GetPaymentMethodsRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.Settings
+		return x.StorefrontId
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontFull) GetSeoMeta() *structpb.Struct {
-	if x != nil {
-		return x.SeoMeta
-	}
-	return nil
+// GetPaymentMethodsResponse returns payment methods
+type GetPaymentMethodsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Methods       []*StorefrontPaymentMethod `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontFull) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
+func (x *GetPaymentMethodsResponse) Reset() {
+	*x = GetPaymentMethodsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontFull) GetIsVerified() bool {
-	if x != nil {
-		return x.IsVerified
-	}
-	return false
+func (x *GetPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontFull) GetVerificationDate() *timestamppb.Timestamp {
+func (*GetPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *GetPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[191]
 	if x != nil {
-		return x.VerificationDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+// Deprecated: Use GetPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *StorefrontFull) GetReviewsCount() int32 {
+func (x *GetPaymentMethodsResponse) GetMethods() []*StorefrontPaymentMethod {
 	if x != nil {
-		return x.ReviewsCount
+		return x.Methods
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontFull) GetProductsCount() int32 {
-	if x != nil {
-		return x.ProductsCount
-	}
-	return 0
+// SetDeliveryOptionsRequest sets delivery options
+type SetDeliveryOptionsRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	StorefrontId  int64                       `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Options       []*StorefrontDeliveryOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontFull) GetSalesCount() int32 {
-	if x != nil {
-		return x.SalesCount
-	}
-	return 0
+func (x *SetDeliveryOptionsRequest) Reset() {
+	*x = SetDeliveryOptionsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontFull) GetViewsCount() int32 {
-	if x != nil {
-		return x.ViewsCount
-	}
-	return 0
+func (x *SetDeliveryOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontFull) GetSubscriptionPlan() SubscriptionPlanType {
+func (*SetDeliveryOptionsRequest) ProtoMessage() {}
+
+func (x *SetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[192]
 	if x != nil {
-		return x.SubscriptionPlan
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return SubscriptionPlanType_SUBSCRIPTION_PLAN_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetSubscriptionExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SubscriptionExpiresAt
-	}
-	return nil
+// Deprecated: Use SetDeliveryOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetDeliveryOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *StorefrontFull) GetCommissionRate() float64 {
+func (x *SetDeliveryOptionsRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.CommissionRate
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *StorefrontFull) GetSubscriptionId() int64 {
-	if x != nil && x.SubscriptionId != nil {
-		return *x.SubscriptionId
+func (x *SetDeliveryOptionsRequest) GetOptions() []*StorefrontDeliveryOption {
+	if x != nil {
+		return x.Options
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontFull) GetIsSubscriptionActive() bool {
-	if x != nil {
-		return x.IsSubscriptionActive
-	}
-	return false
+// GetDeliveryOptionsRequest retrieves delivery options
+type GetDeliveryOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontFull) GetAiAgentEnabled() bool {
-	if x != nil {
-		return x.AiAgentEnabled
-	}
-	return false
+func (x *GetDeliveryOptionsRequest) Reset() {
+	*x = GetDeliveryOptionsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontFull) GetAiAgentConfig() *structpb.Struct {
-	if x != nil {
-		return x.AiAgentConfig
-	}
-	return nil
+func (x *GetDeliveryOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontFull) GetLiveShoppingEnabled() bool {
+func (*GetDeliveryOptionsRequest) ProtoMessage() {}
+
+func (x *GetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[193]
 	if x != nil {
-		return x.LiveShoppingEnabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetGroupBuyingEnabled() bool {
-	if x != nil {
-		return x.GroupBuyingEnabled
-	}
-	return false
+// Deprecated: Use GetDeliveryOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *StorefrontFull) GetFollowersCount() int32 {
+func (x *GetDeliveryOptionsRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.FollowersCount
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *StorefrontFull) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// GetDeliveryOptionsResponse returns delivery options
+type GetDeliveryOptionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Options       []*StorefrontDeliveryOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontFull) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *GetDeliveryOptionsResponse) Reset() {
+	*x = GetDeliveryOptionsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontFull) GetStaff() []*StorefrontStaff {
-	if x != nil {
-		return x.Staff
-	}
-	return nil
+func (x *GetDeliveryOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontFull) GetHours() []*StorefrontHours {
+func (*GetDeliveryOptionsResponse) ProtoMessage() {}
+
+func (x *GetDeliveryOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[194]
 	if x != nil {
-		return x.Hours
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontFull) GetPaymentMethods() []*StorefrontPaymentMethod {
-	if x != nil {
-		return x.PaymentMethods
-	}
-	return nil
+// Deprecated: Use GetDeliveryOptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *StorefrontFull) GetDeliveryOptions() []*StorefrontDeliveryOption {
+func (x *GetDeliveryOptionsResponse) GetOptions() []*StorefrontDeliveryOption {
 	if x != nil {
-		return x.DeliveryOptions
+		return x.Options
 	}
 	return nil
 }
 
-// StorefrontStaff represents staff member (b2c_store_staff table)
-type StorefrontStaff struct {
+// StorefrontMapData represents storefront data for map display
+type StorefrontMapData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role          StaffRole              `protobuf:"varint,4,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"` // default: staff
-	Permissions   *structpb.Struct       `protobuf:"bytes,5,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`            // JSON: {canAddProducts, canEditProducts, ...}
-	LastActiveAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_active_at,json=lastActiveAt,proto3,oneof" json:"last_active_at,omitempty"`
-	ActionsCount  int32                  `protobuf:"varint,7,opt,name=actions_count,json=actionsCount,proto3" json:"actions_count,omitempty"` // default: 0
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Rating        float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,7,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Address       string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	Phone         string                 `protobuf:"bytes,9,opt,name=phone,proto3" json:"phone,omitempty"`
+	WorkingNow    bool                   `protobuf:"varint,10,opt,name=working_now,json=workingNow,proto3" json:"working_now,omitempty"`
+	ProductsCount int32                  `protobuf:"varint,11,opt,name=products_count,json=productsCount,proto3" json:"products_count,omitempty"`
+	SupportsCod   bool                   `protobuf:"varint,12,opt,name=supports_cod,json=supportsCod,proto3" json:"supports_cod,omitempty"`
+	HasDelivery   bool                   `protobuf:"varint,13,opt,name=has_delivery,json=hasDelivery,proto3" json:"has_delivery,omitempty"`
+	HasSelfPickup bool                   `protobuf:"varint,14,opt,name=has_self_pickup,json=hasSelfPickup,proto3" json:"has_self_pickup,omitempty"`
+	AcceptsCards  bool                   `protobuf:"varint,15,opt,name=accepts_cards,json=acceptsCards,proto3" json:"accepts_cards,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontStaff) Reset() {
-	*x = StorefrontStaff{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[116]
+func (x *StorefrontMapData) Reset() {
+	*x = StorefrontMapData{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontStaff) String() string {
+func (x *StorefrontMapData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorefrontStaff) ProtoMessage() {}
+func (*StorefrontMapData) ProtoMessage() {}
 
-func (x *StorefrontStaff) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[116]
+func (x *StorefrontMapData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9716,207 +15471,143 @@ func (x *StorefrontStaff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorefrontStaff.ProtoReflect.Descriptor instead.
-func (*StorefrontStaff) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use StorefrontMapData.ProtoReflect.Descriptor instead.
+func (*StorefrontMapData) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *StorefrontStaff) GetId() int64 {
+func (x *StorefrontMapData) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *StorefrontStaff) GetStorefrontId() int64 {
-	if x != nil {
-		return x.StorefrontId
-	}
-	return 0
-}
-
-func (x *StorefrontStaff) GetUserId() int64 {
+func (x *StorefrontMapData) GetSlug() string {
 	if x != nil {
-		return x.UserId
+		return x.Slug
 	}
-	return 0
+	return ""
 }
 
-func (x *StorefrontStaff) GetRole() StaffRole {
+func (x *StorefrontMapData) GetName() string {
 	if x != nil {
-		return x.Role
+		return x.Name
 	}
-	return StaffRole_STAFF_ROLE_UNSPECIFIED
+	return ""
 }
 
-func (x *StorefrontStaff) GetPermissions() *structpb.Struct {
+func (x *StorefrontMapData) GetLatitude() float64 {
 	if x != nil {
-		return x.Permissions
+		return x.Latitude
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontStaff) GetLastActiveAt() *timestamppb.Timestamp {
+func (x *StorefrontMapData) GetLongitude() float64 {
 	if x != nil {
-		return x.LastActiveAt
+		return x.Longitude
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontStaff) GetActionsCount() int32 {
+func (x *StorefrontMapData) GetRating() float64 {
 	if x != nil {
-		return x.ActionsCount
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *StorefrontStaff) GetCreatedAt() *timestamppb.Timestamp {
+func (x *StorefrontMapData) GetLogoUrl() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.LogoUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *StorefrontStaff) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *StorefrontMapData) GetAddress() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Address
 	}
-	return nil
-}
-
-// StorefrontHours represents working hours (b2c_store_hours table)
-type StorefrontHours struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	DayOfWeek     int32                  `protobuf:"varint,3,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`          // 0=Sunday, 6=Saturday (CHECK: 0-6)
-	OpenTime      *string                `protobuf:"bytes,4,opt,name=open_time,json=openTime,proto3,oneof" json:"open_time,omitempty"`          // TIME (HH:MM:SS format)
-	CloseTime     *string                `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3,oneof" json:"close_time,omitempty"`       // TIME (HH:MM:SS format)
-	IsClosed      bool                   `protobuf:"varint,6,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`               // default: false
-	SpecialDate   *string                `protobuf:"bytes,7,opt,name=special_date,json=specialDate,proto3,oneof" json:"special_date,omitempty"` // DATE (YYYY-MM-DD format)
-	SpecialNote   *string                `protobuf:"bytes,8,opt,name=special_note,json=specialNote,proto3,oneof" json:"special_note,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *StorefrontHours) Reset() {
-	*x = StorefrontHours{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[117]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StorefrontHours) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*StorefrontHours) ProtoMessage() {}
-
-func (x *StorefrontHours) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[117]
+func (x *StorefrontMapData) GetPhone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Phone
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StorefrontHours.ProtoReflect.Descriptor instead.
-func (*StorefrontHours) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{117}
+	return ""
 }
 
-func (x *StorefrontHours) GetId() int64 {
+func (x *StorefrontMapData) GetWorkingNow() bool {
 	if x != nil {
-		return x.Id
+		return x.WorkingNow
 	}
-	return 0
+	return false
 }
 
-func (x *StorefrontHours) GetStorefrontId() int64 {
+func (x *StorefrontMapData) GetProductsCount() int32 {
 	if x != nil {
-		return x.StorefrontId
+		return x.ProductsCount
 	}
 	return 0
 }
 
-func (x *StorefrontHours) GetDayOfWeek() int32 {
+func (x *StorefrontMapData) GetSupportsCod() bool {
 	if x != nil {
-		return x.DayOfWeek
-	}
-	return 0
-}
-
-func (x *StorefrontHours) GetOpenTime() string {
-	if x != nil && x.OpenTime != nil {
-		return *x.OpenTime
-	}
-	return ""
-}
-
-func (x *StorefrontHours) GetCloseTime() string {
-	if x != nil && x.CloseTime != nil {
-		return *x.CloseTime
+		return x.SupportsCod
 	}
-	return ""
+	return false
 }
 
-func (x *StorefrontHours) GetIsClosed() bool {
+func (x *StorefrontMapData) GetHasDelivery() bool {
 	if x != nil {
-		return x.IsClosed
+		return x.HasDelivery
 	}
 	return false
 }
 
-func (x *StorefrontHours) GetSpecialDate() string {
-	if x != nil && x.SpecialDate != nil {
-		return *x.SpecialDate
+func (x *StorefrontMapData) GetHasSelfPickup() bool {
+	if x != nil {
+		return x.HasSelfPickup
 	}
-	return ""
+	return false
 }
 
-func (x *StorefrontHours) GetSpecialNote() string {
-	if x != nil && x.SpecialNote != nil {
-		return *x.SpecialNote
+func (x *StorefrontMapData) GetAcceptsCards() bool {
+	if x != nil {
+		return x.AcceptsCards
 	}
-	return ""
+	return false
 }
 
-// StorefrontPaymentMethod represents payment method (b2c_payment_methods table)
-type StorefrontPaymentMethod struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StorefrontId   int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	MethodType     PaymentMethodType      `protobuf:"varint,3,opt,name=method_type,json=methodType,proto3,enum=listingssvc.v1.PaymentMethodType" json:"method_type,omitempty"`
-	IsEnabled      bool                   `protobuf:"varint,4,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"` // default: true
-	Provider       *string                `protobuf:"bytes,5,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
-	Settings       *structpb.Struct       `protobuf:"bytes,6,opt,name=settings,proto3,oneof" json:"settings,omitempty"`                               // JSON
-	TransactionFee float64                `protobuf:"fixed64,7,opt,name=transaction_fee,json=transactionFee,proto3" json:"transaction_fee,omitempty"` // NUMERIC(5,2), default: 0.00
-	MinAmount      *float64               `protobuf:"fixed64,8,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`          // NUMERIC(10,2)
-	MaxAmount      *float64               `protobuf:"fixed64,9,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`          // NUMERIC(10,2)
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetMapDataRequest retrieves map data
+type GetMapDataRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	North         float64                 `protobuf:"fixed64,1,opt,name=north,proto3" json:"north,omitempty"` // Bounds
+	South         float64                 `protobuf:"fixed64,2,opt,name=south,proto3" json:"south,omitempty"`
+	East          float64                 `protobuf:"fixed64,3,opt,name=east,proto3" json:"east,omitempty"`
+	West          float64                 `protobuf:"fixed64,4,opt,name=west,proto3" json:"west,omitempty"`
+	Filter        *ListStorefrontsRequest `protobuf:"bytes,5,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Additional filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontPaymentMethod) Reset() {
-	*x = StorefrontPaymentMethod{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[118]
+func (x *GetMapDataRequest) Reset() {
+	*x = GetMapDataRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontPaymentMethod) String() string {
+func (x *GetMapDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorefrontPaymentMethod) ProtoMessage() {}
+func (*GetMapDataRequest) ProtoMessage() {}
 
-func (x *StorefrontPaymentMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[118]
+func (x *GetMapDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9927,125 +15618,69 @@ func (x *StorefrontPaymentMethod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorefrontPaymentMethod.ProtoReflect.Descriptor instead.
-func (*StorefrontPaymentMethod) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use GetMapDataRequest.ProtoReflect.Descriptor instead.
+func (*GetMapDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *StorefrontPaymentMethod) GetId() int64 {
+func (x *GetMapDataRequest) GetNorth() float64 {
 	if x != nil {
-		return x.Id
+		return x.North
 	}
 	return 0
 }
 
-func (x *StorefrontPaymentMethod) GetStorefrontId() int64 {
+func (x *GetMapDataRequest) GetSouth() float64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.South
 	}
 	return 0
 }
 
-func (x *StorefrontPaymentMethod) GetMethodType() PaymentMethodType {
-	if x != nil {
-		return x.MethodType
-	}
-	return PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED
-}
-
-func (x *StorefrontPaymentMethod) GetIsEnabled() bool {
-	if x != nil {
-		return x.IsEnabled
-	}
-	return false
-}
-
-func (x *StorefrontPaymentMethod) GetProvider() string {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
-	}
-	return ""
-}
-
-func (x *StorefrontPaymentMethod) GetSettings() *structpb.Struct {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
-}
-
-func (x *StorefrontPaymentMethod) GetTransactionFee() float64 {
+func (x *GetMapDataRequest) GetEast() float64 {
 	if x != nil {
-		return x.TransactionFee
-	}
-	return 0
-}
-
-func (x *StorefrontPaymentMethod) GetMinAmount() float64 {
-	if x != nil && x.MinAmount != nil {
-		return *x.MinAmount
+		return x.East
 	}
 	return 0
 }
 
-func (x *StorefrontPaymentMethod) GetMaxAmount() float64 {
-	if x != nil && x.MaxAmount != nil {
-		return *x.MaxAmount
+func (x *GetMapDataRequest) GetWest() float64 {
+	if x != nil {
+		return x.West
 	}
 	return 0
 }
 
-func (x *StorefrontPaymentMethod) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetMapDataRequest) GetFilter() *ListStorefrontsRequest {
 	if x != nil {
-		return x.CreatedAt
+		return x.Filter
 	}
 	return nil
 }
 
-// StorefrontDeliveryOption represents delivery option (b2c_delivery_options table)
-type StorefrontDeliveryOption struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StorefrontId     int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	BasePrice        float64                `protobuf:"fixed64,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`                           // NUMERIC(10,2), default: 0.00
-	PricePerKm       float64                `protobuf:"fixed64,6,opt,name=price_per_km,json=pricePerKm,proto3" json:"price_per_km,omitempty"`                      // NUMERIC(10,2), default: 0.00
-	PricePerKg       float64                `protobuf:"fixed64,7,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`                      // NUMERIC(10,2), default: 0.00
-	FreeAboveAmount  *float64               `protobuf:"fixed64,8,opt,name=free_above_amount,json=freeAboveAmount,proto3,oneof" json:"free_above_amount,omitempty"` // NUMERIC(10,2)
-	MinOrderAmount   *float64               `protobuf:"fixed64,9,opt,name=min_order_amount,json=minOrderAmount,proto3,oneof" json:"min_order_amount,omitempty"`    // NUMERIC(10,2)
-	MaxWeightKg      *float64               `protobuf:"fixed64,10,opt,name=max_weight_kg,json=maxWeightKg,proto3,oneof" json:"max_weight_kg,omitempty"`            // NUMERIC(10,2)
-	MaxDistanceKm    *float64               `protobuf:"fixed64,11,opt,name=max_distance_km,json=maxDistanceKm,proto3,oneof" json:"max_distance_km,omitempty"`      // NUMERIC(10,2)
-	EstimatedDaysMin int32                  `protobuf:"varint,12,opt,name=estimated_days_min,json=estimatedDaysMin,proto3" json:"estimated_days_min,omitempty"`    // default: 1
-	EstimatedDaysMax int32                  `protobuf:"varint,13,opt,name=estimated_days_max,json=estimatedDaysMax,proto3" json:"estimated_days_max,omitempty"`    // default: 3
-	Zones            *structpb.Struct       `protobuf:"bytes,14,opt,name=zones,proto3,oneof" json:"zones,omitempty"`                                               // JSON array
-	AvailableDays    *structpb.Struct       `protobuf:"bytes,15,opt,name=available_days,json=availableDays,proto3,oneof" json:"available_days,omitempty"`          // JSON array: [1,2,3,4,5]
-	CutoffTime       *string                `protobuf:"bytes,16,opt,name=cutoff_time,json=cutoffTime,proto3,oneof" json:"cutoff_time,omitempty"`                   // TIME (HH:MM:SS)
-	Provider         *string                `protobuf:"bytes,17,opt,name=provider,proto3,oneof" json:"provider,omitempty"`                                         // DeliveryProvider as string
-	ProviderConfig   *structpb.Struct       `protobuf:"bytes,18,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`       // JSON
-	IsActive         bool                   `protobuf:"varint,19,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                              // default: true
-	DisplayOrder     int32                  `protobuf:"varint,20,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`                  // default: 0
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// GetMapDataResponse returns map data
+type GetMapDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Storefronts   []*StorefrontMapData   `protobuf:"bytes,1,rep,name=storefronts,proto3" json:"storefronts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontDeliveryOption) Reset() {
-	*x = StorefrontDeliveryOption{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[119]
+func (x *GetMapDataResponse) Reset() {
+	*x = GetMapDataResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontDeliveryOption) String() string {
+func (x *GetMapDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorefrontDeliveryOption) ProtoMessage() {}
+func (*GetMapDataResponse) ProtoMessage() {}
 
-func (x *StorefrontDeliveryOption) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[119]
+func (x *GetMapDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10056,193 +15691,232 @@ func (x *StorefrontDeliveryOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorefrontDeliveryOption.ProtoReflect.Descriptor instead.
-func (*StorefrontDeliveryOption) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use GetMapDataResponse.ProtoReflect.Descriptor instead.
+func (*GetMapDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *StorefrontDeliveryOption) GetId() int64 {
+func (x *GetMapDataResponse) GetStorefronts() []*StorefrontMapData {
 	if x != nil {
-		return x.Id
+		return x.Storefronts
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontDeliveryOption) GetStorefrontId() int64 {
-	if x != nil {
-		return x.StorefrontId
-	}
-	return 0
+// DashboardStatsRequest retrieves dashboard statistics
+type DashboardStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StorefrontDeliveryOption) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *DashboardStatsRequest) Reset() {
+	*x = DashboardStatsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontDeliveryOption) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *DashboardStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontDeliveryOption) GetBasePrice() float64 {
+func (*DashboardStatsRequest) ProtoMessage() {}
+
+func (x *DashboardStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[198]
 	if x != nil {
-		return x.BasePrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontDeliveryOption) GetPricePerKm() float64 {
+// Deprecated: Use DashboardStatsRequest.ProtoReflect.Descriptor instead.
+func (*DashboardStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *DashboardStatsRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.PricePerKm
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetPricePerKg() float64 {
+func (x *DashboardStatsRequest) GetDateFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PricePerKg
+		return x.DateFrom
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontDeliveryOption) GetFreeAboveAmount() float64 {
-	if x != nil && x.FreeAboveAmount != nil {
-		return *x.FreeAboveAmount
+func (x *DashboardStatsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontDeliveryOption) GetMinOrderAmount() float64 {
-	if x != nil && x.MinOrderAmount != nil {
-		return *x.MinOrderAmount
-	}
-	return 0
+// DashboardStatsResponse returns dashboard statistics
+type DashboardStatsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TotalProducts    int32                  `protobuf:"varint,1,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
+	ActiveProducts   int32                  `protobuf:"varint,2,opt,name=active_products,json=activeProducts,proto3" json:"active_products,omitempty"`
+	OrdersCount      int32                  `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	Revenue          float64                `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	AvgOrderValue    float64                `protobuf:"fixed64,5,opt,name=avg_order_value,json=avgOrderValue,proto3" json:"avg_order_value,omitempty"`
+	ViewsCount       int32                  `protobuf:"varint,6,opt,name=views_count,json=viewsCount,proto3" json:"views_count,omitempty"`
+	UniqueVisitors   int32                  `protobuf:"varint,7,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	ConversionRate   float64                `protobuf:"fixed64,8,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	PendingOrders    int32                  `protobuf:"varint,9,opt,name=pending_orders,json=pendingOrders,proto3" json:"pending_orders,omitempty"`
+	LowStockProducts int32                  `protobuf:"varint,10,opt,name=low_stock_products,json=lowStockProducts,proto3" json:"low_stock_products,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StorefrontDeliveryOption) GetMaxWeightKg() float64 {
-	if x != nil && x.MaxWeightKg != nil {
-		return *x.MaxWeightKg
-	}
-	return 0
+func (x *DashboardStatsResponse) Reset() {
+	*x = DashboardStatsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontDeliveryOption) GetMaxDistanceKm() float64 {
-	if x != nil && x.MaxDistanceKm != nil {
-		return *x.MaxDistanceKm
-	}
-	return 0
+func (x *DashboardStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StorefrontDeliveryOption) GetEstimatedDaysMin() int32 {
+func (*DashboardStatsResponse) ProtoMessage() {}
+
+func (x *DashboardStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[199]
 	if x != nil {
-		return x.EstimatedDaysMin
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StorefrontDeliveryOption) GetEstimatedDaysMax() int32 {
+// Deprecated: Use DashboardStatsResponse.ProtoReflect.Descriptor instead.
+func (*DashboardStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *DashboardStatsResponse) GetTotalProducts() int32 {
 	if x != nil {
-		return x.EstimatedDaysMax
+		return x.TotalProducts
 	}
 	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetZones() *structpb.Struct {
+func (x *DashboardStatsResponse) GetActiveProducts() int32 {
 	if x != nil {
-		return x.Zones
+		return x.ActiveProducts
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetAvailableDays() *structpb.Struct {
+func (x *DashboardStatsResponse) GetOrdersCount() int32 {
 	if x != nil {
-		return x.AvailableDays
+		return x.OrdersCount
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetCutoffTime() string {
-	if x != nil && x.CutoffTime != nil {
-		return *x.CutoffTime
+func (x *DashboardStatsResponse) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetProvider() string {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
+func (x *DashboardStatsResponse) GetAvgOrderValue() float64 {
+	if x != nil {
+		return x.AvgOrderValue
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetProviderConfig() *structpb.Struct {
+func (x *DashboardStatsResponse) GetViewsCount() int32 {
 	if x != nil {
-		return x.ProviderConfig
+		return x.ViewsCount
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetIsActive() bool {
+func (x *DashboardStatsResponse) GetUniqueVisitors() int32 {
 	if x != nil {
-		return x.IsActive
+		return x.UniqueVisitors
 	}
-	return false
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetDisplayOrder() int32 {
+func (x *DashboardStatsResponse) GetConversionRate() float64 {
 	if x != nil {
-		return x.DisplayOrder
+		return x.ConversionRate
 	}
 	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DashboardStatsResponse) GetPendingOrders() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PendingOrders
 	}
-	return nil
+	return 0
 }
 
-func (x *StorefrontDeliveryOption) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DashboardStatsResponse) GetLowStockProducts() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.LowStockProducts
 	}
-	return nil
+	return 0
 }
 
-// Location helper structure for address
-type Location struct {
+// ProductImage represents an image associated with a B2C product
+type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserLat       float64                `protobuf:"fixed64,1,opt,name=user_lat,json=userLat,proto3" json:"user_lat,omitempty"` // latitude
-	UserLng       float64                `protobuf:"fixed64,2,opt,name=user_lng,json=userLng,proto3" json:"user_lng,omitempty"` // longitude
-	FullAddress   string                 `protobuf:"bytes,3,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
-	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
-	PostalCode    *string                `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3,oneof" json:"postal_code,omitempty"`
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"` // default: "RS"
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	StoragePath   *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
+	ThumbnailUrl  *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	FileSize      *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
+	MimeType      *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	VariantId     *int64                 `protobuf:"varint,14,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"` // Set for product variant images
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[120]
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Location) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[120]
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10253,88 +15927,143 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *Location) GetUserLat() float64 {
+func (x *ProductImage) GetId() int64 {
 	if x != nil {
-		return x.UserLat
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Location) GetUserLng() float64 {
+func (x *ProductImage) GetProductId() int64 {
 	if x != nil {
-		return x.UserLng
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *Location) GetFullAddress() string {
+func (x *ProductImage) GetUrl() string {
 	if x != nil {
-		return x.FullAddress
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Location) GetCity() string {
+func (x *ProductImage) GetStoragePath() string {
+	if x != nil && x.StoragePath != nil {
+		return *x.StoragePath
+	}
+	return ""
+}
+
+func (x *ProductImage) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ProductImage) GetDisplayOrder() int32 {
 	if x != nil {
-		return x.City
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *ProductImage) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *ProductImage) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *ProductImage) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *ProductImage) GetFileSize() int64 {
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
+	}
+	return 0
+}
+
+func (x *ProductImage) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
 	}
 	return ""
 }
 
-func (x *Location) GetPostalCode() string {
-	if x != nil && x.PostalCode != nil {
-		return *x.PostalCode
+func (x *ProductImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Location) GetCountry() string {
+func (x *ProductImage) GetUpdatedAt() string {
 	if x != nil {
-		return x.Country
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-// CreateStorefrontRequest creates a new storefront
-type CreateStorefrontRequest struct {
+func (x *ProductImage) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return 0
+}
+
+// AddProductImageRequest adds a new image to a B2C product
+type AddProductImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner (from JWT token)
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // Required
-	Slug          *string                `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`              // Auto-generated if empty
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Logo          []byte                 `protobuf:"bytes,5,opt,name=logo,proto3,oneof" json:"logo,omitempty"`     // File content for upload
-	Banner        []byte                 `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"` // File content for upload
-	Theme         *structpb.Struct       `protobuf:"bytes,7,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
-	Phone         *string                `protobuf:"bytes,8,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email         *string                `protobuf:"bytes,9,opt,name=email,proto3,oneof" json:"email,omitempty"`      // Validated
-	Website       *string                `protobuf:"bytes,10,opt,name=website,proto3,oneof" json:"website,omitempty"` // Validated
-	Location      *Location              `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`     // Required
-	Settings      *structpb.Struct       `protobuf:"bytes,12,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
-	SeoMeta       *structpb.Struct       `protobuf:"bytes,13,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`          // Required
+	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                        // Required: Full URL to image in MinIO/S3
+	StoragePath   *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
+	ThumbnailUrl  *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	FileSize      *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
+	MimeType      *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	VariantId     *int64                 `protobuf:"varint,12,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"` // Attach the image to a variant of the product
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStorefrontRequest) Reset() {
-	*x = CreateStorefrontRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[121]
+func (x *AddProductImageRequest) Reset() {
+	*x = AddProductImageRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStorefrontRequest) String() string {
+func (x *AddProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStorefrontRequest) ProtoMessage() {}
+func (*AddProductImageRequest) ProtoMessage() {}
 
-func (x *CreateStorefrontRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[121]
+func (x *AddProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10345,140 +16074,118 @@ func (x *CreateStorefrontRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStorefrontRequest.ProtoReflect.Descriptor instead.
-func (*CreateStorefrontRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use AddProductImageRequest.ProtoReflect.Descriptor instead.
+func (*AddProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CreateStorefrontRequest) GetUserId() int64 {
+func (x *AddProductImageRequest) GetProductId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *CreateStorefrontRequest) GetName() string {
+func (x *AddProductImageRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.Name
+		return x.StorefrontId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateStorefrontRequest) GetSlug() string {
-	if x != nil && x.Slug != nil {
-		return *x.Slug
+func (x *AddProductImageRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateStorefrontRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *AddProductImageRequest) GetStoragePath() string {
+	if x != nil && x.StoragePath != nil {
+		return *x.StoragePath
 	}
 	return ""
 }
 
-func (x *CreateStorefrontRequest) GetLogo() []byte {
-	if x != nil {
-		return x.Logo
+func (x *AddProductImageRequest) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateStorefrontRequest) GetBanner() []byte {
+func (x *AddProductImageRequest) GetDisplayOrder() int32 {
 	if x != nil {
-		return x.Banner
+		return x.DisplayOrder
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateStorefrontRequest) GetTheme() *structpb.Struct {
+func (x *AddProductImageRequest) GetIsPrimary() bool {
 	if x != nil {
-		return x.Theme
-	}
-	return nil
-}
-
-func (x *CreateStorefrontRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
-}
-
-func (x *CreateStorefrontRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
-}
-
-func (x *CreateStorefrontRequest) GetWebsite() string {
-	if x != nil && x.Website != nil {
-		return *x.Website
+		return x.IsPrimary
 	}
-	return ""
+	return false
 }
 
-func (x *CreateStorefrontRequest) GetLocation() *Location {
-	if x != nil {
-		return x.Location
+func (x *AddProductImageRequest) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateStorefrontRequest) GetSettings() *structpb.Struct {
-	if x != nil {
-		return x.Settings
+func (x *AddProductImageRequest) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateStorefrontRequest) GetSeoMeta() *structpb.Struct {
-	if x != nil {
-		return x.SeoMeta
+func (x *AddProductImageRequest) GetFileSize() int64 {
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
 	}
-	return nil
+	return 0
 }
 
-// UpdateStorefrontRequest updates an existing storefront
-type UpdateStorefrontRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Required
-	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	IsActive            *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	LogoUrl             *string                `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
-	BannerUrl           *string                `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
-	Theme               *structpb.Struct       `protobuf:"bytes,7,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
-	Phone               *string                `protobuf:"bytes,8,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email               *string                `protobuf:"bytes,9,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Website             *string                `protobuf:"bytes,10,opt,name=website,proto3,oneof" json:"website,omitempty"`
-	Location            *Location              `protobuf:"bytes,11,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	Settings            *structpb.Struct       `protobuf:"bytes,12,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
-	SeoMeta             *structpb.Struct       `protobuf:"bytes,13,opt,name=seo_meta,json=seoMeta,proto3,oneof" json:"seo_meta,omitempty"`
-	AiAgentEnabled      *bool                  `protobuf:"varint,14,opt,name=ai_agent_enabled,json=aiAgentEnabled,proto3,oneof" json:"ai_agent_enabled,omitempty"`
-	LiveShoppingEnabled *bool                  `protobuf:"varint,15,opt,name=live_shopping_enabled,json=liveShoppingEnabled,proto3,oneof" json:"live_shopping_enabled,omitempty"`
-	GroupBuyingEnabled  *bool                  `protobuf:"varint,16,opt,name=group_buying_enabled,json=groupBuyingEnabled,proto3,oneof" json:"group_buying_enabled,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *AddProductImageRequest) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
 }
 
-func (x *UpdateStorefrontRequest) Reset() {
-	*x = UpdateStorefrontRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[122]
+func (x *AddProductImageRequest) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return 0
+}
+
+// ProductImageResponse returns a single product image
+type ProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImageResponse) Reset() {
+	*x = ProductImageResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStorefrontRequest) String() string {
+func (x *ProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStorefrontRequest) ProtoMessage() {}
+func (*ProductImageResponse) ProtoMessage() {}
 
-func (x *UpdateStorefrontRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[122]
+func (x *ProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10489,147 +16196,133 @@ func (x *UpdateStorefrontRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStorefrontRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStorefrontRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use ProductImageResponse.ProtoReflect.Descriptor instead.
+func (*ProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *UpdateStorefrontRequest) GetId() int64 {
+func (x *ProductImageResponse) GetImage() *ProductImage {
 	if x != nil {
-		return x.Id
+		return x.Image
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateStorefrontRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// GetProductImagesRequest retrieves images for a product
+type GetProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStorefrontRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *GetProductImagesRequest) Reset() {
+	*x = GetProductImagesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStorefrontRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
-	}
-	return false
+func (x *GetProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateStorefrontRequest) GetLogoUrl() string {
-	if x != nil && x.LogoUrl != nil {
-		return *x.LogoUrl
+func (*GetProductImagesRequest) ProtoMessage() {}
+
+func (x *GetProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateStorefrontRequest) GetBannerUrl() string {
-	if x != nil && x.BannerUrl != nil {
-		return *x.BannerUrl
-	}
-	return ""
+// Deprecated: Use GetProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*GetProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *UpdateStorefrontRequest) GetTheme() *structpb.Struct {
+func (x *GetProductImagesRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Theme
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateStorefrontRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
+// ProductImagesResponse returns multiple product images
+type ProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStorefrontRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+func (x *ProductImagesResponse) Reset() {
+	*x = ProductImagesResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStorefrontRequest) GetWebsite() string {
-	if x != nil && x.Website != nil {
-		return *x.Website
-	}
-	return ""
+func (x *ProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateStorefrontRequest) GetLocation() *Location {
+func (*ProductImagesResponse) ProtoMessage() {}
+
+func (x *ProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[204]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateStorefrontRequest) GetSettings() *structpb.Struct {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use ProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *UpdateStorefrontRequest) GetSeoMeta() *structpb.Struct {
+func (x *ProductImagesResponse) GetImages() []*ProductImage {
 	if x != nil {
-		return x.SeoMeta
+		return x.Images
 	}
 	return nil
 }
 
-func (x *UpdateStorefrontRequest) GetAiAgentEnabled() bool {
-	if x != nil && x.AiAgentEnabled != nil {
-		return *x.AiAgentEnabled
-	}
-	return false
-}
-
-func (x *UpdateStorefrontRequest) GetLiveShoppingEnabled() bool {
-	if x != nil && x.LiveShoppingEnabled != nil {
-		return *x.LiveShoppingEnabled
-	}
-	return false
-}
-
-func (x *UpdateStorefrontRequest) GetGroupBuyingEnabled() bool {
-	if x != nil && x.GroupBuyingEnabled != nil {
-		return *x.GroupBuyingEnabled
-	}
-	return false
-}
-
-// DeleteStorefrontRequest deletes a storefront
-type DeleteStorefrontRequest struct {
+// DeleteProductImageRequest deletes a product image
+type DeleteProductImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	HardDelete    bool                   `protobuf:"varint,2,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"` // false = soft delete (is_active=false), true = hard delete
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`          // Required for ownership check
+	ImageId       int64                  `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`                // Required
+	StorefrontId  int64                  `protobuf:"varint,3,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStorefrontRequest) Reset() {
-	*x = DeleteStorefrontRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[123]
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStorefrontRequest) String() string {
+func (x *DeleteProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStorefrontRequest) ProtoMessage() {}
+func (*DeleteProductImageRequest) ProtoMessage() {}
 
-func (x *DeleteStorefrontRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[123]
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10640,27 +16333,34 @@ func (x *DeleteStorefrontRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStorefrontRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStorefrontRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *DeleteStorefrontRequest) GetId() int64 {
+func (x *DeleteProductImageRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *DeleteStorefrontRequest) GetHardDelete() bool {
+func (x *DeleteProductImageRequest) GetImageId() int64 {
 	if x != nil {
-		return x.HardDelete
+		return x.ImageId
 	}
-	return false
+	return 0
 }
 
-// DeleteStorefrontResponse confirms deletion
-type DeleteStorefrontResponse struct {
+func (x *DeleteProductImageRequest) GetStorefrontId() int64 {
+	if x != nil {
+		return x.StorefrontId
+	}
+	return 0
+}
+
+// DeleteProductImageResponse confirms deletion
+type DeleteProductImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -10668,21 +16368,21 @@ type DeleteStorefrontResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStorefrontResponse) Reset() {
-	*x = DeleteStorefrontResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[124]
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStorefrontResponse) String() string {
+func (x *DeleteProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStorefrontResponse) ProtoMessage() {}
+func (*DeleteProductImageResponse) ProtoMessage() {}
 
-func (x *DeleteStorefrontResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[124]
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10693,51 +16393,50 @@ func (x *DeleteStorefrontResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStorefrontResponse.ProtoReflect.Descriptor instead.
-func (*DeleteStorefrontResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *DeleteStorefrontResponse) GetSuccess() bool {
+func (x *DeleteProductImageResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteStorefrontResponse) GetMessage() string {
+func (x *DeleteProductImageResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// AddStaffRequest adds a staff member
-type AddStaffRequest struct {
+// ReorderProductImagesRequest updates display order for product images
+type ReorderProductImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=listingssvc.v1.StaffRole" json:"role,omitempty"`
-	Permissions   *structpb.Struct       `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
+	ImageIds      []int64                `protobuf:"varint,3,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`      // Ordered list of image IDs (position = array index)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddStaffRequest) Reset() {
-	*x = AddStaffRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[125]
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddStaffRequest) String() string {
+func (x *ReorderProductImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStaffRequest) ProtoMessage() {}
-
-func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[125]
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10748,64 +16447,55 @@ func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStaffRequest.ProtoReflect.Descriptor instead.
-func (*AddStaffRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *AddStaffRequest) GetStorefrontId() int64 {
+func (x *ReorderProductImagesRequest) GetProductId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *AddStaffRequest) GetUserId() int64 {
+func (x *ReorderProductImagesRequest) GetStorefrontId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *AddStaffRequest) GetRole() StaffRole {
-	if x != nil {
-		return x.Role
-	}
-	return StaffRole_STAFF_ROLE_UNSPECIFIED
-}
-
-func (x *AddStaffRequest) GetPermissions() *structpb.Struct {
+func (x *ReorderProductImagesRequest) GetImageIds() []int64 {
 	if x != nil {
-		return x.Permissions
+		return x.ImageIds
 	}
 	return nil
 }
 
-// UpdateStaffRequest updates staff member
-type UpdateStaffRequest struct {
+// ReorderProductImagesResponse confirms reorder operation
+type ReorderProductImagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Role          *StaffRole             `protobuf:"varint,2,opt,name=role,proto3,enum=listingssvc.v1.StaffRole,oneof" json:"role,omitempty"`
-	Permissions   *structpb.Struct       `protobuf:"bytes,3,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStaffRequest) Reset() {
-	*x = UpdateStaffRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[126]
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStaffRequest) String() string {
+func (x *ReorderProductImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStaffRequest) ProtoMessage() {}
+func (*ReorderProductImagesResponse) ProtoMessage() {}
 
-func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[126]
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10816,56 +16506,47 @@ func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStaffRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStaffRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{126}
-}
-
-func (x *UpdateStaffRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateStaffRequest) GetRole() StaffRole {
-	if x != nil && x.Role != nil {
-		return *x.Role
-	}
-	return StaffRole_STAFF_ROLE_UNSPECIFIED
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *UpdateStaffRequest) GetPermissions() *structpb.Struct {
+func (x *ReorderProductImagesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Permissions
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// RemoveStaffRequest removes staff member
-type RemoveStaffRequest struct {
+// PriceHistoryEntry represents a single price change
+type PriceHistoryEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	VariantId     *int64                 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"` // Set for B2C product variant price changes
+	OldPrice      *float64               `protobuf:"fixed64,4,opt,name=old_price,json=oldPrice,proto3,oneof" json:"old_price,omitempty"`   // Empty for the initial price
+	NewPrice      float64                `protobuf:"fixed64,5,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveStaffRequest) Reset() {
-	*x = RemoveStaffRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[127]
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveStaffRequest) String() string {
+func (x *PriceHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStaffRequest) ProtoMessage() {}
+func (*PriceHistoryEntry) ProtoMessage() {}
 
-func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[127]
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10876,48 +16557,87 @@ func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
-func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *RemoveStaffRequest) GetStorefrontId() int64 {
+func (x *PriceHistoryEntry) GetId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RemoveStaffRequest) GetUserId() int64 {
+func (x *PriceHistoryEntry) GetListingId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ListingId
 	}
 	return 0
 }
 
-// GetStaffRequest retrieves staff list
-type GetStaffRequest struct {
+func (x *PriceHistoryEntry) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetOldPrice() float64 {
+	if x != nil && x.OldPrice != nil {
+		return *x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetNewPrice() float64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// GetPriceHistoryRequest retrieves price history of a listing/product
+type GetPriceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`       // Required (listing or B2C product ID)
+	VariantId     *int64                 `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"` // Filter by variant (omit for listing/product price)
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 100, max: 1000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStaffRequest) Reset() {
-	*x = GetStaffRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[128]
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStaffRequest) String() string {
+func (x *GetPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStaffRequest) ProtoMessage() {}
+func (*GetPriceHistoryRequest) ProtoMessage() {}
 
-func (x *GetStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[128]
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10928,41 +16648,71 @@ func (x *GetStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStaffRequest.ProtoReflect.Descriptor instead.
-func (*GetStaffRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *GetStaffRequest) GetStorefrontId() int64 {
+func (x *GetPriceHistoryRequest) GetListingId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.ListingId
 	}
 	return 0
 }
 
-// GetStaffResponse returns staff list
-type GetStaffResponse struct {
+func (x *GetPriceHistoryRequest) GetVariantId() int64 {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetPriceHistoryResponse returns price changes ordered by changed_at descending
+type GetPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Staff         []*StorefrontStaff     `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	Entries       []*PriceHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	LowestPrice   *float64               `protobuf:"fixed64,2,opt,name=lowest_price,json=lowestPrice,proto3,oneof" json:"lowest_price,omitempty"`    // Lowest price within the returned period
+	HighestPrice  *float64               `protobuf:"fixed64,3,opt,name=highest_price,json=highestPrice,proto3,oneof" json:"highest_price,omitempty"` // Highest price within the returned period
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStaffResponse) Reset() {
-	*x = GetStaffResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[129]
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStaffResponse) String() string {
+func (x *GetPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStaffResponse) ProtoMessage() {}
+func (*GetPriceHistoryResponse) ProtoMessage() {}
 
-func (x *GetStaffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[129]
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10973,42 +16723,57 @@ func (x *GetStaffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStaffResponse.ProtoReflect.Descriptor instead.
-func (*GetStaffResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *GetStaffResponse) GetStaff() []*StorefrontStaff {
+func (x *GetPriceHistoryResponse) GetEntries() []*PriceHistoryEntry {
 	if x != nil {
-		return x.Staff
+		return x.Entries
 	}
 	return nil
 }
 
-// SetWorkingHoursRequest sets working hours
-type SetWorkingHoursRequest struct {
+func (x *GetPriceHistoryResponse) GetLowestPrice() float64 {
+	if x != nil && x.LowestPrice != nil {
+		return *x.LowestPrice
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryResponse) GetHighestPrice() float64 {
+	if x != nil && x.HighestPrice != nil {
+		return *x.HighestPrice
+	}
+	return 0
+}
+
+// SubmitListingForReviewRequest submits a draft listing for moderation
+type SubmitListingForReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	Hours         []*StorefrontHours     `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Required for ownership check
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"` // Publish after approval at this time (must be in the future)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetWorkingHoursRequest) Reset() {
-	*x = SetWorkingHoursRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[130]
+func (x *SubmitListingForReviewRequest) Reset() {
+	*x = SubmitListingForReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetWorkingHoursRequest) String() string {
+func (x *SubmitListingForReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetWorkingHoursRequest) ProtoMessage() {}
+func (*SubmitListingForReviewRequest) ProtoMessage() {}
 
-func (x *SetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[130]
+func (x *SubmitListingForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11019,48 +16784,54 @@ func (x *SetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetWorkingHoursRequest.ProtoReflect.Descriptor instead.
-func (*SetWorkingHoursRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use SubmitListingForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitListingForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *SetWorkingHoursRequest) GetStorefrontId() int64 {
+func (x *SubmitListingForReviewRequest) GetId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SetWorkingHoursRequest) GetHours() []*StorefrontHours {
+func (x *SubmitListingForReviewRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Hours
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitListingForReviewRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
 	}
 	return nil
 }
 
-// GetWorkingHoursRequest retrieves working hours
-type GetWorkingHoursRequest struct {
+type SubmitListingForReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkingHoursRequest) Reset() {
-	*x = GetWorkingHoursRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[131]
+func (x *SubmitListingForReviewResponse) Reset() {
+	*x = SubmitListingForReviewResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkingHoursRequest) String() string {
+func (x *SubmitListingForReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkingHoursRequest) ProtoMessage() {}
+func (*SubmitListingForReviewResponse) ProtoMessage() {}
 
-func (x *GetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[131]
+func (x *SubmitListingForReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11071,41 +16842,42 @@ func (x *GetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkingHoursRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkingHoursRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use SubmitListingForReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitListingForReviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *GetWorkingHoursRequest) GetStorefrontId() int64 {
+func (x *SubmitListingForReviewResponse) GetListing() *Listing {
 	if x != nil {
-		return x.StorefrontId
+		return x.Listing
 	}
-	return 0
+	return nil
 }
 
-// GetWorkingHoursResponse returns working hours
-type GetWorkingHoursResponse struct {
+// GetModerationQueueRequest pages through listings awaiting review
+type GetModerationQueueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hours         []*StorefrontHours     `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 50, max: 200
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkingHoursResponse) Reset() {
-	*x = GetWorkingHoursResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[132]
+func (x *GetModerationQueueRequest) Reset() {
+	*x = GetModerationQueueRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkingHoursResponse) String() string {
+func (x *GetModerationQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkingHoursResponse) ProtoMessage() {}
+func (*GetModerationQueueRequest) ProtoMessage() {}
 
-func (x *GetWorkingHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[132]
+func (x *GetModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11116,41 +16888,48 @@ func (x *GetWorkingHoursResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkingHoursResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkingHoursResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use GetModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *GetWorkingHoursResponse) GetHours() []*StorefrontHours {
+func (x *GetModerationQueueRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Hours
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// IsOpenNowRequest checks if storefront is open
-type IsOpenNowRequest struct {
+func (x *GetModerationQueueRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetModerationQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Listings      []*Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsOpenNowRequest) Reset() {
-	*x = IsOpenNowRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[133]
+func (x *GetModerationQueueResponse) Reset() {
+	*x = GetModerationQueueResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsOpenNowRequest) String() string {
+func (x *GetModerationQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsOpenNowRequest) ProtoMessage() {}
+func (*GetModerationQueueResponse) ProtoMessage() {}
 
-func (x *IsOpenNowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[133]
+func (x *GetModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11161,43 +16940,50 @@ func (x *IsOpenNowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsOpenNowRequest.ProtoReflect.Descriptor instead.
-func (*IsOpenNowRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use GetModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *IsOpenNowRequest) GetStorefrontId() int64 {
+func (x *GetModerationQueueResponse) GetListings() []*Listing {
 	if x != nil {
-		return x.StorefrontId
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *GetModerationQueueResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-// IsOpenNowResponse returns open status
-type IsOpenNowResponse struct {
+// ModerateListingRequest records a moderator decision
+type ModerateListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsOpen        bool                   `protobuf:"varint,1,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	NextOpenTime  *string                `protobuf:"bytes,2,opt,name=next_open_time,json=nextOpenTime,proto3,oneof" json:"next_open_time,omitempty"`    // If closed, when will it open
-	NextCloseTime *string                `protobuf:"bytes,3,opt,name=next_close_time,json=nextCloseTime,proto3,oneof" json:"next_close_time,omitempty"` // If open, when will it close
+	ListingId     int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Required when rejecting
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsOpenNowResponse) Reset() {
-	*x = IsOpenNowResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[134]
+func (x *ModerateListingRequest) Reset() {
+	*x = ModerateListingRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsOpenNowResponse) String() string {
+func (x *ModerateListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsOpenNowResponse) ProtoMessage() {}
+func (*ModerateListingRequest) ProtoMessage() {}
 
-func (x *IsOpenNowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[134]
+func (x *ModerateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11208,56 +16994,54 @@ func (x *IsOpenNowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsOpenNowResponse.ProtoReflect.Descriptor instead.
-func (*IsOpenNowResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use ModerateListingRequest.ProtoReflect.Descriptor instead.
+func (*ModerateListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *IsOpenNowResponse) GetIsOpen() bool {
+func (x *ModerateListingRequest) GetListingId() int64 {
 	if x != nil {
-		return x.IsOpen
+		return x.ListingId
 	}
-	return false
+	return 0
 }
 
-func (x *IsOpenNowResponse) GetNextOpenTime() string {
-	if x != nil && x.NextOpenTime != nil {
-		return *x.NextOpenTime
+func (x *ModerateListingRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
 	}
-	return ""
+	return false
 }
 
-func (x *IsOpenNowResponse) GetNextCloseTime() string {
-	if x != nil && x.NextCloseTime != nil {
-		return *x.NextCloseTime
+func (x *ModerateListingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// SetPaymentMethodsRequest sets payment methods
-type SetPaymentMethodsRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	StorefrontId  int64                      `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	Methods       []*StorefrontPaymentMethod `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+type ModerateListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"` // Status: active, scheduled or rejected
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPaymentMethodsRequest) Reset() {
-	*x = SetPaymentMethodsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[135]
+func (x *ModerateListingResponse) Reset() {
+	*x = ModerateListingResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPaymentMethodsRequest) String() string {
+func (x *ModerateListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPaymentMethodsRequest) ProtoMessage() {}
+func (*ModerateListingResponse) ProtoMessage() {}
 
-func (x *SetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[135]
+func (x *ModerateListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11268,48 +17052,47 @@ func (x *SetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
-func (*SetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{135}
-}
-
-func (x *SetPaymentMethodsRequest) GetStorefrontId() int64 {
-	if x != nil {
-		return x.StorefrontId
-	}
-	return 0
+// Deprecated: Use ModerateListingResponse.ProtoReflect.Descriptor instead.
+func (*ModerateListingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *SetPaymentMethodsRequest) GetMethods() []*StorefrontPaymentMethod {
+func (x *ModerateListingResponse) GetListing() *Listing {
 	if x != nil {
-		return x.Methods
+		return x.Listing
 	}
 	return nil
 }
 
-// GetPaymentMethodsRequest retrieves payment methods
-type GetPaymentMethodsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DuplicateMatch is an existing listing similar to the checked one
+type DuplicateMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ListingId      int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TextSimilarity float64                `protobuf:"fixed64,5,opt,name=text_similarity,json=textSimilarity,proto3" json:"text_similarity,omitempty"`   // Estimated title+description similarity (0..1)
+	ImageDistance  *int32                 `protobuf:"varint,6,opt,name=image_distance,json=imageDistance,proto3,oneof" json:"image_distance,omitempty"` // Image hash distance in bits (set for image matches)
+	SameOwner      bool                   `protobuf:"varint,7,opt,name=same_owner,json=sameOwner,proto3" json:"same_owner,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetPaymentMethodsRequest) Reset() {
-	*x = GetPaymentMethodsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[136]
+func (x *DuplicateMatch) Reset() {
+	*x = DuplicateMatch{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentMethodsRequest) String() string {
+func (x *DuplicateMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentMethodsRequest) ProtoMessage() {}
+func (*DuplicateMatch) ProtoMessage() {}
 
-func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[136]
+func (x *DuplicateMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11320,41 +17103,84 @@ func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
-func (*GetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use DuplicateMatch.ProtoReflect.Descriptor instead.
+func (*DuplicateMatch) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *GetPaymentMethodsRequest) GetStorefrontId() int64 {
+func (x *DuplicateMatch) GetListingId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.ListingId
 	}
 	return 0
 }
 
-// GetPaymentMethodsResponse returns payment methods
-type GetPaymentMethodsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Methods       []*StorefrontPaymentMethod `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+func (x *DuplicateMatch) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DuplicateMatch) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateMatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DuplicateMatch) GetTextSimilarity() float64 {
+	if x != nil {
+		return x.TextSimilarity
+	}
+	return 0
+}
+
+func (x *DuplicateMatch) GetImageDistance() int32 {
+	if x != nil && x.ImageDistance != nil {
+		return *x.ImageDistance
+	}
+	return 0
+}
+
+func (x *DuplicateMatch) GetSameOwner() bool {
+	if x != nil {
+		return x.SameOwner
+	}
+	return false
+}
+
+// DuplicateReport is the result of a duplicate check
+type DuplicateReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*DuplicateMatch      `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // none, flagged, blocked
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPaymentMethodsResponse) Reset() {
-	*x = GetPaymentMethodsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[137]
+func (x *DuplicateReport) Reset() {
+	*x = DuplicateReport{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentMethodsResponse) String() string {
+func (x *DuplicateReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentMethodsResponse) ProtoMessage() {}
+func (*DuplicateReport) ProtoMessage() {}
 
-func (x *GetPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[137]
+func (x *DuplicateReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11365,42 +17191,52 @@ func (x *GetPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentMethodsResponse.ProtoReflect.Descriptor instead.
-func (*GetPaymentMethodsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use DuplicateReport.ProtoReflect.Descriptor instead.
+func (*DuplicateReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *GetPaymentMethodsResponse) GetMethods() []*StorefrontPaymentMethod {
+func (x *DuplicateReport) GetMatches() []*DuplicateMatch {
 	if x != nil {
-		return x.Methods
+		return x.Matches
 	}
 	return nil
 }
 
-// SetDeliveryOptionsRequest sets delivery options
-type SetDeliveryOptionsRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	StorefrontId  int64                       `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	Options       []*StorefrontDeliveryOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DuplicateReport) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *SetDeliveryOptionsRequest) Reset() {
-	*x = SetDeliveryOptionsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[138]
+// DuplicateFlag is a recorded duplicate match awaiting moderation
+type DuplicateFlag struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ListingId          int64                  `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	DuplicateListingId int64                  `protobuf:"varint,2,opt,name=duplicate_listing_id,json=duplicateListingId,proto3" json:"duplicate_listing_id,omitempty"`
+	TextSimilarity     float64                `protobuf:"fixed64,3,opt,name=text_similarity,json=textSimilarity,proto3" json:"text_similarity,omitempty"`
+	ImageDistance      *int32                 `protobuf:"varint,4,opt,name=image_distance,json=imageDistance,proto3,oneof" json:"image_distance,omitempty"`
+	DetectedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DuplicateFlag) Reset() {
+	*x = DuplicateFlag{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDeliveryOptionsRequest) String() string {
+func (x *DuplicateFlag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDeliveryOptionsRequest) ProtoMessage() {}
+func (*DuplicateFlag) ProtoMessage() {}
 
-func (x *SetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[138]
+func (x *DuplicateFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11411,48 +17247,69 @@ func (x *SetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDeliveryOptionsRequest.ProtoReflect.Descriptor instead.
-func (*SetDeliveryOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use DuplicateFlag.ProtoReflect.Descriptor instead.
+func (*DuplicateFlag) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *SetDeliveryOptionsRequest) GetStorefrontId() int64 {
+func (x *DuplicateFlag) GetListingId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.ListingId
 	}
 	return 0
 }
 
-func (x *SetDeliveryOptionsRequest) GetOptions() []*StorefrontDeliveryOption {
+func (x *DuplicateFlag) GetDuplicateListingId() int64 {
 	if x != nil {
-		return x.Options
+		return x.DuplicateListingId
+	}
+	return 0
+}
+
+func (x *DuplicateFlag) GetTextSimilarity() float64 {
+	if x != nil {
+		return x.TextSimilarity
+	}
+	return 0
+}
+
+func (x *DuplicateFlag) GetImageDistance() int32 {
+	if x != nil && x.ImageDistance != nil {
+		return *x.ImageDistance
+	}
+	return 0
+}
+
+func (x *DuplicateFlag) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
 	}
 	return nil
 }
 
-// GetDeliveryOptionsRequest retrieves delivery options
-type GetDeliveryOptionsRequest struct {
+type ListDuplicateFlagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 50, max: 200
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeliveryOptionsRequest) Reset() {
-	*x = GetDeliveryOptionsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[139]
+func (x *ListDuplicateFlagsRequest) Reset() {
+	*x = ListDuplicateFlagsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeliveryOptionsRequest) String() string {
+func (x *ListDuplicateFlagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeliveryOptionsRequest) ProtoMessage() {}
+func (*ListDuplicateFlagsRequest) ProtoMessage() {}
 
-func (x *GetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[139]
+func (x *ListDuplicateFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11463,41 +17320,48 @@ func (x *GetDeliveryOptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeliveryOptionsRequest.ProtoReflect.Descriptor instead.
-func (*GetDeliveryOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use ListDuplicateFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *GetDeliveryOptionsRequest) GetStorefrontId() int64 {
+func (x *ListDuplicateFlagsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.StorefrontId
+		return x.Limit
 	}
 	return 0
 }
 
-// GetDeliveryOptionsResponse returns delivery options
-type GetDeliveryOptionsResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Options       []*StorefrontDeliveryOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+func (x *ListDuplicateFlagsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDuplicateFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*DuplicateFlag       `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeliveryOptionsResponse) Reset() {
-	*x = GetDeliveryOptionsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[140]
+func (x *ListDuplicateFlagsResponse) Reset() {
+	*x = ListDuplicateFlagsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeliveryOptionsResponse) String() string {
+func (x *ListDuplicateFlagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeliveryOptionsResponse) ProtoMessage() {}
+func (*ListDuplicateFlagsResponse) ProtoMessage() {}
 
-func (x *GetDeliveryOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[140]
+func (x *ListDuplicateFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11508,55 +17372,64 @@ func (x *GetDeliveryOptionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeliveryOptionsResponse.ProtoReflect.Descriptor instead.
-func (*GetDeliveryOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use ListDuplicateFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *GetDeliveryOptionsResponse) GetOptions() []*StorefrontDeliveryOption {
+func (x *ListDuplicateFlagsResponse) GetFlags() []*DuplicateFlag {
 	if x != nil {
-		return x.Options
+		return x.Flags
 	}
 	return nil
 }
 
-// StorefrontMapData represents storefront data for map display
-type StorefrontMapData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Rating        float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,7,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Address       string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	Phone         string                 `protobuf:"bytes,9,opt,name=phone,proto3" json:"phone,omitempty"`
-	WorkingNow    bool                   `protobuf:"varint,10,opt,name=working_now,json=workingNow,proto3" json:"working_now,omitempty"`
-	ProductsCount int32                  `protobuf:"varint,11,opt,name=products_count,json=productsCount,proto3" json:"products_count,omitempty"`
-	SupportsCod   bool                   `protobuf:"varint,12,opt,name=supports_cod,json=supportsCod,proto3" json:"supports_cod,omitempty"`
-	HasDelivery   bool                   `protobuf:"varint,13,opt,name=has_delivery,json=hasDelivery,proto3" json:"has_delivery,omitempty"`
-	HasSelfPickup bool                   `protobuf:"varint,14,opt,name=has_self_pickup,json=hasSelfPickup,proto3" json:"has_self_pickup,omitempty"`
-	AcceptsCards  bool                   `protobuf:"varint,15,opt,name=accepts_cards,json=acceptsCards,proto3" json:"accepts_cards,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListDuplicateFlagsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *StorefrontMapData) Reset() {
-	*x = StorefrontMapData{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[141]
+// Review is a buyer's rating of a storefront product or storefront
+type Review struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectType        ReviewSubjectType      `protobuf:"varint,2,opt,name=subject_type,json=subjectType,proto3,enum=listingssvc.v1.ReviewSubjectType" json:"subject_type,omitempty"`
+	SubjectId          int64                  `protobuf:"varint,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	StorefrontId       int64                  `protobuf:"varint,4,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
+	UserId             int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsVerifiedPurchase bool                   `protobuf:"varint,6,opt,name=is_verified_purchase,json=isVerifiedPurchase,proto3" json:"is_verified_purchase,omitempty"` // Backed by a delivered order
+	OrderId            *int64                 `protobuf:"varint,7,opt,name=order_id,json=orderId,proto3,oneof" json:"order_id,omitempty"`
+	Rating             int32                  `protobuf:"varint,8,opt,name=rating,proto3" json:"rating,omitempty"` // 1-5
+	Title              *string                `protobuf:"bytes,9,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Body               *string                `protobuf:"bytes,10,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	Status             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // published, hidden
+	SellerReply        *string                `protobuf:"bytes,12,opt,name=seller_reply,json=sellerReply,proto3,oneof" json:"seller_reply,omitempty"`
+	SellerRepliedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=seller_replied_at,json=sellerRepliedAt,proto3,oneof" json:"seller_replied_at,omitempty"`
+	HelpfulCount       int32                  `protobuf:"varint,14,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"`
+	NotHelpfulCount    int32                  `protobuf:"varint,15,opt,name=not_helpful_count,json=notHelpfulCount,proto3" json:"not_helpful_count,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorefrontMapData) String() string {
+func (x *Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorefrontMapData) ProtoMessage() {}
-
-func (x *StorefrontMapData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[141]
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11567,143 +17440,157 @@ func (x *StorefrontMapData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorefrontMapData.ProtoReflect.Descriptor instead.
-func (*StorefrontMapData) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *StorefrontMapData) GetId() int64 {
+func (x *Review) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *StorefrontMapData) GetSlug() string {
+func (x *Review) GetSubjectType() ReviewSubjectType {
 	if x != nil {
-		return x.Slug
+		return x.SubjectType
 	}
-	return ""
+	return ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *StorefrontMapData) GetName() string {
+func (x *Review) GetSubjectId() int64 {
 	if x != nil {
-		return x.Name
+		return x.SubjectId
 	}
-	return ""
+	return 0
 }
 
-func (x *StorefrontMapData) GetLatitude() float64 {
+func (x *Review) GetStorefrontId() int64 {
 	if x != nil {
-		return x.Latitude
+		return x.StorefrontId
 	}
 	return 0
 }
 
-func (x *StorefrontMapData) GetLongitude() float64 {
+func (x *Review) GetUserId() int64 {
 	if x != nil {
-		return x.Longitude
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *StorefrontMapData) GetRating() float64 {
+func (x *Review) GetIsVerifiedPurchase() bool {
 	if x != nil {
-		return x.Rating
+		return x.IsVerifiedPurchase
+	}
+	return false
+}
+
+func (x *Review) GetOrderId() int64 {
+	if x != nil && x.OrderId != nil {
+		return *x.OrderId
 	}
 	return 0
 }
 
-func (x *StorefrontMapData) GetLogoUrl() string {
+func (x *Review) GetRating() int32 {
 	if x != nil {
-		return x.LogoUrl
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-func (x *StorefrontMapData) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *Review) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
 	}
 	return ""
 }
 
-func (x *StorefrontMapData) GetPhone() string {
+func (x *Review) GetStatus() string {
 	if x != nil {
-		return x.Phone
+		return x.Status
 	}
 	return ""
 }
 
-func (x *StorefrontMapData) GetWorkingNow() bool {
-	if x != nil {
-		return x.WorkingNow
+func (x *Review) GetSellerReply() string {
+	if x != nil && x.SellerReply != nil {
+		return *x.SellerReply
 	}
-	return false
+	return ""
 }
 
-func (x *StorefrontMapData) GetProductsCount() int32 {
+func (x *Review) GetSellerRepliedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProductsCount
+		return x.SellerRepliedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *StorefrontMapData) GetSupportsCod() bool {
+func (x *Review) GetHelpfulCount() int32 {
 	if x != nil {
-		return x.SupportsCod
+		return x.HelpfulCount
 	}
-	return false
+	return 0
 }
 
-func (x *StorefrontMapData) GetHasDelivery() bool {
+func (x *Review) GetNotHelpfulCount() int32 {
 	if x != nil {
-		return x.HasDelivery
+		return x.NotHelpfulCount
 	}
-	return false
+	return 0
 }
 
-func (x *StorefrontMapData) GetHasSelfPickup() bool {
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HasSelfPickup
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *StorefrontMapData) GetAcceptsCards() bool {
+func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AcceptsCards
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-// GetMapDataRequest retrieves map data
-type GetMapDataRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	North         float64                 `protobuf:"fixed64,1,opt,name=north,proto3" json:"north,omitempty"` // Bounds
-	South         float64                 `protobuf:"fixed64,2,opt,name=south,proto3" json:"south,omitempty"`
-	East          float64                 `protobuf:"fixed64,3,opt,name=east,proto3" json:"east,omitempty"`
-	West          float64                 `protobuf:"fixed64,4,opt,name=west,proto3" json:"west,omitempty"`
-	Filter        *ListStorefrontsRequest `protobuf:"bytes,5,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Additional filters
+// ReviewSummary is the rating aggregate of a subject
+type ReviewSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   ReviewSubjectType      `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=listingssvc.v1.ReviewSubjectType" json:"subject_type,omitempty"`
+	SubjectId     int64                  `protobuf:"varint,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewsCount  int32                  `protobuf:"varint,4,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`
+	RatingCounts  []int32                `protobuf:"varint,5,rep,packed,name=rating_counts,json=ratingCounts,proto3" json:"rating_counts,omitempty"` // Reviews per star, index 0 is one star
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMapDataRequest) Reset() {
-	*x = GetMapDataRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[142]
+func (x *ReviewSummary) Reset() {
+	*x = ReviewSummary{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMapDataRequest) String() string {
+func (x *ReviewSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapDataRequest) ProtoMessage() {}
+func (*ReviewSummary) ProtoMessage() {}
 
-func (x *GetMapDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[142]
+func (x *ReviewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11714,69 +17601,73 @@ func (x *GetMapDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapDataRequest.ProtoReflect.Descriptor instead.
-func (*GetMapDataRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use ReviewSummary.ProtoReflect.Descriptor instead.
+func (*ReviewSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *GetMapDataRequest) GetNorth() float64 {
+func (x *ReviewSummary) GetSubjectType() ReviewSubjectType {
 	if x != nil {
-		return x.North
+		return x.SubjectType
 	}
-	return 0
+	return ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *GetMapDataRequest) GetSouth() float64 {
+func (x *ReviewSummary) GetSubjectId() int64 {
 	if x != nil {
-		return x.South
+		return x.SubjectId
 	}
 	return 0
 }
 
-func (x *GetMapDataRequest) GetEast() float64 {
+func (x *ReviewSummary) GetAverageRating() float64 {
 	if x != nil {
-		return x.East
+		return x.AverageRating
 	}
 	return 0
 }
 
-func (x *GetMapDataRequest) GetWest() float64 {
+func (x *ReviewSummary) GetReviewsCount() int32 {
 	if x != nil {
-		return x.West
+		return x.ReviewsCount
 	}
 	return 0
 }
 
-func (x *GetMapDataRequest) GetFilter() *ListStorefrontsRequest {
+func (x *ReviewSummary) GetRatingCounts() []int32 {
 	if x != nil {
-		return x.Filter
+		return x.RatingCounts
 	}
 	return nil
 }
 
-// GetMapDataResponse returns map data
-type GetMapDataResponse struct {
+type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Storefronts   []*StorefrontMapData   `protobuf:"bytes,1,rep,name=storefronts,proto3" json:"storefronts,omitempty"`
+	SubjectType   ReviewSubjectType      `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=listingssvc.v1.ReviewSubjectType" json:"subject_type,omitempty"`
+	SubjectId     int64                  `protobuf:"varint,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`               // 1-5
+	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`            // Max 200 characters
+	Body          *string                `protobuf:"bytes,5,opt,name=body,proto3,oneof" json:"body,omitempty"`              // Max 5000 characters
+	UserId        int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMapDataResponse) Reset() {
-	*x = GetMapDataResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[143]
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMapDataResponse) String() string {
+func (x *CreateReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapDataResponse) ProtoMessage() {}
+func (*CreateReviewRequest) ProtoMessage() {}
 
-func (x *GetMapDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[143]
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11787,43 +17678,76 @@ func (x *GetMapDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapDataResponse.ProtoReflect.Descriptor instead.
-func (*GetMapDataResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *GetMapDataResponse) GetStorefronts() []*StorefrontMapData {
+func (x *CreateReviewRequest) GetSubjectType() ReviewSubjectType {
 	if x != nil {
-		return x.Storefronts
+		return x.SubjectType
 	}
-	return nil
+	return ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
 }
 
-// DashboardStatsRequest retrieves dashboard statistics
-type DashboardStatsRequest struct {
+func (x *CreateReviewRequest) GetSubjectId() int64 {
+	if x != nil {
+		return x.SubjectId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StorefrontId  int64                  `protobuf:"varint,1,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"`
-	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"`
-	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Authors can read their hidden reviews
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DashboardStatsRequest) Reset() {
-	*x = DashboardStatsRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[144]
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DashboardStatsRequest) String() string {
+func (x *GetReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DashboardStatsRequest) ProtoMessage() {}
+func (*GetReviewRequest) ProtoMessage() {}
 
-func (x *DashboardStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[144]
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11834,64 +17758,53 @@ func (x *DashboardStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DashboardStatsRequest.ProtoReflect.Descriptor instead.
-func (*DashboardStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *DashboardStatsRequest) GetStorefrontId() int64 {
+func (x *GetReviewRequest) GetId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DashboardStatsRequest) GetDateFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateFrom
-	}
-	return nil
-}
-
-func (x *DashboardStatsRequest) GetDateTo() *timestamppb.Timestamp {
+func (x *GetReviewRequest) GetUserId() int64 {
 	if x != nil {
-		return x.DateTo
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// DashboardStatsResponse returns dashboard statistics
-type DashboardStatsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TotalProducts    int32                  `protobuf:"varint,1,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
-	ActiveProducts   int32                  `protobuf:"varint,2,opt,name=active_products,json=activeProducts,proto3" json:"active_products,omitempty"`
-	OrdersCount      int32                  `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
-	Revenue          float64                `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
-	AvgOrderValue    float64                `protobuf:"fixed64,5,opt,name=avg_order_value,json=avgOrderValue,proto3" json:"avg_order_value,omitempty"`
-	ViewsCount       int32                  `protobuf:"varint,6,opt,name=views_count,json=viewsCount,proto3" json:"views_count,omitempty"`
-	UniqueVisitors   int32                  `protobuf:"varint,7,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
-	ConversionRate   float64                `protobuf:"fixed64,8,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
-	PendingOrders    int32                  `protobuf:"varint,9,opt,name=pending_orders,json=pendingOrders,proto3" json:"pending_orders,omitempty"`
-	LowStockProducts int32                  `protobuf:"varint,10,opt,name=low_stock_products,json=lowStockProducts,proto3" json:"low_stock_products,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   ReviewSubjectType      `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=listingssvc.v1.ReviewSubjectType" json:"subject_type,omitempty"`
+	SubjectId     int64                  `protobuf:"varint,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Rating        *int32                 `protobuf:"varint,3,opt,name=rating,proto3,oneof" json:"rating,omitempty"` // Only reviews with this star rating
+	VerifiedOnly  bool                   `protobuf:"varint,4,opt,name=verified_only,json=verifiedOnly,proto3" json:"verified_only,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`    // newest (default), helpful, highest, lowest
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 20, max: 100
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DashboardStatsResponse) Reset() {
-	*x = DashboardStatsResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[145]
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DashboardStatsResponse) String() string {
+func (x *ListReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DashboardStatsResponse) ProtoMessage() {}
+func (*ListReviewsRequest) ProtoMessage() {}
 
-func (x *DashboardStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[145]
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11902,116 +17815,146 @@ func (x *DashboardStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DashboardStatsResponse.ProtoReflect.Descriptor instead.
-func (*DashboardStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *DashboardStatsResponse) GetTotalProducts() int32 {
+func (x *ListReviewsRequest) GetSubjectType() ReviewSubjectType {
 	if x != nil {
-		return x.TotalProducts
+		return x.SubjectType
 	}
-	return 0
+	return ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *DashboardStatsResponse) GetActiveProducts() int32 {
+func (x *ListReviewsRequest) GetSubjectId() int64 {
 	if x != nil {
-		return x.ActiveProducts
+		return x.SubjectId
 	}
 	return 0
 }
 
-func (x *DashboardStatsResponse) GetOrdersCount() int32 {
-	if x != nil {
-		return x.OrdersCount
+func (x *ListReviewsRequest) GetRating() int32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
 	}
 	return 0
 }
 
-func (x *DashboardStatsResponse) GetRevenue() float64 {
+func (x *ListReviewsRequest) GetVerifiedOnly() bool {
 	if x != nil {
-		return x.Revenue
+		return x.VerifiedOnly
 	}
-	return 0
+	return false
 }
 
-func (x *DashboardStatsResponse) GetAvgOrderValue() float64 {
+func (x *ListReviewsRequest) GetSort() string {
 	if x != nil {
-		return x.AvgOrderValue
+		return x.Sort
 	}
-	return 0
+	return ""
 }
 
-func (x *DashboardStatsResponse) GetViewsCount() int32 {
+func (x *ListReviewsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ViewsCount
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *DashboardStatsResponse) GetUniqueVisitors() int32 {
+func (x *ListReviewsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.UniqueVisitors
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *DashboardStatsResponse) GetConversionRate() float64 {
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Summary       *ReviewSummary         `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[228]
 	if x != nil {
-		return x.ConversionRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DashboardStatsResponse) GetPendingOrders() int32 {
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
 	if x != nil {
-		return x.PendingOrders
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *DashboardStatsResponse) GetLowStockProducts() int32 {
+func (x *ListReviewsResponse) GetSummary() *ReviewSummary {
 	if x != nil {
-		return x.LowStockProducts
+		return x.Summary
 	}
-	return 0
+	return nil
 }
 
-// ProductImage represents an image associated with a B2C product
-type ProductImage struct {
+type UpdateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	StoragePath   *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
-	DisplayOrder  int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	FileSize      *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
-	MimeType      *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Rating        *int32                 `protobuf:"varint,2,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Body          *string                `protobuf:"bytes,4,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductImage) Reset() {
-	*x = ProductImage{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[146]
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductImage) String() string {
+func (x *UpdateReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductImage) ProtoMessage() {}
+func (*UpdateReviewRequest) ProtoMessage() {}
 
-func (x *ProductImage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[146]
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12022,135 +17965,165 @@ func (x *ProductImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
-func (*ProductImage) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *ProductImage) GetId() int64 {
+func (x *UpdateReviewRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ProductImage) GetProductId() int64 {
-	if x != nil {
-		return x.ProductId
+func (x *UpdateReviewRequest) GetRating() int32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
 	}
 	return 0
 }
 
-func (x *ProductImage) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *ProductImage) GetStoragePath() string {
-	if x != nil && x.StoragePath != nil {
-		return *x.StoragePath
+func (x *UpdateReviewRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-func (x *ProductImage) GetThumbnailUrl() string {
-	if x != nil && x.ThumbnailUrl != nil {
-		return *x.ThumbnailUrl
+func (x *UpdateReviewRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
 	}
 	return ""
 }
 
-func (x *ProductImage) GetDisplayOrder() int32 {
+func (x *UpdateReviewRequest) GetUserId() int64 {
 	if x != nil {
-		return x.DisplayOrder
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ProductImage) GetIsPrimary() bool {
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[230]
 	if x != nil {
-		return x.IsPrimary
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ProductImage) GetWidth() int32 {
-	if x != nil && x.Width != nil {
-		return *x.Width
-	}
-	return 0
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *ProductImage) GetHeight() int32 {
-	if x != nil && x.Height != nil {
-		return *x.Height
+func (x *DeleteReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ProductImage) GetFileSize() int64 {
-	if x != nil && x.FileSize != nil {
-		return *x.FileSize
+func (x *DeleteReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ProductImage) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
-	}
-	return ""
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductImage) GetCreatedAt() string {
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[231]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductImage) GetUpdatedAt() string {
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *DeleteReviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-// AddProductImageRequest adds a new image to a B2C product
-type AddProductImageRequest struct {
+type GetReviewSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`          // Required
-	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                        // Required: Full URL to image in MinIO/S3
-	StoragePath   *string                `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3,oneof" json:"storage_path,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
-	DisplayOrder  int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	FileSize      *int64                 `protobuf:"varint,10,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty"`
-	MimeType      *string                `protobuf:"bytes,11,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	SubjectType   ReviewSubjectType      `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=listingssvc.v1.ReviewSubjectType" json:"subject_type,omitempty"`
+	SubjectId     int64                  `protobuf:"varint,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProductImageRequest) Reset() {
-	*x = AddProductImageRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[147]
+func (x *GetReviewSummaryRequest) Reset() {
+	*x = GetReviewSummaryRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProductImageRequest) String() string {
+func (x *GetReviewSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductImageRequest) ProtoMessage() {}
+func (*GetReviewSummaryRequest) ProtoMessage() {}
 
-func (x *AddProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[147]
+func (x *GetReviewSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12161,111 +18134,109 @@ func (x *AddProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductImageRequest.ProtoReflect.Descriptor instead.
-func (*AddProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GetReviewSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *AddProductImageRequest) GetProductId() int64 {
+func (x *GetReviewSummaryRequest) GetSubjectType() ReviewSubjectType {
 	if x != nil {
-		return x.ProductId
+		return x.SubjectType
 	}
-	return 0
+	return ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *AddProductImageRequest) GetStorefrontId() int64 {
+func (x *GetReviewSummaryRequest) GetSubjectId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.SubjectId
 	}
 	return 0
 }
 
-func (x *AddProductImageRequest) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+type ReplyToReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reply         string                 `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`                  // Max 2000 characters
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProductImageRequest) GetStoragePath() string {
-	if x != nil && x.StoragePath != nil {
-		return *x.StoragePath
-	}
-	return ""
+func (x *ReplyToReviewRequest) Reset() {
+	*x = ReplyToReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProductImageRequest) GetThumbnailUrl() string {
-	if x != nil && x.ThumbnailUrl != nil {
-		return *x.ThumbnailUrl
-	}
-	return ""
+func (x *ReplyToReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddProductImageRequest) GetDisplayOrder() int32 {
-	if x != nil {
-		return x.DisplayOrder
-	}
-	return 0
-}
+func (*ReplyToReviewRequest) ProtoMessage() {}
 
-func (x *AddProductImageRequest) GetIsPrimary() bool {
+func (x *ReplyToReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[233]
 	if x != nil {
-		return x.IsPrimary
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *AddProductImageRequest) GetWidth() int32 {
-	if x != nil && x.Width != nil {
-		return *x.Width
-	}
-	return 0
+// Deprecated: Use ReplyToReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *AddProductImageRequest) GetHeight() int32 {
-	if x != nil && x.Height != nil {
-		return *x.Height
+func (x *ReplyToReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
 	}
 	return 0
 }
 
-func (x *AddProductImageRequest) GetFileSize() int64 {
-	if x != nil && x.FileSize != nil {
-		return *x.FileSize
+func (x *ReplyToReviewRequest) GetReply() string {
+	if x != nil {
+		return x.Reply
 	}
-	return 0
+	return ""
 }
 
-func (x *AddProductImageRequest) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
+func (x *ReplyToReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// ProductImageResponse returns a single product image
-type ProductImageResponse struct {
+type VoteReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Helpful       bool                   `protobuf:"varint,2,opt,name=helpful,proto3" json:"helpful,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductImageResponse) Reset() {
-	*x = ProductImageResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[148]
+func (x *VoteReviewRequest) Reset() {
+	*x = VoteReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductImageResponse) String() string {
+func (x *VoteReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductImageResponse) ProtoMessage() {}
+func (*VoteReviewRequest) ProtoMessage() {}
 
-func (x *ProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[148]
+func (x *VoteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12276,41 +18247,57 @@ func (x *ProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductImageResponse.ProtoReflect.Descriptor instead.
-func (*ProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use VoteReviewRequest.ProtoReflect.Descriptor instead.
+func (*VoteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *ProductImageResponse) GetImage() *ProductImage {
+func (x *VoteReviewRequest) GetReviewId() int64 {
 	if x != nil {
-		return x.Image
+		return x.ReviewId
 	}
-	return nil
+	return 0
 }
 
-// GetProductImagesRequest retrieves images for a product
-type GetProductImagesRequest struct {
+func (x *VoteReviewRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+func (x *VoteReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ReportReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // spam, offensive, fake, off_topic, other
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Used when the call carries no authenticated user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductImagesRequest) Reset() {
-	*x = GetProductImagesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[149]
+func (x *ReportReviewRequest) Reset() {
+	*x = ReportReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductImagesRequest) String() string {
+func (x *ReportReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductImagesRequest) ProtoMessage() {}
+func (*ReportReviewRequest) ProtoMessage() {}
 
-func (x *GetProductImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[149]
+func (x *ReportReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12318,44 +18305,65 @@ func (x *GetProductImagesRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReportReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *ReportReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReportReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-// Deprecated: Use GetProductImagesRequest.ProtoReflect.Descriptor instead.
-func (*GetProductImagesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{149}
+func (x *ReportReviewRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
 }
 
-func (x *GetProductImagesRequest) GetProductId() int64 {
+func (x *ReportReviewRequest) GetUserId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.UserId
 	}
 	return 0
 }
 
-// ProductImagesResponse returns multiple product images
-type ProductImagesResponse struct {
+type ReportReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReviewHidden  bool                   `protobuf:"varint,2,opt,name=review_hidden,json=reviewHidden,proto3" json:"review_hidden,omitempty"` // The report hid the review pending moderation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductImagesResponse) Reset() {
-	*x = ProductImagesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[150]
+func (x *ReportReviewResponse) Reset() {
+	*x = ReportReviewResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductImagesResponse) String() string {
+func (x *ReportReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductImagesResponse) ProtoMessage() {}
+func (*ReportReviewResponse) ProtoMessage() {}
 
-func (x *ProductImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[150]
+func (x *ReportReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12366,43 +18374,54 @@ func (x *ProductImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductImagesResponse.ProtoReflect.Descriptor instead.
-func (*ProductImagesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use ReportReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReportReviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *ProductImagesResponse) GetImages() []*ProductImage {
+func (x *ReportReviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Images
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// DeleteProductImageRequest deletes a product image
-type DeleteProductImageRequest struct {
+func (x *ReportReviewResponse) GetReviewHidden() bool {
+	if x != nil {
+		return x.ReviewHidden
+	}
+	return false
+}
+
+// ReviewReport is an abuse report on a review
+type ReviewReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`          // Required for ownership check
-	ImageId       int64                  `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`                // Required
-	StorefrontId  int64                  `protobuf:"varint,3,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment       *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // open, dismissed, actioned
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductImageRequest) Reset() {
-	*x = DeleteProductImageRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[151]
+func (x *ReviewReport) Reset() {
+	*x = ReviewReport{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductImageRequest) String() string {
+func (x *ReviewReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductImageRequest) ProtoMessage() {}
+func (*ReviewReport) ProtoMessage() {}
 
-func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[151]
+func (x *ReviewReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12413,56 +18432,84 @@ func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use ReviewReport.ProtoReflect.Descriptor instead.
+func (*ReviewReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *DeleteProductImageRequest) GetProductId() int64 {
+func (x *ReviewReport) GetId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DeleteProductImageRequest) GetImageId() int64 {
+func (x *ReviewReport) GetReviewId() int64 {
 	if x != nil {
-		return x.ImageId
+		return x.ReviewId
 	}
 	return 0
 }
 
-func (x *DeleteProductImageRequest) GetStorefrontId() int64 {
+func (x *ReviewReport) GetUserId() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.UserId
 	}
 	return 0
 }
 
-// DeleteProductImageResponse confirms deletion
-type DeleteProductImageResponse struct {
+func (x *ReviewReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListReviewReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	OpenOnly      bool                   `protobuf:"varint,1,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default: 20, max: 100
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductImageResponse) Reset() {
-	*x = DeleteProductImageResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[152]
+func (x *ListReviewReportsRequest) Reset() {
+	*x = ListReviewReportsRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductImageResponse) String() string {
+func (x *ListReviewReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductImageResponse) ProtoMessage() {}
+func (*ListReviewReportsRequest) ProtoMessage() {}
 
-func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[152]
+func (x *ListReviewReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12473,50 +18520,55 @@ func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use ListReviewReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewReportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *DeleteProductImageResponse) GetSuccess() bool {
+func (x *ListReviewReportsRequest) GetOpenOnly() bool {
 	if x != nil {
-		return x.Success
+		return x.OpenOnly
 	}
 	return false
 }
 
-func (x *DeleteProductImageResponse) GetMessage() string {
+func (x *ListReviewReportsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Message
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-// ReorderProductImagesRequest updates display order for product images
-type ReorderProductImagesRequest struct {
+func (x *ListReviewReportsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListReviewReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	StorefrontId  int64                  `protobuf:"varint,2,opt,name=storefront_id,json=storefrontId,proto3" json:"storefront_id,omitempty"` // Required for ownership validation
-	ImageIds      []int64                `protobuf:"varint,3,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`      // Ordered list of image IDs (position = array index)
+	Reports       []*ReviewReport        `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReorderProductImagesRequest) Reset() {
-	*x = ReorderProductImagesRequest{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[153]
+func (x *ListReviewReportsResponse) Reset() {
+	*x = ListReviewReportsResponse{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReorderProductImagesRequest) String() string {
+func (x *ListReviewReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderProductImagesRequest) ProtoMessage() {}
+func (*ListReviewReportsResponse) ProtoMessage() {}
 
-func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[153]
+func (x *ListReviewReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12527,55 +18579,49 @@ func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
-func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use ListReviewReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewReportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *ReorderProductImagesRequest) GetProductId() int64 {
+func (x *ListReviewReportsResponse) GetReports() []*ReviewReport {
 	if x != nil {
-		return x.ProductId
+		return x.Reports
 	}
-	return 0
+	return nil
 }
 
-func (x *ReorderProductImagesRequest) GetStorefrontId() int64 {
+func (x *ListReviewReportsResponse) GetTotal() int64 {
 	if x != nil {
-		return x.StorefrontId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *ReorderProductImagesRequest) GetImageIds() []int64 {
-	if x != nil {
-		return x.ImageIds
-	}
-	return nil
-}
-
-// ReorderProductImagesResponse confirms reorder operation
-type ReorderProductImagesResponse struct {
+// ModerateReviewRequest hides or restores a review
+type ModerateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Hide          bool                   `protobuf:"varint,2,opt,name=hide,proto3" json:"hide,omitempty"` // false restores the review
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReorderProductImagesResponse) Reset() {
-	*x = ReorderProductImagesResponse{}
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[154]
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReorderProductImagesResponse) String() string {
+func (x *ModerateReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderProductImagesResponse) ProtoMessage() {}
+func (*ModerateReviewRequest) ProtoMessage() {}
 
-func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[154]
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listings_v1_listings_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12586,14 +18632,21 @@ func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
-func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listings_v1_listings_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *ReorderProductImagesResponse) GetSuccess() bool {
+func (x *ModerateReviewRequest) GetReviewId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetHide() bool {
+	if x != nil {
+		return x.Hide
 	}
 	return false
 }
@@ -12614,7 +18667,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\t_locationB\a\n" +
 	"\x05_cityB\n" +
 	"\n" +
-	"\b_country\"\xa6\t\n" +
+	"\b_country\"\xd6\v\n" +
 	"\aListing\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x17\n" +
@@ -12653,7 +18706,14 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\blocation\x18\x18 \x01(\v2\x1f.listingssvc.v1.ListingLocationH\x05R\blocation\x88\x01\x01\x12:\n" +
 	"\bvariants\x18\x19 \x03(\v2\x1e.listingssvc.v1.ListingVariantR\bvariants\x12M\n" +
 	"\ftranslations\x18\x1e \x03(\v2).listingssvc.v1.Listing.TranslationsEntryR\ftranslations\x12+\n" +
-	"\x11original_language\x18\x1f \x01(\tR\x10originalLanguage\x1ai\n" +
+	"\x11original_language\x18\x1f \x01(\tR\x10originalLanguage\x12\"\n" +
+	"\n" +
+	"expires_at\x18  \x01(\tH\x06R\texpiresAt\x88\x01\x01\x125\n" +
+	"\x14scheduled_publish_at\x18! \x01(\tH\aR\x12scheduledPublishAt\x88\x01\x01\x12\x16\n" +
+	"\x06rating\x18\" \x01(\x01R\x06rating\x12#\n" +
+	"\rreviews_count\x18# \x01(\x05R\freviewsCount\x12 \n" +
+	"\vunavailable\x18$ \x01(\bR\vunavailable\x124\n" +
+	"\x13availability_notice\x18% \x01(\tH\bR\x12availabilityNotice\x88\x01\x01\x1ai\n" +
 	"\x11TranslationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
 	"\x05value\x18\x02 \x01(\v2(.listingssvc.v1.ListingFieldTranslationsR\x05value:\x028\x01B\x10\n" +
@@ -12662,7 +18722,10 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x04_skuB\x0f\n" +
 	"\r_published_atB\r\n" +
 	"\v_deleted_atB\v\n" +
-	"\t_location\"\xf3\x03\n" +
+	"\t_locationB\r\n" +
+	"\v_expires_atB\x17\n" +
+	"\x15_scheduled_publish_atB\x16\n" +
+	"\x14_availability_notice\"\x86\x06\n" +
 	"\fListingImage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -12681,7 +18744,15 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\tR\tupdatedAtB\x0f\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\bblurhash\x18\x0e \x01(\tH\x06R\bblurhash\x88\x01\x01\x12>\n" +
+	"\n" +
+	"renditions\x18\x0f \x03(\v2\x1e.listingssvc.v1.ImageRenditionR\n" +
+	"renditions\x12+\n" +
+	"\x11processing_status\x18\x10 \x01(\tR\x10processingStatus\x12.\n" +
+	"\x10processing_error\x18\x11 \x01(\tH\aR\x0fprocessingError\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"variant_id\x18\x12 \x01(\x03H\bR\tvariantId\x88\x01\x01B\x0f\n" +
 	"\r_storage_pathB\x10\n" +
 	"\x0e_thumbnail_urlB\b\n" +
 	"\x06_widthB\t\n" +
@@ -12689,7 +18760,16 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"_file_sizeB\f\n" +
 	"\n" +
-	"_mime_type\"\xae\x01\n" +
+	"_mime_typeB\v\n" +
+	"\t_blurhashB\x13\n" +
+	"\x11_processing_errorB\r\n" +
+	"\v_variant_id\"\x90\x01\n" +
+	"\x0eImageRendition\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\fstorage_path\x18\x04 \x01(\tR\vstoragePath\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\"\xae\x01\n" +
 	"\x10ListingAttribute\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -12799,7 +18879,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x05_iconB\f\n" +
 	"\n" +
 	"_parent_idB\x16\n" +
-	"\x14_custom_ui_component\"\x81\t\n" +
+	"\x14_custom_ui_component\"\xf1\t\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x12\n" +
@@ -12834,14 +18914,17 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\vshow_on_map\x18\x17 \x01(\bR\tshowOnMap\x12!\n" +
 	"\fhas_variants\x18\x18 \x01(\bR\vhasVariants\x12:\n" +
 	"\bvariants\x18\x19 \x03(\v2\x1e.listingssvc.v1.ProductVariantR\bvariants\x124\n" +
-	"\x06images\x18\x1a \x03(\v2\x1c.listingssvc.v1.ProductImageR\x06imagesB\x06\n" +
+	"\x06images\x18\x1a \x03(\v2\x1c.listingssvc.v1.ProductImageR\x06images\x12 \n" +
+	"\vunavailable\x18\x1b \x01(\bR\vunavailable\x124\n" +
+	"\x13availability_notice\x18\x1c \x01(\tH\x06R\x12availabilityNotice\x88\x01\x01B\x06\n" +
 	"\x04_skuB\n" +
 	"\n" +
 	"\b_barcodeB\x15\n" +
 	"\x13_individual_addressB\x16\n" +
 	"\x14_individual_latitudeB\x17\n" +
 	"\x15_individual_longitudeB\x13\n" +
-	"\x11_location_privacy\"\xd5\x06\n" +
+	"\x11_location_privacyB\x16\n" +
+	"\x14_availability_notice\"\x8b\a\n" +
 	"\x0eProductVariant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -12871,7 +18954,8 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x06\n" +
+	"updated_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\x06images\x18\x14 \x03(\v2\x1c.listingssvc.v1.ProductImageR\x06imagesB\x06\n" +
 	"\x04_skuB\n" +
 	"\n" +
 	"\b_barcodeB\b\n" +
@@ -12905,9 +18989,13 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x0e_storefront_idB\x0e\n" +
 	"\f_descriptionB\x06\n" +
 	"\x04_skuB\x14\n" +
-	"\x12_original_language\"J\n" +
+	"\x12_original_language\"\x9f\x01\n" +
 	"\x15CreateListingResponse\x121\n" +
-	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\"\x96\x02\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\x12D\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\v2\x1f.listingssvc.v1.DuplicateReportH\x00R\n" +
+	"duplicates\x88\x01\x01B\r\n" +
+	"\v_duplicates\"\x96\x02\n" +
 	"\x14UpdateListingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
@@ -12920,8 +19008,17 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\f_descriptionB\b\n" +
 	"\x06_priceB\v\n" +
 	"\t_quantityB\t\n" +
-	"\a_status\"J\n" +
+	"\a_status\"\x9f\x01\n" +
 	"\x15UpdateListingResponse\x121\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\x12D\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\v2\x1f.listingssvc.v1.DuplicateReportH\x00R\n" +
+	"duplicates\x88\x01\x01B\r\n" +
+	"\v_duplicates\">\n" +
+	"\x13RenewListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"I\n" +
+	"\x14RenewListingResponse\x121\n" +
 	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\"Z\n" +
 	"\x14DeleteListingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
@@ -12974,7 +19071,26 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x06_limit\"g\n" +
 	"\x1aGetSimilarListingsResponse\x123\n" +
 	"\blistings\x18\x01 \x03(\v2\x17.listingssvc.v1.ListingR\blistings\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"+\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9b\x01\n" +
+	"\x12RecommendedListing\x121\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\x12<\n" +
+	"\x06source\x18\x02 \x01(\x0e2$.listingssvc.v1.RecommendationSourceR\x06source\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"z\n" +
+	" GetRecommendationsForUserRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x01R\x05limit\x88\x01\x01B\r\n" +
+	"\v_session_idB\b\n" +
+	"\x06_limit\"q\n" +
+	"!GetRecommendationsForUserResponse\x12L\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\".listingssvc.v1.RecommendedListingR\x0frecommendations\"h\n" +
+	"\"GetFrequentlyBoughtTogetherRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"s\n" +
+	"#GetFrequentlyBoughtTogetherResponse\x12L\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\".listingssvc.v1.RecommendedListingR\x0frecommendations\"+\n" +
 	"\x0eImageIDRequest\x12\x19\n" +
 	"\bimage_id\x18\x01 \x01(\x03R\aimageId\"C\n" +
 	"\rImageResponse\x122\n" +
@@ -13043,7 +19159,42 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x06images\x18\x01 \x03(\v2\x1c.listingssvc.v1.ListingImageR\x06images\x12%\n" +
 	"\x0euploaded_count\x18\x02 \x01(\x05R\ruploadedCount\x12!\n" +
 	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\x12\x16\n" +
-	"\x06errors\x18\x04 \x03(\tR\x06errors\"0\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"m\n" +
+	"\x0fImageUploadFile\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\"\xca\x01\n" +
+	"\x1dRequestImageUploadURLsRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12(\n" +
+	"\rstorefront_id\x18\x03 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x125\n" +
+	"\x05files\x18\x04 \x03(\v2\x1f.listingssvc.v1.ImageUploadFileR\x05filesB\x10\n" +
+	"\x0e_storefront_id\"\xad\x01\n" +
+	"\x14PresignedImageUpload\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1f\n" +
+	"\vstorage_key\x18\x02 \x01(\tR\n" +
+	"storageKey\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x03 \x01(\tR\tuploadUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"`\n" +
+	"\x1eRequestImageUploadURLsResponse\x12>\n" +
+	"\auploads\x18\x01 \x03(\v2$.listingssvc.v1.PresignedImageUploadR\auploads\"\xa7\x02\n" +
+	"\x19ConfirmImageUploadRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12(\n" +
+	"\rstorefront_id\x18\x03 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x12\x1f\n" +
+	"\vstorage_key\x18\x04 \x01(\tR\n" +
+	"storageKey\x12#\n" +
+	"\rdisplay_order\x18\x05 \x01(\x05R\fdisplayOrder\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12\"\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\x03H\x01R\tvariantId\x88\x01\x01B\x10\n" +
+	"\x0e_storefront_idB\r\n" +
+	"\v_variant_id\"0\n" +
 	"\x18PopularCategoriesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"N\n" +
 	"\x12CategoriesResponse\x128\n" +
@@ -13713,7 +19864,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\ffailed_count\x18\x02 \x01(\x05R\vfailedCount\x12;\n" +
 	"\aresults\x18\x03 \x03(\v2!.listingssvc.v1.StockUpdateResultR\aresults\"=\n" +
 	"\x16GetProductStatsRequest\x12#\n" +
-	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"\xdd\x01\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"\xa1\x02\n" +
 	"\fProductStats\x12%\n" +
 	"\x0etotal_products\x18\x01 \x01(\x05R\rtotalProducts\x12'\n" +
 	"\x0factive_products\x18\x02 \x01(\x05R\x0eactiveProducts\x12 \n" +
@@ -13723,12 +19874,39 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\vtotal_value\x18\x05 \x01(\x01R\n" +
 	"totalValue\x12\x1d\n" +
 	"\n" +
-	"total_sold\x18\x06 \x01(\x05R\ttotalSold\"M\n" +
+	"total_sold\x18\x06 \x01(\x05R\ttotalSold\x12!\n" +
+	"\funique_views\x18\a \x01(\x03R\vuniqueViews\x12\x1f\n" +
+	"\vtotal_views\x18\b \x01(\x03R\n" +
+	"totalViews\"M\n" +
 	"\x17GetProductStatsResponse\x122\n" +
-	"\x05stats\x18\x01 \x01(\v2\x1c.listingssvc.v1.ProductStatsR\x05stats\"=\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1c.listingssvc.v1.ProductStatsR\x05stats\"\xa3\x01\n" +
 	"\x1cIncrementProductViewsRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\"|\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\"\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tH\x01R\tuserAgent\x88\x01\x01B\r\n" +
+	"\v_session_idB\r\n" +
+	"\v_user_agent\"r\n" +
+	"\x18GetRecentlyViewedRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x01R\x05limit\x88\x01\x01B\r\n" +
+	"\v_session_idB\b\n" +
+	"\x06_limit\"\x83\x01\n" +
+	"\x15RecentlyViewedListing\x121\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\x127\n" +
+	"\tviewed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bviewedAt\"X\n" +
+	"\x19GetRecentlyViewedResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.listingssvc.v1.RecentlyViewedListingR\x05items\"\x82\x01\n" +
+	"\x1aClearRecentlyViewedRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03H\x01R\tlistingId\x88\x01\x01B\r\n" +
+	"\v_session_idB\r\n" +
+	"\v_listing_id\"|\n" +
 	"\x11ReindexAllRequest\x12$\n" +
 	"\vsource_type\x18\x01 \x01(\tH\x00R\n" +
 	"sourceType\x88\x01\x01\x12\"\n" +
@@ -13740,7 +19918,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\rtotal_indexed\x18\x01 \x01(\x05R\ftotalIndexed\x12!\n" +
 	"\ftotal_failed\x18\x02 \x01(\x05R\vtotalFailed\x12)\n" +
 	"\x10duration_seconds\x18\x03 \x01(\x05R\x0fdurationSeconds\x12\x16\n" +
-	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xab\x13\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xcc\x16\n" +
 	"\x0eStorefrontFull\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
@@ -13797,7 +19975,15 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x05staff\x18, \x03(\v2\x1f.listingssvc.v1.StorefrontStaffR\x05staff\x125\n" +
 	"\x05hours\x18- \x03(\v2\x1f.listingssvc.v1.StorefrontHoursR\x05hours\x12P\n" +
 	"\x0fpayment_methods\x18. \x03(\v2'.listingssvc.v1.StorefrontPaymentMethodR\x0epaymentMethods\x12S\n" +
-	"\x10delivery_options\x18/ \x03(\v2(.listingssvc.v1.StorefrontDeliveryOptionR\x0fdeliveryOptionsB\x0e\n" +
+	"\x10delivery_options\x18/ \x03(\v2(.listingssvc.v1.StorefrontDeliveryOptionR\x0fdeliveryOptions\x12#\n" +
+	"\rvacation_mode\x180 \x01(\bR\fvacationMode\x12M\n" +
+	"\x12vacation_starts_at\x181 \x01(\v2\x1a.google.protobuf.TimestampH\x14R\x10vacationStartsAt\x88\x01\x01\x12I\n" +
+	"\x10vacation_ends_at\x182 \x01(\v2\x1a.google.protobuf.TimestampH\x15R\x0evacationEndsAt\x88\x01\x01\x12.\n" +
+	"\x10vacation_message\x183 \x01(\tH\x16R\x0fvacationMessage\x88\x01\x01\x122\n" +
+	"\x15vacation_order_policy\x184 \x01(\tR\x13vacationOrderPolicy\x12\x1f\n" +
+	"\von_vacation\x185 \x01(\bR\n" +
+	"onVacation\x12\x1a\n" +
+	"\btimezone\x186 \x01(\tR\btimezoneB\x0e\n" +
 	"\f_descriptionB\v\n" +
 	"\t_logo_urlB\r\n" +
 	"\v_banner_urlB\b\n" +
@@ -13821,7 +20007,10 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x12_verification_dateB\x1a\n" +
 	"\x18_subscription_expires_atB\x12\n" +
 	"\x10_subscription_idB\x12\n" +
-	"\x10_ai_agent_config\"\xd3\x03\n" +
+	"\x10_ai_agent_configB\x15\n" +
+	"\x13_vacation_starts_atB\x13\n" +
+	"\x11_vacation_ends_atB\x13\n" +
+	"\x11_vacation_message\"\x88\x04\n" +
 	"\x0fStorefrontStaff\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x17\n" +
@@ -13833,7 +20022,9 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
+	"\x15effective_permissions\x18\n" +
+	" \x03(\tR\x14effectivePermissionsB\x0e\n" +
 	"\f_permissionsB\x11\n" +
 	"\x0f_last_active_at\"\xd8\x02\n" +
 	"\x0fStorefrontHours\x12\x0e\n" +
@@ -13946,7 +20137,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"\b_websiteB\v\n" +
 	"\t_settingsB\v\n" +
-	"\t_seo_meta\"\x81\a\n" +
+	"\t_seo_meta\"\xaf\a\n" +
 	"\x17UpdateStorefrontRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
@@ -13966,7 +20157,8 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\bseo_meta\x18\r \x01(\v2\x17.google.protobuf.StructH\vR\aseoMeta\x88\x01\x01\x12-\n" +
 	"\x10ai_agent_enabled\x18\x0e \x01(\bH\fR\x0eaiAgentEnabled\x88\x01\x01\x127\n" +
 	"\x15live_shopping_enabled\x18\x0f \x01(\bH\rR\x13liveShoppingEnabled\x88\x01\x01\x125\n" +
-	"\x14group_buying_enabled\x18\x10 \x01(\bH\x0eR\x12groupBuyingEnabled\x88\x01\x01B\a\n" +
+	"\x14group_buying_enabled\x18\x10 \x01(\bH\x0eR\x12groupBuyingEnabled\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x11 \x01(\tH\x0fR\btimezone\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
@@ -13983,7 +20175,22 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\t_seo_metaB\x13\n" +
 	"\x11_ai_agent_enabledB\x18\n" +
 	"\x16_live_shopping_enabledB\x17\n" +
-	"\x15_group_buying_enabled\"J\n" +
+	"\x15_group_buying_enabledB\v\n" +
+	"\t_timezone\"U\n" +
+	"\tImageCrop\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\"\x80\x02\n" +
+	"\x1cUploadStorefrontImageRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x122\n" +
+	"\x04crop\x18\a \x01(\v2\x19.listingssvc.v1.ImageCropH\x00R\x04crop\x88\x01\x01B\a\n" +
+	"\x05_crop\"J\n" +
 	"\x17DeleteStorefrontRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vhard_delete\x18\x02 \x01(\bR\n" +
@@ -13996,20 +20203,85 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12-\n" +
 	"\x04role\x18\x03 \x01(\x0e2\x19.listingssvc.v1.StaffRoleR\x04role\x12>\n" +
 	"\vpermissions\x18\x04 \x01(\v2\x17.google.protobuf.StructH\x00R\vpermissions\x88\x01\x01B\x0e\n" +
-	"\f_permissions\"\xb1\x01\n" +
+	"\f_permissions\"\xd7\x01\n" +
 	"\x12UpdateStaffRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x122\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x19.listingssvc.v1.StaffRoleH\x00R\x04role\x88\x01\x01\x12>\n" +
-	"\vpermissions\x18\x03 \x01(\v2\x17.google.protobuf.StructH\x01R\vpermissions\x88\x01\x01B\a\n" +
+	"\vpermissions\x18\x03 \x01(\v2\x17.google.protobuf.StructH\x01R\vpermissions\x88\x01\x01\x12$\n" +
+	"\x0eacting_user_id\x18\x04 \x01(\x03R\factingUserIdB\a\n" +
 	"\x05_roleB\x0e\n" +
-	"\f_permissions\"R\n" +
+	"\f_permissions\"x\n" +
 	"\x12RemoveStaffRequest\x12#\n" +
 	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"6\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12$\n" +
+	"\x0eacting_user_id\x18\x03 \x01(\x03R\factingUserId\"\\\n" +
 	"\x0fGetStaffRequest\x12#\n" +
-	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"I\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12$\n" +
+	"\x0eacting_user_id\x18\x02 \x01(\x03R\factingUserId\"I\n" +
 	"\x10GetStaffResponse\x125\n" +
-	"\x05staff\x18\x01 \x03(\v2\x1f.listingssvc.v1.StorefrontStaffR\x05staff\"t\n" +
+	"\x05staff\x18\x01 \x03(\v2\x1f.listingssvc.v1.StorefrontStaffR\x05staff\"\x87\x04\n" +
+	"\x0fStaffInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12-\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x19.listingssvc.v1.StaffRoleR\x04role\x12>\n" +
+	"\vpermissions\x18\x05 \x01(\v2\x17.google.protobuf.StructH\x00R\vpermissions\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\x03R\tinvitedBy\x12=\n" +
+	"\x06status\x18\a \x01(\x0e2%.listingssvc.v1.StaffInvitationStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12B\n" +
+	"\fresponded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vrespondedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_permissionsB\x0f\n" +
+	"\r_responded_at\"\xf7\x01\n" +
+	"\x12InviteStaffRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12-\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x19.listingssvc.v1.StaffRoleR\x04role\x12>\n" +
+	"\vpermissions\x18\x04 \x01(\v2\x17.google.protobuf.StructH\x00R\vpermissions\x88\x01\x01\x12$\n" +
+	"\x0eacting_user_id\x18\x05 \x01(\x03R\factingUserIdB\x0e\n" +
+	"\f_permissions\"\\\n" +
+	"\x1cStaffInvitationActionRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\x03R\finvitationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x95\x01\n" +
+	"\x1bListStaffInvitationsRequest\x12(\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03H\x00R\fstorefrontId\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12!\n" +
+	"\fpending_only\x18\x03 \x01(\bR\vpendingOnlyB\x10\n" +
+	"\x0e_storefront_id\"a\n" +
+	"\x1cListStaffInvitationsResponse\x12A\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1f.listingssvc.v1.StaffInvitationR\vinvitations\"W\n" +
+	"\x17FollowStorefrontRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"a\n" +
+	"\x18FollowStorefrontResponse\x12\x1c\n" +
+	"\tfollowing\x18\x01 \x01(\bR\tfollowing\x12'\n" +
+	"\x0ffollowers_count\x18\x02 \x01(\x05R\x0efollowersCount\"j\n" +
+	"\x12StorefrontFollower\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12;\n" +
+	"\vfollowed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followedAt\"\x98\x01\n" +
+	"\x1dGetStorefrontFollowersRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12$\n" +
+	"\x0eacting_user_id\x18\x04 \x01(\x03R\factingUserId\"x\n" +
+	"\x1eGetStorefrontFollowersResponse\x12@\n" +
+	"\tfollowers\x18\x01 \x03(\v2\".listingssvc.v1.StorefrontFollowerR\tfollowers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"p\n" +
+	"\x17GetFollowingFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\x85\x01\n" +
+	"\x18GetFollowingFeedResponse\x123\n" +
+	"\blistings\x18\x01 \x03(\v2\x17.listingssvc.v1.ListingR\blistings\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"t\n" +
 	"\x16SetWorkingHoursRequest\x12#\n" +
 	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x125\n" +
 	"\x05hours\x18\x02 \x03(\v2\x1f.listingssvc.v1.StorefrontHoursR\x05hours\"=\n" +
@@ -14018,13 +20290,171 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x17GetWorkingHoursResponse\x125\n" +
 	"\x05hours\x18\x01 \x03(\v2\x1f.listingssvc.v1.StorefrontHoursR\x05hours\"7\n" +
 	"\x10IsOpenNowRequest\x12#\n" +
-	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"\xab\x01\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"\xd0\x03\n" +
 	"\x11IsOpenNowResponse\x12\x17\n" +
 	"\ais_open\x18\x01 \x01(\bR\x06isOpen\x12)\n" +
 	"\x0enext_open_time\x18\x02 \x01(\tH\x00R\fnextOpenTime\x88\x01\x01\x12+\n" +
-	"\x0fnext_close_time\x18\x03 \x01(\tH\x01R\rnextCloseTime\x88\x01\x01B\x11\n" +
+	"\x0fnext_close_time\x18\x03 \x01(\tH\x01R\rnextCloseTime\x88\x01\x01\x12(\n" +
+	"\rclosed_reason\x18\x04 \x01(\tH\x02R\fclosedReason\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x03R\x04note\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12A\n" +
+	"\fnext_open_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"nextOpenAt\x88\x01\x01\x12C\n" +
+	"\rnext_close_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x05R\vnextCloseAt\x88\x01\x01B\x11\n" +
 	"\x0f_next_open_timeB\x12\n" +
-	"\x10_next_close_time\"\x82\x01\n" +
+	"\x10_next_close_timeB\x10\n" +
+	"\x0e_closed_reasonB\a\n" +
+	"\x05_noteB\x0f\n" +
+	"\r_next_open_atB\x10\n" +
+	"\x0e_next_close_at\"\xb7\x02\n" +
+	"\x16SetVacationModeRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12<\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bstartsAt\x88\x01\x01\x128\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06endsAt\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x05 \x01(\tH\x02R\amessage\x88\x01\x01\x12!\n" +
+	"\forder_policy\x18\x06 \x01(\tR\vorderPolicyB\f\n" +
+	"\n" +
+	"_starts_atB\n" +
+	"\n" +
+	"\b_ends_atB\n" +
+	"\n" +
+	"\b_message\"\xc1\x01\n" +
+	"\x11StorefrontHoliday\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12)\n" +
+	"\x10recurring_yearly\x18\x06 \x01(\bR\x0frecurringYearly\"x\n" +
+	"\x12SetHolidaysRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12=\n" +
+	"\bholidays\x18\x02 \x03(\v2!.listingssvc.v1.StorefrontHolidayR\bholidays\"9\n" +
+	"\x12GetHolidaysRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"T\n" +
+	"\x13GetHolidaysResponse\x12=\n" +
+	"\bholidays\x18\x01 \x03(\v2!.listingssvc.v1.StorefrontHolidayR\bholidays\"\x8c\x03\n" +
+	"\x10StorefrontDomain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x12@\n" +
+	"\vverified_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"verifiedAt\x88\x01\x01\x12/\n" +
+	"\x13verification_record\x18\a \x01(\tR\x12verificationRecord\x12-\n" +
+	"\x12verification_token\x18\b \x01(\tR\x11verificationToken\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_verified_at\"Y\n" +
+	"\x1aAddStorefrontDomainRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"[\n" +
+	"\x17StorefrontDomainRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\"C\n" +
+	"\x1cListStorefrontDomainsRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"[\n" +
+	"\x1dListStorefrontDomainsResponse\x12:\n" +
+	"\adomains\x18\x01 \x03(\v2 .listingssvc.v1.StorefrontDomainR\adomains\"8\n" +
+	"\x1eResolveStorefrontDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\xa6\x01\n" +
+	"\x1fResolveStorefrontDomainResponse\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12'\n" +
+	"\x0fstorefront_slug\x18\x02 \x01(\tR\x0estorefrontSlug\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\"\x94\x01\n" +
+	"\x1aVerificationDocumentUpload\x12#\n" +
+	"\rdocument_type\x18\x01 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\xff\x01\n" +
+	"\x1eStorefrontVerificationDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rdocument_type\x18\x02 \x01(\tR\fdocumentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\x05\n" +
+	"\x16StorefrontVerification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12!\n" +
+	"\fsubmitted_by\x18\x03 \x01(\x03R\vsubmittedBy\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
+	"\rbusiness_name\x18\x05 \x01(\tR\fbusinessName\x124\n" +
+	"\x13registration_number\x18\x06 \x01(\tH\x00R\x12registrationNumber\x88\x01\x01\x12\x1a\n" +
+	"\x06tax_id\x18\a \x01(\tH\x01R\x05taxId\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\b \x01(\tH\x02R\x05notes\x88\x01\x01\x12$\n" +
+	"\vreviewed_by\x18\t \x01(\x03H\x03R\n" +
+	"reviewedBy\x88\x01\x01\x12@\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"reviewedAt\x88\x01\x01\x12.\n" +
+	"\x10rejection_reason\x18\v \x01(\tH\x05R\x0frejectionReason\x88\x01\x01\x12L\n" +
+	"\tdocuments\x18\f \x03(\v2..listingssvc.v1.StorefrontVerificationDocumentR\tdocuments\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x16\n" +
+	"\x14_registration_numberB\t\n" +
+	"\a_tax_idB\b\n" +
+	"\x06_notesB\x0e\n" +
+	"\f_reviewed_byB\x0e\n" +
+	"\f_reviewed_atB\x13\n" +
+	"\x11_rejection_reason\"\xf6\x01\n" +
+	"\x1bStorefrontVerificationEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\x03H\x00R\trequestId\x88\x01\x01\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x03R\aactorId\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x01R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_request_idB\t\n" +
+	"\a_reason\"\xd3\x02\n" +
+	"#SubmitStorefrontVerificationRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12#\n" +
+	"\rbusiness_name\x18\x02 \x01(\tR\fbusinessName\x124\n" +
+	"\x13registration_number\x18\x03 \x01(\tH\x00R\x12registrationNumber\x88\x01\x01\x12\x1a\n" +
+	"\x06tax_id\x18\x04 \x01(\tH\x01R\x05taxId\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x05 \x01(\tH\x02R\x05notes\x88\x01\x01\x12H\n" +
+	"\tdocuments\x18\x06 \x03(\v2*.listingssvc.v1.VerificationDocumentUploadR\tdocumentsB\x16\n" +
+	"\x14_registration_numberB\t\n" +
+	"\a_tax_idB\b\n" +
+	"\x06_notes\"G\n" +
+	" GetStorefrontVerificationRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\"\x81\x03\n" +
+	"!GetStorefrontVerificationResponse\x12\x1f\n" +
+	"\vis_verified\x18\x01 \x01(\bR\n" +
+	"isVerified\x12L\n" +
+	"\x11verification_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10verificationDate\x88\x01\x01\x12)\n" +
+	"\x10address_verified\x18\x03 \x01(\bR\x0faddressVerified\x12R\n" +
+	"\x0elatest_request\x18\x04 \x01(\v2&.listingssvc.v1.StorefrontVerificationH\x01R\rlatestRequest\x88\x01\x01\x12E\n" +
+	"\ahistory\x18\x05 \x03(\v2+.listingssvc.v1.StorefrontVerificationEventR\ahistoryB\x14\n" +
+	"\x12_verification_dateB\x11\n" +
+	"\x0f_latest_request\"g\n" +
+	"\x1fListVerificationRequestsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"|\n" +
+	" ListVerificationRequestsResponse\x12B\n" +
+	"\brequests\x18\x01 \x03(\v2&.listingssvc.v1.StorefrontVerificationR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"v\n" +
+	"#ReviewStorefrontVerificationRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"b\n" +
+	"#RevokeStorefrontVerificationRequest\x12#\n" +
+	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x82\x01\n" +
 	"\x18SetPaymentMethodsRequest\x12#\n" +
 	"\rstorefront_id\x18\x01 \x01(\x03R\fstorefrontId\x12A\n" +
 	"\amethods\x18\x02 \x03(\v2'.listingssvc.v1.StorefrontPaymentMethodR\amethods\"?\n" +
@@ -14086,7 +20516,7 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x0fconversion_rate\x18\b \x01(\x01R\x0econversionRate\x12%\n" +
 	"\x0epending_orders\x18\t \x01(\x05R\rpendingOrders\x12,\n" +
 	"\x12low_stock_products\x18\n" +
-	" \x01(\x05R\x10lowStockProducts\"\xf3\x03\n" +
+	" \x01(\x05R\x10lowStockProducts\"\xa6\x04\n" +
 	"\fProductImage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -14105,7 +20535,9 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\tR\tupdatedAtB\x0f\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\x12\"\n" +
+	"\n" +
+	"variant_id\x18\x0e \x01(\x03H\x06R\tvariantId\x88\x01\x01B\x0f\n" +
 	"\r_storage_pathB\x10\n" +
 	"\x0e_thumbnail_urlB\b\n" +
 	"\x06_widthB\t\n" +
@@ -14113,7 +20545,8 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"_file_sizeB\f\n" +
 	"\n" +
-	"_mime_type\"\xd4\x03\n" +
+	"_mime_typeB\r\n" +
+	"\v_variant_id\"\x87\x04\n" +
 	"\x16AddProductImageRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12#\n" +
@@ -14128,7 +20561,9 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x06height\x18\t \x01(\x05H\x03R\x06height\x88\x01\x01\x12 \n" +
 	"\tfile_size\x18\n" +
 	" \x01(\x03H\x04R\bfileSize\x88\x01\x01\x12 \n" +
-	"\tmime_type\x18\v \x01(\tH\x05R\bmimeType\x88\x01\x01B\x0f\n" +
+	"\tmime_type\x18\v \x01(\tH\x05R\bmimeType\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"variant_id\x18\f \x01(\x03H\x06R\tvariantId\x88\x01\x01B\x0f\n" +
 	"\r_storage_pathB\x10\n" +
 	"\x0e_thumbnail_urlB\b\n" +
 	"\x06_widthB\t\n" +
@@ -14136,7 +20571,8 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\n" +
 	"_file_sizeB\f\n" +
 	"\n" +
-	"_mime_type\"J\n" +
+	"_mime_typeB\r\n" +
+	"\v_variant_id\"J\n" +
 	"\x14ProductImageResponse\x122\n" +
 	"\x05image\x18\x01 \x01(\v2\x1c.listingssvc.v1.ProductImageR\x05image\"8\n" +
 	"\x17GetProductImagesRequest\x12\x1d\n" +
@@ -14158,7 +20594,206 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\rstorefront_id\x18\x02 \x01(\x03R\fstorefrontId\x12\x1b\n" +
 	"\timage_ids\x18\x03 \x03(\x03R\bimageIds\"8\n" +
 	"\x1cReorderProductImagesResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*\xa2\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x99\x02\n" +
+	"\x11PriceHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03R\tlistingId\x12\"\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03H\x00R\tvariantId\x88\x01\x01\x12 \n" +
+	"\told_price\x18\x04 \x01(\x01H\x01R\boldPrice\x88\x01\x01\x12\x1b\n" +
+	"\tnew_price\x18\x05 \x01(\x01R\bnewPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAtB\r\n" +
+	"\v_variant_idB\f\n" +
+	"\n" +
+	"_old_price\"\xf6\x01\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\"\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03H\x00R\tvariantId\x88\x01\x01\x123\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x02to\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\r\n" +
+	"\v_variant_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xcb\x01\n" +
+	"\x17GetPriceHistoryResponse\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.listingssvc.v1.PriceHistoryEntryR\aentries\x12&\n" +
+	"\flowest_price\x18\x02 \x01(\x01H\x00R\vlowestPrice\x88\x01\x01\x12(\n" +
+	"\rhighest_price\x18\x03 \x01(\x01H\x01R\fhighestPrice\x88\x01\x01B\x0f\n" +
+	"\r_lowest_priceB\x10\n" +
+	"\x0e_highest_price\"\x97\x01\n" +
+	"\x1dSubmitListingForReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12>\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tpublishAt\x88\x01\x01B\r\n" +
+	"\v_publish_at\"S\n" +
+	"\x1eSubmitListingForReviewResponse\x121\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\"I\n" +
+	"\x19GetModerationQueueRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"g\n" +
+	"\x1aGetModerationQueueResponse\x123\n" +
+	"\blistings\x18\x01 \x03(\v2\x17.listingssvc.v1.ListingR\blistings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"i\n" +
+	"\x16ModerateListingRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"L\n" +
+	"\x17ModerateListingResponse\x121\n" +
+	"\alisting\x18\x01 \x01(\v2\x17.listingssvc.v1.ListingR\alisting\"\xfd\x01\n" +
+	"\x0eDuplicateMatch\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0ftext_similarity\x18\x05 \x01(\x01R\x0etextSimilarity\x12*\n" +
+	"\x0eimage_distance\x18\x06 \x01(\x05H\x00R\rimageDistance\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"same_owner\x18\a \x01(\bR\tsameOwnerB\x11\n" +
+	"\x0f_image_distance\"c\n" +
+	"\x0fDuplicateReport\x128\n" +
+	"\amatches\x18\x01 \x03(\v2\x1e.listingssvc.v1.DuplicateMatchR\amatches\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"\x85\x02\n" +
+	"\rDuplicateFlag\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\x03R\tlistingId\x120\n" +
+	"\x14duplicate_listing_id\x18\x02 \x01(\x03R\x12duplicateListingId\x12'\n" +
+	"\x0ftext_similarity\x18\x03 \x01(\x01R\x0etextSimilarity\x12*\n" +
+	"\x0eimage_distance\x18\x04 \x01(\x05H\x00R\rimageDistance\x88\x01\x01\x12;\n" +
+	"\vdetected_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAtB\x11\n" +
+	"\x0f_image_distance\"I\n" +
+	"\x19ListDuplicateFlagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"g\n" +
+	"\x1aListDuplicateFlagsResponse\x123\n" +
+	"\x05flags\x18\x01 \x03(\v2\x1d.listingssvc.v1.DuplicateFlagR\x05flags\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf4\x05\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12D\n" +
+	"\fsubject_type\x18\x02 \x01(\x0e2!.listingssvc.v1.ReviewSubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\x03R\tsubjectId\x12#\n" +
+	"\rstorefront_id\x18\x04 \x01(\x03R\fstorefrontId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x120\n" +
+	"\x14is_verified_purchase\x18\x06 \x01(\bR\x12isVerifiedPurchase\x12\x1e\n" +
+	"\border_id\x18\a \x01(\x03H\x00R\aorderId\x88\x01\x01\x12\x16\n" +
+	"\x06rating\x18\b \x01(\x05R\x06rating\x12\x19\n" +
+	"\x05title\x18\t \x01(\tH\x01R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\n" +
+	" \x01(\tH\x02R\x04body\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12&\n" +
+	"\fseller_reply\x18\f \x01(\tH\x03R\vsellerReply\x88\x01\x01\x12K\n" +
+	"\x11seller_replied_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0fsellerRepliedAt\x88\x01\x01\x12#\n" +
+	"\rhelpful_count\x18\x0e \x01(\x05R\fhelpfulCount\x12*\n" +
+	"\x11not_helpful_count\x18\x0f \x01(\x05R\x0fnotHelpfulCount\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_order_idB\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_bodyB\x0f\n" +
+	"\r_seller_replyB\x14\n" +
+	"\x12_seller_replied_at\"\xe5\x01\n" +
+	"\rReviewSummary\x12D\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e2!.listingssvc.v1.ReviewSubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\x03R\tsubjectId\x12%\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\x01R\raverageRating\x12#\n" +
+	"\rreviews_count\x18\x04 \x01(\x05R\freviewsCount\x12#\n" +
+	"\rrating_counts\x18\x05 \x03(\x05R\fratingCounts\"\xf2\x01\n" +
+	"\x13CreateReviewRequest\x12D\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e2!.listingssvc.v1.ReviewSubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\x03R\tsubjectId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x19\n" +
+	"\x05title\x18\x04 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\x05 \x01(\tH\x01R\x04body\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userIdB\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_body\";\n" +
+	"\x10GetReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x88\x02\n" +
+	"\x12ListReviewsRequest\x12D\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e2!.listingssvc.v1.ReviewSubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\x03R\tsubjectId\x12\x1b\n" +
+	"\x06rating\x18\x03 \x01(\x05H\x00R\x06rating\x88\x01\x01\x12#\n" +
+	"\rverified_only\x18\x04 \x01(\bR\fverifiedOnly\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offsetB\t\n" +
+	"\a_rating\"\x96\x01\n" +
+	"\x13ListReviewsResponse\x120\n" +
+	"\areviews\x18\x01 \x03(\v2\x16.listingssvc.v1.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x127\n" +
+	"\asummary\x18\x03 \x01(\v2\x1d.listingssvc.v1.ReviewSummaryR\asummary\"\xad\x01\n" +
+	"\x13UpdateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\x06rating\x18\x02 \x01(\x05H\x00R\x06rating\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\x04 \x01(\tH\x02R\x04body\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userIdB\t\n" +
+	"\a_ratingB\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_body\">\n" +
+	"\x13DeleteReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"0\n" +
+	"\x14DeleteReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"~\n" +
+	"\x17GetReviewSummaryRequest\x12D\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e2!.listingssvc.v1.ReviewSubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\x03R\tsubjectId\"b\n" +
+	"\x14ReplyToReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x14\n" +
+	"\x05reply\x18\x02 \x01(\tR\x05reply\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"c\n" +
+	"\x11VoteReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x18\n" +
+	"\ahelpful\x18\x02 \x01(\bR\ahelpful\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"\x8e\x01\n" +
+	"\x13ReportReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1d\n" +
+	"\acomment\x18\x03 \x01(\tH\x00R\acomment\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userIdB\n" +
+	"\n" +
+	"\b_comment\"U\n" +
+	"\x14ReportReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rreview_hidden\x18\x02 \x01(\bR\freviewHidden\"\xea\x01\n" +
+	"\fReviewReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x03R\breviewId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\acomment\x18\x05 \x01(\tH\x00R\acomment\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_comment\"e\n" +
+	"\x18ListReviewReportsRequest\x12\x1b\n" +
+	"\topen_only\x18\x01 \x01(\bR\bopenOnly\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"i\n" +
+	"\x19ListReviewReportsResponse\x126\n" +
+	"\areports\x18\x01 \x03(\v2\x1c.listingssvc.v1.ReviewReportR\areports\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"H\n" +
+	"\x15ModerateReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x12\n" +
+	"\x04hide\x18\x02 \x01(\bR\x04hide*\xa2\x01\n" +
 	"\x15StorefrontGeoStrategy\x12'\n" +
 	"#STOREFRONT_GEO_STRATEGY_UNSPECIFIED\x10\x00\x12/\n" +
 	"+STOREFRONT_GEO_STRATEGY_STOREFRONT_LOCATION\x10\x01\x12/\n" +
@@ -14181,7 +20816,18 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x12STAFF_ROLE_MANAGER\x10\x02\x12\x16\n" +
 	"\x12STAFF_ROLE_CASHIER\x10\x03\x12\x16\n" +
 	"\x12STAFF_ROLE_SUPPORT\x10\x04\x12\x18\n" +
-	"\x14STAFF_ROLE_MODERATOR\x10\x05*\xda\x02\n" +
+	"\x14STAFF_ROLE_MODERATOR\x10\x05*\xfb\x01\n" +
+	"\x15StaffInvitationStatus\x12'\n" +
+	"#STAFF_INVITATION_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSTAFF_INVITATION_STATUS_PENDING\x10\x01\x12$\n" +
+	" STAFF_INVITATION_STATUS_ACCEPTED\x10\x02\x12$\n" +
+	" STAFF_INVITATION_STATUS_DECLINED\x10\x03\x12#\n" +
+	"\x1fSTAFF_INVITATION_STATUS_REVOKED\x10\x04\x12#\n" +
+	"\x1fSTAFF_INVITATION_STATUS_EXPIRED\x10\x05*}\n" +
+	"\x11ReviewSubjectType\x12#\n" +
+	"\x1fREVIEW_SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bREVIEW_SUBJECT_TYPE_PRODUCT\x10\x01\x12\"\n" +
+	"\x1eREVIEW_SUBJECT_TYPE_STOREFRONT\x10\x02*\xda\x02\n" +
 	"\x11PaymentMethodType\x12#\n" +
 	"\x1fPAYMENT_METHOD_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18PAYMENT_METHOD_TYPE_CASH\x10\x01\x12\x1b\n" +
@@ -14201,7 +20847,13 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x1bDELIVERY_PROVIDER_D_EXPRESS\x10\x04\x12\"\n" +
 	"\x1eDELIVERY_PROVIDER_CITY_EXPRESS\x10\x05\x12!\n" +
 	"\x1dDELIVERY_PROVIDER_SELF_PICKUP\x10\x06\x12\"\n" +
-	"\x1eDELIVERY_PROVIDER_OWN_DELIVERY\x10\a2\x9c:\n" +
+	"\x1eDELIVERY_PROVIDER_OWN_DELIVERY\x10\a*\xdc\x01\n" +
+	"\x14RecommendationSource\x12%\n" +
+	"!RECOMMENDATION_SOURCE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"RECOMMENDATION_SOURCE_PERSONALIZED\x10\x01\x12)\n" +
+	"%RECOMMENDATION_SOURCE_BOUGHT_TOGETHER\x10\x02\x12&\n" +
+	"\"RECOMMENDATION_SOURCE_ALSO_ENGAGED\x10\x03\x12\"\n" +
+	"\x1eRECOMMENDATION_SOURCE_TRENDING\x10\x042\x83`\n" +
 	"\x0fListingsService\x12S\n" +
 	"\n" +
 	"GetListing\x12!.listingssvc.v1.GetListingRequest\x1a\".listingssvc.v1.GetListingResponse\x12\\\n" +
@@ -14210,13 +20862,18 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\rDeleteListing\x12$.listingssvc.v1.DeleteListingRequest\x1a%.listingssvc.v1.DeleteListingResponse\x12_\n" +
 	"\x0eSearchListings\x12%.listingssvc.v1.SearchListingsRequest\x1a&.listingssvc.v1.SearchListingsResponse\x12Y\n" +
 	"\fListListings\x12#.listingssvc.v1.ListListingsRequest\x1a$.listingssvc.v1.ListListingsResponse\x12k\n" +
-	"\x12GetSimilarListings\x12).listingssvc.v1.GetSimilarListingsRequest\x1a*.listingssvc.v1.GetSimilarListingsResponse\x12P\n" +
+	"\x12GetSimilarListings\x12).listingssvc.v1.GetSimilarListingsRequest\x1a*.listingssvc.v1.GetSimilarListingsResponse\x12\x80\x01\n" +
+	"\x19GetRecommendationsForUser\x120.listingssvc.v1.GetRecommendationsForUserRequest\x1a1.listingssvc.v1.GetRecommendationsForUserResponse\x12\x86\x01\n" +
+	"\x1bGetFrequentlyBoughtTogether\x122.listingssvc.v1.GetFrequentlyBoughtTogetherRequest\x1a3.listingssvc.v1.GetFrequentlyBoughtTogetherResponse\x12Y\n" +
+	"\fRenewListing\x12#.listingssvc.v1.RenewListingRequest\x1a$.listingssvc.v1.RenewListingResponse\x12P\n" +
 	"\x0fGetListingImage\x12\x1e.listingssvc.v1.ImageIDRequest\x1a\x1d.listingssvc.v1.ImageResponse\x12k\n" +
 	"\x12DeleteListingImage\x12).listingssvc.v1.DeleteListingImageRequest\x1a*.listingssvc.v1.DeleteListingImageResponse\x12Q\n" +
 	"\x0fAddListingImage\x12\x1f.listingssvc.v1.AddImageRequest\x1a\x1d.listingssvc.v1.ImageResponse\x12T\n" +
 	"\x10GetListingImages\x12 .listingssvc.v1.ListingIDRequest\x1a\x1e.listingssvc.v1.ImagesResponse\x12c\n" +
 	"\x14ReorderListingImages\x12$.listingssvc.v1.ReorderImagesRequest\x1a%.listingssvc.v1.ReorderImagesResponse\x12f\n" +
-	"\x13UploadListingImages\x12'.listingssvc.v1.UploadImageChunkRequest\x1a$.listingssvc.v1.UploadImagesResponse(\x01\x12O\n" +
+	"\x13UploadListingImages\x12'.listingssvc.v1.UploadImageChunkRequest\x1a$.listingssvc.v1.UploadImagesResponse(\x01\x12w\n" +
+	"\x16RequestImageUploadURLs\x12-.listingssvc.v1.RequestImageUploadURLsRequest\x1a..listingssvc.v1.RequestImageUploadURLsResponse\x12^\n" +
+	"\x12ConfirmImageUpload\x12).listingssvc.v1.ConfirmImageUploadRequest\x1a\x1d.listingssvc.v1.ImageResponse\x12O\n" +
 	"\x11GetRootCategories\x12\x16.google.protobuf.Empty\x1a\".listingssvc.v1.CategoriesResponse\x12N\n" +
 	"\x10GetAllCategories\x12\x16.google.protobuf.Empty\x1a\".listingssvc.v1.CategoriesResponse\x12d\n" +
 	"\x14GetPopularCategories\x12(.listingssvc.v1.PopularCategoriesRequest\x1a\".listingssvc.v1.CategoriesResponse\x12R\n" +
@@ -14262,7 +20919,9 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x17RecordInventoryMovement\x12..listingssvc.v1.RecordInventoryMovementRequest\x1a/.listingssvc.v1.RecordInventoryMovementResponse\x12e\n" +
 	"\x10BatchUpdateStock\x12'.listingssvc.v1.BatchUpdateStockRequest\x1a(.listingssvc.v1.BatchUpdateStockResponse\x12b\n" +
 	"\x0fGetProductStats\x12&.listingssvc.v1.GetProductStatsRequest\x1a'.listingssvc.v1.GetProductStatsResponse\x12]\n" +
-	"\x15IncrementProductViews\x12,.listingssvc.v1.IncrementProductViewsRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x15IncrementProductViews\x12,.listingssvc.v1.IncrementProductViewsRequest\x1a\x16.google.protobuf.Empty\x12h\n" +
+	"\x11GetRecentlyViewed\x12(.listingssvc.v1.GetRecentlyViewedRequest\x1a).listingssvc.v1.GetRecentlyViewedResponse\x12Y\n" +
+	"\x13ClearRecentlyViewed\x12*.listingssvc.v1.ClearRecentlyViewedRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
 	"\x0fAddProductImage\x12&.listingssvc.v1.AddProductImageRequest\x1a$.listingssvc.v1.ProductImageResponse\x12b\n" +
 	"\x10GetProductImages\x12'.listingssvc.v1.GetProductImagesRequest\x1a%.listingssvc.v1.ProductImagesResponse\x12k\n" +
 	"\x12DeleteProductImage\x12).listingssvc.v1.DeleteProductImageRequest\x1a*.listingssvc.v1.DeleteProductImageResponse\x12q\n" +
@@ -14272,21 +20931,62 @@ const file_api_proto_listings_v1_listings_proto_rawDesc = "" +
 	"\x10CreateStorefront\x12'.listingssvc.v1.CreateStorefrontRequest\x1a\x1e.listingssvc.v1.StorefrontFull\x12[\n" +
 	"\x10UpdateStorefront\x12'.listingssvc.v1.UpdateStorefrontRequest\x1a\x1e.listingssvc.v1.StorefrontFull\x12e\n" +
 	"\x10DeleteStorefront\x12'.listingssvc.v1.DeleteStorefrontRequest\x1a(.listingssvc.v1.DeleteStorefrontResponse\x12c\n" +
-	"\x10GetMyStorefronts\x12&.listingssvc.v1.ListStorefrontsRequest\x1a'.listingssvc.v1.ListStorefrontsResponse\x12L\n" +
-	"\bAddStaff\x12\x1f.listingssvc.v1.AddStaffRequest\x1a\x1f.listingssvc.v1.StorefrontStaff\x12R\n" +
+	"\x10GetMyStorefronts\x12&.listingssvc.v1.ListStorefrontsRequest\x1a'.listingssvc.v1.ListStorefrontsResponse\x12e\n" +
+	"\x15UploadStorefrontImage\x12,.listingssvc.v1.UploadStorefrontImageRequest\x1a\x1e.listingssvc.v1.StorefrontFull\x12Q\n" +
+	"\bAddStaff\x12\x1f.listingssvc.v1.AddStaffRequest\x1a\x1f.listingssvc.v1.StorefrontStaff\"\x03\x88\x02\x01\x12R\n" +
 	"\vUpdateStaff\x12\".listingssvc.v1.UpdateStaffRequest\x1a\x1f.listingssvc.v1.StorefrontStaff\x12[\n" +
 	"\vRemoveStaff\x12\".listingssvc.v1.RemoveStaffRequest\x1a(.listingssvc.v1.DeleteStorefrontResponse\x12M\n" +
-	"\bGetStaff\x12\x1f.listingssvc.v1.GetStaffRequest\x1a .listingssvc.v1.GetStaffResponse\x12b\n" +
+	"\bGetStaff\x12\x1f.listingssvc.v1.GetStaffRequest\x1a .listingssvc.v1.GetStaffResponse\x12R\n" +
+	"\vInviteStaff\x12\".listingssvc.v1.InviteStaffRequest\x1a\x1f.listingssvc.v1.StaffInvitation\x12f\n" +
+	"\x15AcceptStaffInvitation\x12,.listingssvc.v1.StaffInvitationActionRequest\x1a\x1f.listingssvc.v1.StorefrontStaff\x12g\n" +
+	"\x16DeclineStaffInvitation\x12,.listingssvc.v1.StaffInvitationActionRequest\x1a\x1f.listingssvc.v1.StaffInvitation\x12f\n" +
+	"\x15RevokeStaffInvitation\x12,.listingssvc.v1.StaffInvitationActionRequest\x1a\x1f.listingssvc.v1.StaffInvitation\x12q\n" +
+	"\x14ListStaffInvitations\x12+.listingssvc.v1.ListStaffInvitationsRequest\x1a,.listingssvc.v1.ListStaffInvitationsResponse\x12e\n" +
+	"\x10FollowStorefront\x12'.listingssvc.v1.FollowStorefrontRequest\x1a(.listingssvc.v1.FollowStorefrontResponse\x12g\n" +
+	"\x12UnfollowStorefront\x12'.listingssvc.v1.FollowStorefrontRequest\x1a(.listingssvc.v1.FollowStorefrontResponse\x12w\n" +
+	"\x16GetStorefrontFollowers\x12-.listingssvc.v1.GetStorefrontFollowersRequest\x1a..listingssvc.v1.GetStorefrontFollowersResponse\x12e\n" +
+	"\x10GetFollowingFeed\x12'.listingssvc.v1.GetFollowingFeedRequest\x1a(.listingssvc.v1.GetFollowingFeedResponse\x12b\n" +
 	"\x0fSetWorkingHours\x12&.listingssvc.v1.SetWorkingHoursRequest\x1a'.listingssvc.v1.GetWorkingHoursResponse\x12b\n" +
 	"\x0fGetWorkingHours\x12&.listingssvc.v1.GetWorkingHoursRequest\x1a'.listingssvc.v1.GetWorkingHoursResponse\x12P\n" +
-	"\tIsOpenNow\x12 .listingssvc.v1.IsOpenNowRequest\x1a!.listingssvc.v1.IsOpenNowResponse\x12h\n" +
+	"\tIsOpenNow\x12 .listingssvc.v1.IsOpenNowRequest\x1a!.listingssvc.v1.IsOpenNowResponse\x12Y\n" +
+	"\x0fSetVacationMode\x12&.listingssvc.v1.SetVacationModeRequest\x1a\x1e.listingssvc.v1.StorefrontFull\x12V\n" +
+	"\vSetHolidays\x12\".listingssvc.v1.SetHolidaysRequest\x1a#.listingssvc.v1.GetHolidaysResponse\x12V\n" +
+	"\vGetHolidays\x12\".listingssvc.v1.GetHolidaysRequest\x1a#.listingssvc.v1.GetHolidaysResponse\x12c\n" +
+	"\x13AddStorefrontDomain\x12*.listingssvc.v1.AddStorefrontDomainRequest\x1a .listingssvc.v1.StorefrontDomain\x12c\n" +
+	"\x16VerifyStorefrontDomain\x12'.listingssvc.v1.StorefrontDomainRequest\x1a .listingssvc.v1.StorefrontDomain\x12Y\n" +
+	"\x16RemoveStorefrontDomain\x12'.listingssvc.v1.StorefrontDomainRequest\x1a\x16.google.protobuf.Empty\x12t\n" +
+	"\x15ListStorefrontDomains\x12,.listingssvc.v1.ListStorefrontDomainsRequest\x1a-.listingssvc.v1.ListStorefrontDomainsResponse\x12z\n" +
+	"\x17ResolveStorefrontDomain\x12..listingssvc.v1.ResolveStorefrontDomainRequest\x1a/.listingssvc.v1.ResolveStorefrontDomainResponse\x12{\n" +
+	"\x1cSubmitStorefrontVerification\x123.listingssvc.v1.SubmitStorefrontVerificationRequest\x1a&.listingssvc.v1.StorefrontVerification\x12\x80\x01\n" +
+	"\x19GetStorefrontVerification\x120.listingssvc.v1.GetStorefrontVerificationRequest\x1a1.listingssvc.v1.GetStorefrontVerificationResponse\x12x\n" +
+	"\x1cCancelStorefrontVerification\x120.listingssvc.v1.GetStorefrontVerificationRequest\x1a&.listingssvc.v1.StorefrontVerification\x12}\n" +
+	"\x18ListVerificationRequests\x12/.listingssvc.v1.ListVerificationRequestsRequest\x1a0.listingssvc.v1.ListVerificationRequestsResponse\x12{\n" +
+	"\x1cReviewStorefrontVerification\x123.listingssvc.v1.ReviewStorefrontVerificationRequest\x1a&.listingssvc.v1.StorefrontVerification\x12s\n" +
+	"\x1cRevokeStorefrontVerification\x123.listingssvc.v1.RevokeStorefrontVerificationRequest\x1a\x1e.listingssvc.v1.StorefrontFull\x12h\n" +
 	"\x11SetPaymentMethods\x12(.listingssvc.v1.SetPaymentMethodsRequest\x1a).listingssvc.v1.GetPaymentMethodsResponse\x12h\n" +
 	"\x11GetPaymentMethods\x12(.listingssvc.v1.GetPaymentMethodsRequest\x1a).listingssvc.v1.GetPaymentMethodsResponse\x12k\n" +
 	"\x12SetDeliveryOptions\x12).listingssvc.v1.SetDeliveryOptionsRequest\x1a*.listingssvc.v1.GetDeliveryOptionsResponse\x12k\n" +
 	"\x12GetDeliveryOptions\x12).listingssvc.v1.GetDeliveryOptionsRequest\x1a*.listingssvc.v1.GetDeliveryOptionsResponse\x12S\n" +
 	"\n" +
 	"GetMapData\x12!.listingssvc.v1.GetMapDataRequest\x1a\".listingssvc.v1.GetMapDataResponse\x12b\n" +
-	"\x11GetDashboardStats\x12%.listingssvc.v1.DashboardStatsRequest\x1a&.listingssvc.v1.DashboardStatsResponseBAZ?github.com/sveturs/listings/api/proto/listings/v1;listingssvcv1b\x06proto3"
+	"\x11GetDashboardStats\x12%.listingssvc.v1.DashboardStatsRequest\x1a&.listingssvc.v1.DashboardStatsResponse\x12b\n" +
+	"\x0fGetPriceHistory\x12&.listingssvc.v1.GetPriceHistoryRequest\x1a'.listingssvc.v1.GetPriceHistoryResponse\x12w\n" +
+	"\x16SubmitListingForReview\x12-.listingssvc.v1.SubmitListingForReviewRequest\x1a..listingssvc.v1.SubmitListingForReviewResponse\x12k\n" +
+	"\x12GetModerationQueue\x12).listingssvc.v1.GetModerationQueueRequest\x1a*.listingssvc.v1.GetModerationQueueResponse\x12b\n" +
+	"\x0fModerateListing\x12&.listingssvc.v1.ModerateListingRequest\x1a'.listingssvc.v1.ModerateListingResponse\x12k\n" +
+	"\x12ListDuplicateFlags\x12).listingssvc.v1.ListDuplicateFlagsRequest\x1a*.listingssvc.v1.ListDuplicateFlagsResponse\x12K\n" +
+	"\fCreateReview\x12#.listingssvc.v1.CreateReviewRequest\x1a\x16.listingssvc.v1.Review\x12E\n" +
+	"\tGetReview\x12 .listingssvc.v1.GetReviewRequest\x1a\x16.listingssvc.v1.Review\x12V\n" +
+	"\vListReviews\x12\".listingssvc.v1.ListReviewsRequest\x1a#.listingssvc.v1.ListReviewsResponse\x12K\n" +
+	"\fUpdateReview\x12#.listingssvc.v1.UpdateReviewRequest\x1a\x16.listingssvc.v1.Review\x12Y\n" +
+	"\fDeleteReview\x12#.listingssvc.v1.DeleteReviewRequest\x1a$.listingssvc.v1.DeleteReviewResponse\x12Z\n" +
+	"\x10GetReviewSummary\x12'.listingssvc.v1.GetReviewSummaryRequest\x1a\x1d.listingssvc.v1.ReviewSummary\x12M\n" +
+	"\rReplyToReview\x12$.listingssvc.v1.ReplyToReviewRequest\x1a\x16.listingssvc.v1.Review\x12G\n" +
+	"\n" +
+	"VoteReview\x12!.listingssvc.v1.VoteReviewRequest\x1a\x16.listingssvc.v1.Review\x12Y\n" +
+	"\fReportReview\x12#.listingssvc.v1.ReportReviewRequest\x1a$.listingssvc.v1.ReportReviewResponse\x12h\n" +
+	"\x11ListReviewReports\x12(.listingssvc.v1.ListReviewReportsRequest\x1a).listingssvc.v1.ListReviewReportsResponse\x12O\n" +
+	"\x0eModerateReview\x12%.listingssvc.v1.ModerateReviewRequest\x1a\x16.listingssvc.v1.ReviewBAZ?github.com/sveturs/listings/api/proto/listings/v1;listingssvcv1b\x06proto3"
 
 var (
 	file_api_proto_listings_v1_listings_proto_rawDescOnce sync.Once
@@ -14300,477 +21000,733 @@ func file_api_proto_listings_v1_listings_proto_rawDescGZIP() []byte {
 	return file_api_proto_listings_v1_listings_proto_rawDescData
 }
 
-var file_api_proto_listings_v1_listings_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_api_proto_listings_v1_listings_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
+var file_api_proto_listings_v1_listings_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_proto_listings_v1_listings_proto_msgTypes = make([]protoimpl.MessageInfo, 248)
 var file_api_proto_listings_v1_listings_proto_goTypes = []any{
-	(StorefrontGeoStrategy)(0),                // 0: listingssvc.v1.StorefrontGeoStrategy
-	(LocationPrivacyLevel)(0),                 // 1: listingssvc.v1.LocationPrivacyLevel
-	(SubscriptionPlanType)(0),                 // 2: listingssvc.v1.SubscriptionPlanType
-	(StaffRole)(0),                            // 3: listingssvc.v1.StaffRole
-	(PaymentMethodType)(0),                    // 4: listingssvc.v1.PaymentMethodType
-	(DeliveryProvider)(0),                     // 5: listingssvc.v1.DeliveryProvider
-	(*ListingFieldTranslations)(nil),          // 6: listingssvc.v1.ListingFieldTranslations
-	(*Listing)(nil),                           // 7: listingssvc.v1.Listing
-	(*ListingImage)(nil),                      // 8: listingssvc.v1.ListingImage
-	(*ListingAttribute)(nil),                  // 9: listingssvc.v1.ListingAttribute
-	(*ListingLocation)(nil),                   // 10: listingssvc.v1.ListingLocation
-	(*ListingVariant)(nil),                    // 11: listingssvc.v1.ListingVariant
-	(*Category)(nil),                          // 12: listingssvc.v1.Category
-	(*CategoryTreeNode)(nil),                  // 13: listingssvc.v1.CategoryTreeNode
-	(*Product)(nil),                           // 14: listingssvc.v1.Product
-	(*ProductVariant)(nil),                    // 15: listingssvc.v1.ProductVariant
-	(*GetListingRequest)(nil),                 // 16: listingssvc.v1.GetListingRequest
-	(*GetListingResponse)(nil),                // 17: listingssvc.v1.GetListingResponse
-	(*CreateListingRequest)(nil),              // 18: listingssvc.v1.CreateListingRequest
-	(*CreateListingResponse)(nil),             // 19: listingssvc.v1.CreateListingResponse
-	(*UpdateListingRequest)(nil),              // 20: listingssvc.v1.UpdateListingRequest
-	(*UpdateListingResponse)(nil),             // 21: listingssvc.v1.UpdateListingResponse
-	(*DeleteListingRequest)(nil),              // 22: listingssvc.v1.DeleteListingRequest
-	(*DeleteListingResponse)(nil),             // 23: listingssvc.v1.DeleteListingResponse
-	(*SearchListingsRequest)(nil),             // 24: listingssvc.v1.SearchListingsRequest
-	(*SearchListingsResponse)(nil),            // 25: listingssvc.v1.SearchListingsResponse
-	(*ListListingsRequest)(nil),               // 26: listingssvc.v1.ListListingsRequest
-	(*ListListingsResponse)(nil),              // 27: listingssvc.v1.ListListingsResponse
-	(*GetSimilarListingsRequest)(nil),         // 28: listingssvc.v1.GetSimilarListingsRequest
-	(*GetSimilarListingsResponse)(nil),        // 29: listingssvc.v1.GetSimilarListingsResponse
-	(*ImageIDRequest)(nil),                    // 30: listingssvc.v1.ImageIDRequest
-	(*ImageResponse)(nil),                     // 31: listingssvc.v1.ImageResponse
-	(*AddImageRequest)(nil),                   // 32: listingssvc.v1.AddImageRequest
-	(*ListingIDRequest)(nil),                  // 33: listingssvc.v1.ListingIDRequest
-	(*ImagesResponse)(nil),                    // 34: listingssvc.v1.ImagesResponse
-	(*ReorderImagesRequest)(nil),              // 35: listingssvc.v1.ReorderImagesRequest
-	(*ReorderImagesResponse)(nil),             // 36: listingssvc.v1.ReorderImagesResponse
-	(*ImageOrder)(nil),                        // 37: listingssvc.v1.ImageOrder
-	(*DeleteListingImageRequest)(nil),         // 38: listingssvc.v1.DeleteListingImageRequest
-	(*DeleteListingImageResponse)(nil),        // 39: listingssvc.v1.DeleteListingImageResponse
-	(*UploadImageChunkRequest)(nil),           // 40: listingssvc.v1.UploadImageChunkRequest
-	(*UploadImageMetadata)(nil),               // 41: listingssvc.v1.UploadImageMetadata
-	(*UploadImagesResponse)(nil),              // 42: listingssvc.v1.UploadImagesResponse
-	(*PopularCategoriesRequest)(nil),          // 43: listingssvc.v1.PopularCategoriesRequest
-	(*CategoriesResponse)(nil),                // 44: listingssvc.v1.CategoriesResponse
-	(*CategoryIDRequest)(nil),                 // 45: listingssvc.v1.CategoryIDRequest
-	(*CategoryResponse)(nil),                  // 46: listingssvc.v1.CategoryResponse
-	(*CategoryTreeResponse)(nil),              // 47: listingssvc.v1.CategoryTreeResponse
-	(*UserIDsResponse)(nil),                   // 48: listingssvc.v1.UserIDsResponse
-	(*AddToFavoritesRequest)(nil),             // 49: listingssvc.v1.AddToFavoritesRequest
-	(*RemoveFromFavoritesRequest)(nil),        // 50: listingssvc.v1.RemoveFromFavoritesRequest
-	(*GetUserFavoritesRequest)(nil),           // 51: listingssvc.v1.GetUserFavoritesRequest
-	(*GetUserFavoritesResponse)(nil),          // 52: listingssvc.v1.GetUserFavoritesResponse
-	(*IsFavoriteRequest)(nil),                 // 53: listingssvc.v1.IsFavoriteRequest
-	(*IsFavoriteResponse)(nil),                // 54: listingssvc.v1.IsFavoriteResponse
-	(*Storefront)(nil),                        // 55: listingssvc.v1.Storefront
-	(*GetStorefrontRequest)(nil),              // 56: listingssvc.v1.GetStorefrontRequest
-	(*GetStorefrontBySlugRequest)(nil),        // 57: listingssvc.v1.GetStorefrontBySlugRequest
-	(*StorefrontResponse)(nil),                // 58: listingssvc.v1.StorefrontResponse
-	(*GetStorefrontResponse)(nil),             // 59: listingssvc.v1.GetStorefrontResponse
-	(*ListStorefrontsRequest)(nil),            // 60: listingssvc.v1.ListStorefrontsRequest
-	(*ListStorefrontsResponse)(nil),           // 61: listingssvc.v1.ListStorefrontsResponse
-	(*CreateVariantsRequest)(nil),             // 62: listingssvc.v1.CreateVariantsRequest
-	(*VariantInput)(nil),                      // 63: listingssvc.v1.VariantInput
-	(*VariantsResponse)(nil),                  // 64: listingssvc.v1.VariantsResponse
-	(*UpdateVariantRequest)(nil),              // 65: listingssvc.v1.UpdateVariantRequest
-	(*VariantIDRequest)(nil),                  // 66: listingssvc.v1.VariantIDRequest
-	(*ReindexRequest)(nil),                    // 67: listingssvc.v1.ReindexRequest
-	(*ListingsResponse)(nil),                  // 68: listingssvc.v1.ListingsResponse
-	(*ResetFlagsRequest)(nil),                 // 69: listingssvc.v1.ResetFlagsRequest
-	(*GetProductRequest)(nil),                 // 70: listingssvc.v1.GetProductRequest
-	(*ProductResponse)(nil),                   // 71: listingssvc.v1.ProductResponse
-	(*GetProductsBySKUsRequest)(nil),          // 72: listingssvc.v1.GetProductsBySKUsRequest
-	(*ProductsResponse)(nil),                  // 73: listingssvc.v1.ProductsResponse
-	(*GetProductsByIDsRequest)(nil),           // 74: listingssvc.v1.GetProductsByIDsRequest
-	(*ListProductsRequest)(nil),               // 75: listingssvc.v1.ListProductsRequest
-	(*GetVariantRequest)(nil),                 // 76: listingssvc.v1.GetVariantRequest
-	(*VariantResponse)(nil),                   // 77: listingssvc.v1.VariantResponse
-	(*GetVariantsByProductIDRequest)(nil),     // 78: listingssvc.v1.GetVariantsByProductIDRequest
-	(*ProductVariantsResponse)(nil),           // 79: listingssvc.v1.ProductVariantsResponse
-	(*StockItem)(nil),                         // 80: listingssvc.v1.StockItem
-	(*StockResult)(nil),                       // 81: listingssvc.v1.StockResult
-	(*DecrementStockRequest)(nil),             // 82: listingssvc.v1.DecrementStockRequest
-	(*DecrementStockResponse)(nil),            // 83: listingssvc.v1.DecrementStockResponse
-	(*RollbackStockRequest)(nil),              // 84: listingssvc.v1.RollbackStockRequest
-	(*RollbackStockResponse)(nil),             // 85: listingssvc.v1.RollbackStockResponse
-	(*CheckStockAvailabilityRequest)(nil),     // 86: listingssvc.v1.CheckStockAvailabilityRequest
-	(*StockAvailability)(nil),                 // 87: listingssvc.v1.StockAvailability
-	(*CheckStockAvailabilityResponse)(nil),    // 88: listingssvc.v1.CheckStockAvailabilityResponse
-	(*CreateProductRequest)(nil),              // 89: listingssvc.v1.CreateProductRequest
-	(*UpdateProductRequest)(nil),              // 90: listingssvc.v1.UpdateProductRequest
-	(*DeleteProductRequest)(nil),              // 91: listingssvc.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil),             // 92: listingssvc.v1.DeleteProductResponse
-	(*ProductInput)(nil),                      // 93: listingssvc.v1.ProductInput
-	(*BulkCreateProductsRequest)(nil),         // 94: listingssvc.v1.BulkCreateProductsRequest
-	(*BulkCreateProductsResponse)(nil),        // 95: listingssvc.v1.BulkCreateProductsResponse
-	(*ProductUpdateInput)(nil),                // 96: listingssvc.v1.ProductUpdateInput
-	(*BulkUpdateProductsRequest)(nil),         // 97: listingssvc.v1.BulkUpdateProductsRequest
-	(*BulkUpdateProductsResponse)(nil),        // 98: listingssvc.v1.BulkUpdateProductsResponse
-	(*BulkDeleteProductsRequest)(nil),         // 99: listingssvc.v1.BulkDeleteProductsRequest
-	(*BulkDeleteProductsResponse)(nil),        // 100: listingssvc.v1.BulkDeleteProductsResponse
-	(*BulkOperationError)(nil),                // 101: listingssvc.v1.BulkOperationError
-	(*CreateProductVariantRequest)(nil),       // 102: listingssvc.v1.CreateProductVariantRequest
-	(*UpdateProductVariantRequest)(nil),       // 103: listingssvc.v1.UpdateProductVariantRequest
-	(*DeleteProductVariantRequest)(nil),       // 104: listingssvc.v1.DeleteProductVariantRequest
-	(*DeleteProductVariantResponse)(nil),      // 105: listingssvc.v1.DeleteProductVariantResponse
-	(*ProductVariantInput)(nil),               // 106: listingssvc.v1.ProductVariantInput
-	(*BulkCreateProductVariantsRequest)(nil),  // 107: listingssvc.v1.BulkCreateProductVariantsRequest
-	(*BulkCreateProductVariantsResponse)(nil), // 108: listingssvc.v1.BulkCreateProductVariantsResponse
-	(*RecordInventoryMovementRequest)(nil),    // 109: listingssvc.v1.RecordInventoryMovementRequest
-	(*RecordInventoryMovementResponse)(nil),   // 110: listingssvc.v1.RecordInventoryMovementResponse
-	(*StockUpdateItem)(nil),                   // 111: listingssvc.v1.StockUpdateItem
-	(*BatchUpdateStockRequest)(nil),           // 112: listingssvc.v1.BatchUpdateStockRequest
-	(*StockUpdateResult)(nil),                 // 113: listingssvc.v1.StockUpdateResult
-	(*BatchUpdateStockResponse)(nil),          // 114: listingssvc.v1.BatchUpdateStockResponse
-	(*GetProductStatsRequest)(nil),            // 115: listingssvc.v1.GetProductStatsRequest
-	(*ProductStats)(nil),                      // 116: listingssvc.v1.ProductStats
-	(*GetProductStatsResponse)(nil),           // 117: listingssvc.v1.GetProductStatsResponse
-	(*IncrementProductViewsRequest)(nil),      // 118: listingssvc.v1.IncrementProductViewsRequest
-	(*ReindexAllRequest)(nil),                 // 119: listingssvc.v1.ReindexAllRequest
-	(*ReindexAllResponse)(nil),                // 120: listingssvc.v1.ReindexAllResponse
-	(*StorefrontFull)(nil),                    // 121: listingssvc.v1.StorefrontFull
-	(*StorefrontStaff)(nil),                   // 122: listingssvc.v1.StorefrontStaff
-	(*StorefrontHours)(nil),                   // 123: listingssvc.v1.StorefrontHours
-	(*StorefrontPaymentMethod)(nil),           // 124: listingssvc.v1.StorefrontPaymentMethod
-	(*StorefrontDeliveryOption)(nil),          // 125: listingssvc.v1.StorefrontDeliveryOption
-	(*Location)(nil),                          // 126: listingssvc.v1.Location
-	(*CreateStorefrontRequest)(nil),           // 127: listingssvc.v1.CreateStorefrontRequest
-	(*UpdateStorefrontRequest)(nil),           // 128: listingssvc.v1.UpdateStorefrontRequest
-	(*DeleteStorefrontRequest)(nil),           // 129: listingssvc.v1.DeleteStorefrontRequest
-	(*DeleteStorefrontResponse)(nil),          // 130: listingssvc.v1.DeleteStorefrontResponse
-	(*AddStaffRequest)(nil),                   // 131: listingssvc.v1.AddStaffRequest
-	(*UpdateStaffRequest)(nil),                // 132: listingssvc.v1.UpdateStaffRequest
-	(*RemoveStaffRequest)(nil),                // 133: listingssvc.v1.RemoveStaffRequest
-	(*GetStaffRequest)(nil),                   // 134: listingssvc.v1.GetStaffRequest
-	(*GetStaffResponse)(nil),                  // 135: listingssvc.v1.GetStaffResponse
-	(*SetWorkingHoursRequest)(nil),            // 136: listingssvc.v1.SetWorkingHoursRequest
-	(*GetWorkingHoursRequest)(nil),            // 137: listingssvc.v1.GetWorkingHoursRequest
-	(*GetWorkingHoursResponse)(nil),           // 138: listingssvc.v1.GetWorkingHoursResponse
-	(*IsOpenNowRequest)(nil),                  // 139: listingssvc.v1.IsOpenNowRequest
-	(*IsOpenNowResponse)(nil),                 // 140: listingssvc.v1.IsOpenNowResponse
-	(*SetPaymentMethodsRequest)(nil),          // 141: listingssvc.v1.SetPaymentMethodsRequest
-	(*GetPaymentMethodsRequest)(nil),          // 142: listingssvc.v1.GetPaymentMethodsRequest
-	(*GetPaymentMethodsResponse)(nil),         // 143: listingssvc.v1.GetPaymentMethodsResponse
-	(*SetDeliveryOptionsRequest)(nil),         // 144: listingssvc.v1.SetDeliveryOptionsRequest
-	(*GetDeliveryOptionsRequest)(nil),         // 145: listingssvc.v1.GetDeliveryOptionsRequest
-	(*GetDeliveryOptionsResponse)(nil),        // 146: listingssvc.v1.GetDeliveryOptionsResponse
-	(*StorefrontMapData)(nil),                 // 147: listingssvc.v1.StorefrontMapData
-	(*GetMapDataRequest)(nil),                 // 148: listingssvc.v1.GetMapDataRequest
-	(*GetMapDataResponse)(nil),                // 149: listingssvc.v1.GetMapDataResponse
-	(*DashboardStatsRequest)(nil),             // 150: listingssvc.v1.DashboardStatsRequest
-	(*DashboardStatsResponse)(nil),            // 151: listingssvc.v1.DashboardStatsResponse
-	(*ProductImage)(nil),                      // 152: listingssvc.v1.ProductImage
-	(*AddProductImageRequest)(nil),            // 153: listingssvc.v1.AddProductImageRequest
-	(*ProductImageResponse)(nil),              // 154: listingssvc.v1.ProductImageResponse
-	(*GetProductImagesRequest)(nil),           // 155: listingssvc.v1.GetProductImagesRequest
-	(*ProductImagesResponse)(nil),             // 156: listingssvc.v1.ProductImagesResponse
-	(*DeleteProductImageRequest)(nil),         // 157: listingssvc.v1.DeleteProductImageRequest
-	(*DeleteProductImageResponse)(nil),        // 158: listingssvc.v1.DeleteProductImageResponse
-	(*ReorderProductImagesRequest)(nil),       // 159: listingssvc.v1.ReorderProductImagesRequest
-	(*ReorderProductImagesResponse)(nil),      // 160: listingssvc.v1.ReorderProductImagesResponse
-	nil,                                       // 161: listingssvc.v1.Listing.TranslationsEntry
-	nil,                                       // 162: listingssvc.v1.ListingVariant.AttributesEntry
-	nil,                                       // 163: listingssvc.v1.Category.TranslationsEntry
-	nil,                                       // 164: listingssvc.v1.CategoryTreeNode.TranslationsEntry
-	nil,                                       // 165: listingssvc.v1.CreateListingRequest.TranslationsEntry
-	nil,                                       // 166: listingssvc.v1.VariantInput.AttributesEntry
-	nil,                                       // 167: listingssvc.v1.UpdateVariantRequest.AttributesEntry
-	(*structpb.Struct)(nil),                   // 168: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),             // 169: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),             // 170: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                     // 171: google.protobuf.Empty
+	(StorefrontGeoStrategy)(0),                  // 0: listingssvc.v1.StorefrontGeoStrategy
+	(LocationPrivacyLevel)(0),                   // 1: listingssvc.v1.LocationPrivacyLevel
+	(SubscriptionPlanType)(0),                   // 2: listingssvc.v1.SubscriptionPlanType
+	(StaffRole)(0),                              // 3: listingssvc.v1.StaffRole
+	(StaffInvitationStatus)(0),                  // 4: listingssvc.v1.StaffInvitationStatus
+	(ReviewSubjectType)(0),                      // 5: listingssvc.v1.ReviewSubjectType
+	(PaymentMethodType)(0),                      // 6: listingssvc.v1.PaymentMethodType
+	(DeliveryProvider)(0),                       // 7: listingssvc.v1.DeliveryProvider
+	(RecommendationSource)(0),                   // 8: listingssvc.v1.RecommendationSource
+	(*ListingFieldTranslations)(nil),            // 9: listingssvc.v1.ListingFieldTranslations
+	(*Listing)(nil),                             // 10: listingssvc.v1.Listing
+	(*ListingImage)(nil),                        // 11: listingssvc.v1.ListingImage
+	(*ImageRendition)(nil),                      // 12: listingssvc.v1.ImageRendition
+	(*ListingAttribute)(nil),                    // 13: listingssvc.v1.ListingAttribute
+	(*ListingLocation)(nil),                     // 14: listingssvc.v1.ListingLocation
+	(*ListingVariant)(nil),                      // 15: listingssvc.v1.ListingVariant
+	(*Category)(nil),                            // 16: listingssvc.v1.Category
+	(*CategoryTreeNode)(nil),                    // 17: listingssvc.v1.CategoryTreeNode
+	(*Product)(nil),                             // 18: listingssvc.v1.Product
+	(*ProductVariant)(nil),                      // 19: listingssvc.v1.ProductVariant
+	(*GetListingRequest)(nil),                   // 20: listingssvc.v1.GetListingRequest
+	(*GetListingResponse)(nil),                  // 21: listingssvc.v1.GetListingResponse
+	(*CreateListingRequest)(nil),                // 22: listingssvc.v1.CreateListingRequest
+	(*CreateListingResponse)(nil),               // 23: listingssvc.v1.CreateListingResponse
+	(*UpdateListingRequest)(nil),                // 24: listingssvc.v1.UpdateListingRequest
+	(*UpdateListingResponse)(nil),               // 25: listingssvc.v1.UpdateListingResponse
+	(*RenewListingRequest)(nil),                 // 26: listingssvc.v1.RenewListingRequest
+	(*RenewListingResponse)(nil),                // 27: listingssvc.v1.RenewListingResponse
+	(*DeleteListingRequest)(nil),                // 28: listingssvc.v1.DeleteListingRequest
+	(*DeleteListingResponse)(nil),               // 29: listingssvc.v1.DeleteListingResponse
+	(*SearchListingsRequest)(nil),               // 30: listingssvc.v1.SearchListingsRequest
+	(*SearchListingsResponse)(nil),              // 31: listingssvc.v1.SearchListingsResponse
+	(*ListListingsRequest)(nil),                 // 32: listingssvc.v1.ListListingsRequest
+	(*ListListingsResponse)(nil),                // 33: listingssvc.v1.ListListingsResponse
+	(*GetSimilarListingsRequest)(nil),           // 34: listingssvc.v1.GetSimilarListingsRequest
+	(*GetSimilarListingsResponse)(nil),          // 35: listingssvc.v1.GetSimilarListingsResponse
+	(*RecommendedListing)(nil),                  // 36: listingssvc.v1.RecommendedListing
+	(*GetRecommendationsForUserRequest)(nil),    // 37: listingssvc.v1.GetRecommendationsForUserRequest
+	(*GetRecommendationsForUserResponse)(nil),   // 38: listingssvc.v1.GetRecommendationsForUserResponse
+	(*GetFrequentlyBoughtTogetherRequest)(nil),  // 39: listingssvc.v1.GetFrequentlyBoughtTogetherRequest
+	(*GetFrequentlyBoughtTogetherResponse)(nil), // 40: listingssvc.v1.GetFrequentlyBoughtTogetherResponse
+	(*ImageIDRequest)(nil),                      // 41: listingssvc.v1.ImageIDRequest
+	(*ImageResponse)(nil),                       // 42: listingssvc.v1.ImageResponse
+	(*AddImageRequest)(nil),                     // 43: listingssvc.v1.AddImageRequest
+	(*ListingIDRequest)(nil),                    // 44: listingssvc.v1.ListingIDRequest
+	(*ImagesResponse)(nil),                      // 45: listingssvc.v1.ImagesResponse
+	(*ReorderImagesRequest)(nil),                // 46: listingssvc.v1.ReorderImagesRequest
+	(*ReorderImagesResponse)(nil),               // 47: listingssvc.v1.ReorderImagesResponse
+	(*ImageOrder)(nil),                          // 48: listingssvc.v1.ImageOrder
+	(*DeleteListingImageRequest)(nil),           // 49: listingssvc.v1.DeleteListingImageRequest
+	(*DeleteListingImageResponse)(nil),          // 50: listingssvc.v1.DeleteListingImageResponse
+	(*UploadImageChunkRequest)(nil),             // 51: listingssvc.v1.UploadImageChunkRequest
+	(*UploadImageMetadata)(nil),                 // 52: listingssvc.v1.UploadImageMetadata
+	(*UploadImagesResponse)(nil),                // 53: listingssvc.v1.UploadImagesResponse
+	(*ImageUploadFile)(nil),                     // 54: listingssvc.v1.ImageUploadFile
+	(*RequestImageUploadURLsRequest)(nil),       // 55: listingssvc.v1.RequestImageUploadURLsRequest
+	(*PresignedImageUpload)(nil),                // 56: listingssvc.v1.PresignedImageUpload
+	(*RequestImageUploadURLsResponse)(nil),      // 57: listingssvc.v1.RequestImageUploadURLsResponse
+	(*ConfirmImageUploadRequest)(nil),           // 58: listingssvc.v1.ConfirmImageUploadRequest
+	(*PopularCategoriesRequest)(nil),            // 59: listingssvc.v1.PopularCategoriesRequest
+	(*CategoriesResponse)(nil),                  // 60: listingssvc.v1.CategoriesResponse
+	(*CategoryIDRequest)(nil),                   // 61: listingssvc.v1.CategoryIDRequest
+	(*CategoryResponse)(nil),                    // 62: listingssvc.v1.CategoryResponse
+	(*CategoryTreeResponse)(nil),                // 63: listingssvc.v1.CategoryTreeResponse
+	(*UserIDsResponse)(nil),                     // 64: listingssvc.v1.UserIDsResponse
+	(*AddToFavoritesRequest)(nil),               // 65: listingssvc.v1.AddToFavoritesRequest
+	(*RemoveFromFavoritesRequest)(nil),          // 66: listingssvc.v1.RemoveFromFavoritesRequest
+	(*GetUserFavoritesRequest)(nil),             // 67: listingssvc.v1.GetUserFavoritesRequest
+	(*GetUserFavoritesResponse)(nil),            // 68: listingssvc.v1.GetUserFavoritesResponse
+	(*IsFavoriteRequest)(nil),                   // 69: listingssvc.v1.IsFavoriteRequest
+	(*IsFavoriteResponse)(nil),                  // 70: listingssvc.v1.IsFavoriteResponse
+	(*Storefront)(nil),                          // 71: listingssvc.v1.Storefront
+	(*GetStorefrontRequest)(nil),                // 72: listingssvc.v1.GetStorefrontRequest
+	(*GetStorefrontBySlugRequest)(nil),          // 73: listingssvc.v1.GetStorefrontBySlugRequest
+	(*StorefrontResponse)(nil),                  // 74: listingssvc.v1.StorefrontResponse
+	(*GetStorefrontResponse)(nil),               // 75: listingssvc.v1.GetStorefrontResponse
+	(*ListStorefrontsRequest)(nil),              // 76: listingssvc.v1.ListStorefrontsRequest
+	(*ListStorefrontsResponse)(nil),             // 77: listingssvc.v1.ListStorefrontsResponse
+	(*CreateVariantsRequest)(nil),               // 78: listingssvc.v1.CreateVariantsRequest
+	(*VariantInput)(nil),                        // 79: listingssvc.v1.VariantInput
+	(*VariantsResponse)(nil),                    // 80: listingssvc.v1.VariantsResponse
+	(*UpdateVariantRequest)(nil),                // 81: listingssvc.v1.UpdateVariantRequest
+	(*VariantIDRequest)(nil),                    // 82: listingssvc.v1.VariantIDRequest
+	(*ReindexRequest)(nil),                      // 83: listingssvc.v1.ReindexRequest
+	(*ListingsResponse)(nil),                    // 84: listingssvc.v1.ListingsResponse
+	(*ResetFlagsRequest)(nil),                   // 85: listingssvc.v1.ResetFlagsRequest
+	(*GetProductRequest)(nil),                   // 86: listingssvc.v1.GetProductRequest
+	(*ProductResponse)(nil),                     // 87: listingssvc.v1.ProductResponse
+	(*GetProductsBySKUsRequest)(nil),            // 88: listingssvc.v1.GetProductsBySKUsRequest
+	(*ProductsResponse)(nil),                    // 89: listingssvc.v1.ProductsResponse
+	(*GetProductsByIDsRequest)(nil),             // 90: listingssvc.v1.GetProductsByIDsRequest
+	(*ListProductsRequest)(nil),                 // 91: listingssvc.v1.ListProductsRequest
+	(*GetVariantRequest)(nil),                   // 92: listingssvc.v1.GetVariantRequest
+	(*VariantResponse)(nil),                     // 93: listingssvc.v1.VariantResponse
+	(*GetVariantsByProductIDRequest)(nil),       // 94: listingssvc.v1.GetVariantsByProductIDRequest
+	(*ProductVariantsResponse)(nil),             // 95: listingssvc.v1.ProductVariantsResponse
+	(*StockItem)(nil),                           // 96: listingssvc.v1.StockItem
+	(*StockResult)(nil),                         // 97: listingssvc.v1.StockResult
+	(*DecrementStockRequest)(nil),               // 98: listingssvc.v1.DecrementStockRequest
+	(*DecrementStockResponse)(nil),              // 99: listingssvc.v1.DecrementStockResponse
+	(*RollbackStockRequest)(nil),                // 100: listingssvc.v1.RollbackStockRequest
+	(*RollbackStockResponse)(nil),               // 101: listingssvc.v1.RollbackStockResponse
+	(*CheckStockAvailabilityRequest)(nil),       // 102: listingssvc.v1.CheckStockAvailabilityRequest
+	(*StockAvailability)(nil),                   // 103: listingssvc.v1.StockAvailability
+	(*CheckStockAvailabilityResponse)(nil),      // 104: listingssvc.v1.CheckStockAvailabilityResponse
+	(*CreateProductRequest)(nil),                // 105: listingssvc.v1.CreateProductRequest
+	(*UpdateProductRequest)(nil),                // 106: listingssvc.v1.UpdateProductRequest
+	(*DeleteProductRequest)(nil),                // 107: listingssvc.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),               // 108: listingssvc.v1.DeleteProductResponse
+	(*ProductInput)(nil),                        // 109: listingssvc.v1.ProductInput
+	(*BulkCreateProductsRequest)(nil),           // 110: listingssvc.v1.BulkCreateProductsRequest
+	(*BulkCreateProductsResponse)(nil),          // 111: listingssvc.v1.BulkCreateProductsResponse
+	(*ProductUpdateInput)(nil),                  // 112: listingssvc.v1.ProductUpdateInput
+	(*BulkUpdateProductsRequest)(nil),           // 113: listingssvc.v1.BulkUpdateProductsRequest
+	(*BulkUpdateProductsResponse)(nil),          // 114: listingssvc.v1.BulkUpdateProductsResponse
+	(*BulkDeleteProductsRequest)(nil),           // 115: listingssvc.v1.BulkDeleteProductsRequest
+	(*BulkDeleteProductsResponse)(nil),          // 116: listingssvc.v1.BulkDeleteProductsResponse
+	(*BulkOperationError)(nil),                  // 117: listingssvc.v1.BulkOperationError
+	(*CreateProductVariantRequest)(nil),         // 118: listingssvc.v1.CreateProductVariantRequest
+	(*UpdateProductVariantRequest)(nil),         // 119: listingssvc.v1.UpdateProductVariantRequest
+	(*DeleteProductVariantRequest)(nil),         // 120: listingssvc.v1.DeleteProductVariantRequest
+	(*DeleteProductVariantResponse)(nil),        // 121: listingssvc.v1.DeleteProductVariantResponse
+	(*ProductVariantInput)(nil),                 // 122: listingssvc.v1.ProductVariantInput
+	(*BulkCreateProductVariantsRequest)(nil),    // 123: listingssvc.v1.BulkCreateProductVariantsRequest
+	(*BulkCreateProductVariantsResponse)(nil),   // 124: listingssvc.v1.BulkCreateProductVariantsResponse
+	(*RecordInventoryMovementRequest)(nil),      // 125: listingssvc.v1.RecordInventoryMovementRequest
+	(*RecordInventoryMovementResponse)(nil),     // 126: listingssvc.v1.RecordInventoryMovementResponse
+	(*StockUpdateItem)(nil),                     // 127: listingssvc.v1.StockUpdateItem
+	(*BatchUpdateStockRequest)(nil),             // 128: listingssvc.v1.BatchUpdateStockRequest
+	(*StockUpdateResult)(nil),                   // 129: listingssvc.v1.StockUpdateResult
+	(*BatchUpdateStockResponse)(nil),            // 130: listingssvc.v1.BatchUpdateStockResponse
+	(*GetProductStatsRequest)(nil),              // 131: listingssvc.v1.GetProductStatsRequest
+	(*ProductStats)(nil),                        // 132: listingssvc.v1.ProductStats
+	(*GetProductStatsResponse)(nil),             // 133: listingssvc.v1.GetProductStatsResponse
+	(*IncrementProductViewsRequest)(nil),        // 134: listingssvc.v1.IncrementProductViewsRequest
+	(*GetRecentlyViewedRequest)(nil),            // 135: listingssvc.v1.GetRecentlyViewedRequest
+	(*RecentlyViewedListing)(nil),               // 136: listingssvc.v1.RecentlyViewedListing
+	(*GetRecentlyViewedResponse)(nil),           // 137: listingssvc.v1.GetRecentlyViewedResponse
+	(*ClearRecentlyViewedRequest)(nil),          // 138: listingssvc.v1.ClearRecentlyViewedRequest
+	(*ReindexAllRequest)(nil),                   // 139: listingssvc.v1.ReindexAllRequest
+	(*ReindexAllResponse)(nil),                  // 140: listingssvc.v1.ReindexAllResponse
+	(*StorefrontFull)(nil),                      // 141: listingssvc.v1.StorefrontFull
+	(*StorefrontStaff)(nil),                     // 142: listingssvc.v1.StorefrontStaff
+	(*StorefrontHours)(nil),                     // 143: listingssvc.v1.StorefrontHours
+	(*StorefrontPaymentMethod)(nil),             // 144: listingssvc.v1.StorefrontPaymentMethod
+	(*StorefrontDeliveryOption)(nil),            // 145: listingssvc.v1.StorefrontDeliveryOption
+	(*Location)(nil),                            // 146: listingssvc.v1.Location
+	(*CreateStorefrontRequest)(nil),             // 147: listingssvc.v1.CreateStorefrontRequest
+	(*UpdateStorefrontRequest)(nil),             // 148: listingssvc.v1.UpdateStorefrontRequest
+	(*ImageCrop)(nil),                           // 149: listingssvc.v1.ImageCrop
+	(*UploadStorefrontImageRequest)(nil),        // 150: listingssvc.v1.UploadStorefrontImageRequest
+	(*DeleteStorefrontRequest)(nil),             // 151: listingssvc.v1.DeleteStorefrontRequest
+	(*DeleteStorefrontResponse)(nil),            // 152: listingssvc.v1.DeleteStorefrontResponse
+	(*AddStaffRequest)(nil),                     // 153: listingssvc.v1.AddStaffRequest
+	(*UpdateStaffRequest)(nil),                  // 154: listingssvc.v1.UpdateStaffRequest
+	(*RemoveStaffRequest)(nil),                  // 155: listingssvc.v1.RemoveStaffRequest
+	(*GetStaffRequest)(nil),                     // 156: listingssvc.v1.GetStaffRequest
+	(*GetStaffResponse)(nil),                    // 157: listingssvc.v1.GetStaffResponse
+	(*StaffInvitation)(nil),                     // 158: listingssvc.v1.StaffInvitation
+	(*InviteStaffRequest)(nil),                  // 159: listingssvc.v1.InviteStaffRequest
+	(*StaffInvitationActionRequest)(nil),        // 160: listingssvc.v1.StaffInvitationActionRequest
+	(*ListStaffInvitationsRequest)(nil),         // 161: listingssvc.v1.ListStaffInvitationsRequest
+	(*ListStaffInvitationsResponse)(nil),        // 162: listingssvc.v1.ListStaffInvitationsResponse
+	(*FollowStorefrontRequest)(nil),             // 163: listingssvc.v1.FollowStorefrontRequest
+	(*FollowStorefrontResponse)(nil),            // 164: listingssvc.v1.FollowStorefrontResponse
+	(*StorefrontFollower)(nil),                  // 165: listingssvc.v1.StorefrontFollower
+	(*GetStorefrontFollowersRequest)(nil),       // 166: listingssvc.v1.GetStorefrontFollowersRequest
+	(*GetStorefrontFollowersResponse)(nil),      // 167: listingssvc.v1.GetStorefrontFollowersResponse
+	(*GetFollowingFeedRequest)(nil),             // 168: listingssvc.v1.GetFollowingFeedRequest
+	(*GetFollowingFeedResponse)(nil),            // 169: listingssvc.v1.GetFollowingFeedResponse
+	(*SetWorkingHoursRequest)(nil),              // 170: listingssvc.v1.SetWorkingHoursRequest
+	(*GetWorkingHoursRequest)(nil),              // 171: listingssvc.v1.GetWorkingHoursRequest
+	(*GetWorkingHoursResponse)(nil),             // 172: listingssvc.v1.GetWorkingHoursResponse
+	(*IsOpenNowRequest)(nil),                    // 173: listingssvc.v1.IsOpenNowRequest
+	(*IsOpenNowResponse)(nil),                   // 174: listingssvc.v1.IsOpenNowResponse
+	(*SetVacationModeRequest)(nil),              // 175: listingssvc.v1.SetVacationModeRequest
+	(*StorefrontHoliday)(nil),                   // 176: listingssvc.v1.StorefrontHoliday
+	(*SetHolidaysRequest)(nil),                  // 177: listingssvc.v1.SetHolidaysRequest
+	(*GetHolidaysRequest)(nil),                  // 178: listingssvc.v1.GetHolidaysRequest
+	(*GetHolidaysResponse)(nil),                 // 179: listingssvc.v1.GetHolidaysResponse
+	(*StorefrontDomain)(nil),                    // 180: listingssvc.v1.StorefrontDomain
+	(*AddStorefrontDomainRequest)(nil),          // 181: listingssvc.v1.AddStorefrontDomainRequest
+	(*StorefrontDomainRequest)(nil),             // 182: listingssvc.v1.StorefrontDomainRequest
+	(*ListStorefrontDomainsRequest)(nil),        // 183: listingssvc.v1.ListStorefrontDomainsRequest
+	(*ListStorefrontDomainsResponse)(nil),       // 184: listingssvc.v1.ListStorefrontDomainsResponse
+	(*ResolveStorefrontDomainRequest)(nil),      // 185: listingssvc.v1.ResolveStorefrontDomainRequest
+	(*ResolveStorefrontDomainResponse)(nil),     // 186: listingssvc.v1.ResolveStorefrontDomainResponse
+	(*VerificationDocumentUpload)(nil),          // 187: listingssvc.v1.VerificationDocumentUpload
+	(*StorefrontVerificationDocument)(nil),      // 188: listingssvc.v1.StorefrontVerificationDocument
+	(*StorefrontVerification)(nil),              // 189: listingssvc.v1.StorefrontVerification
+	(*StorefrontVerificationEvent)(nil),         // 190: listingssvc.v1.StorefrontVerificationEvent
+	(*SubmitStorefrontVerificationRequest)(nil), // 191: listingssvc.v1.SubmitStorefrontVerificationRequest
+	(*GetStorefrontVerificationRequest)(nil),    // 192: listingssvc.v1.GetStorefrontVerificationRequest
+	(*GetStorefrontVerificationResponse)(nil),   // 193: listingssvc.v1.GetStorefrontVerificationResponse
+	(*ListVerificationRequestsRequest)(nil),     // 194: listingssvc.v1.ListVerificationRequestsRequest
+	(*ListVerificationRequestsResponse)(nil),    // 195: listingssvc.v1.ListVerificationRequestsResponse
+	(*ReviewStorefrontVerificationRequest)(nil), // 196: listingssvc.v1.ReviewStorefrontVerificationRequest
+	(*RevokeStorefrontVerificationRequest)(nil), // 197: listingssvc.v1.RevokeStorefrontVerificationRequest
+	(*SetPaymentMethodsRequest)(nil),            // 198: listingssvc.v1.SetPaymentMethodsRequest
+	(*GetPaymentMethodsRequest)(nil),            // 199: listingssvc.v1.GetPaymentMethodsRequest
+	(*GetPaymentMethodsResponse)(nil),           // 200: listingssvc.v1.GetPaymentMethodsResponse
+	(*SetDeliveryOptionsRequest)(nil),           // 201: listingssvc.v1.SetDeliveryOptionsRequest
+	(*GetDeliveryOptionsRequest)(nil),           // 202: listingssvc.v1.GetDeliveryOptionsRequest
+	(*GetDeliveryOptionsResponse)(nil),          // 203: listingssvc.v1.GetDeliveryOptionsResponse
+	(*StorefrontMapData)(nil),                   // 204: listingssvc.v1.StorefrontMapData
+	(*GetMapDataRequest)(nil),                   // 205: listingssvc.v1.GetMapDataRequest
+	(*GetMapDataResponse)(nil),                  // 206: listingssvc.v1.GetMapDataResponse
+	(*DashboardStatsRequest)(nil),               // 207: listingssvc.v1.DashboardStatsRequest
+	(*DashboardStatsResponse)(nil),              // 208: listingssvc.v1.DashboardStatsResponse
+	(*ProductImage)(nil),                        // 209: listingssvc.v1.ProductImage
+	(*AddProductImageRequest)(nil),              // 210: listingssvc.v1.AddProductImageRequest
+	(*ProductImageResponse)(nil),                // 211: listingssvc.v1.ProductImageResponse
+	(*GetProductImagesRequest)(nil),             // 212: listingssvc.v1.GetProductImagesRequest
+	(*ProductImagesResponse)(nil),               // 213: listingssvc.v1.ProductImagesResponse
+	(*DeleteProductImageRequest)(nil),           // 214: listingssvc.v1.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),          // 215: listingssvc.v1.DeleteProductImageResponse
+	(*ReorderProductImagesRequest)(nil),         // 216: listingssvc.v1.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),        // 217: listingssvc.v1.ReorderProductImagesResponse
+	(*PriceHistoryEntry)(nil),                   // 218: listingssvc.v1.PriceHistoryEntry
+	(*GetPriceHistoryRequest)(nil),              // 219: listingssvc.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),             // 220: listingssvc.v1.GetPriceHistoryResponse
+	(*SubmitListingForReviewRequest)(nil),       // 221: listingssvc.v1.SubmitListingForReviewRequest
+	(*SubmitListingForReviewResponse)(nil),      // 222: listingssvc.v1.SubmitListingForReviewResponse
+	(*GetModerationQueueRequest)(nil),           // 223: listingssvc.v1.GetModerationQueueRequest
+	(*GetModerationQueueResponse)(nil),          // 224: listingssvc.v1.GetModerationQueueResponse
+	(*ModerateListingRequest)(nil),              // 225: listingssvc.v1.ModerateListingRequest
+	(*ModerateListingResponse)(nil),             // 226: listingssvc.v1.ModerateListingResponse
+	(*DuplicateMatch)(nil),                      // 227: listingssvc.v1.DuplicateMatch
+	(*DuplicateReport)(nil),                     // 228: listingssvc.v1.DuplicateReport
+	(*DuplicateFlag)(nil),                       // 229: listingssvc.v1.DuplicateFlag
+	(*ListDuplicateFlagsRequest)(nil),           // 230: listingssvc.v1.ListDuplicateFlagsRequest
+	(*ListDuplicateFlagsResponse)(nil),          // 231: listingssvc.v1.ListDuplicateFlagsResponse
+	(*Review)(nil),                              // 232: listingssvc.v1.Review
+	(*ReviewSummary)(nil),                       // 233: listingssvc.v1.ReviewSummary
+	(*CreateReviewRequest)(nil),                 // 234: listingssvc.v1.CreateReviewRequest
+	(*GetReviewRequest)(nil),                    // 235: listingssvc.v1.GetReviewRequest
+	(*ListReviewsRequest)(nil),                  // 236: listingssvc.v1.ListReviewsRequest
+	(*ListReviewsResponse)(nil),                 // 237: listingssvc.v1.ListReviewsResponse
+	(*UpdateReviewRequest)(nil),                 // 238: listingssvc.v1.UpdateReviewRequest
+	(*DeleteReviewRequest)(nil),                 // 239: listingssvc.v1.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),                // 240: listingssvc.v1.DeleteReviewResponse
+	(*GetReviewSummaryRequest)(nil),             // 241: listingssvc.v1.GetReviewSummaryRequest
+	(*ReplyToReviewRequest)(nil),                // 242: listingssvc.v1.ReplyToReviewRequest
+	(*VoteReviewRequest)(nil),                   // 243: listingssvc.v1.VoteReviewRequest
+	(*ReportReviewRequest)(nil),                 // 244: listingssvc.v1.ReportReviewRequest
+	(*ReportReviewResponse)(nil),                // 245: listingssvc.v1.ReportReviewResponse
+	(*ReviewReport)(nil),                        // 246: listingssvc.v1.ReviewReport
+	(*ListReviewReportsRequest)(nil),            // 247: listingssvc.v1.ListReviewReportsRequest
+	(*ListReviewReportsResponse)(nil),           // 248: listingssvc.v1.ListReviewReportsResponse
+	(*ModerateReviewRequest)(nil),               // 249: listingssvc.v1.ModerateReviewRequest
+	nil,                                         // 250: listingssvc.v1.Listing.TranslationsEntry
+	nil,                                         // 251: listingssvc.v1.ListingVariant.AttributesEntry
+	nil,                                         // 252: listingssvc.v1.Category.TranslationsEntry
+	nil,                                         // 253: listingssvc.v1.CategoryTreeNode.TranslationsEntry
+	nil,                                         // 254: listingssvc.v1.CreateListingRequest.TranslationsEntry
+	nil,                                         // 255: listingssvc.v1.VariantInput.AttributesEntry
+	nil,                                         // 256: listingssvc.v1.UpdateVariantRequest.AttributesEntry
+	(*structpb.Struct)(nil),                     // 257: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),               // 258: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 259: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 260: google.protobuf.Empty
 }
 var file_api_proto_listings_v1_listings_proto_depIdxs = []int32{
-	8,   // 0: listingssvc.v1.Listing.images:type_name -> listingssvc.v1.ListingImage
-	9,   // 1: listingssvc.v1.Listing.attributes:type_name -> listingssvc.v1.ListingAttribute
-	10,  // 2: listingssvc.v1.Listing.location:type_name -> listingssvc.v1.ListingLocation
-	11,  // 3: listingssvc.v1.Listing.variants:type_name -> listingssvc.v1.ListingVariant
-	161, // 4: listingssvc.v1.Listing.translations:type_name -> listingssvc.v1.Listing.TranslationsEntry
-	162, // 5: listingssvc.v1.ListingVariant.attributes:type_name -> listingssvc.v1.ListingVariant.AttributesEntry
-	163, // 6: listingssvc.v1.Category.translations:type_name -> listingssvc.v1.Category.TranslationsEntry
-	13,  // 7: listingssvc.v1.CategoryTreeNode.children:type_name -> listingssvc.v1.CategoryTreeNode
-	164, // 8: listingssvc.v1.CategoryTreeNode.translations:type_name -> listingssvc.v1.CategoryTreeNode.TranslationsEntry
-	168, // 9: listingssvc.v1.Product.attributes:type_name -> google.protobuf.Struct
-	169, // 10: listingssvc.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	169, // 11: listingssvc.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	15,  // 12: listingssvc.v1.Product.variants:type_name -> listingssvc.v1.ProductVariant
-	152, // 13: listingssvc.v1.Product.images:type_name -> listingssvc.v1.ProductImage
-	168, // 14: listingssvc.v1.ProductVariant.variant_attributes:type_name -> google.protobuf.Struct
-	168, // 15: listingssvc.v1.ProductVariant.dimensions:type_name -> google.protobuf.Struct
-	169, // 16: listingssvc.v1.ProductVariant.created_at:type_name -> google.protobuf.Timestamp
-	169, // 17: listingssvc.v1.ProductVariant.updated_at:type_name -> google.protobuf.Timestamp
-	7,   // 18: listingssvc.v1.GetListingResponse.listing:type_name -> listingssvc.v1.Listing
-	165, // 19: listingssvc.v1.CreateListingRequest.translations:type_name -> listingssvc.v1.CreateListingRequest.TranslationsEntry
-	7,   // 20: listingssvc.v1.CreateListingResponse.listing:type_name -> listingssvc.v1.Listing
-	7,   // 21: listingssvc.v1.UpdateListingResponse.listing:type_name -> listingssvc.v1.Listing
-	7,   // 22: listingssvc.v1.SearchListingsResponse.listings:type_name -> listingssvc.v1.Listing
-	7,   // 23: listingssvc.v1.ListListingsResponse.listings:type_name -> listingssvc.v1.Listing
-	7,   // 24: listingssvc.v1.GetSimilarListingsResponse.listings:type_name -> listingssvc.v1.Listing
-	8,   // 25: listingssvc.v1.ImageResponse.image:type_name -> listingssvc.v1.ListingImage
-	8,   // 26: listingssvc.v1.ImagesResponse.images:type_name -> listingssvc.v1.ListingImage
-	41,  // 27: listingssvc.v1.UploadImageChunkRequest.metadata:type_name -> listingssvc.v1.UploadImageMetadata
-	8,   // 28: listingssvc.v1.UploadImagesResponse.images:type_name -> listingssvc.v1.ListingImage
-	12,  // 29: listingssvc.v1.CategoriesResponse.categories:type_name -> listingssvc.v1.Category
-	12,  // 30: listingssvc.v1.CategoryResponse.category:type_name -> listingssvc.v1.Category
-	13,  // 31: listingssvc.v1.CategoryTreeResponse.tree:type_name -> listingssvc.v1.CategoryTreeNode
-	55,  // 32: listingssvc.v1.StorefrontResponse.storefront:type_name -> listingssvc.v1.Storefront
-	121, // 33: listingssvc.v1.GetStorefrontResponse.storefront:type_name -> listingssvc.v1.StorefrontFull
-	2,   // 34: listingssvc.v1.ListStorefrontsRequest.subscription_plans:type_name -> listingssvc.v1.SubscriptionPlanType
-	4,   // 35: listingssvc.v1.ListStorefrontsRequest.payment_methods:type_name -> listingssvc.v1.PaymentMethodType
-	121, // 36: listingssvc.v1.ListStorefrontsResponse.storefronts:type_name -> listingssvc.v1.StorefrontFull
-	63,  // 37: listingssvc.v1.CreateVariantsRequest.variants:type_name -> listingssvc.v1.VariantInput
-	166, // 38: listingssvc.v1.VariantInput.attributes:type_name -> listingssvc.v1.VariantInput.AttributesEntry
-	11,  // 39: listingssvc.v1.VariantsResponse.variants:type_name -> listingssvc.v1.ListingVariant
-	167, // 40: listingssvc.v1.UpdateVariantRequest.attributes:type_name -> listingssvc.v1.UpdateVariantRequest.AttributesEntry
-	7,   // 41: listingssvc.v1.ListingsResponse.listings:type_name -> listingssvc.v1.Listing
-	14,  // 42: listingssvc.v1.ProductResponse.product:type_name -> listingssvc.v1.Product
-	14,  // 43: listingssvc.v1.ProductsResponse.products:type_name -> listingssvc.v1.Product
-	15,  // 44: listingssvc.v1.VariantResponse.variant:type_name -> listingssvc.v1.ProductVariant
-	15,  // 45: listingssvc.v1.ProductVariantsResponse.variants:type_name -> listingssvc.v1.ProductVariant
-	80,  // 46: listingssvc.v1.DecrementStockRequest.items:type_name -> listingssvc.v1.StockItem
-	81,  // 47: listingssvc.v1.DecrementStockResponse.results:type_name -> listingssvc.v1.StockResult
-	80,  // 48: listingssvc.v1.RollbackStockRequest.items:type_name -> listingssvc.v1.StockItem
-	81,  // 49: listingssvc.v1.RollbackStockResponse.results:type_name -> listingssvc.v1.StockResult
-	80,  // 50: listingssvc.v1.CheckStockAvailabilityRequest.items:type_name -> listingssvc.v1.StockItem
-	87,  // 51: listingssvc.v1.CheckStockAvailabilityResponse.items:type_name -> listingssvc.v1.StockAvailability
-	168, // 52: listingssvc.v1.CreateProductRequest.attributes:type_name -> google.protobuf.Struct
-	168, // 53: listingssvc.v1.UpdateProductRequest.attributes:type_name -> google.protobuf.Struct
-	170, // 54: listingssvc.v1.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
-	168, // 55: listingssvc.v1.ProductInput.attributes:type_name -> google.protobuf.Struct
-	93,  // 56: listingssvc.v1.BulkCreateProductsRequest.products:type_name -> listingssvc.v1.ProductInput
-	14,  // 57: listingssvc.v1.BulkCreateProductsResponse.products:type_name -> listingssvc.v1.Product
-	101, // 58: listingssvc.v1.BulkCreateProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
-	168, // 59: listingssvc.v1.ProductUpdateInput.attributes:type_name -> google.protobuf.Struct
-	170, // 60: listingssvc.v1.ProductUpdateInput.update_mask:type_name -> google.protobuf.FieldMask
-	96,  // 61: listingssvc.v1.BulkUpdateProductsRequest.updates:type_name -> listingssvc.v1.ProductUpdateInput
-	14,  // 62: listingssvc.v1.BulkUpdateProductsResponse.products:type_name -> listingssvc.v1.Product
-	101, // 63: listingssvc.v1.BulkUpdateProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
-	101, // 64: listingssvc.v1.BulkDeleteProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
-	168, // 65: listingssvc.v1.CreateProductVariantRequest.variant_attributes:type_name -> google.protobuf.Struct
-	168, // 66: listingssvc.v1.CreateProductVariantRequest.dimensions:type_name -> google.protobuf.Struct
-	168, // 67: listingssvc.v1.UpdateProductVariantRequest.variant_attributes:type_name -> google.protobuf.Struct
-	168, // 68: listingssvc.v1.UpdateProductVariantRequest.dimensions:type_name -> google.protobuf.Struct
-	170, // 69: listingssvc.v1.UpdateProductVariantRequest.update_mask:type_name -> google.protobuf.FieldMask
-	168, // 70: listingssvc.v1.ProductVariantInput.variant_attributes:type_name -> google.protobuf.Struct
-	168, // 71: listingssvc.v1.ProductVariantInput.dimensions:type_name -> google.protobuf.Struct
-	106, // 72: listingssvc.v1.BulkCreateProductVariantsRequest.variants:type_name -> listingssvc.v1.ProductVariantInput
-	15,  // 73: listingssvc.v1.BulkCreateProductVariantsResponse.variants:type_name -> listingssvc.v1.ProductVariant
-	101, // 74: listingssvc.v1.BulkCreateProductVariantsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
-	111, // 75: listingssvc.v1.BatchUpdateStockRequest.items:type_name -> listingssvc.v1.StockUpdateItem
-	113, // 76: listingssvc.v1.BatchUpdateStockResponse.results:type_name -> listingssvc.v1.StockUpdateResult
-	116, // 77: listingssvc.v1.GetProductStatsResponse.stats:type_name -> listingssvc.v1.ProductStats
-	168, // 78: listingssvc.v1.StorefrontFull.theme:type_name -> google.protobuf.Struct
-	0,   // 79: listingssvc.v1.StorefrontFull.geo_strategy:type_name -> listingssvc.v1.StorefrontGeoStrategy
-	1,   // 80: listingssvc.v1.StorefrontFull.default_privacy_level:type_name -> listingssvc.v1.LocationPrivacyLevel
-	168, // 81: listingssvc.v1.StorefrontFull.settings:type_name -> google.protobuf.Struct
-	168, // 82: listingssvc.v1.StorefrontFull.seo_meta:type_name -> google.protobuf.Struct
-	169, // 83: listingssvc.v1.StorefrontFull.verification_date:type_name -> google.protobuf.Timestamp
-	2,   // 84: listingssvc.v1.StorefrontFull.subscription_plan:type_name -> listingssvc.v1.SubscriptionPlanType
-	169, // 85: listingssvc.v1.StorefrontFull.subscription_expires_at:type_name -> google.protobuf.Timestamp
-	168, // 86: listingssvc.v1.StorefrontFull.ai_agent_config:type_name -> google.protobuf.Struct
-	169, // 87: listingssvc.v1.StorefrontFull.created_at:type_name -> google.protobuf.Timestamp
-	169, // 88: listingssvc.v1.StorefrontFull.updated_at:type_name -> google.protobuf.Timestamp
-	122, // 89: listingssvc.v1.StorefrontFull.staff:type_name -> listingssvc.v1.StorefrontStaff
-	123, // 90: listingssvc.v1.StorefrontFull.hours:type_name -> listingssvc.v1.StorefrontHours
-	124, // 91: listingssvc.v1.StorefrontFull.payment_methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
-	125, // 92: listingssvc.v1.StorefrontFull.delivery_options:type_name -> listingssvc.v1.StorefrontDeliveryOption
-	3,   // 93: listingssvc.v1.StorefrontStaff.role:type_name -> listingssvc.v1.StaffRole
-	168, // 94: listingssvc.v1.StorefrontStaff.permissions:type_name -> google.protobuf.Struct
-	169, // 95: listingssvc.v1.StorefrontStaff.last_active_at:type_name -> google.protobuf.Timestamp
-	169, // 96: listingssvc.v1.StorefrontStaff.created_at:type_name -> google.protobuf.Timestamp
-	169, // 97: listingssvc.v1.StorefrontStaff.updated_at:type_name -> google.protobuf.Timestamp
-	4,   // 98: listingssvc.v1.StorefrontPaymentMethod.method_type:type_name -> listingssvc.v1.PaymentMethodType
-	168, // 99: listingssvc.v1.StorefrontPaymentMethod.settings:type_name -> google.protobuf.Struct
-	169, // 100: listingssvc.v1.StorefrontPaymentMethod.created_at:type_name -> google.protobuf.Timestamp
-	168, // 101: listingssvc.v1.StorefrontDeliveryOption.zones:type_name -> google.protobuf.Struct
-	168, // 102: listingssvc.v1.StorefrontDeliveryOption.available_days:type_name -> google.protobuf.Struct
-	168, // 103: listingssvc.v1.StorefrontDeliveryOption.provider_config:type_name -> google.protobuf.Struct
-	169, // 104: listingssvc.v1.StorefrontDeliveryOption.created_at:type_name -> google.protobuf.Timestamp
-	169, // 105: listingssvc.v1.StorefrontDeliveryOption.updated_at:type_name -> google.protobuf.Timestamp
-	168, // 106: listingssvc.v1.CreateStorefrontRequest.theme:type_name -> google.protobuf.Struct
-	126, // 107: listingssvc.v1.CreateStorefrontRequest.location:type_name -> listingssvc.v1.Location
-	168, // 108: listingssvc.v1.CreateStorefrontRequest.settings:type_name -> google.protobuf.Struct
-	168, // 109: listingssvc.v1.CreateStorefrontRequest.seo_meta:type_name -> google.protobuf.Struct
-	168, // 110: listingssvc.v1.UpdateStorefrontRequest.theme:type_name -> google.protobuf.Struct
-	126, // 111: listingssvc.v1.UpdateStorefrontRequest.location:type_name -> listingssvc.v1.Location
-	168, // 112: listingssvc.v1.UpdateStorefrontRequest.settings:type_name -> google.protobuf.Struct
-	168, // 113: listingssvc.v1.UpdateStorefrontRequest.seo_meta:type_name -> google.protobuf.Struct
-	3,   // 114: listingssvc.v1.AddStaffRequest.role:type_name -> listingssvc.v1.StaffRole
-	168, // 115: listingssvc.v1.AddStaffRequest.permissions:type_name -> google.protobuf.Struct
-	3,   // 116: listingssvc.v1.UpdateStaffRequest.role:type_name -> listingssvc.v1.StaffRole
-	168, // 117: listingssvc.v1.UpdateStaffRequest.permissions:type_name -> google.protobuf.Struct
-	122, // 118: listingssvc.v1.GetStaffResponse.staff:type_name -> listingssvc.v1.StorefrontStaff
-	123, // 119: listingssvc.v1.SetWorkingHoursRequest.hours:type_name -> listingssvc.v1.StorefrontHours
-	123, // 120: listingssvc.v1.GetWorkingHoursResponse.hours:type_name -> listingssvc.v1.StorefrontHours
-	124, // 121: listingssvc.v1.SetPaymentMethodsRequest.methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
-	124, // 122: listingssvc.v1.GetPaymentMethodsResponse.methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
-	125, // 123: listingssvc.v1.SetDeliveryOptionsRequest.options:type_name -> listingssvc.v1.StorefrontDeliveryOption
-	125, // 124: listingssvc.v1.GetDeliveryOptionsResponse.options:type_name -> listingssvc.v1.StorefrontDeliveryOption
-	60,  // 125: listingssvc.v1.GetMapDataRequest.filter:type_name -> listingssvc.v1.ListStorefrontsRequest
-	147, // 126: listingssvc.v1.GetMapDataResponse.storefronts:type_name -> listingssvc.v1.StorefrontMapData
-	169, // 127: listingssvc.v1.DashboardStatsRequest.date_from:type_name -> google.protobuf.Timestamp
-	169, // 128: listingssvc.v1.DashboardStatsRequest.date_to:type_name -> google.protobuf.Timestamp
-	152, // 129: listingssvc.v1.ProductImageResponse.image:type_name -> listingssvc.v1.ProductImage
-	152, // 130: listingssvc.v1.ProductImagesResponse.images:type_name -> listingssvc.v1.ProductImage
-	6,   // 131: listingssvc.v1.Listing.TranslationsEntry.value:type_name -> listingssvc.v1.ListingFieldTranslations
-	6,   // 132: listingssvc.v1.CreateListingRequest.TranslationsEntry.value:type_name -> listingssvc.v1.ListingFieldTranslations
-	16,  // 133: listingssvc.v1.ListingsService.GetListing:input_type -> listingssvc.v1.GetListingRequest
-	18,  // 134: listingssvc.v1.ListingsService.CreateListing:input_type -> listingssvc.v1.CreateListingRequest
-	20,  // 135: listingssvc.v1.ListingsService.UpdateListing:input_type -> listingssvc.v1.UpdateListingRequest
-	22,  // 136: listingssvc.v1.ListingsService.DeleteListing:input_type -> listingssvc.v1.DeleteListingRequest
-	24,  // 137: listingssvc.v1.ListingsService.SearchListings:input_type -> listingssvc.v1.SearchListingsRequest
-	26,  // 138: listingssvc.v1.ListingsService.ListListings:input_type -> listingssvc.v1.ListListingsRequest
-	28,  // 139: listingssvc.v1.ListingsService.GetSimilarListings:input_type -> listingssvc.v1.GetSimilarListingsRequest
-	30,  // 140: listingssvc.v1.ListingsService.GetListingImage:input_type -> listingssvc.v1.ImageIDRequest
-	38,  // 141: listingssvc.v1.ListingsService.DeleteListingImage:input_type -> listingssvc.v1.DeleteListingImageRequest
-	32,  // 142: listingssvc.v1.ListingsService.AddListingImage:input_type -> listingssvc.v1.AddImageRequest
-	33,  // 143: listingssvc.v1.ListingsService.GetListingImages:input_type -> listingssvc.v1.ListingIDRequest
-	35,  // 144: listingssvc.v1.ListingsService.ReorderListingImages:input_type -> listingssvc.v1.ReorderImagesRequest
-	40,  // 145: listingssvc.v1.ListingsService.UploadListingImages:input_type -> listingssvc.v1.UploadImageChunkRequest
-	171, // 146: listingssvc.v1.ListingsService.GetRootCategories:input_type -> google.protobuf.Empty
-	171, // 147: listingssvc.v1.ListingsService.GetAllCategories:input_type -> google.protobuf.Empty
-	43,  // 148: listingssvc.v1.ListingsService.GetPopularCategories:input_type -> listingssvc.v1.PopularCategoriesRequest
-	45,  // 149: listingssvc.v1.ListingsService.GetCategory:input_type -> listingssvc.v1.CategoryIDRequest
-	45,  // 150: listingssvc.v1.ListingsService.GetCategoryTree:input_type -> listingssvc.v1.CategoryIDRequest
-	33,  // 151: listingssvc.v1.ListingsService.GetFavoritedUsers:input_type -> listingssvc.v1.ListingIDRequest
-	49,  // 152: listingssvc.v1.ListingsService.AddToFavorites:input_type -> listingssvc.v1.AddToFavoritesRequest
-	50,  // 153: listingssvc.v1.ListingsService.RemoveFromFavorites:input_type -> listingssvc.v1.RemoveFromFavoritesRequest
-	51,  // 154: listingssvc.v1.ListingsService.GetUserFavorites:input_type -> listingssvc.v1.GetUserFavoritesRequest
-	53,  // 155: listingssvc.v1.ListingsService.IsFavorite:input_type -> listingssvc.v1.IsFavoriteRequest
-	56,  // 156: listingssvc.v1.ListingsService.GetStorefront:input_type -> listingssvc.v1.GetStorefrontRequest
-	57,  // 157: listingssvc.v1.ListingsService.GetStorefrontBySlug:input_type -> listingssvc.v1.GetStorefrontBySlugRequest
-	60,  // 158: listingssvc.v1.ListingsService.ListStorefronts:input_type -> listingssvc.v1.ListStorefrontsRequest
-	62,  // 159: listingssvc.v1.ListingsService.CreateVariants:input_type -> listingssvc.v1.CreateVariantsRequest
-	33,  // 160: listingssvc.v1.ListingsService.GetVariants:input_type -> listingssvc.v1.ListingIDRequest
-	65,  // 161: listingssvc.v1.ListingsService.UpdateVariant:input_type -> listingssvc.v1.UpdateVariantRequest
-	66,  // 162: listingssvc.v1.ListingsService.DeleteVariant:input_type -> listingssvc.v1.VariantIDRequest
-	67,  // 163: listingssvc.v1.ListingsService.GetListingsForReindex:input_type -> listingssvc.v1.ReindexRequest
-	69,  // 164: listingssvc.v1.ListingsService.ResetReindexFlags:input_type -> listingssvc.v1.ResetFlagsRequest
-	171, // 165: listingssvc.v1.ListingsService.SyncDiscounts:input_type -> google.protobuf.Empty
-	70,  // 166: listingssvc.v1.ListingsService.GetProduct:input_type -> listingssvc.v1.GetProductRequest
-	72,  // 167: listingssvc.v1.ListingsService.GetProductsBySKUs:input_type -> listingssvc.v1.GetProductsBySKUsRequest
-	74,  // 168: listingssvc.v1.ListingsService.GetProductsByIDs:input_type -> listingssvc.v1.GetProductsByIDsRequest
-	75,  // 169: listingssvc.v1.ListingsService.ListProducts:input_type -> listingssvc.v1.ListProductsRequest
-	76,  // 170: listingssvc.v1.ListingsService.GetVariant:input_type -> listingssvc.v1.GetVariantRequest
-	78,  // 171: listingssvc.v1.ListingsService.GetVariantsByProductID:input_type -> listingssvc.v1.GetVariantsByProductIDRequest
-	82,  // 172: listingssvc.v1.ListingsService.DecrementStock:input_type -> listingssvc.v1.DecrementStockRequest
-	84,  // 173: listingssvc.v1.ListingsService.RollbackStock:input_type -> listingssvc.v1.RollbackStockRequest
-	86,  // 174: listingssvc.v1.ListingsService.CheckStockAvailability:input_type -> listingssvc.v1.CheckStockAvailabilityRequest
-	89,  // 175: listingssvc.v1.ListingsService.CreateProduct:input_type -> listingssvc.v1.CreateProductRequest
-	90,  // 176: listingssvc.v1.ListingsService.UpdateProduct:input_type -> listingssvc.v1.UpdateProductRequest
-	91,  // 177: listingssvc.v1.ListingsService.DeleteProduct:input_type -> listingssvc.v1.DeleteProductRequest
-	94,  // 178: listingssvc.v1.ListingsService.BulkCreateProducts:input_type -> listingssvc.v1.BulkCreateProductsRequest
-	97,  // 179: listingssvc.v1.ListingsService.BulkUpdateProducts:input_type -> listingssvc.v1.BulkUpdateProductsRequest
-	99,  // 180: listingssvc.v1.ListingsService.BulkDeleteProducts:input_type -> listingssvc.v1.BulkDeleteProductsRequest
-	102, // 181: listingssvc.v1.ListingsService.CreateProductVariant:input_type -> listingssvc.v1.CreateProductVariantRequest
-	103, // 182: listingssvc.v1.ListingsService.UpdateProductVariant:input_type -> listingssvc.v1.UpdateProductVariantRequest
-	104, // 183: listingssvc.v1.ListingsService.DeleteProductVariant:input_type -> listingssvc.v1.DeleteProductVariantRequest
-	107, // 184: listingssvc.v1.ListingsService.BulkCreateProductVariants:input_type -> listingssvc.v1.BulkCreateProductVariantsRequest
-	109, // 185: listingssvc.v1.ListingsService.RecordInventoryMovement:input_type -> listingssvc.v1.RecordInventoryMovementRequest
-	112, // 186: listingssvc.v1.ListingsService.BatchUpdateStock:input_type -> listingssvc.v1.BatchUpdateStockRequest
-	115, // 187: listingssvc.v1.ListingsService.GetProductStats:input_type -> listingssvc.v1.GetProductStatsRequest
-	118, // 188: listingssvc.v1.ListingsService.IncrementProductViews:input_type -> listingssvc.v1.IncrementProductViewsRequest
-	153, // 189: listingssvc.v1.ListingsService.AddProductImage:input_type -> listingssvc.v1.AddProductImageRequest
-	155, // 190: listingssvc.v1.ListingsService.GetProductImages:input_type -> listingssvc.v1.GetProductImagesRequest
-	157, // 191: listingssvc.v1.ListingsService.DeleteProductImage:input_type -> listingssvc.v1.DeleteProductImageRequest
-	159, // 192: listingssvc.v1.ListingsService.ReorderProductImages:input_type -> listingssvc.v1.ReorderProductImagesRequest
-	119, // 193: listingssvc.v1.ListingsService.ReindexAll:input_type -> listingssvc.v1.ReindexAllRequest
-	127, // 194: listingssvc.v1.ListingsService.CreateStorefront:input_type -> listingssvc.v1.CreateStorefrontRequest
-	128, // 195: listingssvc.v1.ListingsService.UpdateStorefront:input_type -> listingssvc.v1.UpdateStorefrontRequest
-	129, // 196: listingssvc.v1.ListingsService.DeleteStorefront:input_type -> listingssvc.v1.DeleteStorefrontRequest
-	60,  // 197: listingssvc.v1.ListingsService.GetMyStorefronts:input_type -> listingssvc.v1.ListStorefrontsRequest
-	131, // 198: listingssvc.v1.ListingsService.AddStaff:input_type -> listingssvc.v1.AddStaffRequest
-	132, // 199: listingssvc.v1.ListingsService.UpdateStaff:input_type -> listingssvc.v1.UpdateStaffRequest
-	133, // 200: listingssvc.v1.ListingsService.RemoveStaff:input_type -> listingssvc.v1.RemoveStaffRequest
-	134, // 201: listingssvc.v1.ListingsService.GetStaff:input_type -> listingssvc.v1.GetStaffRequest
-	136, // 202: listingssvc.v1.ListingsService.SetWorkingHours:input_type -> listingssvc.v1.SetWorkingHoursRequest
-	137, // 203: listingssvc.v1.ListingsService.GetWorkingHours:input_type -> listingssvc.v1.GetWorkingHoursRequest
-	139, // 204: listingssvc.v1.ListingsService.IsOpenNow:input_type -> listingssvc.v1.IsOpenNowRequest
-	141, // 205: listingssvc.v1.ListingsService.SetPaymentMethods:input_type -> listingssvc.v1.SetPaymentMethodsRequest
-	142, // 206: listingssvc.v1.ListingsService.GetPaymentMethods:input_type -> listingssvc.v1.GetPaymentMethodsRequest
-	144, // 207: listingssvc.v1.ListingsService.SetDeliveryOptions:input_type -> listingssvc.v1.SetDeliveryOptionsRequest
-	145, // 208: listingssvc.v1.ListingsService.GetDeliveryOptions:input_type -> listingssvc.v1.GetDeliveryOptionsRequest
-	148, // 209: listingssvc.v1.ListingsService.GetMapData:input_type -> listingssvc.v1.GetMapDataRequest
-	150, // 210: listingssvc.v1.ListingsService.GetDashboardStats:input_type -> listingssvc.v1.DashboardStatsRequest
-	17,  // 211: listingssvc.v1.ListingsService.GetListing:output_type -> listingssvc.v1.GetListingResponse
-	19,  // 212: listingssvc.v1.ListingsService.CreateListing:output_type -> listingssvc.v1.CreateListingResponse
-	21,  // 213: listingssvc.v1.ListingsService.UpdateListing:output_type -> listingssvc.v1.UpdateListingResponse
-	23,  // 214: listingssvc.v1.ListingsService.DeleteListing:output_type -> listingssvc.v1.DeleteListingResponse
-	25,  // 215: listingssvc.v1.ListingsService.SearchListings:output_type -> listingssvc.v1.SearchListingsResponse
-	27,  // 216: listingssvc.v1.ListingsService.ListListings:output_type -> listingssvc.v1.ListListingsResponse
-	29,  // 217: listingssvc.v1.ListingsService.GetSimilarListings:output_type -> listingssvc.v1.GetSimilarListingsResponse
-	31,  // 218: listingssvc.v1.ListingsService.GetListingImage:output_type -> listingssvc.v1.ImageResponse
-	39,  // 219: listingssvc.v1.ListingsService.DeleteListingImage:output_type -> listingssvc.v1.DeleteListingImageResponse
-	31,  // 220: listingssvc.v1.ListingsService.AddListingImage:output_type -> listingssvc.v1.ImageResponse
-	34,  // 221: listingssvc.v1.ListingsService.GetListingImages:output_type -> listingssvc.v1.ImagesResponse
-	36,  // 222: listingssvc.v1.ListingsService.ReorderListingImages:output_type -> listingssvc.v1.ReorderImagesResponse
-	42,  // 223: listingssvc.v1.ListingsService.UploadListingImages:output_type -> listingssvc.v1.UploadImagesResponse
-	44,  // 224: listingssvc.v1.ListingsService.GetRootCategories:output_type -> listingssvc.v1.CategoriesResponse
-	44,  // 225: listingssvc.v1.ListingsService.GetAllCategories:output_type -> listingssvc.v1.CategoriesResponse
-	44,  // 226: listingssvc.v1.ListingsService.GetPopularCategories:output_type -> listingssvc.v1.CategoriesResponse
-	46,  // 227: listingssvc.v1.ListingsService.GetCategory:output_type -> listingssvc.v1.CategoryResponse
-	47,  // 228: listingssvc.v1.ListingsService.GetCategoryTree:output_type -> listingssvc.v1.CategoryTreeResponse
-	48,  // 229: listingssvc.v1.ListingsService.GetFavoritedUsers:output_type -> listingssvc.v1.UserIDsResponse
-	171, // 230: listingssvc.v1.ListingsService.AddToFavorites:output_type -> google.protobuf.Empty
-	171, // 231: listingssvc.v1.ListingsService.RemoveFromFavorites:output_type -> google.protobuf.Empty
-	52,  // 232: listingssvc.v1.ListingsService.GetUserFavorites:output_type -> listingssvc.v1.GetUserFavoritesResponse
-	54,  // 233: listingssvc.v1.ListingsService.IsFavorite:output_type -> listingssvc.v1.IsFavoriteResponse
-	59,  // 234: listingssvc.v1.ListingsService.GetStorefront:output_type -> listingssvc.v1.GetStorefrontResponse
-	59,  // 235: listingssvc.v1.ListingsService.GetStorefrontBySlug:output_type -> listingssvc.v1.GetStorefrontResponse
-	61,  // 236: listingssvc.v1.ListingsService.ListStorefronts:output_type -> listingssvc.v1.ListStorefrontsResponse
-	171, // 237: listingssvc.v1.ListingsService.CreateVariants:output_type -> google.protobuf.Empty
-	64,  // 238: listingssvc.v1.ListingsService.GetVariants:output_type -> listingssvc.v1.VariantsResponse
-	171, // 239: listingssvc.v1.ListingsService.UpdateVariant:output_type -> google.protobuf.Empty
-	171, // 240: listingssvc.v1.ListingsService.DeleteVariant:output_type -> google.protobuf.Empty
-	68,  // 241: listingssvc.v1.ListingsService.GetListingsForReindex:output_type -> listingssvc.v1.ListingsResponse
-	171, // 242: listingssvc.v1.ListingsService.ResetReindexFlags:output_type -> google.protobuf.Empty
-	171, // 243: listingssvc.v1.ListingsService.SyncDiscounts:output_type -> google.protobuf.Empty
-	71,  // 244: listingssvc.v1.ListingsService.GetProduct:output_type -> listingssvc.v1.ProductResponse
-	73,  // 245: listingssvc.v1.ListingsService.GetProductsBySKUs:output_type -> listingssvc.v1.ProductsResponse
-	73,  // 246: listingssvc.v1.ListingsService.GetProductsByIDs:output_type -> listingssvc.v1.ProductsResponse
-	73,  // 247: listingssvc.v1.ListingsService.ListProducts:output_type -> listingssvc.v1.ProductsResponse
-	77,  // 248: listingssvc.v1.ListingsService.GetVariant:output_type -> listingssvc.v1.VariantResponse
-	79,  // 249: listingssvc.v1.ListingsService.GetVariantsByProductID:output_type -> listingssvc.v1.ProductVariantsResponse
-	83,  // 250: listingssvc.v1.ListingsService.DecrementStock:output_type -> listingssvc.v1.DecrementStockResponse
-	85,  // 251: listingssvc.v1.ListingsService.RollbackStock:output_type -> listingssvc.v1.RollbackStockResponse
-	88,  // 252: listingssvc.v1.ListingsService.CheckStockAvailability:output_type -> listingssvc.v1.CheckStockAvailabilityResponse
-	71,  // 253: listingssvc.v1.ListingsService.CreateProduct:output_type -> listingssvc.v1.ProductResponse
-	71,  // 254: listingssvc.v1.ListingsService.UpdateProduct:output_type -> listingssvc.v1.ProductResponse
-	92,  // 255: listingssvc.v1.ListingsService.DeleteProduct:output_type -> listingssvc.v1.DeleteProductResponse
-	95,  // 256: listingssvc.v1.ListingsService.BulkCreateProducts:output_type -> listingssvc.v1.BulkCreateProductsResponse
-	98,  // 257: listingssvc.v1.ListingsService.BulkUpdateProducts:output_type -> listingssvc.v1.BulkUpdateProductsResponse
-	100, // 258: listingssvc.v1.ListingsService.BulkDeleteProducts:output_type -> listingssvc.v1.BulkDeleteProductsResponse
-	77,  // 259: listingssvc.v1.ListingsService.CreateProductVariant:output_type -> listingssvc.v1.VariantResponse
-	77,  // 260: listingssvc.v1.ListingsService.UpdateProductVariant:output_type -> listingssvc.v1.VariantResponse
-	105, // 261: listingssvc.v1.ListingsService.DeleteProductVariant:output_type -> listingssvc.v1.DeleteProductVariantResponse
-	108, // 262: listingssvc.v1.ListingsService.BulkCreateProductVariants:output_type -> listingssvc.v1.BulkCreateProductVariantsResponse
-	110, // 263: listingssvc.v1.ListingsService.RecordInventoryMovement:output_type -> listingssvc.v1.RecordInventoryMovementResponse
-	114, // 264: listingssvc.v1.ListingsService.BatchUpdateStock:output_type -> listingssvc.v1.BatchUpdateStockResponse
-	117, // 265: listingssvc.v1.ListingsService.GetProductStats:output_type -> listingssvc.v1.GetProductStatsResponse
-	171, // 266: listingssvc.v1.ListingsService.IncrementProductViews:output_type -> google.protobuf.Empty
-	154, // 267: listingssvc.v1.ListingsService.AddProductImage:output_type -> listingssvc.v1.ProductImageResponse
-	156, // 268: listingssvc.v1.ListingsService.GetProductImages:output_type -> listingssvc.v1.ProductImagesResponse
-	158, // 269: listingssvc.v1.ListingsService.DeleteProductImage:output_type -> listingssvc.v1.DeleteProductImageResponse
-	160, // 270: listingssvc.v1.ListingsService.ReorderProductImages:output_type -> listingssvc.v1.ReorderProductImagesResponse
-	120, // 271: listingssvc.v1.ListingsService.ReindexAll:output_type -> listingssvc.v1.ReindexAllResponse
-	121, // 272: listingssvc.v1.ListingsService.CreateStorefront:output_type -> listingssvc.v1.StorefrontFull
-	121, // 273: listingssvc.v1.ListingsService.UpdateStorefront:output_type -> listingssvc.v1.StorefrontFull
-	130, // 274: listingssvc.v1.ListingsService.DeleteStorefront:output_type -> listingssvc.v1.DeleteStorefrontResponse
-	61,  // 275: listingssvc.v1.ListingsService.GetMyStorefronts:output_type -> listingssvc.v1.ListStorefrontsResponse
-	122, // 276: listingssvc.v1.ListingsService.AddStaff:output_type -> listingssvc.v1.StorefrontStaff
-	122, // 277: listingssvc.v1.ListingsService.UpdateStaff:output_type -> listingssvc.v1.StorefrontStaff
-	130, // 278: listingssvc.v1.ListingsService.RemoveStaff:output_type -> listingssvc.v1.DeleteStorefrontResponse
-	135, // 279: listingssvc.v1.ListingsService.GetStaff:output_type -> listingssvc.v1.GetStaffResponse
-	138, // 280: listingssvc.v1.ListingsService.SetWorkingHours:output_type -> listingssvc.v1.GetWorkingHoursResponse
-	138, // 281: listingssvc.v1.ListingsService.GetWorkingHours:output_type -> listingssvc.v1.GetWorkingHoursResponse
-	140, // 282: listingssvc.v1.ListingsService.IsOpenNow:output_type -> listingssvc.v1.IsOpenNowResponse
-	143, // 283: listingssvc.v1.ListingsService.SetPaymentMethods:output_type -> listingssvc.v1.GetPaymentMethodsResponse
-	143, // 284: listingssvc.v1.ListingsService.GetPaymentMethods:output_type -> listingssvc.v1.GetPaymentMethodsResponse
-	146, // 285: listingssvc.v1.ListingsService.SetDeliveryOptions:output_type -> listingssvc.v1.GetDeliveryOptionsResponse
-	146, // 286: listingssvc.v1.ListingsService.GetDeliveryOptions:output_type -> listingssvc.v1.GetDeliveryOptionsResponse
-	149, // 287: listingssvc.v1.ListingsService.GetMapData:output_type -> listingssvc.v1.GetMapDataResponse
-	151, // 288: listingssvc.v1.ListingsService.GetDashboardStats:output_type -> listingssvc.v1.DashboardStatsResponse
-	211, // [211:289] is the sub-list for method output_type
-	133, // [133:211] is the sub-list for method input_type
-	133, // [133:133] is the sub-list for extension type_name
-	133, // [133:133] is the sub-list for extension extendee
-	0,   // [0:133] is the sub-list for field type_name
+	11,  // 0: listingssvc.v1.Listing.images:type_name -> listingssvc.v1.ListingImage
+	13,  // 1: listingssvc.v1.Listing.attributes:type_name -> listingssvc.v1.ListingAttribute
+	14,  // 2: listingssvc.v1.Listing.location:type_name -> listingssvc.v1.ListingLocation
+	15,  // 3: listingssvc.v1.Listing.variants:type_name -> listingssvc.v1.ListingVariant
+	250, // 4: listingssvc.v1.Listing.translations:type_name -> listingssvc.v1.Listing.TranslationsEntry
+	12,  // 5: listingssvc.v1.ListingImage.renditions:type_name -> listingssvc.v1.ImageRendition
+	251, // 6: listingssvc.v1.ListingVariant.attributes:type_name -> listingssvc.v1.ListingVariant.AttributesEntry
+	252, // 7: listingssvc.v1.Category.translations:type_name -> listingssvc.v1.Category.TranslationsEntry
+	17,  // 8: listingssvc.v1.CategoryTreeNode.children:type_name -> listingssvc.v1.CategoryTreeNode
+	253, // 9: listingssvc.v1.CategoryTreeNode.translations:type_name -> listingssvc.v1.CategoryTreeNode.TranslationsEntry
+	257, // 10: listingssvc.v1.Product.attributes:type_name -> google.protobuf.Struct
+	258, // 11: listingssvc.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	258, // 12: listingssvc.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	19,  // 13: listingssvc.v1.Product.variants:type_name -> listingssvc.v1.ProductVariant
+	209, // 14: listingssvc.v1.Product.images:type_name -> listingssvc.v1.ProductImage
+	257, // 15: listingssvc.v1.ProductVariant.variant_attributes:type_name -> google.protobuf.Struct
+	257, // 16: listingssvc.v1.ProductVariant.dimensions:type_name -> google.protobuf.Struct
+	258, // 17: listingssvc.v1.ProductVariant.created_at:type_name -> google.protobuf.Timestamp
+	258, // 18: listingssvc.v1.ProductVariant.updated_at:type_name -> google.protobuf.Timestamp
+	209, // 19: listingssvc.v1.ProductVariant.images:type_name -> listingssvc.v1.ProductImage
+	10,  // 20: listingssvc.v1.GetListingResponse.listing:type_name -> listingssvc.v1.Listing
+	254, // 21: listingssvc.v1.CreateListingRequest.translations:type_name -> listingssvc.v1.CreateListingRequest.TranslationsEntry
+	10,  // 22: listingssvc.v1.CreateListingResponse.listing:type_name -> listingssvc.v1.Listing
+	228, // 23: listingssvc.v1.CreateListingResponse.duplicates:type_name -> listingssvc.v1.DuplicateReport
+	10,  // 24: listingssvc.v1.UpdateListingResponse.listing:type_name -> listingssvc.v1.Listing
+	228, // 25: listingssvc.v1.UpdateListingResponse.duplicates:type_name -> listingssvc.v1.DuplicateReport
+	10,  // 26: listingssvc.v1.RenewListingResponse.listing:type_name -> listingssvc.v1.Listing
+	10,  // 27: listingssvc.v1.SearchListingsResponse.listings:type_name -> listingssvc.v1.Listing
+	10,  // 28: listingssvc.v1.ListListingsResponse.listings:type_name -> listingssvc.v1.Listing
+	10,  // 29: listingssvc.v1.GetSimilarListingsResponse.listings:type_name -> listingssvc.v1.Listing
+	10,  // 30: listingssvc.v1.RecommendedListing.listing:type_name -> listingssvc.v1.Listing
+	8,   // 31: listingssvc.v1.RecommendedListing.source:type_name -> listingssvc.v1.RecommendationSource
+	36,  // 32: listingssvc.v1.GetRecommendationsForUserResponse.recommendations:type_name -> listingssvc.v1.RecommendedListing
+	36,  // 33: listingssvc.v1.GetFrequentlyBoughtTogetherResponse.recommendations:type_name -> listingssvc.v1.RecommendedListing
+	11,  // 34: listingssvc.v1.ImageResponse.image:type_name -> listingssvc.v1.ListingImage
+	11,  // 35: listingssvc.v1.ImagesResponse.images:type_name -> listingssvc.v1.ListingImage
+	52,  // 36: listingssvc.v1.UploadImageChunkRequest.metadata:type_name -> listingssvc.v1.UploadImageMetadata
+	11,  // 37: listingssvc.v1.UploadImagesResponse.images:type_name -> listingssvc.v1.ListingImage
+	54,  // 38: listingssvc.v1.RequestImageUploadURLsRequest.files:type_name -> listingssvc.v1.ImageUploadFile
+	258, // 39: listingssvc.v1.PresignedImageUpload.expires_at:type_name -> google.protobuf.Timestamp
+	56,  // 40: listingssvc.v1.RequestImageUploadURLsResponse.uploads:type_name -> listingssvc.v1.PresignedImageUpload
+	16,  // 41: listingssvc.v1.CategoriesResponse.categories:type_name -> listingssvc.v1.Category
+	16,  // 42: listingssvc.v1.CategoryResponse.category:type_name -> listingssvc.v1.Category
+	17,  // 43: listingssvc.v1.CategoryTreeResponse.tree:type_name -> listingssvc.v1.CategoryTreeNode
+	71,  // 44: listingssvc.v1.StorefrontResponse.storefront:type_name -> listingssvc.v1.Storefront
+	141, // 45: listingssvc.v1.GetStorefrontResponse.storefront:type_name -> listingssvc.v1.StorefrontFull
+	2,   // 46: listingssvc.v1.ListStorefrontsRequest.subscription_plans:type_name -> listingssvc.v1.SubscriptionPlanType
+	6,   // 47: listingssvc.v1.ListStorefrontsRequest.payment_methods:type_name -> listingssvc.v1.PaymentMethodType
+	141, // 48: listingssvc.v1.ListStorefrontsResponse.storefronts:type_name -> listingssvc.v1.StorefrontFull
+	79,  // 49: listingssvc.v1.CreateVariantsRequest.variants:type_name -> listingssvc.v1.VariantInput
+	255, // 50: listingssvc.v1.VariantInput.attributes:type_name -> listingssvc.v1.VariantInput.AttributesEntry
+	15,  // 51: listingssvc.v1.VariantsResponse.variants:type_name -> listingssvc.v1.ListingVariant
+	256, // 52: listingssvc.v1.UpdateVariantRequest.attributes:type_name -> listingssvc.v1.UpdateVariantRequest.AttributesEntry
+	10,  // 53: listingssvc.v1.ListingsResponse.listings:type_name -> listingssvc.v1.Listing
+	18,  // 54: listingssvc.v1.ProductResponse.product:type_name -> listingssvc.v1.Product
+	18,  // 55: listingssvc.v1.ProductsResponse.products:type_name -> listingssvc.v1.Product
+	19,  // 56: listingssvc.v1.VariantResponse.variant:type_name -> listingssvc.v1.ProductVariant
+	19,  // 57: listingssvc.v1.ProductVariantsResponse.variants:type_name -> listingssvc.v1.ProductVariant
+	96,  // 58: listingssvc.v1.DecrementStockRequest.items:type_name -> listingssvc.v1.StockItem
+	97,  // 59: listingssvc.v1.DecrementStockResponse.results:type_name -> listingssvc.v1.StockResult
+	96,  // 60: listingssvc.v1.RollbackStockRequest.items:type_name -> listingssvc.v1.StockItem
+	97,  // 61: listingssvc.v1.RollbackStockResponse.results:type_name -> listingssvc.v1.StockResult
+	96,  // 62: listingssvc.v1.CheckStockAvailabilityRequest.items:type_name -> listingssvc.v1.StockItem
+	103, // 63: listingssvc.v1.CheckStockAvailabilityResponse.items:type_name -> listingssvc.v1.StockAvailability
+	257, // 64: listingssvc.v1.CreateProductRequest.attributes:type_name -> google.protobuf.Struct
+	257, // 65: listingssvc.v1.UpdateProductRequest.attributes:type_name -> google.protobuf.Struct
+	259, // 66: listingssvc.v1.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	257, // 67: listingssvc.v1.ProductInput.attributes:type_name -> google.protobuf.Struct
+	109, // 68: listingssvc.v1.BulkCreateProductsRequest.products:type_name -> listingssvc.v1.ProductInput
+	18,  // 69: listingssvc.v1.BulkCreateProductsResponse.products:type_name -> listingssvc.v1.Product
+	117, // 70: listingssvc.v1.BulkCreateProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
+	257, // 71: listingssvc.v1.ProductUpdateInput.attributes:type_name -> google.protobuf.Struct
+	259, // 72: listingssvc.v1.ProductUpdateInput.update_mask:type_name -> google.protobuf.FieldMask
+	112, // 73: listingssvc.v1.BulkUpdateProductsRequest.updates:type_name -> listingssvc.v1.ProductUpdateInput
+	18,  // 74: listingssvc.v1.BulkUpdateProductsResponse.products:type_name -> listingssvc.v1.Product
+	117, // 75: listingssvc.v1.BulkUpdateProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
+	117, // 76: listingssvc.v1.BulkDeleteProductsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
+	257, // 77: listingssvc.v1.CreateProductVariantRequest.variant_attributes:type_name -> google.protobuf.Struct
+	257, // 78: listingssvc.v1.CreateProductVariantRequest.dimensions:type_name -> google.protobuf.Struct
+	257, // 79: listingssvc.v1.UpdateProductVariantRequest.variant_attributes:type_name -> google.protobuf.Struct
+	257, // 80: listingssvc.v1.UpdateProductVariantRequest.dimensions:type_name -> google.protobuf.Struct
+	259, // 81: listingssvc.v1.UpdateProductVariantRequest.update_mask:type_name -> google.protobuf.FieldMask
+	257, // 82: listingssvc.v1.ProductVariantInput.variant_attributes:type_name -> google.protobuf.Struct
+	257, // 83: listingssvc.v1.ProductVariantInput.dimensions:type_name -> google.protobuf.Struct
+	122, // 84: listingssvc.v1.BulkCreateProductVariantsRequest.variants:type_name -> listingssvc.v1.ProductVariantInput
+	19,  // 85: listingssvc.v1.BulkCreateProductVariantsResponse.variants:type_name -> listingssvc.v1.ProductVariant
+	117, // 86: listingssvc.v1.BulkCreateProductVariantsResponse.errors:type_name -> listingssvc.v1.BulkOperationError
+	127, // 87: listingssvc.v1.BatchUpdateStockRequest.items:type_name -> listingssvc.v1.StockUpdateItem
+	129, // 88: listingssvc.v1.BatchUpdateStockResponse.results:type_name -> listingssvc.v1.StockUpdateResult
+	132, // 89: listingssvc.v1.GetProductStatsResponse.stats:type_name -> listingssvc.v1.ProductStats
+	10,  // 90: listingssvc.v1.RecentlyViewedListing.listing:type_name -> listingssvc.v1.Listing
+	258, // 91: listingssvc.v1.RecentlyViewedListing.viewed_at:type_name -> google.protobuf.Timestamp
+	136, // 92: listingssvc.v1.GetRecentlyViewedResponse.items:type_name -> listingssvc.v1.RecentlyViewedListing
+	257, // 93: listingssvc.v1.StorefrontFull.theme:type_name -> google.protobuf.Struct
+	0,   // 94: listingssvc.v1.StorefrontFull.geo_strategy:type_name -> listingssvc.v1.StorefrontGeoStrategy
+	1,   // 95: listingssvc.v1.StorefrontFull.default_privacy_level:type_name -> listingssvc.v1.LocationPrivacyLevel
+	257, // 96: listingssvc.v1.StorefrontFull.settings:type_name -> google.protobuf.Struct
+	257, // 97: listingssvc.v1.StorefrontFull.seo_meta:type_name -> google.protobuf.Struct
+	258, // 98: listingssvc.v1.StorefrontFull.verification_date:type_name -> google.protobuf.Timestamp
+	2,   // 99: listingssvc.v1.StorefrontFull.subscription_plan:type_name -> listingssvc.v1.SubscriptionPlanType
+	258, // 100: listingssvc.v1.StorefrontFull.subscription_expires_at:type_name -> google.protobuf.Timestamp
+	257, // 101: listingssvc.v1.StorefrontFull.ai_agent_config:type_name -> google.protobuf.Struct
+	258, // 102: listingssvc.v1.StorefrontFull.created_at:type_name -> google.protobuf.Timestamp
+	258, // 103: listingssvc.v1.StorefrontFull.updated_at:type_name -> google.protobuf.Timestamp
+	142, // 104: listingssvc.v1.StorefrontFull.staff:type_name -> listingssvc.v1.StorefrontStaff
+	143, // 105: listingssvc.v1.StorefrontFull.hours:type_name -> listingssvc.v1.StorefrontHours
+	144, // 106: listingssvc.v1.StorefrontFull.payment_methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
+	145, // 107: listingssvc.v1.StorefrontFull.delivery_options:type_name -> listingssvc.v1.StorefrontDeliveryOption
+	258, // 108: listingssvc.v1.StorefrontFull.vacation_starts_at:type_name -> google.protobuf.Timestamp
+	258, // 109: listingssvc.v1.StorefrontFull.vacation_ends_at:type_name -> google.protobuf.Timestamp
+	3,   // 110: listingssvc.v1.StorefrontStaff.role:type_name -> listingssvc.v1.StaffRole
+	257, // 111: listingssvc.v1.StorefrontStaff.permissions:type_name -> google.protobuf.Struct
+	258, // 112: listingssvc.v1.StorefrontStaff.last_active_at:type_name -> google.protobuf.Timestamp
+	258, // 113: listingssvc.v1.StorefrontStaff.created_at:type_name -> google.protobuf.Timestamp
+	258, // 114: listingssvc.v1.StorefrontStaff.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 115: listingssvc.v1.StorefrontPaymentMethod.method_type:type_name -> listingssvc.v1.PaymentMethodType
+	257, // 116: listingssvc.v1.StorefrontPaymentMethod.settings:type_name -> google.protobuf.Struct
+	258, // 117: listingssvc.v1.StorefrontPaymentMethod.created_at:type_name -> google.protobuf.Timestamp
+	257, // 118: listingssvc.v1.StorefrontDeliveryOption.zones:type_name -> google.protobuf.Struct
+	257, // 119: listingssvc.v1.StorefrontDeliveryOption.available_days:type_name -> google.protobuf.Struct
+	257, // 120: listingssvc.v1.StorefrontDeliveryOption.provider_config:type_name -> google.protobuf.Struct
+	258, // 121: listingssvc.v1.StorefrontDeliveryOption.created_at:type_name -> google.protobuf.Timestamp
+	258, // 122: listingssvc.v1.StorefrontDeliveryOption.updated_at:type_name -> google.protobuf.Timestamp
+	257, // 123: listingssvc.v1.CreateStorefrontRequest.theme:type_name -> google.protobuf.Struct
+	146, // 124: listingssvc.v1.CreateStorefrontRequest.location:type_name -> listingssvc.v1.Location
+	257, // 125: listingssvc.v1.CreateStorefrontRequest.settings:type_name -> google.protobuf.Struct
+	257, // 126: listingssvc.v1.CreateStorefrontRequest.seo_meta:type_name -> google.protobuf.Struct
+	257, // 127: listingssvc.v1.UpdateStorefrontRequest.theme:type_name -> google.protobuf.Struct
+	146, // 128: listingssvc.v1.UpdateStorefrontRequest.location:type_name -> listingssvc.v1.Location
+	257, // 129: listingssvc.v1.UpdateStorefrontRequest.settings:type_name -> google.protobuf.Struct
+	257, // 130: listingssvc.v1.UpdateStorefrontRequest.seo_meta:type_name -> google.protobuf.Struct
+	149, // 131: listingssvc.v1.UploadStorefrontImageRequest.crop:type_name -> listingssvc.v1.ImageCrop
+	3,   // 132: listingssvc.v1.AddStaffRequest.role:type_name -> listingssvc.v1.StaffRole
+	257, // 133: listingssvc.v1.AddStaffRequest.permissions:type_name -> google.protobuf.Struct
+	3,   // 134: listingssvc.v1.UpdateStaffRequest.role:type_name -> listingssvc.v1.StaffRole
+	257, // 135: listingssvc.v1.UpdateStaffRequest.permissions:type_name -> google.protobuf.Struct
+	142, // 136: listingssvc.v1.GetStaffResponse.staff:type_name -> listingssvc.v1.StorefrontStaff
+	3,   // 137: listingssvc.v1.StaffInvitation.role:type_name -> listingssvc.v1.StaffRole
+	257, // 138: listingssvc.v1.StaffInvitation.permissions:type_name -> google.protobuf.Struct
+	4,   // 139: listingssvc.v1.StaffInvitation.status:type_name -> listingssvc.v1.StaffInvitationStatus
+	258, // 140: listingssvc.v1.StaffInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	258, // 141: listingssvc.v1.StaffInvitation.responded_at:type_name -> google.protobuf.Timestamp
+	258, // 142: listingssvc.v1.StaffInvitation.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 143: listingssvc.v1.InviteStaffRequest.role:type_name -> listingssvc.v1.StaffRole
+	257, // 144: listingssvc.v1.InviteStaffRequest.permissions:type_name -> google.protobuf.Struct
+	158, // 145: listingssvc.v1.ListStaffInvitationsResponse.invitations:type_name -> listingssvc.v1.StaffInvitation
+	258, // 146: listingssvc.v1.StorefrontFollower.followed_at:type_name -> google.protobuf.Timestamp
+	165, // 147: listingssvc.v1.GetStorefrontFollowersResponse.followers:type_name -> listingssvc.v1.StorefrontFollower
+	10,  // 148: listingssvc.v1.GetFollowingFeedResponse.listings:type_name -> listingssvc.v1.Listing
+	143, // 149: listingssvc.v1.SetWorkingHoursRequest.hours:type_name -> listingssvc.v1.StorefrontHours
+	143, // 150: listingssvc.v1.GetWorkingHoursResponse.hours:type_name -> listingssvc.v1.StorefrontHours
+	258, // 151: listingssvc.v1.IsOpenNowResponse.next_open_at:type_name -> google.protobuf.Timestamp
+	258, // 152: listingssvc.v1.IsOpenNowResponse.next_close_at:type_name -> google.protobuf.Timestamp
+	258, // 153: listingssvc.v1.SetVacationModeRequest.starts_at:type_name -> google.protobuf.Timestamp
+	258, // 154: listingssvc.v1.SetVacationModeRequest.ends_at:type_name -> google.protobuf.Timestamp
+	176, // 155: listingssvc.v1.SetHolidaysRequest.holidays:type_name -> listingssvc.v1.StorefrontHoliday
+	176, // 156: listingssvc.v1.GetHolidaysResponse.holidays:type_name -> listingssvc.v1.StorefrontHoliday
+	258, // 157: listingssvc.v1.StorefrontDomain.verified_at:type_name -> google.protobuf.Timestamp
+	258, // 158: listingssvc.v1.StorefrontDomain.created_at:type_name -> google.protobuf.Timestamp
+	180, // 159: listingssvc.v1.ListStorefrontDomainsResponse.domains:type_name -> listingssvc.v1.StorefrontDomain
+	258, // 160: listingssvc.v1.StorefrontVerificationDocument.created_at:type_name -> google.protobuf.Timestamp
+	258, // 161: listingssvc.v1.StorefrontVerification.reviewed_at:type_name -> google.protobuf.Timestamp
+	188, // 162: listingssvc.v1.StorefrontVerification.documents:type_name -> listingssvc.v1.StorefrontVerificationDocument
+	258, // 163: listingssvc.v1.StorefrontVerification.created_at:type_name -> google.protobuf.Timestamp
+	258, // 164: listingssvc.v1.StorefrontVerification.updated_at:type_name -> google.protobuf.Timestamp
+	258, // 165: listingssvc.v1.StorefrontVerificationEvent.created_at:type_name -> google.protobuf.Timestamp
+	187, // 166: listingssvc.v1.SubmitStorefrontVerificationRequest.documents:type_name -> listingssvc.v1.VerificationDocumentUpload
+	258, // 167: listingssvc.v1.GetStorefrontVerificationResponse.verification_date:type_name -> google.protobuf.Timestamp
+	189, // 168: listingssvc.v1.GetStorefrontVerificationResponse.latest_request:type_name -> listingssvc.v1.StorefrontVerification
+	190, // 169: listingssvc.v1.GetStorefrontVerificationResponse.history:type_name -> listingssvc.v1.StorefrontVerificationEvent
+	189, // 170: listingssvc.v1.ListVerificationRequestsResponse.requests:type_name -> listingssvc.v1.StorefrontVerification
+	144, // 171: listingssvc.v1.SetPaymentMethodsRequest.methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
+	144, // 172: listingssvc.v1.GetPaymentMethodsResponse.methods:type_name -> listingssvc.v1.StorefrontPaymentMethod
+	145, // 173: listingssvc.v1.SetDeliveryOptionsRequest.options:type_name -> listingssvc.v1.StorefrontDeliveryOption
+	145, // 174: listingssvc.v1.GetDeliveryOptionsResponse.options:type_name -> listingssvc.v1.StorefrontDeliveryOption
+	76,  // 175: listingssvc.v1.GetMapDataRequest.filter:type_name -> listingssvc.v1.ListStorefrontsRequest
+	204, // 176: listingssvc.v1.GetMapDataResponse.storefronts:type_name -> listingssvc.v1.StorefrontMapData
+	258, // 177: listingssvc.v1.DashboardStatsRequest.date_from:type_name -> google.protobuf.Timestamp
+	258, // 178: listingssvc.v1.DashboardStatsRequest.date_to:type_name -> google.protobuf.Timestamp
+	209, // 179: listingssvc.v1.ProductImageResponse.image:type_name -> listingssvc.v1.ProductImage
+	209, // 180: listingssvc.v1.ProductImagesResponse.images:type_name -> listingssvc.v1.ProductImage
+	258, // 181: listingssvc.v1.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	258, // 182: listingssvc.v1.GetPriceHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	258, // 183: listingssvc.v1.GetPriceHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	218, // 184: listingssvc.v1.GetPriceHistoryResponse.entries:type_name -> listingssvc.v1.PriceHistoryEntry
+	258, // 185: listingssvc.v1.SubmitListingForReviewRequest.publish_at:type_name -> google.protobuf.Timestamp
+	10,  // 186: listingssvc.v1.SubmitListingForReviewResponse.listing:type_name -> listingssvc.v1.Listing
+	10,  // 187: listingssvc.v1.GetModerationQueueResponse.listings:type_name -> listingssvc.v1.Listing
+	10,  // 188: listingssvc.v1.ModerateListingResponse.listing:type_name -> listingssvc.v1.Listing
+	227, // 189: listingssvc.v1.DuplicateReport.matches:type_name -> listingssvc.v1.DuplicateMatch
+	258, // 190: listingssvc.v1.DuplicateFlag.detected_at:type_name -> google.protobuf.Timestamp
+	229, // 191: listingssvc.v1.ListDuplicateFlagsResponse.flags:type_name -> listingssvc.v1.DuplicateFlag
+	5,   // 192: listingssvc.v1.Review.subject_type:type_name -> listingssvc.v1.ReviewSubjectType
+	258, // 193: listingssvc.v1.Review.seller_replied_at:type_name -> google.protobuf.Timestamp
+	258, // 194: listingssvc.v1.Review.created_at:type_name -> google.protobuf.Timestamp
+	258, // 195: listingssvc.v1.Review.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 196: listingssvc.v1.ReviewSummary.subject_type:type_name -> listingssvc.v1.ReviewSubjectType
+	5,   // 197: listingssvc.v1.CreateReviewRequest.subject_type:type_name -> listingssvc.v1.ReviewSubjectType
+	5,   // 198: listingssvc.v1.ListReviewsRequest.subject_type:type_name -> listingssvc.v1.ReviewSubjectType
+	232, // 199: listingssvc.v1.ListReviewsResponse.reviews:type_name -> listingssvc.v1.Review
+	233, // 200: listingssvc.v1.ListReviewsResponse.summary:type_name -> listingssvc.v1.ReviewSummary
+	5,   // 201: listingssvc.v1.GetReviewSummaryRequest.subject_type:type_name -> listingssvc.v1.ReviewSubjectType
+	258, // 202: listingssvc.v1.ReviewReport.created_at:type_name -> google.protobuf.Timestamp
+	246, // 203: listingssvc.v1.ListReviewReportsResponse.reports:type_name -> listingssvc.v1.ReviewReport
+	9,   // 204: listingssvc.v1.Listing.TranslationsEntry.value:type_name -> listingssvc.v1.ListingFieldTranslations
+	9,   // 205: listingssvc.v1.CreateListingRequest.TranslationsEntry.value:type_name -> listingssvc.v1.ListingFieldTranslations
+	20,  // 206: listingssvc.v1.ListingsService.GetListing:input_type -> listingssvc.v1.GetListingRequest
+	22,  // 207: listingssvc.v1.ListingsService.CreateListing:input_type -> listingssvc.v1.CreateListingRequest
+	24,  // 208: listingssvc.v1.ListingsService.UpdateListing:input_type -> listingssvc.v1.UpdateListingRequest
+	28,  // 209: listingssvc.v1.ListingsService.DeleteListing:input_type -> listingssvc.v1.DeleteListingRequest
+	30,  // 210: listingssvc.v1.ListingsService.SearchListings:input_type -> listingssvc.v1.SearchListingsRequest
+	32,  // 211: listingssvc.v1.ListingsService.ListListings:input_type -> listingssvc.v1.ListListingsRequest
+	34,  // 212: listingssvc.v1.ListingsService.GetSimilarListings:input_type -> listingssvc.v1.GetSimilarListingsRequest
+	37,  // 213: listingssvc.v1.ListingsService.GetRecommendationsForUser:input_type -> listingssvc.v1.GetRecommendationsForUserRequest
+	39,  // 214: listingssvc.v1.ListingsService.GetFrequentlyBoughtTogether:input_type -> listingssvc.v1.GetFrequentlyBoughtTogetherRequest
+	26,  // 215: listingssvc.v1.ListingsService.RenewListing:input_type -> listingssvc.v1.RenewListingRequest
+	41,  // 216: listingssvc.v1.ListingsService.GetListingImage:input_type -> listingssvc.v1.ImageIDRequest
+	49,  // 217: listingssvc.v1.ListingsService.DeleteListingImage:input_type -> listingssvc.v1.DeleteListingImageRequest
+	43,  // 218: listingssvc.v1.ListingsService.AddListingImage:input_type -> listingssvc.v1.AddImageRequest
+	44,  // 219: listingssvc.v1.ListingsService.GetListingImages:input_type -> listingssvc.v1.ListingIDRequest
+	46,  // 220: listingssvc.v1.ListingsService.ReorderListingImages:input_type -> listingssvc.v1.ReorderImagesRequest
+	51,  // 221: listingssvc.v1.ListingsService.UploadListingImages:input_type -> listingssvc.v1.UploadImageChunkRequest
+	55,  // 222: listingssvc.v1.ListingsService.RequestImageUploadURLs:input_type -> listingssvc.v1.RequestImageUploadURLsRequest
+	58,  // 223: listingssvc.v1.ListingsService.ConfirmImageUpload:input_type -> listingssvc.v1.ConfirmImageUploadRequest
+	260, // 224: listingssvc.v1.ListingsService.GetRootCategories:input_type -> google.protobuf.Empty
+	260, // 225: listingssvc.v1.ListingsService.GetAllCategories:input_type -> google.protobuf.Empty
+	59,  // 226: listingssvc.v1.ListingsService.GetPopularCategories:input_type -> listingssvc.v1.PopularCategoriesRequest
+	61,  // 227: listingssvc.v1.ListingsService.GetCategory:input_type -> listingssvc.v1.CategoryIDRequest
+	61,  // 228: listingssvc.v1.ListingsService.GetCategoryTree:input_type -> listingssvc.v1.CategoryIDRequest
+	44,  // 229: listingssvc.v1.ListingsService.GetFavoritedUsers:input_type -> listingssvc.v1.ListingIDRequest
+	65,  // 230: listingssvc.v1.ListingsService.AddToFavorites:input_type -> listingssvc.v1.AddToFavoritesRequest
+	66,  // 231: listingssvc.v1.ListingsService.RemoveFromFavorites:input_type -> listingssvc.v1.RemoveFromFavoritesRequest
+	67,  // 232: listingssvc.v1.ListingsService.GetUserFavorites:input_type -> listingssvc.v1.GetUserFavoritesRequest
+	69,  // 233: listingssvc.v1.ListingsService.IsFavorite:input_type -> listingssvc.v1.IsFavoriteRequest
+	72,  // 234: listingssvc.v1.ListingsService.GetStorefront:input_type -> listingssvc.v1.GetStorefrontRequest
+	73,  // 235: listingssvc.v1.ListingsService.GetStorefrontBySlug:input_type -> listingssvc.v1.GetStorefrontBySlugRequest
+	76,  // 236: listingssvc.v1.ListingsService.ListStorefronts:input_type -> listingssvc.v1.ListStorefrontsRequest
+	78,  // 237: listingssvc.v1.ListingsService.CreateVariants:input_type -> listingssvc.v1.CreateVariantsRequest
+	44,  // 238: listingssvc.v1.ListingsService.GetVariants:input_type -> listingssvc.v1.ListingIDRequest
+	81,  // 239: listingssvc.v1.ListingsService.UpdateVariant:input_type -> listingssvc.v1.UpdateVariantRequest
+	82,  // 240: listingssvc.v1.ListingsService.DeleteVariant:input_type -> listingssvc.v1.VariantIDRequest
+	83,  // 241: listingssvc.v1.ListingsService.GetListingsForReindex:input_type -> listingssvc.v1.ReindexRequest
+	85,  // 242: listingssvc.v1.ListingsService.ResetReindexFlags:input_type -> listingssvc.v1.ResetFlagsRequest
+	260, // 243: listingssvc.v1.ListingsService.SyncDiscounts:input_type -> google.protobuf.Empty
+	86,  // 244: listingssvc.v1.ListingsService.GetProduct:input_type -> listingssvc.v1.GetProductRequest
+	88,  // 245: listingssvc.v1.ListingsService.GetProductsBySKUs:input_type -> listingssvc.v1.GetProductsBySKUsRequest
+	90,  // 246: listingssvc.v1.ListingsService.GetProductsByIDs:input_type -> listingssvc.v1.GetProductsByIDsRequest
+	91,  // 247: listingssvc.v1.ListingsService.ListProducts:input_type -> listingssvc.v1.ListProductsRequest
+	92,  // 248: listingssvc.v1.ListingsService.GetVariant:input_type -> listingssvc.v1.GetVariantRequest
+	94,  // 249: listingssvc.v1.ListingsService.GetVariantsByProductID:input_type -> listingssvc.v1.GetVariantsByProductIDRequest
+	98,  // 250: listingssvc.v1.ListingsService.DecrementStock:input_type -> listingssvc.v1.DecrementStockRequest
+	100, // 251: listingssvc.v1.ListingsService.RollbackStock:input_type -> listingssvc.v1.RollbackStockRequest
+	102, // 252: listingssvc.v1.ListingsService.CheckStockAvailability:input_type -> listingssvc.v1.CheckStockAvailabilityRequest
+	105, // 253: listingssvc.v1.ListingsService.CreateProduct:input_type -> listingssvc.v1.CreateProductRequest
+	106, // 254: listingssvc.v1.ListingsService.UpdateProduct:input_type -> listingssvc.v1.UpdateProductRequest
+	107, // 255: listingssvc.v1.ListingsService.DeleteProduct:input_type -> listingssvc.v1.DeleteProductRequest
+	110, // 256: listingssvc.v1.ListingsService.BulkCreateProducts:input_type -> listingssvc.v1.BulkCreateProductsRequest
+	113, // 257: listingssvc.v1.ListingsService.BulkUpdateProducts:input_type -> listingssvc.v1.BulkUpdateProductsRequest
+	115, // 258: listingssvc.v1.ListingsService.BulkDeleteProducts:input_type -> listingssvc.v1.BulkDeleteProductsRequest
+	118, // 259: listingssvc.v1.ListingsService.CreateProductVariant:input_type -> listingssvc.v1.CreateProductVariantRequest
+	119, // 260: listingssvc.v1.ListingsService.UpdateProductVariant:input_type -> listingssvc.v1.UpdateProductVariantRequest
+	120, // 261: listingssvc.v1.ListingsService.DeleteProductVariant:input_type -> listingssvc.v1.DeleteProductVariantRequest
+	123, // 262: listingssvc.v1.ListingsService.BulkCreateProductVariants:input_type -> listingssvc.v1.BulkCreateProductVariantsRequest
+	125, // 263: listingssvc.v1.ListingsService.RecordInventoryMovement:input_type -> listingssvc.v1.RecordInventoryMovementRequest
+	128, // 264: listingssvc.v1.ListingsService.BatchUpdateStock:input_type -> listingssvc.v1.BatchUpdateStockRequest
+	131, // 265: listingssvc.v1.ListingsService.GetProductStats:input_type -> listingssvc.v1.GetProductStatsRequest
+	134, // 266: listingssvc.v1.ListingsService.IncrementProductViews:input_type -> listingssvc.v1.IncrementProductViewsRequest
+	135, // 267: listingssvc.v1.ListingsService.GetRecentlyViewed:input_type -> listingssvc.v1.GetRecentlyViewedRequest
+	138, // 268: listingssvc.v1.ListingsService.ClearRecentlyViewed:input_type -> listingssvc.v1.ClearRecentlyViewedRequest
+	210, // 269: listingssvc.v1.ListingsService.AddProductImage:input_type -> listingssvc.v1.AddProductImageRequest
+	212, // 270: listingssvc.v1.ListingsService.GetProductImages:input_type -> listingssvc.v1.GetProductImagesRequest
+	214, // 271: listingssvc.v1.ListingsService.DeleteProductImage:input_type -> listingssvc.v1.DeleteProductImageRequest
+	216, // 272: listingssvc.v1.ListingsService.ReorderProductImages:input_type -> listingssvc.v1.ReorderProductImagesRequest
+	139, // 273: listingssvc.v1.ListingsService.ReindexAll:input_type -> listingssvc.v1.ReindexAllRequest
+	147, // 274: listingssvc.v1.ListingsService.CreateStorefront:input_type -> listingssvc.v1.CreateStorefrontRequest
+	148, // 275: listingssvc.v1.ListingsService.UpdateStorefront:input_type -> listingssvc.v1.UpdateStorefrontRequest
+	151, // 276: listingssvc.v1.ListingsService.DeleteStorefront:input_type -> listingssvc.v1.DeleteStorefrontRequest
+	76,  // 277: listingssvc.v1.ListingsService.GetMyStorefronts:input_type -> listingssvc.v1.ListStorefrontsRequest
+	150, // 278: listingssvc.v1.ListingsService.UploadStorefrontImage:input_type -> listingssvc.v1.UploadStorefrontImageRequest
+	153, // 279: listingssvc.v1.ListingsService.AddStaff:input_type -> listingssvc.v1.AddStaffRequest
+	154, // 280: listingssvc.v1.ListingsService.UpdateStaff:input_type -> listingssvc.v1.UpdateStaffRequest
+	155, // 281: listingssvc.v1.ListingsService.RemoveStaff:input_type -> listingssvc.v1.RemoveStaffRequest
+	156, // 282: listingssvc.v1.ListingsService.GetStaff:input_type -> listingssvc.v1.GetStaffRequest
+	159, // 283: listingssvc.v1.ListingsService.InviteStaff:input_type -> listingssvc.v1.InviteStaffRequest
+	160, // 284: listingssvc.v1.ListingsService.AcceptStaffInvitation:input_type -> listingssvc.v1.StaffInvitationActionRequest
+	160, // 285: listingssvc.v1.ListingsService.DeclineStaffInvitation:input_type -> listingssvc.v1.StaffInvitationActionRequest
+	160, // 286: listingssvc.v1.ListingsService.RevokeStaffInvitation:input_type -> listingssvc.v1.StaffInvitationActionRequest
+	161, // 287: listingssvc.v1.ListingsService.ListStaffInvitations:input_type -> listingssvc.v1.ListStaffInvitationsRequest
+	163, // 288: listingssvc.v1.ListingsService.FollowStorefront:input_type -> listingssvc.v1.FollowStorefrontRequest
+	163, // 289: listingssvc.v1.ListingsService.UnfollowStorefront:input_type -> listingssvc.v1.FollowStorefrontRequest
+	166, // 290: listingssvc.v1.ListingsService.GetStorefrontFollowers:input_type -> listingssvc.v1.GetStorefrontFollowersRequest
+	168, // 291: listingssvc.v1.ListingsService.GetFollowingFeed:input_type -> listingssvc.v1.GetFollowingFeedRequest
+	170, // 292: listingssvc.v1.ListingsService.SetWorkingHours:input_type -> listingssvc.v1.SetWorkingHoursRequest
+	171, // 293: listingssvc.v1.ListingsService.GetWorkingHours:input_type -> listingssvc.v1.GetWorkingHoursRequest
+	173, // 294: listingssvc.v1.ListingsService.IsOpenNow:input_type -> listingssvc.v1.IsOpenNowRequest
+	175, // 295: listingssvc.v1.ListingsService.SetVacationMode:input_type -> listingssvc.v1.SetVacationModeRequest
+	177, // 296: listingssvc.v1.ListingsService.SetHolidays:input_type -> listingssvc.v1.SetHolidaysRequest
+	178, // 297: listingssvc.v1.ListingsService.GetHolidays:input_type -> listingssvc.v1.GetHolidaysRequest
+	181, // 298: listingssvc.v1.ListingsService.AddStorefrontDomain:input_type -> listingssvc.v1.AddStorefrontDomainRequest
+	182, // 299: listingssvc.v1.ListingsService.VerifyStorefrontDomain:input_type -> listingssvc.v1.StorefrontDomainRequest
+	182, // 300: listingssvc.v1.ListingsService.RemoveStorefrontDomain:input_type -> listingssvc.v1.StorefrontDomainRequest
+	183, // 301: listingssvc.v1.ListingsService.ListStorefrontDomains:input_type -> listingssvc.v1.ListStorefrontDomainsRequest
+	185, // 302: listingssvc.v1.ListingsService.ResolveStorefrontDomain:input_type -> listingssvc.v1.ResolveStorefrontDomainRequest
+	191, // 303: listingssvc.v1.ListingsService.SubmitStorefrontVerification:input_type -> listingssvc.v1.SubmitStorefrontVerificationRequest
+	192, // 304: listingssvc.v1.ListingsService.GetStorefrontVerification:input_type -> listingssvc.v1.GetStorefrontVerificationRequest
+	192, // 305: listingssvc.v1.ListingsService.CancelStorefrontVerification:input_type -> listingssvc.v1.GetStorefrontVerificationRequest
+	194, // 306: listingssvc.v1.ListingsService.ListVerificationRequests:input_type -> listingssvc.v1.ListVerificationRequestsRequest
+	196, // 307: listingssvc.v1.ListingsService.ReviewStorefrontVerification:input_type -> listingssvc.v1.ReviewStorefrontVerificationRequest
+	197, // 308: listingssvc.v1.ListingsService.RevokeStorefrontVerification:input_type -> listingssvc.v1.RevokeStorefrontVerificationRequest
+	198, // 309: listingssvc.v1.ListingsService.SetPaymentMethods:input_type -> listingssvc.v1.SetPaymentMethodsRequest
+	199, // 310: listingssvc.v1.ListingsService.GetPaymentMethods:input_type -> listingssvc.v1.GetPaymentMethodsRequest
+	201, // 311: listingssvc.v1.ListingsService.SetDeliveryOptions:input_type -> listingssvc.v1.SetDeliveryOptionsRequest
+	202, // 312: listingssvc.v1.ListingsService.GetDeliveryOptions:input_type -> listingssvc.v1.GetDeliveryOptionsRequest
+	205, // 313: listingssvc.v1.ListingsService.GetMapData:input_type -> listingssvc.v1.GetMapDataRequest
+	207, // 314: listingssvc.v1.ListingsService.GetDashboardStats:input_type -> listingssvc.v1.DashboardStatsRequest
+	219, // 315: listingssvc.v1.ListingsService.GetPriceHistory:input_type -> listingssvc.v1.GetPriceHistoryRequest
+	221, // 316: listingssvc.v1.ListingsService.SubmitListingForReview:input_type -> listingssvc.v1.SubmitListingForReviewRequest
+	223, // 317: listingssvc.v1.ListingsService.GetModerationQueue:input_type -> listingssvc.v1.GetModerationQueueRequest
+	225, // 318: listingssvc.v1.ListingsService.ModerateListing:input_type -> listingssvc.v1.ModerateListingRequest
+	230, // 319: listingssvc.v1.ListingsService.ListDuplicateFlags:input_type -> listingssvc.v1.ListDuplicateFlagsRequest
+	234, // 320: listingssvc.v1.ListingsService.CreateReview:input_type -> listingssvc.v1.CreateReviewRequest
+	235, // 321: listingssvc.v1.ListingsService.GetReview:input_type -> listingssvc.v1.GetReviewRequest
+	236, // 322: listingssvc.v1.ListingsService.ListReviews:input_type -> listingssvc.v1.ListReviewsRequest
+	238, // 323: listingssvc.v1.ListingsService.UpdateReview:input_type -> listingssvc.v1.UpdateReviewRequest
+	239, // 324: listingssvc.v1.ListingsService.DeleteReview:input_type -> listingssvc.v1.DeleteReviewRequest
+	241, // 325: listingssvc.v1.ListingsService.GetReviewSummary:input_type -> listingssvc.v1.GetReviewSummaryRequest
+	242, // 326: listingssvc.v1.ListingsService.ReplyToReview:input_type -> listingssvc.v1.ReplyToReviewRequest
+	243, // 327: listingssvc.v1.ListingsService.VoteReview:input_type -> listingssvc.v1.VoteReviewRequest
+	244, // 328: listingssvc.v1.ListingsService.ReportReview:input_type -> listingssvc.v1.ReportReviewRequest
+	247, // 329: listingssvc.v1.ListingsService.ListReviewReports:input_type -> listingssvc.v1.ListReviewReportsRequest
+	249, // 330: listingssvc.v1.ListingsService.ModerateReview:input_type -> listingssvc.v1.ModerateReviewRequest
+	21,  // 331: listingssvc.v1.ListingsService.GetListing:output_type -> listingssvc.v1.GetListingResponse
+	23,  // 332: listingssvc.v1.ListingsService.CreateListing:output_type -> listingssvc.v1.CreateListingResponse
+	25,  // 333: listingssvc.v1.ListingsService.UpdateListing:output_type -> listingssvc.v1.UpdateListingResponse
+	29,  // 334: listingssvc.v1.ListingsService.DeleteListing:output_type -> listingssvc.v1.DeleteListingResponse
+	31,  // 335: listingssvc.v1.ListingsService.SearchListings:output_type -> listingssvc.v1.SearchListingsResponse
+	33,  // 336: listingssvc.v1.ListingsService.ListListings:output_type -> listingssvc.v1.ListListingsResponse
+	35,  // 337: listingssvc.v1.ListingsService.GetSimilarListings:output_type -> listingssvc.v1.GetSimilarListingsResponse
+	38,  // 338: listingssvc.v1.ListingsService.GetRecommendationsForUser:output_type -> listingssvc.v1.GetRecommendationsForUserResponse
+	40,  // 339: listingssvc.v1.ListingsService.GetFrequentlyBoughtTogether:output_type -> listingssvc.v1.GetFrequentlyBoughtTogetherResponse
+	27,  // 340: listingssvc.v1.ListingsService.RenewListing:output_type -> listingssvc.v1.RenewListingResponse
+	42,  // 341: listingssvc.v1.ListingsService.GetListingImage:output_type -> listingssvc.v1.ImageResponse
+	50,  // 342: listingssvc.v1.ListingsService.DeleteListingImage:output_type -> listingssvc.v1.DeleteListingImageResponse
+	42,  // 343: listingssvc.v1.ListingsService.AddListingImage:output_type -> listingssvc.v1.ImageResponse
+	45,  // 344: listingssvc.v1.ListingsService.GetListingImages:output_type -> listingssvc.v1.ImagesResponse
+	47,  // 345: listingssvc.v1.ListingsService.ReorderListingImages:output_type -> listingssvc.v1.ReorderImagesResponse
+	53,  // 346: listingssvc.v1.ListingsService.UploadListingImages:output_type -> listingssvc.v1.UploadImagesResponse
+	57,  // 347: listingssvc.v1.ListingsService.RequestImageUploadURLs:output_type -> listingssvc.v1.RequestImageUploadURLsResponse
+	42,  // 348: listingssvc.v1.ListingsService.ConfirmImageUpload:output_type -> listingssvc.v1.ImageResponse
+	60,  // 349: listingssvc.v1.ListingsService.GetRootCategories:output_type -> listingssvc.v1.CategoriesResponse
+	60,  // 350: listingssvc.v1.ListingsService.GetAllCategories:output_type -> listingssvc.v1.CategoriesResponse
+	60,  // 351: listingssvc.v1.ListingsService.GetPopularCategories:output_type -> listingssvc.v1.CategoriesResponse
+	62,  // 352: listingssvc.v1.ListingsService.GetCategory:output_type -> listingssvc.v1.CategoryResponse
+	63,  // 353: listingssvc.v1.ListingsService.GetCategoryTree:output_type -> listingssvc.v1.CategoryTreeResponse
+	64,  // 354: listingssvc.v1.ListingsService.GetFavoritedUsers:output_type -> listingssvc.v1.UserIDsResponse
+	260, // 355: listingssvc.v1.ListingsService.AddToFavorites:output_type -> google.protobuf.Empty
+	260, // 356: listingssvc.v1.ListingsService.RemoveFromFavorites:output_type -> google.protobuf.Empty
+	68,  // 357: listingssvc.v1.ListingsService.GetUserFavorites:output_type -> listingssvc.v1.GetUserFavoritesResponse
+	70,  // 358: listingssvc.v1.ListingsService.IsFavorite:output_type -> listingssvc.v1.IsFavoriteResponse
+	75,  // 359: listingssvc.v1.ListingsService.GetStorefront:output_type -> listingssvc.v1.GetStorefrontResponse
+	75,  // 360: listingssvc.v1.ListingsService.GetStorefrontBySlug:output_type -> listingssvc.v1.GetStorefrontResponse
+	77,  // 361: listingssvc.v1.ListingsService.ListStorefronts:output_type -> listingssvc.v1.ListStorefrontsResponse
+	260, // 362: listingssvc.v1.ListingsService.CreateVariants:output_type -> google.protobuf.Empty
+	80,  // 363: listingssvc.v1.ListingsService.GetVariants:output_type -> listingssvc.v1.VariantsResponse
+	260, // 364: listingssvc.v1.ListingsService.UpdateVariant:output_type -> google.protobuf.Empty
+	260, // 365: listingssvc.v1.ListingsService.DeleteVariant:output_type -> google.protobuf.Empty
+	84,  // 366: listingssvc.v1.ListingsService.GetListingsForReindex:output_type -> listingssvc.v1.ListingsResponse
+	260, // 367: listingssvc.v1.ListingsService.ResetReindexFlags:output_type -> google.protobuf.Empty
+	260, // 368: listingssvc.v1.ListingsService.SyncDiscounts:output_type -> google.protobuf.Empty
+	87,  // 369: listingssvc.v1.ListingsService.GetProduct:output_type -> listingssvc.v1.ProductResponse
+	89,  // 370: listingssvc.v1.ListingsService.GetProductsBySKUs:output_type -> listingssvc.v1.ProductsResponse
+	89,  // 371: listingssvc.v1.ListingsService.GetProductsByIDs:output_type -> listingssvc.v1.ProductsResponse
+	89,  // 372: listingssvc.v1.ListingsService.ListProducts:output_type -> listingssvc.v1.ProductsResponse
+	93,  // 373: listingssvc.v1.ListingsService.GetVariant:output_type -> listingssvc.v1.VariantResponse
+	95,  // 374: listingssvc.v1.ListingsService.GetVariantsByProductID:output_type -> listingssvc.v1.ProductVariantsResponse
+	99,  // 375: listingssvc.v1.ListingsService.DecrementStock:output_type -> listingssvc.v1.DecrementStockResponse
+	101, // 376: listingssvc.v1.ListingsService.RollbackStock:output_type -> listingssvc.v1.RollbackStockResponse
+	104, // 377: listingssvc.v1.ListingsService.CheckStockAvailability:output_type -> listingssvc.v1.CheckStockAvailabilityResponse
+	87,  // 378: listingssvc.v1.ListingsService.CreateProduct:output_type -> listingssvc.v1.ProductResponse
+	87,  // 379: listingssvc.v1.ListingsService.UpdateProduct:output_type -> listingssvc.v1.ProductResponse
+	108, // 380: listingssvc.v1.ListingsService.DeleteProduct:output_type -> listingssvc.v1.DeleteProductResponse
+	111, // 381: listingssvc.v1.ListingsService.BulkCreateProducts:output_type -> listingssvc.v1.BulkCreateProductsResponse
+	114, // 382: listingssvc.v1.ListingsService.BulkUpdateProducts:output_type -> listingssvc.v1.BulkUpdateProductsResponse
+	116, // 383: listingssvc.v1.ListingsService.BulkDeleteProducts:output_type -> listingssvc.v1.BulkDeleteProductsResponse
+	93,  // 384: listingssvc.v1.ListingsService.CreateProductVariant:output_type -> listingssvc.v1.VariantResponse
+	93,  // 385: listingssvc.v1.ListingsService.UpdateProductVariant:output_type -> listingssvc.v1.VariantResponse
+	121, // 386: listingssvc.v1.ListingsService.DeleteProductVariant:output_type -> listingssvc.v1.DeleteProductVariantResponse
+	124, // 387: listingssvc.v1.ListingsService.BulkCreateProductVariants:output_type -> listingssvc.v1.BulkCreateProductVariantsResponse
+	126, // 388: listingssvc.v1.ListingsService.RecordInventoryMovement:output_type -> listingssvc.v1.RecordInventoryMovementResponse
+	130, // 389: listingssvc.v1.ListingsService.BatchUpdateStock:output_type -> listingssvc.v1.BatchUpdateStockResponse
+	133, // 390: listingssvc.v1.ListingsService.GetProductStats:output_type -> listingssvc.v1.GetProductStatsResponse
+	260, // 391: listingssvc.v1.ListingsService.IncrementProductViews:output_type -> google.protobuf.Empty
+	137, // 392: listingssvc.v1.ListingsService.GetRecentlyViewed:output_type -> listingssvc.v1.GetRecentlyViewedResponse
+	260, // 393: listingssvc.v1.ListingsService.ClearRecentlyViewed:output_type -> google.protobuf.Empty
+	211, // 394: listingssvc.v1.ListingsService.AddProductImage:output_type -> listingssvc.v1.ProductImageResponse
+	213, // 395: listingssvc.v1.ListingsService.GetProductImages:output_type -> listingssvc.v1.ProductImagesResponse
+	215, // 396: listingssvc.v1.ListingsService.DeleteProductImage:output_type -> listingssvc.v1.DeleteProductImageResponse
+	217, // 397: listingssvc.v1.ListingsService.ReorderProductImages:output_type -> listingssvc.v1.ReorderProductImagesResponse
+	140, // 398: listingssvc.v1.ListingsService.ReindexAll:output_type -> listingssvc.v1.ReindexAllResponse
+	141, // 399: listingssvc.v1.ListingsService.CreateStorefront:output_type -> listingssvc.v1.StorefrontFull
+	141, // 400: listingssvc.v1.ListingsService.UpdateStorefront:output_type -> listingssvc.v1.StorefrontFull
+	152, // 401: listingssvc.v1.ListingsService.DeleteStorefront:output_type -> listingssvc.v1.DeleteStorefrontResponse
+	77,  // 402: listingssvc.v1.ListingsService.GetMyStorefronts:output_type -> listingssvc.v1.ListStorefrontsResponse
+	141, // 403: listingssvc.v1.ListingsService.UploadStorefrontImage:output_type -> listingssvc.v1.StorefrontFull
+	142, // 404: listingssvc.v1.ListingsService.AddStaff:output_type -> listingssvc.v1.StorefrontStaff
+	142, // 405: listingssvc.v1.ListingsService.UpdateStaff:output_type -> listingssvc.v1.StorefrontStaff
+	152, // 406: listingssvc.v1.ListingsService.RemoveStaff:output_type -> listingssvc.v1.DeleteStorefrontResponse
+	157, // 407: listingssvc.v1.ListingsService.GetStaff:output_type -> listingssvc.v1.GetStaffResponse
+	158, // 408: listingssvc.v1.ListingsService.InviteStaff:output_type -> listingssvc.v1.StaffInvitation
+	142, // 409: listingssvc.v1.ListingsService.AcceptStaffInvitation:output_type -> listingssvc.v1.StorefrontStaff
+	158, // 410: listingssvc.v1.ListingsService.DeclineStaffInvitation:output_type -> listingssvc.v1.StaffInvitation
+	158, // 411: listingssvc.v1.ListingsService.RevokeStaffInvitation:output_type -> listingssvc.v1.StaffInvitation
+	162, // 412: listingssvc.v1.ListingsService.ListStaffInvitations:output_type -> listingssvc.v1.ListStaffInvitationsResponse
+	164, // 413: listingssvc.v1.ListingsService.FollowStorefront:output_type -> listingssvc.v1.FollowStorefrontResponse
+	164, // 414: listingssvc.v1.ListingsService.UnfollowStorefront:output_type -> listingssvc.v1.FollowStorefrontResponse
+	167, // 415: listingssvc.v1.ListingsService.GetStorefrontFollowers:output_type -> listingssvc.v1.GetStorefrontFollowersResponse
+	169, // 416: listingssvc.v1.ListingsService.GetFollowingFeed:output_type -> listingssvc.v1.GetFollowingFeedResponse
+	172, // 417: listingssvc.v1.ListingsService.SetWorkingHours:output_type -> listingssvc.v1.GetWorkingHoursResponse
+	172, // 418: listingssvc.v1.ListingsService.GetWorkingHours:output_type -> listingssvc.v1.GetWorkingHoursResponse
+	174, // 419: listingssvc.v1.ListingsService.IsOpenNow:output_type -> listingssvc.v1.IsOpenNowResponse
+	141, // 420: listingssvc.v1.ListingsService.SetVacationMode:output_type -> listingssvc.v1.StorefrontFull
+	179, // 421: listingssvc.v1.ListingsService.SetHolidays:output_type -> listingssvc.v1.GetHolidaysResponse
+	179, // 422: listingssvc.v1.ListingsService.GetHolidays:output_type -> listingssvc.v1.GetHolidaysResponse
+	180, // 423: listingssvc.v1.ListingsService.AddStorefrontDomain:output_type -> listingssvc.v1.StorefrontDomain
+	180, // 424: listingssvc.v1.ListingsService.VerifyStorefrontDomain:output_type -> listingssvc.v1.StorefrontDomain
+	260, // 425: listingssvc.v1.ListingsService.RemoveStorefrontDomain:output_type -> google.protobuf.Empty
+	184, // 426: listingssvc.v1.ListingsService.ListStorefrontDomains:output_type -> listingssvc.v1.ListStorefrontDomainsResponse
+	186, // 427: listingssvc.v1.ListingsService.ResolveStorefrontDomain:output_type -> listingssvc.v1.ResolveStorefrontDomainResponse
+	189, // 428: listingssvc.v1.ListingsService.SubmitStorefrontVerification:output_type -> listingssvc.v1.StorefrontVerification
+	193, // 429: listingssvc.v1.ListingsService.GetStorefrontVerification:output_type -> listingssvc.v1.GetStorefrontVerificationResponse
+	189, // 430: listingssvc.v1.ListingsService.CancelStorefrontVerification:output_type -> listingssvc.v1.StorefrontVerification
+	195, // 431: listingssvc.v1.ListingsService.ListVerificationRequests:output_type -> listingssvc.v1.ListVerificationRequestsResponse
+	189, // 432: listingssvc.v1.ListingsService.ReviewStorefrontVerification:output_type -> listingssvc.v1.StorefrontVerification
+	141, // 433: listingssvc.v1.ListingsService.RevokeStorefrontVerification:output_type -> listingssvc.v1.StorefrontFull
+	200, // 434: listingssvc.v1.ListingsService.SetPaymentMethods:output_type -> listingssvc.v1.GetPaymentMethodsResponse
+	200, // 435: listingssvc.v1.ListingsService.GetPaymentMethods:output_type -> listingssvc.v1.GetPaymentMethodsResponse
+	203, // 436: listingssvc.v1.ListingsService.SetDeliveryOptions:output_type -> listingssvc.v1.GetDeliveryOptionsResponse
+	203, // 437: listingssvc.v1.ListingsService.GetDeliveryOptions:output_type -> listingssvc.v1.GetDeliveryOptionsResponse
+	206, // 438: listingssvc.v1.ListingsService.GetMapData:output_type -> listingssvc.v1.GetMapDataResponse
+	208, // 439: listingssvc.v1.ListingsService.GetDashboardStats:output_type -> listingssvc.v1.DashboardStatsResponse
+	220, // 440: listingssvc.v1.ListingsService.GetPriceHistory:output_type -> listingssvc.v1.GetPriceHistoryResponse
+	222, // 441: listingssvc.v1.ListingsService.SubmitListingForReview:output_type -> listingssvc.v1.SubmitListingForReviewResponse
+	224, // 442: listingssvc.v1.ListingsService.GetModerationQueue:output_type -> listingssvc.v1.GetModerationQueueResponse
+	226, // 443: listingssvc.v1.ListingsService.ModerateListing:output_type -> listingssvc.v1.ModerateListingResponse
+	231, // 444: listingssvc.v1.ListingsService.ListDuplicateFlags:output_type -> listingssvc.v1.ListDuplicateFlagsResponse
+	232, // 445: listingssvc.v1.ListingsService.CreateReview:output_type -> listingssvc.v1.Review
+	232, // 446: listingssvc.v1.ListingsService.GetReview:output_type -> listingssvc.v1.Review
+	237, // 447: listingssvc.v1.ListingsService.ListReviews:output_type -> listingssvc.v1.ListReviewsResponse
+	232, // 448: listingssvc.v1.ListingsService.UpdateReview:output_type -> listingssvc.v1.Review
+	240, // 449: listingssvc.v1.ListingsService.DeleteReview:output_type -> listingssvc.v1.DeleteReviewResponse
+	233, // 450: listingssvc.v1.ListingsService.GetReviewSummary:output_type -> listingssvc.v1.ReviewSummary
+	232, // 451: listingssvc.v1.ListingsService.ReplyToReview:output_type -> listingssvc.v1.Review
+	232, // 452: listingssvc.v1.ListingsService.VoteReview:output_type -> listingssvc.v1.Review
+	245, // 453: listingssvc.v1.ListingsService.ReportReview:output_type -> listingssvc.v1.ReportReviewResponse
+	248, // 454: listingssvc.v1.ListingsService.ListReviewReports:output_type -> listingssvc.v1.ListReviewReportsResponse
+	232, // 455: listingssvc.v1.ListingsService.ModerateReview:output_type -> listingssvc.v1.Review
+	331, // [331:456] is the sub-list for method output_type
+	206, // [206:331] is the sub-list for method input_type
+	206, // [206:206] is the sub-list for extension type_name
+	206, // [206:206] is the sub-list for extension extendee
+	0,   // [0:206] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_listings_v1_listings_proto_init() }
@@ -14781,84 +21737,117 @@ func file_api_proto_listings_v1_listings_proto_init() {
 	file_api_proto_listings_v1_listings_proto_msgTypes[0].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[1].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[2].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[4].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[6].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[7].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[8].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[9].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[10].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[13].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[14].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[18].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[20].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[22].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[26].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[34].OneofWrappers = []any{
+	file_api_proto_listings_v1_listings_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[23].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[42].OneofWrappers = []any{
 		(*UploadImageChunkRequest_Metadata)(nil),
 		(*UploadImageChunkRequest_Chunk)(nil),
 	}
+	file_api_proto_listings_v1_listings_proto_msgTypes[46].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[49].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[50].OneofWrappers = []any{
+	file_api_proto_listings_v1_listings_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[63].OneofWrappers = []any{
 		(*GetStorefrontRequest_Id)(nil),
 		(*GetStorefrontRequest_Slug)(nil),
 	}
-	file_api_proto_listings_v1_listings_proto_msgTypes[54].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[57].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[59].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[61].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[62].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[64].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[66].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[68].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[69].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[67].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[70].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[72].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[74].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[75].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[76].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[77].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[78].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[79].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[81].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[82].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[83].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[84].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[86].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[85].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[87].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[89].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[90].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[95].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[91].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[92].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[94].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[96].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[97].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[99].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[100].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[103].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[104].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[105].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[106].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[107].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[108].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[109].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[110].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[112].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[113].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[115].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[116].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[117].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[118].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[119].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[120].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[121].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[122].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[125].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[126].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[129].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[130].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[132].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[133].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[134].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[142].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[135].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[136].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[137].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[138].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[139].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[141].OneofWrappers = []any{}
 	file_api_proto_listings_v1_listings_proto_msgTypes[144].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[146].OneofWrappers = []any{}
-	file_api_proto_listings_v1_listings_proto_msgTypes[147].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[145].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[149].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[150].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[152].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[159].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[160].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[165].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[166].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[171].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[180].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[181].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[182].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[184].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[196].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[198].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[200].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[201].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[209].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[210].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[211].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[212].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[218].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[220].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[223].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[225].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[227].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[229].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[235].OneofWrappers = []any{}
+	file_api_proto_listings_v1_listings_proto_msgTypes[237].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_listings_v1_listings_proto_rawDesc), len(file_api_proto_listings_v1_listings_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   162,
+			NumEnums:      9,
+			NumMessages:   248,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
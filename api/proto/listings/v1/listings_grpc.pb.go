@@ -20,84 +20,131 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ListingsService_GetListing_FullMethodName                = "/listingssvc.v1.ListingsService/GetListing"
-	ListingsService_CreateListing_FullMethodName             = "/listingssvc.v1.ListingsService/CreateListing"
-	ListingsService_UpdateListing_FullMethodName             = "/listingssvc.v1.ListingsService/UpdateListing"
-	ListingsService_DeleteListing_FullMethodName             = "/listingssvc.v1.ListingsService/DeleteListing"
-	ListingsService_SearchListings_FullMethodName            = "/listingssvc.v1.ListingsService/SearchListings"
-	ListingsService_ListListings_FullMethodName              = "/listingssvc.v1.ListingsService/ListListings"
-	ListingsService_GetSimilarListings_FullMethodName        = "/listingssvc.v1.ListingsService/GetSimilarListings"
-	ListingsService_GetListingImage_FullMethodName           = "/listingssvc.v1.ListingsService/GetListingImage"
-	ListingsService_DeleteListingImage_FullMethodName        = "/listingssvc.v1.ListingsService/DeleteListingImage"
-	ListingsService_AddListingImage_FullMethodName           = "/listingssvc.v1.ListingsService/AddListingImage"
-	ListingsService_GetListingImages_FullMethodName          = "/listingssvc.v1.ListingsService/GetListingImages"
-	ListingsService_ReorderListingImages_FullMethodName      = "/listingssvc.v1.ListingsService/ReorderListingImages"
-	ListingsService_UploadListingImages_FullMethodName       = "/listingssvc.v1.ListingsService/UploadListingImages"
-	ListingsService_GetRootCategories_FullMethodName         = "/listingssvc.v1.ListingsService/GetRootCategories"
-	ListingsService_GetAllCategories_FullMethodName          = "/listingssvc.v1.ListingsService/GetAllCategories"
-	ListingsService_GetPopularCategories_FullMethodName      = "/listingssvc.v1.ListingsService/GetPopularCategories"
-	ListingsService_GetCategory_FullMethodName               = "/listingssvc.v1.ListingsService/GetCategory"
-	ListingsService_GetCategoryTree_FullMethodName           = "/listingssvc.v1.ListingsService/GetCategoryTree"
-	ListingsService_GetFavoritedUsers_FullMethodName         = "/listingssvc.v1.ListingsService/GetFavoritedUsers"
-	ListingsService_AddToFavorites_FullMethodName            = "/listingssvc.v1.ListingsService/AddToFavorites"
-	ListingsService_RemoveFromFavorites_FullMethodName       = "/listingssvc.v1.ListingsService/RemoveFromFavorites"
-	ListingsService_GetUserFavorites_FullMethodName          = "/listingssvc.v1.ListingsService/GetUserFavorites"
-	ListingsService_IsFavorite_FullMethodName                = "/listingssvc.v1.ListingsService/IsFavorite"
-	ListingsService_GetStorefront_FullMethodName             = "/listingssvc.v1.ListingsService/GetStorefront"
-	ListingsService_GetStorefrontBySlug_FullMethodName       = "/listingssvc.v1.ListingsService/GetStorefrontBySlug"
-	ListingsService_ListStorefronts_FullMethodName           = "/listingssvc.v1.ListingsService/ListStorefronts"
-	ListingsService_CreateVariants_FullMethodName            = "/listingssvc.v1.ListingsService/CreateVariants"
-	ListingsService_GetVariants_FullMethodName               = "/listingssvc.v1.ListingsService/GetVariants"
-	ListingsService_UpdateVariant_FullMethodName             = "/listingssvc.v1.ListingsService/UpdateVariant"
-	ListingsService_DeleteVariant_FullMethodName             = "/listingssvc.v1.ListingsService/DeleteVariant"
-	ListingsService_GetListingsForReindex_FullMethodName     = "/listingssvc.v1.ListingsService/GetListingsForReindex"
-	ListingsService_ResetReindexFlags_FullMethodName         = "/listingssvc.v1.ListingsService/ResetReindexFlags"
-	ListingsService_SyncDiscounts_FullMethodName             = "/listingssvc.v1.ListingsService/SyncDiscounts"
-	ListingsService_GetProduct_FullMethodName                = "/listingssvc.v1.ListingsService/GetProduct"
-	ListingsService_GetProductsBySKUs_FullMethodName         = "/listingssvc.v1.ListingsService/GetProductsBySKUs"
-	ListingsService_GetProductsByIDs_FullMethodName          = "/listingssvc.v1.ListingsService/GetProductsByIDs"
-	ListingsService_ListProducts_FullMethodName              = "/listingssvc.v1.ListingsService/ListProducts"
-	ListingsService_GetVariant_FullMethodName                = "/listingssvc.v1.ListingsService/GetVariant"
-	ListingsService_GetVariantsByProductID_FullMethodName    = "/listingssvc.v1.ListingsService/GetVariantsByProductID"
-	ListingsService_DecrementStock_FullMethodName            = "/listingssvc.v1.ListingsService/DecrementStock"
-	ListingsService_RollbackStock_FullMethodName             = "/listingssvc.v1.ListingsService/RollbackStock"
-	ListingsService_CheckStockAvailability_FullMethodName    = "/listingssvc.v1.ListingsService/CheckStockAvailability"
-	ListingsService_CreateProduct_FullMethodName             = "/listingssvc.v1.ListingsService/CreateProduct"
-	ListingsService_UpdateProduct_FullMethodName             = "/listingssvc.v1.ListingsService/UpdateProduct"
-	ListingsService_DeleteProduct_FullMethodName             = "/listingssvc.v1.ListingsService/DeleteProduct"
-	ListingsService_BulkCreateProducts_FullMethodName        = "/listingssvc.v1.ListingsService/BulkCreateProducts"
-	ListingsService_BulkUpdateProducts_FullMethodName        = "/listingssvc.v1.ListingsService/BulkUpdateProducts"
-	ListingsService_BulkDeleteProducts_FullMethodName        = "/listingssvc.v1.ListingsService/BulkDeleteProducts"
-	ListingsService_CreateProductVariant_FullMethodName      = "/listingssvc.v1.ListingsService/CreateProductVariant"
-	ListingsService_UpdateProductVariant_FullMethodName      = "/listingssvc.v1.ListingsService/UpdateProductVariant"
-	ListingsService_DeleteProductVariant_FullMethodName      = "/listingssvc.v1.ListingsService/DeleteProductVariant"
-	ListingsService_BulkCreateProductVariants_FullMethodName = "/listingssvc.v1.ListingsService/BulkCreateProductVariants"
-	ListingsService_RecordInventoryMovement_FullMethodName   = "/listingssvc.v1.ListingsService/RecordInventoryMovement"
-	ListingsService_BatchUpdateStock_FullMethodName          = "/listingssvc.v1.ListingsService/BatchUpdateStock"
-	ListingsService_GetProductStats_FullMethodName           = "/listingssvc.v1.ListingsService/GetProductStats"
-	ListingsService_IncrementProductViews_FullMethodName     = "/listingssvc.v1.ListingsService/IncrementProductViews"
-	ListingsService_AddProductImage_FullMethodName           = "/listingssvc.v1.ListingsService/AddProductImage"
-	ListingsService_GetProductImages_FullMethodName          = "/listingssvc.v1.ListingsService/GetProductImages"
-	ListingsService_DeleteProductImage_FullMethodName        = "/listingssvc.v1.ListingsService/DeleteProductImage"
-	ListingsService_ReorderProductImages_FullMethodName      = "/listingssvc.v1.ListingsService/ReorderProductImages"
-	ListingsService_ReindexAll_FullMethodName                = "/listingssvc.v1.ListingsService/ReindexAll"
-	ListingsService_CreateStorefront_FullMethodName          = "/listingssvc.v1.ListingsService/CreateStorefront"
-	ListingsService_UpdateStorefront_FullMethodName          = "/listingssvc.v1.ListingsService/UpdateStorefront"
-	ListingsService_DeleteStorefront_FullMethodName          = "/listingssvc.v1.ListingsService/DeleteStorefront"
-	ListingsService_GetMyStorefronts_FullMethodName          = "/listingssvc.v1.ListingsService/GetMyStorefronts"
-	ListingsService_AddStaff_FullMethodName                  = "/listingssvc.v1.ListingsService/AddStaff"
-	ListingsService_UpdateStaff_FullMethodName               = "/listingssvc.v1.ListingsService/UpdateStaff"
-	ListingsService_RemoveStaff_FullMethodName               = "/listingssvc.v1.ListingsService/RemoveStaff"
-	ListingsService_GetStaff_FullMethodName                  = "/listingssvc.v1.ListingsService/GetStaff"
-	ListingsService_SetWorkingHours_FullMethodName           = "/listingssvc.v1.ListingsService/SetWorkingHours"
-	ListingsService_GetWorkingHours_FullMethodName           = "/listingssvc.v1.ListingsService/GetWorkingHours"
-	ListingsService_IsOpenNow_FullMethodName                 = "/listingssvc.v1.ListingsService/IsOpenNow"
-	ListingsService_SetPaymentMethods_FullMethodName         = "/listingssvc.v1.ListingsService/SetPaymentMethods"
-	ListingsService_GetPaymentMethods_FullMethodName         = "/listingssvc.v1.ListingsService/GetPaymentMethods"
-	ListingsService_SetDeliveryOptions_FullMethodName        = "/listingssvc.v1.ListingsService/SetDeliveryOptions"
-	ListingsService_GetDeliveryOptions_FullMethodName        = "/listingssvc.v1.ListingsService/GetDeliveryOptions"
-	ListingsService_GetMapData_FullMethodName                = "/listingssvc.v1.ListingsService/GetMapData"
-	ListingsService_GetDashboardStats_FullMethodName         = "/listingssvc.v1.ListingsService/GetDashboardStats"
+	ListingsService_GetListing_FullMethodName                   = "/listingssvc.v1.ListingsService/GetListing"
+	ListingsService_CreateListing_FullMethodName                = "/listingssvc.v1.ListingsService/CreateListing"
+	ListingsService_UpdateListing_FullMethodName                = "/listingssvc.v1.ListingsService/UpdateListing"
+	ListingsService_DeleteListing_FullMethodName                = "/listingssvc.v1.ListingsService/DeleteListing"
+	ListingsService_SearchListings_FullMethodName               = "/listingssvc.v1.ListingsService/SearchListings"
+	ListingsService_ListListings_FullMethodName                 = "/listingssvc.v1.ListingsService/ListListings"
+	ListingsService_GetSimilarListings_FullMethodName           = "/listingssvc.v1.ListingsService/GetSimilarListings"
+	ListingsService_GetRecommendationsForUser_FullMethodName    = "/listingssvc.v1.ListingsService/GetRecommendationsForUser"
+	ListingsService_GetFrequentlyBoughtTogether_FullMethodName  = "/listingssvc.v1.ListingsService/GetFrequentlyBoughtTogether"
+	ListingsService_RenewListing_FullMethodName                 = "/listingssvc.v1.ListingsService/RenewListing"
+	ListingsService_GetListingImage_FullMethodName              = "/listingssvc.v1.ListingsService/GetListingImage"
+	ListingsService_DeleteListingImage_FullMethodName           = "/listingssvc.v1.ListingsService/DeleteListingImage"
+	ListingsService_AddListingImage_FullMethodName              = "/listingssvc.v1.ListingsService/AddListingImage"
+	ListingsService_GetListingImages_FullMethodName             = "/listingssvc.v1.ListingsService/GetListingImages"
+	ListingsService_ReorderListingImages_FullMethodName         = "/listingssvc.v1.ListingsService/ReorderListingImages"
+	ListingsService_UploadListingImages_FullMethodName          = "/listingssvc.v1.ListingsService/UploadListingImages"
+	ListingsService_RequestImageUploadURLs_FullMethodName       = "/listingssvc.v1.ListingsService/RequestImageUploadURLs"
+	ListingsService_ConfirmImageUpload_FullMethodName           = "/listingssvc.v1.ListingsService/ConfirmImageUpload"
+	ListingsService_GetRootCategories_FullMethodName            = "/listingssvc.v1.ListingsService/GetRootCategories"
+	ListingsService_GetAllCategories_FullMethodName             = "/listingssvc.v1.ListingsService/GetAllCategories"
+	ListingsService_GetPopularCategories_FullMethodName         = "/listingssvc.v1.ListingsService/GetPopularCategories"
+	ListingsService_GetCategory_FullMethodName                  = "/listingssvc.v1.ListingsService/GetCategory"
+	ListingsService_GetCategoryTree_FullMethodName              = "/listingssvc.v1.ListingsService/GetCategoryTree"
+	ListingsService_GetFavoritedUsers_FullMethodName            = "/listingssvc.v1.ListingsService/GetFavoritedUsers"
+	ListingsService_AddToFavorites_FullMethodName               = "/listingssvc.v1.ListingsService/AddToFavorites"
+	ListingsService_RemoveFromFavorites_FullMethodName          = "/listingssvc.v1.ListingsService/RemoveFromFavorites"
+	ListingsService_GetUserFavorites_FullMethodName             = "/listingssvc.v1.ListingsService/GetUserFavorites"
+	ListingsService_IsFavorite_FullMethodName                   = "/listingssvc.v1.ListingsService/IsFavorite"
+	ListingsService_GetStorefront_FullMethodName                = "/listingssvc.v1.ListingsService/GetStorefront"
+	ListingsService_GetStorefrontBySlug_FullMethodName          = "/listingssvc.v1.ListingsService/GetStorefrontBySlug"
+	ListingsService_ListStorefronts_FullMethodName              = "/listingssvc.v1.ListingsService/ListStorefronts"
+	ListingsService_CreateVariants_FullMethodName               = "/listingssvc.v1.ListingsService/CreateVariants"
+	ListingsService_GetVariants_FullMethodName                  = "/listingssvc.v1.ListingsService/GetVariants"
+	ListingsService_UpdateVariant_FullMethodName                = "/listingssvc.v1.ListingsService/UpdateVariant"
+	ListingsService_DeleteVariant_FullMethodName                = "/listingssvc.v1.ListingsService/DeleteVariant"
+	ListingsService_GetListingsForReindex_FullMethodName        = "/listingssvc.v1.ListingsService/GetListingsForReindex"
+	ListingsService_ResetReindexFlags_FullMethodName            = "/listingssvc.v1.ListingsService/ResetReindexFlags"
+	ListingsService_SyncDiscounts_FullMethodName                = "/listingssvc.v1.ListingsService/SyncDiscounts"
+	ListingsService_GetProduct_FullMethodName                   = "/listingssvc.v1.ListingsService/GetProduct"
+	ListingsService_GetProductsBySKUs_FullMethodName            = "/listingssvc.v1.ListingsService/GetProductsBySKUs"
+	ListingsService_GetProductsByIDs_FullMethodName             = "/listingssvc.v1.ListingsService/GetProductsByIDs"
+	ListingsService_ListProducts_FullMethodName                 = "/listingssvc.v1.ListingsService/ListProducts"
+	ListingsService_GetVariant_FullMethodName                   = "/listingssvc.v1.ListingsService/GetVariant"
+	ListingsService_GetVariantsByProductID_FullMethodName       = "/listingssvc.v1.ListingsService/GetVariantsByProductID"
+	ListingsService_DecrementStock_FullMethodName               = "/listingssvc.v1.ListingsService/DecrementStock"
+	ListingsService_RollbackStock_FullMethodName                = "/listingssvc.v1.ListingsService/RollbackStock"
+	ListingsService_CheckStockAvailability_FullMethodName       = "/listingssvc.v1.ListingsService/CheckStockAvailability"
+	ListingsService_CreateProduct_FullMethodName                = "/listingssvc.v1.ListingsService/CreateProduct"
+	ListingsService_UpdateProduct_FullMethodName                = "/listingssvc.v1.ListingsService/UpdateProduct"
+	ListingsService_DeleteProduct_FullMethodName                = "/listingssvc.v1.ListingsService/DeleteProduct"
+	ListingsService_BulkCreateProducts_FullMethodName           = "/listingssvc.v1.ListingsService/BulkCreateProducts"
+	ListingsService_BulkUpdateProducts_FullMethodName           = "/listingssvc.v1.ListingsService/BulkUpdateProducts"
+	ListingsService_BulkDeleteProducts_FullMethodName           = "/listingssvc.v1.ListingsService/BulkDeleteProducts"
+	ListingsService_CreateProductVariant_FullMethodName         = "/listingssvc.v1.ListingsService/CreateProductVariant"
+	ListingsService_UpdateProductVariant_FullMethodName         = "/listingssvc.v1.ListingsService/UpdateProductVariant"
+	ListingsService_DeleteProductVariant_FullMethodName         = "/listingssvc.v1.ListingsService/DeleteProductVariant"
+	ListingsService_BulkCreateProductVariants_FullMethodName    = "/listingssvc.v1.ListingsService/BulkCreateProductVariants"
+	ListingsService_RecordInventoryMovement_FullMethodName      = "/listingssvc.v1.ListingsService/RecordInventoryMovement"
+	ListingsService_BatchUpdateStock_FullMethodName             = "/listingssvc.v1.ListingsService/BatchUpdateStock"
+	ListingsService_GetProductStats_FullMethodName              = "/listingssvc.v1.ListingsService/GetProductStats"
+	ListingsService_IncrementProductViews_FullMethodName        = "/listingssvc.v1.ListingsService/IncrementProductViews"
+	ListingsService_GetRecentlyViewed_FullMethodName            = "/listingssvc.v1.ListingsService/GetRecentlyViewed"
+	ListingsService_ClearRecentlyViewed_FullMethodName          = "/listingssvc.v1.ListingsService/ClearRecentlyViewed"
+	ListingsService_AddProductImage_FullMethodName              = "/listingssvc.v1.ListingsService/AddProductImage"
+	ListingsService_GetProductImages_FullMethodName             = "/listingssvc.v1.ListingsService/GetProductImages"
+	ListingsService_DeleteProductImage_FullMethodName           = "/listingssvc.v1.ListingsService/DeleteProductImage"
+	ListingsService_ReorderProductImages_FullMethodName         = "/listingssvc.v1.ListingsService/ReorderProductImages"
+	ListingsService_ReindexAll_FullMethodName                   = "/listingssvc.v1.ListingsService/ReindexAll"
+	ListingsService_CreateStorefront_FullMethodName             = "/listingssvc.v1.ListingsService/CreateStorefront"
+	ListingsService_UpdateStorefront_FullMethodName             = "/listingssvc.v1.ListingsService/UpdateStorefront"
+	ListingsService_DeleteStorefront_FullMethodName             = "/listingssvc.v1.ListingsService/DeleteStorefront"
+	ListingsService_GetMyStorefronts_FullMethodName             = "/listingssvc.v1.ListingsService/GetMyStorefronts"
+	ListingsService_UploadStorefrontImage_FullMethodName        = "/listingssvc.v1.ListingsService/UploadStorefrontImage"
+	ListingsService_AddStaff_FullMethodName                     = "/listingssvc.v1.ListingsService/AddStaff"
+	ListingsService_UpdateStaff_FullMethodName                  = "/listingssvc.v1.ListingsService/UpdateStaff"
+	ListingsService_RemoveStaff_FullMethodName                  = "/listingssvc.v1.ListingsService/RemoveStaff"
+	ListingsService_GetStaff_FullMethodName                     = "/listingssvc.v1.ListingsService/GetStaff"
+	ListingsService_InviteStaff_FullMethodName                  = "/listingssvc.v1.ListingsService/InviteStaff"
+	ListingsService_AcceptStaffInvitation_FullMethodName        = "/listingssvc.v1.ListingsService/AcceptStaffInvitation"
+	ListingsService_DeclineStaffInvitation_FullMethodName       = "/listingssvc.v1.ListingsService/DeclineStaffInvitation"
+	ListingsService_RevokeStaffInvitation_FullMethodName        = "/listingssvc.v1.ListingsService/RevokeStaffInvitation"
+	ListingsService_ListStaffInvitations_FullMethodName         = "/listingssvc.v1.ListingsService/ListStaffInvitations"
+	ListingsService_FollowStorefront_FullMethodName             = "/listingssvc.v1.ListingsService/FollowStorefront"
+	ListingsService_UnfollowStorefront_FullMethodName           = "/listingssvc.v1.ListingsService/UnfollowStorefront"
+	ListingsService_GetStorefrontFollowers_FullMethodName       = "/listingssvc.v1.ListingsService/GetStorefrontFollowers"
+	ListingsService_GetFollowingFeed_FullMethodName             = "/listingssvc.v1.ListingsService/GetFollowingFeed"
+	ListingsService_SetWorkingHours_FullMethodName              = "/listingssvc.v1.ListingsService/SetWorkingHours"
+	ListingsService_GetWorkingHours_FullMethodName              = "/listingssvc.v1.ListingsService/GetWorkingHours"
+	ListingsService_IsOpenNow_FullMethodName                    = "/listingssvc.v1.ListingsService/IsOpenNow"
+	ListingsService_SetVacationMode_FullMethodName              = "/listingssvc.v1.ListingsService/SetVacationMode"
+	ListingsService_SetHolidays_FullMethodName                  = "/listingssvc.v1.ListingsService/SetHolidays"
+	ListingsService_GetHolidays_FullMethodName                  = "/listingssvc.v1.ListingsService/GetHolidays"
+	ListingsService_AddStorefrontDomain_FullMethodName          = "/listingssvc.v1.ListingsService/AddStorefrontDomain"
+	ListingsService_VerifyStorefrontDomain_FullMethodName       = "/listingssvc.v1.ListingsService/VerifyStorefrontDomain"
+	ListingsService_RemoveStorefrontDomain_FullMethodName       = "/listingssvc.v1.ListingsService/RemoveStorefrontDomain"
+	ListingsService_ListStorefrontDomains_FullMethodName        = "/listingssvc.v1.ListingsService/ListStorefrontDomains"
+	ListingsService_ResolveStorefrontDomain_FullMethodName      = "/listingssvc.v1.ListingsService/ResolveStorefrontDomain"
+	ListingsService_SubmitStorefrontVerification_FullMethodName = "/listingssvc.v1.ListingsService/SubmitStorefrontVerification"
+	ListingsService_GetStorefrontVerification_FullMethodName    = "/listingssvc.v1.ListingsService/GetStorefrontVerification"
+	ListingsService_CancelStorefrontVerification_FullMethodName = "/listingssvc.v1.ListingsService/CancelStorefrontVerification"
+	ListingsService_ListVerificationRequests_FullMethodName     = "/listingssvc.v1.ListingsService/ListVerificationRequests"
+	ListingsService_ReviewStorefrontVerification_FullMethodName = "/listingssvc.v1.ListingsService/ReviewStorefrontVerification"
+	ListingsService_RevokeStorefrontVerification_FullMethodName = "/listingssvc.v1.ListingsService/RevokeStorefrontVerification"
+	ListingsService_SetPaymentMethods_FullMethodName            = "/listingssvc.v1.ListingsService/SetPaymentMethods"
+	ListingsService_GetPaymentMethods_FullMethodName            = "/listingssvc.v1.ListingsService/GetPaymentMethods"
+	ListingsService_SetDeliveryOptions_FullMethodName           = "/listingssvc.v1.ListingsService/SetDeliveryOptions"
+	ListingsService_GetDeliveryOptions_FullMethodName           = "/listingssvc.v1.ListingsService/GetDeliveryOptions"
+	ListingsService_GetMapData_FullMethodName                   = "/listingssvc.v1.ListingsService/GetMapData"
+	ListingsService_GetDashboardStats_FullMethodName            = "/listingssvc.v1.ListingsService/GetDashboardStats"
+	ListingsService_GetPriceHistory_FullMethodName              = "/listingssvc.v1.ListingsService/GetPriceHistory"
+	ListingsService_SubmitListingForReview_FullMethodName       = "/listingssvc.v1.ListingsService/SubmitListingForReview"
+	ListingsService_GetModerationQueue_FullMethodName           = "/listingssvc.v1.ListingsService/GetModerationQueue"
+	ListingsService_ModerateListing_FullMethodName              = "/listingssvc.v1.ListingsService/ModerateListing"
+	ListingsService_ListDuplicateFlags_FullMethodName           = "/listingssvc.v1.ListingsService/ListDuplicateFlags"
+	ListingsService_CreateReview_FullMethodName                 = "/listingssvc.v1.ListingsService/CreateReview"
+	ListingsService_GetReview_FullMethodName                    = "/listingssvc.v1.ListingsService/GetReview"
+	ListingsService_ListReviews_FullMethodName                  = "/listingssvc.v1.ListingsService/ListReviews"
+	ListingsService_UpdateReview_FullMethodName                 = "/listingssvc.v1.ListingsService/UpdateReview"
+	ListingsService_DeleteReview_FullMethodName                 = "/listingssvc.v1.ListingsService/DeleteReview"
+	ListingsService_GetReviewSummary_FullMethodName             = "/listingssvc.v1.ListingsService/GetReviewSummary"
+	ListingsService_ReplyToReview_FullMethodName                = "/listingssvc.v1.ListingsService/ReplyToReview"
+	ListingsService_VoteReview_FullMethodName                   = "/listingssvc.v1.ListingsService/VoteReview"
+	ListingsService_ReportReview_FullMethodName                 = "/listingssvc.v1.ListingsService/ReportReview"
+	ListingsService_ListReviewReports_FullMethodName            = "/listingssvc.v1.ListingsService/ListReviewReports"
+	ListingsService_ModerateReview_FullMethodName               = "/listingssvc.v1.ListingsService/ModerateReview"
 )
 
 // ListingsServiceClient is the client API for ListingsService service.
@@ -120,6 +167,13 @@ type ListingsServiceClient interface {
 	ListListings(ctx context.Context, in *ListListingsRequest, opts ...grpc.CallOption) (*ListListingsResponse, error)
 	// GetSimilarListings retrieves similar listings based on category and price
 	GetSimilarListings(ctx context.Context, in *GetSimilarListingsRequest, opts ...grpc.CallOption) (*GetSimilarListingsResponse, error)
+	// GetRecommendationsForUser recommends listings based on the caller's views, favorites
+	// and orders (anonymous callers by session_id), falling back to trending listings
+	GetRecommendationsForUser(ctx context.Context, in *GetRecommendationsForUserRequest, opts ...grpc.CallOption) (*GetRecommendationsForUserResponse, error)
+	// GetFrequentlyBoughtTogether recommends listings bought together with a listing
+	GetFrequentlyBoughtTogether(ctx context.Context, in *GetFrequentlyBoughtTogetherRequest, opts ...grpc.CallOption) (*GetFrequentlyBoughtTogetherResponse, error)
+	// RenewListing extends the lifetime of a C2C listing (reactivates expired listings)
+	RenewListing(ctx context.Context, in *RenewListingRequest, opts ...grpc.CallOption) (*RenewListingResponse, error)
 	// GetListingImage retrieves a single image by ID
 	GetListingImage(ctx context.Context, in *ImageIDRequest, opts ...grpc.CallOption) (*ImageResponse, error)
 	// DeleteListingImage removes an image from a listing (with authorization)
@@ -134,6 +188,12 @@ type ListingsServiceClient interface {
 	// Client streams: metadata first, then chunks for each image
 	// Server returns: uploaded images with URLs + errors for failed uploads
 	UploadListingImages(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadImageChunkRequest, UploadImagesResponse], error)
+	// RequestImageUploadURLs returns presigned PUT URLs so that clients upload images
+	// directly to object storage (listing or, with storefront_id, product images)
+	RequestImageUploadURLs(ctx context.Context, in *RequestImageUploadURLsRequest, opts ...grpc.CallOption) (*RequestImageUploadURLsResponse, error)
+	// ConfirmImageUpload verifies a directly uploaded object and registers the image.
+	// Thumbnail and renditions are generated by the image processing worker.
+	ConfirmImageUpload(ctx context.Context, in *ConfirmImageUploadRequest, opts ...grpc.CallOption) (*ImageResponse, error)
 	// GetRootCategories retrieves all top-level categories (no parent)
 	GetRootCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoriesResponse, error)
 	// GetAllCategories retrieves all categories in the system
@@ -236,6 +296,11 @@ type ListingsServiceClient interface {
 	// IncrementProductViews increments view counter for analytics
 	// Used when customer views product detail page
 	IncrementProductViews(ctx context.Context, in *IncrementProductViewsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetRecentlyViewed returns the caller's recently viewed listings, most recent first
+	// (anonymous callers by session_id; a session's list is merged into the user's after login)
+	GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedRequest, opts ...grpc.CallOption) (*GetRecentlyViewedResponse, error)
+	// ClearRecentlyViewed removes one listing or the whole recently viewed history of the caller
+	ClearRecentlyViewed(ctx context.Context, in *ClearRecentlyViewedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// AddProductImage adds a new image to a B2C product
 	AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error)
 	// GetProductImages retrieves all images for a B2C product
@@ -255,20 +320,69 @@ type ListingsServiceClient interface {
 	DeleteStorefront(ctx context.Context, in *DeleteStorefrontRequest, opts ...grpc.CallOption) (*DeleteStorefrontResponse, error)
 	// GetMyStorefronts retrieves storefronts owned by user
 	GetMyStorefronts(ctx context.Context, in *ListStorefrontsRequest, opts ...grpc.CallOption) (*ListStorefrontsResponse, error)
-	// AddStaff adds a new staff member to storefront
+	// UploadStorefrontImage uploads and crops the storefront logo or banner
+	UploadStorefrontImage(ctx context.Context, in *UploadStorefrontImageRequest, opts ...grpc.CallOption) (*StorefrontFull, error)
+	// Deprecated: Do not use.
+	// AddStaff is replaced by invitations (InviteStaff); always fails with FAILED_PRECONDITION
 	AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*StorefrontStaff, error)
-	// UpdateStaff updates staff member role/permissions
+	// UpdateStaff updates staff member role/permissions (requires staff.manage)
 	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*StorefrontStaff, error)
-	// RemoveStaff removes a staff member from storefront
+	// RemoveStaff removes a staff member from storefront (requires staff.manage, or leaving)
 	RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*DeleteStorefrontResponse, error)
-	// GetStaff retrieves all staff members for storefront
+	// GetStaff retrieves all staff members for storefront (requires staff.manage)
 	GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error)
+	// InviteStaff invites a user to the storefront staff (requires staff.manage)
+	InviteStaff(ctx context.Context, in *InviteStaffRequest, opts ...grpc.CallOption) (*StaffInvitation, error)
+	// AcceptStaffInvitation accepts an invitation; the invited user becomes a staff member
+	AcceptStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StorefrontStaff, error)
+	// DeclineStaffInvitation declines an invitation
+	DeclineStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StaffInvitation, error)
+	// RevokeStaffInvitation withdraws a pending invitation (requires staff.manage)
+	RevokeStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StaffInvitation, error)
+	// ListStaffInvitations lists a storefront's invitations or the caller's own
+	ListStaffInvitations(ctx context.Context, in *ListStaffInvitationsRequest, opts ...grpc.CallOption) (*ListStaffInvitationsResponse, error)
+	// FollowStorefront makes the caller follow a storefront (idempotent)
+	FollowStorefront(ctx context.Context, in *FollowStorefrontRequest, opts ...grpc.CallOption) (*FollowStorefrontResponse, error)
+	// UnfollowStorefront removes the caller's follow (idempotent)
+	UnfollowStorefront(ctx context.Context, in *FollowStorefrontRequest, opts ...grpc.CallOption) (*FollowStorefrontResponse, error)
+	// GetStorefrontFollowers lists a storefront's followers (requires analytics.read)
+	GetStorefrontFollowers(ctx context.Context, in *GetStorefrontFollowersRequest, opts ...grpc.CallOption) (*GetStorefrontFollowersResponse, error)
+	// GetFollowingFeed returns new products from the storefronts the caller follows, newest first
+	GetFollowingFeed(ctx context.Context, in *GetFollowingFeedRequest, opts ...grpc.CallOption) (*GetFollowingFeedResponse, error)
 	// SetWorkingHours sets operating hours for storefront
 	SetWorkingHours(ctx context.Context, in *SetWorkingHoursRequest, opts ...grpc.CallOption) (*GetWorkingHoursResponse, error)
 	// GetWorkingHours retrieves operating hours
 	GetWorkingHours(ctx context.Context, in *GetWorkingHoursRequest, opts ...grpc.CallOption) (*GetWorkingHoursResponse, error)
-	// IsOpenNow checks if storefront is currently open
+	// IsOpenNow checks if storefront is currently open, in the storefront's timezone
 	IsOpenNow(ctx context.Context, in *IsOpenNowRequest, opts ...grpc.CallOption) (*IsOpenNowResponse, error)
+	// SetVacationMode turns vacation mode on or off (requires settings.write)
+	SetVacationMode(ctx context.Context, in *SetVacationModeRequest, opts ...grpc.CallOption) (*StorefrontFull, error)
+	// SetHolidays replaces the storefront's holiday calendar (requires settings.write)
+	SetHolidays(ctx context.Context, in *SetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error)
+	// GetHolidays retrieves the storefront's holiday calendar
+	GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error)
+	// AddStorefrontDomain registers a custom domain; it resolves after DNS verification (requires settings.write)
+	AddStorefrontDomain(ctx context.Context, in *AddStorefrontDomainRequest, opts ...grpc.CallOption) (*StorefrontDomain, error)
+	// VerifyStorefrontDomain checks the domain's verification TXT record (requires settings.write)
+	VerifyStorefrontDomain(ctx context.Context, in *StorefrontDomainRequest, opts ...grpc.CallOption) (*StorefrontDomain, error)
+	// RemoveStorefrontDomain removes a custom domain (requires settings.write)
+	RemoveStorefrontDomain(ctx context.Context, in *StorefrontDomainRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListStorefrontDomains lists the storefront's custom domains (requires settings.write)
+	ListStorefrontDomains(ctx context.Context, in *ListStorefrontDomainsRequest, opts ...grpc.CallOption) (*ListStorefrontDomainsResponse, error)
+	// ResolveStorefrontDomain maps a verified custom domain (e.g. shop.example.rs) to its storefront
+	ResolveStorefrontDomain(ctx context.Context, in *ResolveStorefrontDomainRequest, opts ...grpc.CallOption) (*ResolveStorefrontDomainResponse, error)
+	// SubmitStorefrontVerification submits business documents for storefront verification (requires settings.write)
+	SubmitStorefrontVerification(ctx context.Context, in *SubmitStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error)
+	// GetStorefrontVerification returns the verification status, latest request and audit history (requires settings.write)
+	GetStorefrontVerification(ctx context.Context, in *GetStorefrontVerificationRequest, opts ...grpc.CallOption) (*GetStorefrontVerificationResponse, error)
+	// CancelStorefrontVerification withdraws the pending verification request (requires settings.write)
+	CancelStorefrontVerification(ctx context.Context, in *GetStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error)
+	// ListVerificationRequests returns verification requests by status, oldest first (admin only)
+	ListVerificationRequests(ctx context.Context, in *ListVerificationRequestsRequest, opts ...grpc.CallOption) (*ListVerificationRequestsResponse, error)
+	// ReviewStorefrontVerification approves or rejects a pending verification request (admin only)
+	ReviewStorefrontVerification(ctx context.Context, in *ReviewStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error)
+	// RevokeStorefrontVerification removes the verified status of a storefront (admin only)
+	RevokeStorefrontVerification(ctx context.Context, in *RevokeStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontFull, error)
 	// SetPaymentMethods configures payment methods for storefront
 	SetPaymentMethods(ctx context.Context, in *SetPaymentMethodsRequest, opts ...grpc.CallOption) (*GetPaymentMethodsResponse, error)
 	// GetPaymentMethods retrieves payment methods
@@ -281,6 +395,38 @@ type ListingsServiceClient interface {
 	GetMapData(ctx context.Context, in *GetMapDataRequest, opts ...grpc.CallOption) (*GetMapDataResponse, error)
 	// GetDashboardStats retrieves storefront dashboard statistics
 	GetDashboardStats(ctx context.Context, in *DashboardStatsRequest, opts ...grpc.CallOption) (*DashboardStatsResponse, error)
+	// GetPriceHistory retrieves price changes of a listing/product (optionally a single variant)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// SubmitListingForReview moves a draft listing to the moderation queue (optionally with a publish time)
+	SubmitListingForReview(ctx context.Context, in *SubmitListingForReviewRequest, opts ...grpc.CallOption) (*SubmitListingForReviewResponse, error)
+	// GetModerationQueue returns listings awaiting review, oldest first (admin only)
+	GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error)
+	// ModerateListing approves or rejects a listing awaiting review (admin only)
+	ModerateListing(ctx context.Context, in *ModerateListingRequest, opts ...grpc.CallOption) (*ModerateListingResponse, error)
+	// ListDuplicateFlags returns listings flagged as possible duplicates, newest first (admin only)
+	ListDuplicateFlags(ctx context.Context, in *ListDuplicateFlagsRequest, opts ...grpc.CallOption) (*ListDuplicateFlagsResponse, error)
+	// CreateReview rates a storefront product or storefront (one review per user and subject)
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// GetReview retrieves a review
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// ListReviews lists the published reviews of a subject with its rating summary
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// UpdateReview changes the rating or text of the caller's review
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// DeleteReview deletes the caller's review (or any review, admin only)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	// GetReviewSummary returns the average rating and star distribution of a subject
+	GetReviewSummary(ctx context.Context, in *GetReviewSummaryRequest, opts ...grpc.CallOption) (*ReviewSummary, error)
+	// ReplyToReview sets the seller reply of a review (requires reviews.reply)
+	ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// VoteReview records whether the caller found a review helpful
+	VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// ReportReview reports an abusive review; reviews with enough reports are hidden
+	ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*ReportReviewResponse, error)
+	// ListReviewReports returns abuse reports, oldest first (admin only)
+	ListReviewReports(ctx context.Context, in *ListReviewReportsRequest, opts ...grpc.CallOption) (*ListReviewReportsResponse, error)
+	// ModerateReview hides or restores a review and resolves its reports (admin only)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
 }
 
 type listingsServiceClient struct {
@@ -361,6 +507,36 @@ func (c *listingsServiceClient) GetSimilarListings(ctx context.Context, in *GetS
 	return out, nil
 }
 
+func (c *listingsServiceClient) GetRecommendationsForUser(ctx context.Context, in *GetRecommendationsForUserRequest, opts ...grpc.CallOption) (*GetRecommendationsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendationsForUserResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetRecommendationsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetFrequentlyBoughtTogether(ctx context.Context, in *GetFrequentlyBoughtTogetherRequest, opts ...grpc.CallOption) (*GetFrequentlyBoughtTogetherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFrequentlyBoughtTogetherResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetFrequentlyBoughtTogether_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RenewListing(ctx context.Context, in *RenewListingRequest, opts ...grpc.CallOption) (*RenewListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_RenewListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingsServiceClient) GetListingImage(ctx context.Context, in *ImageIDRequest, opts ...grpc.CallOption) (*ImageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ImageResponse)
@@ -424,6 +600,26 @@ func (c *listingsServiceClient) UploadListingImages(ctx context.Context, opts ..
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ListingsService_UploadListingImagesClient = grpc.ClientStreamingClient[UploadImageChunkRequest, UploadImagesResponse]
 
+func (c *listingsServiceClient) RequestImageUploadURLs(ctx context.Context, in *RequestImageUploadURLsRequest, opts ...grpc.CallOption) (*RequestImageUploadURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestImageUploadURLsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_RequestImageUploadURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ConfirmImageUpload(ctx context.Context, in *ConfirmImageUploadRequest, opts ...grpc.CallOption) (*ImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ConfirmImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingsServiceClient) GetRootCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CategoriesResponse)
@@ -854,6 +1050,26 @@ func (c *listingsServiceClient) IncrementProductViews(ctx context.Context, in *I
 	return out, nil
 }
 
+func (c *listingsServiceClient) GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedRequest, opts ...grpc.CallOption) (*GetRecentlyViewedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentlyViewedResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetRecentlyViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ClearRecentlyViewed(ctx context.Context, in *ClearRecentlyViewedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ListingsService_ClearRecentlyViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingsServiceClient) AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProductImageResponse)
@@ -944,6 +1160,17 @@ func (c *listingsServiceClient) GetMyStorefronts(ctx context.Context, in *ListSt
 	return out, nil
 }
 
+func (c *listingsServiceClient) UploadStorefrontImage(ctx context.Context, in *UploadStorefrontImageRequest, opts ...grpc.CallOption) (*StorefrontFull, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontFull)
+	err := c.cc.Invoke(ctx, ListingsService_UploadStorefrontImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *listingsServiceClient) AddStaff(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*StorefrontStaff, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StorefrontStaff)
@@ -984,6 +1211,96 @@ func (c *listingsServiceClient) GetStaff(ctx context.Context, in *GetStaffReques
 	return out, nil
 }
 
+func (c *listingsServiceClient) InviteStaff(ctx context.Context, in *InviteStaffRequest, opts ...grpc.CallOption) (*StaffInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StaffInvitation)
+	err := c.cc.Invoke(ctx, ListingsService_InviteStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) AcceptStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StorefrontStaff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontStaff)
+	err := c.cc.Invoke(ctx, ListingsService_AcceptStaffInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) DeclineStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StaffInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StaffInvitation)
+	err := c.cc.Invoke(ctx, ListingsService_DeclineStaffInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RevokeStaffInvitation(ctx context.Context, in *StaffInvitationActionRequest, opts ...grpc.CallOption) (*StaffInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StaffInvitation)
+	err := c.cc.Invoke(ctx, ListingsService_RevokeStaffInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListStaffInvitations(ctx context.Context, in *ListStaffInvitationsRequest, opts ...grpc.CallOption) (*ListStaffInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffInvitationsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListStaffInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) FollowStorefront(ctx context.Context, in *FollowStorefrontRequest, opts ...grpc.CallOption) (*FollowStorefrontResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowStorefrontResponse)
+	err := c.cc.Invoke(ctx, ListingsService_FollowStorefront_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) UnfollowStorefront(ctx context.Context, in *FollowStorefrontRequest, opts ...grpc.CallOption) (*FollowStorefrontResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowStorefrontResponse)
+	err := c.cc.Invoke(ctx, ListingsService_UnfollowStorefront_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetStorefrontFollowers(ctx context.Context, in *GetStorefrontFollowersRequest, opts ...grpc.CallOption) (*GetStorefrontFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorefrontFollowersResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetStorefrontFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetFollowingFeed(ctx context.Context, in *GetFollowingFeedRequest, opts ...grpc.CallOption) (*GetFollowingFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowingFeedResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetFollowingFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingsServiceClient) SetWorkingHours(ctx context.Context, in *SetWorkingHoursRequest, opts ...grpc.CallOption) (*GetWorkingHoursResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWorkingHoursResponse)
@@ -1014,6 +1331,146 @@ func (c *listingsServiceClient) IsOpenNow(ctx context.Context, in *IsOpenNowRequ
 	return out, nil
 }
 
+func (c *listingsServiceClient) SetVacationMode(ctx context.Context, in *SetVacationModeRequest, opts ...grpc.CallOption) (*StorefrontFull, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontFull)
+	err := c.cc.Invoke(ctx, ListingsService_SetVacationMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) SetHolidays(ctx context.Context, in *SetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolidaysResponse)
+	err := c.cc.Invoke(ctx, ListingsService_SetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolidaysResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) AddStorefrontDomain(ctx context.Context, in *AddStorefrontDomainRequest, opts ...grpc.CallOption) (*StorefrontDomain, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontDomain)
+	err := c.cc.Invoke(ctx, ListingsService_AddStorefrontDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) VerifyStorefrontDomain(ctx context.Context, in *StorefrontDomainRequest, opts ...grpc.CallOption) (*StorefrontDomain, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontDomain)
+	err := c.cc.Invoke(ctx, ListingsService_VerifyStorefrontDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RemoveStorefrontDomain(ctx context.Context, in *StorefrontDomainRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ListingsService_RemoveStorefrontDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListStorefrontDomains(ctx context.Context, in *ListStorefrontDomainsRequest, opts ...grpc.CallOption) (*ListStorefrontDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStorefrontDomainsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListStorefrontDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ResolveStorefrontDomain(ctx context.Context, in *ResolveStorefrontDomainRequest, opts ...grpc.CallOption) (*ResolveStorefrontDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveStorefrontDomainResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ResolveStorefrontDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) SubmitStorefrontVerification(ctx context.Context, in *SubmitStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontVerification)
+	err := c.cc.Invoke(ctx, ListingsService_SubmitStorefrontVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetStorefrontVerification(ctx context.Context, in *GetStorefrontVerificationRequest, opts ...grpc.CallOption) (*GetStorefrontVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorefrontVerificationResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetStorefrontVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) CancelStorefrontVerification(ctx context.Context, in *GetStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontVerification)
+	err := c.cc.Invoke(ctx, ListingsService_CancelStorefrontVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListVerificationRequests(ctx context.Context, in *ListVerificationRequestsRequest, opts ...grpc.CallOption) (*ListVerificationRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVerificationRequestsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListVerificationRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ReviewStorefrontVerification(ctx context.Context, in *ReviewStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontVerification)
+	err := c.cc.Invoke(ctx, ListingsService_ReviewStorefrontVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) RevokeStorefrontVerification(ctx context.Context, in *RevokeStorefrontVerificationRequest, opts ...grpc.CallOption) (*StorefrontFull, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontFull)
+	err := c.cc.Invoke(ctx, ListingsService_RevokeStorefrontVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingsServiceClient) SetPaymentMethods(ctx context.Context, in *SetPaymentMethodsRequest, opts ...grpc.CallOption) (*GetPaymentMethodsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPaymentMethodsResponse)
@@ -1074,40 +1531,213 @@ func (c *listingsServiceClient) GetDashboardStats(ctx context.Context, in *Dashb
 	return out, nil
 }
 
-// ListingsServiceServer is the server API for ListingsService service.
-// All implementations must embed UnimplementedListingsServiceServer
-// for forward compatibility.
-//
-// ListingsService provides RPC methods for managing marketplace listings
-type ListingsServiceServer interface {
-	// GetListing retrieves a single listing by ID
-	GetListing(context.Context, *GetListingRequest) (*GetListingResponse, error)
-	// CreateListing creates a new listing
-	CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error)
-	// UpdateListing updates an existing listing
-	UpdateListing(context.Context, *UpdateListingRequest) (*UpdateListingResponse, error)
-	// DeleteListing soft-deletes a listing
-	DeleteListing(context.Context, *DeleteListingRequest) (*DeleteListingResponse, error)
-	// SearchListings performs full-text search on listings
-	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
-	// ListListings returns a paginated list of listings
-	ListListings(context.Context, *ListListingsRequest) (*ListListingsResponse, error)
-	// GetSimilarListings retrieves similar listings based on category and price
-	GetSimilarListings(context.Context, *GetSimilarListingsRequest) (*GetSimilarListingsResponse, error)
-	// GetListingImage retrieves a single image by ID
-	GetListingImage(context.Context, *ImageIDRequest) (*ImageResponse, error)
-	// DeleteListingImage removes an image from a listing (with authorization)
-	DeleteListingImage(context.Context, *DeleteListingImageRequest) (*DeleteListingImageResponse, error)
-	// AddListingImage adds a new image to a listing
-	AddListingImage(context.Context, *AddImageRequest) (*ImageResponse, error)
-	// GetListingImages retrieves all images for a listing
-	GetListingImages(context.Context, *ListingIDRequest) (*ImagesResponse, error)
-	// ReorderListingImages updates display order for multiple images
-	ReorderListingImages(context.Context, *ReorderImagesRequest) (*ReorderImagesResponse, error)
-	// UploadListingImages uploads images via streaming (Phase 24 - TRUE MICROSERVICE)
-	// Client streams: metadata first, then chunks for each image
+func (c *listingsServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) SubmitListingForReview(ctx context.Context, in *SubmitListingForReviewRequest, opts ...grpc.CallOption) (*SubmitListingForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitListingForReviewResponse)
+	err := c.cc.Invoke(ctx, ListingsService_SubmitListingForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModerationQueueResponse)
+	err := c.cc.Invoke(ctx, ListingsService_GetModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ModerateListing(ctx context.Context, in *ModerateListingRequest, opts ...grpc.CallOption) (*ModerateListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateListingResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ModerateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListDuplicateFlags(ctx context.Context, in *ListDuplicateFlagsRequest, opts ...grpc.CallOption) (*ListDuplicateFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateFlagsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListDuplicateFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, ListingsService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) GetReviewSummary(ctx context.Context, in *GetReviewSummaryRequest, opts ...grpc.CallOption) (*ReviewSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewSummary)
+	err := c.cc.Invoke(ctx, ListingsService_GetReviewSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_ReplyToReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_VoteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*ReportReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportReviewResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ReportReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ListReviewReports(ctx context.Context, in *ListReviewReportsRequest, opts ...grpc.CallOption) (*ListReviewReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewReportsResponse)
+	err := c.cc.Invoke(ctx, ListingsService_ListReviewReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingsServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ListingsService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListingsServiceServer is the server API for ListingsService service.
+// All implementations must embed UnimplementedListingsServiceServer
+// for forward compatibility.
+//
+// ListingsService provides RPC methods for managing marketplace listings
+type ListingsServiceServer interface {
+	// GetListing retrieves a single listing by ID
+	GetListing(context.Context, *GetListingRequest) (*GetListingResponse, error)
+	// CreateListing creates a new listing
+	CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error)
+	// UpdateListing updates an existing listing
+	UpdateListing(context.Context, *UpdateListingRequest) (*UpdateListingResponse, error)
+	// DeleteListing soft-deletes a listing
+	DeleteListing(context.Context, *DeleteListingRequest) (*DeleteListingResponse, error)
+	// SearchListings performs full-text search on listings
+	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
+	// ListListings returns a paginated list of listings
+	ListListings(context.Context, *ListListingsRequest) (*ListListingsResponse, error)
+	// GetSimilarListings retrieves similar listings based on category and price
+	GetSimilarListings(context.Context, *GetSimilarListingsRequest) (*GetSimilarListingsResponse, error)
+	// GetRecommendationsForUser recommends listings based on the caller's views, favorites
+	// and orders (anonymous callers by session_id), falling back to trending listings
+	GetRecommendationsForUser(context.Context, *GetRecommendationsForUserRequest) (*GetRecommendationsForUserResponse, error)
+	// GetFrequentlyBoughtTogether recommends listings bought together with a listing
+	GetFrequentlyBoughtTogether(context.Context, *GetFrequentlyBoughtTogetherRequest) (*GetFrequentlyBoughtTogetherResponse, error)
+	// RenewListing extends the lifetime of a C2C listing (reactivates expired listings)
+	RenewListing(context.Context, *RenewListingRequest) (*RenewListingResponse, error)
+	// GetListingImage retrieves a single image by ID
+	GetListingImage(context.Context, *ImageIDRequest) (*ImageResponse, error)
+	// DeleteListingImage removes an image from a listing (with authorization)
+	DeleteListingImage(context.Context, *DeleteListingImageRequest) (*DeleteListingImageResponse, error)
+	// AddListingImage adds a new image to a listing
+	AddListingImage(context.Context, *AddImageRequest) (*ImageResponse, error)
+	// GetListingImages retrieves all images for a listing
+	GetListingImages(context.Context, *ListingIDRequest) (*ImagesResponse, error)
+	// ReorderListingImages updates display order for multiple images
+	ReorderListingImages(context.Context, *ReorderImagesRequest) (*ReorderImagesResponse, error)
+	// UploadListingImages uploads images via streaming (Phase 24 - TRUE MICROSERVICE)
+	// Client streams: metadata first, then chunks for each image
 	// Server returns: uploaded images with URLs + errors for failed uploads
 	UploadListingImages(grpc.ClientStreamingServer[UploadImageChunkRequest, UploadImagesResponse]) error
+	// RequestImageUploadURLs returns presigned PUT URLs so that clients upload images
+	// directly to object storage (listing or, with storefront_id, product images)
+	RequestImageUploadURLs(context.Context, *RequestImageUploadURLsRequest) (*RequestImageUploadURLsResponse, error)
+	// ConfirmImageUpload verifies a directly uploaded object and registers the image.
+	// Thumbnail and renditions are generated by the image processing worker.
+	ConfirmImageUpload(context.Context, *ConfirmImageUploadRequest) (*ImageResponse, error)
 	// GetRootCategories retrieves all top-level categories (no parent)
 	GetRootCategories(context.Context, *emptypb.Empty) (*CategoriesResponse, error)
 	// GetAllCategories retrieves all categories in the system
@@ -1210,6 +1840,11 @@ type ListingsServiceServer interface {
 	// IncrementProductViews increments view counter for analytics
 	// Used when customer views product detail page
 	IncrementProductViews(context.Context, *IncrementProductViewsRequest) (*emptypb.Empty, error)
+	// GetRecentlyViewed returns the caller's recently viewed listings, most recent first
+	// (anonymous callers by session_id; a session's list is merged into the user's after login)
+	GetRecentlyViewed(context.Context, *GetRecentlyViewedRequest) (*GetRecentlyViewedResponse, error)
+	// ClearRecentlyViewed removes one listing or the whole recently viewed history of the caller
+	ClearRecentlyViewed(context.Context, *ClearRecentlyViewedRequest) (*emptypb.Empty, error)
 	// AddProductImage adds a new image to a B2C product
 	AddProductImage(context.Context, *AddProductImageRequest) (*ProductImageResponse, error)
 	// GetProductImages retrieves all images for a B2C product
@@ -1229,20 +1864,69 @@ type ListingsServiceServer interface {
 	DeleteStorefront(context.Context, *DeleteStorefrontRequest) (*DeleteStorefrontResponse, error)
 	// GetMyStorefronts retrieves storefronts owned by user
 	GetMyStorefronts(context.Context, *ListStorefrontsRequest) (*ListStorefrontsResponse, error)
-	// AddStaff adds a new staff member to storefront
+	// UploadStorefrontImage uploads and crops the storefront logo or banner
+	UploadStorefrontImage(context.Context, *UploadStorefrontImageRequest) (*StorefrontFull, error)
+	// Deprecated: Do not use.
+	// AddStaff is replaced by invitations (InviteStaff); always fails with FAILED_PRECONDITION
 	AddStaff(context.Context, *AddStaffRequest) (*StorefrontStaff, error)
-	// UpdateStaff updates staff member role/permissions
+	// UpdateStaff updates staff member role/permissions (requires staff.manage)
 	UpdateStaff(context.Context, *UpdateStaffRequest) (*StorefrontStaff, error)
-	// RemoveStaff removes a staff member from storefront
+	// RemoveStaff removes a staff member from storefront (requires staff.manage, or leaving)
 	RemoveStaff(context.Context, *RemoveStaffRequest) (*DeleteStorefrontResponse, error)
-	// GetStaff retrieves all staff members for storefront
+	// GetStaff retrieves all staff members for storefront (requires staff.manage)
 	GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error)
+	// InviteStaff invites a user to the storefront staff (requires staff.manage)
+	InviteStaff(context.Context, *InviteStaffRequest) (*StaffInvitation, error)
+	// AcceptStaffInvitation accepts an invitation; the invited user becomes a staff member
+	AcceptStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StorefrontStaff, error)
+	// DeclineStaffInvitation declines an invitation
+	DeclineStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StaffInvitation, error)
+	// RevokeStaffInvitation withdraws a pending invitation (requires staff.manage)
+	RevokeStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StaffInvitation, error)
+	// ListStaffInvitations lists a storefront's invitations or the caller's own
+	ListStaffInvitations(context.Context, *ListStaffInvitationsRequest) (*ListStaffInvitationsResponse, error)
+	// FollowStorefront makes the caller follow a storefront (idempotent)
+	FollowStorefront(context.Context, *FollowStorefrontRequest) (*FollowStorefrontResponse, error)
+	// UnfollowStorefront removes the caller's follow (idempotent)
+	UnfollowStorefront(context.Context, *FollowStorefrontRequest) (*FollowStorefrontResponse, error)
+	// GetStorefrontFollowers lists a storefront's followers (requires analytics.read)
+	GetStorefrontFollowers(context.Context, *GetStorefrontFollowersRequest) (*GetStorefrontFollowersResponse, error)
+	// GetFollowingFeed returns new products from the storefronts the caller follows, newest first
+	GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFollowingFeedResponse, error)
 	// SetWorkingHours sets operating hours for storefront
 	SetWorkingHours(context.Context, *SetWorkingHoursRequest) (*GetWorkingHoursResponse, error)
 	// GetWorkingHours retrieves operating hours
 	GetWorkingHours(context.Context, *GetWorkingHoursRequest) (*GetWorkingHoursResponse, error)
-	// IsOpenNow checks if storefront is currently open
+	// IsOpenNow checks if storefront is currently open, in the storefront's timezone
 	IsOpenNow(context.Context, *IsOpenNowRequest) (*IsOpenNowResponse, error)
+	// SetVacationMode turns vacation mode on or off (requires settings.write)
+	SetVacationMode(context.Context, *SetVacationModeRequest) (*StorefrontFull, error)
+	// SetHolidays replaces the storefront's holiday calendar (requires settings.write)
+	SetHolidays(context.Context, *SetHolidaysRequest) (*GetHolidaysResponse, error)
+	// GetHolidays retrieves the storefront's holiday calendar
+	GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error)
+	// AddStorefrontDomain registers a custom domain; it resolves after DNS verification (requires settings.write)
+	AddStorefrontDomain(context.Context, *AddStorefrontDomainRequest) (*StorefrontDomain, error)
+	// VerifyStorefrontDomain checks the domain's verification TXT record (requires settings.write)
+	VerifyStorefrontDomain(context.Context, *StorefrontDomainRequest) (*StorefrontDomain, error)
+	// RemoveStorefrontDomain removes a custom domain (requires settings.write)
+	RemoveStorefrontDomain(context.Context, *StorefrontDomainRequest) (*emptypb.Empty, error)
+	// ListStorefrontDomains lists the storefront's custom domains (requires settings.write)
+	ListStorefrontDomains(context.Context, *ListStorefrontDomainsRequest) (*ListStorefrontDomainsResponse, error)
+	// ResolveStorefrontDomain maps a verified custom domain (e.g. shop.example.rs) to its storefront
+	ResolveStorefrontDomain(context.Context, *ResolveStorefrontDomainRequest) (*ResolveStorefrontDomainResponse, error)
+	// SubmitStorefrontVerification submits business documents for storefront verification (requires settings.write)
+	SubmitStorefrontVerification(context.Context, *SubmitStorefrontVerificationRequest) (*StorefrontVerification, error)
+	// GetStorefrontVerification returns the verification status, latest request and audit history (requires settings.write)
+	GetStorefrontVerification(context.Context, *GetStorefrontVerificationRequest) (*GetStorefrontVerificationResponse, error)
+	// CancelStorefrontVerification withdraws the pending verification request (requires settings.write)
+	CancelStorefrontVerification(context.Context, *GetStorefrontVerificationRequest) (*StorefrontVerification, error)
+	// ListVerificationRequests returns verification requests by status, oldest first (admin only)
+	ListVerificationRequests(context.Context, *ListVerificationRequestsRequest) (*ListVerificationRequestsResponse, error)
+	// ReviewStorefrontVerification approves or rejects a pending verification request (admin only)
+	ReviewStorefrontVerification(context.Context, *ReviewStorefrontVerificationRequest) (*StorefrontVerification, error)
+	// RevokeStorefrontVerification removes the verified status of a storefront (admin only)
+	RevokeStorefrontVerification(context.Context, *RevokeStorefrontVerificationRequest) (*StorefrontFull, error)
 	// SetPaymentMethods configures payment methods for storefront
 	SetPaymentMethods(context.Context, *SetPaymentMethodsRequest) (*GetPaymentMethodsResponse, error)
 	// GetPaymentMethods retrieves payment methods
@@ -1255,6 +1939,38 @@ type ListingsServiceServer interface {
 	GetMapData(context.Context, *GetMapDataRequest) (*GetMapDataResponse, error)
 	// GetDashboardStats retrieves storefront dashboard statistics
 	GetDashboardStats(context.Context, *DashboardStatsRequest) (*DashboardStatsResponse, error)
+	// GetPriceHistory retrieves price changes of a listing/product (optionally a single variant)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// SubmitListingForReview moves a draft listing to the moderation queue (optionally with a publish time)
+	SubmitListingForReview(context.Context, *SubmitListingForReviewRequest) (*SubmitListingForReviewResponse, error)
+	// GetModerationQueue returns listings awaiting review, oldest first (admin only)
+	GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error)
+	// ModerateListing approves or rejects a listing awaiting review (admin only)
+	ModerateListing(context.Context, *ModerateListingRequest) (*ModerateListingResponse, error)
+	// ListDuplicateFlags returns listings flagged as possible duplicates, newest first (admin only)
+	ListDuplicateFlags(context.Context, *ListDuplicateFlagsRequest) (*ListDuplicateFlagsResponse, error)
+	// CreateReview rates a storefront product or storefront (one review per user and subject)
+	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
+	// GetReview retrieves a review
+	GetReview(context.Context, *GetReviewRequest) (*Review, error)
+	// ListReviews lists the published reviews of a subject with its rating summary
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// UpdateReview changes the rating or text of the caller's review
+	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
+	// DeleteReview deletes the caller's review (or any review, admin only)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	// GetReviewSummary returns the average rating and star distribution of a subject
+	GetReviewSummary(context.Context, *GetReviewSummaryRequest) (*ReviewSummary, error)
+	// ReplyToReview sets the seller reply of a review (requires reviews.reply)
+	ReplyToReview(context.Context, *ReplyToReviewRequest) (*Review, error)
+	// VoteReview records whether the caller found a review helpful
+	VoteReview(context.Context, *VoteReviewRequest) (*Review, error)
+	// ReportReview reports an abusive review; reviews with enough reports are hidden
+	ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewResponse, error)
+	// ListReviewReports returns abuse reports, oldest first (admin only)
+	ListReviewReports(context.Context, *ListReviewReportsRequest) (*ListReviewReportsResponse, error)
+	// ModerateReview hides or restores a review and resolves its reports (admin only)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error)
 	mustEmbedUnimplementedListingsServiceServer()
 }
 
@@ -1286,6 +2002,15 @@ func (UnimplementedListingsServiceServer) ListListings(context.Context, *ListLis
 func (UnimplementedListingsServiceServer) GetSimilarListings(context.Context, *GetSimilarListingsRequest) (*GetSimilarListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarListings not implemented")
 }
+func (UnimplementedListingsServiceServer) GetRecommendationsForUser(context.Context, *GetRecommendationsForUserRequest) (*GetRecommendationsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendationsForUser not implemented")
+}
+func (UnimplementedListingsServiceServer) GetFrequentlyBoughtTogether(context.Context, *GetFrequentlyBoughtTogetherRequest) (*GetFrequentlyBoughtTogetherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrequentlyBoughtTogether not implemented")
+}
+func (UnimplementedListingsServiceServer) RenewListing(context.Context, *RenewListingRequest) (*RenewListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewListing not implemented")
+}
 func (UnimplementedListingsServiceServer) GetListingImage(context.Context, *ImageIDRequest) (*ImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListingImage not implemented")
 }
@@ -1304,6 +2029,12 @@ func (UnimplementedListingsServiceServer) ReorderListingImages(context.Context,
 func (UnimplementedListingsServiceServer) UploadListingImages(grpc.ClientStreamingServer[UploadImageChunkRequest, UploadImagesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method UploadListingImages not implemented")
 }
+func (UnimplementedListingsServiceServer) RequestImageUploadURLs(context.Context, *RequestImageUploadURLsRequest) (*RequestImageUploadURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestImageUploadURLs not implemented")
+}
+func (UnimplementedListingsServiceServer) ConfirmImageUpload(context.Context, *ConfirmImageUploadRequest) (*ImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmImageUpload not implemented")
+}
 func (UnimplementedListingsServiceServer) GetRootCategories(context.Context, *emptypb.Empty) (*CategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRootCategories not implemented")
 }
@@ -1433,6 +2164,12 @@ func (UnimplementedListingsServiceServer) GetProductStats(context.Context, *GetP
 func (UnimplementedListingsServiceServer) IncrementProductViews(context.Context, *IncrementProductViewsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncrementProductViews not implemented")
 }
+func (UnimplementedListingsServiceServer) GetRecentlyViewed(context.Context, *GetRecentlyViewedRequest) (*GetRecentlyViewedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentlyViewed not implemented")
+}
+func (UnimplementedListingsServiceServer) ClearRecentlyViewed(context.Context, *ClearRecentlyViewedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRecentlyViewed not implemented")
+}
 func (UnimplementedListingsServiceServer) AddProductImage(context.Context, *AddProductImageRequest) (*ProductImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProductImage not implemented")
 }
@@ -1460,6 +2197,9 @@ func (UnimplementedListingsServiceServer) DeleteStorefront(context.Context, *Del
 func (UnimplementedListingsServiceServer) GetMyStorefronts(context.Context, *ListStorefrontsRequest) (*ListStorefrontsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMyStorefronts not implemented")
 }
+func (UnimplementedListingsServiceServer) UploadStorefrontImage(context.Context, *UploadStorefrontImageRequest) (*StorefrontFull, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStorefrontImage not implemented")
+}
 func (UnimplementedListingsServiceServer) AddStaff(context.Context, *AddStaffRequest) (*StorefrontStaff, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddStaff not implemented")
 }
@@ -1472,6 +2212,33 @@ func (UnimplementedListingsServiceServer) RemoveStaff(context.Context, *RemoveSt
 func (UnimplementedListingsServiceServer) GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStaff not implemented")
 }
+func (UnimplementedListingsServiceServer) InviteStaff(context.Context, *InviteStaffRequest) (*StaffInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteStaff not implemented")
+}
+func (UnimplementedListingsServiceServer) AcceptStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StorefrontStaff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptStaffInvitation not implemented")
+}
+func (UnimplementedListingsServiceServer) DeclineStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StaffInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineStaffInvitation not implemented")
+}
+func (UnimplementedListingsServiceServer) RevokeStaffInvitation(context.Context, *StaffInvitationActionRequest) (*StaffInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStaffInvitation not implemented")
+}
+func (UnimplementedListingsServiceServer) ListStaffInvitations(context.Context, *ListStaffInvitationsRequest) (*ListStaffInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaffInvitations not implemented")
+}
+func (UnimplementedListingsServiceServer) FollowStorefront(context.Context, *FollowStorefrontRequest) (*FollowStorefrontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowStorefront not implemented")
+}
+func (UnimplementedListingsServiceServer) UnfollowStorefront(context.Context, *FollowStorefrontRequest) (*FollowStorefrontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowStorefront not implemented")
+}
+func (UnimplementedListingsServiceServer) GetStorefrontFollowers(context.Context, *GetStorefrontFollowersRequest) (*GetStorefrontFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefrontFollowers not implemented")
+}
+func (UnimplementedListingsServiceServer) GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFollowingFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingFeed not implemented")
+}
 func (UnimplementedListingsServiceServer) SetWorkingHours(context.Context, *SetWorkingHoursRequest) (*GetWorkingHoursResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWorkingHours not implemented")
 }
@@ -1481,6 +2248,48 @@ func (UnimplementedListingsServiceServer) GetWorkingHours(context.Context, *GetW
 func (UnimplementedListingsServiceServer) IsOpenNow(context.Context, *IsOpenNowRequest) (*IsOpenNowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsOpenNow not implemented")
 }
+func (UnimplementedListingsServiceServer) SetVacationMode(context.Context, *SetVacationModeRequest) (*StorefrontFull, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVacationMode not implemented")
+}
+func (UnimplementedListingsServiceServer) SetHolidays(context.Context, *SetHolidaysRequest) (*GetHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHolidays not implemented")
+}
+func (UnimplementedListingsServiceServer) GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHolidays not implemented")
+}
+func (UnimplementedListingsServiceServer) AddStorefrontDomain(context.Context, *AddStorefrontDomainRequest) (*StorefrontDomain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStorefrontDomain not implemented")
+}
+func (UnimplementedListingsServiceServer) VerifyStorefrontDomain(context.Context, *StorefrontDomainRequest) (*StorefrontDomain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStorefrontDomain not implemented")
+}
+func (UnimplementedListingsServiceServer) RemoveStorefrontDomain(context.Context, *StorefrontDomainRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStorefrontDomain not implemented")
+}
+func (UnimplementedListingsServiceServer) ListStorefrontDomains(context.Context, *ListStorefrontDomainsRequest) (*ListStorefrontDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorefrontDomains not implemented")
+}
+func (UnimplementedListingsServiceServer) ResolveStorefrontDomain(context.Context, *ResolveStorefrontDomainRequest) (*ResolveStorefrontDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStorefrontDomain not implemented")
+}
+func (UnimplementedListingsServiceServer) SubmitStorefrontVerification(context.Context, *SubmitStorefrontVerificationRequest) (*StorefrontVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStorefrontVerification not implemented")
+}
+func (UnimplementedListingsServiceServer) GetStorefrontVerification(context.Context, *GetStorefrontVerificationRequest) (*GetStorefrontVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefrontVerification not implemented")
+}
+func (UnimplementedListingsServiceServer) CancelStorefrontVerification(context.Context, *GetStorefrontVerificationRequest) (*StorefrontVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStorefrontVerification not implemented")
+}
+func (UnimplementedListingsServiceServer) ListVerificationRequests(context.Context, *ListVerificationRequestsRequest) (*ListVerificationRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVerificationRequests not implemented")
+}
+func (UnimplementedListingsServiceServer) ReviewStorefrontVerification(context.Context, *ReviewStorefrontVerificationRequest) (*StorefrontVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewStorefrontVerification not implemented")
+}
+func (UnimplementedListingsServiceServer) RevokeStorefrontVerification(context.Context, *RevokeStorefrontVerificationRequest) (*StorefrontFull, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStorefrontVerification not implemented")
+}
 func (UnimplementedListingsServiceServer) SetPaymentMethods(context.Context, *SetPaymentMethodsRequest) (*GetPaymentMethodsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPaymentMethods not implemented")
 }
@@ -1499,6 +2308,54 @@ func (UnimplementedListingsServiceServer) GetMapData(context.Context, *GetMapDat
 func (UnimplementedListingsServiceServer) GetDashboardStats(context.Context, *DashboardStatsRequest) (*DashboardStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardStats not implemented")
 }
+func (UnimplementedListingsServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedListingsServiceServer) SubmitListingForReview(context.Context, *SubmitListingForReviewRequest) (*SubmitListingForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitListingForReview not implemented")
+}
+func (UnimplementedListingsServiceServer) GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
+func (UnimplementedListingsServiceServer) ModerateListing(context.Context, *ModerateListingRequest) (*ModerateListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateListing not implemented")
+}
+func (UnimplementedListingsServiceServer) ListDuplicateFlags(context.Context, *ListDuplicateFlagsRequest) (*ListDuplicateFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateFlags not implemented")
+}
+func (UnimplementedListingsServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedListingsServiceServer) GetReview(context.Context, *GetReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedListingsServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedListingsServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedListingsServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedListingsServiceServer) GetReviewSummary(context.Context, *GetReviewSummaryRequest) (*ReviewSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewSummary not implemented")
+}
+func (UnimplementedListingsServiceServer) ReplyToReview(context.Context, *ReplyToReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedListingsServiceServer) VoteReview(context.Context, *VoteReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReview not implemented")
+}
+func (UnimplementedListingsServiceServer) ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReview not implemented")
+}
+func (UnimplementedListingsServiceServer) ListReviewReports(context.Context, *ListReviewReportsRequest) (*ListReviewReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewReports not implemented")
+}
+func (UnimplementedListingsServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
 func (UnimplementedListingsServiceServer) mustEmbedUnimplementedListingsServiceServer() {}
 func (UnimplementedListingsServiceServer) testEmbeddedByValue()                         {}
 
@@ -1646,6 +2503,60 @@ func _ListingsService_GetSimilarListings_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingsService_GetRecommendationsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetRecommendationsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetRecommendationsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetRecommendationsForUser(ctx, req.(*GetRecommendationsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetFrequentlyBoughtTogether_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrequentlyBoughtTogetherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetFrequentlyBoughtTogether(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetFrequentlyBoughtTogether_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetFrequentlyBoughtTogether(ctx, req.(*GetFrequentlyBoughtTogetherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RenewListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RenewListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RenewListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RenewListing(ctx, req.(*RenewListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListingsService_GetListingImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImageIDRequest)
 	if err := dec(in); err != nil {
@@ -1743,6 +2654,42 @@ func _ListingsService_UploadListingImages_Handler(srv interface{}, stream grpc.S
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ListingsService_UploadListingImagesServer = grpc.ClientStreamingServer[UploadImageChunkRequest, UploadImagesResponse]
 
+func _ListingsService_RequestImageUploadURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestImageUploadURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RequestImageUploadURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RequestImageUploadURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RequestImageUploadURLs(ctx, req.(*RequestImageUploadURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ConfirmImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmImageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ConfirmImageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ConfirmImageUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ConfirmImageUpload(ctx, req.(*ConfirmImageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListingsService_GetRootCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2517,398 +3464,1154 @@ func _ListingsService_IncrementProductViews_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingsService_GetRecentlyViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentlyViewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetRecentlyViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetRecentlyViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetRecentlyViewed(ctx, req.(*GetRecentlyViewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ClearRecentlyViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRecentlyViewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ClearRecentlyViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ClearRecentlyViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ClearRecentlyViewed(ctx, req.(*ClearRecentlyViewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListingsService_AddProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddProductImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).AddProductImage(ctx, in)
+		return srv.(ListingsServiceServer).AddProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AddProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AddProductImage(ctx, req.(*AddProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetProductImages(ctx, req.(*GetProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).DeleteProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_DeleteProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).DeleteProductImage(ctx, req.(*DeleteProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ReindexAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ReindexAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ReindexAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ReindexAll(ctx, req.(*ReindexAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_CreateStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorefrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).CreateStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_CreateStorefront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).CreateStorefront(ctx, req.(*CreateStorefrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UpdateStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStorefrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UpdateStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UpdateStorefront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UpdateStorefront(ctx, req.(*UpdateStorefrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_DeleteStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStorefrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).DeleteStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_DeleteStorefront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).DeleteStorefront(ctx, req.(*DeleteStorefrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetMyStorefronts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorefrontsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetMyStorefronts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetMyStorefronts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetMyStorefronts(ctx, req.(*ListStorefrontsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UploadStorefrontImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStorefrontImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UploadStorefrontImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UploadStorefrontImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UploadStorefrontImage(ctx, req.(*UploadStorefrontImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_AddStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).AddStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AddStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AddStaff(ctx, req.(*AddStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UpdateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UpdateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RemoveStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RemoveStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RemoveStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RemoveStaff(ctx, req.(*RemoveStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetStaff(ctx, req.(*GetStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_InviteStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).InviteStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_InviteStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).InviteStaff(ctx, req.(*InviteStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_AcceptStaffInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffInvitationActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).AcceptStaffInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AcceptStaffInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AcceptStaffInvitation(ctx, req.(*StaffInvitationActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_DeclineStaffInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffInvitationActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).DeclineStaffInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_DeclineStaffInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).DeclineStaffInvitation(ctx, req.(*StaffInvitationActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RevokeStaffInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffInvitationActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RevokeStaffInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RevokeStaffInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RevokeStaffInvitation(ctx, req.(*StaffInvitationActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ListStaffInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ListStaffInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ListStaffInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ListStaffInvitations(ctx, req.(*ListStaffInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_FollowStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowStorefrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).FollowStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_FollowStorefront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).FollowStorefront(ctx, req.(*FollowStorefrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_UnfollowStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowStorefrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).UnfollowStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_UnfollowStorefront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).UnfollowStorefront(ctx, req.(*FollowStorefrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetStorefrontFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetStorefrontFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetStorefrontFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetStorefrontFollowers(ctx, req.(*GetStorefrontFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetFollowingFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetFollowingFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetFollowingFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetFollowingFeed(ctx, req.(*GetFollowingFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SetWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SetWorkingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SetWorkingHours(ctx, req.(*SetWorkingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetWorkingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetWorkingHours(ctx, req.(*GetWorkingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_IsOpenNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsOpenNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).IsOpenNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_IsOpenNow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).IsOpenNow(ctx, req.(*IsOpenNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SetVacationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVacationModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SetVacationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SetVacationMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SetVacationMode(ctx, req.(*SetVacationModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SetHolidays(ctx, req.(*SetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetHolidays(ctx, req.(*GetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_AddStorefrontDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStorefrontDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).AddStorefrontDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_AddStorefrontDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).AddStorefrontDomain(ctx, req.(*AddStorefrontDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_VerifyStorefrontDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorefrontDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).VerifyStorefrontDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_VerifyStorefrontDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).VerifyStorefrontDomain(ctx, req.(*StorefrontDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RemoveStorefrontDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorefrontDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RemoveStorefrontDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_RemoveStorefrontDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).RemoveStorefrontDomain(ctx, req.(*StorefrontDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ListStorefrontDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorefrontDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ListStorefrontDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ListStorefrontDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ListStorefrontDomains(ctx, req.(*ListStorefrontDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ResolveStorefrontDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveStorefrontDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ResolveStorefrontDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ResolveStorefrontDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ResolveStorefrontDomain(ctx, req.(*ResolveStorefrontDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_SubmitStorefrontVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitStorefrontVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).SubmitStorefrontVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_SubmitStorefrontVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).SubmitStorefrontVerification(ctx, req.(*SubmitStorefrontVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_GetStorefrontVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).GetStorefrontVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_GetStorefrontVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).GetStorefrontVerification(ctx, req.(*GetStorefrontVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_CancelStorefrontVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).CancelStorefrontVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_CancelStorefrontVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).CancelStorefrontVerification(ctx, req.(*GetStorefrontVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ListVerificationRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVerificationRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ListVerificationRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ListVerificationRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ListVerificationRequests(ctx, req.(*ListVerificationRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ReviewStorefrontVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewStorefrontVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ReviewStorefrontVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ReviewStorefrontVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ReviewStorefrontVerification(ctx, req.(*ReviewStorefrontVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_RevokeStorefrontVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeStorefrontVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).RevokeStorefrontVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_AddProductImage_FullMethodName,
+		FullMethod: ListingsService_RevokeStorefrontVerification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).AddProductImage(ctx, req.(*AddProductImageRequest))
+		return srv.(ListingsServiceServer).RevokeStorefrontVerification(ctx, req.(*RevokeStorefrontVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductImagesRequest)
+func _ListingsService_SetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPaymentMethodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetProductImages(ctx, in)
+		return srv.(ListingsServiceServer).SetPaymentMethods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetProductImages_FullMethodName,
+		FullMethod: ListingsService_SetPaymentMethods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetProductImages(ctx, req.(*GetProductImagesRequest))
+		return srv.(ListingsServiceServer).SetPaymentMethods(ctx, req.(*SetPaymentMethodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductImageRequest)
+func _ListingsService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentMethodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).DeleteProductImage(ctx, in)
+		return srv.(ListingsServiceServer).GetPaymentMethods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_DeleteProductImage_FullMethodName,
+		FullMethod: ListingsService_GetPaymentMethods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).DeleteProductImage(ctx, req.(*DeleteProductImageRequest))
+		return srv.(ListingsServiceServer).GetPaymentMethods(ctx, req.(*GetPaymentMethodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReorderProductImagesRequest)
+func _ListingsService_SetDeliveryOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeliveryOptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).ReorderProductImages(ctx, in)
+		return srv.(ListingsServiceServer).SetDeliveryOptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_ReorderProductImages_FullMethodName,
+		FullMethod: ListingsService_SetDeliveryOptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+		return srv.(ListingsServiceServer).SetDeliveryOptions(ctx, req.(*SetDeliveryOptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_ReindexAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReindexAllRequest)
+func _ListingsService_GetDeliveryOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryOptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).ReindexAll(ctx, in)
+		return srv.(ListingsServiceServer).GetDeliveryOptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_ReindexAll_FullMethodName,
+		FullMethod: ListingsService_GetDeliveryOptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).ReindexAll(ctx, req.(*ReindexAllRequest))
+		return srv.(ListingsServiceServer).GetDeliveryOptions(ctx, req.(*GetDeliveryOptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_CreateStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStorefrontRequest)
+func _ListingsService_GetMapData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).CreateStorefront(ctx, in)
+		return srv.(ListingsServiceServer).GetMapData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_CreateStorefront_FullMethodName,
+		FullMethod: ListingsService_GetMapData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).CreateStorefront(ctx, req.(*CreateStorefrontRequest))
+		return srv.(ListingsServiceServer).GetMapData(ctx, req.(*GetMapDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_UpdateStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStorefrontRequest)
+func _ListingsService_GetDashboardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).UpdateStorefront(ctx, in)
+		return srv.(ListingsServiceServer).GetDashboardStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_UpdateStorefront_FullMethodName,
+		FullMethod: ListingsService_GetDashboardStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).UpdateStorefront(ctx, req.(*UpdateStorefrontRequest))
+		return srv.(ListingsServiceServer).GetDashboardStats(ctx, req.(*DashboardStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_DeleteStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStorefrontRequest)
+func _ListingsService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).DeleteStorefront(ctx, in)
+		return srv.(ListingsServiceServer).GetPriceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_DeleteStorefront_FullMethodName,
+		FullMethod: ListingsService_GetPriceHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).DeleteStorefront(ctx, req.(*DeleteStorefrontRequest))
+		return srv.(ListingsServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetMyStorefronts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStorefrontsRequest)
+func _ListingsService_SubmitListingForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitListingForReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetMyStorefronts(ctx, in)
+		return srv.(ListingsServiceServer).SubmitListingForReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetMyStorefronts_FullMethodName,
+		FullMethod: ListingsService_SubmitListingForReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetMyStorefronts(ctx, req.(*ListStorefrontsRequest))
+		return srv.(ListingsServiceServer).SubmitListingForReview(ctx, req.(*SubmitListingForReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_AddStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStaffRequest)
+func _ListingsService_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationQueueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).AddStaff(ctx, in)
+		return srv.(ListingsServiceServer).GetModerationQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_AddStaff_FullMethodName,
+		FullMethod: ListingsService_GetModerationQueue_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).AddStaff(ctx, req.(*AddStaffRequest))
+		return srv.(ListingsServiceServer).GetModerationQueue(ctx, req.(*GetModerationQueueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStaffRequest)
+func _ListingsService_ModerateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateListingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).UpdateStaff(ctx, in)
+		return srv.(ListingsServiceServer).ModerateListing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_UpdateStaff_FullMethodName,
+		FullMethod: ListingsService_ModerateListing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+		return srv.(ListingsServiceServer).ModerateListing(ctx, req.(*ModerateListingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_RemoveStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveStaffRequest)
+func _ListingsService_ListDuplicateFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateFlagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).RemoveStaff(ctx, in)
+		return srv.(ListingsServiceServer).ListDuplicateFlags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_RemoveStaff_FullMethodName,
+		FullMethod: ListingsService_ListDuplicateFlags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).RemoveStaff(ctx, req.(*RemoveStaffRequest))
+		return srv.(ListingsServiceServer).ListDuplicateFlags(ctx, req.(*ListDuplicateFlagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStaffRequest)
+func _ListingsService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetStaff(ctx, in)
+		return srv.(ListingsServiceServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetStaff_FullMethodName,
+		FullMethod: ListingsService_CreateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetStaff(ctx, req.(*GetStaffRequest))
+		return srv.(ListingsServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_SetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetWorkingHoursRequest)
+func _ListingsService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).SetWorkingHours(ctx, in)
+		return srv.(ListingsServiceServer).GetReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_SetWorkingHours_FullMethodName,
+		FullMethod: ListingsService_GetReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).SetWorkingHours(ctx, req.(*SetWorkingHoursRequest))
+		return srv.(ListingsServiceServer).GetReview(ctx, req.(*GetReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkingHoursRequest)
+func _ListingsService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetWorkingHours(ctx, in)
+		return srv.(ListingsServiceServer).ListReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetWorkingHours_FullMethodName,
+		FullMethod: ListingsService_ListReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetWorkingHours(ctx, req.(*GetWorkingHoursRequest))
+		return srv.(ListingsServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_IsOpenNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsOpenNowRequest)
+func _ListingsService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).IsOpenNow(ctx, in)
+		return srv.(ListingsServiceServer).UpdateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_IsOpenNow_FullMethodName,
+		FullMethod: ListingsService_UpdateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).IsOpenNow(ctx, req.(*IsOpenNowRequest))
+		return srv.(ListingsServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_SetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPaymentMethodsRequest)
+func _ListingsService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).SetPaymentMethods(ctx, in)
+		return srv.(ListingsServiceServer).DeleteReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_SetPaymentMethods_FullMethodName,
+		FullMethod: ListingsService_DeleteReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).SetPaymentMethods(ctx, req.(*SetPaymentMethodsRequest))
+		return srv.(ListingsServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPaymentMethodsRequest)
+func _ListingsService_GetReviewSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetPaymentMethods(ctx, in)
+		return srv.(ListingsServiceServer).GetReviewSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetPaymentMethods_FullMethodName,
+		FullMethod: ListingsService_GetReviewSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetPaymentMethods(ctx, req.(*GetPaymentMethodsRequest))
+		return srv.(ListingsServiceServer).GetReviewSummary(ctx, req.(*GetReviewSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_SetDeliveryOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDeliveryOptionsRequest)
+func _ListingsService_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).SetDeliveryOptions(ctx, in)
+		return srv.(ListingsServiceServer).ReplyToReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_SetDeliveryOptions_FullMethodName,
+		FullMethod: ListingsService_ReplyToReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).SetDeliveryOptions(ctx, req.(*SetDeliveryOptionsRequest))
+		return srv.(ListingsServiceServer).ReplyToReview(ctx, req.(*ReplyToReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetDeliveryOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDeliveryOptionsRequest)
+func _ListingsService_VoteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetDeliveryOptions(ctx, in)
+		return srv.(ListingsServiceServer).VoteReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetDeliveryOptions_FullMethodName,
+		FullMethod: ListingsService_VoteReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetDeliveryOptions(ctx, req.(*GetDeliveryOptionsRequest))
+		return srv.(ListingsServiceServer).VoteReview(ctx, req.(*VoteReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetMapData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMapDataRequest)
+func _ListingsService_ReportReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetMapData(ctx, in)
+		return srv.(ListingsServiceServer).ReportReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetMapData_FullMethodName,
+		FullMethod: ListingsService_ReportReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetMapData(ctx, req.(*GetMapDataRequest))
+		return srv.(ListingsServiceServer).ReportReview(ctx, req.(*ReportReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ListingsService_GetDashboardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DashboardStatsRequest)
+func _ListingsService_ListReviewReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ListingsServiceServer).GetDashboardStats(ctx, in)
+		return srv.(ListingsServiceServer).ListReviewReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ListingsService_GetDashboardStats_FullMethodName,
+		FullMethod: ListingsService_ListReviewReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ListingsServiceServer).GetDashboardStats(ctx, req.(*DashboardStatsRequest))
+		return srv.(ListingsServiceServer).ListReviewReports(ctx, req.(*ListReviewReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingsService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingsServiceServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingsService_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingsServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2948,6 +4651,18 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSimilarListings",
 			Handler:    _ListingsService_GetSimilarListings_Handler,
 		},
+		{
+			MethodName: "GetRecommendationsForUser",
+			Handler:    _ListingsService_GetRecommendationsForUser_Handler,
+		},
+		{
+			MethodName: "GetFrequentlyBoughtTogether",
+			Handler:    _ListingsService_GetFrequentlyBoughtTogether_Handler,
+		},
+		{
+			MethodName: "RenewListing",
+			Handler:    _ListingsService_RenewListing_Handler,
+		},
 		{
 			MethodName: "GetListingImage",
 			Handler:    _ListingsService_GetListingImage_Handler,
@@ -2968,6 +4683,14 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReorderListingImages",
 			Handler:    _ListingsService_ReorderListingImages_Handler,
 		},
+		{
+			MethodName: "RequestImageUploadURLs",
+			Handler:    _ListingsService_RequestImageUploadURLs_Handler,
+		},
+		{
+			MethodName: "ConfirmImageUpload",
+			Handler:    _ListingsService_ConfirmImageUpload_Handler,
+		},
 		{
 			MethodName: "GetRootCategories",
 			Handler:    _ListingsService_GetRootCategories_Handler,
@@ -3140,6 +4863,14 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IncrementProductViews",
 			Handler:    _ListingsService_IncrementProductViews_Handler,
 		},
+		{
+			MethodName: "GetRecentlyViewed",
+			Handler:    _ListingsService_GetRecentlyViewed_Handler,
+		},
+		{
+			MethodName: "ClearRecentlyViewed",
+			Handler:    _ListingsService_ClearRecentlyViewed_Handler,
+		},
 		{
 			MethodName: "AddProductImage",
 			Handler:    _ListingsService_AddProductImage_Handler,
@@ -3176,6 +4907,10 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMyStorefronts",
 			Handler:    _ListingsService_GetMyStorefronts_Handler,
 		},
+		{
+			MethodName: "UploadStorefrontImage",
+			Handler:    _ListingsService_UploadStorefrontImage_Handler,
+		},
 		{
 			MethodName: "AddStaff",
 			Handler:    _ListingsService_AddStaff_Handler,
@@ -3192,6 +4927,42 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStaff",
 			Handler:    _ListingsService_GetStaff_Handler,
 		},
+		{
+			MethodName: "InviteStaff",
+			Handler:    _ListingsService_InviteStaff_Handler,
+		},
+		{
+			MethodName: "AcceptStaffInvitation",
+			Handler:    _ListingsService_AcceptStaffInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineStaffInvitation",
+			Handler:    _ListingsService_DeclineStaffInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeStaffInvitation",
+			Handler:    _ListingsService_RevokeStaffInvitation_Handler,
+		},
+		{
+			MethodName: "ListStaffInvitations",
+			Handler:    _ListingsService_ListStaffInvitations_Handler,
+		},
+		{
+			MethodName: "FollowStorefront",
+			Handler:    _ListingsService_FollowStorefront_Handler,
+		},
+		{
+			MethodName: "UnfollowStorefront",
+			Handler:    _ListingsService_UnfollowStorefront_Handler,
+		},
+		{
+			MethodName: "GetStorefrontFollowers",
+			Handler:    _ListingsService_GetStorefrontFollowers_Handler,
+		},
+		{
+			MethodName: "GetFollowingFeed",
+			Handler:    _ListingsService_GetFollowingFeed_Handler,
+		},
 		{
 			MethodName: "SetWorkingHours",
 			Handler:    _ListingsService_SetWorkingHours_Handler,
@@ -3204,6 +4975,62 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsOpenNow",
 			Handler:    _ListingsService_IsOpenNow_Handler,
 		},
+		{
+			MethodName: "SetVacationMode",
+			Handler:    _ListingsService_SetVacationMode_Handler,
+		},
+		{
+			MethodName: "SetHolidays",
+			Handler:    _ListingsService_SetHolidays_Handler,
+		},
+		{
+			MethodName: "GetHolidays",
+			Handler:    _ListingsService_GetHolidays_Handler,
+		},
+		{
+			MethodName: "AddStorefrontDomain",
+			Handler:    _ListingsService_AddStorefrontDomain_Handler,
+		},
+		{
+			MethodName: "VerifyStorefrontDomain",
+			Handler:    _ListingsService_VerifyStorefrontDomain_Handler,
+		},
+		{
+			MethodName: "RemoveStorefrontDomain",
+			Handler:    _ListingsService_RemoveStorefrontDomain_Handler,
+		},
+		{
+			MethodName: "ListStorefrontDomains",
+			Handler:    _ListingsService_ListStorefrontDomains_Handler,
+		},
+		{
+			MethodName: "ResolveStorefrontDomain",
+			Handler:    _ListingsService_ResolveStorefrontDomain_Handler,
+		},
+		{
+			MethodName: "SubmitStorefrontVerification",
+			Handler:    _ListingsService_SubmitStorefrontVerification_Handler,
+		},
+		{
+			MethodName: "GetStorefrontVerification",
+			Handler:    _ListingsService_GetStorefrontVerification_Handler,
+		},
+		{
+			MethodName: "CancelStorefrontVerification",
+			Handler:    _ListingsService_CancelStorefrontVerification_Handler,
+		},
+		{
+			MethodName: "ListVerificationRequests",
+			Handler:    _ListingsService_ListVerificationRequests_Handler,
+		},
+		{
+			MethodName: "ReviewStorefrontVerification",
+			Handler:    _ListingsService_ReviewStorefrontVerification_Handler,
+		},
+		{
+			MethodName: "RevokeStorefrontVerification",
+			Handler:    _ListingsService_RevokeStorefrontVerification_Handler,
+		},
 		{
 			MethodName: "SetPaymentMethods",
 			Handler:    _ListingsService_SetPaymentMethods_Handler,
@@ -3228,6 +5055,70 @@ var ListingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDashboardStats",
 			Handler:    _ListingsService_GetDashboardStats_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ListingsService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "SubmitListingForReview",
+			Handler:    _ListingsService_SubmitListingForReview_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _ListingsService_GetModerationQueue_Handler,
+		},
+		{
+			MethodName: "ModerateListing",
+			Handler:    _ListingsService_ModerateListing_Handler,
+		},
+		{
+			MethodName: "ListDuplicateFlags",
+			Handler:    _ListingsService_ListDuplicateFlags_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _ListingsService_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _ListingsService_GetReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _ListingsService_ListReviews_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ListingsService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ListingsService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "GetReviewSummary",
+			Handler:    _ListingsService_GetReviewSummary_Handler,
+		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _ListingsService_ReplyToReview_Handler,
+		},
+		{
+			MethodName: "VoteReview",
+			Handler:    _ListingsService_VoteReview_Handler,
+		},
+		{
+			MethodName: "ReportReview",
+			Handler:    _ListingsService_ReportReview_Handler,
+		},
+		{
+			MethodName: "ListReviewReports",
+			Handler:    _ListingsService_ListReviewReports_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _ListingsService_ModerateReview_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -55,9 +55,15 @@ type Listing struct {
 	// Source type (c2c or b2c)
 	SourceType string `protobuf:"bytes,15,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
 	// Stock status
-	StockStatus   string `protobuf:"bytes,16,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	StockStatus string `protobuf:"bytes,16,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"`
+	// Whether the seller's storefront is verified
+	StorefrontVerified bool `protobuf:"varint,17,opt,name=storefront_verified,json=storefrontVerified,proto3" json:"storefront_verified,omitempty"`
+	// Storefront on vacation and not taking orders
+	Unavailable bool `protobuf:"varint,18,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	// Vacation notice, also set when orders ship delayed
+	AvailabilityNotice *string `protobuf:"bytes,19,opt,name=availability_notice,json=availabilityNotice,proto3,oneof" json:"availability_notice,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Listing) Reset() {
@@ -202,6 +208,27 @@ func (x *Listing) GetStockStatus() string {
 	return ""
 }
 
+func (x *Listing) GetStorefrontVerified() bool {
+	if x != nil {
+		return x.StorefrontVerified
+	}
+	return false
+}
+
+func (x *Listing) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *Listing) GetAvailabilityNotice() string {
+	if x != nil && x.AvailabilityNotice != nil {
+		return *x.AvailabilityNotice
+	}
+	return ""
+}
+
 // ListingImage represents an image in search results
 type ListingImage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -287,9 +314,11 @@ type Filters struct {
 	// Source type filter ("c2c" or "b2c")
 	SourceType *string `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3,oneof" json:"source_type,omitempty"`
 	// Stock status filter ("in_stock", "out_of_stock", "low_stock")
-	StockStatus   *string `protobuf:"bytes,5,opt,name=stock_status,json=stockStatus,proto3,oneof" json:"stock_status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	StockStatus *string `protobuf:"bytes,5,opt,name=stock_status,json=stockStatus,proto3,oneof" json:"stock_status,omitempty"`
+	// Only listings of verified storefronts
+	VerifiedSellersOnly *bool `protobuf:"varint,6,opt,name=verified_sellers_only,json=verifiedSellersOnly,proto3,oneof" json:"verified_sellers_only,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Filters) Reset() {
@@ -357,6 +386,13 @@ func (x *Filters) GetStockStatus() string {
 	return ""
 }
 
+func (x *Filters) GetVerifiedSellersOnly() bool {
+	if x != nil && x.VerifiedSellersOnly != nil {
+		return *x.VerifiedSellersOnly
+	}
+	return false
+}
+
 // PriceRange filter
 type PriceRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -520,7 +556,7 @@ var File_api_proto_search_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_proto_search_v1_common_proto_rawDesc = "" +
 	"\n" +
-	" api/proto/search/v1/common.proto\x12\tsearch.v1\"\x89\x04\n" +
+	" api/proto/search/v1/common.proto\x12\tsearch.v1\"\xaa\x05\n" +
 	"\aListing\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x14\n" +
@@ -541,16 +577,20 @@ const file_api_proto_search_v1_common_proto_rawDesc = "" +
 	"\x03sku\x18\x0e \x01(\tH\x02R\x03sku\x88\x01\x01\x12\x1f\n" +
 	"\vsource_type\x18\x0f \x01(\tR\n" +
 	"sourceType\x12!\n" +
-	"\fstock_status\x18\x10 \x01(\tR\vstockStatusB\x0e\n" +
+	"\fstock_status\x18\x10 \x01(\tR\vstockStatus\x12/\n" +
+	"\x13storefront_verified\x18\x11 \x01(\bR\x12storefrontVerified\x12 \n" +
+	"\vunavailable\x18\x12 \x01(\bR\vunavailable\x124\n" +
+	"\x13availability_notice\x18\x13 \x01(\tH\x03R\x12availabilityNotice\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\x10\n" +
 	"\x0e_storefront_idB\x06\n" +
-	"\x04_sku\"t\n" +
+	"\x04_skuB\x16\n" +
+	"\x14_availability_notice\"t\n" +
 	"\fListingImage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
 	"\n" +
 	"is_primary\x18\x03 \x01(\bR\tisPrimary\x12#\n" +
-	"\rdisplay_order\x18\x04 \x01(\x05R\fdisplayOrder\"\x9c\x03\n" +
+	"\rdisplay_order\x18\x04 \x01(\x05R\fdisplayOrder\"\xef\x03\n" +
 	"\aFilters\x120\n" +
 	"\x05price\x18\x01 \x01(\v2\x15.search.v1.PriceRangeH\x00R\x05price\x88\x01\x01\x12B\n" +
 	"\n" +
@@ -559,14 +599,16 @@ const file_api_proto_search_v1_common_proto_rawDesc = "" +
 	"\blocation\x18\x03 \x01(\v2\x19.search.v1.LocationFilterH\x01R\blocation\x88\x01\x01\x12$\n" +
 	"\vsource_type\x18\x04 \x01(\tH\x02R\n" +
 	"sourceType\x88\x01\x01\x12&\n" +
-	"\fstock_status\x18\x05 \x01(\tH\x03R\vstockStatus\x88\x01\x01\x1aY\n" +
+	"\fstock_status\x18\x05 \x01(\tH\x03R\vstockStatus\x88\x01\x01\x127\n" +
+	"\x15verified_sellers_only\x18\x06 \x01(\bH\x04R\x13verifiedSellersOnly\x88\x01\x01\x1aY\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
 	"\x05value\x18\x02 \x01(\v2\x1a.search.v1.AttributeValuesR\x05value:\x028\x01B\b\n" +
 	"\x06_priceB\v\n" +
 	"\t_locationB\x0e\n" +
 	"\f_source_typeB\x0f\n" +
-	"\r_stock_status\"J\n" +
+	"\r_stock_statusB\x18\n" +
+	"\x16_verified_sellers_only\"J\n" +
 	"\n" +
 	"PriceRange\x12\x15\n" +
 	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
@@ -41,6 +41,10 @@ type SearchWithFiltersRequest struct {
 	// Include facets in response (default: false)
 	// When true, response includes facets for dynamic filter UI
 	IncludeFacets bool `protobuf:"varint,8,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	// Searcher identity for search analytics (optional, first page only)
+	// When one is set the search is recorded and search_query_id is returned
+	UserId        *int64  `protobuf:"varint,9,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	SessionId     *string `protobuf:"bytes,10,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +135,20 @@ func (x *SearchWithFiltersRequest) GetIncludeFacets() bool {
 	return false
 }
 
+func (x *SearchWithFiltersRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SearchWithFiltersRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
 // SortConfig defines sorting parameters
 type SortConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -200,9 +218,14 @@ type SearchWithFiltersResponse struct {
 	// Whether result was served from cache
 	Cached bool `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
 	// Optional facets (only included if include_facets=true)
-	Facets        *GetSearchFacetsResponse `protobuf:"bytes,5,opt,name=facets,proto3,oneof" json:"facets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Facets *GetSearchFacetsResponse `protobuf:"bytes,5,opt,name=facets,proto3,oneof" json:"facets,omitempty"`
+	// ID of the recorded search, to be sent with RecordSearchClick
+	SearchQueryId *int64 `protobuf:"varint,6,opt,name=search_query_id,json=searchQueryId,proto3,oneof" json:"search_query_id,omitempty"`
+	// Search experiment arm that ranked the results (not set = default ranking)
+	ExperimentId      *string `protobuf:"bytes,7,opt,name=experiment_id,json=experimentId,proto3,oneof" json:"experiment_id,omitempty"`
+	ExperimentVariant *string `protobuf:"bytes,8,opt,name=experiment_variant,json=experimentVariant,proto3,oneof" json:"experiment_variant,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SearchWithFiltersResponse) Reset() {
@@ -270,11 +293,32 @@ func (x *SearchWithFiltersResponse) GetFacets() *GetSearchFacetsResponse {
 	return nil
 }
 
+func (x *SearchWithFiltersResponse) GetSearchQueryId() int64 {
+	if x != nil && x.SearchQueryId != nil {
+		return *x.SearchQueryId
+	}
+	return 0
+}
+
+func (x *SearchWithFiltersResponse) GetExperimentId() string {
+	if x != nil && x.ExperimentId != nil {
+		return *x.ExperimentId
+	}
+	return ""
+}
+
+func (x *SearchWithFiltersResponse) GetExperimentVariant() string {
+	if x != nil && x.ExperimentVariant != nil {
+		return *x.ExperimentVariant
+	}
+	return ""
+}
+
 var File_api_proto_search_v1_filters_proto protoreflect.FileDescriptor
 
 const file_api_proto_search_v1_filters_proto_rawDesc = "" +
 	"\n" +
-	"!api/proto/search/v1/filters.proto\x12\tsearch.v1\x1a api/proto/search/v1/common.proto\x1a api/proto/search/v1/facets.proto\"\xd0\x02\n" +
+	"!api/proto/search/v1/filters.proto\x12\tsearch.v1\x1a api/proto/search/v1/common.proto\x1a api/proto/search/v1/facets.proto\"\xad\x03\n" +
 	"\x18SearchWithFiltersRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12$\n" +
 	"\vcategory_id\x18\x02 \x01(\x03H\x00R\n" +
@@ -284,22 +328,35 @@ const file_api_proto_search_v1_filters_proto_rawDesc = "" +
 	"\afilters\x18\x05 \x01(\v2\x12.search.v1.FiltersH\x01R\afilters\x88\x01\x01\x12.\n" +
 	"\x04sort\x18\x06 \x01(\v2\x15.search.v1.SortConfigH\x02R\x04sort\x88\x01\x01\x12\x1b\n" +
 	"\tuse_cache\x18\a \x01(\bR\buseCache\x12%\n" +
-	"\x0einclude_facets\x18\b \x01(\bR\rincludeFacetsB\x0e\n" +
+	"\x0einclude_facets\x18\b \x01(\bR\rincludeFacets\x12\x1c\n" +
+	"\auser_id\x18\t \x01(\x03H\x03R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\n" +
+	" \x01(\tH\x04R\tsessionId\x88\x01\x01B\x0e\n" +
 	"\f_category_idB\n" +
 	"\n" +
 	"\b_filtersB\a\n" +
-	"\x05_sort\"8\n" +
+	"\x05_sortB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_session_id\"8\n" +
 	"\n" +
 	"SortConfig\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
-	"\x05order\x18\x02 \x01(\tR\x05order\"\xde\x01\n" +
+	"\x05order\x18\x02 \x01(\tR\x05order\"\xa6\x03\n" +
 	"\x19SearchWithFiltersResponse\x12.\n" +
 	"\blistings\x18\x01 \x03(\v2\x12.search.v1.ListingR\blistings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x17\n" +
 	"\atook_ms\x18\x03 \x01(\x05R\x06tookMs\x12\x16\n" +
 	"\x06cached\x18\x04 \x01(\bR\x06cached\x12?\n" +
-	"\x06facets\x18\x05 \x01(\v2\".search.v1.GetSearchFacetsResponseH\x00R\x06facets\x88\x01\x01B\t\n" +
-	"\a_facetsB:Z8github.com/sveturs/listings/api/proto/search/v1;searchv1b\x06proto3"
+	"\x06facets\x18\x05 \x01(\v2\".search.v1.GetSearchFacetsResponseH\x00R\x06facets\x88\x01\x01\x12+\n" +
+	"\x0fsearch_query_id\x18\x06 \x01(\x03H\x01R\rsearchQueryId\x88\x01\x01\x12(\n" +
+	"\rexperiment_id\x18\a \x01(\tH\x02R\fexperimentId\x88\x01\x01\x122\n" +
+	"\x12experiment_variant\x18\b \x01(\tH\x03R\x11experimentVariant\x88\x01\x01B\t\n" +
+	"\a_facetsB\x12\n" +
+	"\x10_search_query_idB\x10\n" +
+	"\x0e_experiment_idB\x15\n" +
+	"\x13_experiment_variantB:Z8github.com/sveturs/listings/api/proto/search/v1;searchv1b\x06proto3"
 
 var (
 	file_api_proto_search_v1_filters_proto_rawDescOnce sync.Once
@@ -34,7 +34,11 @@ type SearchListingsRequest struct {
 	// Pagination offset (default: 0)
 	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	// Use Redis cache for results (default: true)
-	UseCache      bool `protobuf:"varint,5,opt,name=use_cache,json=useCache,proto3" json:"use_cache,omitempty"`
+	UseCache bool `protobuf:"varint,5,opt,name=use_cache,json=useCache,proto3" json:"use_cache,omitempty"`
+	// Searcher identity for search analytics (optional, first page only)
+	// When one is set the search is recorded and search_query_id is returned
+	UserId        *int64  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	SessionId     *string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -104,6 +108,20 @@ func (x *SearchListingsRequest) GetUseCache() bool {
 	return false
 }
 
+func (x *SearchListingsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
 // SearchListingsResponse contains search results
 type SearchListingsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -114,9 +132,14 @@ type SearchListingsResponse struct {
 	// Search duration in milliseconds
 	TookMs int32 `protobuf:"varint,3,opt,name=took_ms,json=tookMs,proto3" json:"took_ms,omitempty"`
 	// Whether result was served from cache
-	Cached        bool `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Cached bool `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
+	// ID of the recorded search, to be sent with RecordSearchClick
+	SearchQueryId *int64 `protobuf:"varint,5,opt,name=search_query_id,json=searchQueryId,proto3,oneof" json:"search_query_id,omitempty"`
+	// Search experiment arm that ranked the results (not set = default ranking)
+	ExperimentId      *string `protobuf:"bytes,6,opt,name=experiment_id,json=experimentId,proto3,oneof" json:"experiment_id,omitempty"`
+	ExperimentVariant *string `protobuf:"bytes,7,opt,name=experiment_variant,json=experimentVariant,proto3,oneof" json:"experiment_variant,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SearchListingsResponse) Reset() {
@@ -177,6 +200,27 @@ func (x *SearchListingsResponse) GetCached() bool {
 	return false
 }
 
+func (x *SearchListingsResponse) GetSearchQueryId() int64 {
+	if x != nil && x.SearchQueryId != nil {
+		return *x.SearchQueryId
+	}
+	return 0
+}
+
+func (x *SearchListingsResponse) GetExperimentId() string {
+	if x != nil && x.ExperimentId != nil {
+		return *x.ExperimentId
+	}
+	return ""
+}
+
+func (x *SearchListingsResponse) GetExperimentVariant() string {
+	if x != nil && x.ExperimentVariant != nil {
+		return *x.ExperimentVariant
+	}
+	return ""
+}
+
 // GetTrendingSearchesRequest contains parameters for trending searches
 type GetTrendingSearchesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -543,24 +587,156 @@ func (x *SearchHistoryEntry) GetSearchedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// RecordSearchClickRequest identifies the clicked result
+type RecordSearchClickRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// search_query_id returned with the search results
+	SearchQueryId int64 `protobuf:"varint,1,opt,name=search_query_id,json=searchQueryId,proto3" json:"search_query_id,omitempty"`
+	// Clicked listing
+	ListingId int64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	// 1-based rank of the listing in the results (offset + index + 1)
+	Position int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Session that ran the search (anonymous searches). Clicks are only counted for the
+	// authenticated user or session the search was recorded under.
+	SessionId     *string `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickRequest) Reset() {
+	*x = RecordSearchClickRequest{}
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickRequest) ProtoMessage() {}
+
+func (x *RecordSearchClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordSearchClickRequest) GetSearchQueryId() int64 {
+	if x != nil {
+		return x.SearchQueryId
+	}
+	return 0
+}
+
+func (x *RecordSearchClickRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *RecordSearchClickRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RecordSearchClickRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+// RecordSearchClickResponse reports whether the click was counted
+type RecordSearchClickResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False for unknown or expired searches (older than 24h) and repeated clicks
+	Recorded      bool `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickResponse) Reset() {
+	*x = RecordSearchClickResponse{}
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickResponse) ProtoMessage() {}
+
+func (x *RecordSearchClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickResponse.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordSearchClickResponse) GetRecorded() bool {
+	if x != nil {
+		return x.Recorded
+	}
+	return false
+}
+
 var File_api_proto_search_v1_search_proto protoreflect.FileDescriptor
 
 const file_api_proto_search_v1_search_proto_rawDesc = "" +
 	"\n" +
-	" api/proto/search/v1/search.proto\x12\tsearch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a api/proto/search/v1/common.proto\x1a api/proto/search/v1/facets.proto\x1a!api/proto/search/v1/filters.proto\x1a%api/proto/search/v1/suggestions.proto\x1a!api/proto/search/v1/popular.proto\"\xae\x01\n" +
+	" api/proto/search/v1/search.proto\x12\tsearch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a api/proto/search/v1/common.proto\x1a api/proto/search/v1/facets.proto\x1a!api/proto/search/v1/filters.proto\x1a%api/proto/search/v1/suggestions.proto\x1a!api/proto/search/v1/popular.proto\"\x8b\x02\n" +
 	"\x15SearchListingsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12$\n" +
 	"\vcategory_id\x18\x02 \x01(\x03H\x00R\n" +
 	"categoryId\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1b\n" +
-	"\tuse_cache\x18\x05 \x01(\bR\buseCacheB\x0e\n" +
-	"\f_category_id\"\x8f\x01\n" +
+	"\tuse_cache\x18\x05 \x01(\bR\buseCache\x12\x1c\n" +
+	"\auser_id\x18\x06 \x01(\x03H\x01R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tH\x02R\tsessionId\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_session_id\"\xd7\x02\n" +
 	"\x16SearchListingsResponse\x12.\n" +
 	"\blistings\x18\x01 \x03(\v2\x12.search.v1.ListingR\blistings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x17\n" +
 	"\atook_ms\x18\x03 \x01(\x05R\x06tookMs\x12\x16\n" +
-	"\x06cached\x18\x04 \x01(\bR\x06cached\"|\n" +
+	"\x06cached\x18\x04 \x01(\bR\x06cached\x12+\n" +
+	"\x0fsearch_query_id\x18\x05 \x01(\x03H\x00R\rsearchQueryId\x88\x01\x01\x12(\n" +
+	"\rexperiment_id\x18\x06 \x01(\tH\x01R\fexperimentId\x88\x01\x01\x122\n" +
+	"\x12experiment_variant\x18\a \x01(\tH\x02R\x11experimentVariant\x88\x01\x01B\x12\n" +
+	"\x10_search_query_idB\x10\n" +
+	"\x0e_experiment_idB\x15\n" +
+	"\x13_experiment_variant\"|\n" +
 	"\x1aGetTrendingSearchesRequest\x12$\n" +
 	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
 	"categoryId\x88\x01\x01\x12\x14\n" +
@@ -594,7 +770,17 @@ const file_api_proto_search_v1_search_proto_rawDesc = "" +
 	"\vsearched_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"searchedAtB\x0e\n" +
 	"\f_category_idB\x15\n" +
-	"\x13_clicked_listing_id2\x97\x05\n" +
+	"\x13_clicked_listing_id\"\xb0\x01\n" +
+	"\x18RecordSearchClickRequest\x12&\n" +
+	"\x0fsearch_query_id\x18\x01 \x01(\x03R\rsearchQueryId\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03R\tlistingId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\"\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tH\x00R\tsessionId\x88\x01\x01B\r\n" +
+	"\v_session_id\"7\n" +
+	"\x19RecordSearchClickResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\bR\brecorded2\xf7\x05\n" +
 	"\rSearchService\x12U\n" +
 	"\x0eSearchListings\x12 .search.v1.SearchListingsRequest\x1a!.search.v1.SearchListingsResponse\x12X\n" +
 	"\x0fGetSearchFacets\x12!.search.v1.GetSearchFacetsRequest\x1a\".search.v1.GetSearchFacetsResponse\x12^\n" +
@@ -602,7 +788,8 @@ const file_api_proto_search_v1_search_proto_rawDesc = "" +
 	"\x0eGetSuggestions\x12 .search.v1.GetSuggestionsRequest\x1a!.search.v1.GetSuggestionsResponse\x12a\n" +
 	"\x12GetPopularSearches\x12$.search.v1.GetPopularSearchesRequest\x1a%.search.v1.GetPopularSearchesResponse\x12a\n" +
 	"\x13GetTrendingSearches\x12%.search.v1.GetTrendingSearchesRequest\x1a#.search.v1.TrendingSearchesResponse\x12X\n" +
-	"\x10GetSearchHistory\x12\".search.v1.GetSearchHistoryRequest\x1a .search.v1.SearchHistoryResponseB:Z8github.com/sveturs/listings/api/proto/search/v1;searchv1b\x06proto3"
+	"\x10GetSearchHistory\x12\".search.v1.GetSearchHistoryRequest\x1a .search.v1.SearchHistoryResponse\x12^\n" +
+	"\x11RecordSearchClick\x12#.search.v1.RecordSearchClickRequest\x1a$.search.v1.RecordSearchClickResponseB:Z8github.com/sveturs/listings/api/proto/search/v1;searchv1b\x06proto3"
 
 var (
 	file_api_proto_search_v1_search_proto_rawDescOnce sync.Once
@@ -616,7 +803,7 @@ func file_api_proto_search_v1_search_proto_rawDescGZIP() []byte {
 	return file_api_proto_search_v1_search_proto_rawDescData
 }
 
-var file_api_proto_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_proto_search_v1_search_proto_goTypes = []any{
 	(*SearchListingsRequest)(nil),      // 0: search.v1.SearchListingsRequest
 	(*SearchListingsResponse)(nil),     // 1: search.v1.SearchListingsResponse
@@ -626,39 +813,43 @@ var file_api_proto_search_v1_search_proto_goTypes = []any{
 	(*GetSearchHistoryRequest)(nil),    // 5: search.v1.GetSearchHistoryRequest
 	(*SearchHistoryResponse)(nil),      // 6: search.v1.SearchHistoryResponse
 	(*SearchHistoryEntry)(nil),         // 7: search.v1.SearchHistoryEntry
-	(*Listing)(nil),                    // 8: search.v1.Listing
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
-	(*GetSearchFacetsRequest)(nil),     // 10: search.v1.GetSearchFacetsRequest
-	(*SearchWithFiltersRequest)(nil),   // 11: search.v1.SearchWithFiltersRequest
-	(*GetSuggestionsRequest)(nil),      // 12: search.v1.GetSuggestionsRequest
-	(*GetPopularSearchesRequest)(nil),  // 13: search.v1.GetPopularSearchesRequest
-	(*GetSearchFacetsResponse)(nil),    // 14: search.v1.GetSearchFacetsResponse
-	(*SearchWithFiltersResponse)(nil),  // 15: search.v1.SearchWithFiltersResponse
-	(*GetSuggestionsResponse)(nil),     // 16: search.v1.GetSuggestionsResponse
-	(*GetPopularSearchesResponse)(nil), // 17: search.v1.GetPopularSearchesResponse
+	(*RecordSearchClickRequest)(nil),   // 8: search.v1.RecordSearchClickRequest
+	(*RecordSearchClickResponse)(nil),  // 9: search.v1.RecordSearchClickResponse
+	(*Listing)(nil),                    // 10: search.v1.Listing
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
+	(*GetSearchFacetsRequest)(nil),     // 12: search.v1.GetSearchFacetsRequest
+	(*SearchWithFiltersRequest)(nil),   // 13: search.v1.SearchWithFiltersRequest
+	(*GetSuggestionsRequest)(nil),      // 14: search.v1.GetSuggestionsRequest
+	(*GetPopularSearchesRequest)(nil),  // 15: search.v1.GetPopularSearchesRequest
+	(*GetSearchFacetsResponse)(nil),    // 16: search.v1.GetSearchFacetsResponse
+	(*SearchWithFiltersResponse)(nil),  // 17: search.v1.SearchWithFiltersResponse
+	(*GetSuggestionsResponse)(nil),     // 18: search.v1.GetSuggestionsResponse
+	(*GetPopularSearchesResponse)(nil), // 19: search.v1.GetPopularSearchesResponse
 }
 var file_api_proto_search_v1_search_proto_depIdxs = []int32{
-	8,  // 0: search.v1.SearchListingsResponse.listings:type_name -> search.v1.Listing
+	10, // 0: search.v1.SearchListingsResponse.listings:type_name -> search.v1.Listing
 	4,  // 1: search.v1.TrendingSearchesResponse.searches:type_name -> search.v1.TrendingSearch
-	9,  // 2: search.v1.TrendingSearch.last_searched:type_name -> google.protobuf.Timestamp
+	11, // 2: search.v1.TrendingSearch.last_searched:type_name -> google.protobuf.Timestamp
 	7,  // 3: search.v1.SearchHistoryResponse.entries:type_name -> search.v1.SearchHistoryEntry
-	9,  // 4: search.v1.SearchHistoryEntry.searched_at:type_name -> google.protobuf.Timestamp
+	11, // 4: search.v1.SearchHistoryEntry.searched_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: search.v1.SearchService.SearchListings:input_type -> search.v1.SearchListingsRequest
-	10, // 6: search.v1.SearchService.GetSearchFacets:input_type -> search.v1.GetSearchFacetsRequest
-	11, // 7: search.v1.SearchService.SearchWithFilters:input_type -> search.v1.SearchWithFiltersRequest
-	12, // 8: search.v1.SearchService.GetSuggestions:input_type -> search.v1.GetSuggestionsRequest
-	13, // 9: search.v1.SearchService.GetPopularSearches:input_type -> search.v1.GetPopularSearchesRequest
+	12, // 6: search.v1.SearchService.GetSearchFacets:input_type -> search.v1.GetSearchFacetsRequest
+	13, // 7: search.v1.SearchService.SearchWithFilters:input_type -> search.v1.SearchWithFiltersRequest
+	14, // 8: search.v1.SearchService.GetSuggestions:input_type -> search.v1.GetSuggestionsRequest
+	15, // 9: search.v1.SearchService.GetPopularSearches:input_type -> search.v1.GetPopularSearchesRequest
 	2,  // 10: search.v1.SearchService.GetTrendingSearches:input_type -> search.v1.GetTrendingSearchesRequest
 	5,  // 11: search.v1.SearchService.GetSearchHistory:input_type -> search.v1.GetSearchHistoryRequest
-	1,  // 12: search.v1.SearchService.SearchListings:output_type -> search.v1.SearchListingsResponse
-	14, // 13: search.v1.SearchService.GetSearchFacets:output_type -> search.v1.GetSearchFacetsResponse
-	15, // 14: search.v1.SearchService.SearchWithFilters:output_type -> search.v1.SearchWithFiltersResponse
-	16, // 15: search.v1.SearchService.GetSuggestions:output_type -> search.v1.GetSuggestionsResponse
-	17, // 16: search.v1.SearchService.GetPopularSearches:output_type -> search.v1.GetPopularSearchesResponse
-	3,  // 17: search.v1.SearchService.GetTrendingSearches:output_type -> search.v1.TrendingSearchesResponse
-	6,  // 18: search.v1.SearchService.GetSearchHistory:output_type -> search.v1.SearchHistoryResponse
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
+	8,  // 12: search.v1.SearchService.RecordSearchClick:input_type -> search.v1.RecordSearchClickRequest
+	1,  // 13: search.v1.SearchService.SearchListings:output_type -> search.v1.SearchListingsResponse
+	16, // 14: search.v1.SearchService.GetSearchFacets:output_type -> search.v1.GetSearchFacetsResponse
+	17, // 15: search.v1.SearchService.SearchWithFilters:output_type -> search.v1.SearchWithFiltersResponse
+	18, // 16: search.v1.SearchService.GetSuggestions:output_type -> search.v1.GetSuggestionsResponse
+	19, // 17: search.v1.SearchService.GetPopularSearches:output_type -> search.v1.GetPopularSearchesResponse
+	3,  // 18: search.v1.SearchService.GetTrendingSearches:output_type -> search.v1.TrendingSearchesResponse
+	6,  // 19: search.v1.SearchService.GetSearchHistory:output_type -> search.v1.SearchHistoryResponse
+	9,  // 20: search.v1.SearchService.RecordSearchClick:output_type -> search.v1.RecordSearchClickResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
@@ -675,16 +866,18 @@ func file_api_proto_search_v1_search_proto_init() {
 	file_api_proto_search_v1_suggestions_proto_init()
 	file_api_proto_search_v1_popular_proto_init()
 	file_api_proto_search_v1_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_proto_search_v1_search_proto_msgTypes[1].OneofWrappers = []any{}
 	file_api_proto_search_v1_search_proto_msgTypes[2].OneofWrappers = []any{}
 	file_api_proto_search_v1_search_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_proto_search_v1_search_proto_msgTypes[7].OneofWrappers = []any{}
+	file_api_proto_search_v1_search_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_search_v1_search_proto_rawDesc), len(file_api_proto_search_v1_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	SearchService_GetPopularSearches_FullMethodName  = "/search.v1.SearchService/GetPopularSearches"
 	SearchService_GetTrendingSearches_FullMethodName = "/search.v1.SearchService/GetTrendingSearches"
 	SearchService_GetSearchHistory_FullMethodName    = "/search.v1.SearchService/GetSearchHistory"
+	SearchService_RecordSearchClick_FullMethodName   = "/search.v1.SearchService/RecordSearchClick"
 )
 
 // SearchServiceClient is the client API for SearchService service.
@@ -55,6 +56,9 @@ type SearchServiceClient interface {
 	// GetSearchHistory returns user's personal search history
 	// Phase 28 - Search Analytics - Personal search history for authenticated or anonymous users
 	GetSearchHistory(ctx context.Context, in *GetSearchHistoryRequest, opts ...grpc.CallOption) (*SearchHistoryResponse, error)
+	// RecordSearchClick records a click on a search result for CTR analytics
+	// Search Analytics - ties the click to the search_query_id returned with the results
+	RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error)
 }
 
 type searchServiceClient struct {
@@ -135,6 +139,16 @@ func (c *searchServiceClient) GetSearchHistory(ctx context.Context, in *GetSearc
 	return out, nil
 }
 
+func (c *searchServiceClient) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSearchClickResponse)
+	err := c.cc.Invoke(ctx, SearchService_RecordSearchClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServiceServer is the server API for SearchService service.
 // All implementations must embed UnimplementedSearchServiceServer
 // for forward compatibility.
@@ -162,6 +176,9 @@ type SearchServiceServer interface {
 	// GetSearchHistory returns user's personal search history
 	// Phase 28 - Search Analytics - Personal search history for authenticated or anonymous users
 	GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*SearchHistoryResponse, error)
+	// RecordSearchClick records a click on a search result for CTR analytics
+	// Search Analytics - ties the click to the search_query_id returned with the results
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
 
@@ -193,6 +210,9 @@ func (UnimplementedSearchServiceServer) GetTrendingSearches(context.Context, *Ge
 func (UnimplementedSearchServiceServer) GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*SearchHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSearchHistory not implemented")
 }
+func (UnimplementedSearchServiceServer) RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSearchClick not implemented")
+}
 func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
 func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
 
@@ -340,6 +360,24 @@ func _SearchService_GetSearchHistory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_RecordSearchClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).RecordSearchClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_RecordSearchClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -375,6 +413,10 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSearchHistory",
 			Handler:    _SearchService_GetSearchHistory_Handler,
 		},
+		{
+			MethodName: "RecordSearchClick",
+			Handler:    _SearchService_RecordSearchClick_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/search/v1/search.proto",
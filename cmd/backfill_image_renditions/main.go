@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/sveturs/listings/internal/config"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
+	"github.com/sveturs/listings/internal/repository/minio"
+	"github.com/sveturs/listings/internal/repository/postgres"
+)
+
+const (
+	maxOriginalSize   = 50 * 1024 * 1024 // Refuse to process larger objects
+	minioPresignedTTL = 24 * time.Hour
+)
+
+// stats counts backfill results
+type stats struct {
+	processed int
+	failed    int
+}
+
+func main() {
+	// Parse flags
+	batchSize := flag.Int("batch", 50, "Batch size for processing images")
+	all := flag.Bool("all", false, "Regenerate renditions for all images, not only those without renditions")
+	dryRun := flag.Bool("dry-run", false, "Dry run mode (only count images to process)")
+	flag.Parse()
+
+	// Setup logger
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339})
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load configuration")
+	}
+
+	log.Info().
+		Str("env", cfg.App.Env).
+		Int("batch_size", *batchSize).
+		Bool("all", *all).
+		Bool("dry_run", *dryRun).
+		Msg("starting image renditions backfill")
+
+	// Connect to database
+	db, err := sqlx.Connect("postgres", cfg.DB.DSN())
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect to database")
+	}
+	defer db.Close()
+
+	repo := postgres.NewRepository(db, log.Logger)
+	ctx := context.Background()
+
+	if *dryRun {
+		var count int
+		query := `SELECT COUNT(*) FROM listing_images WHERE storage_path IS NOT NULL AND processing_status = 'ready' AND ($1 OR renditions = '[]'::jsonb)`
+		if err := db.GetContext(ctx, &count, query, *all); err != nil {
+			log.Fatal().Err(err).Msg("failed to count images")
+		}
+		log.Info().Int("images_to_process", count).Msg("dry run completed")
+		return
+	}
+
+	// Connect to MinIO
+	storage, err := minio.NewClient(
+		cfg.Storage.Endpoint,
+		cfg.Storage.AccessKey,
+		cfg.Storage.SecretKey,
+		cfg.Storage.Bucket,
+		cfg.Storage.UseSSL,
+		log.Logger,
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect to MinIO")
+	}
+
+	startTime := time.Now()
+	var result stats
+	var afterID int64
+
+	for {
+		images, err := repo.ListImagesForRenditionBackfill(ctx, afterID, *batchSize, *all)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to list images")
+		}
+		if len(images) == 0 {
+			break
+		}
+
+		for _, image := range images {
+			afterID = image.ID
+
+			if err := backfillImage(ctx, repo, storage, image); err != nil {
+				result.failed++
+				log.Error().Err(err).Int64("image_id", image.ID).Str("key", *image.StoragePath).Msg("failed to backfill image")
+				continue
+			}
+			result.processed++
+		}
+
+		log.Info().Int64("last_image_id", afterID).Int("processed", result.processed).Int("failed", result.failed).Msg("batch completed")
+	}
+
+	elapsed := time.Since(startTime)
+	log.Info().Dur("elapsed", elapsed).Msg("image renditions backfill completed")
+
+	fmt.Println("\n=== Summary ===")
+	fmt.Printf("Images processed: %d\n", result.processed)
+	fmt.Printf("Images failed: %d\n", result.failed)
+	fmt.Printf("Time elapsed: %s\n", elapsed)
+
+	if result.failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// backfillImage re-encodes the stored original without metadata (keeping its key) and
+// regenerates the thumbnail, renditions and blurhash
+func backfillImage(ctx context.Context, repo *postgres.Repository, storage *minio.Client, image *domain.ListingImage) error {
+	originalKey := *image.StoragePath
+
+	reader, err := storage.DownloadImage(ctx, originalKey)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(reader, maxOriginalSize+1))
+	_ = reader.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read original: %w", err)
+	}
+	if len(data) > maxOriginalSize {
+		return fmt.Errorf("original exceeds %dMB", maxOriginalSize/(1024*1024))
+	}
+
+	processed, err := imaging.Process(data)
+	if err != nil {
+		return err
+	}
+	img := processed.Image
+
+	renditions, err := imaging.Renditions(img)
+	if err != nil {
+		return fmt.Errorf("failed to generate renditions: %w", err)
+	}
+
+	// Overwrite the original with the upright, metadata-free version
+	if err := storage.UploadImage(ctx, originalKey, bytes.NewReader(processed.Data), int64(len(processed.Data)), processed.ContentType); err != nil {
+		return err
+	}
+
+	// Thumbnails were generated before orientation correction; regenerate them as well
+	thumbnail, err := imaging.Thumbnail(img)
+	if err != nil {
+		return err
+	}
+	if err := storage.UploadImage(ctx, imaging.ThumbnailKey(originalKey), bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+		return err
+	}
+
+	stored, err := imaging.StoreRenditions(ctx, storage, originalKey, renditions, minioPresignedTTL)
+	if err != nil {
+		return err
+	}
+
+	// Rendition keys are deterministic: on failure below the objects are kept and overwritten
+	// by the next run (with -all they may still be referenced by the current row)
+	originalURL, err := storage.GetPresignedURL(ctx, originalKey, minioPresignedTTL)
+	if err != nil {
+		return err
+	}
+
+	width := int32(img.Bounds().Dx())
+	height := int32(img.Bounds().Dy())
+	fileSize := int64(len(processed.Data))
+	mimeType := processed.ContentType
+	blurHash := processed.BlurHash
+
+	previous := image.Renditions
+	image.URL = originalURL
+	image.Width = &width
+	image.Height = &height
+	image.FileSize = &fileSize
+	image.MimeType = &mimeType
+	image.BlurHash = &blurHash
+	image.Renditions = stored
+
+	if err := repo.UpdateImageRenditions(ctx, image); err != nil {
+		return err
+	}
+
+	// Remove previous renditions that were not overwritten (e.g. widths no longer generated)
+	current := make(map[string]bool, len(stored))
+	for _, rendition := range stored {
+		current[rendition.StoragePath] = true
+	}
+	for _, rendition := range previous {
+		if !current[rendition.StoragePath] {
+			_ = storage.DeleteImage(ctx, rendition.StoragePath)
+		}
+	}
+
+	log.Debug().Int64("image_id", image.ID).Int("renditions", len(stored)).Msg("image backfilled")
+	return nil
+}
@@ -30,12 +30,16 @@ import (
 	"github.com/sveturs/listings/internal/middleware"
 	"github.com/sveturs/listings/internal/opensearch"
 	"github.com/sveturs/listings/internal/ratelimit"
+	"github.com/sveturs/listings/internal/repository"
 	"github.com/sveturs/listings/internal/repository/minio"
 	opensearchRepo "github.com/sveturs/listings/internal/repository/opensearch"
 	"github.com/sveturs/listings/internal/repository/postgres"
+	"github.com/sveturs/listings/internal/scheduler"
 	"github.com/sveturs/listings/internal/service"
+	"github.com/sveturs/listings/internal/service/feed"
 	"github.com/sveturs/listings/internal/service/listings"
 	searchService "github.com/sveturs/listings/internal/service/search"
+	"github.com/sveturs/listings/internal/service/sitemap"
 	"github.com/sveturs/listings/internal/timeout"
 	grpcTransport "github.com/sveturs/listings/internal/transport/grpc"
 	httpTransport "github.com/sveturs/listings/internal/transport/http"
@@ -220,10 +224,20 @@ func main() {
 
 	// Initialize listings service
 	listingsService := listings.NewService(pgRepo, redisCache, searchClient, zerologLogger)
+	listingsService.SetDefaultRenewalDays(cfg.Expiration.DefaultRenewalDays)
+	listingsService.SetDuplicateDetection(listings.DuplicateDetectionConfig{
+		Mode:             cfg.Duplicates.Mode,
+		TextThreshold:    cfg.Duplicates.TextThreshold,
+		ImageMaxDistance: cfg.Duplicates.ImageMaxDistance,
+		MaxCandidates:    cfg.Duplicates.MaxCandidates,
+	})
 
 	// Initialize storefront service
 	storefrontService := listings.NewStorefrontService(pgRepo, &zerologLogger)
 
+	// Initialize review service (seller replies are authorized by storefront staff permissions)
+	reviewService := listings.NewReviewService(pgRepo, storefrontService, &zerologLogger)
+
 	// Initialize attribute repository and service
 	attrRepo := postgres.NewAttributeRepository(db, zerologLogger)
 	attributeService := service.NewAttributeService(attrRepo, redisCache.GetClient(), zerologLogger)
@@ -235,6 +249,26 @@ func main() {
 	// Analytics uses materialized views and analytics_events table
 	analyticsRepo := postgres.NewAnalyticsRepository(pgxPool, zerologLogger)
 
+	// Initialize analytics event writer (buffers events and writes them in batches via COPY)
+	var analyticsEventWriter *worker.AnalyticsEventWriter
+	if cfg.AnalyticsEvents.Enabled {
+		analyticsEventWriter = worker.NewAnalyticsEventWriter(
+			analyticsRepo,
+			metricsInstance,
+			worker.AnalyticsEventConfig{
+				BufferSize:    cfg.AnalyticsEvents.BufferSize,
+				BatchSize:     cfg.AnalyticsEvents.BatchSize,
+				FlushInterval: cfg.AnalyticsEvents.FlushInterval,
+			},
+			zerologLogger,
+		)
+		if err := analyticsEventWriter.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start analytics event writer")
+		}
+	} else {
+		logger.Warn().Msg("Analytics event ingestion DISABLED")
+	}
+
 	// Initialize search service (Phase 21.1)
 	var searchSvc *searchService.Service
 	if searchClient != nil {
@@ -285,6 +319,7 @@ func main() {
 			// Create search service
 			searchSvc = searchService.NewService(osSearchClient, searchCache, zerologLogger)
 			searchSvc.SetSearchQueriesRepo(searchQueriesRepo)
+			searchSvc.SetExperimentSource(postgres.NewSearchExperimentsRepository(pgxPool, zerologLogger))
 			logger.Info().Msg("Search service initialized successfully (with analytics)")
 		}
 	}
@@ -296,6 +331,9 @@ func main() {
 		redisCache.GetClient(), // Reuse existing Redis client
 		zerologLogger,
 	)
+	analyticsSvc.SetStorefrontAuthorizer(storefrontService)
+	analyticsSvc.SetSearchQueryReporter(postgres.NewSearchQueriesRepository(pgxPool, zerologLogger))
+	analyticsSvc.SetSearchExperiments(postgres.NewSearchExperimentsRepository(pgxPool, zerologLogger))
 	logger.Info().Msg("Analytics service initialized successfully")
 
 	// Initialize storefront analytics service (Phase 30.1)
@@ -306,6 +344,7 @@ func main() {
 		redisCache.GetClient(), // Reuse existing Redis client
 		zerologLogger,
 	)
+	storefrontAnalyticsSvc.SetStorefrontAuthorizer(storefrontService)
 	logger.Info().Msg("Storefront analytics service initialized successfully")
 
 	// Initialize order service dependencies
@@ -333,6 +372,52 @@ func main() {
 		zerologLogger,
 	)
 
+	// Storefront staff act as sellers according to their permissions
+	orderService.SetStorefrontAuthorizer(storefrontService)
+
+	// Connect analytics event ingestion (views, favorites, cart additions, searches, orders and TrackEvents)
+	if analyticsEventWriter != nil {
+		listingsService.SetEventTracker(analyticsEventWriter)
+		analyticsSvc.SetEventTracker(analyticsEventWriter)
+		orderService.SetEventTracker(analyticsEventWriter)
+		cartService.SetEventTracker(analyticsEventWriter)
+		if searchSvc != nil {
+			searchSvc.SetEventTracker(analyticsEventWriter)
+		}
+	}
+
+	// Item-to-item recommendations (neighbors are rebuilt by the rebuild_recommendations job)
+	recommendationRepo := postgres.NewRecommendationRepository(pgxPool, zerologLogger)
+	listingsService.SetRecommendationStore(recommendationRepo)
+
+	// Deduplicated view counting: repeat views are detected in Redis, counters are written in batches
+	var viewCounter *worker.ViewCounter
+	if cfg.ViewCounting.Enabled {
+		viewCounter = worker.NewViewCounter(
+			pgRepo,
+			metricsInstance,
+			worker.ViewCounterConfig{FlushInterval: cfg.ViewCounting.FlushInterval},
+			zerologLogger,
+		)
+		if err := viewCounter.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start view counter")
+		}
+		listingsService.SetViewCounting(
+			cache.NewViewDeduplicator(redisCache.GetClient(), cfg.ViewCounting.DedupWindow),
+			viewCounter,
+		)
+	}
+
+	// Recently viewed lists live in Redis next to the cache
+	if cfg.RecentlyViewed.Enabled {
+		listingsService.SetRecentlyViewedStore(cache.NewRecentlyViewedStore(
+			redisCache.GetClient(),
+			cfg.RecentlyViewed.MaxItems,
+			cfg.RecentlyViewed.TTL,
+			zerologLogger,
+		))
+	}
+
 	// Initialize delivery client (if enabled)
 	var deliveryClient *deliveryclient.Client
 	if cfg.Delivery.Enabled {
@@ -411,6 +496,12 @@ func main() {
 	// Connect chat service to order service for notifications
 	orderService.SetChatService(chatService)
 
+	// Connect chat service to listings service for price-drop alerts on favorites
+	listingsService.SetPriceDropNotifier(service.NewPriceDropNotifier(chatService, zerologLogger))
+
+	// Connect chat service to listings service for review decision notifications
+	listingsService.SetModerationNotifier(service.NewModerationNotifier(chatService, zerologLogger))
+
 	// Initialize health check service
 	healthConfig := &health.Config{
 		CheckTimeout:     cfg.Health.CheckTimeout,
@@ -441,6 +532,128 @@ func main() {
 		}()
 	}
 
+	// Initialize listing expiration worker (expires C2C listings, sends renewal reminders)
+	var expirationWorker *worker.ExpirationWorker
+	if cfg.Expiration.Enabled {
+		expirationWorker = worker.NewExpirationWorker(
+			pgRepo,
+			service.NewExpiryNotifier(chatService, zerologLogger),
+			metricsInstance,
+			worker.ExpirationConfig{
+				Interval:     cfg.Expiration.Interval,
+				ReminderDays: cfg.Expiration.ReminderDays,
+				BatchSize:    cfg.Expiration.BatchSize,
+			},
+			zerologLogger,
+		)
+		if err := expirationWorker.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start expiration worker")
+		}
+	}
+
+	// Initialize scheduled publish worker (publishes approved listings at their publish time)
+	var publishWorker *worker.PublishWorker
+	if cfg.ScheduledPublish.Enabled {
+		publishWorker = worker.NewPublishWorker(
+			pgRepo,
+			metricsInstance,
+			worker.PublishConfig{
+				Interval:  cfg.ScheduledPublish.Interval,
+				BatchSize: cfg.ScheduledPublish.BatchSize,
+			},
+			zerologLogger,
+		)
+		if err := publishWorker.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start publish worker")
+		}
+	}
+
+	// Initialize image processing worker (uploaded images are processed asynchronously)
+	var imageWorker *worker.ImageWorker
+	if minioClient != nil {
+		imageWorker = worker.NewImageWorker(
+			pgRepo,
+			minioClient,
+			listingsService,
+			redisCache,
+			metricsInstance,
+			worker.ImageProcessingConfig{
+				Concurrency:  cfg.ImageProcessing.Concurrency,
+				PollInterval: cfg.ImageProcessing.PollInterval,
+				BatchSize:    cfg.ImageProcessing.BatchSize,
+				JobTimeout:   cfg.ImageProcessing.JobTimeout,
+			},
+			zerologLogger,
+		)
+		if err := imageWorker.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start image worker")
+		}
+	}
+
+	// Initialize image GC worker (deletes stored images no longer referenced by any row)
+	var imageGCWorker *worker.ImageGCWorker
+	if minioClient != nil && cfg.ImageGC.Enabled {
+		imageGCWorker = worker.NewImageGCWorker(
+			pgRepo,
+			minioClient,
+			metricsInstance,
+			worker.ImageGCConfig{
+				Interval:    cfg.ImageGC.Interval,
+				GracePeriod: cfg.ImageGC.GracePeriod,
+				DryRun:      cfg.ImageGC.DryRun,
+			},
+			zerologLogger,
+		)
+		if err := imageGCWorker.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start image GC worker")
+		}
+	}
+
+	// Initialize report export worker (requested reports are rendered to object storage in the background)
+	var reportExportWorker *worker.ReportExportWorker
+	var purgeReportExports func(context.Context) error
+	if minioClient != nil && cfg.ReportExports.Enabled {
+		reportExportRepo := postgres.NewReportExportRepository(pgxPool, zerologLogger)
+		reportExportWorker = worker.NewReportExportWorker(
+			reportExportRepo,
+			minioClient,
+			metricsInstance,
+			worker.ReportExportConfig{
+				Concurrency:  cfg.ReportExports.Concurrency,
+				PollInterval: cfg.ReportExports.PollInterval,
+				JobTimeout:   cfg.ReportExports.JobTimeout,
+				Retention:    cfg.ReportExports.Retention,
+			},
+			zerologLogger,
+		)
+		analyticsSvc.SetReportExports(reportExportRepo, minioClient)
+		purgeReportExports = reportExportWorker.PurgeExpired
+		if err := reportExportWorker.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start report export worker")
+		}
+	}
+
+	// Initialize job scheduler (materialized view refresh, event archival and other periodic maintenance)
+	var jobScheduler *scheduler.Scheduler
+	if cfg.Scheduler.Enabled {
+		jobScheduler, err = newJobScheduler(
+			cfg.Scheduler,
+			analyticsRepo,
+			postgres.NewSearchQueriesRepository(pgxPool, zerologLogger),
+			postgres.NewJobRunsRepository(pgxPool, zerologLogger),
+			recommendationRepo,
+			purgeReportExports,
+			metricsInstance,
+			zerologLogger,
+		)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to configure job scheduler")
+		}
+		if err := jobScheduler.Start(); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start job scheduler")
+		}
+	}
+
 	// Initialize rate limiter (conditionally based on config)
 	var rateLimiterInterceptor grpc.UnaryServerInterceptor
 	if cfg.Features.RateLimitEnabled {
@@ -480,7 +693,11 @@ func main() {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(interceptors...),
 	)
-	grpcHandler := grpcTransport.NewServer(listingsService, storefrontService, attributeService, categoryService, orderService, cartService, chatService, analyticsSvc, storefrontAnalyticsSvc, minioClient, metricsInstance, zerologLogger)
+	grpcHandler := grpcTransport.NewServer(listingsService, storefrontService, attributeService, categoryService, orderService, cartService, chatService, analyticsSvc, storefrontAnalyticsSvc, reviewService, minioClient, metricsInstance, zerologLogger)
+	grpcHandler.SetTrustedMetadata(cfg.Auth.TrustMetadata)
+	if err := grpcHandler.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal().Err(err).Msg("invalid trusted proxies")
+	}
 	listingspb.RegisterListingsServiceServer(grpcServer, grpcHandler)
 	attributespb.RegisterAttributeServiceServer(grpcServer, grpcHandler)
 
@@ -492,7 +709,7 @@ func main() {
 
 	// Register SearchService (Phase 21.1)
 	if searchSvc != nil {
-		searchHandler := grpcTransport.NewSearchHandler(searchSvc, zerologLogger)
+		searchHandler := grpcTransport.NewSearchHandler(searchSvc, storefrontService, zerologLogger)
 		searchv1.RegisterSearchServiceServer(grpcServer, searchHandler)
 		logger.Info().Msg("SearchService registered with gRPC server")
 	}
@@ -534,12 +751,38 @@ func main() {
 		logger.Warn().Msg("Chat WebSocket disabled - auth service not available")
 	}
 
+	// Initialize product feed handler (Google Merchant, Facebook catalog)
+	var feedHandler *httpTransport.FeedHandler
+	if cfg.Feed.Enabled {
+		feedService := feed.NewService(pgRepo, redisCache.GetClient(), feed.Config{
+			BaseURL:  cfg.Feed.BaseURL,
+			CacheTTL: cfg.Feed.CacheTTL,
+			PageSize: cfg.Feed.PageSize,
+		}, zerologLogger)
+		feedHandler = httpTransport.NewFeedHandler(feedService, cfg.Feed.CacheTTL, zerologLogger)
+		logger.Info().Str("base_url", cfg.Feed.BaseURL).Msg("Product feed handler initialized")
+	}
+
+	// Initialize sitemap.xml / robots.txt handler
+	var sitemapHandler *httpTransport.SitemapHandler
+	if cfg.Sitemap.Enabled {
+		sitemapService := sitemap.NewService(pgRepo, redisCache.GetClient(), sitemap.Config{
+			BaseURL:     cfg.Sitemap.BaseURL,
+			CacheTTL:    cfg.Sitemap.CacheTTL,
+			URLsPerFile: cfg.Sitemap.URLsPerFile,
+		}, zerologLogger)
+		sitemapHandler = httpTransport.NewSitemapHandler(sitemapService, cfg.Sitemap.CacheTTL, zerologLogger)
+		logger.Info().Str("base_url", cfg.Sitemap.BaseURL).Msg("Sitemap handler initialized")
+	}
+
 	httpApp, err := httpTransport.StartMinimalServer(
 		cfg.Server.HTTPHost,
 		cfg.Server.HTTPPort,
 		httpHandler,
 		healthHandler,
 		chatWSHandler,
+		feedHandler,
+		sitemapHandler,
 		zerologLogger,
 	)
 	if err != nil {
@@ -575,6 +818,42 @@ func main() {
 		}
 	}
 
+	if expirationWorker != nil {
+		if err := expirationWorker.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping expiration worker")
+		}
+	}
+
+	if publishWorker != nil {
+		if err := publishWorker.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping publish worker")
+		}
+	}
+
+	if imageWorker != nil {
+		if err := imageWorker.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping image worker")
+		}
+	}
+
+	if imageGCWorker != nil {
+		if err := imageGCWorker.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping image GC worker")
+		}
+	}
+
+	if reportExportWorker != nil {
+		if err := reportExportWorker.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping report export worker")
+		}
+	}
+
+	if jobScheduler != nil {
+		if err := jobScheduler.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping job scheduler")
+		}
+	}
+
 	// Stop chat hub (closes all WebSocket connections)
 	logger.Info().Msg("Stopping chat WebSocket hub...")
 	chatHubCancel()
@@ -589,6 +868,20 @@ func main() {
 	logger.Info().Msg("Stopping gRPC server...")
 	grpcServer.GracefulStop()
 
+	// Write buffered view counts once no more views can arrive
+	if viewCounter != nil {
+		if err := viewCounter.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping view counter")
+		}
+	}
+
+	// Flush buffered analytics events once no more requests can emit them
+	if analyticsEventWriter != nil {
+		if err := analyticsEventWriter.Stop(); err != nil {
+			logger.Error().Err(err).Msg("error stopping analytics event writer")
+		}
+	}
+
 	// Shutdown HTTP server
 	if err := httpApp.ShutdownWithContext(ctx); err != nil {
 		logger.Error().Err(err).Msg("error shutting down HTTP server")
@@ -597,6 +890,100 @@ func main() {
 	logger.Info().Msg("Listings Service stopped")
 }
 
+// newJobScheduler creates the job scheduler and registers the maintenance jobs enabled in cfg
+// (purgeReportExports is nil when report exports are disabled)
+func newJobScheduler(
+	cfg config.SchedulerConfig,
+	analyticsRepo repository.AnalyticsRepository,
+	searchQueriesRepo repository.SearchQueriesRepository,
+	jobRunsRepo repository.JobRunsRepository,
+	recommendationRepo repository.RecommendationRepository,
+	purgeReportExports func(context.Context) error,
+	metricsInstance *metrics.Metrics,
+	logger zerolog.Logger,
+) (*scheduler.Scheduler, error) {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+
+	s := scheduler.New(jobRunsRepo, metricsInstance, scheduler.Config{
+		Instance:       instance,
+		DefaultTimeout: cfg.JobTimeout,
+	}, logger)
+
+	jobs := []scheduler.Job{
+		{
+			Name:     "refresh_analytics_views",
+			Schedule: cfg.RefreshViewsSchedule,
+			Run:      analyticsRepo.RefreshMaterializedViews,
+		},
+		{
+			Name:     "refresh_trending_cache",
+			Schedule: cfg.TrendingCacheSchedule,
+			Run:      analyticsRepo.RefreshTrendingCache,
+		},
+		{
+			Name:     "archive_analytics_events",
+			Schedule: cfg.ArchiveEventsSchedule,
+			Run: func(ctx context.Context) error {
+				_, err := analyticsRepo.ArchiveOldEvents(ctx, cfg.EventRetention, cfg.ArchiveBatchSize)
+				return err
+			},
+		},
+		{
+			Name:     "cleanup_search_queries",
+			Schedule: cfg.SearchQueryCleanupSchedule,
+			Run: func(ctx context.Context) error {
+				_, err := searchQueriesRepo.CleanupOldQueries(ctx, cfg.SearchQueryRetentionDays)
+				return err
+			},
+		},
+		{
+			Name:     "rebuild_recommendations",
+			Schedule: cfg.RecommendationsSchedule,
+			Run: func(ctx context.Context) error {
+				_, err := recommendationRepo.RebuildCoOccurrences(ctx, repository.CoOccurrenceBuildOptions{
+					Since:              time.Now().Add(-cfg.RecommendationsWindow),
+					TopN:               cfg.RecommendationsTopN,
+					MinVisitorSupport:  2,   // A single visitor is not a pattern
+					MinOrderSupport:    1,   // Orders are rare enough that one shared order counts
+					MaxItemsPerVisitor: 200, // Crawlers and bulk buyers would relate everything
+				})
+				return err
+			},
+		},
+		{
+			Name:     "prune_job_history",
+			Schedule: cfg.PruneHistorySchedule,
+			Run: func(ctx context.Context) error {
+				_, err := jobRunsRepo.PruneJobRuns(ctx, cfg.HistoryRetention)
+				return err
+			},
+		},
+	}
+
+	if purgeReportExports != nil {
+		jobs = append(jobs, scheduler.Job{
+			Name:     "purge_report_exports",
+			Schedule: cfg.PurgeReportExportsSchedule,
+			Run:      purgeReportExports,
+		})
+	}
+
+	for _, job := range jobs {
+		if job.Schedule == "" {
+			logger.Info().Str("job", job.Name).Msg("scheduled job disabled")
+			continue
+		}
+		if err := s.Register(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
 // initLogger initializes zerolog logger
 func initLogger(level, format string) zerolog.Logger {
 	// Parse log level
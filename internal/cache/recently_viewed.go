@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// RecentlyViewedStore keeps a bounded, most-recent-first list of viewed listings per user
+// and per anonymous session in Redis. Every list is a sorted set scored by view time, so a
+// repeated view moves the listing to the top instead of adding a duplicate.
+// Key patterns: recently_viewed:user:{user_id}, recently_viewed:session:{session_id}
+type RecentlyViewedStore struct {
+	client   *redis.Client
+	maxItems int
+	ttl      time.Duration
+	logger   zerolog.Logger
+}
+
+// NewRecentlyViewedStore creates a recently viewed store. Lists keep at most maxItems entries
+// and expire ttl after the last view.
+func NewRecentlyViewedStore(client *redis.Client, maxItems int, ttl time.Duration, logger zerolog.Logger) *RecentlyViewedStore {
+	if maxItems <= 0 {
+		maxItems = domain.MaxRecentlyViewedLimit
+	}
+	if ttl <= 0 {
+		ttl = 30 * 24 * time.Hour
+	}
+
+	return &RecentlyViewedStore{
+		client:   client,
+		maxItems: maxItems,
+		ttl:      ttl,
+		logger:   logger.With().Str("component", "recently_viewed_store").Logger(),
+	}
+}
+
+// Record adds a view to the list of a user (or, when userID is nil, of a session)
+func (s *RecentlyViewedStore) Record(ctx context.Context, userID *int64, sessionID string, listingID int64, viewedAt time.Time) error {
+	key, ok := recentlyViewedKey(userID, sessionID)
+	if !ok {
+		return nil
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(viewedAt.UnixMilli()), Member: listingID})
+	pipe.ZRemRangeByRank(ctx, key, 0, int64(-s.maxItems-1)) // Drop the oldest entries beyond the bound
+	pipe.Expire(ctx, key, s.ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record recently viewed listing: %w", err)
+	}
+	return nil
+}
+
+// List returns up to limit entries of a user's (or session's) list, most recent first
+func (s *RecentlyViewedStore) List(ctx context.Context, userID *int64, sessionID string, limit int) ([]domain.RecentlyViewedItem, error) {
+	key, ok := recentlyViewedKey(userID, sessionID)
+	if !ok || limit <= 0 {
+		return []domain.RecentlyViewedItem{}, nil
+	}
+
+	entries, err := s.client.ZRevRangeWithScores(ctx, key, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recently viewed listings: %w", err)
+	}
+
+	items := make([]domain.RecentlyViewedItem, 0, len(entries))
+	for _, entry := range entries {
+		member, _ := entry.Member.(string)
+		listingID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			s.logger.Warn().Str("key", key).Str("member", member).Msg("skipping malformed recently viewed entry")
+			continue
+		}
+		items = append(items, domain.RecentlyViewedItem{
+			ListingID: listingID,
+			ViewedAt:  time.UnixMilli(int64(entry.Score)).UTC(),
+		})
+	}
+
+	return items, nil
+}
+
+// Merge moves the list of an anonymous session into the list of the user who logged in.
+// A listing in both lists keeps its latest view time; the session list is deleted.
+func (s *RecentlyViewedStore) Merge(ctx context.Context, sessionID string, userID int64) error {
+	if sessionID == "" {
+		return nil
+	}
+	sessionKey, _ := recentlyViewedKey(nil, sessionID)
+	userKey, _ := recentlyViewedKey(&userID, "")
+
+	exists, err := s.client.Exists(ctx, sessionKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check session recently viewed list: %w", err)
+	}
+	if exists == 0 {
+		return nil
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.ZUnionStore(ctx, userKey, &redis.ZStore{Keys: []string{userKey, sessionKey}, Aggregate: "MAX"})
+	pipe.ZRemRangeByRank(ctx, userKey, 0, int64(-s.maxItems-1))
+	pipe.Expire(ctx, userKey, s.ttl)
+	pipe.Del(ctx, sessionKey)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to merge recently viewed lists: %w", err)
+	}
+
+	s.logger.Debug().Int64("user_id", userID).Msg("session recently viewed list merged")
+	return nil
+}
+
+// Clear removes one listing (or, when listingID is nil, everything) from the lists of a user
+// and of a session; either may be empty
+func (s *RecentlyViewedStore) Clear(ctx context.Context, userID *int64, sessionID string, listingID *int64) error {
+	var keys []string
+	if userID != nil {
+		key, _ := recentlyViewedKey(userID, "")
+		keys = append(keys, key)
+	}
+	if sessionID != "" {
+		key, _ := recentlyViewedKey(nil, sessionID)
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	var err error
+	if listingID == nil {
+		err = s.client.Del(ctx, keys...).Err()
+	} else {
+		pipe := s.client.TxPipeline()
+		for _, key := range keys {
+			pipe.ZRem(ctx, key, *listingID)
+		}
+		_, err = pipe.Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to clear recently viewed listings: %w", err)
+	}
+	return nil
+}
+
+// recentlyViewedKey returns the list key of a user, or of a session when userID is nil.
+// ok is false when neither is known.
+func recentlyViewedKey(userID *int64, sessionID string) (string, bool) {
+	if userID != nil {
+		return fmt.Sprintf("recently_viewed:user:%d", *userID), true
+	}
+	if sessionID != "" {
+		return fmt.Sprintf("recently_viewed:session:%s", sessionID), true
+	}
+	return "", false
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ViewDeduplicator remembers which viewers saw a listing within a window, so refreshes and
+// revisits count once. Every (listing, viewer) pair is a key that expires after the window.
+// Key pattern: view_dedup:{listing_id}:{sha1(viewer_key)}
+type ViewDeduplicator struct {
+	client *redis.Client
+	window time.Duration
+}
+
+// NewViewDeduplicator creates a view deduplicator with the given window
+func NewViewDeduplicator(client *redis.Client, window time.Duration) *ViewDeduplicator {
+	if window <= 0 {
+		window = 30 * time.Minute
+	}
+	return &ViewDeduplicator{client: client, window: window}
+}
+
+// FirstView reports whether this is the viewer's first view of the listing within the window
+func (d *ViewDeduplicator) FirstView(ctx context.Context, listingID int64, viewerKey string) (bool, error) {
+	// Viewer keys contain session IDs and IPs; hashing keeps them out of Redis and bounds the key length
+	sum := sha1.Sum([]byte(viewerKey))
+	key := fmt.Sprintf("view_dedup:%d:%s", listingID, hex.EncodeToString(sum[:]))
+
+	first, err := d.client.SetNX(ctx, key, 1, d.window).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to deduplicate view: %w", err)
+	}
+	return first, nil
+}
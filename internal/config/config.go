@@ -10,19 +10,31 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	App      AppConfig
-	Server   ServerConfig
-	DB       DBConfig
-	Redis    RedisConfig
-	Search   SearchConfig
-	Storage  StorageConfig
-	Auth     AuthConfig
-	Delivery DeliveryConfig
-	Worker   WorkerConfig
-	Features FeatureFlags
-	Tracing  TracingConfig
-	CORS     CORSConfig
-	Health   HealthConfig
+	App              AppConfig
+	Server           ServerConfig
+	DB               DBConfig
+	Redis            RedisConfig
+	Search           SearchConfig
+	Storage          StorageConfig
+	Auth             AuthConfig
+	Delivery         DeliveryConfig
+	Worker           WorkerConfig
+	Features         FeatureFlags
+	Tracing          TracingConfig
+	CORS             CORSConfig
+	Health           HealthConfig
+	Feed             FeedConfig
+	Sitemap          SitemapConfig
+	Expiration       ExpirationConfig
+	ScheduledPublish ScheduledPublishConfig
+	Duplicates       DuplicateConfig
+	ImageProcessing  ImageProcessingConfig
+	ImageGC          ImageGCConfig
+	AnalyticsEvents  AnalyticsEventsConfig
+	Scheduler        SchedulerConfig
+	ReportExports    ReportExportsConfig
+	RecentlyViewed   RecentlyViewedConfig
+	ViewCounting     ViewCountingConfig
 }
 
 // AppConfig contains general application settings
@@ -40,6 +52,9 @@ type ServerConfig struct {
 	HTTPPort    int    `envconfig:"SVETULISTINGS_HTTP_PORT" default:"8086"`
 	MetricsHost string `envconfig:"SVETULISTINGS_METRICS_HOST" default:"0.0.0.0"`
 	MetricsPort int    `envconfig:"SVETULISTINGS_METRICS_PORT" default:"9093"`
+
+	// Proxies (IPs or CIDRs) trusted to set X-Forwarded-For; without them the peer address is the client
+	TrustedProxies []string `envconfig:"SVETULISTINGS_TRUSTED_PROXIES" default:""`
 }
 
 // DBConfig contains PostgreSQL database configuration
@@ -109,7 +124,8 @@ type AuthConfig struct {
 	ServiceURL    string        `envconfig:"SVETULISTINGS_AUTH_SERVICE_URL" default:"http://localhost:8081"`
 	PublicKeyPath string        `envconfig:"SVETULISTINGS_AUTH_PUBLIC_KEY_PATH" default:"/keys/public.pem"`
 	Timeout       time.Duration `envconfig:"SVETULISTINGS_AUTH_TIMEOUT" default:"10s"`
-	Enabled       bool          `envconfig:"SVETULISTINGS_AUTH_ENABLED" default:"false"` // Disabled for Phase 13.1.15.8 until logger adapter is fixed
+	Enabled       bool          `envconfig:"SVETULISTINGS_AUTH_ENABLED" default:"false"`        // Disabled for Phase 13.1.15.8 until logger adapter is fixed
+	TrustMetadata bool          `envconfig:"SVETULISTINGS_AUTH_TRUST_METADATA" default:"false"` // Identify callers without a JWT by gateway-set user_id/roles metadata
 }
 
 // DeliveryConfig contains Delivery microservice integration settings
@@ -160,6 +176,113 @@ type HealthConfig struct {
 	EnableDeepChecks bool          `envconfig:"SVETULISTINGS_HEALTH_ENABLE_DEEP_CHECKS" default:"true"`
 }
 
+// FeedConfig contains product feed (Google Merchant, Facebook catalog) settings
+type FeedConfig struct {
+	Enabled  bool          `envconfig:"SVETULISTINGS_FEED_ENABLED" default:"true"`
+	BaseURL  string        `envconfig:"SVETULISTINGS_FEED_BASE_URL" default:"https://svetu.rs"`
+	CacheTTL time.Duration `envconfig:"SVETULISTINGS_FEED_CACHE_TTL" default:"1h"`
+	PageSize int           `envconfig:"SVETULISTINGS_FEED_PAGE_SIZE" default:"100"`
+}
+
+// SitemapConfig contains sitemap.xml and robots.txt settings
+type SitemapConfig struct {
+	Enabled     bool          `envconfig:"SVETULISTINGS_SITEMAP_ENABLED" default:"true"`
+	BaseURL     string        `envconfig:"SVETULISTINGS_SITEMAP_BASE_URL" default:"https://svetu.rs"`
+	CacheTTL    time.Duration `envconfig:"SVETULISTINGS_SITEMAP_CACHE_TTL" default:"6h"`
+	URLsPerFile int           `envconfig:"SVETULISTINGS_SITEMAP_URLS_PER_FILE" default:"50000"` // sitemaps.org maximum
+}
+
+// ExpirationConfig contains C2C listing expiration and renewal settings
+type ExpirationConfig struct {
+	Enabled            bool          `envconfig:"SVETULISTINGS_EXPIRATION_ENABLED" default:"true"`
+	Interval           time.Duration `envconfig:"SVETULISTINGS_EXPIRATION_INTERVAL" default:"15m"`
+	ReminderDays       int           `envconfig:"SVETULISTINGS_EXPIRATION_REMINDER_DAYS" default:"3"`
+	BatchSize          int           `envconfig:"SVETULISTINGS_EXPIRATION_BATCH_SIZE" default:"500"`
+	DefaultRenewalDays int           `envconfig:"SVETULISTINGS_EXPIRATION_DEFAULT_RENEWAL_DAYS" default:"30"`
+}
+
+// ScheduledPublishConfig contains settings for publishing approved listings at their scheduled time
+type ScheduledPublishConfig struct {
+	Enabled   bool          `envconfig:"SVETULISTINGS_SCHEDULED_PUBLISH_ENABLED" default:"true"`
+	Interval  time.Duration `envconfig:"SVETULISTINGS_SCHEDULED_PUBLISH_INTERVAL" default:"1m"`
+	BatchSize int           `envconfig:"SVETULISTINGS_SCHEDULED_PUBLISH_BATCH_SIZE" default:"200"`
+}
+
+// DuplicateConfig contains near-duplicate listing detection settings
+type DuplicateConfig struct {
+	Mode             string  `envconfig:"SVETULISTINGS_DUPLICATES_MODE" default:"flag"` // off, report, flag, block
+	TextThreshold    float64 `envconfig:"SVETULISTINGS_DUPLICATES_TEXT_THRESHOLD" default:"0.8"`
+	ImageMaxDistance int     `envconfig:"SVETULISTINGS_DUPLICATES_IMAGE_MAX_DISTANCE" default:"8"`
+	MaxCandidates    int     `envconfig:"SVETULISTINGS_DUPLICATES_MAX_CANDIDATES" default:"50"`
+}
+
+// ImageProcessingConfig contains settings for the asynchronous image processing workers
+type ImageProcessingConfig struct {
+	Concurrency  int           `envconfig:"SVETULISTINGS_IMAGE_PROCESSING_CONCURRENCY" default:"2"`
+	PollInterval time.Duration `envconfig:"SVETULISTINGS_IMAGE_PROCESSING_POLL_INTERVAL" default:"2s"`
+	BatchSize    int           `envconfig:"SVETULISTINGS_IMAGE_PROCESSING_BATCH_SIZE" default:"5"`
+	JobTimeout   time.Duration `envconfig:"SVETULISTINGS_IMAGE_PROCESSING_JOB_TIMEOUT" default:"2m"`
+}
+
+// ImageGCConfig contains settings for garbage collection of unreferenced image objects
+type ImageGCConfig struct {
+	Enabled     bool          `envconfig:"SVETULISTINGS_IMAGE_GC_ENABLED" default:"true"`
+	Interval    time.Duration `envconfig:"SVETULISTINGS_IMAGE_GC_INTERVAL" default:"6h"`
+	GracePeriod time.Duration `envconfig:"SVETULISTINGS_IMAGE_GC_GRACE_PERIOD" default:"24h"` // Objects younger than this are kept (uploads in flight)
+	DryRun      bool          `envconfig:"SVETULISTINGS_IMAGE_GC_DRY_RUN" default:"true"`     // Only log unreferenced objects until the reference check is verified
+}
+
+// AnalyticsEventsConfig contains settings for the buffered analytics event writer
+type AnalyticsEventsConfig struct {
+	Enabled       bool          `envconfig:"SVETULISTINGS_ANALYTICS_EVENTS_ENABLED" default:"true"`
+	BufferSize    int           `envconfig:"SVETULISTINGS_ANALYTICS_EVENTS_BUFFER_SIZE" default:"10000"` // Events beyond this are dropped
+	BatchSize     int           `envconfig:"SVETULISTINGS_ANALYTICS_EVENTS_BATCH_SIZE" default:"500"`
+	FlushInterval time.Duration `envconfig:"SVETULISTINGS_ANALYTICS_EVENTS_FLUSH_INTERVAL" default:"2s"`
+}
+
+// ReportExportsConfig contains settings for the asynchronous report export workers
+type ReportExportsConfig struct {
+	Enabled      bool          `envconfig:"SVETULISTINGS_REPORT_EXPORTS_ENABLED" default:"true"`
+	Concurrency  int           `envconfig:"SVETULISTINGS_REPORT_EXPORTS_CONCURRENCY" default:"1"`
+	PollInterval time.Duration `envconfig:"SVETULISTINGS_REPORT_EXPORTS_POLL_INTERVAL" default:"5s"`
+	JobTimeout   time.Duration `envconfig:"SVETULISTINGS_REPORT_EXPORTS_JOB_TIMEOUT" default:"10m"`
+	Retention    time.Duration `envconfig:"SVETULISTINGS_REPORT_EXPORTS_RETENTION" default:"168h"` // Files are deleted after 7 days
+}
+
+// RecentlyViewedConfig contains settings for the per-user/session recently viewed lists
+type RecentlyViewedConfig struct {
+	Enabled  bool          `envconfig:"SVETULISTINGS_RECENTLY_VIEWED_ENABLED" default:"true"`
+	MaxItems int           `envconfig:"SVETULISTINGS_RECENTLY_VIEWED_MAX_ITEMS" default:"50"` // Older views are dropped
+	TTL      time.Duration `envconfig:"SVETULISTINGS_RECENTLY_VIEWED_TTL" default:"720h"`     // Lists expire 30 days after the last view
+}
+
+// ViewCountingConfig contains settings for deduplicated, buffered view counting
+type ViewCountingConfig struct {
+	Enabled       bool          `envconfig:"SVETULISTINGS_VIEW_COUNTING_ENABLED" default:"true"`
+	DedupWindow   time.Duration `envconfig:"SVETULISTINGS_VIEW_COUNTING_DEDUP_WINDOW" default:"30m"` // Repeat views of a viewer within the window count once
+	FlushInterval time.Duration `envconfig:"SVETULISTINGS_VIEW_COUNTING_FLUSH_INTERVAL" default:"10s"`
+}
+
+// SchedulerConfig contains settings for the periodic job scheduler.
+// Schedules are 5-field cron expressions in UTC; an empty schedule disables the job.
+type SchedulerConfig struct {
+	Enabled                    bool          `envconfig:"SVETULISTINGS_SCHEDULER_ENABLED" default:"true"`
+	JobTimeout                 time.Duration `envconfig:"SVETULISTINGS_SCHEDULER_JOB_TIMEOUT" default:"10m"`
+	RefreshViewsSchedule       string        `envconfig:"SVETULISTINGS_SCHEDULER_REFRESH_VIEWS_SCHEDULE" default:"*/15 * * * *"`
+	TrendingCacheSchedule      string        `envconfig:"SVETULISTINGS_SCHEDULER_TRENDING_CACHE_SCHEDULE" default:"5 * * * *"`
+	ArchiveEventsSchedule      string        `envconfig:"SVETULISTINGS_SCHEDULER_ARCHIVE_EVENTS_SCHEDULE" default:"30 3 * * *"`
+	EventRetention             time.Duration `envconfig:"SVETULISTINGS_SCHEDULER_EVENT_RETENTION" default:"2160h"` // 90 days
+	ArchiveBatchSize           int           `envconfig:"SVETULISTINGS_SCHEDULER_ARCHIVE_BATCH_SIZE" default:"50000"`
+	SearchQueryCleanupSchedule string        `envconfig:"SVETULISTINGS_SCHEDULER_SEARCH_QUERY_CLEANUP_SCHEDULE" default:"0 4 * * *"`
+	SearchQueryRetentionDays   int32         `envconfig:"SVETULISTINGS_SCHEDULER_SEARCH_QUERY_RETENTION_DAYS" default:"90"`
+	PruneHistorySchedule       string        `envconfig:"SVETULISTINGS_SCHEDULER_PRUNE_HISTORY_SCHEDULE" default:"0 5 * * *"`
+	HistoryRetention           time.Duration `envconfig:"SVETULISTINGS_SCHEDULER_HISTORY_RETENTION" default:"720h"` // 30 days
+	PurgeReportExportsSchedule string        `envconfig:"SVETULISTINGS_SCHEDULER_PURGE_REPORT_EXPORTS_SCHEDULE" default:"15 * * * *"`
+	RecommendationsSchedule    string        `envconfig:"SVETULISTINGS_SCHEDULER_RECOMMENDATIONS_SCHEDULE" default:"45 */6 * * *"`
+	RecommendationsWindow      time.Duration `envconfig:"SVETULISTINGS_SCHEDULER_RECOMMENDATIONS_WINDOW" default:"2160h"` // Interactions older than 90 days are ignored
+	RecommendationsTopN        int           `envconfig:"SVETULISTINGS_SCHEDULER_RECOMMENDATIONS_TOP_N" default:"30"`     // Neighbors stored per listing
+}
+
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file (ignore error if file doesn't exist - OK for production)
@@ -192,6 +315,12 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("database name is required")
 	}
 
+	switch c.Duplicates.Mode {
+	case "off", "report", "flag", "block":
+	default:
+		return fmt.Errorf("invalid duplicates mode: %q (expected off, report, flag or block)", c.Duplicates.Mode)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,101 @@
+package dedup
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShingles(t *testing.T) {
+	assert.Equal(t, []string{"iphone 13 pro", "13 pro max"}, Shingles("iPhone 13 Pro, MAX!"))
+	assert.Equal(t, []string{"bike"}, Shingles("  Bike "))
+	assert.Nil(t, Shingles(" -- "))
+}
+
+func TestSignature_Similarity(t *testing.T) {
+	original := NewSignature("Selling my mountain bike, 21 speed, aluminium frame, barely used, pickup in Novi Sad")
+	repost := NewSignature("Selling my mountain bike 21 speed aluminium frame barely used pickup in Novi Sad!!")
+	edited := NewSignature("Selling my mountain bike, 21 speed, aluminium frame, barely used, pickup in Belgrade")
+	other := NewSignature("Vintage leather sofa in excellent condition, three seats, delivery possible")
+
+	require.Len(t, original, SignatureSize)
+	assert.Equal(t, 1.0, original.Similarity(repost))
+	assert.Greater(t, original.Similarity(edited), 0.6)
+	assert.Less(t, original.Similarity(other), 0.2)
+	assert.Equal(t, 0.0, original.Similarity(nil))
+	assert.Nil(t, NewSignature(""))
+}
+
+func TestSignature_BandKeys(t *testing.T) {
+	a := NewSignature("Selling my mountain bike, 21 speed, aluminium frame, barely used")
+	b := NewSignature("selling my MOUNTAIN bike 21 speed aluminium frame barely used")
+
+	keysA := a.BandKeys()
+	require.Len(t, keysA, Bands)
+	assert.Equal(t, keysA, b.BandKeys())
+	assert.Nil(t, Signature(nil).BandKeys())
+}
+
+func TestSignature_Int64sRoundTrip(t *testing.T) {
+	sig := NewSignature("round trip through a bigint array column")
+	assert.Equal(t, sig, SignatureFromInt64s(sig.Int64s()))
+}
+
+func TestImageHash(t *testing.T) {
+	gradient := func(w, h int, invert bool) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				v := uint8(x * 255 / w)
+				if invert {
+					v = 255 - v
+				}
+				img.Set(x, y, color.RGBA{R: v, G: uint8(y * 255 / h), B: v, A: 255})
+			}
+		}
+		return img
+	}
+
+	original := ImageHash(gradient(640, 480, false))
+	resized := ImageHash(gradient(320, 240, false))
+	inverted := ImageHash(gradient(640, 480, true))
+
+	assert.LessOrEqual(t, HammingDistance(original, resized), 4)
+	assert.Greater(t, HammingDistance(original, inverted), 32)
+}
+
+func TestImageBandKeys(t *testing.T) {
+	hash := uint64(0x0123_4567_89ab_cdef)
+
+	assert.Equal(t, ImageHashBandKeys(hash), ImageBandKeys(hash, 3), "below one bit per band only exact bands match")
+	assert.Len(t, ImageBandKeys(hash, 8), ImageHashBands*(1+16+120))
+	assert.Nil(t, ImageBandKeys(hash, -1))
+
+	// Every hash within the distance shares at least one band key with the query
+	similar := []uint64{
+		hash ^ 0b11,
+		hash ^ 0x8000_0000_0000_0001,
+		hash ^ 0x0003_0003_0003_0003, // two bits in every band
+	}
+	for _, other := range similar {
+		require.LessOrEqual(t, HammingDistance(hash, other), 8)
+		assert.True(t, sharesKey(ImageBandKeys(hash, 8), ImageHashBandKeys(other)), "%016x", other)
+	}
+
+	far := hash ^ 0x0007_0007_0007_0007
+	assert.False(t, sharesKey(ImageBandKeys(hash, 8), ImageHashBandKeys(far)))
+}
+
+func sharesKey(a, b []int64) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
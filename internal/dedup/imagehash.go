@@ -0,0 +1,112 @@
+package dedup
+
+import (
+	"image"
+	"math/bits"
+
+	"github.com/nfnt/resize"
+)
+
+const (
+	// ImageHashBands is the number of 16-bit bands an image hash is indexed by
+	ImageHashBands = 4
+
+	// imageBandBits is the width of one band
+	imageBandBits = 64 / ImageHashBands
+)
+
+// ImageHash computes a 64-bit difference hash (dHash) of an image.
+// Visually similar images (re-encoded, resized, slightly cropped or recolored)
+// produce hashes with a small Hamming distance.
+func ImageHash(img image.Image) uint64 {
+	// 9x8 grayscale grid: each row yields 8 left/right brightness comparisons
+	small := resize.Resize(9, 8, img, resize.Bilinear)
+	bounds := small.Bounds()
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			left := luminance(small, bounds.Min.X+x, bounds.Min.Y+y)
+			right := luminance(small, bounds.Min.X+x+1, bounds.Min.Y+y)
+			hash <<= 1
+			if left < right {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// HammingDistance returns the number of differing bits between two image hashes
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// ImageBandKeys returns the multi-index hashing keys of every image hash within maxDistance
+// bits of hash. The 64-bit hash is split into ImageHashBands 16-bit bands and an image hash
+// is keyed by its band values (see ImageHashBandKeys). Two hashes within maxDistance bits differ
+// in at most maxDistance/ImageHashBands bits of some band, so enumerating the band values in
+// that radius finds every match without comparing against all stored hashes.
+func ImageBandKeys(hash uint64, maxDistance int) []int64 {
+	if maxDistance < 0 {
+		return nil
+	}
+	radius := maxDistance / ImageHashBands
+	if radius > imageBandBits {
+		radius = imageBandBits
+	}
+
+	keys := make([]int64, 0, ImageHashBands*bandVariants(radius))
+	for b, value := range imageBands(hash) {
+		keys = appendBandVariants(keys, int64(b)<<imageBandBits, value, 0, radius)
+	}
+	return keys
+}
+
+// ImageHashBandKeys returns the band keys stored for an image hash
+// Must match the phash_bands generated column of listing_images
+func ImageHashBandKeys(hash uint64) []int64 {
+	keys := make([]int64, ImageHashBands)
+	for b, value := range imageBands(hash) {
+		keys[b] = int64(b)<<imageBandBits | int64(value)
+	}
+	return keys
+}
+
+// imageBands splits a hash into its bands, most significant first
+func imageBands(hash uint64) [ImageHashBands]uint16 {
+	var bands [ImageHashBands]uint16
+	for b := range bands {
+		bands[b] = uint16(hash >> (imageBandBits * (ImageHashBands - 1 - b)))
+	}
+	return bands
+}
+
+// appendBandVariants appends the keys of all band values within radius bits of value,
+// flipping bits from position from upwards
+func appendBandVariants(keys []int64, prefix int64, value uint16, from, radius int) []int64 {
+	keys = append(keys, prefix|int64(value))
+	if radius == 0 {
+		return keys
+	}
+	for bit := from; bit < imageBandBits; bit++ {
+		keys = appendBandVariants(keys, prefix, value^(1<<bit), bit+1, radius-1)
+	}
+	return keys
+}
+
+// bandVariants returns the number of band values within radius bits of a band value
+func bandVariants(radius int) int {
+	total, choose := 0, 1
+	for k := 0; k <= radius; k++ {
+		total += choose
+		choose = choose * (imageBandBits - k) / (k + 1)
+	}
+	return total
+}
+
+func luminance(img image.Image, x, y int) uint32 {
+	r, g, b, _ := img.At(x, y).RGBA()
+	// ITU-R BT.601 luma weights
+	return (299*r + 587*g + 114*b) / 1000
+}
@@ -0,0 +1,156 @@
+// Package dedup provides near-duplicate detection primitives: MinHash signatures of
+// listing text (word shingles) and perceptual hashes of images.
+package dedup
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// SignatureSize is the number of MinHash values per signature
+	SignatureSize = 64
+
+	// Bands and rows used for locality-sensitive hashing (Bands * rowsPerBand = SignatureSize).
+	// 16 bands of 4 rows find candidates with Jaccard similarity above ~0.5 with high probability.
+	Bands       = 16
+	rowsPerBand = SignatureSize / Bands
+
+	// shingleSize is the number of consecutive words per shingle
+	shingleSize = 3
+)
+
+// Signature is a MinHash signature of a text
+type Signature []uint64
+
+// Shingles splits normalized text into overlapping word n-grams.
+// Texts shorter than the shingle size produce a single shingle of all words.
+func Shingles(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+	if len(words) < shingleSize {
+		return []string{strings.Join(words, " ")}
+	}
+
+	seen := make(map[string]struct{}, len(words))
+	shingles := make([]string, 0, len(words)-shingleSize+1)
+	for i := 0; i+shingleSize <= len(words); i++ {
+		shingle := strings.Join(words[i:i+shingleSize], " ")
+		if _, ok := seen[shingle]; ok {
+			continue
+		}
+		seen[shingle] = struct{}{}
+		shingles = append(shingles, shingle)
+	}
+	return shingles
+}
+
+// NewSignature computes the MinHash signature of a text (nil if the text has no words)
+func NewSignature(text string) Signature {
+	shingles := Shingles(text)
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	sig := make(Signature, SignatureSize)
+	for i := range sig {
+		sig[i] = ^uint64(0)
+	}
+
+	for _, shingle := range shingles {
+		h := hashString(shingle)
+		for i := range sig {
+			// Independent hash functions derived from one base hash
+			if v := mix(h ^ seeds[i]); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Similarity estimates the Jaccard similarity of the texts behind two signatures (0..1)
+func (s Signature) Similarity(other Signature) float64 {
+	if len(s) != SignatureSize || len(other) != SignatureSize {
+		return 0
+	}
+	equal := 0
+	for i := range s {
+		if s[i] == other[i] {
+			equal++
+		}
+	}
+	return float64(equal) / SignatureSize
+}
+
+// BandKeys returns the LSH bucket keys of the signature; texts sharing a key are duplicate candidates
+func (s Signature) BandKeys() []int64 {
+	if len(s) != SignatureSize {
+		return nil
+	}
+
+	keys := make([]int64, Bands)
+	buf := make([]byte, 8)
+	for b := 0; b < Bands; b++ {
+		h := fnv.New64a()
+		binary.BigEndian.PutUint64(buf, uint64(b))
+		_, _ = h.Write(buf)
+		for _, v := range s[b*rowsPerBand : (b+1)*rowsPerBand] {
+			binary.BigEndian.PutUint64(buf, v)
+			_, _ = h.Write(buf)
+		}
+		keys[b] = int64(h.Sum64())
+	}
+	return keys
+}
+
+// Int64s converts the signature for storage in a BIGINT[] column
+func (s Signature) Int64s() []int64 {
+	out := make([]int64, len(s))
+	for i, v := range s {
+		out[i] = int64(v)
+	}
+	return out
+}
+
+// SignatureFromInt64s restores a signature stored as BIGINT[]
+func SignatureFromInt64s(values []int64) Signature {
+	sig := make(Signature, len(values))
+	for i, v := range values {
+		sig[i] = uint64(v)
+	}
+	return sig
+}
+
+// seeds are the per-position salts of the MinHash family (fixed so stored signatures stay comparable)
+var seeds = func() [SignatureSize]uint64 {
+	var out [SignatureSize]uint64
+	x := uint64(0x9E3779B97F4A7C15)
+	for i := range out {
+		x = mix(x + uint64(i))
+		out[i] = x
+	}
+	return out
+}()
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix is the splitmix64 finalizer
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xBF58476D1CE4E5B9
+	x ^= x >> 27
+	x *= 0x94D049BB133111EB
+	x ^= x >> 31
+	return x
+}
@@ -48,6 +48,18 @@ type TimeSeriesDataPoint struct {
 	ConversionRate float64 `json:"conversion_rate" db:"-"` // (orders / views) * 100
 }
 
+// ListingAnalyticsInfo identifies a listing and its owner for listing analytics
+// (access checks and the descriptive fields of GetListingStats responses)
+type ListingAnalyticsInfo struct {
+	ListingID    int64  `json:"listing_id" db:"id"`
+	Title        string `json:"title" db:"title"`
+	SourceType   string `json:"source_type" db:"source_type"` // c2c or b2c
+	UserID       int64  `json:"user_id" db:"user_id"`
+	StorefrontID *int64 `json:"storefront_id,omitempty" db:"storefront_id"`
+	CategoryID   int64  `json:"category_id" db:"category_id"`
+	CategoryName string `json:"category_name" db:"category_name"`
+}
+
 // ListingStats represents engagement, conversion, and revenue metrics for a single listing
 type ListingStats struct {
 	// Identification
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// ANALYTICS EVENTS
+// ============================================================================
+
+// Analytics event types (analytics_events.event_type)
+const (
+	AnalyticsEventImpression     = "impression" // Listing shown in search results or a feed
+	AnalyticsEventView           = "view"
+	AnalyticsEventFavorite       = "favorite"
+	AnalyticsEventAddToCart      = "add_to_cart"
+	AnalyticsEventSearch         = "search"
+	AnalyticsEventInquiry        = "inquiry"
+	AnalyticsEventOrderCreated   = "order_created"
+	AnalyticsEventOrderCompleted = "order_completed"
+)
+
+// Analytics entity types (analytics_events.entity_type)
+const (
+	AnalyticsEntityListing = "listing"
+	AnalyticsEntitySearch  = "search"
+)
+
+// Analytics ingestion limits
+const (
+	// MaxTrackEventsBatch is the maximum number of events accepted by a single TrackEvents call
+	MaxTrackEventsBatch = 100
+
+	// MaxAnalyticsEventAge is how far in the past a client-reported event time may lie
+	// (clients may send events buffered while offline)
+	MaxAnalyticsEventAge = 24 * time.Hour
+
+	// MaxAnalyticsSessionIDLength matches analytics_events.session_id
+	MaxAnalyticsSessionIDLength = 100
+
+	// MaxAnalyticsMetadataKeys bounds the metadata attached by clients
+	MaxAnalyticsMetadataKeys = 20
+)
+
+// clientAnalyticsEvents are the event types clients may report. Favorites, cart additions
+// and orders are emitted by the server only, so clients cannot inflate them (or revenue).
+var clientAnalyticsEvents = map[string]string{
+	AnalyticsEventImpression: AnalyticsEntityListing,
+	AnalyticsEventView:       AnalyticsEntityListing,
+	AnalyticsEventInquiry:    AnalyticsEntityListing,
+	AnalyticsEventSearch:     AnalyticsEntitySearch,
+}
+
+// AnalyticsEvent is a single row of analytics_events
+type AnalyticsEvent struct {
+	EventType  string                 `json:"event_type" db:"event_type"`
+	EntityType string                 `json:"entity_type" db:"entity_type"`
+	EntityID   int64                  `json:"entity_id" db:"entity_id"`
+	UserID     *int64                 `json:"user_id,omitempty" db:"user_id"`
+	SessionID  *string                `json:"session_id,omitempty" db:"session_id"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty" db:"metadata"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+}
+
+// NewListingEvent creates a server-side event about a listing
+func NewListingEvent(eventType string, listingID int64, userID *int64, metadata map[string]interface{}) *AnalyticsEvent {
+	return &AnalyticsEvent{
+		EventType:  eventType,
+		EntityType: AnalyticsEntityListing,
+		EntityID:   listingID,
+		UserID:     userID,
+		Metadata:   metadata,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// ValidateClientEvent validates an event reported by a client and normalizes its entity
+// type and timestamp. Timestamps outside [now-MaxAnalyticsEventAge, now] are replaced by now.
+func (e *AnalyticsEvent) ValidateClientEvent(now time.Time) error {
+	entityType, ok := clientAnalyticsEvents[e.EventType]
+	if !ok {
+		return fmt.Errorf("event_type %q cannot be tracked by clients", e.EventType)
+	}
+	e.EntityType = entityType
+
+	// Search events describe a query, not an entity; entity_id carries the category (0 = none)
+	if e.EntityID < 0 || (e.EntityID == 0 && entityType == AnalyticsEntityListing) {
+		return fmt.Errorf("entity_id must be greater than 0")
+	}
+	if e.UserID == nil && (e.SessionID == nil || *e.SessionID == "") {
+		return fmt.Errorf("session_id is required for anonymous events")
+	}
+	if e.SessionID != nil && len(*e.SessionID) > MaxAnalyticsSessionIDLength {
+		return fmt.Errorf("session_id must be at most %d characters", MaxAnalyticsSessionIDLength)
+	}
+	if len(e.Metadata) > MaxAnalyticsMetadataKeys {
+		return fmt.Errorf("metadata must have at most %d keys", MaxAnalyticsMetadataKeys)
+	}
+
+	if e.CreatedAt.IsZero() || e.CreatedAt.After(now) || e.CreatedAt.Before(now.Add(-MaxAnalyticsEventAge)) {
+		e.CreatedAt = now
+	}
+
+	return nil
+}
+
+// listingMetadataKeys are the listing attributes in event metadata (see Listing.AnalyticsMetadata)
+var listingMetadataKeys = []string{"category_id", "source_type", "storefront_id"}
+
+// SetListingMetadata replaces the listing attributes in the metadata of a client event with
+// those of the listing. GetOverviewStats filters on them, so values sent by clients are never
+// kept; a nil listing only removes them (search events).
+func (e *AnalyticsEvent) SetListingMetadata(info *ListingAnalyticsInfo) {
+	for _, key := range listingMetadataKeys {
+		delete(e.Metadata, key)
+	}
+	if info == nil {
+		return
+	}
+
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]interface{}, len(listingMetadataKeys))
+	}
+	e.Metadata["category_id"] = info.CategoryID
+	e.Metadata["source_type"] = info.SourceType
+	if info.StorefrontID != nil {
+		e.Metadata["storefront_id"] = *info.StorefrontID
+	}
+}
+
+// AnalyticsMetadata returns the listing attributes GetOverviewStats filters on
+func (l *Listing) AnalyticsMetadata() map[string]interface{} {
+	metadata := map[string]interface{}{
+		"category_id": l.CategoryID,
+		"source_type": l.SourceType,
+	}
+	if l.StorefrontID != nil {
+		metadata["storefront_id"] = *l.StorefrontID
+	}
+	return metadata
+}
+
+// OrderAnalyticsEvents creates one event per order item. Listing stats are keyed by
+// listing, so revenue (metadata.total_amount) is attributed to each item's listing.
+func OrderAnalyticsEvents(order *Order, eventType string) []*AnalyticsEvent {
+	events := make([]*AnalyticsEvent, 0, len(order.Items))
+	for _, item := range order.Items {
+		e := NewListingEvent(eventType, item.ListingID, order.UserID, map[string]interface{}{
+			"order_id":      order.ID,
+			"storefront_id": order.StorefrontID,
+			"quantity":      item.Quantity,
+			"total_amount":  item.Total,
+			"currency":      order.Currency,
+		})
+		events = append(events, e)
+	}
+	return events
+}
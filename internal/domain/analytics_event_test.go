@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsEvent_ValidateClientEvent(t *testing.T) {
+	now := time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+	userID := int64(42)
+	session := "sess-1"
+
+	tests := []struct {
+		name    string
+		event   AnalyticsEvent
+		wantErr string
+	}{
+		{name: "listing view", event: AnalyticsEvent{EventType: AnalyticsEventView, EntityID: 7, UserID: &userID}},
+		{name: "anonymous inquiry", event: AnalyticsEvent{EventType: AnalyticsEventInquiry, EntityID: 7, SessionID: &session}},
+		{name: "search without category", event: AnalyticsEvent{EventType: AnalyticsEventSearch, SessionID: &session}},
+		{name: "favorites are server-side", event: AnalyticsEvent{EventType: AnalyticsEventFavorite, EntityID: 7, UserID: &userID}, wantErr: "cannot be tracked"},
+		{name: "orders are server-side", event: AnalyticsEvent{EventType: AnalyticsEventOrderCompleted, EntityID: 7, UserID: &userID}, wantErr: "cannot be tracked"},
+		{name: "view without listing", event: AnalyticsEvent{EventType: AnalyticsEventView, UserID: &userID}, wantErr: "entity_id"},
+		{name: "anonymous without session", event: AnalyticsEvent{EventType: AnalyticsEventView, EntityID: 7}, wantErr: "session_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.event
+			err := e.ValidateClientEvent(now)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, e.EntityType)
+			assert.Equal(t, now, e.CreatedAt)
+		})
+	}
+}
+
+func TestAnalyticsEvent_ValidateClientEvent_Timestamp(t *testing.T) {
+	now := time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+	session := "sess-1"
+
+	recent := now.Add(-time.Hour)
+	e := &AnalyticsEvent{EventType: AnalyticsEventView, EntityID: 1, SessionID: &session, CreatedAt: recent}
+	require.NoError(t, e.ValidateClientEvent(now))
+	assert.Equal(t, recent, e.CreatedAt, "buffered client events keep their time")
+
+	e.CreatedAt = now.Add(-MaxAnalyticsEventAge - time.Minute)
+	require.NoError(t, e.ValidateClientEvent(now))
+	assert.Equal(t, now, e.CreatedAt, "stale timestamps are replaced")
+
+	e.CreatedAt = now.Add(time.Hour)
+	require.NoError(t, e.ValidateClientEvent(now))
+	assert.Equal(t, now, e.CreatedAt, "future timestamps are replaced")
+}
+
+func TestAnalyticsEvent_SetListingMetadata(t *testing.T) {
+	storefrontID := int64(5)
+	info := &ListingAnalyticsInfo{ListingID: 7, SourceType: "b2c", StorefrontID: &storefrontID, CategoryID: 3}
+
+	e := &AnalyticsEvent{
+		EventType: AnalyticsEventView,
+		EntityID:  7,
+		Metadata:  map[string]interface{}{"storefront_id": "99", "category_id": "1", "referrer": "feed"},
+	}
+	e.SetListingMetadata(info)
+	assert.Equal(t, map[string]interface{}{
+		"storefront_id": int64(5),
+		"category_id":   int64(3),
+		"source_type":   "b2c",
+		"referrer":      "feed",
+	}, e.Metadata, "client values are replaced by the listing's")
+
+	search := &AnalyticsEvent{EventType: AnalyticsEventSearch, Metadata: map[string]interface{}{"storefront_id": "99", "query": "bike"}}
+	search.SetListingMetadata(nil)
+	assert.Equal(t, map[string]interface{}{"query": "bike"}, search.Metadata)
+
+	noMetadata := &AnalyticsEvent{EventType: AnalyticsEventImpression, EntityID: 7}
+	noMetadata.SetListingMetadata(&ListingAnalyticsInfo{ListingID: 7, SourceType: "c2c", CategoryID: 3})
+	assert.Equal(t, map[string]interface{}{"category_id": int64(3), "source_type": "c2c"}, noMetadata.Metadata)
+}
+
+func TestOrderAnalyticsEvents(t *testing.T) {
+	userID := int64(42)
+	order := &Order{
+		ID:           10,
+		UserID:       &userID,
+		StorefrontID: 3,
+		Currency:     "RSD",
+		Items: []*OrderItem{
+			{ListingID: 100, Quantity: 2, Total: 2000},
+			{ListingID: 200, Quantity: 1, Total: 500},
+		},
+	}
+
+	events := OrderAnalyticsEvents(order, AnalyticsEventOrderCompleted)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(100), events[0].EntityID)
+	assert.Equal(t, AnalyticsEntityListing, events[0].EntityType)
+	assert.Equal(t, &userID, events[0].UserID)
+	assert.Equal(t, 2000.0, events[0].Metadata["total_amount"])
+	assert.Equal(t, int64(10), events[1].Metadata["order_id"])
+}
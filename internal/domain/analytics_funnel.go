@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ============================================================================
+// CONVERSION FUNNEL
+// ============================================================================
+
+// Funnel and cohort query limits
+const (
+	// MaxFunnelRangeDays bounds the period of a single funnel query
+	MaxFunnelRangeDays = 365
+
+	// DefaultCohortWeeks and MaxCohortWeeks bound the number of weekly buyer cohorts
+	DefaultCohortWeeks = 8
+	MaxCohortWeeks     = 26
+)
+
+// GetFunnelFilter selects the storefront (and optionally one of its products) and period
+// of a conversion funnel. The period is half-open: [StartDate, EndDate).
+type GetFunnelFilter struct {
+	StorefrontID int64     `json:"storefront_id"`
+	ListingID    *int64    `json:"listing_id,omitempty"` // Product-level funnel
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+}
+
+// Validate validates GetFunnelFilter
+func (filter *GetFunnelFilter) Validate() error {
+	if filter == nil {
+		return errors.New("filter cannot be nil")
+	}
+	if filter.StorefrontID <= 0 {
+		return errors.New("storefront_id must be greater than 0")
+	}
+	if filter.ListingID != nil && *filter.ListingID <= 0 {
+		return errors.New("listing_id must be greater than 0")
+	}
+	if filter.StartDate.IsZero() || filter.EndDate.IsZero() {
+		return errors.New("start_date and end_date are required")
+	}
+	if !filter.StartDate.Before(filter.EndDate) {
+		return errors.New("start_date must be before end_date")
+	}
+	if filter.EndDate.Sub(filter.StartDate) > MaxFunnelRangeDays*24*time.Hour {
+		return errors.New("date range cannot exceed 365 days")
+	}
+	return nil
+}
+
+// PreviousPeriod returns the filter for the period of equal length right before this one
+func (filter *GetFunnelFilter) PreviousPeriod() *GetFunnelFilter {
+	previous := *filter
+	previous.EndDate = filter.StartDate
+	previous.StartDate = filter.StartDate.Add(-filter.EndDate.Sub(filter.StartDate))
+	return &previous
+}
+
+// FunnelStats counts distinct visitors (users, or sessions for anonymous visitors) reaching
+// each stage of the purchase funnel: impression → view → engaged (favorite or add to cart)
+// → ordered → delivered. Order stages count buyers (guest orders count individually).
+type FunnelStats struct {
+	Impressions int64 `json:"impressions" db:"impressions"`
+	Views       int64 `json:"views" db:"views"`
+	Engaged     int64 `json:"engaged" db:"engaged"`
+	Ordered     int64 `json:"ordered" db:"ordered"`
+	Delivered   int64 `json:"delivered" db:"delivered"`
+
+	// Stage-to-stage rates in percent (calculated)
+	ViewRate       float64 `json:"view_rate" db:"-"`       // views / impressions
+	EngagementRate float64 `json:"engagement_rate" db:"-"` // engaged / views
+	OrderRate      float64 `json:"order_rate" db:"-"`      // ordered / engaged
+	DeliveryRate   float64 `json:"delivery_rate" db:"-"`   // delivered / ordered
+	ConversionRate float64 `json:"conversion_rate" db:"-"` // ordered / views
+
+	PeriodStart time.Time `json:"period_start" db:"-"`
+	PeriodEnd   time.Time `json:"period_end" db:"-"`
+}
+
+// EnrichWithCalculatedFields calculates the stage-to-stage rates. Visitors can skip stages
+// (e.g. order without favoriting), so rates above 100% are possible and kept as is.
+func (f *FunnelStats) EnrichWithCalculatedFields() {
+	f.ViewRate = percentOf(f.Views, f.Impressions)
+	f.EngagementRate = percentOf(f.Engaged, f.Views)
+	f.OrderRate = percentOf(f.Ordered, f.Engaged)
+	f.DeliveryRate = percentOf(f.Delivered, f.Ordered)
+	f.ConversionRate = CalculateConversionRate(f.Views, f.Ordered)
+}
+
+// FunnelComparison compares a funnel to the previous period
+type FunnelComparison struct {
+	ImpressionsGrowth    float64 `json:"impressions_growth"`     // %
+	ViewsGrowth          float64 `json:"views_growth"`           // %
+	EngagedGrowth        float64 `json:"engaged_growth"`         // %
+	OrderedGrowth        float64 `json:"ordered_growth"`         // %
+	DeliveredGrowth      float64 `json:"delivered_growth"`       // %
+	ConversionRateChange float64 `json:"conversion_rate_change"` // Percentage points
+}
+
+// CompareFunnels compares two funnels stage by stage. Like ComparePerformance, a stage
+// that was empty in the previous period grows by 100% if it has any visitors now.
+func CompareFunnels(previous, current *FunnelStats) *FunnelComparison {
+	if previous == nil {
+		previous = &FunnelStats{}
+	}
+	return &FunnelComparison{
+		ImpressionsGrowth:    growthPercent(previous.Impressions, current.Impressions),
+		ViewsGrowth:          growthPercent(previous.Views, current.Views),
+		EngagedGrowth:        growthPercent(previous.Engaged, current.Engaged),
+		OrderedGrowth:        growthPercent(previous.Ordered, current.Ordered),
+		DeliveredGrowth:      growthPercent(previous.Delivered, current.Delivered),
+		ConversionRateChange: current.ConversionRate - previous.ConversionRate,
+	}
+}
+
+// ============================================================================
+// REPEAT-BUYER COHORTS
+// ============================================================================
+
+// GetCohortsFilter selects the weekly buyer cohorts of a storefront. Cohorts are the
+// Weeks ISO weeks (Monday-based, UTC) ending with the week that contains EndDate.
+type GetCohortsFilter struct {
+	StorefrontID int64     `json:"storefront_id"`
+	Weeks        int       `json:"weeks"`
+	EndDate      time.Time `json:"end_date"`
+}
+
+// Validate validates GetCohortsFilter and applies defaults
+func (filter *GetCohortsFilter) Validate() error {
+	if filter == nil {
+		return errors.New("filter cannot be nil")
+	}
+	if filter.StorefrontID <= 0 {
+		return errors.New("storefront_id must be greater than 0")
+	}
+	if filter.Weeks == 0 {
+		filter.Weeks = DefaultCohortWeeks
+	}
+	if filter.Weeks < 1 || filter.Weeks > MaxCohortWeeks {
+		return errors.New("weeks must be between 1 and 26")
+	}
+	if filter.EndDate.IsZero() {
+		filter.EndDate = time.Now()
+	}
+	return nil
+}
+
+// FirstWeek returns the start of the oldest cohort week
+func (filter *GetCohortsFilter) FirstWeek() time.Time {
+	return StartOfWeek(filter.EndDate).AddDate(0, 0, -7*(filter.Weeks-1))
+}
+
+// PreviousPeriod returns the filter for the same number of weeks right before this one
+func (filter *GetCohortsFilter) PreviousPeriod() *GetCohortsFilter {
+	previous := *filter
+	previous.EndDate = filter.EndDate.AddDate(0, 0, -7*filter.Weeks)
+	return &previous
+}
+
+// StartOfWeek returns Monday 00:00 UTC of the week containing t
+func StartOfWeek(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+// BuyerCohort groups the registered buyers whose first order at the storefront was
+// placed in the same week
+type BuyerCohort struct {
+	CohortWeek time.Time `json:"cohort_week"` // Monday 00:00 UTC
+	Buyers     int64     `json:"buyers"`
+
+	// Returning[k] is the number of cohort buyers who ordered again in week k+1 after
+	// the cohort week; it only covers weeks that have started
+	Returning []int64 `json:"returning"`
+
+	// RepeatBuyers is the number of cohort buyers with more than one order so far
+	RepeatBuyers int64 `json:"repeat_buyers"`
+}
+
+// RepeatRate returns the share of cohort buyers who ordered again, in percent
+func (c *BuyerCohort) RepeatRate() float64 {
+	return percentOf(c.RepeatBuyers, c.Buyers)
+}
+
+// CohortStats is the result of a cohort query
+type CohortStats struct {
+	Cohorts []*BuyerCohort `json:"cohorts"`
+
+	// Totals over all cohorts
+	Buyers          int64   `json:"buyers"`
+	RepeatBuyers    int64   `json:"repeat_buyers"`
+	RepeatBuyerRate float64 `json:"repeat_buyer_rate"` // %
+}
+
+// NewCohortStats summarizes cohorts
+func NewCohortStats(cohorts []*BuyerCohort) *CohortStats {
+	stats := &CohortStats{Cohorts: cohorts}
+	for _, c := range cohorts {
+		stats.Buyers += c.Buyers
+		stats.RepeatBuyers += c.RepeatBuyers
+	}
+	stats.RepeatBuyerRate = percentOf(stats.RepeatBuyers, stats.Buyers)
+	return stats
+}
+
+// ============================================================================
+// HELPERS
+// ============================================================================
+
+// percentOf returns part/total in percent, 0 when total is 0
+func percentOf(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+// growthPercent returns the change from previous to current in percent
+func growthPercent(previous, current int64) float64 {
+	if previous == 0 {
+		if current > 0 {
+			return 100
+		}
+		return 0
+	}
+	return float64(current-previous) / float64(previous) * 100
+}
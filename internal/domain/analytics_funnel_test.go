@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFunnelFilter_PreviousPeriod(t *testing.T) {
+	filter := &GetFunnelFilter{
+		StorefrontID: 1,
+		StartDate:    time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2025, 11, 24, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, filter.Validate())
+
+	previous := filter.PreviousPeriod()
+
+	assert.Equal(t, time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC), previous.StartDate)
+	assert.Equal(t, filter.StartDate, previous.EndDate)
+	assert.Equal(t, filter.StorefrontID, previous.StorefrontID)
+}
+
+func TestGetFunnelFilter_Validate(t *testing.T) {
+	day := time.Date(2025, 11, 24, 0, 0, 0, 0, time.UTC)
+	badListing := int64(0)
+
+	assert.Error(t, (&GetFunnelFilter{StartDate: day, EndDate: day.Add(time.Hour)}).Validate(), "storefront required")
+	assert.Error(t, (&GetFunnelFilter{StorefrontID: 1, StartDate: day, EndDate: day}).Validate(), "empty period")
+	assert.Error(t, (&GetFunnelFilter{StorefrontID: 1, ListingID: &badListing, StartDate: day, EndDate: day.Add(time.Hour)}).Validate())
+	assert.Error(t, (&GetFunnelFilter{StorefrontID: 1, StartDate: day.AddDate(-2, 0, 0), EndDate: day}).Validate(), "range too long")
+}
+
+func TestFunnelStats_RatesAndComparison(t *testing.T) {
+	previous := &FunnelStats{Impressions: 1000, Views: 200, Engaged: 50, Ordered: 10, Delivered: 0}
+	current := &FunnelStats{Impressions: 1500, Views: 300, Engaged: 60, Ordered: 15, Delivered: 9}
+	previous.EnrichWithCalculatedFields()
+	current.EnrichWithCalculatedFields()
+
+	assert.InDelta(t, 20.0, current.ViewRate, 0.001)
+	assert.InDelta(t, 20.0, current.EngagementRate, 0.001)
+	assert.InDelta(t, 25.0, current.OrderRate, 0.001)
+	assert.InDelta(t, 60.0, current.DeliveryRate, 0.001)
+	assert.InDelta(t, 5.0, current.ConversionRate, 0.001)
+
+	cmp := CompareFunnels(previous, current)
+
+	assert.InDelta(t, 50.0, cmp.ImpressionsGrowth, 0.001)
+	assert.InDelta(t, 20.0, cmp.EngagedGrowth, 0.001)
+	assert.InDelta(t, 100.0, cmp.DeliveredGrowth, 0.001, "growth from an empty stage")
+	assert.InDelta(t, 0.0, cmp.ConversionRateChange, 0.001)
+}
+
+func TestGetCohortsFilter_Weeks(t *testing.T) {
+	// Wednesday
+	filter := &GetCohortsFilter{StorefrontID: 1, Weeks: 4, EndDate: time.Date(2025, 11, 26, 15, 0, 0, 0, time.UTC)}
+	require.NoError(t, filter.Validate())
+
+	assert.Equal(t, time.Date(2025, 11, 24, 0, 0, 0, 0, time.UTC), StartOfWeek(filter.EndDate))
+	assert.Equal(t, time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), filter.FirstWeek())
+	assert.Equal(t, time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC), filter.PreviousPeriod().FirstWeek())
+
+	assert.Equal(t, time.Date(2025, 11, 24, 0, 0, 0, 0, time.UTC), StartOfWeek(time.Date(2025, 11, 30, 23, 0, 0, 0, time.UTC)), "sunday belongs to the week before")
+
+	assert.Error(t, (&GetCohortsFilter{StorefrontID: 1, Weeks: MaxCohortWeeks + 1}).Validate())
+}
+
+func TestNewCohortStats(t *testing.T) {
+	stats := NewCohortStats([]*BuyerCohort{
+		{Buyers: 10, RepeatBuyers: 3},
+		{Buyers: 30, RepeatBuyers: 3},
+	})
+
+	assert.Equal(t, int64(40), stats.Buyers)
+	assert.Equal(t, int64(6), stats.RepeatBuyers)
+	assert.InDelta(t, 15.0, stats.RepeatBuyerRate, 0.001)
+	assert.InDelta(t, 30.0, stats.Cohorts[0].RepeatRate(), 0.001)
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Duplicate detection modes
+const (
+	DuplicateModeOff    = "off"    // No checks
+	DuplicateModeReport = "report" // Return a similarity report only
+	DuplicateModeFlag   = "flag"   // Report and record the matches for moderators
+	DuplicateModeBlock  = "block"  // Reject listings/images that duplicate an existing listing
+)
+
+// Actions taken for a duplicate report
+const (
+	DuplicateActionNone    = "none"
+	DuplicateActionFlagged = "flagged"
+	DuplicateActionBlocked = "blocked"
+)
+
+// Duplicate detection defaults
+const (
+	DefaultDuplicateTextThreshold    = 0.8 // Estimated Jaccard similarity of title+description shingles
+	DefaultDuplicateImageMaxDistance = 8   // Max Hamming distance of 64-bit image hashes
+	DefaultDuplicateMaxCandidates    = 50
+)
+
+// ListingFingerprint is the stored MinHash signature of a listing's text
+type ListingFingerprint struct {
+	ListingID int64   `json:"listing_id" db:"listing_id"`
+	UserID    int64   `json:"user_id" db:"user_id"`
+	Title     string  `json:"title" db:"title"`
+	Status    string  `json:"status" db:"status"`
+	MinHash   []int64 `json:"minhash" db:"minhash"`
+	BandKeys  []int64 `json:"band_keys" db:"band_keys"`
+}
+
+// DuplicateMatch is an existing listing that looks like a duplicate
+type DuplicateMatch struct {
+	ListingID      int64   `json:"listing_id" db:"listing_id"`
+	UserID         int64   `json:"user_id" db:"user_id"`
+	Title          string  `json:"title" db:"title"`
+	Status         string  `json:"status" db:"status"`
+	TextSimilarity float64 `json:"text_similarity" db:"text_similarity"`         // 0..1, 0 if matched by image only
+	ImageDistance  *int    `json:"image_distance,omitempty" db:"image_distance"` // Best image hash distance, nil if matched by text only
+	SameOwner      bool    `json:"same_owner" db:"-"`
+}
+
+// DuplicateReport is the result of a duplicate check
+type DuplicateReport struct {
+	Matches []*DuplicateMatch `json:"matches"`
+	Action  string            `json:"action"` // none, flagged, blocked
+}
+
+// HasDuplicates reports whether any duplicates were found
+func (r *DuplicateReport) HasDuplicates() bool {
+	return r != nil && len(r.Matches) > 0
+}
+
+// BlockError returns the error used to reject a duplicate
+func (r *DuplicateReport) BlockError() error {
+	if !r.HasDuplicates() {
+		return nil
+	}
+	return fmt.Errorf("duplicate listing: similar to listing %d", r.Matches[0].ListingID)
+}
+
+// DuplicateFlag is a recorded duplicate match awaiting moderator attention
+type DuplicateFlag struct {
+	ListingID          int64     `json:"listing_id" db:"listing_id"`
+	DuplicateListingID int64     `json:"duplicate_listing_id" db:"duplicate_listing_id"`
+	TextSimilarity     float64   `json:"text_similarity" db:"text_similarity"`
+	ImageDistance      *int      `json:"image_distance,omitempty" db:"image_distance"`
+	DetectedAt         time.Time `json:"detected_at" db:"detected_at"`
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FeedFormat identifies an external shopping channel feed format
+type FeedFormat string
+
+// Supported feed formats
+const (
+	FeedFormatGoogleMerchant FeedFormat = "google"   // Google Merchant Center RSS 2.0 XML
+	FeedFormatFacebook       FeedFormat = "facebook" // Facebook (Meta) catalog CSV
+)
+
+// ContentType returns the HTTP content type for the feed format
+func (f FeedFormat) ContentType() string {
+	switch f {
+	case FeedFormatGoogleMerchant:
+		return "application/xml; charset=utf-8"
+	case FeedFormatFacebook:
+		return "text/csv; charset=utf-8"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// ParseFeedFormat converts a string to FeedFormat
+func ParseFeedFormat(s string) (FeedFormat, error) {
+	switch FeedFormat(strings.ToLower(s)) {
+	case FeedFormatGoogleMerchant:
+		return FeedFormatGoogleMerchant, nil
+	case FeedFormatFacebook:
+		return FeedFormatFacebook, nil
+	default:
+		return "", fmt.Errorf("unsupported feed format: %s", s)
+	}
+}
+
+// FeedAvailability is the channel-neutral availability of a feed item
+type FeedAvailability string
+
+// Feed availability values (mapped to channel-specific strings by renderers)
+const (
+	FeedAvailabilityInStock      FeedAvailability = "in_stock"
+	FeedAvailabilityOutOfStock   FeedAvailability = "out_of_stock"
+	FeedAvailabilityPreOrder     FeedAvailability = "preorder"
+	FeedAvailabilityDiscontinued FeedAvailability = "discontinued"
+)
+
+// FeedItem represents a single product (or product variant) in an external channel feed
+type FeedItem struct {
+	ID                   string            `json:"id"`
+	ItemGroupID          string            `json:"item_group_id,omitempty"` // Parent product ID for variants
+	Title                string            `json:"title"`
+	Description          string            `json:"description"`
+	Link                 string            `json:"link"`
+	ImageLink            string            `json:"image_link,omitempty"`
+	AdditionalImageLinks []string          `json:"additional_image_links,omitempty"`
+	Price                float64           `json:"price"`                // Regular price
+	SalePrice            *float64          `json:"sale_price,omitempty"` // Set when CompareAtPrice > Price
+	Currency             string            `json:"currency"`
+	Availability         FeedAvailability  `json:"availability"`
+	GTIN                 string            `json:"gtin,omitempty"`
+	MPN                  string            `json:"mpn,omitempty"`
+	Brand                string            `json:"brand"`
+	Condition            string            `json:"condition"`
+	ProductType          string            `json:"product_type,omitempty"` // Category name
+	Attributes           map[string]string `json:"attributes,omitempty"`   // Variant attributes (color, size, ...)
+}
+
+// HasIdentifier returns true if the item carries a GTIN or MPN
+func (i *FeedItem) HasIdentifier() bool {
+	return i.GTIN != "" || i.MPN != ""
+}
+
+// ProductFeed represents a generated feed for a storefront
+type ProductFeed struct {
+	StorefrontID   int64      `json:"storefront_id"`
+	StorefrontSlug string     `json:"storefront_slug"`
+	StorefrontName string     `json:"storefront_name"`
+	Link           string     `json:"link"`
+	Description    string     `json:"description"`
+	Items          []FeedItem `json:"items"`
+}
+
+// FeedConditionNew is the default item condition for B2C storefront products
+const FeedConditionNew = "new"
+
+// FeedAvailabilityFromStock maps a stock status and quantity to a feed availability
+func FeedAvailabilityFromStock(stockStatus string, quantity int32) FeedAvailability {
+	switch stockStatus {
+	case StockStatusPreOrder:
+		return FeedAvailabilityPreOrder
+	case StockStatusDiscontinued:
+		return FeedAvailabilityDiscontinued
+	case StockStatusOutOfStock:
+		return FeedAvailabilityOutOfStock
+	case StockStatusInStock, StockStatusLowStock:
+		if quantity > 0 {
+			return FeedAvailabilityInStock
+		}
+		return FeedAvailabilityOutOfStock
+	default:
+		if quantity > 0 {
+			return FeedAvailabilityInStock
+		}
+		return FeedAvailabilityOutOfStock
+	}
+}
+
+// FeedPricing returns the regular price and optional sale price for a feed item.
+// When compareAtPrice is higher than price, the compare-at price becomes the
+// regular price and the current price is reported as the sale price.
+func FeedPricing(price float64, compareAtPrice *float64) (float64, *float64) {
+	if compareAtPrice != nil && *compareAtPrice > price {
+		sale := price
+		return *compareAtPrice, &sale
+	}
+	return price, nil
+}
+
+// NormalizeGTIN returns the barcode as a GTIN if it is a valid GTIN-8/12/13/14, or "" otherwise
+func NormalizeGTIN(barcode *string) string {
+	if barcode == nil {
+		return ""
+	}
+
+	code := strings.ReplaceAll(strings.TrimSpace(*barcode), " ", "")
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return ""
+	}
+
+	sum := 0
+	for i := 0; i < len(code)-1; i++ {
+		c := code[len(code)-2-i]
+		if c < '0' || c > '9' {
+			return ""
+		}
+		d := int(c - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	last := code[len(code)-1]
+	if last < '0' || last > '9' {
+		return ""
+	}
+	if (10-sum%10)%10 != int(last-'0') {
+		return ""
+	}
+
+	return code
+}
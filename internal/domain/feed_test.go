@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeGTIN(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode *string
+		want    string
+	}{
+		{name: "nil barcode", barcode: nil, want: ""},
+		{name: "valid EAN-13", barcode: ptr("4006381333931"), want: "4006381333931"},
+		{name: "valid EAN-8", barcode: ptr("96385074"), want: "96385074"},
+		{name: "valid UPC-A with spaces", barcode: ptr(" 0360 0029 1452 "), want: "036000291452"},
+		{name: "bad check digit", barcode: ptr("4006381333932"), want: ""},
+		{name: "wrong length", barcode: ptr("12345"), want: ""},
+		{name: "non numeric", barcode: ptr("40063813339AB"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeGTIN(tt.barcode))
+		})
+	}
+}
+
+func TestFeedPricing(t *testing.T) {
+	price, sale := FeedPricing(100, nil)
+	assert.Equal(t, 100.0, price)
+	assert.Nil(t, sale)
+
+	price, sale = FeedPricing(80, ptr(100.0))
+	assert.Equal(t, 100.0, price)
+	require.NotNil(t, sale)
+	assert.Equal(t, 80.0, *sale)
+
+	// Compare-at price lower than the price is ignored
+	price, sale = FeedPricing(120, ptr(100.0))
+	assert.Equal(t, 120.0, price)
+	assert.Nil(t, sale)
+}
+
+func TestFeedAvailabilityFromStock(t *testing.T) {
+	assert.Equal(t, FeedAvailabilityInStock, FeedAvailabilityFromStock(StockStatusInStock, 5))
+	assert.Equal(t, FeedAvailabilityInStock, FeedAvailabilityFromStock(StockStatusLowStock, 1))
+	assert.Equal(t, FeedAvailabilityOutOfStock, FeedAvailabilityFromStock(StockStatusInStock, 0))
+	assert.Equal(t, FeedAvailabilityOutOfStock, FeedAvailabilityFromStock(StockStatusOutOfStock, 3))
+	assert.Equal(t, FeedAvailabilityPreOrder, FeedAvailabilityFromStock(StockStatusPreOrder, 0))
+	assert.Equal(t, FeedAvailabilityDiscontinued, FeedAvailabilityFromStock(StockStatusDiscontinued, 0))
+}
+
+func TestParseFeedFormat(t *testing.T) {
+	f, err := ParseFeedFormat("Google")
+	require.NoError(t, err)
+	assert.Equal(t, FeedFormatGoogleMerchant, f)
+
+	f, err = ParseFeedFormat("facebook")
+	require.NoError(t, err)
+	assert.Equal(t, FeedFormatFacebook, f)
+
+	_, err = ParseFeedFormat("amazon")
+	assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Image processing statuses of a listing image
+const (
+	ImageStatusPending    = "pending"    // Original stored, waiting for a worker
+	ImageStatusProcessing = "processing" // Being processed
+	ImageStatusReady      = "ready"      // Thumbnail, renditions and hashes available
+	ImageStatusFailed     = "failed"     // Rejected (invalid, duplicate) or retries exhausted
+)
+
+// ImageProcessingJob is a queued processing job for an uploaded image
+type ImageProcessingJob struct {
+	ID           int64      `json:"id" db:"id"`
+	ImageID      int64      `json:"image_id" db:"image_id"`
+	Status       string     `json:"status" db:"status"` // pending, processing, completed, failed
+	RetryCount   int32      `json:"retry_count" db:"retry_count"`
+	MaxRetries   int32      `json:"max_retries" db:"max_retries"`
+	ErrorMessage *string    `json:"error_message,omitempty" db:"error_message"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+	ProcessedAt  *time.Time `json:"processed_at,omitempty" db:"processed_at"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Scheduled job run statuses (scheduled_job_runs.status)
+const (
+	JobRunStatusRunning   = "running"
+	JobRunStatusSucceeded = "succeeded"
+	JobRunStatusFailed    = "failed"
+)
+
+// JobRun is a single execution of a scheduled job
+type JobRun struct {
+	ID         int64      `json:"id" db:"id"`
+	JobName    string     `json:"job_name" db:"job_name"`
+	Instance   string     `json:"instance" db:"instance"`
+	Status     string     `json:"status" db:"status"`
+	StartedAt  time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+	DurationMs *int64     `json:"duration_ms,omitempty" db:"duration_ms"`
+	Error      *string    `json:"error,omitempty" db:"error"`
+}
@@ -27,7 +27,10 @@ type Listing struct {
 	AttributesJSON *string    `json:"attributes_json,omitempty" db:"attributes"` // JSONB column stored as string for flexibility
 	ViewsCount     int32      `json:"views_count" db:"view_count"`
 	FavoritesCount int32      `json:"favorites_count" db:"favorites_count"`
+	Rating         float64    `json:"rating" db:"rating"`               // Average of published reviews
+	ReviewsCount   int32      `json:"reviews_count" db:"reviews_count"` // Number of published reviews
 	ExpiresAt      *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	ScheduledAt    *time.Time `json:"scheduled_publish_at,omitempty" db:"scheduled_publish_at"`
 	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
 	PublishedAt    *time.Time `json:"published_at,omitempty" db:"published_at"`
@@ -42,11 +45,15 @@ type Listing struct {
 	CountryTranslations     map[string]string `json:"country_translations" db:"country_translations"`
 	OriginalLanguage        string            `json:"original_language" db:"original_language"`
 
+	// StorefrontVerified is set when the listing's storefront passed verification (search filter)
+	StorefrontVerified bool `json:"storefront_verified,omitempty" db:"storefront_verified"`
+
 	// Relations (loaded on demand)
 	Attributes []*ListingAttribute `json:"attributes,omitempty" db:"-"`
 	Images     []*ListingImage     `json:"images,omitempty" db:"-"`
 	Tags       []string            `json:"tags,omitempty" db:"-"`
 	Location   *ListingLocation    `json:"location,omitempty" db:"-"`
+	Duplicates *DuplicateReport    `json:"duplicates,omitempty" db:"-"` // Set by create/update duplicate checks
 }
 
 // ListingAttribute represents flexible key-value attributes
@@ -60,19 +67,34 @@ type ListingAttribute struct {
 
 // ListingImage represents an image associated with a listing
 type ListingImage struct {
-	ID           int64     `json:"id" db:"id"`
-	ListingID    int64     `json:"listing_id" db:"listing_id"`
-	URL          string    `json:"url" db:"url"`
-	StoragePath  *string   `json:"storage_path,omitempty" db:"storage_path"`
-	ThumbnailURL *string   `json:"thumbnail_url,omitempty" db:"thumbnail_url"`
-	DisplayOrder int32     `json:"display_order" db:"display_order"`
-	IsPrimary    bool      `json:"is_primary" db:"is_primary"`
-	Width        *int32    `json:"width,omitempty" db:"width"`
-	Height       *int32    `json:"height,omitempty" db:"height"`
-	FileSize     *int64    `json:"file_size,omitempty" db:"file_size"`
-	MimeType     *string   `json:"mime_type,omitempty" db:"mime_type"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID               int64            `json:"id" db:"id"`
+	ListingID        int64            `json:"listing_id" db:"listing_id"`
+	VariantID        *int64           `json:"variant_id,omitempty" db:"variant_id"` // Set for product variant images
+	URL              string           `json:"url" db:"url"`
+	StoragePath      *string          `json:"storage_path,omitempty" db:"storage_path"`
+	ThumbnailURL     *string          `json:"thumbnail_url,omitempty" db:"thumbnail_url"`
+	DisplayOrder     int32            `json:"display_order" db:"display_order"`
+	IsPrimary        bool             `json:"is_primary" db:"is_primary"`
+	Width            *int32           `json:"width,omitempty" db:"width"`
+	Height           *int32           `json:"height,omitempty" db:"height"`
+	FileSize         *int64           `json:"file_size,omitempty" db:"file_size"`
+	MimeType         *string          `json:"mime_type,omitempty" db:"mime_type"`
+	PHash            *int64           `json:"phash,omitempty" db:"phash"`               // Perceptual hash for duplicate detection
+	BlurHash         *string          `json:"blurhash,omitempty" db:"blurhash"`         // Placeholder shown while loading
+	Renditions       []ImageRendition `json:"renditions,omitempty" db:"renditions"`     // JSONB, smallest first
+	ProcessingStatus string           `json:"processing_status" db:"processing_status"` // pending, processing, ready, failed
+	ProcessingError  *string          `json:"processing_error,omitempty" db:"processing_error"`
+	CreatedAt        time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// ImageRendition is a resized JPEG copy of a listing image
+type ImageRendition struct {
+	Width       int32  `json:"width"`
+	Height      int32  `json:"height"`
+	StoragePath string `json:"storage_path"`
+	URL         string `json:"url"`
+	FileSize    int64  `json:"file_size"`
 }
 
 // ListingLocation represents the geographic location of a listing
@@ -139,7 +161,7 @@ type UpdateListingInput struct {
 	Description *string  `json:"description,omitempty"`
 	Price       *float64 `json:"price,omitempty" validate:"omitempty,gte=0"`
 	Quantity    *int32   `json:"quantity,omitempty" validate:"omitempty,gte=0"`
-	Status      *string  `json:"status,omitempty" validate:"omitempty,oneof=draft active inactive sold archived"`
+	Status      *string  `json:"status,omitempty" validate:"omitempty,oneof=draft pending_review active inactive sold archived"`
 }
 
 // ListListingsFilter represents filters for listing queries
@@ -184,6 +206,16 @@ const (
 	StatusInactive = "inactive"
 	StatusSold     = "sold"
 	StatusArchived = "archived"
+	StatusExpired  = "expired" // Set by the expiration job, leaves via RenewListing
+
+	// Moderation workflow statuses (see listing_moderation.go)
+	StatusPendingReview = "pending_review"
+	StatusScheduled     = "scheduled" // Approved, waiting for ScheduledPublishAt
+	StatusRejected      = "rejected"
+
+	// Workflow names of the stored statuses
+	StatusPublished = StatusActive
+	StatusPaused    = StatusInactive
 )
 
 // Constants for listing visibility
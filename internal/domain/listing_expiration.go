@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// DefaultRenewalPeriodDays is the listing lifetime used when a category has no renewal period
+const DefaultRenewalPeriodDays = 30
+
+// ExpiringListing is a C2C listing approaching its expiration date (reminder candidate)
+type ExpiringListing struct {
+	ID        int64     `json:"id" db:"id"`
+	UserID    int64     `json:"user_id" db:"user_id"`
+	Title     string    `json:"title" db:"title"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+}
+
+// DaysLeft returns the number of whole days until expiration (0 if already due)
+func (e *ExpiringListing) DaysLeft(now time.Time) int {
+	if !e.ExpiresAt.After(now) {
+		return 0
+	}
+	return int(e.ExpiresAt.Sub(now).Hours() / 24)
+}
+
+// IsRenewable reports whether the listing can be renewed by its owner
+// Only active and expired C2C listings have a lifetime
+func (l *Listing) IsRenewable() bool {
+	if l.IsDeleted || l.SourceType != SourceTypeC2C {
+		return false
+	}
+	return l.Status == StatusActive || l.Status == StatusExpired
+}
+
+// RenewalExpiry returns the new expiration time for a renewal starting at now
+func RenewalExpiry(now time.Time, renewalDays int) time.Time {
+	if renewalDays <= 0 {
+		renewalDays = DefaultRenewalPeriodDays
+	}
+	return now.AddDate(0, 0, renewalDays)
+}
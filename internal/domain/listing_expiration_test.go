@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListing_IsRenewable(t *testing.T) {
+	tests := []struct {
+		name    string
+		listing Listing
+		want    bool
+	}{
+		{name: "active c2c", listing: Listing{SourceType: SourceTypeC2C, Status: StatusActive}, want: true},
+		{name: "expired c2c", listing: Listing{SourceType: SourceTypeC2C, Status: StatusExpired}, want: true},
+		{name: "sold c2c", listing: Listing{SourceType: SourceTypeC2C, Status: StatusSold}, want: false},
+		{name: "b2c product", listing: Listing{SourceType: SourceTypeB2C, Status: StatusActive}, want: false},
+		{name: "deleted", listing: Listing{SourceType: SourceTypeC2C, Status: StatusExpired, IsDeleted: true}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.listing.IsRenewable())
+		})
+	}
+}
+
+func TestRenewalExpiry(t *testing.T) {
+	now := time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.AddDate(0, 0, 60), RenewalExpiry(now, 60))
+	assert.Equal(t, now.AddDate(0, 0, DefaultRenewalPeriodDays), RenewalExpiry(now, 0))
+}
+
+func TestExpiringListing_DaysLeft(t *testing.T) {
+	now := time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 3, (&ExpiringListing{ExpiresAt: now.Add(3*24*time.Hour + time.Hour)}).DaysLeft(now))
+	assert.Equal(t, 0, (&ExpiringListing{ExpiresAt: now.Add(-time.Hour)}).DaysLeft(now))
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Moderation actions recorded in listing_moderation_events
+const (
+	ModerationActionSubmit  = "submit"
+	ModerationActionApprove = "approve"
+	ModerationActionReject  = "reject"
+	ModerationActionPublish = "publish" // Scheduled publish job
+)
+
+// Moderation limits
+const (
+	DefaultModerationQueueLimit = 50
+	MaxModerationQueueLimit     = 200
+	MaxModerationReasonLength   = 1000
+)
+
+// ListingModerationEvent is an audit record of a workflow status change
+type ListingModerationEvent struct {
+	ID         int64     `json:"id" db:"id"`
+	ListingID  int64     `json:"listing_id" db:"listing_id"`
+	Action     string    `json:"action" db:"action"`
+	FromStatus string    `json:"from_status" db:"from_status"`
+	ToStatus   string    `json:"to_status" db:"to_status"`
+	ActorID    *int64    `json:"actor_id,omitempty" db:"actor_id"` // nil = system (scheduled publish)
+	Reason     *string   `json:"reason,omitempty" db:"reason"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// ModerationDecision is a moderator's verdict on a listing awaiting review
+type ModerationDecision struct {
+	ListingID   int64  `json:"listing_id"`
+	ModeratorID int64  `json:"moderator_id"`
+	Approve     bool   `json:"approve"`
+	Reason      string `json:"reason,omitempty"` // Required for rejections
+}
+
+// Validate validates the decision and normalizes the reason
+func (d *ModerationDecision) Validate() error {
+	if d.ListingID <= 0 {
+		return fmt.Errorf("listing_id must be greater than 0")
+	}
+	if d.ModeratorID <= 0 {
+		return fmt.Errorf("moderator_id must be greater than 0")
+	}
+
+	d.Reason = strings.TrimSpace(d.Reason)
+	if !d.Approve && d.Reason == "" {
+		return fmt.Errorf("reason is required when rejecting a listing")
+	}
+	if len(d.Reason) > MaxModerationReasonLength {
+		return fmt.Errorf("reason must be at most %d characters", MaxModerationReasonLength)
+	}
+	return nil
+}
+
+// TargetStatus returns the status a pending listing moves to after the decision.
+// Approved listings with a future publish time are scheduled instead of published.
+func (d *ModerationDecision) TargetStatus(scheduledAt *time.Time, now time.Time) string {
+	if !d.Approve {
+		return StatusRejected
+	}
+	if scheduledAt != nil && scheduledAt.After(now) {
+		return StatusScheduled
+	}
+	return StatusPublished
+}
+
+// IsPublished reports whether the listing is publicly visible and may be indexed for search
+func (l *Listing) IsPublished() bool {
+	return !l.IsDeleted && l.Status == StatusPublished
+}
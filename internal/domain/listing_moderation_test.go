@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerationDecision_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		decision ModerationDecision
+		wantErr  bool
+	}{
+		{name: "approve without reason", decision: ModerationDecision{ListingID: 1, ModeratorID: 2, Approve: true}},
+		{name: "reject with reason", decision: ModerationDecision{ListingID: 1, ModeratorID: 2, Reason: "prohibited item"}},
+		{name: "reject without reason", decision: ModerationDecision{ListingID: 1, ModeratorID: 2, Reason: "   "}, wantErr: true},
+		{name: "reason too long", decision: ModerationDecision{ListingID: 1, ModeratorID: 2, Reason: strings.Repeat("x", MaxModerationReasonLength+1)}, wantErr: true},
+		{name: "missing listing", decision: ModerationDecision{ModeratorID: 2, Approve: true}, wantErr: true},
+		{name: "missing moderator", decision: ModerationDecision{ListingID: 1, Approve: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.decision.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestModerationDecision_TargetStatus(t *testing.T) {
+	now := time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	approve := &ModerationDecision{Approve: true}
+	reject := &ModerationDecision{Approve: false}
+
+	assert.Equal(t, StatusPublished, approve.TargetStatus(nil, now))
+	assert.Equal(t, StatusPublished, approve.TargetStatus(&past, now))
+	assert.Equal(t, StatusScheduled, approve.TargetStatus(&future, now))
+	assert.Equal(t, StatusRejected, reject.TargetStatus(&future, now))
+}
+
+func TestListing_IsPublished(t *testing.T) {
+	assert.True(t, (&Listing{Status: StatusPublished}).IsPublished())
+	assert.False(t, (&Listing{Status: StatusPublished, IsDeleted: true}).IsPublished())
+	assert.False(t, (&Listing{Status: StatusPendingReview}).IsPublished())
+	assert.False(t, (&Listing{Status: StatusPaused}).IsPublished())
+}
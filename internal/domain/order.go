@@ -114,6 +114,9 @@ type Order struct {
 	AdminNotes    *string `json:"admin_notes,omitempty" db:"admin_notes"`   // Internal admin notes
 	SellerNotes   *string `json:"seller_notes,omitempty" db:"seller_notes"` // Seller notes about the order
 
+	// ShippingNotice is set on creation when shipping is delayed (storefront on vacation)
+	ShippingNotice *string `json:"shipping_notice,omitempty" db:"-"`
+
 	// Timestamps
 	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Price history limits
+const (
+	DefaultPriceHistoryLimit = 100
+	MaxPriceHistoryLimit     = 1000
+
+	// MinPriceDropPercent is the minimum relative drop that triggers a price-drop alert
+	MinPriceDropPercent = 1.0
+)
+
+// PriceHistoryEntry represents a single recorded price change
+// Rows are written by database triggers on listings and b2c_product_variants
+type PriceHistoryEntry struct {
+	ID        int64     `json:"id" db:"id"`
+	ListingID int64     `json:"listing_id" db:"listing_id"`
+	VariantID *int64    `json:"variant_id,omitempty" db:"variant_id"` // nil = listing/product level price
+	OldPrice  *float64  `json:"old_price,omitempty" db:"old_price"`   // nil = initial price
+	NewPrice  float64   `json:"new_price" db:"new_price"`
+	Currency  string    `json:"currency" db:"currency"`
+	ChangedAt time.Time `json:"changed_at" db:"changed_at"`
+}
+
+// PriceHistoryFilter represents filters for querying price history
+type PriceHistoryFilter struct {
+	ListingID int64      `json:"listing_id"`
+	VariantID *int64     `json:"variant_id,omitempty"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+	Limit     int        `json:"limit"`
+}
+
+// Validate validates the filter and applies the default limit
+func (f *PriceHistoryFilter) Validate() error {
+	if f.ListingID <= 0 {
+		return fmt.Errorf("listing_id must be greater than 0")
+	}
+	if f.VariantID != nil && *f.VariantID <= 0 {
+		return fmt.Errorf("variant_id must be greater than 0")
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return fmt.Errorf("from must be before to")
+	}
+	if f.Limit <= 0 {
+		f.Limit = DefaultPriceHistoryLimit
+	}
+	if f.Limit > MaxPriceHistoryLimit {
+		f.Limit = MaxPriceHistoryLimit
+	}
+	return nil
+}
+
+// PriceDrop describes a price decrease used for favorites alerts
+type PriceDrop struct {
+	ListingID int64   `json:"listing_id"`
+	VariantID *int64  `json:"variant_id,omitempty"`
+	Title     string  `json:"title"`
+	OldPrice  float64 `json:"old_price"`
+	NewPrice  float64 `json:"new_price"`
+	Currency  string  `json:"currency"`
+}
+
+// DropPercent returns the price decrease in percent (0 if the price did not drop)
+func (d *PriceDrop) DropPercent() float64 {
+	if d.OldPrice <= 0 || d.NewPrice >= d.OldPrice {
+		return 0
+	}
+	return math.Round((d.OldPrice-d.NewPrice)/d.OldPrice*10000) / 100
+}
+
+// IsSignificant reports whether the drop is large enough to notify users
+func (d *PriceDrop) IsSignificant() bool {
+	return d.DropPercent() >= MinPriceDropPercent
+}
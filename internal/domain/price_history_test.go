@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceHistoryFilter_Validate(t *testing.T) {
+	f := &PriceHistoryFilter{ListingID: 1}
+	assert.NoError(t, f.Validate())
+	assert.Equal(t, DefaultPriceHistoryLimit, f.Limit)
+
+	f = &PriceHistoryFilter{ListingID: 1, Limit: 5000}
+	assert.NoError(t, f.Validate())
+	assert.Equal(t, MaxPriceHistoryLimit, f.Limit)
+
+	assert.Error(t, (&PriceHistoryFilter{}).Validate())
+	assert.Error(t, (&PriceHistoryFilter{ListingID: 1, VariantID: ptr(int64(0))}).Validate())
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	assert.Error(t, (&PriceHistoryFilter{ListingID: 1, From: &from, To: &to}).Validate())
+}
+
+func TestPriceDrop_DropPercent(t *testing.T) {
+	tests := []struct {
+		name        string
+		drop        PriceDrop
+		want        float64
+		significant bool
+	}{
+		{name: "20% drop", drop: PriceDrop{OldPrice: 100, NewPrice: 80}, want: 20, significant: true},
+		{name: "tiny drop", drop: PriceDrop{OldPrice: 1000, NewPrice: 999}, want: 0.1, significant: false},
+		{name: "price increase", drop: PriceDrop{OldPrice: 80, NewPrice: 100}, want: 0, significant: false},
+		{name: "unchanged", drop: PriceDrop{OldPrice: 50, NewPrice: 50}, want: 0, significant: false},
+		{name: "zero old price", drop: PriceDrop{OldPrice: 0, NewPrice: 0}, want: 0, significant: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.drop.DropPercent())
+			assert.Equal(t, tt.significant, tt.drop.IsSignificant())
+		})
+	}
+}
@@ -329,6 +329,8 @@ type ProductStats struct {
 	LowStock       int32   `json:"low_stock"`
 	TotalValue     float64 `json:"total_value"`
 	TotalSold      int32   `json:"total_sold"`
+	UniqueViews    int64   `json:"unique_views"` // Deduplicated views (one per viewer per window)
+	TotalViews     int64   `json:"total_views"`  // All human views including repeats
 }
 
 // StockUpdateItem represents a single stock update in batch operation
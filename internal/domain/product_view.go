@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Product view outcomes
+const (
+	ProductViewUnique = "unique" // First view of the viewer within the deduplication window
+	ProductViewRepeat = "repeat" // Refresh or revisit within the window (total views only)
+	ProductViewBot    = "bot"    // Crawler or automated client (not counted)
+)
+
+// botUserAgentMarkers are lowercase substrings identifying crawlers and automated clients
+var botUserAgentMarkers = []string{
+	"bot", "spider", "slurp", "crawl", "archiver", "scrapy",
+	"curl/", "wget/", "python-requests", "python-urllib", "go-http-client", "java/", "okhttp",
+	"headlesschrome", "phantomjs", "lighthouse", "pingdom", "facebookexternalhit", "embedly",
+}
+
+// IsBotUserAgent reports whether a user agent belongs to a crawler or automated client.
+// An empty user agent is not treated as a bot: internal callers often do not forward one.
+func IsBotUserAgent(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProductView is a single view of a listing or product detail page
+type ProductView struct {
+	ProductID int64
+	UserID    *int64
+	SessionID string
+	IP        string
+	UserAgent string
+}
+
+// ViewerKey identifies the viewer for deduplication: the user, else the client IP, else the
+// session. Anonymous viewers are keyed by IP first because the session_id is chosen by the
+// client and a fresh one per request would count every view as unique. Empty when nothing
+// identifies the viewer.
+func (v *ProductView) ViewerKey() string {
+	switch {
+	case v.UserID != nil:
+		return fmt.Sprintf("u:%d", *v.UserID)
+	case v.IP != "":
+		return "ip:" + v.IP
+	case v.SessionID != "":
+		return "s:" + v.SessionID
+	default:
+		return ""
+	}
+}
+
+// ViewCount is an increment of the view counters of a listing
+type ViewCount struct {
+	ListingID   int64
+	UniqueViews int64 // Deduplicated views (listings.view_count)
+	TotalViews  int64 // All human views including repeats (listings.total_view_count)
+}
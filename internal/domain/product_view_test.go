@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBotUserAgent(t *testing.T) {
+	bots := []string{
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)",
+		"Mozilla/5.0 (compatible; YandexBot/3.0)",
+		"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+		"curl/8.4.0",
+		"python-requests/2.31.0",
+		"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/120.0.0.0 Safari/537.36",
+	}
+	for _, ua := range bots {
+		assert.True(t, IsBotUserAgent(ua), ua)
+	}
+
+	humans := []string{
+		"",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (X11; Linux x86_64; rv:120.0) Gecko/20100101 Firefox/120.0",
+	}
+	for _, ua := range humans {
+		assert.False(t, IsBotUserAgent(ua), ua)
+	}
+}
+
+func TestProductView_ViewerKey(t *testing.T) {
+	userID := int64(42)
+
+	view := &ProductView{ProductID: 1, UserID: &userID, SessionID: "sess", IP: "10.0.0.1"}
+	assert.Equal(t, "u:42", view.ViewerKey(), "user wins over session and IP")
+
+	view.UserID = nil
+	assert.Equal(t, "ip:10.0.0.1", view.ViewerKey(), "client-chosen sessions do not split an IP")
+
+	view.IP = ""
+	assert.Equal(t, "s:sess", view.ViewerKey())
+
+	view.SessionID = ""
+	assert.Empty(t, view.ViewerKey())
+}
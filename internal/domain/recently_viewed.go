@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Recently viewed list limits
+const (
+	DefaultRecentlyViewedLimit = 20
+	MaxRecentlyViewedLimit     = 50
+)
+
+// RecentlyViewedItem is an entry of a visitor's recently viewed list
+type RecentlyViewedItem struct {
+	ListingID int64
+	ViewedAt  time.Time
+}
+
+// RecentlyViewedListing is a recently viewed entry resolved to its listing
+type RecentlyViewedListing struct {
+	Listing  *Listing
+	ViewedAt time.Time
+}
@@ -0,0 +1,112 @@
+package domain
+
+import "sort"
+
+// Co-occurrence kinds stored in listing_co_occurrences
+const (
+	CoOccurrenceEngagement = "engagement" // Viewed, favorited, carted or bought by the same visitor
+	CoOccurrencePurchase   = "purchase"   // Bought in the same order
+)
+
+// Recommendation sources reported with every recommended listing
+const (
+	RecommendationSourcePersonalized   = "personalized"    // Related to the visitor's own interactions
+	RecommendationSourceBoughtTogether = "bought_together" // Bought in the same orders as the listing
+	RecommendationSourceAlsoEngaged    = "also_engaged"    // Engaged with by visitors of the listing
+	RecommendationSourceTrending       = "trending"        // Fallback when there is not enough history
+)
+
+// Recommendation limits
+const (
+	DefaultRecommendationLimit = 10
+	MaxRecommendationLimit     = 20
+	MaxRecommendationSeeds     = 20 // Interactions of a visitor used as seeds
+)
+
+// Interaction weights: a purchase says more about a visitor's taste than a favorite or cart
+// addition, which in turn says more than a view
+const (
+	InteractionWeightView     = 1.0
+	InteractionWeightFavorite = 3.0
+	InteractionWeightPurchase = 5.0
+)
+
+// RecommendationSeed is a listing a visitor interacted with, weighted by the strength and
+// recency of the interaction
+type RecommendationSeed struct {
+	ListingID int64
+	Weight    float64
+}
+
+// RelatedListing is a stored neighbor of a listing
+type RelatedListing struct {
+	ListingID        int64
+	RelatedListingID int64
+	Score            float64
+}
+
+// Recommendation is a ranked candidate listing
+type Recommendation struct {
+	ListingID int64
+	Score     float64
+	Source    string
+}
+
+// RecommendedListing is a recommendation resolved to its listing
+type RecommendedListing struct {
+	Listing *Listing
+	Score   float64
+	Source  string
+}
+
+// RankRelatedListings aggregates the neighbors of several seeds into candidates. A candidate
+// reached from several seeds accumulates neighbor score times seed weight (seeds missing from
+// weights count 1). Excluded listings are skipped. Candidates are ordered by score, highest first.
+func RankRelatedListings(related []RelatedListing, weights map[int64]float64, exclude map[int64]bool, source string) []Recommendation {
+	scores := make(map[int64]float64)
+	for _, r := range related {
+		if exclude[r.RelatedListingID] {
+			continue
+		}
+		weight, ok := weights[r.ListingID]
+		if !ok {
+			weight = 1
+		}
+		scores[r.RelatedListingID] += r.Score * weight
+	}
+
+	recommendations := make([]Recommendation, 0, len(scores))
+	for listingID, score := range scores {
+		recommendations = append(recommendations, Recommendation{ListingID: listingID, Score: score, Source: source})
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].ListingID < recommendations[j].ListingID
+	})
+
+	return recommendations
+}
+
+// MergeRecommendations concatenates candidate groups in priority order, skipping excluded
+// and repeated listings, until limit candidates are collected
+func MergeRecommendations(limit int, exclude map[int64]bool, groups ...[]Recommendation) []Recommendation {
+	merged := make([]Recommendation, 0, limit)
+	seen := make(map[int64]bool)
+
+	for _, group := range groups {
+		for _, candidate := range group {
+			if len(merged) >= limit {
+				return merged
+			}
+			if exclude[candidate.ListingID] || seen[candidate.ListingID] {
+				continue
+			}
+			seen[candidate.ListingID] = true
+			merged = append(merged, candidate)
+		}
+	}
+
+	return merged
+}
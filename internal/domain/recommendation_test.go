@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankRelatedListings(t *testing.T) {
+	related := []RelatedListing{
+		{ListingID: 1, RelatedListingID: 10, Score: 0.5},
+		{ListingID: 1, RelatedListingID: 11, Score: 0.25},
+		{ListingID: 2, RelatedListingID: 11, Score: 0.75},
+		{ListingID: 2, RelatedListingID: 1, Score: 0.9},
+		{ListingID: 3, RelatedListingID: 12, Score: 0.2},
+	}
+	weights := map[int64]float64{1: 1, 2: 0.5}
+	exclude := map[int64]bool{1: true, 2: true, 3: true}
+
+	ranked := RankRelatedListings(related, weights, exclude, RecommendationSourcePersonalized)
+
+	assert.Equal(t, []Recommendation{
+		{ListingID: 11, Score: 0.625, Source: RecommendationSourcePersonalized},
+		{ListingID: 10, Score: 0.5, Source: RecommendationSourcePersonalized},
+		{ListingID: 12, Score: 0.2, Source: RecommendationSourcePersonalized},
+	}, ranked, "scores add up across seeds, seeds are never recommended, unweighted seeds count 1")
+}
+
+func TestMergeRecommendations(t *testing.T) {
+	primary := []Recommendation{
+		{ListingID: 10, Score: 0.9, Source: RecommendationSourceBoughtTogether},
+		{ListingID: 11, Score: 0.5, Source: RecommendationSourceBoughtTogether},
+	}
+	secondary := []Recommendation{
+		{ListingID: 11, Score: 0.8, Source: RecommendationSourceAlsoEngaged},
+		{ListingID: 12, Score: 0.7, Source: RecommendationSourceAlsoEngaged},
+	}
+	trending := []Recommendation{
+		{ListingID: 1, Score: 40, Source: RecommendationSourceTrending},
+		{ListingID: 13, Score: 30, Source: RecommendationSourceTrending},
+		{ListingID: 14, Score: 20, Source: RecommendationSourceTrending},
+	}
+
+	merged := MergeRecommendations(4, map[int64]bool{1: true}, primary, secondary, trending)
+
+	assert.Equal(t, []int64{10, 11, 12, 13}, recommendationIDs(merged))
+	assert.Equal(t, RecommendationSourceBoughtTogether, merged[1].Source, "first group wins for repeated listings")
+	assert.Equal(t, RecommendationSourceTrending, merged[3].Source)
+
+	assert.Empty(t, MergeRecommendations(5, nil))
+}
+
+func recommendationIDs(recommendations []Recommendation) []int64 {
+	ids := make([]int64, len(recommendations))
+	for i, r := range recommendations {
+		ids[i] = r.ListingID
+	}
+	return ids
+}
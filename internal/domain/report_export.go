@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// REPORT EXPORTS
+// ============================================================================
+
+// ReportType identifies the data of a report export
+type ReportType string
+
+// Report types
+const (
+	ReportTypeOrders             ReportType = "orders"              // One row per order
+	ReportTypeProductPerformance ReportType = "product_performance" // One row per product: views, favorites, sales
+	ReportTypeStockMovements     ReportType = "stock_movements"     // One row per inventory movement
+)
+
+// ReportFormat is the file format of a report export
+type ReportFormat string
+
+// Report formats
+const (
+	ReportFormatCSV   ReportFormat = "csv"
+	ReportFormatJSONL ReportFormat = "jsonl" // JSON Lines: one JSON object per row
+)
+
+// Report export statuses
+const (
+	ReportExportStatusPending    = "pending"    // Waiting for a worker
+	ReportExportStatusProcessing = "processing" // Being rendered
+	ReportExportStatusCompleted  = "completed"  // File available until expires_at
+	ReportExportStatusFailed     = "failed"     // Retries exhausted or report too large
+)
+
+// Report export limits
+const (
+	// MaxReportRangeDays bounds the period of a single export
+	MaxReportRangeDays = 366
+
+	// MaxReportRows bounds the rows of a single export; larger reports fail permanently
+	MaxReportRows = 1_000_000
+
+	// MaxActiveReportExports bounds the pending and processing exports of one user
+	MaxActiveReportExports = 5
+)
+
+// Valid reports whether t is a known report type
+func (t ReportType) Valid() bool {
+	switch t {
+	case ReportTypeOrders, ReportTypeProductPerformance, ReportTypeStockMovements:
+		return true
+	}
+	return false
+}
+
+// RequiredPermission returns the storefront staff permission needed to export the report
+func (t ReportType) RequiredPermission() string {
+	if t == ReportTypeOrders {
+		return PermissionOrdersRead
+	}
+	return PermissionAnalyticsRead
+}
+
+// Valid reports whether f is a known report format
+func (f ReportFormat) Valid() bool {
+	return f == ReportFormatCSV || f == ReportFormatJSONL
+}
+
+// ContentType returns the MIME type of files in this format
+func (f ReportFormat) ContentType() string {
+	if f == ReportFormatJSONL {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ReportExport is a requested report export and, once completed, its stored file
+type ReportExport struct {
+	ID           int64        `json:"id" db:"id"`
+	RequestedBy  int64        `json:"requested_by" db:"requested_by"`
+	StorefrontID *int64       `json:"storefront_id,omitempty" db:"storefront_id"` // nil = platform-wide
+	ReportType   ReportType   `json:"report_type" db:"report_type"`
+	Format       ReportFormat `json:"format" db:"format"`
+	DateFrom     time.Time    `json:"date_from" db:"date_from"`
+	DateTo       time.Time    `json:"date_to" db:"date_to"`
+
+	Status       string  `json:"status" db:"status"`
+	RetryCount   int32   `json:"retry_count" db:"retry_count"`
+	MaxRetries   int32   `json:"max_retries" db:"max_retries"`
+	ErrorMessage *string `json:"error_message,omitempty" db:"error_message"`
+
+	ObjectKey *string `json:"object_key,omitempty" db:"object_key"`
+	RowCount  *int64  `json:"row_count,omitempty" db:"row_count"`
+	FileSize  *int64  `json:"file_size,omitempty" db:"file_size"`
+
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" db:"completed_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+}
+
+// FileName returns the download file name of the export
+func (e *ReportExport) FileName() string {
+	return fmt.Sprintf("%s_%s_%s.%s",
+		e.ReportType, e.DateFrom.UTC().Format("20060102"), e.DateTo.UTC().Format("20060102"), e.Format)
+}
+
+// ObjectName returns the object storage key of the export file
+func (e *ReportExport) ObjectName() string {
+	scope := "platform"
+	if e.StorefrontID != nil {
+		scope = fmt.Sprintf("storefront_%d", *e.StorefrontID)
+	}
+	return fmt.Sprintf("exports/%s/%d/%s", scope, e.ID, e.FileName())
+}
+
+// IsExpired reports whether the export file has been (or is about to be) purged
+func (e *ReportExport) IsExpired(now time.Time) bool {
+	return e.ExpiresAt != nil && !now.Before(*e.ExpiresAt)
+}
+
+// CreateReportExportInput requests a report export. The period is half-open: [DateFrom, DateTo).
+type CreateReportExportInput struct {
+	RequestedBy  int64        `json:"requested_by"`
+	StorefrontID *int64       `json:"storefront_id,omitempty"`
+	ReportType   ReportType   `json:"report_type"`
+	Format       ReportFormat `json:"format"`
+	DateFrom     time.Time    `json:"date_from"`
+	DateTo       time.Time    `json:"date_to"`
+}
+
+// Validate validates CreateReportExportInput and defaults the format to CSV
+func (input *CreateReportExportInput) Validate() error {
+	if input == nil {
+		return errors.New("input cannot be nil")
+	}
+	if input.RequestedBy <= 0 {
+		return errors.New("requested_by must be greater than 0")
+	}
+	if input.StorefrontID != nil && *input.StorefrontID <= 0 {
+		return errors.New("storefront_id must be greater than 0")
+	}
+	if !input.ReportType.Valid() {
+		return fmt.Errorf("invalid report type: %q", input.ReportType)
+	}
+	if input.Format == "" {
+		input.Format = ReportFormatCSV
+	}
+	if !input.Format.Valid() {
+		return fmt.Errorf("invalid report format: %q", input.Format)
+	}
+	if input.DateFrom.IsZero() || input.DateTo.IsZero() {
+		return errors.New("date_from and date_to are required")
+	}
+	if !input.DateFrom.Before(input.DateTo) {
+		return errors.New("date_from must be before date_to")
+	}
+	if input.DateTo.Sub(input.DateFrom) > MaxReportRangeDays*24*time.Hour {
+		return fmt.Errorf("date range cannot exceed %d days", MaxReportRangeDays)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReportExportInput_Validate(t *testing.T) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	storefrontID := int64(7)
+
+	valid := func() *CreateReportExportInput {
+		return &CreateReportExportInput{
+			RequestedBy:  1,
+			StorefrontID: &storefrontID,
+			ReportType:   ReportTypeOrders,
+			DateFrom:     from,
+			DateTo:       to,
+		}
+	}
+
+	input := valid()
+	require.NoError(t, input.Validate())
+	assert.Equal(t, ReportFormatCSV, input.Format, "format defaults to CSV")
+
+	input = valid()
+	input.StorefrontID = nil
+	assert.NoError(t, input.Validate(), "platform-wide export")
+
+	input = valid()
+	input.RequestedBy = 0
+	assert.Error(t, input.Validate())
+
+	input = valid()
+	input.ReportType = "bogus"
+	assert.Error(t, input.Validate())
+
+	input = valid()
+	input.Format = "xlsx"
+	assert.Error(t, input.Validate())
+
+	input = valid()
+	input.DateTo = from
+	assert.Error(t, input.Validate(), "empty period")
+
+	input = valid()
+	input.DateTo = from.AddDate(0, 0, MaxReportRangeDays+1)
+	assert.Error(t, input.Validate(), "period too long")
+}
+
+func TestReportType_RequiredPermission(t *testing.T) {
+	assert.Equal(t, PermissionOrdersRead, ReportTypeOrders.RequiredPermission())
+	assert.Equal(t, PermissionAnalyticsRead, ReportTypeProductPerformance.RequiredPermission())
+	assert.Equal(t, PermissionAnalyticsRead, ReportTypeStockMovements.RequiredPermission())
+}
+
+func TestReportExport_ObjectName(t *testing.T) {
+	storefrontID := int64(7)
+	export := &ReportExport{
+		ID:           42,
+		StorefrontID: &storefrontID,
+		ReportType:   ReportTypeStockMovements,
+		Format:       ReportFormatJSONL,
+		DateFrom:     time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:       time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, "stock_movements_20251101_20251201.jsonl", export.FileName())
+	assert.Equal(t, "exports/storefront_7/42/stock_movements_20251101_20251201.jsonl", export.ObjectName())
+
+	export.StorefrontID = nil
+	assert.Equal(t, "exports/platform/42/stock_movements_20251101_20251201.jsonl", export.ObjectName())
+}
+
+func TestReportExport_IsExpired(t *testing.T) {
+	now := time.Now()
+	export := &ReportExport{}
+	assert.False(t, export.IsExpired(now), "no expiry before completion")
+
+	expiresAt := now.Add(time.Hour)
+	export.ExpiresAt = &expiresAt
+	assert.False(t, export.IsExpired(now))
+	assert.True(t, export.IsExpired(expiresAt))
+}
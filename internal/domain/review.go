@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Review subjects (reviews.subject_type)
+const (
+	ReviewSubjectProduct    = "product"    // Storefront product (listings.id)
+	ReviewSubjectStorefront = "storefront" // Storefront (storefronts.id)
+)
+
+// Review statuses (reviews.status). Hidden reviews are excluded from listings and aggregates.
+const (
+	ReviewStatusPublished = "published"
+	ReviewStatusHidden    = "hidden"
+)
+
+// Review report reasons (review_reports.reason)
+const (
+	ReviewReportSpam      = "spam"
+	ReviewReportOffensive = "offensive"
+	ReviewReportFake      = "fake"
+	ReviewReportOffTopic  = "off_topic"
+	ReviewReportOther     = "other"
+)
+
+// Review report statuses (review_reports.status)
+const (
+	ReviewReportStatusOpen      = "open"
+	ReviewReportStatusDismissed = "dismissed" // Review restored or kept
+	ReviewReportStatusActioned  = "actioned"  // Review hidden
+)
+
+// Review sort orders
+const (
+	ReviewSortNewest  = "newest"
+	ReviewSortHelpful = "helpful"
+	ReviewSortHighest = "highest"
+	ReviewSortLowest  = "lowest"
+)
+
+// Review limits
+const (
+	MinReviewRating       = 1
+	MaxReviewRating       = 5
+	MaxReviewTitleLength  = 200
+	MaxReviewBodyLength   = 5000
+	MaxReviewReplyLength  = 2000
+	DefaultReviewsLimit   = 20
+	MaxReviewsLimit       = 100
+	ReviewAutoHideReports = 5 // Open reports after which a review is hidden pending moderation
+)
+
+// Review is a buyer's rating of a storefront product or a storefront (reviews table)
+type Review struct {
+	ID           int64  `db:"id" json:"id"`
+	SubjectType  string `db:"subject_type" json:"subject_type"`
+	SubjectID    int64  `db:"subject_id" json:"subject_id"`
+	StorefrontID int64  `db:"storefront_id" json:"storefront_id"` // Storefront that receives replies
+	UserID       int64  `db:"user_id" json:"user_id"`
+
+	// Verified purchase: set when the user has a delivered order for the subject
+	OrderID            *int64 `db:"order_id" json:"order_id,omitempty"`
+	IsVerifiedPurchase bool   `db:"is_verified_purchase" json:"is_verified_purchase"`
+
+	Rating int32   `db:"rating" json:"rating"`
+	Title  *string `db:"title" json:"title,omitempty"`
+	Body   *string `db:"body" json:"body,omitempty"`
+	Status string  `db:"status" json:"status"`
+
+	SellerReply     *string    `db:"seller_reply" json:"seller_reply,omitempty"`
+	SellerReplyBy   *int64     `db:"seller_reply_by" json:"seller_reply_by,omitempty"`
+	SellerRepliedAt *time.Time `db:"seller_replied_at" json:"seller_replied_at,omitempty"`
+
+	HelpfulCount    int32 `db:"helpful_count" json:"helpful_count"`
+	NotHelpfulCount int32 `db:"not_helpful_count" json:"not_helpful_count"`
+	ReportsCount    int32 `db:"reports_count" json:"reports_count"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// IsPublished reports whether the review counts towards the subject's rating
+func (r *Review) IsPublished() bool {
+	return r.Status == ReviewStatusPublished
+}
+
+// IsValidReviewSubject reports whether the subject type can be reviewed
+func IsValidReviewSubject(subjectType string) bool {
+	return subjectType == ReviewSubjectProduct || subjectType == ReviewSubjectStorefront
+}
+
+// IsValidReviewReportReason reports whether the abuse report reason is known
+func IsValidReviewReportReason(reason string) bool {
+	switch reason {
+	case ReviewReportSpam, ReviewReportOffensive, ReviewReportFake, ReviewReportOffTopic, ReviewReportOther:
+		return true
+	}
+	return false
+}
+
+// ReviewInput is the data for a new review
+type ReviewInput struct {
+	SubjectType string
+	SubjectID   int64
+	Rating      int32
+	Title       *string
+	Body        *string
+}
+
+// Validate checks the review input
+func (i *ReviewInput) Validate() error {
+	if !IsValidReviewSubject(i.SubjectType) {
+		return fmt.Errorf("invalid subject type: %s", i.SubjectType)
+	}
+	if i.SubjectID <= 0 {
+		return fmt.Errorf("subject_id is required")
+	}
+	if err := validateReviewRating(i.Rating); err != nil {
+		return err
+	}
+	return validateReviewText(i.Title, i.Body)
+}
+
+// ReviewUpdate holds the review fields an author can change
+type ReviewUpdate struct {
+	Rating *int32
+	Title  *string
+	Body   *string
+}
+
+// Validate checks the review update
+func (u *ReviewUpdate) Validate() error {
+	if u.Rating == nil && u.Title == nil && u.Body == nil {
+		return fmt.Errorf("nothing to update")
+	}
+	if u.Rating != nil {
+		if err := validateReviewRating(*u.Rating); err != nil {
+			return err
+		}
+	}
+	return validateReviewText(u.Title, u.Body)
+}
+
+// ValidateReviewReply checks a seller reply
+func ValidateReviewReply(reply string) error {
+	if strings.TrimSpace(reply) == "" {
+		return fmt.Errorf("reply cannot be empty")
+	}
+	if utf8.RuneCountInString(reply) > MaxReviewReplyLength {
+		return fmt.Errorf("reply must be at most %d characters", MaxReviewReplyLength)
+	}
+	return nil
+}
+
+func validateReviewRating(rating int32) error {
+	if rating < MinReviewRating || rating > MaxReviewRating {
+		return fmt.Errorf("rating must be between %d and %d", MinReviewRating, MaxReviewRating)
+	}
+	return nil
+}
+
+func validateReviewText(title, body *string) error {
+	if title != nil && utf8.RuneCountInString(*title) > MaxReviewTitleLength {
+		return fmt.Errorf("title must be at most %d characters", MaxReviewTitleLength)
+	}
+	if body != nil && utf8.RuneCountInString(*body) > MaxReviewBodyLength {
+		return fmt.Errorf("body must be at most %d characters", MaxReviewBodyLength)
+	}
+	return nil
+}
+
+// ReviewFilter selects the reviews of a subject
+type ReviewFilter struct {
+	SubjectType   string
+	SubjectID     int64
+	Rating        *int32 // Only reviews with this star rating
+	VerifiedOnly  bool
+	IncludeHidden bool // Moderators only
+	Sort          string
+	Limit         int
+	Offset        int
+}
+
+// Normalize applies the default sort and pagination bounds
+func (f *ReviewFilter) Normalize() {
+	switch f.Sort {
+	case ReviewSortNewest, ReviewSortHelpful, ReviewSortHighest, ReviewSortLowest:
+	default:
+		f.Sort = ReviewSortNewest
+	}
+	if f.Limit <= 0 {
+		f.Limit = DefaultReviewsLimit
+	}
+	if f.Limit > MaxReviewsLimit {
+		f.Limit = MaxReviewsLimit
+	}
+	if f.Offset < 0 {
+		f.Offset = 0
+	}
+}
+
+// ReviewSummary is the rating aggregate of a subject (review_aggregates table)
+type ReviewSummary struct {
+	SubjectType  string   `db:"subject_type" json:"subject_type"`
+	SubjectID    int64    `db:"subject_id" json:"subject_id"`
+	ReviewsCount int32    `db:"reviews_count" json:"reviews_count"`
+	RatingSum    int64    `db:"rating_sum" json:"rating_sum"`
+	RatingCounts [5]int32 `json:"rating_counts"` // Index 0 is one star
+}
+
+// Average returns the average rating rounded to two decimals (0 without reviews)
+func (s *ReviewSummary) Average() float64 {
+	if s.ReviewsCount <= 0 {
+		return 0
+	}
+	return math.Round(float64(s.RatingSum)/float64(s.ReviewsCount)*100) / 100
+}
+
+// RatingDelta is an incremental change to a subject's rating aggregate
+type RatingDelta struct {
+	Count   int32
+	Sum     int64
+	Buckets [5]int32 // Index 0 is one star
+}
+
+// Add adds (sign 1) or removes (sign -1) a published rating
+func (d *RatingDelta) Add(rating int32, sign int32) {
+	if rating < MinReviewRating || rating > MaxReviewRating {
+		return
+	}
+	d.Count += sign
+	d.Sum += int64(rating) * int64(sign)
+	d.Buckets[rating-1] += sign
+}
+
+// IsZero reports whether the delta leaves the aggregate unchanged
+func (d *RatingDelta) IsZero() bool {
+	return *d == RatingDelta{}
+}
+
+// ReviewReport is an abuse report on a review (review_reports table)
+type ReviewReport struct {
+	ID         int64      `db:"id" json:"id"`
+	ReviewID   int64      `db:"review_id" json:"review_id"`
+	UserID     int64      `db:"user_id" json:"user_id"`
+	Reason     string     `db:"reason" json:"reason"`
+	Comment    *string    `db:"comment" json:"comment,omitempty"`
+	Status     string     `db:"status" json:"status"`
+	ResolvedBy *int64     `db:"resolved_by" json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `db:"resolved_at" json:"resolved_at,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+}
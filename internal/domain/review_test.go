@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewInput_Validate(t *testing.T) {
+	longTitle := strings.Repeat("a", MaxReviewTitleLength+1)
+	longBody := strings.Repeat("é", MaxReviewBodyLength+1)
+	maxTitle := strings.Repeat("ж", MaxReviewTitleLength)
+
+	tests := []struct {
+		name    string
+		input   ReviewInput
+		wantErr bool
+	}{
+		{name: "product review", input: ReviewInput{SubjectType: ReviewSubjectProduct, SubjectID: 1, Rating: 5}},
+		{name: "storefront review", input: ReviewInput{SubjectType: ReviewSubjectStorefront, SubjectID: 1, Rating: 1, Title: &maxTitle}},
+		{name: "unknown subject", input: ReviewInput{SubjectType: "listing", SubjectID: 1, Rating: 3}, wantErr: true},
+		{name: "missing subject id", input: ReviewInput{SubjectType: ReviewSubjectProduct, Rating: 3}, wantErr: true},
+		{name: "rating too low", input: ReviewInput{SubjectType: ReviewSubjectProduct, SubjectID: 1, Rating: 0}, wantErr: true},
+		{name: "rating too high", input: ReviewInput{SubjectType: ReviewSubjectProduct, SubjectID: 1, Rating: 6}, wantErr: true},
+		{name: "title too long", input: ReviewInput{SubjectType: ReviewSubjectProduct, SubjectID: 1, Rating: 4, Title: &longTitle}, wantErr: true},
+		{name: "body too long", input: ReviewInput{SubjectType: ReviewSubjectProduct, SubjectID: 1, Rating: 4, Body: &longBody}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReviewUpdate_Validate(t *testing.T) {
+	rating := int32(4)
+	badRating := int32(9)
+
+	assert.Error(t, (&ReviewUpdate{}).Validate())
+	assert.NoError(t, (&ReviewUpdate{Rating: &rating}).Validate())
+	assert.Error(t, (&ReviewUpdate{Rating: &badRating}).Validate())
+}
+
+func TestValidateReviewReply(t *testing.T) {
+	assert.NoError(t, ValidateReviewReply("Thank you!"))
+	assert.Error(t, ValidateReviewReply("   "))
+	assert.Error(t, ValidateReviewReply(strings.Repeat("a", MaxReviewReplyLength+1)))
+}
+
+func TestRatingDelta_Add(t *testing.T) {
+	var delta RatingDelta
+	delta.Add(2, -1)
+	delta.Add(5, 1)
+
+	assert.Equal(t, int32(0), delta.Count)
+	assert.Equal(t, int64(3), delta.Sum)
+	assert.Equal(t, [5]int32{0, -1, 0, 0, 1}, delta.Buckets)
+	assert.False(t, delta.IsZero())
+
+	delta.Add(5, -1)
+	delta.Add(2, 1)
+	assert.True(t, delta.IsZero())
+
+	delta.Add(7, 1) // Out of range ratings are ignored
+	assert.True(t, delta.IsZero())
+}
+
+func TestReviewSummary_Average(t *testing.T) {
+	assert.Equal(t, 0.0, (&ReviewSummary{}).Average())
+	assert.Equal(t, 4.5, (&ReviewSummary{ReviewsCount: 2, RatingSum: 9}).Average())
+	assert.Equal(t, 4.67, (&ReviewSummary{ReviewsCount: 3, RatingSum: 14}).Average())
+}
+
+func TestReviewFilter_Normalize(t *testing.T) {
+	filter := ReviewFilter{Sort: "random", Limit: 1000, Offset: -5}
+	filter.Normalize()
+
+	assert.Equal(t, ReviewSortNewest, filter.Sort)
+	assert.Equal(t, MaxReviewsLimit, filter.Limit)
+	assert.Equal(t, 0, filter.Offset)
+
+	filter = ReviewFilter{Sort: ReviewSortHelpful}
+	filter.Normalize()
+
+	assert.Equal(t, ReviewSortHelpful, filter.Sort)
+	assert.Equal(t, DefaultReviewsLimit, filter.Limit)
+}
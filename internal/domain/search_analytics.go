@@ -31,6 +31,10 @@ type CreateSearchQueryInput struct {
 	SessionID        *string `json:"session_id,omitempty" validate:"omitempty,uuid4"`
 	ResultsCount     int32   `json:"results_count" validate:"gte=0"`
 	ClickedListingID *int64  `json:"clicked_listing_id,omitempty"`
+
+	// Search experiment arm that served the results (nil = not enrolled)
+	ExperimentID      *string `json:"experiment_id,omitempty"`
+	ExperimentVariant *string `json:"experiment_variant,omitempty"`
 }
 
 // Validate validates CreateSearchQueryInput
@@ -231,6 +235,148 @@ func (filter *GetCTRAnalysisFilter) Validate() error {
 	return nil
 }
 
+// ============================================================================
+// CLICK TRACKING
+// ============================================================================
+
+// RecordSearchClickInput ties a click on a search result to the search that produced it.
+// The searcher (user or session) must match the one the search was recorded under.
+type RecordSearchClickInput struct {
+	SearchQueryID int64   `json:"search_query_id"`
+	ListingID     int64   `json:"listing_id"`
+	Position      int32   `json:"position"`             // 1-based rank across pages
+	UserID        *int64  `json:"user_id,omitempty"`    // Authenticated searcher
+	SessionID     *string `json:"session_id,omitempty"` // Anonymous searcher
+}
+
+// Validate validates RecordSearchClickInput
+func (input *RecordSearchClickInput) Validate() error {
+	if input.SearchQueryID <= 0 {
+		return fmt.Errorf("search_query_id must be greater than 0")
+	}
+	if input.ListingID <= 0 {
+		return fmt.Errorf("listing_id must be greater than 0")
+	}
+	if input.Position < 1 || input.Position > MaxSearchClickPosition {
+		return fmt.Errorf("position must be between 1 and %d", MaxSearchClickPosition)
+	}
+	if input.UserID == nil && (input.SessionID == nil || *input.SessionID == "") {
+		return fmt.Errorf("user or session_id is required")
+	}
+	return nil
+}
+
+// ============================================================================
+// SEARCH QUALITY REPORTS
+// ============================================================================
+
+// SearchQueryReportType selects which queries a search quality report lists
+type SearchQueryReportType string
+
+const (
+	// SearchQueryReportZeroResults lists queries that returned nothing
+	SearchQueryReportZeroResults SearchQueryReportType = "zero_results"
+
+	// SearchQueryReportLowResults lists queries that returned at most MaxResults results
+	SearchQueryReportLowResults SearchQueryReportType = "low_results"
+
+	// SearchQueryReportTopQueries lists the most frequent queries with their CTR
+	SearchQueryReportTopQueries SearchQueryReportType = "top_queries"
+)
+
+// GetSearchQueryReportFilter represents filters for a search quality report.
+// Queries are grouped case-insensitively.
+type GetSearchQueryReportFilter struct {
+	Type        SearchQueryReportType `json:"type"`
+	MaxResults  int32                 `json:"max_results"`           // Low-results threshold
+	CategoryID  *int64                `json:"category_id,omitempty"` // Filter by category
+	DaysAgo     int32                 `json:"days_ago"`
+	MinSearches int64                 `json:"min_searches"` // Skip queries searched fewer times
+	Limit       int32                 `json:"limit"`
+}
+
+// Validate validates GetSearchQueryReportFilter and applies defaults
+func (filter *GetSearchQueryReportFilter) Validate() error {
+	switch filter.Type {
+	case SearchQueryReportZeroResults, SearchQueryReportTopQueries:
+	case SearchQueryReportLowResults:
+		if filter.MaxResults == 0 {
+			filter.MaxResults = DefaultLowResultsThreshold
+		}
+		if filter.MaxResults < 1 || filter.MaxResults > 100 {
+			return fmt.Errorf("max_results must be between 1 and 100")
+		}
+	default:
+		return fmt.Errorf("invalid report type: %q", filter.Type)
+	}
+
+	if filter.DaysAgo == 0 {
+		filter.DaysAgo = DefaultTrendingDays
+	}
+	if filter.DaysAgo < 1 || filter.DaysAgo > 90 {
+		return fmt.Errorf("days_ago must be between 1 and 90")
+	}
+
+	if filter.MinSearches == 0 {
+		filter.MinSearches = 1
+	}
+	if filter.MinSearches < 1 {
+		return fmt.Errorf("min_searches must be at least 1")
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = DefaultReportLimit
+	}
+	if filter.Limit < 1 || filter.Limit > 100 {
+		return fmt.Errorf("limit must be between 1 and 100")
+	}
+
+	return nil
+}
+
+// SearchQueryPerformance aggregates the searches and clicks of one (normalized) query
+type SearchQueryPerformance struct {
+	QueryText          string    `json:"query_text"` // Lower-cased
+	TotalSearches      int64     `json:"total_searches"`
+	ZeroResultSearches int64     `json:"zero_result_searches"`
+	AvgResultsCount    float64   `json:"avg_results_count"`
+	ClickedSearches    int64     `json:"clicked_searches"` // Searches with at least one click
+	TotalClicks        int64     `json:"total_clicks"`
+	AvgClickPosition   float64   `json:"avg_click_position"` // 0 without clicks
+	CTRPercent         float64   `json:"ctr_percent"`        // clicked searches / searches (calculated)
+	LastSearched       time.Time `json:"last_searched"`
+}
+
+// EnrichWithCalculatedFields calculates the click-through rate
+func (p *SearchQueryPerformance) EnrichWithCalculatedFields() {
+	p.CTRPercent = CalculateCTR(p.TotalSearches, p.ClickedSearches)
+}
+
+// SearchQueryReport is the result of a search quality report
+type SearchQueryReport struct {
+	Type    SearchQueryReportType    `json:"type"`
+	Queries []SearchQueryPerformance `json:"queries"`
+
+	// Totals over all searches of the period (not only the listed queries)
+	TotalSearches      int64   `json:"total_searches"`
+	ZeroResultSearches int64   `json:"zero_result_searches"`
+	ClickedSearches    int64   `json:"clicked_searches"`
+	ZeroResultRate     float64 `json:"zero_result_rate"` // % (calculated)
+	CTRPercent         float64 `json:"ctr_percent"`      // % (calculated)
+
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+// EnrichWithCalculatedFields calculates the report and per-query rates
+func (r *SearchQueryReport) EnrichWithCalculatedFields() {
+	r.ZeroResultRate = percentOf(r.ZeroResultSearches, r.TotalSearches)
+	r.CTRPercent = CalculateCTR(r.TotalSearches, r.ClickedSearches)
+	for i := range r.Queries {
+		r.Queries[i].EnrichWithCalculatedFields()
+	}
+}
+
 // ============================================================================
 // CONSTANTS
 // ============================================================================
@@ -250,4 +396,16 @@ const (
 
 	// RetentionPolicyDays is the number of days to keep search query data
 	RetentionPolicyDays = 90
+
+	// MaxSearchClickPosition is the deepest result position a click can be recorded for
+	MaxSearchClickPosition = 10000
+
+	// SearchClickWindow is how long after a search its results can still be clicked
+	SearchClickWindow = 24 * time.Hour
+
+	// DefaultLowResultsThreshold is the default results count of the low-results report
+	DefaultLowResultsThreshold = 5
+
+	// DefaultReportLimit is the default number of queries in a search quality report
+	DefaultReportLimit = 50
 )
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordSearchClickInput_Validate(t *testing.T) {
+	userID := int64(5)
+	sessionID := "550e8400-e29b-41d4-a716-446655440000"
+
+	assert.NoError(t, (&RecordSearchClickInput{SearchQueryID: 1, ListingID: 2, Position: 1, UserID: &userID}).Validate())
+	assert.NoError(t, (&RecordSearchClickInput{SearchQueryID: 1, ListingID: 2, Position: 1, SessionID: &sessionID}).Validate())
+
+	assert.Error(t, (&RecordSearchClickInput{ListingID: 2, Position: 1, UserID: &userID}).Validate(), "search_query_id required")
+	assert.Error(t, (&RecordSearchClickInput{SearchQueryID: 1, Position: 1, UserID: &userID}).Validate(), "listing_id required")
+	assert.Error(t, (&RecordSearchClickInput{SearchQueryID: 1, ListingID: 2, UserID: &userID}).Validate(), "positions are 1-based")
+	assert.Error(t, (&RecordSearchClickInput{SearchQueryID: 1, ListingID: 2, Position: MaxSearchClickPosition + 1, UserID: &userID}).Validate())
+	assert.Error(t, (&RecordSearchClickInput{SearchQueryID: 1, ListingID: 2, Position: 1}).Validate(), "searcher required")
+}
+
+func TestGetSearchQueryReportFilter_Defaults(t *testing.T) {
+	filter := &GetSearchQueryReportFilter{Type: SearchQueryReportLowResults}
+	require.NoError(t, filter.Validate())
+
+	assert.Equal(t, int32(DefaultLowResultsThreshold), filter.MaxResults)
+	assert.Equal(t, int32(DefaultTrendingDays), filter.DaysAgo)
+	assert.Equal(t, int64(1), filter.MinSearches)
+	assert.Equal(t, int32(DefaultReportLimit), filter.Limit)
+}
+
+func TestGetSearchQueryReportFilter_Validate(t *testing.T) {
+	assert.Error(t, (&GetSearchQueryReportFilter{}).Validate(), "type required")
+	assert.Error(t, (&GetSearchQueryReportFilter{Type: "bogus"}).Validate())
+	assert.Error(t, (&GetSearchQueryReportFilter{Type: SearchQueryReportLowResults, MaxResults: 101}).Validate())
+	assert.Error(t, (&GetSearchQueryReportFilter{Type: SearchQueryReportZeroResults, DaysAgo: 91}).Validate())
+	assert.Error(t, (&GetSearchQueryReportFilter{Type: SearchQueryReportTopQueries, MinSearches: -1}).Validate())
+	assert.Error(t, (&GetSearchQueryReportFilter{Type: SearchQueryReportTopQueries, Limit: 101}).Validate())
+}
+
+func TestSearchQueryReport_EnrichWithCalculatedFields(t *testing.T) {
+	report := &SearchQueryReport{
+		Type:               SearchQueryReportTopQueries,
+		TotalSearches:      200,
+		ZeroResultSearches: 30,
+		ClickedSearches:    90,
+		Queries: []SearchQueryPerformance{
+			{QueryText: "iphone", TotalSearches: 40, ClickedSearches: 10},
+			{QueryText: "zzz", TotalSearches: 5},
+		},
+	}
+
+	report.EnrichWithCalculatedFields()
+
+	assert.InDelta(t, 15.0, report.ZeroResultRate, 0.001)
+	assert.InDelta(t, 45.0, report.CTRPercent, 0.001)
+	assert.InDelta(t, 25.0, report.Queries[0].CTRPercent, 0.001)
+	assert.InDelta(t, 0.0, report.Queries[1].CTRPercent, 0.001)
+}
@@ -0,0 +1,322 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+)
+
+// SearchExperimentStatus is the lifecycle state of a search ranking experiment
+type SearchExperimentStatus string
+
+const (
+	SearchExperimentDraft   SearchExperimentStatus = "draft"   // Defined, not serving traffic
+	SearchExperimentRunning SearchExperimentStatus = "running" // Assigning searchers to its variants
+	SearchExperimentStopped SearchExperimentStatus = "stopped" // Finished; results stay available
+)
+
+// Search experiment limits
+const (
+	MaxSearchExperimentVariants = 5
+	MaxSearchExperimentIDLength = 64
+
+	// SearchConversionWindow is how long after a click an order of the clicked listing
+	// by the same user counts as a conversion of the search
+	SearchConversionWindow = 7 * 24 * time.Hour
+)
+
+// Default field boosts of the search text query
+const (
+	DefaultTitleBoost       = 3.0
+	DefaultDescriptionBoost = 1.0
+)
+
+// searchExperimentNamePattern matches experiment IDs and variant names
+var searchExperimentNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// SearchRanking tunes the relevance scoring of the search text query. Zero values keep the
+// default behaviour; the popularity weights wrap the query in a function_score on the
+// indexed views_count, favorites_count and rating.
+type SearchRanking struct {
+	TitleBoost       float64 `json:"title_boost,omitempty"`       // Default 3
+	DescriptionBoost float64 `json:"description_boost,omitempty"` // Default 1
+	ViewsWeight      float64 `json:"views_weight,omitempty"`      // Factor of log1p(views_count)
+	FavoritesWeight  float64 `json:"favorites_weight,omitempty"`  // Factor of log1p(favorites_count)
+	RatingWeight     float64 `json:"rating_weight,omitempty"`     // Factor of rating (0-5)
+	BoostMode        string  `json:"boost_mode,omitempty"`        // "multiply" (default) or "sum"
+}
+
+// Validate validates the ranking parameters
+func (r *SearchRanking) Validate() error {
+	if r.TitleBoost < 0 || r.TitleBoost > 20 || r.DescriptionBoost < 0 || r.DescriptionBoost > 20 {
+		return fmt.Errorf("field boosts must be between 0 and 20")
+	}
+	for _, weight := range []float64{r.ViewsWeight, r.FavoritesWeight, r.RatingWeight} {
+		if weight < 0 || weight > 10 {
+			return fmt.Errorf("popularity weights must be between 0 and 10")
+		}
+	}
+	switch r.BoostMode {
+	case "", "multiply", "sum":
+	default:
+		return fmt.Errorf("boost_mode must be multiply or sum")
+	}
+	return nil
+}
+
+// FieldBoosts returns the title and description boosts with defaults applied
+func (r *SearchRanking) FieldBoosts() (title, description float64) {
+	title, description = DefaultTitleBoost, DefaultDescriptionBoost
+	if r == nil {
+		return title, description
+	}
+	if r.TitleBoost > 0 {
+		title = r.TitleBoost
+	}
+	if r.DescriptionBoost > 0 {
+		description = r.DescriptionBoost
+	}
+	return title, description
+}
+
+// HasPopularityBoost reports whether the ranking adds a function_score on popularity
+func (r *SearchRanking) HasPopularityBoost() bool {
+	return r != nil && (r.ViewsWeight > 0 || r.FavoritesWeight > 0 || r.RatingWeight > 0)
+}
+
+// SearchExperimentVariant is one arm of an experiment. A variant without ranking is a
+// control arm served by the default query builder.
+type SearchExperimentVariant struct {
+	Name    string         `json:"name"`
+	Weight  int32          `json:"weight"` // Relative share of the experiment traffic
+	Ranking *SearchRanking `json:"ranking,omitempty"`
+}
+
+// SearchExperiment is a search ranking A/B experiment
+type SearchExperiment struct {
+	ID             string                    `json:"id"`
+	Description    string                    `json:"description"`
+	Status         SearchExperimentStatus    `json:"status"`
+	TrafficPercent int32                     `json:"traffic_percent"` // Share of searchers enrolled
+	Variants       []SearchExperimentVariant `json:"variants"`        // The first one is the baseline
+	StartedAt      *time.Time                `json:"started_at,omitempty"`
+	StoppedAt      *time.Time                `json:"stopped_at,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+}
+
+// SearchExperimentAssignment is the experiment arm a search was served by
+type SearchExperimentAssignment struct {
+	ExperimentID string
+	Variant      string
+	Ranking      *SearchRanking // Nil for control arms
+}
+
+// SearchExperimentSubject identifies a searcher for bucketing: the user, else the session.
+// Empty for anonymous searches without a session, which are never enrolled.
+func SearchExperimentSubject(userID *int64, sessionID *string) string {
+	switch {
+	case userID != nil:
+		return fmt.Sprintf("u:%d", *userID)
+	case sessionID != nil && *sessionID != "":
+		return "s:" + *sessionID
+	default:
+		return ""
+	}
+}
+
+// Assign returns the variant of a subject, or nil if the experiment is not running or the
+// subject falls outside its traffic. Bucketing hashes the experiment ID with the subject,
+// so a searcher always sees the same arm and different experiments split independently.
+func (e *SearchExperiment) Assign(subject string) *SearchExperimentVariant {
+	if subject == "" || e.Status != SearchExperimentRunning || len(e.Variants) == 0 {
+		return nil
+	}
+
+	if experimentBucket(e.ID, "traffic", subject, 100) >= uint64(e.TrafficPercent) {
+		return nil
+	}
+
+	var total uint64
+	for _, v := range e.Variants {
+		total += uint64(v.Weight)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	pick := experimentBucket(e.ID, "variant", subject, total)
+	for i := range e.Variants {
+		weight := uint64(e.Variants[i].Weight)
+		if pick < weight {
+			return &e.Variants[i]
+		}
+		pick -= weight
+	}
+	return nil
+}
+
+// AssignSearchExperiment assigns a subject to at most one of the running experiments.
+// Experiments are tried in order, so a subject enrolled in an earlier experiment is never
+// exposed to a later one and concurrent experiments do not contaminate each other.
+func AssignSearchExperiment(experiments []SearchExperiment, subject string) *SearchExperimentAssignment {
+	for i := range experiments {
+		if variant := experiments[i].Assign(subject); variant != nil {
+			return &SearchExperimentAssignment{
+				ExperimentID: experiments[i].ID,
+				Variant:      variant.Name,
+				Ranking:      variant.Ranking,
+			}
+		}
+	}
+	return nil
+}
+
+// experimentBucket maps a subject to one of n buckets, stable per experiment and salt
+func experimentBucket(experimentID, salt, subject string, n uint64) uint64 {
+	sum := sha256.Sum256([]byte(experimentID + ":" + salt + ":" + subject))
+	return binary.BigEndian.Uint64(sum[:8]) % n
+}
+
+// CreateSearchExperimentInput represents input for defining a search experiment
+type CreateSearchExperimentInput struct {
+	ID             string                    `json:"id"`
+	Description    string                    `json:"description"`
+	TrafficPercent int32                     `json:"traffic_percent"`
+	Variants       []SearchExperimentVariant `json:"variants"`
+}
+
+// Validate validates CreateSearchExperimentInput and applies defaults
+func (input *CreateSearchExperimentInput) Validate() error {
+	if len(input.ID) > MaxSearchExperimentIDLength || !searchExperimentNamePattern.MatchString(input.ID) {
+		return fmt.Errorf("id must be 1-%d lowercase letters, digits, '-' or '_'", MaxSearchExperimentIDLength)
+	}
+
+	if input.TrafficPercent == 0 {
+		input.TrafficPercent = 100
+	}
+	if input.TrafficPercent < 1 || input.TrafficPercent > 100 {
+		return fmt.Errorf("traffic_percent must be between 1 and 100")
+	}
+
+	if len(input.Variants) < 2 || len(input.Variants) > MaxSearchExperimentVariants {
+		return fmt.Errorf("an experiment needs between 2 and %d variants", MaxSearchExperimentVariants)
+	}
+
+	names := make(map[string]bool, len(input.Variants))
+	for i := range input.Variants {
+		v := &input.Variants[i]
+		if len(v.Name) > MaxSearchExperimentIDLength || !searchExperimentNamePattern.MatchString(v.Name) {
+			return fmt.Errorf("variant name %q must be 1-%d lowercase letters, digits, '-' or '_'", v.Name, MaxSearchExperimentIDLength)
+		}
+		if names[v.Name] {
+			return fmt.Errorf("duplicate variant name %q", v.Name)
+		}
+		names[v.Name] = true
+
+		if v.Weight == 0 {
+			v.Weight = 1
+		}
+		if v.Weight < 1 || v.Weight > 100 {
+			return fmt.Errorf("variant %q: weight must be between 1 and 100", v.Name)
+		}
+		if v.Ranking != nil {
+			if err := v.Ranking.Validate(); err != nil {
+				return fmt.Errorf("variant %q: %w", v.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CanTransitionTo reports whether the experiment may move to the given status.
+// Stopped experiments are final: restarting would mix two periods in one result.
+func (e *SearchExperiment) CanTransitionTo(status SearchExperimentStatus) bool {
+	switch e.Status {
+	case SearchExperimentDraft:
+		return status == SearchExperimentRunning || status == SearchExperimentStopped
+	case SearchExperimentRunning:
+		return status == SearchExperimentStopped
+	default:
+		return false
+	}
+}
+
+// SearchExperimentArm aggregates the recorded searches served by one variant
+type SearchExperimentArm struct {
+	Variant           string  `json:"variant"`
+	Searches          int64   `json:"searches"`
+	ClickedSearches   int64   `json:"clicked_searches"` // Searches with at least one click
+	Clicks            int64   `json:"clicks"`
+	ConvertedSearches int64   `json:"converted_searches"` // A clicked listing was ordered
+	AvgClickPosition  float64 `json:"avg_click_position"` // 0 without clicks
+
+	// Calculated
+	CTRPercent            float64 `json:"ctr_percent"`             // clicked searches / searches
+	ConversionPercent     float64 `json:"conversion_percent"`      // converted searches / searches
+	CTRLiftPercent        float64 `json:"ctr_lift_percent"`        // Relative to the baseline arm
+	ConversionLiftPercent float64 `json:"conversion_lift_percent"` // Relative to the baseline arm
+	CTRPValue             float64 `json:"ctr_p_value"`             // Two-proportion z-test vs baseline
+	ConversionPValue      float64 `json:"conversion_p_value"`      // Two-proportion z-test vs baseline
+}
+
+// SearchExperimentResults compares the arms of an experiment over its running period
+type SearchExperimentResults struct {
+	Experiment  *SearchExperiment     `json:"experiment"`
+	Arms        []SearchExperimentArm `json:"arms"` // In variant order, baseline first
+	PeriodStart time.Time             `json:"period_start"`
+	PeriodEnd   time.Time             `json:"period_end"`
+}
+
+// EnrichWithCalculatedFields calculates the rates of every arm and compares them with the
+// baseline (first) arm
+func (r *SearchExperimentResults) EnrichWithCalculatedFields() {
+	for i := range r.Arms {
+		arm := &r.Arms[i]
+		arm.CTRPercent = CalculateCTR(arm.Searches, arm.ClickedSearches)
+		arm.ConversionPercent = percentOf(arm.ConvertedSearches, arm.Searches)
+		arm.CTRPValue = 1
+		arm.ConversionPValue = 1
+	}
+	if len(r.Arms) == 0 {
+		return
+	}
+
+	baseline := r.Arms[0]
+	for i := 1; i < len(r.Arms); i++ {
+		arm := &r.Arms[i]
+		arm.CTRLiftPercent = relativeLift(baseline.CTRPercent, arm.CTRPercent)
+		arm.ConversionLiftPercent = relativeLift(baseline.ConversionPercent, arm.ConversionPercent)
+		arm.CTRPValue = twoProportionPValue(baseline.Searches, baseline.ClickedSearches, arm.Searches, arm.ClickedSearches)
+		arm.ConversionPValue = twoProportionPValue(baseline.Searches, baseline.ConvertedSearches, arm.Searches, arm.ConvertedSearches)
+	}
+}
+
+// relativeLift returns the relative change of a rate against the baseline rate in percent
+func relativeLift(baseline, rate float64) float64 {
+	if baseline == 0 {
+		return 0
+	}
+	return (rate - baseline) / baseline * 100
+}
+
+// twoProportionPValue returns the two-sided p-value of a z-test for the difference between
+// two proportions (1 when either sample is empty or there is no variance)
+func twoProportionPValue(n1, x1, n2, x2 int64) float64 {
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	pooled := float64(x1+x2) / float64(n1+n2)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(n1) + 1/float64(n2)))
+	if se == 0 {
+		return 1
+	}
+
+	z := (float64(x2)/float64(n2) - float64(x1)/float64(n1)) / se
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchExperiment_Assign(t *testing.T) {
+	experiment := &SearchExperiment{
+		ID:             "popularity-boost",
+		Status:         SearchExperimentRunning,
+		TrafficPercent: 100,
+		Variants: []SearchExperimentVariant{
+			{Name: "control", Weight: 1},
+			{Name: "popular", Weight: 1, Ranking: &SearchRanking{ViewsWeight: 1}},
+		},
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 2000; i++ {
+		subject := fmt.Sprintf("u:%d", i)
+		variant := experiment.Assign(subject)
+		require.NotNil(t, variant)
+		assert.Equal(t, variant, experiment.Assign(subject), "assignment is deterministic")
+		counts[variant.Name]++
+	}
+	assert.InDelta(t, 1000, counts["control"], 150)
+	assert.InDelta(t, 1000, counts["popular"], 150)
+
+	assert.Nil(t, experiment.Assign(""), "anonymous searches are not enrolled")
+
+	experiment.TrafficPercent = 10
+	enrolled := 0
+	for i := 0; i < 2000; i++ {
+		if experiment.Assign(fmt.Sprintf("s:%d", i)) != nil {
+			enrolled++
+		}
+	}
+	assert.InDelta(t, 200, enrolled, 80)
+
+	experiment.Status = SearchExperimentStopped
+	assert.Nil(t, experiment.Assign("u:1"))
+}
+
+func TestAssignSearchExperiment_MutuallyExclusive(t *testing.T) {
+	experiments := []SearchExperiment{
+		{ID: "first", Status: SearchExperimentRunning, TrafficPercent: 50, Variants: []SearchExperimentVariant{{Name: "a", Weight: 1}}},
+		{ID: "second", Status: SearchExperimentRunning, TrafficPercent: 100, Variants: []SearchExperimentVariant{{Name: "b", Weight: 1}}},
+	}
+
+	for i := 0; i < 200; i++ {
+		subject := fmt.Sprintf("u:%d", i)
+		assignment := AssignSearchExperiment(experiments, subject)
+		require.NotNil(t, assignment)
+		if experiments[0].Assign(subject) != nil {
+			assert.Equal(t, "first", assignment.ExperimentID)
+		} else {
+			assert.Equal(t, "second", assignment.ExperimentID)
+		}
+	}
+
+	assert.Nil(t, AssignSearchExperiment(experiments, ""))
+}
+
+func TestSearchExperimentSubject(t *testing.T) {
+	userID := int64(7)
+	sessionID := "b3c1f2de-0a4b-4c8e-9f10-1234567890ab"
+
+	assert.Equal(t, "u:7", SearchExperimentSubject(&userID, &sessionID))
+	assert.Equal(t, "s:"+sessionID, SearchExperimentSubject(nil, &sessionID))
+	assert.Empty(t, SearchExperimentSubject(nil, nil))
+}
+
+func TestCreateSearchExperimentInput_Validate(t *testing.T) {
+	input := &CreateSearchExperimentInput{
+		ID: "title-boost",
+		Variants: []SearchExperimentVariant{
+			{Name: "control"},
+			{Name: "title5", Ranking: &SearchRanking{TitleBoost: 5}},
+		},
+	}
+	require.NoError(t, input.Validate())
+	assert.Equal(t, int32(100), input.TrafficPercent)
+	assert.Equal(t, int32(1), input.Variants[0].Weight)
+
+	invalid := []*CreateSearchExperimentInput{
+		{ID: "Bad ID", Variants: input.Variants},
+		{ID: "one-arm", Variants: []SearchExperimentVariant{{Name: "control"}}},
+		{ID: "dup", Variants: []SearchExperimentVariant{{Name: "a"}, {Name: "a"}}},
+		{ID: "traffic", TrafficPercent: 101, Variants: input.Variants},
+		{ID: "ranking", Variants: []SearchExperimentVariant{{Name: "a"}, {Name: "b", Ranking: &SearchRanking{BoostMode: "replace"}}}},
+	}
+	for _, in := range invalid {
+		assert.Error(t, in.Validate(), in.ID)
+	}
+}
+
+func TestSearchExperiment_CanTransitionTo(t *testing.T) {
+	experiment := &SearchExperiment{Status: SearchExperimentDraft}
+	assert.True(t, experiment.CanTransitionTo(SearchExperimentRunning))
+
+	experiment.Status = SearchExperimentRunning
+	assert.True(t, experiment.CanTransitionTo(SearchExperimentStopped))
+	assert.False(t, experiment.CanTransitionTo(SearchExperimentDraft))
+
+	experiment.Status = SearchExperimentStopped
+	assert.False(t, experiment.CanTransitionTo(SearchExperimentRunning))
+}
+
+func TestSearchExperimentResults_EnrichWithCalculatedFields(t *testing.T) {
+	results := &SearchExperimentResults{
+		Arms: []SearchExperimentArm{
+			{Variant: "control", Searches: 1000, ClickedSearches: 200, ConvertedSearches: 20},
+			{Variant: "popular", Searches: 1000, ClickedSearches: 250, ConvertedSearches: 20},
+		},
+	}
+
+	results.EnrichWithCalculatedFields()
+
+	control, popular := results.Arms[0], results.Arms[1]
+	assert.InDelta(t, 20.0, control.CTRPercent, 0.001)
+	assert.InDelta(t, 2.0, control.ConversionPercent, 0.001)
+	assert.Equal(t, 1.0, control.CTRPValue, "baseline is not tested against itself")
+
+	assert.InDelta(t, 25.0, popular.CTRLiftPercent, 0.001)
+	assert.Less(t, popular.CTRPValue, 0.05)
+	assert.InDelta(t, 0.0, popular.ConversionLiftPercent, 0.001)
+	assert.InDelta(t, 1.0, popular.ConversionPValue, 0.001)
+}
+
+func TestSearchRanking_FieldBoosts(t *testing.T) {
+	var control *SearchRanking
+	title, description := control.FieldBoosts()
+	assert.Equal(t, DefaultTitleBoost, title)
+	assert.Equal(t, DefaultDescriptionBoost, description)
+	assert.False(t, control.HasPopularityBoost())
+
+	ranking := &SearchRanking{TitleBoost: 5, RatingWeight: 0.5}
+	title, description = ranking.FieldBoosts()
+	assert.Equal(t, 5.0, title)
+	assert.Equal(t, DefaultDescriptionBoost, description)
+	assert.True(t, ranking.HasPopularityBoost())
+}
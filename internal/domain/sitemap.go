@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MaxSitemapURLs is the sitemaps.org limit of URLs per sitemap file. Larger sitemaps are
+// split into pages listed by a sitemap index.
+const MaxSitemapURLs = 50000
+
+// Sitemap change frequencies (sitemaps.org <changefreq>)
+const (
+	SitemapChangeDaily   = "daily"
+	SitemapChangeWeekly  = "weekly"
+	SitemapChangeMonthly = "monthly"
+)
+
+// SitemapURL is a single <url> entry of a sitemap
+type SitemapURL struct {
+	Loc        string
+	LastMod    *time.Time
+	ChangeFreq string
+	Priority   float64 // 0 omits the element
+}
+
+// SitemapItem is a public page source (category, storefront or listing) loaded for sitemaps
+type SitemapItem struct {
+	ID             int64      `db:"id"`
+	Slug           string     `db:"slug"`
+	StorefrontSlug *string    `db:"storefront_slug"` // Set for storefront products
+	UpdatedAt      *time.Time `db:"updated_at"`
+}
+
+// SitemapPageCount returns the number of sitemap files needed for total URLs
+func SitemapPageCount(total, perPage int) int {
+	if total <= 0 {
+		return 1
+	}
+	return (total + perPage - 1) / perPage
+}
+
+// IsNoIndex returns true when the seller opted the storefront out of search engines
+// (seo_meta {"noindex": true})
+func (s *Storefront) IsNoIndex() bool {
+	if len(s.SeoMeta) == 0 {
+		return false
+	}
+	var meta struct {
+		NoIndex bool `json:"noindex"`
+	}
+	if err := json.Unmarshal(s.SeoMeta, &meta); err != nil {
+		return false
+	}
+	return meta.NoIndex
+}
@@ -62,9 +62,11 @@ type Storefront struct {
 	Description *string `db:"description" json:"description,omitempty"`
 
 	// Branding
-	LogoURL   *string `db:"logo_url" json:"logo_url,omitempty"`
-	BannerURL *string `db:"banner_url" json:"banner_url,omitempty"`
-	Theme     JSONB   `db:"theme" json:"theme,omitempty"`
+	LogoURL           *string `db:"logo_url" json:"logo_url,omitempty"`
+	BannerURL         *string `db:"banner_url" json:"banner_url,omitempty"`
+	LogoStoragePath   *string `db:"logo_storage_path" json:"-"` // MinIO key of an uploaded logo
+	BannerStoragePath *string `db:"banner_storage_path" json:"-"`
+	Theme             JSONB   `db:"theme" json:"theme,omitempty"`
 
 	// Contact Information
 	Phone   *string `db:"phone" json:"phone,omitempty"`
@@ -113,6 +115,14 @@ type Storefront struct {
 	// Social Features
 	FollowersCount int32 `db:"followers_count" json:"followers_count"`
 
+	// Vacation and opening hours
+	VacationMode        bool       `db:"vacation_mode" json:"vacation_mode"`
+	VacationStartsAt    *time.Time `db:"vacation_starts_at" json:"vacation_starts_at,omitempty"`
+	VacationEndsAt      *time.Time `db:"vacation_ends_at" json:"vacation_ends_at,omitempty"`
+	VacationMessage     *string    `db:"vacation_message" json:"vacation_message,omitempty"`
+	VacationOrderPolicy string     `db:"vacation_order_policy" json:"vacation_order_policy"`
+	Timezone            *string    `db:"timezone" json:"timezone,omitempty"` // IANA name, NULL = country timezone
+
 	// Timestamps
 	CreatedAt time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at" json:"updated_at"`
@@ -233,6 +243,7 @@ type StorefrontUpdate struct {
 	AIAgentEnabled      *bool    `json:"ai_agent_enabled,omitempty"`
 	LiveShoppingEnabled *bool    `json:"live_shopping_enabled,omitempty"`
 	GroupBuyingEnabled  *bool    `json:"group_buying_enabled,omitempty"`
+	Timezone            *string  `json:"timezone,omitempty"` // Empty resets to the country timezone
 }
 
 // StaffUpdate represents staff fields that can be updated
@@ -314,3 +325,9 @@ type Includes struct {
 	PaymentMethods  bool
 	DeliveryOptions bool
 }
+
+// Storefront branding image kinds
+const (
+	StorefrontImageLogo   = "logo"
+	StorefrontImageBanner = "banner"
+)
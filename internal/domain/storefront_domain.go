@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// StorefrontDomainVerifyPrefix is the DNS label under which the verification TXT record is
+// published, e.g. _svetu-verify.shop.example.rs
+const StorefrontDomainVerifyPrefix = "_svetu-verify"
+
+// MaxStorefrontDomains limits the custom domains of a storefront
+const MaxStorefrontDomains = 5
+
+var domainLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// StorefrontDomain is a custom domain mapped to a storefront (storefront_domains table)
+type StorefrontDomain struct {
+	ID                int64      `db:"id" json:"id"`
+	StorefrontID      int64      `db:"storefront_id" json:"storefront_id"`
+	Domain            string     `db:"domain" json:"domain"`
+	IsPrimary         bool       `db:"is_primary" json:"is_primary"`
+	VerificationToken string     `db:"verification_token" json:"-"`
+	VerifiedAt        *time.Time `db:"verified_at" json:"verified_at,omitempty"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at" json:"updated_at"`
+
+	// StorefrontSlug is loaded when resolving a domain
+	StorefrontSlug string `db:"storefront_slug" json:"storefront_slug,omitempty"`
+}
+
+// IsVerified returns true once DNS ownership of the domain was proven
+func (d *StorefrontDomain) IsVerified() bool {
+	return d.VerifiedAt != nil
+}
+
+// VerificationRecord returns the DNS name of the TXT record proving ownership
+func (d *StorefrontDomain) VerificationRecord() string {
+	return StorefrontDomainVerifyPrefix + "." + d.Domain
+}
+
+// NormalizeStorefrontDomain lower-cases a host name and checks that it is a fully
+// qualified domain name. Schemes, ports, paths and IP addresses are rejected.
+func NormalizeStorefrontDomain(host string) (string, error) {
+	domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if domain == "" {
+		return "", fmt.Errorf("domain is required")
+	}
+	if len(domain) > 253 {
+		return "", fmt.Errorf("domain is too long")
+	}
+	if net.ParseIP(domain) != nil {
+		return "", fmt.Errorf("IP addresses cannot be used as domains")
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", fmt.Errorf("domain must be fully qualified: %s", host)
+	}
+	for _, label := range labels {
+		if !domainLabelRegex.MatchString(label) {
+			return "", fmt.Errorf("invalid domain: %s", host)
+		}
+	}
+
+	return domain, nil
+}
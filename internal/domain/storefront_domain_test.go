@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeStorefrontDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", host: "shop.example.rs", want: "shop.example.rs"},
+		{name: "case and trailing dot", host: " Shop.Example.RS. ", want: "shop.example.rs"},
+		{name: "hyphenated", host: "moja-radnja.co.rs", want: "moja-radnja.co.rs"},
+		{name: "empty", host: "  ", wantErr: true},
+		{name: "single label", host: "localhost", wantErr: true},
+		{name: "scheme", host: "https://shop.example.rs", wantErr: true},
+		{name: "port", host: "shop.example.rs:8080", wantErr: true},
+		{name: "path", host: "shop.example.rs/store", wantErr: true},
+		{name: "ip address", host: "192.168.1.10", wantErr: true},
+		{name: "leading hyphen", host: "-shop.example.rs", wantErr: true},
+		{name: "empty label", host: "shop..example.rs", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeStorefrontDomain(tt.host)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorefrontDomain_VerificationRecord(t *testing.T) {
+	d := StorefrontDomain{Domain: "shop.example.rs"}
+
+	assert.Equal(t, "_svetu-verify.shop.example.rs", d.VerificationRecord())
+	assert.False(t, d.IsVerified())
+}
+
+func TestSitemapPageCount(t *testing.T) {
+	assert.Equal(t, 1, SitemapPageCount(0, MaxSitemapURLs))
+	assert.Equal(t, 1, SitemapPageCount(MaxSitemapURLs, MaxSitemapURLs))
+	assert.Equal(t, 2, SitemapPageCount(MaxSitemapURLs+1, MaxSitemapURLs))
+	assert.Equal(t, 3, SitemapPageCount(250, 100))
+}
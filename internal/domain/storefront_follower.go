@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Following feed page sizes
+const (
+	DefaultFollowingFeedLimit = 20
+	MaxFollowingFeedLimit     = 100
+)
+
+// StorefrontFollower is a user following a storefront (storefront_followers table)
+type StorefrontFollower struct {
+	StorefrontID int64     `db:"storefront_id" json:"storefront_id"`
+	UserID       int64     `db:"user_id" json:"user_id"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"` // When the user started following
+}
+
+// FollowingFeedCursor is the position of the last product of a feed page. The next page
+// starts with products created before it (ties broken by ID).
+type FollowingFeedCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// String encodes the cursor as "<created_at unix microseconds>:<id>"
+func (c FollowingFeedCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.CreatedAt.UnixMicro(), c.ID)
+}
+
+// ParseFollowingFeedCursor decodes a cursor produced by FollowingFeedCursor.String
+func ParseFollowingFeedCursor(cursor string) (*FollowingFeedCursor, error) {
+	micros, id, ok := strings.Cut(cursor, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid feed cursor")
+	}
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed cursor")
+	}
+	listingID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || listingID <= 0 {
+		return nil, fmt.Errorf("invalid feed cursor")
+	}
+	return &FollowingFeedCursor{CreatedAt: time.UnixMicro(createdAt), ID: listingID}, nil
+}
+
+// FollowingFeedFilter selects a page of new products from the storefronts a user follows
+type FollowingFeedFilter struct {
+	UserID int64
+	After  *FollowingFeedCursor // nil for the first page
+	Limit  int
+}
+
+// Normalize applies the default and maximum page size
+func (f *FollowingFeedFilter) Normalize() {
+	if f.Limit <= 0 {
+		f.Limit = DefaultFollowingFeedLimit
+	}
+	if f.Limit > MaxFollowingFeedLimit {
+		f.Limit = MaxFollowingFeedLimit
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowingFeedCursor_RoundTrip(t *testing.T) {
+	cursor := FollowingFeedCursor{CreatedAt: time.Date(2025, 11, 24, 10, 30, 0, 123456000, time.UTC), ID: 42}
+
+	parsed, err := ParseFollowingFeedCursor(cursor.String())
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(parsed.CreatedAt))
+	assert.Equal(t, int64(42), parsed.ID)
+}
+
+func TestParseFollowingFeedCursor_Invalid(t *testing.T) {
+	for _, cursor := range []string{"", "abc", "123", "x:1", "123:y", "123:0"} {
+		_, err := ParseFollowingFeedCursor(cursor)
+		assert.Error(t, err, cursor)
+	}
+}
+
+func TestFollowingFeedFilter_Normalize(t *testing.T) {
+	filter := FollowingFeedFilter{}
+	filter.Normalize()
+	assert.Equal(t, DefaultFollowingFeedLimit, filter.Limit)
+
+	filter = FollowingFeedFilter{Limit: 500}
+	filter.Normalize()
+	assert.Equal(t, MaxFollowingFeedLimit, filter.Limit)
+}
@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Storefront staff permissions. The storefront owner holds all of them; staff members hold the
+// preset of their role, adjusted per member by the permissions object ({"orders.fulfill": true}).
+const (
+	PermissionProductsWrite   = "products.write"   // Create, update and delete products, variants and images
+	PermissionOrdersRead      = "orders.read"      // View storefront orders
+	PermissionOrdersFulfill   = "orders.fulfill"   // Accept, ship and track orders
+	PermissionInventoryAdjust = "inventory.adjust" // Record stock movements
+	PermissionStaffManage     = "staff.manage"     // Invite, update and remove staff
+	PermissionAnalyticsRead   = "analytics.read"   // View storefront statistics
+	PermissionSettingsWrite   = "settings.write"   // Edit storefront profile, hours, payment and delivery
+	PermissionReviewsReply    = "reviews.reply"    // Reply to product and storefront reviews
+)
+
+// PermissionStorefrontDelete is held by the owner only and cannot be granted to staff
+const PermissionStorefrontDelete = "storefront.delete"
+
+// Staff roles
+const (
+	StaffRoleOwner     = "owner" // Co-owner: every staff permission
+	StaffRoleManager   = "manager"
+	StaffRoleCashier   = "cashier"
+	StaffRoleSupport   = "support"
+	StaffRoleModerator = "moderator"
+)
+
+// StaffPermissions lists all permissions that can be granted to staff members
+var StaffPermissions = []string{
+	PermissionProductsWrite,
+	PermissionOrdersRead,
+	PermissionOrdersFulfill,
+	PermissionInventoryAdjust,
+	PermissionStaffManage,
+	PermissionAnalyticsRead,
+	PermissionSettingsWrite,
+	PermissionReviewsReply,
+}
+
+// StaffRolePresets are the permissions granted by each staff role
+var StaffRolePresets = map[string][]string{
+	StaffRoleOwner: StaffPermissions,
+	StaffRoleManager: {
+		PermissionProductsWrite,
+		PermissionOrdersRead,
+		PermissionOrdersFulfill,
+		PermissionInventoryAdjust,
+		PermissionAnalyticsRead,
+		PermissionSettingsWrite,
+		PermissionReviewsReply,
+	},
+	StaffRoleCashier: {
+		PermissionOrdersRead,
+		PermissionOrdersFulfill,
+		PermissionInventoryAdjust,
+	},
+	StaffRoleSupport: {
+		PermissionOrdersRead,
+		PermissionReviewsReply,
+	},
+	StaffRoleModerator: {
+		PermissionProductsWrite,
+	},
+}
+
+// IsValidStaffPermission reports whether the permission can be granted to staff
+func IsValidStaffPermission(permission string) bool {
+	for _, p := range StaffPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStaffRole reports whether the role has a permission preset
+func IsValidStaffRole(role string) bool {
+	_, ok := StaffRolePresets[role]
+	return ok
+}
+
+// ValidateStaffPermissions checks a permission overrides object: known permissions
+// mapped to true (grant) or false (revoke)
+func ValidateStaffPermissions(permissions JSONB) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	var overrides map[string]interface{}
+	if err := json.Unmarshal(permissions, &overrides); err != nil {
+		return fmt.Errorf("permissions must be a JSON object")
+	}
+	for permission, value := range overrides {
+		if !IsValidStaffPermission(permission) {
+			return fmt.Errorf("unknown permission: %s", permission)
+		}
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("permission %s must be true or false", permission)
+		}
+	}
+	return nil
+}
+
+// PermissionOverrides returns the per-member permission adjustments. Unknown keys and
+// non-boolean values (legacy flags) are ignored.
+func (s *StorefrontStaff) PermissionOverrides() map[string]bool {
+	overrides := make(map[string]bool)
+	if len(s.Permissions) == 0 {
+		return overrides
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(s.Permissions, &raw); err != nil {
+		return overrides
+	}
+	for permission, value := range raw {
+		granted, ok := value.(bool)
+		if ok && IsValidStaffPermission(permission) {
+			overrides[permission] = granted
+		}
+	}
+	return overrides
+}
+
+// HasPermission reports whether the staff member holds the permission
+func (s *StorefrontStaff) HasPermission(permission string) bool {
+	if !IsValidStaffPermission(permission) {
+		return false
+	}
+	if granted, ok := s.PermissionOverrides()[permission]; ok {
+		return granted
+	}
+	for _, p := range StaffRolePresets[s.Role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectivePermissions returns the sorted permissions the staff member holds
+func (s *StorefrontStaff) EffectivePermissions() []string {
+	permissions := make([]string, 0, len(StaffPermissions))
+	for _, p := range StaffPermissions {
+		if s.HasPermission(p) {
+			permissions = append(permissions, p)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+// Staff invitation statuses (storefront_staff_invitations.status)
+const (
+	StaffInvitationPending  = "pending"
+	StaffInvitationAccepted = "accepted"
+	StaffInvitationDeclined = "declined"
+	StaffInvitationRevoked  = "revoked"
+	StaffInvitationExpired  = "expired"
+)
+
+// DefaultStaffInvitationTTL is how long an invitation can be accepted
+const DefaultStaffInvitationTTL = 7 * 24 * time.Hour
+
+// StaffInvitation invites a user to join a storefront's staff (storefront_staff_invitations table).
+// The user becomes a staff member only after accepting.
+type StaffInvitation struct {
+	ID           int64      `db:"id" json:"id"`
+	StorefrontID int64      `db:"storefront_id" json:"storefront_id"`
+	UserID       int64      `db:"user_id" json:"user_id"` // Invited user
+	Role         string     `db:"role" json:"role"`
+	Permissions  JSONB      `db:"permissions" json:"permissions,omitempty"`
+	InvitedBy    int64      `db:"invited_by" json:"invited_by"`
+	Status       string     `db:"status" json:"status"`
+	ExpiresAt    time.Time  `db:"expires_at" json:"expires_at"`
+	RespondedAt  *time.Time `db:"responded_at" json:"responded_at,omitempty"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// EffectiveStatus returns the status, reporting pending invitations past their expiry as expired
+func (i *StaffInvitation) EffectiveStatus(now time.Time) string {
+	if i.Status == StaffInvitationPending && !now.Before(i.ExpiresAt) {
+		return StaffInvitationExpired
+	}
+	return i.Status
+}
+
+// IsOpen reports whether the invitation can still be accepted or declined
+func (i *StaffInvitation) IsOpen(now time.Time) bool {
+	return i.EffectiveStatus(now) == StaffInvitationPending
+}
+
+// StaffInvitationFilter selects invitations by storefront and/or invited user
+type StaffInvitationFilter struct {
+	StorefrontID *int64
+	UserID       *int64
+	PendingOnly  bool // Only invitations that can still be answered
+}
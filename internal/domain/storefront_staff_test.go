@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorefrontStaff_HasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		staff      StorefrontStaff
+		permission string
+		want       bool
+	}{
+		{name: "manager preset", staff: StorefrontStaff{Role: StaffRoleManager}, permission: PermissionProductsWrite, want: true},
+		{name: "manager cannot manage staff", staff: StorefrontStaff{Role: StaffRoleManager}, permission: PermissionStaffManage},
+		{name: "cashier fulfils orders", staff: StorefrontStaff{Role: StaffRoleCashier}, permission: PermissionOrdersFulfill, want: true},
+		{name: "support cannot edit products", staff: StorefrontStaff{Role: StaffRoleSupport}, permission: PermissionProductsWrite},
+		{name: "override grants", staff: StorefrontStaff{Role: StaffRoleSupport, Permissions: JSONB(`{"orders.fulfill": true}`)}, permission: PermissionOrdersFulfill, want: true},
+		{name: "override revokes", staff: StorefrontStaff{Role: StaffRoleCashier, Permissions: JSONB(`{"inventory.adjust": false}`)}, permission: PermissionInventoryAdjust},
+		{name: "legacy flags ignored", staff: StorefrontStaff{Role: StaffRoleSupport, Permissions: JSONB(`{"canAddProducts": true}`)}, permission: PermissionOrdersRead, want: true},
+		{name: "owner role cannot delete storefront", staff: StorefrontStaff{Role: StaffRoleOwner}, permission: PermissionStorefrontDelete},
+		{name: "unknown role", staff: StorefrontStaff{Role: "intern"}, permission: PermissionOrdersRead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.staff.HasPermission(tt.permission))
+		})
+	}
+}
+
+func TestStorefrontStaff_EffectivePermissions(t *testing.T) {
+	staff := StorefrontStaff{Role: StaffRoleCashier, Permissions: JSONB(`{"inventory.adjust": false, "analytics.read": true}`)}
+
+	assert.Equal(t, []string{PermissionAnalyticsRead, PermissionOrdersFulfill, PermissionOrdersRead}, staff.EffectivePermissions())
+}
+
+func TestValidateStaffPermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions JSONB
+		wantErr     bool
+	}{
+		{name: "empty"},
+		{name: "known permissions", permissions: JSONB(`{"orders.read": true, "products.write": false}`)},
+		{name: "unknown permission", permissions: JSONB(`{"storefront.delete": true}`), wantErr: true},
+		{name: "non-boolean value", permissions: JSONB(`{"orders.read": "yes"}`), wantErr: true},
+		{name: "not an object", permissions: JSONB(`["orders.read"]`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStaffPermissions(tt.permissions)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaffInvitation_EffectiveStatus(t *testing.T) {
+	now := time.Now()
+
+	pending := StaffInvitation{Status: StaffInvitationPending, ExpiresAt: now.Add(time.Hour)}
+	assert.Equal(t, StaffInvitationPending, pending.EffectiveStatus(now))
+	assert.True(t, pending.IsOpen(now))
+
+	expired := StaffInvitation{Status: StaffInvitationPending, ExpiresAt: now.Add(-time.Hour)}
+	assert.Equal(t, StaffInvitationExpired, expired.EffectiveStatus(now))
+	assert.False(t, expired.IsOpen(now))
+
+	declined := StaffInvitation{Status: StaffInvitationDeclined, ExpiresAt: now.Add(time.Hour)}
+	assert.Equal(t, StaffInvitationDeclined, declined.EffectiveStatus(now))
+	assert.False(t, declined.IsOpen(now))
+}
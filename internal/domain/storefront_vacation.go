@@ -0,0 +1,365 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Vacation order policies (storefronts.vacation_order_policy)
+const (
+	VacationPolicyBlock = "block" // Cart additions and checkout are rejected
+	VacationPolicyDelay = "delay" // Orders are accepted with a delayed shipping notice
+)
+
+// Reasons a storefront is closed, reported by EvaluateOpenStatus
+const (
+	ClosedReasonSchedule = "schedule" // Outside of the working hours
+	ClosedReasonHoliday  = "holiday"
+	ClosedReasonVacation = "vacation"
+)
+
+// Vacation and holiday limits
+const (
+	MaxVacationMessageLength  = 500
+	MaxHolidayNameLength      = 100
+	MaxStorefrontHolidays     = 100
+	DefaultStorefrontTimezone = "Europe/Belgrade" // Storefronts default to country RS
+	openStatusLookaheadDays   = 14                // How far ahead the next opening is searched
+)
+
+// countryTimezones maps ISO 3166-1 alpha-2 country codes to the timezone used for opening
+// hours. Countries spanning several timezones are not listed: such storefronts set an
+// explicit timezone.
+var countryTimezones = map[string]string{
+	"RS": "Europe/Belgrade",
+	"XK": "Europe/Belgrade",
+	"ME": "Europe/Podgorica",
+	"BA": "Europe/Sarajevo",
+	"HR": "Europe/Zagreb",
+	"SI": "Europe/Ljubljana",
+	"MK": "Europe/Skopje",
+	"AL": "Europe/Tirane",
+	"HU": "Europe/Budapest",
+	"RO": "Europe/Bucharest",
+	"BG": "Europe/Sofia",
+	"GR": "Europe/Athens",
+	"AT": "Europe/Vienna",
+	"DE": "Europe/Berlin",
+	"CH": "Europe/Zurich",
+	"IT": "Europe/Rome",
+	"FR": "Europe/Paris",
+	"NL": "Europe/Amsterdam",
+	"BE": "Europe/Brussels",
+	"PL": "Europe/Warsaw",
+	"CZ": "Europe/Prague",
+	"SK": "Europe/Bratislava",
+	"GB": "Europe/London",
+	"IE": "Europe/Dublin",
+	"UA": "Europe/Kyiv",
+	"BY": "Europe/Minsk",
+	"TR": "Europe/Istanbul",
+	"CY": "Asia/Nicosia",
+}
+
+// TimezoneForCountry returns the timezone of a country, or the default storefront
+// timezone for unknown countries
+func TimezoneForCountry(country string) string {
+	if tz, ok := countryTimezones[strings.ToUpper(strings.TrimSpace(country))]; ok {
+		return tz
+	}
+	return DefaultStorefrontTimezone
+}
+
+// ValidateTimezone checks that tz is a known IANA timezone name
+func ValidateTimezone(tz string) error {
+	if tz == "" || tz == "Local" {
+		return fmt.Errorf("invalid timezone: %q", tz)
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("invalid timezone: %s", tz)
+	}
+	return nil
+}
+
+// Location returns the timezone opening hours are evaluated in: the explicit timezone,
+// else the timezone of the storefront country. Falls back to UTC if the timezone
+// database is unavailable.
+func (s *Storefront) Location() *time.Location {
+	name := DefaultStorefrontTimezone
+	if s.Timezone != nil && *s.Timezone != "" {
+		name = *s.Timezone
+	} else if s.Country != nil {
+		name = TimezoneForCountry(*s.Country)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsOnVacation reports whether vacation mode applies at the given time: it is enabled and
+// now lies within the optional vacation window
+func (s *Storefront) IsOnVacation(now time.Time) bool {
+	if !s.VacationMode {
+		return false
+	}
+	if s.VacationStartsAt != nil && now.Before(*s.VacationStartsAt) {
+		return false
+	}
+	if s.VacationEndsAt != nil && !now.Before(*s.VacationEndsAt) {
+		return false
+	}
+	return true
+}
+
+// AcceptsOrders reports whether the storefront takes cart additions and orders at the
+// given time. Storefronts on vacation with the delay policy still accept orders.
+func (s *Storefront) AcceptsOrders(now time.Time) bool {
+	return !s.IsOnVacation(now) || s.VacationOrderPolicy == VacationPolicyDelay
+}
+
+// VacationNotice describes the vacation for buyers, e.g. as a delayed shipping notice
+func (s *Storefront) VacationNotice() string {
+	notice := "The storefront is on vacation"
+	if s.VacationEndsAt != nil {
+		notice += " until " + s.VacationEndsAt.In(s.Location()).Format("2006-01-02")
+	}
+	if s.VacationMessage != nil && *s.VacationMessage != "" {
+		notice += ": " + *s.VacationMessage
+	}
+	return notice
+}
+
+// VacationSettings holds the vacation mode fields a seller can change
+type VacationSettings struct {
+	Enabled     bool
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	Message     *string
+	OrderPolicy string
+}
+
+// Validate checks the vacation settings and defaults the order policy to block
+func (v *VacationSettings) Validate() error {
+	if v.OrderPolicy == "" {
+		v.OrderPolicy = VacationPolicyBlock
+	}
+	if v.OrderPolicy != VacationPolicyBlock && v.OrderPolicy != VacationPolicyDelay {
+		return fmt.Errorf("invalid order policy: %s", v.OrderPolicy)
+	}
+	if v.StartsAt != nil && v.EndsAt != nil && !v.EndsAt.After(*v.StartsAt) {
+		return fmt.Errorf("vacation end must be after its start")
+	}
+	if v.Message != nil && utf8.RuneCountInString(*v.Message) > MaxVacationMessageLength {
+		return fmt.Errorf("vacation message must be at most %d characters", MaxVacationMessageLength)
+	}
+	return nil
+}
+
+// StorefrontHoliday is a date-range closure (storefront_holidays table). Dates are calendar
+// dates in the storefront's timezone; recurring holidays repeat every year.
+type StorefrontHoliday struct {
+	ID              int64     `db:"id" json:"id"`
+	StorefrontID    int64     `db:"storefront_id" json:"storefront_id"`
+	Name            string    `db:"name" json:"name"`
+	StartDate       time.Time `db:"start_date" json:"start_date"`
+	EndDate         time.Time `db:"end_date" json:"end_date"` // Inclusive
+	RecurringYearly bool      `db:"recurring_yearly" json:"recurring_yearly"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+}
+
+// Validate checks the holiday
+func (h *StorefrontHoliday) Validate() error {
+	name := strings.TrimSpace(h.Name)
+	if name == "" {
+		return fmt.Errorf("holiday name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxHolidayNameLength {
+		return fmt.Errorf("holiday name must be at most %d characters", MaxHolidayNameLength)
+	}
+	if h.EndDate.Before(h.StartDate) {
+		return fmt.Errorf("holiday end date must not be before its start date")
+	}
+	if h.RecurringYearly && !h.EndDate.Before(h.StartDate.AddDate(1, 0, 0)) {
+		return fmt.Errorf("recurring holiday must be shorter than a year")
+	}
+	return nil
+}
+
+// Covers reports whether the holiday includes the calendar date of day (in day's location)
+func (h *StorefrontHoliday) Covers(day time.Time) bool {
+	if !h.RecurringYearly {
+		d := civilDate(day)
+		return !d.Before(civilDate(h.StartDate)) && !d.After(civilDate(h.EndDate))
+	}
+
+	key := monthDayKey(day)
+	start, end := monthDayKey(h.StartDate), monthDayKey(h.EndDate)
+	if start <= end {
+		return key >= start && key <= end
+	}
+	// Range wraps over the new year, e.g. Dec 31 - Jan 2
+	return key >= start || key <= end
+}
+
+// OpenStatus is a storefront's opening state at a point in time
+type OpenStatus struct {
+	IsOpen       bool
+	NextOpen     *time.Time // Set when closed and an opening was found within the lookahead
+	NextClose    *time.Time // Set when open
+	ClosedReason string     // ClosedReason* when closed
+	Note         string     // Holiday name, special date note or vacation message
+	Location     *time.Location
+}
+
+// EvaluateOpenStatus evaluates the storefront's working hours, holidays and vacation at now,
+// in the storefront's timezone. Special dates in hours override the weekday schedule for
+// that date; holidays and vacation override both. Closing times at or before the opening
+// time are treated as closing after midnight.
+func EvaluateOpenStatus(sf *Storefront, hours []StorefrontHours, holidays []StorefrontHoliday, now time.Time) OpenStatus {
+	loc := sf.Location()
+	local := now.In(loc)
+	status := OpenStatus{Location: loc}
+
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	// Start from yesterday so that overnight windows reaching into today are seen
+	for i := -1; i <= openStatusLookaheadDays; i++ {
+		day := today.AddDate(0, 0, i)
+		open, closeAt, ok := scheduleFor(day, hours, holidays)
+		if !ok {
+			continue
+		}
+
+		for _, window := range sf.clipVacation(open, closeAt) {
+			if now.Before(window[0]) {
+				status.NextOpen = &window[0]
+				status.ClosedReason, status.Note = closedReason(sf, today, hours, holidays, now)
+				return status
+			}
+			if now.Before(window[1]) {
+				status.IsOpen = true
+				status.NextClose = &window[1]
+				return status
+			}
+		}
+	}
+
+	status.ClosedReason, status.Note = closedReason(sf, today, hours, holidays, now)
+	return status
+}
+
+// clipVacation removes the vacation period from an opening window [open, close). The result
+// has up to two windows.
+func (s *Storefront) clipVacation(open, closeAt time.Time) [][2]time.Time {
+	if !s.VacationMode {
+		return [][2]time.Time{{open, closeAt}}
+	}
+
+	var windows [][2]time.Time
+	if s.VacationStartsAt != nil && s.VacationStartsAt.After(open) {
+		end := closeAt
+		if s.VacationStartsAt.Before(end) {
+			end = *s.VacationStartsAt
+		}
+		windows = append(windows, [2]time.Time{open, end})
+	}
+	if s.VacationEndsAt != nil && s.VacationEndsAt.Before(closeAt) {
+		start := open
+		if s.VacationEndsAt.After(start) {
+			start = *s.VacationEndsAt
+		}
+		windows = append(windows, [2]time.Time{start, closeAt})
+	}
+	return windows
+}
+
+// scheduleFor returns the opening window of a calendar day
+func scheduleFor(day time.Time, hours []StorefrontHours, holidays []StorefrontHoliday) (time.Time, time.Time, bool) {
+	for i := range holidays {
+		if holidays[i].Covers(day) {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	entry := hoursFor(day, hours)
+	if entry == nil || entry.IsClosed || entry.OpenTime == nil || entry.CloseTime == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	open, ok := clockOn(day, *entry.OpenTime)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	closeAt, ok := clockOn(day, *entry.CloseTime)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	if !closeAt.After(open) {
+		closeAt = closeAt.AddDate(0, 0, 1)
+	}
+	return open, closeAt, true
+}
+
+// hoursFor returns the hours entry for a calendar day: its special date entry if any, else
+// the regular entry for its weekday
+func hoursFor(day time.Time, hours []StorefrontHours) *StorefrontHours {
+	date := day.Format("2006-01-02")
+	var regular *StorefrontHours
+	for i := range hours {
+		h := &hours[i]
+		if h.SpecialDate != nil {
+			if strings.HasPrefix(*h.SpecialDate, date) {
+				return h
+			}
+			continue
+		}
+		if regular == nil && h.DayOfWeek == int32(day.Weekday()) {
+			regular = h
+		}
+	}
+	return regular
+}
+
+// closedReason explains why the storefront is closed at now
+func closedReason(sf *Storefront, today time.Time, hours []StorefrontHours, holidays []StorefrontHoliday, now time.Time) (string, string) {
+	if sf.IsOnVacation(now) {
+		if sf.VacationMessage != nil {
+			return ClosedReasonVacation, *sf.VacationMessage
+		}
+		return ClosedReasonVacation, ""
+	}
+	for i := range holidays {
+		if holidays[i].Covers(today) {
+			return ClosedReasonHoliday, holidays[i].Name
+		}
+	}
+	if entry := hoursFor(today, hours); entry != nil && entry.SpecialDate != nil && entry.SpecialNote != nil {
+		return ClosedReasonSchedule, *entry.SpecialNote
+	}
+	return ClosedReasonSchedule, ""
+}
+
+// clockOn returns the time of day value ("15:04:05" or "15:04") on the given day
+func clockOn(day time.Time, value string) (time.Time, bool) {
+	t, err := time.Parse("15:04:05", value)
+	if err != nil {
+		if t, err = time.Parse("15:04", value); err != nil {
+			return time.Time{}, false
+		}
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), t.Second(), 0, day.Location()), true
+}
+
+// civilDate truncates t to its calendar date
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// monthDayKey orders dates within a year, ignoring the year
+func monthDayKey(t time.Time) int {
+	return int(t.Month())*100 + t.Day()
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func weekdayHours(open, closeAt string) []StorefrontHours {
+	hours := make([]StorefrontHours, 0, 7)
+	for day := int32(0); day < 7; day++ {
+		o, c := open, closeAt
+		hours = append(hours, StorefrontHours{DayOfWeek: day, OpenTime: &o, CloseTime: &c})
+	}
+	return hours
+}
+
+func belgrade(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("Europe/Belgrade")
+	require.NoError(t, err)
+	return loc
+}
+
+func TestStorefront_Location(t *testing.T) {
+	rs, me, us, tz := "RS", "me", "US", "Asia/Tokyo"
+
+	assert.Equal(t, "Europe/Belgrade", (&Storefront{Country: &rs}).Location().String())
+	assert.Equal(t, "Europe/Podgorica", (&Storefront{Country: &me}).Location().String())
+	assert.Equal(t, DefaultStorefrontTimezone, (&Storefront{Country: &us}).Location().String())
+	assert.Equal(t, "Asia/Tokyo", (&Storefront{Country: &rs, Timezone: &tz}).Location().String())
+}
+
+func TestValidateTimezone(t *testing.T) {
+	assert.NoError(t, ValidateTimezone("Europe/Belgrade"))
+	assert.Error(t, ValidateTimezone(""))
+	assert.Error(t, ValidateTimezone("Local"))
+	assert.Error(t, ValidateTimezone("Mars/Olympus"))
+}
+
+func TestStorefront_IsOnVacation(t *testing.T) {
+	now := time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-24 * time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	assert.False(t, (&Storefront{}).IsOnVacation(now))
+	assert.True(t, (&Storefront{VacationMode: true}).IsOnVacation(now))
+	assert.True(t, (&Storefront{VacationMode: true, VacationStartsAt: &past, VacationEndsAt: &future}).IsOnVacation(now))
+	assert.False(t, (&Storefront{VacationMode: true, VacationStartsAt: &future}).IsOnVacation(now))
+	assert.False(t, (&Storefront{VacationMode: true, VacationEndsAt: &past}).IsOnVacation(now))
+
+	assert.False(t, (&Storefront{VacationMode: true, VacationOrderPolicy: VacationPolicyBlock}).AcceptsOrders(now))
+	assert.True(t, (&Storefront{VacationMode: true, VacationOrderPolicy: VacationPolicyDelay}).AcceptsOrders(now))
+}
+
+func TestVacationSettings_Validate(t *testing.T) {
+	start := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	settings := VacationSettings{Enabled: true, StartsAt: &start, EndsAt: &end}
+	require.NoError(t, settings.Validate())
+	assert.Equal(t, VacationPolicyBlock, settings.OrderPolicy)
+
+	assert.Error(t, (&VacationSettings{StartsAt: &end, EndsAt: &start}).Validate())
+	assert.Error(t, (&VacationSettings{OrderPolicy: "ignore"}).Validate())
+}
+
+func TestStorefrontHoliday_Covers(t *testing.T) {
+	christmas := StorefrontHoliday{
+		Name:            "Orthodox Christmas",
+		StartDate:       time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+		RecurringYearly: true,
+	}
+	assert.True(t, christmas.Covers(time.Date(2027, 1, 7, 23, 30, 0, 0, time.UTC)))
+	assert.False(t, christmas.Covers(time.Date(2027, 1, 8, 0, 0, 0, 0, time.UTC)))
+
+	newYear := StorefrontHoliday{
+		Name:            "New Year",
+		StartDate:       time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		RecurringYearly: true,
+	}
+	assert.True(t, newYear.Covers(time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, newYear.Covers(time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, newYear.Covers(time.Date(2030, 1, 3, 0, 0, 0, 0, time.UTC)))
+
+	inventory := StorefrontHoliday{
+		Name:      "Inventory",
+		StartDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC),
+	}
+	assert.True(t, inventory.Covers(time.Date(2025, 3, 11, 10, 0, 0, 0, belgrade(t))))
+	assert.False(t, inventory.Covers(time.Date(2026, 3, 11, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestStorefrontHoliday_Validate(t *testing.T) {
+	day := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, (&StorefrontHoliday{Name: "Labour Day", StartDate: day, EndDate: day.AddDate(0, 0, 1), RecurringYearly: true}).Validate())
+	assert.Error(t, (&StorefrontHoliday{Name: " ", StartDate: day, EndDate: day}).Validate())
+	assert.Error(t, (&StorefrontHoliday{Name: "Backwards", StartDate: day, EndDate: day.AddDate(0, 0, -1)}).Validate())
+	assert.Error(t, (&StorefrontHoliday{Name: "Year", StartDate: day, EndDate: day.AddDate(1, 0, 0), RecurringYearly: true}).Validate())
+}
+
+func TestEvaluateOpenStatus(t *testing.T) {
+	loc := belgrade(t)
+	rs := "RS"
+	hours := weekdayHours("09:00:00", "17:00:00")
+
+	t.Run("open in storefront timezone", func(t *testing.T) {
+		// 08:30 UTC is 10:30 in Belgrade (summer time)
+		now := time.Date(2025, 7, 10, 8, 30, 0, 0, time.UTC)
+		status := EvaluateOpenStatus(&Storefront{Country: &rs}, hours, nil, now)
+
+		assert.True(t, status.IsOpen)
+		require.NotNil(t, status.NextClose)
+		assert.Equal(t, time.Date(2025, 7, 10, 17, 0, 0, 0, loc), status.NextClose.In(loc))
+	})
+
+	t.Run("closed before opening", func(t *testing.T) {
+		// 06:30 UTC is 08:30 in Belgrade
+		now := time.Date(2025, 7, 10, 6, 30, 0, 0, time.UTC)
+		status := EvaluateOpenStatus(&Storefront{Country: &rs}, hours, nil, now)
+
+		assert.False(t, status.IsOpen)
+		assert.Equal(t, ClosedReasonSchedule, status.ClosedReason)
+		require.NotNil(t, status.NextOpen)
+		assert.Equal(t, time.Date(2025, 7, 10, 9, 0, 0, 0, loc), status.NextOpen.In(loc))
+	})
+
+	t.Run("holiday skips to the next day", func(t *testing.T) {
+		now := time.Date(2025, 7, 10, 8, 30, 0, 0, time.UTC)
+		holidays := []StorefrontHoliday{{
+			Name:      "Stocktake",
+			StartDate: time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 7, 11, 0, 0, 0, 0, time.UTC),
+		}}
+		status := EvaluateOpenStatus(&Storefront{Country: &rs}, hours, holidays, now)
+
+		assert.False(t, status.IsOpen)
+		assert.Equal(t, ClosedReasonHoliday, status.ClosedReason)
+		assert.Equal(t, "Stocktake", status.Note)
+		require.NotNil(t, status.NextOpen)
+		assert.Equal(t, time.Date(2025, 7, 12, 9, 0, 0, 0, loc), status.NextOpen.In(loc))
+	})
+
+	t.Run("special date overrides weekday", func(t *testing.T) {
+		now := time.Date(2025, 7, 10, 8, 30, 0, 0, time.UTC)
+		date, note := "2025-07-10T00:00:00Z", "Inventory"
+		special := append(weekdayHours("09:00:00", "17:00:00"), StorefrontHours{IsClosed: true, SpecialDate: &date, SpecialNote: &note})
+		status := EvaluateOpenStatus(&Storefront{Country: &rs}, special, nil, now)
+
+		assert.False(t, status.IsOpen)
+		assert.Equal(t, "Inventory", status.Note)
+	})
+
+	t.Run("vacation reopens at its end", func(t *testing.T) {
+		now := time.Date(2025, 7, 10, 8, 30, 0, 0, time.UTC)
+		end := time.Date(2025, 7, 15, 12, 0, 0, 0, loc)
+		message := "Back on the 15th"
+		sf := &Storefront{Country: &rs, VacationMode: true, VacationEndsAt: &end, VacationMessage: &message}
+		status := EvaluateOpenStatus(sf, hours, nil, now)
+
+		assert.False(t, status.IsOpen)
+		assert.Equal(t, ClosedReasonVacation, status.ClosedReason)
+		assert.Equal(t, message, status.Note)
+		require.NotNil(t, status.NextOpen)
+		assert.True(t, status.NextOpen.Equal(end))
+	})
+
+	t.Run("overnight hours", func(t *testing.T) {
+		late := weekdayHours("20:00", "02:00")
+		// 23:30 UTC is 01:30 the next day in Belgrade, inside yesterday's window
+		now := time.Date(2025, 7, 10, 23, 30, 0, 0, time.UTC)
+		status := EvaluateOpenStatus(&Storefront{Country: &rs}, late, nil, now)
+
+		assert.True(t, status.IsOpen)
+		require.NotNil(t, status.NextClose)
+		assert.Equal(t, time.Date(2025, 7, 11, 2, 0, 0, 0, loc), status.NextClose.In(loc))
+	})
+
+	t.Run("no hours", func(t *testing.T) {
+		status := EvaluateOpenStatus(&Storefront{}, nil, nil, time.Now())
+
+		assert.False(t, status.IsOpen)
+		assert.Nil(t, status.NextOpen)
+	})
+}
@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Verification request statuses
+const (
+	VerificationStatusPending   = "pending"
+	VerificationStatusApproved  = "approved"
+	VerificationStatusRejected  = "rejected"
+	VerificationStatusCancelled = "cancelled"
+)
+
+// Verification actions recorded in storefront_verification_events
+const (
+	VerificationActionSubmit  = "submit"
+	VerificationActionApprove = "approve"
+	VerificationActionReject  = "reject"
+	VerificationActionCancel  = "cancel"
+	VerificationActionRevoke  = "revoke"
+)
+
+// Verification document types
+const (
+	VerificationDocBusinessRegistration = "business_registration" // Business register (APR) excerpt
+	VerificationDocTaxCertificate       = "tax_certificate"       // PIB certificate
+	VerificationDocIdentity             = "identity"              // ID of the legal representative
+	VerificationDocAddressProof         = "address_proof"         // Proof of the storefront address
+	VerificationDocOther                = "other"
+)
+
+// Verification limits
+const (
+	MaxVerificationDocuments    = 10
+	MaxVerificationDocumentSize = 10 * 1024 * 1024 // 10MB per document
+	MaxVerificationNotesLength  = 2000
+	MaxVerificationReasonLength = 1000
+)
+
+// verificationDocumentExtensions maps accepted document content types to file extensions
+var verificationDocumentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+var validVerificationDocTypes = map[string]bool{
+	VerificationDocBusinessRegistration: true,
+	VerificationDocTaxCertificate:       true,
+	VerificationDocIdentity:             true,
+	VerificationDocAddressProof:         true,
+	VerificationDocOther:                true,
+}
+
+var (
+	registrationNumberRegex = regexp.MustCompile(`^\d{8}$`) // Matični broj (MB)
+	taxIDRegex              = regexp.MustCompile(`^\d{9}$`) // PIB
+)
+
+// StorefrontVerificationRequest is a seller's request to verify a storefront
+type StorefrontVerificationRequest struct {
+	ID                 int64      `db:"id" json:"id"`
+	StorefrontID       int64      `db:"storefront_id" json:"storefront_id"`
+	SubmittedBy        int64      `db:"submitted_by" json:"submitted_by"`
+	Status             string     `db:"status" json:"status"`
+	BusinessName       string     `db:"business_name" json:"business_name"`
+	RegistrationNumber *string    `db:"registration_number" json:"registration_number,omitempty"`
+	TaxID              *string    `db:"tax_id" json:"tax_id,omitempty"`
+	Notes              *string    `db:"notes" json:"notes,omitempty"`
+	ReviewedBy         *int64     `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt         *time.Time `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	RejectionReason    *string    `db:"rejection_reason" json:"rejection_reason,omitempty"`
+	CreatedAt          time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time  `db:"updated_at" json:"updated_at"`
+
+	Documents []StorefrontVerificationDocument `db:"-" json:"documents,omitempty"`
+}
+
+// StorefrontVerificationDocument is a business document attached to a verification request
+type StorefrontVerificationDocument struct {
+	ID           int64     `db:"id" json:"id"`
+	RequestID    int64     `db:"request_id" json:"request_id"`
+	DocumentType string    `db:"document_type" json:"document_type"`
+	FileName     string    `db:"file_name" json:"file_name"`
+	ContentType  string    `db:"content_type" json:"content_type"`
+	FileSize     int64     `db:"file_size" json:"file_size"`
+	StoragePath  string    `db:"storage_path" json:"-"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+// StorefrontVerificationEvent is an audit record of the verification workflow
+type StorefrontVerificationEvent struct {
+	ID           int64     `db:"id" json:"id"`
+	StorefrontID int64     `db:"storefront_id" json:"storefront_id"`
+	RequestID    *int64    `db:"request_id" json:"request_id,omitempty"` // nil for revocations
+	Action       string    `db:"action" json:"action"`
+	ActorID      int64     `db:"actor_id" json:"actor_id"`
+	Reason       *string   `db:"reason" json:"reason,omitempty"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+// VerificationDecision is an admin's verdict on a pending verification request
+type VerificationDecision struct {
+	RequestID  int64  `json:"request_id"`
+	ReviewerID int64  `json:"reviewer_id"`
+	Approve    bool   `json:"approve"`
+	Reason     string `json:"reason,omitempty"` // Required for rejections
+}
+
+// Validate normalizes the request and checks business data and attached documents
+func (r *StorefrontVerificationRequest) Validate() error {
+	r.BusinessName = strings.TrimSpace(r.BusinessName)
+	if r.BusinessName == "" {
+		return fmt.Errorf("business_name is required")
+	}
+	if len(r.BusinessName) > 255 {
+		return fmt.Errorf("business_name must be at most 255 characters")
+	}
+
+	r.RegistrationNumber = trimmedOrNil(r.RegistrationNumber)
+	if r.RegistrationNumber != nil && !registrationNumberRegex.MatchString(*r.RegistrationNumber) {
+		return fmt.Errorf("registration_number must be 8 digits")
+	}
+	r.TaxID = trimmedOrNil(r.TaxID)
+	if r.TaxID != nil && !taxIDRegex.MatchString(*r.TaxID) {
+		return fmt.Errorf("tax_id must be 9 digits")
+	}
+	r.Notes = trimmedOrNil(r.Notes)
+	if r.Notes != nil && len(*r.Notes) > MaxVerificationNotesLength {
+		return fmt.Errorf("notes must be at most %d characters", MaxVerificationNotesLength)
+	}
+
+	if len(r.Documents) == 0 {
+		return fmt.Errorf("at least one document is required")
+	}
+	if len(r.Documents) > MaxVerificationDocuments {
+		return fmt.Errorf("at most %d documents are allowed", MaxVerificationDocuments)
+	}
+	for _, doc := range r.Documents {
+		if err := ValidateVerificationDocument(doc.DocumentType, doc.ContentType, doc.FileSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasDocument reports whether a document of the given type is attached
+func (r *StorefrontVerificationRequest) HasDocument(documentType string) bool {
+	for _, doc := range r.Documents {
+		if doc.DocumentType == documentType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPending reports whether the request awaits review
+func (r *StorefrontVerificationRequest) IsPending() bool {
+	return r.Status == VerificationStatusPending
+}
+
+// Validate validates the decision and normalizes the reason
+func (d *VerificationDecision) Validate() error {
+	if d.RequestID <= 0 {
+		return fmt.Errorf("request_id must be greater than 0")
+	}
+	if d.ReviewerID <= 0 {
+		return fmt.Errorf("reviewer_id must be greater than 0")
+	}
+
+	d.Reason = strings.TrimSpace(d.Reason)
+	if !d.Approve && d.Reason == "" {
+		return fmt.Errorf("reason is required when rejecting a verification request")
+	}
+	if len(d.Reason) > MaxVerificationReasonLength {
+		return fmt.Errorf("reason must be at most %d characters", MaxVerificationReasonLength)
+	}
+	return nil
+}
+
+// TargetStatus returns the status a pending request moves to after the decision
+func (d *VerificationDecision) TargetStatus() string {
+	if d.Approve {
+		return VerificationStatusApproved
+	}
+	return VerificationStatusRejected
+}
+
+// ValidateVerificationDocument checks the type, format and size of a verification document
+func ValidateVerificationDocument(documentType, contentType string, size int64) error {
+	if !validVerificationDocTypes[documentType] {
+		return fmt.Errorf("invalid document_type: %s", documentType)
+	}
+	if _, ok := verificationDocumentExtensions[contentType]; !ok {
+		return fmt.Errorf("unsupported document content_type %s (allowed: application/pdf, image/jpeg, image/png)", contentType)
+	}
+	if size <= 0 {
+		return fmt.Errorf("document is empty")
+	}
+	if size > MaxVerificationDocumentSize {
+		return fmt.Errorf("document exceeds %dMB limit", MaxVerificationDocumentSize/(1024*1024))
+	}
+	return nil
+}
+
+// VerificationDocumentExt returns the file extension stored for a document content type
+func VerificationDocumentExt(contentType string) string {
+	return verificationDocumentExtensions[contentType]
+}
+
+// trimmedOrNil trims an optional string, mapping blank values to nil
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
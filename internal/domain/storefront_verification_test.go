@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validVerificationRequest() *StorefrontVerificationRequest {
+	return &StorefrontVerificationRequest{
+		StorefrontID: 7,
+		SubmittedBy:  42,
+		BusinessName: "  Tech Shop d.o.o. ",
+		Documents: []StorefrontVerificationDocument{
+			{DocumentType: VerificationDocBusinessRegistration, ContentType: "application/pdf", FileSize: 1024},
+		},
+	}
+}
+
+func TestStorefrontVerificationRequest_Validate(t *testing.T) {
+	blank := "  "
+	tests := []struct {
+		name    string
+		modify  func(r *StorefrontVerificationRequest)
+		wantErr string
+	}{
+		{name: "valid", modify: func(r *StorefrontVerificationRequest) {}},
+		{name: "valid identifiers", modify: func(r *StorefrontVerificationRequest) {
+			mb, pib := "21345678", " 109876543 "
+			r.RegistrationNumber, r.TaxID = &mb, &pib
+		}},
+		{name: "blank identifiers ignored", modify: func(r *StorefrontVerificationRequest) {
+			r.RegistrationNumber, r.TaxID, r.Notes = &blank, &blank, &blank
+		}},
+		{name: "missing business name", modify: func(r *StorefrontVerificationRequest) { r.BusinessName = " " }, wantErr: "business_name is required"},
+		{name: "invalid registration number", modify: func(r *StorefrontVerificationRequest) {
+			mb := "1234"
+			r.RegistrationNumber = &mb
+		}, wantErr: "registration_number"},
+		{name: "invalid tax id", modify: func(r *StorefrontVerificationRequest) {
+			pib := "10987654A"
+			r.TaxID = &pib
+		}, wantErr: "tax_id"},
+		{name: "no documents", modify: func(r *StorefrontVerificationRequest) { r.Documents = nil }, wantErr: "at least one document"},
+		{name: "unsupported content type", modify: func(r *StorefrontVerificationRequest) {
+			r.Documents[0].ContentType = "application/zip"
+		}, wantErr: "unsupported document content_type"},
+		{name: "unknown document type", modify: func(r *StorefrontVerificationRequest) {
+			r.Documents[0].DocumentType = "selfie"
+		}, wantErr: "invalid document_type"},
+		{name: "document too large", modify: func(r *StorefrontVerificationRequest) {
+			r.Documents[0].FileSize = MaxVerificationDocumentSize + 1
+		}, wantErr: "exceeds"},
+		{name: "notes too long", modify: func(r *StorefrontVerificationRequest) {
+			notes := strings.Repeat("a", MaxVerificationNotesLength+1)
+			r.Notes = &notes
+		}, wantErr: "notes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validVerificationRequest()
+			tt.modify(r)
+
+			err := r.Validate()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Tech Shop d.o.o.", r.BusinessName)
+		})
+	}
+}
+
+func TestStorefrontVerificationRequest_Validate_NormalizesIdentifiers(t *testing.T) {
+	r := validVerificationRequest()
+	pib, blank := " 109876543 ", " "
+	r.TaxID, r.Notes = &pib, &blank
+
+	require.NoError(t, r.Validate())
+	assert.Equal(t, "109876543", *r.TaxID)
+	assert.Nil(t, r.Notes)
+}
+
+func TestStorefrontVerificationRequest_HasDocument(t *testing.T) {
+	r := validVerificationRequest()
+
+	assert.True(t, r.HasDocument(VerificationDocBusinessRegistration))
+	assert.False(t, r.HasDocument(VerificationDocAddressProof))
+}
+
+func TestVerificationDecision_Validate(t *testing.T) {
+	approve := &VerificationDecision{RequestID: 1, ReviewerID: 2, Approve: true}
+	require.NoError(t, approve.Validate())
+	assert.Equal(t, VerificationStatusApproved, approve.TargetStatus())
+
+	reject := &VerificationDecision{RequestID: 1, ReviewerID: 2, Reason: "  "}
+	assert.Error(t, reject.Validate())
+
+	reject.Reason = " Documents are not legible "
+	require.NoError(t, reject.Validate())
+	assert.Equal(t, "Documents are not legible", reject.Reason)
+	assert.Equal(t, VerificationStatusRejected, reject.TargetStatus())
+
+	assert.Error(t, (&VerificationDecision{ReviewerID: 2, Approve: true}).Validate())
+}
+
+func TestVerificationDocumentExt(t *testing.T) {
+	assert.Equal(t, ".pdf", VerificationDocumentExt("application/pdf"))
+	assert.Equal(t, ".jpg", VerificationDocumentExt("image/jpeg"))
+	assert.Equal(t, "", VerificationDocumentExt("text/plain"))
+}
@@ -0,0 +1,65 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/nfnt/resize"
+)
+
+// Storefront branding sizes
+const (
+	LogoSize     = 512  // Logos are square
+	BannerWidth  = 1600 // Banners are 4:1
+	BannerHeight = 400
+)
+
+// CenterCrop returns the largest centered rectangle of an image with the given aspect ratio
+func CenterCrop(img image.Image, aspectWidth, aspectHeight int) image.Rectangle {
+	width := img.Bounds().Dx()
+	height := img.Bounds().Dy()
+
+	cropWidth := width
+	cropHeight := width * aspectHeight / aspectWidth
+	if cropHeight > height {
+		cropHeight = height
+		cropWidth = height * aspectWidth / aspectHeight
+	}
+
+	x := (width - cropWidth) / 2
+	y := (height - cropHeight) / 2
+	return image.Rect(x, y, x+cropWidth, y+cropHeight)
+}
+
+// CropAndFit crops an image to rect (relative to its top-left corner) and scales the result
+// down to fit maxWidth x maxHeight. Images are never upscaled.
+func CropAndFit(img image.Image, rect image.Rectangle, maxWidth, maxHeight int) (image.Image, error) {
+	src := toNRGBA(img)
+	if rect.Empty() || !rect.In(src.Rect) {
+		return nil, fmt.Errorf("crop %v is outside of the %dx%d image", rect, src.Rect.Dx(), src.Rect.Dy())
+	}
+
+	cropped := src.SubImage(rect)
+	return resize.Thumbnail(uint(maxWidth), uint(maxHeight), cropped, resize.Lanczos3), nil
+}
+
+// Encode encodes an image as PNG (format "png", keeps transparency) or JPEG and returns
+// the data, content type and file extension
+func Encode(img image.Image, format string) ([]byte, string, string, error) {
+	var buf bytes.Buffer
+
+	if format == "png" {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", "", fmt.Errorf("failed to encode image: %w", err)
+		}
+		return buf.Bytes(), "image/png", ".png", nil
+	}
+
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: OriginalQuality}); err != nil {
+		return nil, "", "", fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), "image/jpeg", ".jpg", nil
+}
@@ -0,0 +1,110 @@
+package imaging
+
+import "encoding/binary"
+
+// EXIF orientation values (TIFF tag 0x0112)
+const (
+	OrientationNormal     = 1
+	OrientationFlipH      = 2
+	OrientationRotate180  = 3
+	OrientationFlipV      = 4
+	OrientationTranspose  = 5
+	OrientationRotate90   = 6 // Rotate 90° clockwise to display
+	OrientationTransverse = 7
+	OrientationRotate270  = 8 // Rotate 90° counter-clockwise to display
+)
+
+const (
+	jpegMarkerSOI  = 0xD8
+	jpegMarkerAPP1 = 0xE1
+	jpegMarkerSOS  = 0xDA
+	jpegMarkerEOI  = 0xD9
+
+	exifTagOrientation = 0x0112
+	tiffTypeShort      = 3
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// Orientation reads the EXIF orientation of a JPEG image.
+// Returns OrientationNormal when the data is not a JPEG or carries no valid orientation tag.
+func Orientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != jpegMarkerSOI {
+		return OrientationNormal
+	}
+
+	// Walk the marker segments up to the start of scan
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return OrientationNormal
+		}
+		marker := data[pos+1]
+		if marker == 0xFF { // Fill byte
+			pos++
+			continue
+		}
+		if marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			return OrientationNormal
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return OrientationNormal
+		}
+		segment := data[pos+4 : pos+2+length]
+
+		if marker == jpegMarkerAPP1 && len(segment) > len(exifHeader) && string(segment[:len(exifHeader)]) == string(exifHeader) {
+			return tiffOrientation(segment[len(exifHeader):])
+		}
+		pos += 2 + length
+	}
+
+	return OrientationNormal
+}
+
+// tiffOrientation reads the orientation tag from IFD0 of a TIFF structure
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return OrientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return OrientationNormal
+	}
+	if order.Uint16(tiff[2:]) != 0x002A {
+		return OrientationNormal
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return OrientationNormal
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) != exifTagOrientation {
+			continue
+		}
+		if order.Uint16(tiff[entry+2:]) != tiffTypeShort {
+			return OrientationNormal
+		}
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < OrientationNormal || value > OrientationRotate270 {
+			return OrientationNormal
+		}
+		return value
+	}
+
+	return OrientationNormal
+}
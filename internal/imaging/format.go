@@ -0,0 +1,31 @@
+package imaging
+
+import "bytes"
+
+// HeaderSize is the number of leading bytes DetectFormat needs
+const HeaderSize = 12
+
+// FormatContentTypes maps detected formats to their MIME types
+var FormatContentTypes = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"gif":  "image/gif",
+	"webp": "image/webp",
+}
+
+// DetectFormat identifies an image format from its magic bytes.
+// Returns an empty string for unknown formats.
+func DetectFormat(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, []byte{0xFF, 0xD8, 0xFF}):
+		return "jpeg"
+	case bytes.HasPrefix(header, []byte("\x89PNG\r\n\x1a\n")):
+		return "png"
+	case bytes.HasPrefix(header, []byte("GIF87a")), bytes.HasPrefix(header, []byte("GIF89a")):
+		return "gif"
+	case len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP")):
+		return "webp"
+	default:
+		return ""
+	}
+}
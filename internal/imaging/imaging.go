@@ -0,0 +1,158 @@
+// Package imaging prepares uploaded images for storage: upload validation, EXIF orientation
+// correction, metadata stripping, responsive renditions, blurhash placeholders, branding
+// crops and the object key layout shared by listings, products, variants and storefronts.
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"path/filepath"
+	"strings"
+
+	"github.com/nfnt/resize"
+)
+
+// Encoding settings
+const (
+	OriginalQuality  = 92  // JPEG quality of the re-encoded original
+	RenditionQuality = 85  // JPEG quality of renditions
+	ThumbnailSize    = 200 // Thumbnail bounding box (200x200px)
+	ThumbnailQuality = 85  // JPEG quality of thumbnails
+)
+
+// RenditionWidths are the widths generated for every image (never upscaled)
+var RenditionWidths = []int{200, 400, 800, 1600}
+
+// Processed is an uploaded image prepared for storage
+type Processed struct {
+	Image       image.Image // Upright image
+	Format      string      // Decoded format: jpeg, png or gif
+	Data        []byte      // Original to store, without EXIF/GPS metadata
+	ContentType string
+	BlurHash    string
+}
+
+// Rendition is a JPEG resized to a target width
+type Rendition struct {
+	Width  int
+	Height int
+	Data   []byte
+}
+
+// Process decodes an uploaded image, applies its EXIF orientation and re-encodes it.
+// Re-encoding drops all metadata (EXIF, GPS, XMP). GIFs are stored as uploaded:
+// they carry no EXIF and re-encoding would lose the animation.
+func Process(data []byte) (*Processed, error) {
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image format: %w", err)
+	}
+
+	processed := &Processed{Format: format}
+
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		img = ApplyOrientation(img, Orientation(data))
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: OriginalQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		processed.Data = buf.Bytes()
+		processed.ContentType = "image/jpeg"
+	case "png":
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		processed.Data = buf.Bytes()
+		processed.ContentType = "image/png"
+	case "gif":
+		processed.Data = data
+		processed.ContentType = "image/gif"
+	default:
+		return nil, fmt.Errorf("unsupported image format: %s", format)
+	}
+	processed.Image = img
+
+	hash, err := BlurHash(img, BlurHashXComponents, BlurHashYComponents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute blurhash: %w", err)
+	}
+	processed.BlurHash = hash
+
+	return processed, nil
+}
+
+// Extension returns the file extension matching the processed format
+func (p *Processed) Extension() string {
+	if p.Format == "jpeg" {
+		return ".jpg"
+	}
+	return "." + p.Format
+}
+
+// Thumbnail generates the JPEG thumbnail (fits 200x200px, aspect ratio kept)
+func Thumbnail(img image.Image) ([]byte, error) {
+	thumbnail := resize.Thumbnail(ThumbnailSize, ThumbnailSize, img, resize.Lanczos3)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: ThumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Renditions generates JPEG renditions for every width in RenditionWidths that does
+// not exceed the image width. Images narrower than the smallest width get a single
+// rendition at their own width.
+func Renditions(img image.Image) ([]Rendition, error) {
+	width := img.Bounds().Dx()
+
+	var widths []int
+	for _, w := range RenditionWidths {
+		if w <= width {
+			widths = append(widths, w)
+		}
+	}
+	if len(widths) == 0 {
+		widths = []int{width}
+	}
+
+	renditions := make([]Rendition, 0, len(widths))
+	for _, w := range widths {
+		resized := img
+		if w != width {
+			resized = resize.Resize(uint(w), 0, img, resize.Lanczos3)
+		}
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: RenditionQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode %dpx rendition: %w", w, err)
+		}
+		renditions = append(renditions, Rendition{
+			Width:  resized.Bounds().Dx(),
+			Height: resized.Bounds().Dy(),
+			Data:   buf.Bytes(),
+		})
+	}
+
+	return renditions, nil
+}
+
+// ThumbnailKey returns the object key of the thumbnail stored next to the original
+// Input:  "listings/123/1638360000_abc123.jpg"
+// Output: "listings/123/1638360000_abc123_thumb.jpg"
+func ThumbnailKey(originalKey string) string {
+	return strings.TrimSuffix(originalKey, filepath.Ext(originalKey)) + "_thumb.jpg"
+}
+
+// RenditionKey returns the object key of a rendition stored next to the original
+// Input:  "listings/123/1638360000_abc123.jpg", 400
+// Output: "listings/123/1638360000_abc123_w400.jpg"
+func RenditionKey(originalKey string, width int) string {
+	base := strings.TrimSuffix(originalKey, filepath.Ext(originalKey))
+	return fmt.Sprintf("%s_w%d.jpg", base, width)
+}
@@ -0,0 +1,223 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exifSegment builds an APP1 segment with IFD0 holding the orientation and a GPS IFD pointer
+func exifSegment(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+2*12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 0x002A)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 2)
+
+	// GPSInfo pointer (LONG) before the orientation tag
+	order.PutUint16(tiff[10:], 0x8825)
+	order.PutUint16(tiff[12:], 4)
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint32(tiff[18:], 0)
+
+	order.PutUint16(tiff[22:], exifTagOrientation)
+	order.PutUint16(tiff[24:], tiffTypeShort)
+	order.PutUint32(tiff[26:], 1)
+	order.PutUint16(tiff[30:], orientation)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, jpegMarkerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// testJPEG encodes a w x h JPEG with a red left half, optionally with an EXIF segment
+func testJPEG(t *testing.T, w, h int, exif []byte) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	data := buf.Bytes()
+	if exif == nil {
+		return data
+	}
+	return append(append(append([]byte{}, data[:2]...), exif...), data[2:]...)
+}
+
+func TestOrientation(t *testing.T) {
+	assert.Equal(t, OrientationRotate90, Orientation(testJPEG(t, 8, 4, exifSegment(binary.LittleEndian, 6))))
+	assert.Equal(t, OrientationRotate270, Orientation(testJPEG(t, 8, 4, exifSegment(binary.BigEndian, 8))))
+	assert.Equal(t, OrientationNormal, Orientation(testJPEG(t, 8, 4, nil)))
+	assert.Equal(t, OrientationNormal, Orientation(testJPEG(t, 8, 4, exifSegment(binary.BigEndian, 42))))
+	assert.Equal(t, OrientationNormal, Orientation([]byte("not a jpeg")))
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 3x2 image with distinct red values: R = 10*y + x
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{R: uint8(10*y + x), A: 255})
+		}
+	}
+	red := func(img image.Image, x, y int) uint8 {
+		return img.(*image.NRGBA).NRGBAAt(x, y).R
+	}
+
+	rotated := ApplyOrientation(src, OrientationRotate90)
+	require.Equal(t, image.Rect(0, 0, 2, 3), rotated.Bounds())
+	assert.Equal(t, uint8(10), red(rotated, 0, 0)) // Bottom-left moves to top-left
+	assert.Equal(t, uint8(0), red(rotated, 1, 0))
+	assert.Equal(t, uint8(2), red(rotated, 1, 2))
+
+	ccw := ApplyOrientation(src, OrientationRotate270)
+	assert.Equal(t, uint8(2), red(ccw, 0, 0)) // Top-right moves to top-left
+	assert.Equal(t, uint8(10), red(ccw, 1, 2))
+
+	flipped := ApplyOrientation(src, OrientationFlipH)
+	assert.Equal(t, uint8(2), red(flipped, 0, 0))
+
+	assert.Same(t, src, ApplyOrientation(src, OrientationNormal).(*image.NRGBA))
+}
+
+func TestProcess_CorrectsOrientationAndStripsExif(t *testing.T) {
+	data := testJPEG(t, 64, 32, exifSegment(binary.LittleEndian, OrientationRotate90))
+	require.True(t, bytes.Contains(data, []byte("Exif")))
+
+	processed, err := Process(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "jpeg", processed.Format)
+	assert.Equal(t, "image/jpeg", processed.ContentType)
+	assert.Equal(t, ".jpg", processed.Extension())
+	assert.Equal(t, image.Rect(0, 0, 32, 64), processed.Image.Bounds())
+	assert.False(t, bytes.Contains(processed.Data, []byte("Exif")))
+	assert.Equal(t, OrientationNormal, Orientation(processed.Data))
+	assert.Len(t, processed.BlurHash, 28)
+
+	_, err = Process([]byte("not an image"))
+	assert.Error(t, err)
+}
+
+func TestRenditions(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+
+	renditions, err := Renditions(img)
+	require.NoError(t, err)
+	require.Len(t, renditions, 3)
+	assert.Equal(t, 200, renditions[0].Width)
+	assert.Equal(t, 100, renditions[0].Height)
+	assert.Equal(t, 800, renditions[2].Width)
+
+	decoded, err := jpeg.Decode(bytes.NewReader(renditions[1].Data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 400, 200), decoded.Bounds())
+
+	small, err := Renditions(image.NewRGBA(image.Rect(0, 0, 120, 90)))
+	require.NoError(t, err)
+	require.Len(t, small, 1)
+	assert.Equal(t, 120, small[0].Width)
+}
+
+func TestBlurHash(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 100, 50))
+	for y := 0; y < 50; y++ {
+		for x := 0; x < 100; x++ {
+			img.Set(x, y, color.RGBA{R: 255, G: 128, A: 255})
+		}
+	}
+
+	hash, err := BlurHash(img, 4, 3)
+	require.NoError(t, err)
+	require.Len(t, hash, 28)
+
+	// Size flag: (4-1) + (3-1)*9 = 21 -> "L", followed by the max AC value and the average color
+	assert.Equal(t, "L", hash[:1])
+	assert.Equal(t, encodeBase83(255<<16+128<<8, 4), hash[2:6])
+
+	_, err = BlurHash(img, 0, 3)
+	assert.Error(t, err)
+}
+
+func TestThumbnail(t *testing.T) {
+	data, err := Thumbnail(image.NewRGBA(image.Rect(0, 0, 800, 400)))
+	require.NoError(t, err)
+
+	decoded, err := jpeg.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 200, 100), decoded.Bounds())
+}
+
+func TestObjectKeys(t *testing.T) {
+	assert.Equal(t, "listings/123/1638360000_abc123_thumb.jpg", ThumbnailKey("listings/123/1638360000_abc123.jpeg"))
+	assert.Equal(t, "listings/123/1638360000_abc123_w400.jpg", RenditionKey("listings/123/1638360000_abc123.png", 400))
+}
+
+func TestDetectFormat(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 4)), nil))
+
+	assert.Equal(t, "jpeg", DetectFormat(buf.Bytes()[:HeaderSize]))
+	assert.Equal(t, "png", DetectFormat([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0d")))
+	assert.Equal(t, "gif", DetectFormat([]byte("GIF89a")))
+	assert.Equal(t, "webp", DetectFormat([]byte("RIFF\x00\x00\x00\x00WEBP")))
+	assert.Equal(t, "", DetectFormat([]byte("RIFF\x00\x00\x00\x00WAVE")))
+	assert.Equal(t, "", DetectFormat([]byte("<svg")))
+	assert.Equal(t, "", DetectFormat(nil))
+}
+
+func TestCropAndFit(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2000, 1000))
+
+	// Default banner crop: full width, 4:1
+	rect := CenterCrop(img, BannerWidth, BannerHeight)
+	assert.Equal(t, image.Rect(0, 250, 2000, 750), rect)
+
+	banner, err := CropAndFit(img, rect, BannerWidth, BannerHeight)
+	require.NoError(t, err)
+	assert.Equal(t, BannerWidth, banner.Bounds().Dx())
+	assert.Equal(t, BannerHeight, banner.Bounds().Dy())
+
+	// Default logo crop: centered square, never upscaled
+	rect = CenterCrop(img, 1, 1)
+	assert.Equal(t, image.Rect(500, 0, 1500, 1000), rect)
+
+	logo, err := CropAndFit(img, image.Rect(10, 10, 110, 110), LogoSize, LogoSize)
+	require.NoError(t, err)
+	assert.Equal(t, 100, logo.Bounds().Dx())
+	assert.Equal(t, 100, logo.Bounds().Dy())
+
+	_, err = CropAndFit(img, image.Rect(1900, 0, 2100, 200), LogoSize, LogoSize)
+	assert.Error(t, err)
+}
+
+func TestKeyLayout(t *testing.T) {
+	key := NewObjectKey(OwnerStorefronts, 7, "logo_", ".PNG")
+	assert.Regexp(t, `^storefronts/7/logo_\d+_[0-9a-f]{8}\.png$`, key)
+	assert.Equal(t, "listings/123/", KeyPrefix(OwnerListings, 123))
+
+	assert.Equal(t, "listings/123/1638360000_abc123", BaseKey("listings/123/1638360000_abc123.png"))
+	assert.Equal(t, "listings/123/1638360000_abc123", BaseKey(ThumbnailKey("listings/123/1638360000_abc123.png")))
+	assert.Equal(t, "listings/123/1638360000_abc123", BaseKey(RenditionKey("listings/123/1638360000_abc123.png", 400)))
+}
@@ -0,0 +1,48 @@
+package imaging
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Storage layout: every image lives under "{owner}/{owner_id}/". Product and variant
+// images are stored under the product's listing prefix (they share listing_images).
+// Thumbnails and renditions are stored next to the original (see ThumbnailKey, RenditionKey).
+// Storefront verification documents are private and live under their own prefix, which the
+// image garbage collector does not scan.
+const (
+	OwnerListings     = "listings"
+	OwnerStorefronts  = "storefronts"
+	OwnerVerification = "verification"
+)
+
+// derivedKeySuffix matches the suffix of thumbnail and rendition keys
+var derivedKeySuffix = regexp.MustCompile(`_(thumb|w\d+)$`)
+
+// KeyPrefix returns the prefix of all objects of an owner
+// Input:  "listings", 123
+// Output: "listings/123/"
+func KeyPrefix(owner string, ownerID int64) string {
+	return fmt.Sprintf("%s/%d/", owner, ownerID)
+}
+
+// NewObjectKey returns a unique object key for a new original
+// Input:  "storefronts", 7, "logo_", ".png"
+// Output: "storefronts/7/logo_1638360000000000000_abc12345.png"
+func NewObjectKey(owner string, ownerID int64, namePrefix, ext string) string {
+	return fmt.Sprintf("%s%s%d_%s%s", KeyPrefix(owner, ownerID), namePrefix, time.Now().UnixNano(), uuid.New().String()[:8], strings.ToLower(ext))
+}
+
+// BaseKey returns the key of an original (or of a derived object) without extension and
+// without thumbnail/rendition suffix. All objects of one image share the same base key.
+// Input:  "listings/123/1638360000_abc123_w400.jpg"
+// Output: "listings/123/1638360000_abc123"
+func BaseKey(key string) string {
+	base := strings.TrimSuffix(key, filepath.Ext(key))
+	return derivedKeySuffix.ReplaceAllString(base, "")
+}
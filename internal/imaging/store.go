@@ -0,0 +1,57 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ObjectStore is the subset of the MinIO client used to store renditions
+type ObjectStore interface {
+	UploadImage(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) error
+	DeleteImage(ctx context.Context, objectName string) error
+	GetPresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
+}
+
+// StoreRenditions uploads renditions next to the original object and returns their descriptors.
+// On failure the renditions uploaded so far are removed.
+func StoreRenditions(ctx context.Context, store ObjectStore, originalKey string, renditions []Rendition, urlTTL time.Duration) ([]domain.ImageRendition, error) {
+	stored := make([]domain.ImageRendition, 0, len(renditions))
+
+	for _, rendition := range renditions {
+		key := RenditionKey(originalKey, rendition.Width)
+
+		if err := store.UploadImage(ctx, key, bytes.NewReader(rendition.Data), int64(len(rendition.Data)), "image/jpeg"); err != nil {
+			DeleteRenditions(ctx, store, stored)
+			return nil, fmt.Errorf("failed to upload %dpx rendition: %w", rendition.Width, err)
+		}
+
+		url, err := store.GetPresignedURL(ctx, key, urlTTL)
+		if err != nil {
+			_ = store.DeleteImage(ctx, key)
+			DeleteRenditions(ctx, store, stored)
+			return nil, fmt.Errorf("failed to generate presigned URL for %dpx rendition: %w", rendition.Width, err)
+		}
+
+		stored = append(stored, domain.ImageRendition{
+			Width:       int32(rendition.Width),
+			Height:      int32(rendition.Height),
+			StoragePath: key,
+			URL:         url,
+			FileSize:    int64(len(rendition.Data)),
+		})
+	}
+
+	return stored, nil
+}
+
+// DeleteRenditions removes rendition objects, ignoring errors (best-effort cleanup)
+func DeleteRenditions(ctx context.Context, store ObjectStore, renditions []domain.ImageRendition) {
+	for _, rendition := range renditions {
+		_ = store.DeleteImage(ctx, rendition.StoragePath)
+	}
+}
@@ -0,0 +1,60 @@
+package imaging
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Upload limits shared by all image uploads (listings, products, variants, storefronts)
+const (
+	MaxImageSize = 10 * 1024 * 1024 // 10MB per image
+	MaxTotalSize = 50 * 1024 * 1024 // 50MB total per upload batch
+	MaxFiles     = 10               // Maximum files per upload
+)
+
+// AllowedExtensions are the file extensions accepted for uploads
+var AllowedExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+}
+
+// ProcessableContentTypes are the formats that can be decoded and processed server-side
+var ProcessableContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// ValidateFile checks the filename extension, content type and size of an upload
+func ValidateFile(filename, contentType string, size int64) error {
+	if filename == "" {
+		return fmt.Errorf("filename is required")
+	}
+	if size <= 0 {
+		return fmt.Errorf("file_size must be positive")
+	}
+	if size > MaxImageSize {
+		return fmt.Errorf("file_size exceeds %dMB limit", MaxImageSize/(1024*1024))
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	if ext == "" {
+		return fmt.Errorf("filename must have an extension")
+	}
+	if !AllowedExtensions[ext] {
+		return fmt.Errorf("unsupported file extension: %s (allowed: jpg, jpeg, png, gif, webp)", ext)
+	}
+
+	if contentType == "" {
+		return fmt.Errorf("content_type is required")
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return fmt.Errorf("invalid content_type: must be image/*")
+	}
+
+	return nil
+}
@@ -193,6 +193,8 @@ func (idx *ListingIndexer) buildListingDocument(listing *domain.Listing, attribu
 		"document_type":   "listing",
 		"views_count":     listing.ViewsCount,
 		"favorites_count": listing.FavoritesCount,
+		"rating":          listing.Rating,
+		"reviews_count":   listing.ReviewsCount,
 		"created_at":      listing.CreatedAt,
 		"updated_at":      listing.UpdatedAt,
 	}
@@ -218,6 +220,10 @@ func (idx *ListingIndexer) buildListingDocument(listing *domain.Listing, attribu
 	if len(listing.Images) > 0 {
 		images := make([]map[string]interface{}, 0, len(listing.Images))
 		for _, img := range listing.Images {
+			// Images waiting for processing have no URL yet; the listing is reindexed once they are ready
+			if img.URL == "" {
+				continue
+			}
 			images = append(images, map[string]interface{}{
 				"id":         img.ID,
 				"public_url": img.URL,
@@ -300,7 +306,7 @@ func (idx *ListingIndexer) ReindexAllWithAttributes(ctx context.Context, batchSi
 	query := `
 		SELECT id, uuid, user_id, storefront_id, title, description,
 		       price, currency, category_id, status, visibility, quantity, sku,
-		       source_type, stock_status, view_count, favorites_count,
+		       source_type, stock_status, view_count, favorites_count, rating, reviews_count,
 		       created_at, updated_at, published_at
 		FROM listings
 		WHERE status = 'active' AND visibility = 'public' AND is_deleted = false
@@ -336,6 +342,8 @@ func (idx *ListingIndexer) ReindexAllWithAttributes(ctx context.Context, batchSi
 			&listing.StockStatus,
 			&listing.ViewsCount,
 			&listing.FavoritesCount,
+			&listing.Rating,
+			&listing.ReviewsCount,
 			&listing.CreatedAt,
 			&listing.UpdatedAt,
 			&listing.PublishedAt,
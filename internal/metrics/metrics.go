@@ -36,12 +36,36 @@ type Metrics struct {
 	IndexingJobsProcessed *prometheus.CounterVec
 	IndexingJobDuration   prometheus.Histogram
 
+	// Image processing queue metrics
+	ImageJobsProcessed *prometheus.CounterVec
+	ImageJobDuration   prometheus.Histogram
+
+	// Image garbage collection metrics
+	ImageGCObjectsDeleted prometheus.Counter
+
+	// Report export metrics
+	ReportExportsProcessed *prometheus.CounterVec
+	ReportExportDuration   prometheus.Histogram
+
+	// Analytics event ingestion metrics
+	AnalyticsEventsAccepted     *prometheus.CounterVec
+	AnalyticsEventsDropped      *prometheus.CounterVec
+	AnalyticsEventsWritten      prometheus.Counter
+	AnalyticsEventsBuffered     prometheus.Gauge
+	AnalyticsEventFlushDuration prometheus.Histogram
+
+	// Scheduled job metrics
+	ScheduledJobRuns        *prometheus.CounterVec
+	ScheduledJobDuration    *prometheus.HistogramVec
+	ScheduledJobLastSuccess *prometheus.GaugeVec
+
 	// Error metrics
 	ErrorsTotal *prometheus.CounterVec
 
 	// Inventory-specific metrics
 	InventoryProductViews       *prometheus.CounterVec
 	InventoryProductViewsErrors prometheus.Counter
+	ProductViewsCounted         *prometheus.CounterVec
 	InventoryStockOperations    *prometheus.CounterVec
 	InventoryStockLowThreshold  *prometheus.CounterVec
 	InventoryMovementsRecorded  *prometheus.CounterVec
@@ -209,6 +233,118 @@ func NewMetrics(namespace string) *Metrics {
 			},
 		),
 
+		// Image processing queue metrics
+		ImageJobsProcessed: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "image_jobs_processed_total",
+				Help:      "Total number of image processing jobs processed",
+			},
+			[]string{"status"},
+		),
+		ImageJobDuration: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "image_job_duration_seconds",
+				Help:      "Image processing job time in seconds",
+				Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+			},
+		),
+
+		// Image garbage collection metrics
+		ImageGCObjectsDeleted: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "image_gc_objects_deleted_total",
+				Help:      "Total number of unreferenced image objects deleted from storage",
+			},
+		),
+
+		// Report export metrics
+		ReportExportsProcessed: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "report_exports_processed_total",
+				Help:      "Total number of report exports processed",
+			},
+			[]string{"report_type", "status"},
+		),
+		ReportExportDuration: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "report_export_duration_seconds",
+				Help:      "Report export rendering time in seconds",
+				Buckets:   []float64{0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+			},
+		),
+
+		// Analytics event ingestion metrics
+		AnalyticsEventsAccepted: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "analytics_events_accepted_total",
+				Help:      "Total number of analytics events accepted into the write buffer",
+			},
+			[]string{"event_type"},
+		),
+		AnalyticsEventsDropped: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "analytics_events_dropped_total",
+				Help:      "Total number of analytics events dropped",
+			},
+			[]string{"reason"},
+		),
+		AnalyticsEventsWritten: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "analytics_events_written_total",
+				Help:      "Total number of analytics events written to the database",
+			},
+		),
+		AnalyticsEventsBuffered: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "analytics_events_buffered",
+				Help:      "Current number of analytics events waiting in the write buffer",
+			},
+		),
+		AnalyticsEventFlushDuration: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "analytics_event_flush_duration_seconds",
+				Help:      "Analytics event batch write time in seconds",
+				Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+			},
+		),
+
+		// Scheduled job metrics
+		ScheduledJobRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "scheduled_job_runs_total",
+				Help:      "Total number of scheduled job runs by status (succeeded, failed, skipped)",
+			},
+			[]string{"job", "status"},
+		),
+		ScheduledJobDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "scheduled_job_duration_seconds",
+				Help:      "Scheduled job run time in seconds",
+				Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 300, 900},
+			},
+			[]string{"job"},
+		),
+		ScheduledJobLastSuccess: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "scheduled_job_last_success_timestamp_seconds",
+				Help:      "Unix time of the last successful run of a scheduled job",
+			},
+			[]string{"job"},
+		),
+
 		// Error metrics
 		ErrorsTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
@@ -235,6 +371,14 @@ func NewMetrics(namespace string) *Metrics {
 				Help:      "Total number of product view increment errors",
 			},
 		),
+		ProductViewsCounted: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "product_views_counted_total",
+				Help:      "Total number of product views by outcome (unique, repeat, bot)",
+			},
+			[]string{"result"},
+		),
 		InventoryStockOperations: promauto.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -381,6 +525,61 @@ func (m *Metrics) RecordIndexingJob(operation, status string, duration float64)
 	m.IndexingJobDuration.Observe(duration)
 }
 
+// RecordImageJob records image processing job metrics
+func (m *Metrics) RecordImageJob(status string, duration float64) {
+	m.ImageJobsProcessed.WithLabelValues(status).Inc()
+	m.ImageJobDuration.Observe(duration)
+}
+
+// RecordImageGCDeleted records image objects removed by garbage collection
+func (m *Metrics) RecordImageGCDeleted(count int) {
+	m.ImageGCObjectsDeleted.Add(float64(count))
+}
+
+// RecordReportExport records report export metrics
+func (m *Metrics) RecordReportExport(reportType, status string, duration float64) {
+	m.ReportExportsProcessed.WithLabelValues(reportType, status).Inc()
+	m.ReportExportDuration.Observe(duration)
+}
+
+// RecordAnalyticsEventAccepted records an analytics event accepted into the write buffer
+func (m *Metrics) RecordAnalyticsEventAccepted(eventType string) {
+	m.AnalyticsEventsAccepted.WithLabelValues(eventType).Inc()
+}
+
+// RecordAnalyticsEventsDropped records analytics events dropped (buffer_full, write_failed, stopped)
+func (m *Metrics) RecordAnalyticsEventsDropped(reason string, count int) {
+	m.AnalyticsEventsDropped.WithLabelValues(reason).Add(float64(count))
+}
+
+// RecordAnalyticsFlush records a batch of analytics events written to the database
+func (m *Metrics) RecordAnalyticsFlush(written int64, duration float64) {
+	m.AnalyticsEventsWritten.Add(float64(written))
+	m.AnalyticsEventFlushDuration.Observe(duration)
+}
+
+// UpdateAnalyticsEventsBuffered updates the analytics write buffer size metric
+func (m *Metrics) UpdateAnalyticsEventsBuffered(size int) {
+	m.AnalyticsEventsBuffered.Set(float64(size))
+}
+
+// RecordScheduledJobRun records a finished scheduled job run
+func (m *Metrics) RecordScheduledJobRun(job string, err error, duration float64) {
+	status := "succeeded"
+	if err != nil {
+		status = "failed"
+	} else {
+		m.ScheduledJobLastSuccess.WithLabelValues(job).SetToCurrentTime()
+	}
+	m.ScheduledJobRuns.WithLabelValues(job, status).Inc()
+	m.ScheduledJobDuration.WithLabelValues(job).Observe(duration)
+}
+
+// RecordScheduledJobSkipped records a run skipped because another instance holds the job lock
+func (m *Metrics) RecordScheduledJobSkipped(job string) {
+	m.ScheduledJobRuns.WithLabelValues(job, "skipped").Inc()
+}
+
 // UpdateDBConnectionStats updates database connection pool metrics
 func (m *Metrics) UpdateDBConnectionStats(open, idle int) {
 	m.DBConnectionsOpen.Set(float64(open))
@@ -402,6 +601,11 @@ func (m *Metrics) RecordInventoryProductViewError() {
 	m.InventoryProductViewsErrors.Inc()
 }
 
+// RecordProductView records the outcome of a counted product view (unique, repeat, bot)
+func (m *Metrics) RecordProductView(result string) {
+	m.ProductViewsCounted.WithLabelValues(result).Inc()
+}
+
 // RecordInventoryStockOperation records a stock operation (update/batch)
 func (m *Metrics) RecordInventoryStockOperation(operation, status string) {
 	m.InventoryStockOperations.WithLabelValues(operation, status).Inc()
@@ -120,9 +120,16 @@ func (a *AuthInterceptor) isPublicMethod(method string) bool {
 		"/listingssvc.v1.ListingsService/ListListings",
 		"/listingssvc.v1.ListingsService/GetListing",
 		"/listingssvc.v1.ListingsService/GetSimilarListings",
+		"/listingssvc.v1.ListingsService/GetRecommendationsForUser", // Anonymous visitors pass a session_id
+		"/listingssvc.v1.ListingsService/GetFrequentlyBoughtTogether",
+		// Recently viewed lists (anonymous visitors pass a session_id)
+		"/listingssvc.v1.ListingsService/IncrementProductViews",
+		"/listingssvc.v1.ListingsService/GetRecentlyViewed",
+		"/listingssvc.v1.ListingsService/ClearRecentlyViewed",
 		"/listingssvc.v1.ListingsService/GetProduct",
 		"/listingssvc.v1.ListingsService/GetProductBySKU",
 		"/listingssvc.v1.ListingsService/ListProducts",
+		"/listingssvc.v1.ListingsService/GetPriceHistory",
 		// Storefront public methods
 		"/listingssvc.v1.ListingsService/GetStorefront",
 		"/listingssvc.v1.ListingsService/GetStorefrontBySlug",
@@ -130,10 +137,17 @@ func (a *AuthInterceptor) isPublicMethod(method string) bool {
 		"/listingssvc.v1.ListingsService/GetMyStorefronts",
 		"/listingssvc.v1.ListingsService/GetWorkingHours",
 		"/listingssvc.v1.ListingsService/IsOpenNow",
-		"/listingssvc.v1.ListingsService/GetStaff",
+		"/listingssvc.v1.ListingsService/GetHolidays",
 		"/listingssvc.v1.ListingsService/GetPaymentMethods",
 		"/listingssvc.v1.ListingsService/GetDeliveryOptions",
 		"/listingssvc.v1.ListingsService/GetMapData",
+		"/listingssvc.v1.ListingsService/ResolveStorefrontDomain",
+		// Review public methods
+		"/listingssvc.v1.ListingsService/GetReview",
+		"/listingssvc.v1.ListingsService/ListReviews",
+		"/listingssvc.v1.ListingsService/GetReviewSummary",
+		// Analytics event ingestion (anonymous events carry a session_id)
+		"/listingssvc.v1.AnalyticsService/TrackEvents",
 		// Attributes public methods (no auth required for viewing category attributes)
 		"/listingssvc.v1.AttributeService/GetCategoryAttributes",
 		"/listingssvc.v1.AttributeService/GetCategoryVariantAttributes",
@@ -70,6 +70,104 @@ func NewDefaultConfig() *Config {
 				Enabled:    true,
 			},
 
+			// Storefront funnels, cohorts and search reports (aggregate over raw events, orders and searches)
+			"/listingssvc.v1.AnalyticsService/GetStorefrontFunnel": {
+				Limit:      30,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.AnalyticsService/GetBuyerCohorts": {
+				Limit:      30,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.AnalyticsService/GetSearchQueryReport": {
+				Limit:      30,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+
+			// Search experiments (admin)
+			"/listingssvc.v1.AnalyticsService/CreateSearchExperiment": {
+				Limit:      10,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.AnalyticsService/UpdateSearchExperimentStatus": {
+				Limit:      10,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.AnalyticsService/GetSearchExperimentResults": {
+				Limit:      30,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+
+			// Report exports (rendering is queued; status is polled)
+			"/listingssvc.v1.AnalyticsService/RequestReportExport": {
+				Limit:      10,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.AnalyticsService/GetExportStatus": {
+				Limit:      120,
+				Window:     time.Minute,
+				Identifier: ByUserID,
+				Enabled:    true,
+			},
+
+			// Analytics event ingestion
+			"/listingssvc.v1.AnalyticsService/TrackEvents": {
+				Limit:      600,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+
+			// Recommendations (public, anonymous visitors included)
+			"/listingssvc.v1.ListingsService/GetRecommendationsForUser": {
+				Limit:      120,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.ListingsService/GetFrequentlyBoughtTogether": {
+				Limit:      200,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+
+			// Recently viewed lists
+			"/listingssvc.v1.ListingsService/GetRecentlyViewed": {
+				Limit:      120,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+			"/listingssvc.v1.ListingsService/ClearRecentlyViewed": {
+				Limit:      30,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+
+			// Search click tracking
+			"/search.v1.SearchService/RecordSearchClick": {
+				Limit:      300,
+				Window:     time.Minute,
+				Identifier: ByIP,
+				Enabled:    true,
+			},
+
 			// Inventory endpoints (from the spec)
 			"/inventory.InventoryService/IncrementProductViews": {
 				Limit:      100,
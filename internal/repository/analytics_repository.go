@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/sveturs/listings/internal/domain"
 )
@@ -21,16 +22,44 @@ type AnalyticsRepository interface {
 	// Performance target: < 300ms without cache
 	GetListingStats(ctx context.Context, filter *domain.GetListingStatsFilter) (*domain.ListingStats, error)
 
+	// GetListingAnalyticsInfo retrieves the owner, title, type and category of a listing
+	// Used for listing stats access checks; returns nil when the listing does not exist
+	GetListingAnalyticsInfo(ctx context.Context, listingID int64) (*domain.ListingAnalyticsInfo, error)
+
+	// GetListingsAnalyticsInfo is GetListingAnalyticsInfo for a batch of listings, keyed by ID
+	// Used to attach listing attributes to client-reported events; missing listings are omitted
+	GetListingsAnalyticsInfo(ctx context.Context, listingIDs []int64) (map[int64]*domain.ListingAnalyticsInfo, error)
+
 	// LogEvent records a single analytics event
 	// Events are logged asynchronously for high-throughput scenarios
 	// Event types: view, favorite, search, order_created, order_completed
 	LogEvent(ctx context.Context, eventType, entityType string, entityID int64, userID *int64, sessionID *string, metadata map[string]interface{}) error
 
+	// LogEvents bulk-inserts a batch of analytics events via COPY
+	// Used by the buffered event writer; returns the number of rows written
+	LogEvents(ctx context.Context, events []*domain.AnalyticsEvent) (int64, error)
+
 	// RefreshMaterializedViews refreshes all analytics materialized views
 	// Should be called periodically (e.g., every 15 minutes via cron)
 	// Uses CONCURRENTLY to avoid blocking reads
 	RefreshMaterializedViews(ctx context.Context) error
 
+	// RefreshTrendingCache refreshes the trending analytics materialized view
+	// Called hourly by the job scheduler
+	RefreshTrendingCache(ctx context.Context) error
+
+	// ArchiveOldEvents moves events older than retention to analytics_events_archive
+	// Works in batches of batchSize events; returns the number of archived events
+	ArchiveOldEvents(ctx context.Context, retention time.Duration, batchSize int) (int64, error)
+
+	// GetFunnelStats computes the purchase funnel of a storefront or one of its products
+	// Stages: impression → view → favorite/add to cart → order → delivered (distinct visitors)
+	GetFunnelStats(ctx context.Context, filter *domain.GetFunnelFilter) (*domain.FunnelStats, error)
+
+	// GetBuyerCohorts groups registered buyers by the week of their first order at a storefront
+	// and counts repeat purchases in the following weeks
+	GetBuyerCohorts(ctx context.Context, filter *domain.GetCohortsFilter) (*domain.CohortStats, error)
+
 	// GetTrendingStats retrieves platform trending analytics
 	// Returns trending categories, hot listings, and popular searches
 	// Data is pre-calculated in materialized view for optimal performance
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// JobRunsRepository defines operations for the job scheduler's locking and run history
+type JobRunsRepository interface {
+	// AcquireJobLock tries to take the cluster-wide lock of a job without waiting
+	// Returns acquired=false when another instance holds it; release must be called
+	// when acquired is true (it is safe to call with a cancelled context)
+	AcquireJobLock(ctx context.Context, jobName string) (release func(), acquired bool, err error)
+
+	// StartJobRun records the start of the run of a schedule slot and returns its ID
+	// Returns started=false when the slot already has a run (another replica ran it)
+	StartJobRun(ctx context.Context, jobName, instance string, scheduledAt time.Time) (runID int64, started bool, err error)
+
+	// FinishJobRun records the outcome of a run; runErr nil means succeeded
+	FinishJobRun(ctx context.Context, runID int64, runErr error) error
+
+	// PruneJobRuns deletes run history older than the given age
+	PruneJobRuns(ctx context.Context, olderThan time.Duration) (int64, error)
+}
@@ -2,6 +2,7 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -112,6 +113,88 @@ func (c *Client) GetPresignedURL(ctx context.Context, objectName string, expiry
 	return url.String(), nil
 }
 
+// GetPresignedPutURL generates a presigned URL that lets a client upload an object directly
+func (c *Client) GetPresignedPutURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	url, err := c.client.PresignedPutObject(ctx, c.bucket, objectName, expiry)
+	if err != nil {
+		c.logger.Error().Err(err).Str("object", objectName).Msg("failed to generate presigned upload URL")
+		return "", fmt.Errorf("failed to generate presigned upload URL: %w", err)
+	}
+
+	return url.String(), nil
+}
+
+// ErrObjectNotFound is returned when the requested object does not exist
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// StatImage returns the size and content type of a stored object
+func (c *Client) StatImage(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	info, err := c.client.StatObject(ctx, c.bucket, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrObjectNotFound
+		}
+		c.logger.Error().Err(err).Str("object", objectName).Msg("failed to stat image")
+		return nil, fmt.Errorf("failed to stat image: %w", err)
+	}
+
+	return &ObjectInfo{Size: info.Size, ContentType: info.ContentType}, nil
+}
+
+// ReadImageHeader reads the first n bytes of a stored object (e.g. to check magic bytes)
+func (c *Client) ReadImageHeader(ctx context.Context, objectName string, n int64) ([]byte, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, n-1); err != nil {
+		return nil, fmt.Errorf("invalid range: %w", err)
+	}
+
+	object, err := c.client.GetObject(ctx, c.bucket, objectName, opts)
+	if err != nil {
+		c.logger.Error().Err(err).Str("object", objectName).Msg("failed to get image")
+		return nil, fmt.Errorf("failed to get image: %w", err)
+	}
+	defer object.Close()
+
+	header, err := io.ReadAll(io.LimitReader(object, n))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image header: %w", err)
+	}
+
+	return header, nil
+}
+
+// StoredObject describes an object returned by WalkImages
+type StoredObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// WalkImages calls fn for every object under prefix, in lexicographic key order.
+// Walking stops at the first error returned by fn.
+func (c *Client) WalkImages(ctx context.Context, prefix string, fn func(StoredObject) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // Stops the listing goroutine when walking ends early
+
+	for object := range c.client.ListObjects(ctx, c.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			c.logger.Error().Err(object.Err).Str("prefix", prefix).Msg("failed to list images")
+			return fmt.Errorf("failed to list images: %w", object.Err)
+		}
+		if err := fn(StoredObject{Key: object.Key, Size: object.Size, LastModified: object.LastModified}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // BucketExists checks if the bucket exists
 func (c *Client) BucketExists(ctx context.Context) (bool, error) {
 	exists, err := c.client.BucketExists(ctx, c.bucket)
@@ -79,9 +79,13 @@ func (c *Client) IndexListing(ctx context.Context, listing *domain.Listing) erro
 		"stock_status":    listing.StockStatus, // in_stock, out_of_stock, etc
 		"views_count":     listing.ViewsCount,
 		"favorites_count": listing.FavoritesCount,
+		"rating":          listing.Rating,
+		"reviews_count":   listing.ReviewsCount,
 		"created_at":      listing.CreatedAt,
 		"updated_at":      listing.UpdatedAt,
 		"published_at":    listing.PublishedAt,
+
+		"storefront_verified": listing.StorefrontVerified,
 	}
 
 	// Add attributes from cache if available
@@ -98,6 +102,10 @@ func (c *Client) IndexListing(ctx context.Context, listing *domain.Listing) erro
 	if len(listing.Images) > 0 {
 		images := make([]map[string]interface{}, 0, len(listing.Images))
 		for _, img := range listing.Images {
+			// Images waiting for processing have no URL yet; the listing is reindexed once they are ready
+			if img.URL == "" {
+				continue
+			}
 			images = append(images, map[string]interface{}{
 				"public_url": img.URL,
 				"is_main":    img.IsPrimary,
@@ -294,6 +302,10 @@ func (c *Client) SearchListings(ctx context.Context, query *domain.SearchListing
 		viewsCount, _ := source["views_count"].(float64)
 		//nolint:errcheck
 		favoritesCount, _ := source["favorites_count"].(float64)
+		//nolint:errcheck
+		rating, _ := source["rating"].(float64)
+		//nolint:errcheck
+		reviewsCount, _ := source["reviews_count"].(float64)
 
 		listing := &domain.Listing{
 			ID:             int64(id),
@@ -309,6 +321,8 @@ func (c *Client) SearchListings(ctx context.Context, query *domain.SearchListing
 			SourceType:     sourceType,
 			ViewsCount:     int32(viewsCount),
 			FavoritesCount: int32(favoritesCount),
+			Rating:         rating,
+			ReviewsCount:   int32(reviewsCount),
 		}
 
 		// Optional fields
@@ -535,6 +549,10 @@ func (c *Client) GetSimilarListings(ctx context.Context, listingID int64, limit
 		viewsCount, _ := hitSource["views_count"].(float64)
 		//nolint:errcheck
 		favoritesCount, _ := hitSource["favorites_count"].(float64)
+		//nolint:errcheck
+		rating, _ := hitSource["rating"].(float64)
+		//nolint:errcheck
+		reviewsCount, _ := hitSource["reviews_count"].(float64)
 
 		listing := &domain.Listing{
 			ID:             int64(id),
@@ -550,6 +568,8 @@ func (c *Client) GetSimilarListings(ctx context.Context, listingID int64, limit
 			SourceType:     sourceType,
 			ViewsCount:     int32(viewsCount),
 			FavoritesCount: int32(favoritesCount),
+			Rating:         rating,
+			ReviewsCount:   int32(reviewsCount),
 		}
 
 		// Optional fields
@@ -912,6 +932,7 @@ func (c *Client) buildProductDocument(product *domain.Listing) map[string]interf
 	}
 	if product.StorefrontID != nil {
 		doc["storefront_id"] = *product.StorefrontID
+		doc["storefront_verified"] = product.StorefrontVerified
 	}
 	if product.SKU != nil {
 		doc["sku"] = *product.SKU
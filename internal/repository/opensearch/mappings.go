@@ -71,12 +71,21 @@ func GetListingsIndexMapping() map[string]interface{} {
 				"stock_status": map[string]interface{}{
 					"type": "keyword",
 				},
+				"storefront_verified": map[string]interface{}{
+					"type": "boolean",
+				},
 				"views_count": map[string]interface{}{
 					"type": "integer",
 				},
 				"favorites_count": map[string]interface{}{
 					"type": "integer",
 				},
+				"rating": map[string]interface{}{
+					"type": "float",
+				},
+				"reviews_count": map[string]interface{}{
+					"type": "integer",
+				},
 				"created_at": map[string]interface{}{
 					"type": "date",
 				},
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ============================================================================
+// CONVERSION FUNNEL & BUYER COHORTS
+// ============================================================================
+
+// GetFunnelStats computes the purchase funnel of a storefront (or one of its products).
+// Visitors are identified by user_id, falling back to session_id for anonymous events.
+// The engaged stage combines favorite and add_to_cart events with items still sitting in
+// carts (cart items are removed at checkout, add_to_cart events keep them countable).
+func (r *analyticsRepository) GetFunnelStats(ctx context.Context, filter *domain.GetFunnelFilter) (*domain.FunnelStats, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	query := `
+		WITH scoped_listings AS (
+			SELECT id
+			FROM listings
+			WHERE storefront_id = $1 AND ($2::BIGINT IS NULL OR id = $2)
+		),
+		scoped_events AS (
+			SELECT e.event_type, COALESCE('u' || e.user_id::TEXT, 's' || e.session_id) AS visitor
+			FROM analytics_events e
+			WHERE e.entity_type = 'listing'
+			  AND e.entity_id IN (SELECT id FROM scoped_listings)
+			  AND e.event_type IN ('impression', 'view', 'favorite', 'add_to_cart')
+			  AND e.created_at >= $3 AND e.created_at < $4
+		),
+		engaged_visitors AS (
+			SELECT visitor
+			FROM scoped_events
+			WHERE event_type IN ('favorite', 'add_to_cart')
+			UNION
+			SELECT COALESCE('u' || c.user_id::TEXT, 's' || c.session_id)
+			FROM cart_items ci
+			JOIN shopping_carts c ON c.id = ci.cart_id
+			WHERE ci.listing_id IN (SELECT id FROM scoped_listings)
+			  AND ci.created_at >= $3 AND ci.created_at < $4
+		),
+		order_stages AS (
+			SELECT
+				COUNT(DISTINCT COALESCE('u' || o.user_id::TEXT, 'o' || o.id::TEXT)) AS ordered,
+				COUNT(DISTINCT COALESCE('u' || o.user_id::TEXT, 'o' || o.id::TEXT)) FILTER (
+					WHERE o.status = 'delivered'
+				) AS delivered
+			FROM orders o
+			WHERE o.storefront_id = $1
+			  AND o.status <> 'failed'
+			  AND o.created_at >= $3 AND o.created_at < $4
+			  AND ($2::BIGINT IS NULL OR EXISTS (
+				SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND oi.listing_id = $2
+			  ))
+		)
+		SELECT
+			(SELECT COUNT(DISTINCT visitor) FROM scoped_events WHERE event_type = 'impression') AS impressions,
+			(SELECT COUNT(DISTINCT visitor) FROM scoped_events WHERE event_type = 'view') AS views,
+			(SELECT COUNT(DISTINCT visitor) FROM engaged_visitors WHERE visitor IS NOT NULL) AS engaged,
+			os.ordered,
+			os.delivered
+		FROM order_stages os
+	`
+
+	stats := &domain.FunnelStats{
+		PeriodStart: filter.StartDate,
+		PeriodEnd:   filter.EndDate,
+	}
+	err := r.db.QueryRow(ctx, query, filter.StorefrontID, filter.ListingID, filter.StartDate, filter.EndDate).Scan(
+		&stats.Impressions,
+		&stats.Views,
+		&stats.Engaged,
+		&stats.Ordered,
+		&stats.Delivered,
+	)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get funnel stats")
+		return nil, fmt.Errorf("failed to get funnel stats: %w", err)
+	}
+
+	stats.EnrichWithCalculatedFields()
+	return stats, nil
+}
+
+// GetBuyerCohorts groups registered buyers of a storefront by the week of their first order
+// and counts how many of them ordered again in each following week. Only orders placed
+// before the end of the last cohort week are considered, so cohorts of different periods
+// are observed over the same horizon and can be compared.
+func (r *analyticsRepository) GetBuyerCohorts(ctx context.Context, filter *domain.GetCohortsFilter) (*domain.CohortStats, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	firstWeek := filter.FirstWeek()
+	windowEnd := domain.StartOfWeek(filter.EndDate).AddDate(0, 0, 7)
+
+	query := `
+		WITH buyer_orders AS (
+			SELECT user_id, created_at
+			FROM orders
+			WHERE storefront_id = $1
+			  AND user_id IS NOT NULL
+			  AND status NOT IN ('cancelled', 'failed')
+			  AND created_at < $3
+		),
+		cohort_members AS (
+			SELECT user_id,
+			       COUNT(*) AS order_count,
+			       date_trunc('week', MIN(created_at) AT TIME ZONE 'UTC') AS cohort_week
+			FROM buyer_orders
+			GROUP BY user_id
+			HAVING MIN(created_at) >= $2
+		)
+		SELECT
+			cm.cohort_week,
+			(date_trunc('week', bo.created_at AT TIME ZONE 'UTC')::DATE - cm.cohort_week::DATE) / 7 AS week_offset,
+			COUNT(DISTINCT cm.user_id) AS buyers,
+			COUNT(DISTINCT cm.user_id) FILTER (WHERE cm.order_count > 1) AS repeat_buyers
+		FROM cohort_members cm
+		JOIN buyer_orders bo ON bo.user_id = cm.user_id
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+
+	rows, err := r.db.Query(ctx, query, filter.StorefrontID, firstWeek, windowEnd)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get buyer cohorts")
+		return nil, fmt.Errorf("failed to get buyer cohorts: %w", err)
+	}
+	defer rows.Close()
+
+	// One cohort per week, including weeks without new buyers
+	cohorts := make([]*domain.BuyerCohort, filter.Weeks)
+	for i := range cohorts {
+		cohorts[i] = &domain.BuyerCohort{
+			CohortWeek: firstWeek.AddDate(0, 0, 7*i),
+			Returning:  make([]int64, filter.Weeks-1-i),
+		}
+	}
+
+	for rows.Next() {
+		var (
+			cohortWeek   time.Time
+			weekOffset   int
+			buyers       int64
+			repeatBuyers int64
+		)
+		if err := rows.Scan(&cohortWeek, &weekOffset, &buyers, &repeatBuyers); err != nil {
+			return nil, fmt.Errorf("failed to scan cohort row: %w", err)
+		}
+
+		index := int(cohortWeek.Sub(firstWeek).Hours() / (24 * 7))
+		if index < 0 || index >= len(cohorts) {
+			continue
+		}
+		cohort := cohorts[index]
+
+		switch {
+		case weekOffset == 0:
+			cohort.Buyers = buyers
+			cohort.RepeatBuyers = repeatBuyers
+		case weekOffset <= len(cohort.Returning):
+			cohort.Returning[weekOffset-1] = buyers
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate cohort rows: %w", err)
+	}
+
+	return domain.NewCohortStats(cohorts), nil
+}
@@ -86,7 +86,8 @@ func (r *analyticsRepository) GetOverviewStats(ctx context.Context, filter *doma
 			SELECT
 				COUNT(*) FILTER (WHERE event_type = 'view' AND entity_type = 'listing') AS total_views,
 				COUNT(*) FILTER (WHERE event_type = 'favorite' AND entity_type = 'listing') AS total_favorites,
-				COUNT(*) FILTER (WHERE event_type = 'order_created') AS total_orders,
+				-- Order events are recorded per item; count each order once
+				COUNT(DISTINCT metadata->>'order_id') FILTER (WHERE event_type = 'order_created') AS total_orders,
 				COALESCE(SUM((metadata->>'total_amount')::DECIMAL) FILTER (
 					WHERE event_type = 'order_completed'
 				), 0) AS total_revenue,
@@ -363,6 +364,124 @@ func (r *analyticsRepository) LogEvent(ctx context.Context, eventType, entityTyp
 	return nil
 }
 
+// LogEvents bulk-inserts analytics events using COPY
+// Used by the buffered event writer; returns the number of rows written
+func (r *analyticsRepository) LogEvents(ctx context.Context, events []*domain.AnalyticsEvent) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	rows := make([][]interface{}, 0, len(events))
+	for _, e := range events {
+		metadataJSON := []byte("{}")
+		if len(e.Metadata) > 0 {
+			var err error
+			metadataJSON, err = json.Marshal(e.Metadata)
+			if err != nil {
+				return 0, fmt.Errorf("failed to marshal metadata: %w", err)
+			}
+		}
+
+		createdAt := e.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now().UTC()
+		}
+
+		rows = append(rows, []interface{}{
+			e.EventType, e.EntityType, e.EntityID,
+			e.UserID, e.SessionID, metadataJSON, createdAt,
+		})
+	}
+
+	written, err := r.db.CopyFrom(ctx,
+		pgx.Identifier{"analytics_events"},
+		[]string{"event_type", "entity_type", "entity_id", "user_id", "session_id", "metadata", "created_at"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		r.logger.Error().Err(err).Int("events", len(events)).Msg("failed to copy analytics events")
+		return 0, fmt.Errorf("failed to copy analytics events: %w", err)
+	}
+
+	r.logger.Debug().Int64("written", written).Msg("analytics events copied")
+	return written, nil
+}
+
+// GetListingAnalyticsInfo retrieves the owner and descriptive fields of a listing
+// Returns nil when the listing does not exist
+func (r *analyticsRepository) GetListingAnalyticsInfo(ctx context.Context, listingID int64) (*domain.ListingAnalyticsInfo, error) {
+	query := `
+		SELECT l.id, l.title, l.source_type, l.user_id, l.storefront_id, l.category_id,
+		       COALESCE(c.name, '') AS category_name
+		FROM listings l
+		LEFT JOIN categories c ON c.id = l.category_id
+		WHERE l.id = $1
+	`
+
+	var info domain.ListingAnalyticsInfo
+	err := r.db.QueryRow(ctx, query, listingID).Scan(
+		&info.ListingID,
+		&info.Title,
+		&info.SourceType,
+		&info.UserID,
+		&info.StorefrontID,
+		&info.CategoryID,
+		&info.CategoryName,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get listing info: %w", err)
+	}
+
+	return &info, nil
+}
+
+// GetListingsAnalyticsInfo retrieves the owner and descriptive fields of several listings,
+// keyed by listing ID. Listings that do not exist are omitted.
+func (r *analyticsRepository) GetListingsAnalyticsInfo(ctx context.Context, listingIDs []int64) (map[int64]*domain.ListingAnalyticsInfo, error) {
+	infos := make(map[int64]*domain.ListingAnalyticsInfo, len(listingIDs))
+	if len(listingIDs) == 0 {
+		return infos, nil
+	}
+
+	query := `
+		SELECT l.id, l.title, l.source_type, l.user_id, l.storefront_id, l.category_id,
+		       COALESCE(c.name, '') AS category_name
+		FROM listings l
+		LEFT JOIN categories c ON c.id = l.category_id
+		WHERE l.id = ANY($1)
+	`
+
+	rows, err := r.db.Query(ctx, query, listingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listings info: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var info domain.ListingAnalyticsInfo
+		if err := rows.Scan(
+			&info.ListingID,
+			&info.Title,
+			&info.SourceType,
+			&info.UserID,
+			&info.StorefrontID,
+			&info.CategoryID,
+			&info.CategoryName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan listing info: %w", err)
+		}
+		infos[info.ListingID] = &info
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read listings info: %w", err)
+	}
+
+	return infos, nil
+}
+
 // RefreshMaterializedViews refreshes all analytics materialized views concurrently
 // Should be called periodically (e.g., every 15 minutes via cron)
 func (r *analyticsRepository) RefreshMaterializedViews(ctx context.Context) error {
@@ -383,6 +502,51 @@ func (r *analyticsRepository) RefreshMaterializedViews(ctx context.Context) erro
 	return nil
 }
 
+// RefreshTrendingCache refreshes the analytics_trending_cache materialized view
+func (r *analyticsRepository) RefreshTrendingCache(ctx context.Context) error {
+	if _, err := r.db.Exec(ctx, `SELECT refresh_analytics_trending_cache()`); err != nil {
+		r.logger.Error().Err(err).Msg("failed to refresh trending cache")
+		return fmt.Errorf("failed to refresh trending cache: %w", err)
+	}
+
+	r.logger.Info().Msg("trending cache refreshed successfully")
+	return nil
+}
+
+// ArchiveOldEvents moves events older than the retention period to analytics_events_archive.
+// Events are moved in batches (one transaction each) so a large backlog never holds
+// long locks on analytics_events; returns the total number of moved events.
+func (r *analyticsRepository) ArchiveOldEvents(ctx context.Context, retention time.Duration, batchSize int) (int64, error) {
+	if retention <= 0 {
+		return 0, fmt.Errorf("retention must be positive")
+	}
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be positive")
+	}
+
+	query := `SELECT archive_old_analytics_events(make_interval(secs => $1), $2)`
+
+	var total int64
+	for {
+		var moved int
+		if err := r.db.QueryRow(ctx, query, retention.Seconds(), batchSize).Scan(&moved); err != nil {
+			r.logger.Error().Err(err).Int64("archived", total).Msg("failed to archive analytics events")
+			return total, fmt.Errorf("failed to archive analytics events: %w", err)
+		}
+		total += int64(moved)
+
+		if moved < batchSize {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+	}
+
+	r.logger.Info().Int64("archived", total).Dur("retention", retention).Msg("analytics events archived")
+	return total, nil
+}
+
 // ============================================================================
 // PRIVATE HELPER METHODS
 // ============================================================================
@@ -414,7 +578,7 @@ func (r *analyticsRepository) getOverviewTimeSeries(ctx context.Context, filter
 				date_trunc('%s', created_at) AS time_bucket,
 				COUNT(*) FILTER (WHERE event_type = 'view' AND entity_type = 'listing') AS views,
 				COUNT(*) FILTER (WHERE event_type = 'favorite' AND entity_type = 'listing') AS favorites,
-				COUNT(*) FILTER (WHERE event_type = 'order_created') AS orders,
+				COUNT(DISTINCT metadata->>'order_id') FILTER (WHERE event_type = 'order_created') AS orders,
 				COALESCE(SUM((metadata->>'total_amount')::DECIMAL) FILTER (
 					WHERE event_type = 'order_completed'
 				), 0) AS revenue,
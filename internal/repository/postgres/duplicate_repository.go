@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/dedup"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SaveListingFingerprint creates or replaces the MinHash fingerprint of a listing
+func (r *Repository) SaveListingFingerprint(ctx context.Context, fingerprint *domain.ListingFingerprint) error {
+	query := `
+		INSERT INTO listing_fingerprints (listing_id, minhash, band_keys)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (listing_id)
+		DO UPDATE SET minhash = EXCLUDED.minhash, band_keys = EXCLUDED.band_keys, updated_at = CURRENT_TIMESTAMP
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		fingerprint.ListingID, pq.Array(fingerprint.MinHash), pq.Array(fingerprint.BandKeys),
+	); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", fingerprint.ListingID).Msg("failed to save listing fingerprint")
+		return fmt.Errorf("failed to save listing fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+// FindDuplicateCandidates retrieves fingerprints of live listings sharing at least one LSH band key
+// Sold, archived and deleted listings are not considered duplicates.
+func (r *Repository) FindDuplicateCandidates(ctx context.Context, bandKeys []int64, excludeListingID int64, limit int) ([]*domain.ListingFingerprint, error) {
+	query := `
+		SELECT f.listing_id, l.user_id, l.title, l.status, f.minhash
+		FROM listing_fingerprints f
+		JOIN listings l ON l.id = f.listing_id
+		WHERE f.band_keys && $1
+		  AND f.listing_id <> $2
+		  AND l.is_deleted = false
+		  AND l.status NOT IN ('sold', 'archived')
+		ORDER BY l.updated_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(bandKeys), excludeListingID, limit)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("failed to query duplicate candidates")
+		return nil, fmt.Errorf("failed to query duplicate candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*domain.ListingFingerprint
+	for rows.Next() {
+		var fp domain.ListingFingerprint
+		var minhash pq.Int64Array
+		if err := rows.Scan(&fp.ListingID, &fp.UserID, &fp.Title, &fp.Status, &minhash); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate candidate: %w", err)
+		}
+		fp.MinHash = minhash
+		candidates = append(candidates, &fp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate duplicate candidates: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// FindSimilarImages retrieves live listings having an image within maxDistance bits of the hash,
+// closest match first. Candidates are looked up by band key (phash_bands && $5) so the distance
+// is only computed for images sharing a band within range, not for the whole table.
+func (r *Repository) FindSimilarImages(ctx context.Context, hash int64, maxDistance int, excludeListingID int64, limit int) ([]*domain.DuplicateMatch, error) {
+	bandKeys := dedup.ImageBandKeys(uint64(hash), maxDistance)
+	if len(bandKeys) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT l.id AS listing_id, l.user_id, l.title, l.status,
+		       MIN(bit_count((i.phash # $1)::bit(64)))::int AS image_distance
+		FROM listing_images i
+		JOIN listings l ON l.id = i.listing_id
+		WHERE i.phash_bands && $5
+		  AND bit_count((i.phash # $1)::bit(64)) <= $2
+		  AND i.listing_id <> $3
+		  AND l.is_deleted = false
+		  AND l.status NOT IN ('sold', 'archived')
+		GROUP BY l.id, l.user_id, l.title, l.status
+		ORDER BY image_distance ASC, l.id DESC
+		LIMIT $4
+	`
+
+	var matches []*domain.DuplicateMatch
+	if err := r.db.SelectContext(ctx, &matches, query, hash, maxDistance, excludeListingID, limit, pq.Array(bandKeys)); err != nil {
+		r.logger.Error().Err(err).Msg("failed to query similar images")
+		return nil, fmt.Errorf("failed to query similar images: %w", err)
+	}
+
+	return matches, nil
+}
+
+// FlagDuplicates records duplicate matches of a listing for moderator review
+func (r *Repository) FlagDuplicates(ctx context.Context, listingID int64, matches []*domain.DuplicateMatch) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Keep the strongest evidence when the same pair is flagged again (e.g. text first, image later)
+	query := `
+		INSERT INTO listing_duplicate_flags (listing_id, duplicate_listing_id, text_similarity, image_distance)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (listing_id, duplicate_listing_id)
+		DO UPDATE SET text_similarity = GREATEST(listing_duplicate_flags.text_similarity, EXCLUDED.text_similarity),
+		              image_distance = LEAST(listing_duplicate_flags.image_distance, EXCLUDED.image_distance),
+		              detected_at = CURRENT_TIMESTAMP
+	`
+
+	for _, match := range matches {
+		if _, err := tx.ExecContext(ctx, query, listingID, match.ListingID, match.TextSimilarity, match.ImageDistance); err != nil {
+			r.logger.Error().Err(err).Int64("listing_id", listingID).Int64("duplicate_listing_id", match.ListingID).Msg("failed to flag duplicate")
+			return fmt.Errorf("failed to flag duplicate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListDuplicateFlags retrieves flagged duplicate matches, newest first
+func (r *Repository) ListDuplicateFlags(ctx context.Context, limit, offset int) ([]*domain.DuplicateFlag, int32, error) {
+	var total int32
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM listing_duplicate_flags`); err != nil {
+		r.logger.Error().Err(err).Msg("failed to count duplicate flags")
+		return nil, 0, fmt.Errorf("failed to count duplicate flags: %w", err)
+	}
+
+	query := `
+		SELECT listing_id, duplicate_listing_id, text_similarity, image_distance, detected_at
+		FROM listing_duplicate_flags
+		ORDER BY detected_at DESC, listing_id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	var flags []*domain.DuplicateFlag
+	if err := r.db.SelectContext(ctx, &flags, query, limit, offset); err != nil {
+		r.logger.Error().Err(err).Msg("failed to query duplicate flags")
+		return nil, 0, fmt.Errorf("failed to query duplicate flags: %w", err)
+	}
+
+	return flags, total, nil
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// staleImageJobError is recorded for jobs whose last attempt never finished
+const staleImageJobError = "image processing did not finish"
+
+// GetPendingImageJobs claims pending image processing jobs (oldest first) and marks their images
+// as processing. Jobs stuck in 'processing' for longer than staleAfter (crashed worker) are
+// reclaimed; those without retries left are failed together with their images.
+func (r *Repository) GetPendingImageJobs(ctx context.Context, limit int, staleAfter time.Duration) ([]*domain.ImageProcessingJob, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	exhaustedQuery := `
+		WITH exhausted AS (
+			UPDATE image_processing_jobs
+			SET status = 'failed', error_message = $2
+			WHERE status = 'processing'
+			  AND retry_count >= max_retries
+			  AND updated_at < CURRENT_TIMESTAMP - make_interval(secs => $1)
+			RETURNING image_id
+		)
+		UPDATE listing_images
+		SET processing_status = 'failed', processing_error = $2
+		WHERE id IN (SELECT image_id FROM exhausted)
+	`
+	if _, err := tx.ExecContext(ctx, exhaustedQuery, staleAfter.Seconds(), staleImageJobError); err != nil {
+		r.logger.Error().Err(err).Msg("failed to fail exhausted image jobs")
+		return nil, fmt.Errorf("failed to fail exhausted image jobs: %w", err)
+	}
+
+	query := `
+		UPDATE image_processing_jobs
+		SET status = 'processing',
+		    -- A reclaimed job counts as a failed attempt (e.g. the image crashed the worker)
+		    retry_count = retry_count + CASE WHEN status = 'processing' THEN 1 ELSE 0 END
+		WHERE id IN (
+			SELECT id FROM image_processing_jobs
+			WHERE retry_count < max_retries
+			  AND (status = 'pending'
+			       OR (status = 'processing' AND updated_at < CURRENT_TIMESTAMP - make_interval(secs => $2)))
+			ORDER BY created_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, image_id, status, retry_count, max_retries,
+		          error_message, created_at, updated_at, processed_at
+	`
+
+	var jobs []*domain.ImageProcessingJob
+	if err := tx.SelectContext(ctx, &jobs, query, limit, staleAfter.Seconds()); err != nil {
+		r.logger.Error().Err(err).Msg("failed to get pending image jobs")
+		return nil, fmt.Errorf("failed to get pending image jobs: %w", err)
+	}
+
+	if len(jobs) > 0 {
+		imageIDs := make([]int64, len(jobs))
+		for i, job := range jobs {
+			imageIDs[i] = job.ImageID
+		}
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE listing_images SET processing_status = 'processing' WHERE id = ANY($1)`,
+			pq.Array(imageIDs),
+		); err != nil {
+			r.logger.Error().Err(err).Msg("failed to mark images as processing")
+			return nil, fmt.Errorf("failed to mark images as processing: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// CompleteImageJob stores the processing results of an image and marks its job as completed
+func (r *Repository) CompleteImageJob(ctx context.Context, jobID int64, image *domain.ListingImage) error {
+	renditions, err := marshalRenditions(image.Renditions)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	imageQuery := `
+		UPDATE listing_images
+		SET url = $2, thumbnail_url = $3, width = $4, height = $5, file_size = $6,
+		    mime_type = $7, phash = $8, blurhash = $9, renditions = $10,
+		    processing_status = 'ready', processing_error = NULL
+		WHERE id = $1
+	`
+
+	result, err := tx.ExecContext(ctx, imageQuery,
+		image.ID,
+		image.URL,
+		image.ThumbnailURL,
+		image.Width,
+		image.Height,
+		image.FileSize,
+		image.MimeType,
+		image.PHash,
+		image.BlurHash,
+		renditions,
+	)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("image_id", image.ID).Msg("failed to save processed image")
+		return fmt.Errorf("failed to save processed image: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("image not found")
+	}
+
+	jobQuery := `
+		UPDATE image_processing_jobs
+		SET status = 'completed', error_message = NULL, processed_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, jobQuery, jobID); err != nil {
+		r.logger.Error().Err(err).Int64("job_id", jobID).Msg("failed to complete image job")
+		return fmt.Errorf("failed to complete image job: %w", err)
+	}
+
+	// Reindex the listing so that search results pick up the thumbnail
+	enqueueQuery := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		VALUES ($1, $2, 'pending', 0, 3)
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := tx.ExecContext(ctx, enqueueQuery, image.ListingID, domain.IndexOpUpdate); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", image.ListingID).Msg("failed to enqueue indexing")
+		return fmt.Errorf("failed to enqueue indexing: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FailImageJob records a failed processing attempt. The job goes back to 'pending' until
+// max_retries is reached; permanent failures (invalid or rejected image) fail immediately.
+// Returns true when the job failed for good and the image was marked as failed. The row is
+// kept so that the failure stays visible; the image GC reclaims its stored objects.
+func (r *Repository) FailImageJob(ctx context.Context, jobID, imageID int64, errorMsg string, permanent bool) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	jobQuery := `
+		UPDATE image_processing_jobs
+		SET retry_count = retry_count + 1,
+		    status = CASE WHEN $3 OR retry_count + 1 >= max_retries THEN 'failed' ELSE 'pending' END,
+		    error_message = $2
+		WHERE id = $1
+		RETURNING status
+	`
+
+	var status string
+	if err := tx.GetContext(ctx, &status, jobQuery, jobID, errorMsg, permanent); err != nil {
+		r.logger.Error().Err(err).Int64("job_id", jobID).Msg("failed to mark image job as failed")
+		return false, fmt.Errorf("failed to fail image job: %w", err)
+	}
+
+	imageStatus := domain.ImageStatusPending
+	var imageError *string
+	if status == "failed" {
+		imageStatus = domain.ImageStatusFailed
+		imageError = &errorMsg
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE listing_images SET processing_status = $2, processing_error = $3 WHERE id = $1`,
+		imageID, imageStatus, imageError,
+	); err != nil {
+		r.logger.Error().Err(err).Int64("image_id", imageID).Msg("failed to update image processing status")
+		return false, fmt.Errorf("failed to update image processing status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return status == "failed", nil
+}
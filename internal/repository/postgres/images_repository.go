@@ -3,8 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/sveturs/listings/internal/domain"
 )
 
@@ -14,15 +17,18 @@ func (r *Repository) GetImageByID(ctx context.Context, imageID int64) (*domain.L
 		SELECT id, listing_id, url, storage_path,
 		       thumbnail_url, display_order, is_primary,
 		       width, height, file_size,
-		       mime_type, created_at, updated_at
+		       mime_type, blurhash, renditions,
+		       processing_status, processing_error, variant_id,
+		       created_at, updated_at
 		FROM listing_images
 		WHERE id = $1
 	`
 
 	var image domain.ListingImage
 	var width, height sql.NullInt32
-	var fileSize sql.NullInt64
-	var mimeType, storagePath, thumbnailURL sql.NullString
+	var fileSize, variantID sql.NullInt64
+	var mimeType, storagePath, thumbnailURL, blurHash, processingError sql.NullString
+	var renditions []byte
 
 	err := r.db.QueryRowxContext(ctx, query, imageID).Scan(
 		&image.ID,
@@ -36,6 +42,11 @@ func (r *Repository) GetImageByID(ctx context.Context, imageID int64) (*domain.L
 		&height,
 		&fileSize,
 		&mimeType,
+		&blurHash,
+		&renditions,
+		&image.ProcessingStatus,
+		&processingError,
+		&variantID,
 		&image.CreatedAt,
 		&image.UpdatedAt,
 	)
@@ -66,6 +77,18 @@ func (r *Repository) GetImageByID(ctx context.Context, imageID int64) (*domain.L
 	if mimeType.Valid {
 		image.MimeType = &mimeType.String
 	}
+	if blurHash.Valid {
+		image.BlurHash = &blurHash.String
+	}
+	if processingError.Valid {
+		image.ProcessingError = &processingError.String
+	}
+	if variantID.Valid {
+		image.VariantID = &variantID.Int64
+	}
+	if image.Renditions, err = unmarshalRenditions(renditions); err != nil {
+		return nil, err
+	}
 
 	return &image, nil
 }
@@ -96,19 +119,38 @@ func (r *Repository) DeleteImage(ctx context.Context, imageID int64) error {
 	return nil
 }
 
-// AddImage adds a new image to a listing
+// AddImage adds a new image to a listing.
+// Images added with processing status "pending" are queued for the image processing workers
+// in the same statement.
 func (r *Repository) AddImage(ctx context.Context, image *domain.ListingImage) (*domain.ListingImage, error) {
 	query := `
-		INSERT INTO listing_images (
-			listing_id, url, storage_path, thumbnail_url, display_order,
-			is_primary, width, height, file_size, mime_type
+		WITH new_image AS (
+			INSERT INTO listing_images (
+				listing_id, url, storage_path, thumbnail_url, display_order,
+				is_primary, width, height, file_size, mime_type, phash,
+				blurhash, renditions, processing_status, variant_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			RETURNING id, processing_status, created_at, updated_at
+		), job AS (
+			INSERT INTO image_processing_jobs (image_id)
+			SELECT id FROM new_image WHERE processing_status = 'pending'
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, created_at, updated_at
+		SELECT id, created_at, updated_at FROM new_image
 	`
 
+	processingStatus := image.ProcessingStatus
+	if processingStatus == "" {
+		processingStatus = domain.ImageStatusReady
+	}
+
+	renditions, err := marshalRenditions(image.Renditions)
+	if err != nil {
+		return nil, err
+	}
+
 	var newImage domain.ListingImage
-	err := r.db.QueryRowxContext(ctx, query,
+	err = r.db.QueryRowxContext(ctx, query,
 		image.ListingID,
 		image.URL,
 		image.StoragePath,
@@ -119,6 +161,11 @@ func (r *Repository) AddImage(ctx context.Context, image *domain.ListingImage) (
 		image.Height,
 		image.FileSize,
 		image.MimeType,
+		image.PHash,
+		image.BlurHash,
+		renditions,
+		processingStatus,
+		image.VariantID,
 	).Scan(&newImage.ID, &newImage.CreatedAt, &newImage.UpdatedAt)
 
 	if err != nil {
@@ -137,6 +184,11 @@ func (r *Repository) AddImage(ctx context.Context, image *domain.ListingImage) (
 	newImage.Height = image.Height
 	newImage.FileSize = image.FileSize
 	newImage.MimeType = image.MimeType
+	newImage.PHash = image.PHash
+	newImage.BlurHash = image.BlurHash
+	newImage.Renditions = image.Renditions
+	newImage.ProcessingStatus = processingStatus
+	newImage.VariantID = image.VariantID
 	newImage.UpdatedAt = newImage.CreatedAt
 
 	r.logger.Info().Int64("image_id", newImage.ID).Int64("listing_id", image.ListingID).Msg("image added")
@@ -149,7 +201,9 @@ func (r *Repository) GetImages(ctx context.Context, listingID int64) ([]*domain.
 		SELECT id, listing_id, url, storage_path,
 		       thumbnail_url, display_order, is_primary,
 		       width, height, file_size,
-		       mime_type, created_at, updated_at
+		       mime_type, blurhash, renditions,
+		       processing_status, processing_error, variant_id,
+		       created_at, updated_at
 		FROM listing_images
 		WHERE listing_id = $1
 		ORDER BY is_primary DESC, display_order ASC, id ASC
@@ -166,8 +220,9 @@ func (r *Repository) GetImages(ctx context.Context, listingID int64) ([]*domain.
 	for rows.Next() {
 		var image domain.ListingImage
 		var width, height sql.NullInt32
-		var fileSize sql.NullInt64
-		var mimeType, storagePath, thumbnailURL sql.NullString
+		var fileSize, variantID sql.NullInt64
+		var mimeType, storagePath, thumbnailURL, blurHash, processingError sql.NullString
+		var renditions []byte
 
 		err := rows.Scan(
 			&image.ID,
@@ -181,6 +236,11 @@ func (r *Repository) GetImages(ctx context.Context, listingID int64) ([]*domain.
 			&height,
 			&fileSize,
 			&mimeType,
+			&blurHash,
+			&renditions,
+			&image.ProcessingStatus,
+			&processingError,
+			&variantID,
 			&image.CreatedAt,
 			&image.UpdatedAt,
 		)
@@ -208,6 +268,18 @@ func (r *Repository) GetImages(ctx context.Context, listingID int64) ([]*domain.
 		if mimeType.Valid {
 			image.MimeType = &mimeType.String
 		}
+		if blurHash.Valid {
+			image.BlurHash = &blurHash.String
+		}
+		if processingError.Valid {
+			image.ProcessingError = &processingError.String
+		}
+		if variantID.Valid {
+			image.VariantID = &variantID.Int64
+		}
+		if image.Renditions, err = unmarshalRenditions(renditions); err != nil {
+			return nil, err
+		}
 
 		images = append(images, &image)
 	}
@@ -219,6 +291,127 @@ func (r *Repository) GetImages(ctx context.Context, listingID int64) ([]*domain.
 	return images, nil
 }
 
+// UpdateImageRenditions replaces the stored original, blurhash and renditions of an image
+// (used when renditions are regenerated for existing images)
+func (r *Repository) UpdateImageRenditions(ctx context.Context, image *domain.ListingImage) error {
+	renditions, err := marshalRenditions(image.Renditions)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE listing_images
+		SET url = $2, storage_path = $3, width = $4, height = $5, file_size = $6,
+		    mime_type = $7, blurhash = $8, renditions = $9
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		image.ID,
+		image.URL,
+		image.StoragePath,
+		image.Width,
+		image.Height,
+		image.FileSize,
+		image.MimeType,
+		image.BlurHash,
+		renditions,
+	)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("image_id", image.ID).Msg("failed to update image renditions")
+		return fmt.Errorf("failed to update image renditions: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("image not found")
+	}
+
+	return nil
+}
+
+// ListImagesForRenditionBackfill retrieves images stored in MinIO with id > afterID, in id order.
+// Unless all is set, only images without renditions are returned.
+func (r *Repository) ListImagesForRenditionBackfill(ctx context.Context, afterID int64, limit int, all bool) ([]*domain.ListingImage, error) {
+	query := `
+		SELECT id, listing_id, storage_path, thumbnail_url, mime_type, renditions
+		FROM listing_images
+		WHERE id > $1
+		  AND storage_path IS NOT NULL
+		  AND processing_status = 'ready'
+		  AND ($3 OR renditions = '[]'::jsonb)
+		ORDER BY id ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryxContext(ctx, query, afterID, limit, all)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("failed to list images for rendition backfill")
+		return nil, fmt.Errorf("failed to list images for rendition backfill: %w", err)
+	}
+	defer rows.Close()
+
+	var images []*domain.ListingImage
+	for rows.Next() {
+		var image domain.ListingImage
+		var storagePath string
+		var thumbnailURL, mimeType sql.NullString
+		var renditions []byte
+
+		if err := rows.Scan(&image.ID, &image.ListingID, &storagePath, &thumbnailURL, &mimeType, &renditions); err != nil {
+			return nil, fmt.Errorf("failed to scan image: %w", err)
+		}
+		image.StoragePath = &storagePath
+		if thumbnailURL.Valid {
+			image.ThumbnailURL = &thumbnailURL.String
+		}
+		if mimeType.Valid {
+			image.MimeType = &mimeType.String
+		}
+		if image.Renditions, err = unmarshalRenditions(renditions); err != nil {
+			return nil, err
+		}
+
+		images = append(images, &image)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating images: %w", err)
+	}
+
+	return images, nil
+}
+
+// marshalRenditions encodes renditions for the JSONB column
+func marshalRenditions(renditions []domain.ImageRendition) ([]byte, error) {
+	if renditions == nil {
+		renditions = []domain.ImageRendition{}
+	}
+	data, err := json.Marshal(renditions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal image renditions: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalRenditions decodes the JSONB renditions column
+func unmarshalRenditions(data []byte) ([]domain.ImageRendition, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var renditions []domain.ImageRendition
+	if err := json.Unmarshal(data, &renditions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal image renditions: %w", err)
+	}
+	if len(renditions) == 0 {
+		return nil, nil
+	}
+	return renditions, nil
+}
+
 // ImageOrder represents display order update for a single image
 type ImageOrder struct {
 	ImageID      int64
@@ -311,3 +504,30 @@ func (r *Repository) ReorderImages(ctx context.Context, listingID int64, orders
 
 	return nil
 }
+
+// GetReferencedImageKeys returns the keys among keys that are stored as the original of an
+// image row (listing/product/variant images, storefront logos and banners). Images that failed
+// processing do not keep their objects: they are never served. Rows are matched
+// by key only: the key prefix does not identify the owning row (migrated C2C images keep
+// their old key, and images can be registered with a client-supplied storage path).
+func (r *Repository) GetReferencedImageKeys(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT storage_path FROM listing_images
+		WHERE storage_path = ANY($1) AND processing_status <> 'failed'
+		UNION
+		SELECT path FROM storefronts, unnest(ARRAY[logo_storage_path, banner_storage_path]) AS path
+		WHERE path = ANY($1)
+	`
+
+	var referenced []string
+	if err := r.db.SelectContext(ctx, &referenced, query, pq.Array(keys)); err != nil {
+		r.logger.Error().Err(err).Int("keys", len(keys)).Msg("failed to get referenced image keys")
+		return nil, fmt.Errorf("failed to get referenced image keys: %w", err)
+	}
+
+	return referenced, nil
+}
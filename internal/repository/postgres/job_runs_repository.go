@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+// jobLockNamespace keeps scheduler advisory locks apart from any other advisory lock user
+// (two-key form: pg_try_advisory_lock(namespace, hashtext(job_name)))
+const jobLockNamespace = 7301
+
+// maxJobRunErrorLength bounds the error text stored per run
+const maxJobRunErrorLength = 2000
+
+// jobRunsRepository implements repository.JobRunsRepository
+type jobRunsRepository struct {
+	db     *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+// NewJobRunsRepository creates a new job runs repository
+func NewJobRunsRepository(db *pgxpool.Pool, logger zerolog.Logger) repository.JobRunsRepository {
+	return &jobRunsRepository{
+		db:     db,
+		logger: logger.With().Str("repository", "job_runs").Logger(),
+	}
+}
+
+// AcquireJobLock takes a session-level advisory lock on a dedicated pool connection.
+// The connection stays checked out until release, so the lock lives exactly as long as
+// the run; if the process dies the connection closes and PostgreSQL frees the lock.
+func (r *jobRunsRepository) AcquireJobLock(ctx context.Context, jobName string) (func(), bool, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var acquired bool
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1, hashtext($2))`, jobLockNamespace, jobName).Scan(&acquired)
+	if err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("failed to try job lock: %w", err)
+	}
+	if !acquired {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	release := func() {
+		// The run context may already be cancelled; unlock with a fresh one
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock($1, hashtext($2))`, jobLockNamespace, jobName); err != nil {
+			// Never hand a connection that may still hold the lock back to the pool
+			r.logger.Error().Err(err).Str("job", jobName).Msg("failed to release job lock, closing connection")
+			_ = conn.Conn().Close(unlockCtx)
+		}
+		conn.Release()
+	}
+
+	return release, true, nil
+}
+
+// StartJobRun records the start of the run of a schedule slot. Returns started=false when
+// the slot was already run, e.g. by a replica whose tick fired before this one.
+func (r *jobRunsRepository) StartJobRun(ctx context.Context, jobName, instance string, scheduledAt time.Time) (int64, bool, error) {
+	query := `
+		INSERT INTO scheduled_job_runs (job_name, instance, status, scheduled_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (job_name, scheduled_at) WHERE scheduled_at IS NOT NULL DO NOTHING
+		RETURNING id
+	`
+
+	var id int64
+	err := r.db.QueryRow(ctx, query, jobName, instance, domain.JobRunStatusRunning, scheduledAt).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to record job run start: %w", err)
+	}
+
+	return id, true, nil
+}
+
+// FinishJobRun records the outcome of a run
+func (r *jobRunsRepository) FinishJobRun(ctx context.Context, runID int64, runErr error) error {
+	status := domain.JobRunStatusSucceeded
+	var errText *string
+	if runErr != nil {
+		status = domain.JobRunStatusFailed
+		msg := truncateText(runErr.Error(), maxJobRunErrorLength)
+		errText = &msg
+	}
+
+	query := `
+		UPDATE scheduled_job_runs
+		SET status = $2,
+		    error = $3,
+		    finished_at = CURRENT_TIMESTAMP,
+		    duration_ms = (EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - started_at)) * 1000)::BIGINT
+		WHERE id = $1
+	`
+
+	if _, err := r.db.Exec(ctx, query, runID, status, errText); err != nil {
+		return fmt.Errorf("failed to record job run result: %w", err)
+	}
+
+	return nil
+}
+
+// truncateText shortens s to at most maxBytes bytes without splitting a UTF-8 character
+func truncateText(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
+
+// PruneJobRuns deletes run history older than the given age
+func (r *jobRunsRepository) PruneJobRuns(ctx context.Context, olderThan time.Duration) (int64, error) {
+	result, err := r.db.Exec(ctx,
+		`DELETE FROM scheduled_job_runs WHERE started_at < NOW() - make_interval(secs => $1)`,
+		olderThan.Seconds(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune job runs: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
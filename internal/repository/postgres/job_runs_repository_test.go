@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestTruncateText tests that truncation never splits a UTF-8 character
+func TestTruncateText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxBytes int
+		expected string
+	}{
+		{name: "short", input: "timeout", maxBytes: 10, expected: "timeout"},
+		{name: "ascii", input: "connection refused", maxBytes: 10, expected: "connection"},
+		{name: "cut inside a character", input: "greška", maxBytes: 4, expected: "gre"},
+		{name: "cut after a character", input: "greška", maxBytes: 5, expected: "greš"},
+		{name: "cyrillic", input: "ошибка", maxBytes: 5, expected: "ош"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateText(tt.input, tt.maxBytes)
+			if got != tt.expected {
+				t.Errorf("truncateText(%q, %d) = %q, want %q", tt.input, tt.maxBytes, got, tt.expected)
+			}
+		})
+	}
+
+	long := strings.Repeat("ж", maxJobRunErrorLength)
+	if got := truncateText(long, maxJobRunErrorLength); !utf8.ValidString(got) || len(got) > maxJobRunErrorLength {
+		t.Errorf("truncated error is not valid UTF-8 within %d bytes", maxJobRunErrorLength)
+	}
+}
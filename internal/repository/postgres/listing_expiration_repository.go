@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ExpireListings moves active C2C listings past their expiration date to 'expired'
+// and enqueues their removal from the search index. Returns the expired listing IDs.
+func (r *Repository) ExpireListings(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		UPDATE listings
+		SET status = 'expired', updated_at = CURRENT_TIMESTAMP
+		WHERE id IN (
+			SELECT id FROM listings
+			WHERE source_type = 'c2c'
+			  AND status = 'active'
+			  AND is_deleted = false
+			  AND expires_at IS NOT NULL
+			  AND expires_at <= $1
+			ORDER BY expires_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`
+
+	var ids []int64
+	if err := tx.SelectContext(ctx, &ids, query, now, limit); err != nil {
+		r.logger.Error().Err(err).Msg("failed to expire listings")
+		return nil, fmt.Errorf("failed to expire listings: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	enqueueQuery := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		SELECT id, $2, 'pending', 0, 3 FROM unnest($1::bigint[]) AS id
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP
+	`
+
+	if _, err := tx.ExecContext(ctx, enqueueQuery, pq.Array(ids), domain.IndexOpDelete); err != nil {
+		r.logger.Error().Err(err).Int("count", len(ids)).Msg("failed to enqueue expired listings for index removal")
+		return nil, fmt.Errorf("failed to enqueue index removal: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Debug().Int("count", len(ids)).Msg("listings expired")
+	return ids, nil
+}
+
+// GetListingsForExpiryReminder retrieves active C2C listings expiring before the given time
+// that have not yet received a reminder for their current expiration date
+func (r *Repository) GetListingsForExpiryReminder(ctx context.Context, now, before time.Time, limit int) ([]*domain.ExpiringListing, error) {
+	query := `
+		SELECT l.id, l.user_id, l.title, l.expires_at
+		FROM listings l
+		WHERE l.source_type = 'c2c'
+		  AND l.status = 'active'
+		  AND l.is_deleted = false
+		  AND l.expires_at > $1
+		  AND l.expires_at <= $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM listing_expiry_reminders er
+			WHERE er.listing_id = l.id AND er.expires_at = l.expires_at
+		  )
+		ORDER BY l.expires_at ASC
+		LIMIT $3
+	`
+
+	var listings []*domain.ExpiringListing
+	if err := r.db.SelectContext(ctx, &listings, query, now, before, limit); err != nil {
+		r.logger.Error().Err(err).Msg("failed to query listings for expiry reminder")
+		return nil, fmt.Errorf("failed to query listings for expiry reminder: %w", err)
+	}
+
+	return listings, nil
+}
+
+// ClaimExpiryReminder records that a reminder is being sent for the listing's expiration date.
+// Returns false if another instance already claimed it.
+func (r *Repository) ClaimExpiryReminder(ctx context.Context, listingID int64, expiresAt time.Time) (bool, error) {
+	query := `
+		INSERT INTO listing_expiry_reminders (listing_id, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (listing_id, expires_at) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query, listingID, expiresAt)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", listingID).Msg("failed to claim expiry reminder")
+		return false, fmt.Errorf("failed to claim expiry reminder: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// GetCategoryRenewalDays returns the renewal period of a category, inherited from the
+// nearest ancestor that defines one. Returns 0 if no category in the chain has a period.
+func (r *Repository) GetCategoryRenewalDays(ctx context.Context, categoryID int64) (int, error) {
+	query := `
+		WITH RECURSIVE chain AS (
+			SELECT id, parent_id, 0 AS depth
+			FROM categories
+			WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, chain.depth + 1
+			FROM categories c
+			JOIN chain ON c.id = chain.parent_id
+			WHERE chain.depth < 10
+		)
+		SELECT crp.renewal_days
+		FROM chain
+		JOIN category_renewal_periods crp ON crp.category_id = chain.id
+		ORDER BY chain.depth ASC
+		LIMIT 1
+	`
+
+	var days int
+	err := r.db.QueryRowContext(ctx, query, categoryID).Scan(&days)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		r.logger.Error().Err(err).Int64("category_id", categoryID).Msg("failed to get category renewal period")
+		return 0, fmt.Errorf("failed to get category renewal period: %w", err)
+	}
+
+	return days, nil
+}
+
+// SetListingExpiration sets the expiration date of a listing
+func (r *Repository) SetListingExpiration(ctx context.Context, listingID int64, expiresAt time.Time) error {
+	query := `
+		UPDATE listings
+		SET expires_at = $2
+		WHERE id = $1 AND is_deleted = false
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, listingID, expiresAt); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", listingID).Msg("failed to set listing expiration")
+		return fmt.Errorf("failed to set listing expiration: %w", err)
+	}
+
+	return nil
+}
+
+// RenewListing extends the expiration date of a listing and reactivates it if expired
+func (r *Repository) RenewListing(ctx context.Context, listingID int64, expiresAt time.Time) error {
+	query := `
+		UPDATE listings
+		SET expires_at = $2,
+		    status = 'active',
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		  AND is_deleted = false
+		  AND status IN ('active', 'expired')
+	`
+
+	result, err := r.db.ExecContext(ctx, query, listingID, expiresAt)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", listingID).Msg("failed to renew listing")
+		return fmt.Errorf("failed to renew listing: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("listing not found or not renewable")
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// TransitionListingStatus moves a listing from one workflow status to another, records the
+// moderation event and enqueues re-indexing in one transaction. The update only applies if the
+// listing is still in fromStatus, so concurrent decisions cannot overwrite each other.
+func (r *Repository) TransitionListingStatus(ctx context.Context, event *domain.ListingModerationEvent, scheduledAt *time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		UPDATE listings
+		SET status = $3,
+		    scheduled_publish_at = $4,
+		    published_at = CASE WHEN $3 = 'active' THEN CURRENT_TIMESTAMP ELSE published_at END,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = $2 AND is_deleted = false
+	`
+
+	result, err := tx.ExecContext(ctx, query, event.ListingID, event.FromStatus, event.ToStatus, scheduledAt)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", event.ListingID).Msg("failed to change listing status")
+		return fmt.Errorf("failed to change listing status: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("listing not found or status changed concurrently")
+	}
+
+	eventQuery := `
+		INSERT INTO listing_moderation_events (listing_id, action, from_status, to_status, actor_id, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if _, err := tx.ExecContext(ctx, eventQuery,
+		event.ListingID, event.Action, event.FromStatus, event.ToStatus, event.ActorID, event.Reason,
+	); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", event.ListingID).Msg("failed to record moderation event")
+		return fmt.Errorf("failed to record moderation event: %w", err)
+	}
+
+	// The indexing worker indexes published listings and removes all others
+	enqueueQuery := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		VALUES ($1, $2, 'pending', 0, 3)
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP
+	`
+
+	if _, err := tx.ExecContext(ctx, enqueueQuery, event.ListingID, domain.IndexOpUpdate); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", event.ListingID).Msg("failed to enqueue indexing")
+		return fmt.Errorf("failed to enqueue indexing: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Debug().
+		Int64("listing_id", event.ListingID).
+		Str("from_status", event.FromStatus).
+		Str("to_status", event.ToStatus).
+		Msg("listing status changed")
+	return nil
+}
+
+// GetModerationQueue retrieves listings awaiting review, oldest submission first
+func (r *Repository) GetModerationQueue(ctx context.Context, limit, offset int) ([]*domain.Listing, int32, error) {
+	var total int32
+	countQuery := `SELECT COUNT(*) FROM listings WHERE status = 'pending_review' AND is_deleted = false`
+	if err := r.db.GetContext(ctx, &total, countQuery); err != nil {
+		r.logger.Error().Err(err).Msg("failed to count moderation queue")
+		return nil, 0, fmt.Errorf("failed to count moderation queue: %w", err)
+	}
+
+	query := `
+		SELECT id, uuid, slug, user_id, storefront_id, title, description, price, currency, category_id,
+		       status, visibility, quantity, sku, source_type, view_count, favorites_count,
+		       expires_at, scheduled_publish_at, created_at, updated_at, published_at, deleted_at, is_deleted
+		FROM listings
+		WHERE status = 'pending_review' AND is_deleted = false
+		ORDER BY updated_at ASC, id ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	var listings []*domain.Listing
+	if err := r.db.SelectContext(ctx, &listings, query, limit, offset); err != nil {
+		r.logger.Error().Err(err).Msg("failed to query moderation queue")
+		return nil, 0, fmt.Errorf("failed to query moderation queue: %w", err)
+	}
+
+	return listings, total, nil
+}
+
+// PublishScheduledListings publishes approved listings whose publish time has come, records
+// the publish events and enqueues them for indexing. Returns the published listing IDs.
+func (r *Repository) PublishScheduledListings(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		UPDATE listings
+		SET status = 'active',
+		    published_at = CURRENT_TIMESTAMP,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id IN (
+			SELECT id FROM listings
+			WHERE status = 'scheduled'
+			  AND is_deleted = false
+			  AND (scheduled_publish_at IS NULL OR scheduled_publish_at <= $1)
+			ORDER BY scheduled_publish_at ASC NULLS FIRST
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`
+
+	var ids []int64
+	if err := tx.SelectContext(ctx, &ids, query, now, limit); err != nil {
+		r.logger.Error().Err(err).Msg("failed to publish scheduled listings")
+		return nil, fmt.Errorf("failed to publish scheduled listings: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	eventQuery := `
+		INSERT INTO listing_moderation_events (listing_id, action, from_status, to_status)
+		SELECT id, $2, $3, $4 FROM unnest($1::bigint[]) AS id
+	`
+
+	if _, err := tx.ExecContext(ctx, eventQuery, pq.Array(ids),
+		domain.ModerationActionPublish, domain.StatusScheduled, domain.StatusPublished,
+	); err != nil {
+		r.logger.Error().Err(err).Int("count", len(ids)).Msg("failed to record publish events")
+		return nil, fmt.Errorf("failed to record publish events: %w", err)
+	}
+
+	enqueueQuery := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		SELECT id, $2, 'pending', 0, 3 FROM unnest($1::bigint[]) AS id
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP
+	`
+
+	if _, err := tx.ExecContext(ctx, enqueueQuery, pq.Array(ids), domain.IndexOpIndex); err != nil {
+		r.logger.Error().Err(err).Int("count", len(ids)).Msg("failed to enqueue published listings for indexing")
+		return nil, fmt.Errorf("failed to enqueue indexing: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Debug().Int("count", len(ids)).Msg("scheduled listings published")
+	return ids, nil
+}
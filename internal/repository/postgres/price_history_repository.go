@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// GetPriceHistory retrieves price changes of a listing/product, newest first
+// Without a variant filter only listing/product level changes are returned
+func (r *Repository) GetPriceHistory(ctx context.Context, filter *domain.PriceHistoryFilter) ([]*domain.PriceHistoryEntry, error) {
+	conditions := []string{"listing_id = $1"}
+	args := []interface{}{filter.ListingID}
+
+	if filter.VariantID != nil {
+		args = append(args, *filter.VariantID)
+		conditions = append(conditions, fmt.Sprintf("variant_id = $%d", len(args)))
+	} else {
+		conditions = append(conditions, "variant_id IS NULL")
+	}
+
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("changed_at >= $%d", len(args)))
+	}
+
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("changed_at <= $%d", len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT id, listing_id, variant_id, old_price, new_price, currency, changed_at
+		FROM price_history
+		WHERE %s
+		ORDER BY changed_at DESC, id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	var entries []*domain.PriceHistoryEntry
+	if err := r.db.SelectContext(ctx, &entries, query, args...); err != nil {
+		r.logger.Error().Err(err).Int64("listing_id", filter.ListingID).Msg("failed to query price history")
+		return nil, fmt.Errorf("failed to query price history: %w", err)
+	}
+
+	return entries, nil
+}
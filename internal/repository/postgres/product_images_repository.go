@@ -9,27 +9,30 @@ import (
 	"github.com/sveturs/listings/internal/domain"
 )
 
-// GetProductImageByID retrieves a single product image by ID
-// Uses listing_images table (unified for both C2C and B2C products)
-func (r *Repository) GetProductImageByID(ctx context.Context, imageID int64) (*domain.ProductImage, error) {
-	query := `
-		SELECT id, listing_id, url, storage_path,
-		       thumbnail_url, display_order, is_primary,
-		       width, height, file_size,
-		       mime_type, created_at, updated_at
-		FROM listing_images
-		WHERE id = $1
-	`
+// productImageColumns are the listing_images columns scanned by scanProductImage
+const productImageColumns = `
+		id, listing_id, variant_id, url, storage_path,
+		thumbnail_url, display_order, is_primary,
+		width, height, file_size,
+		mime_type, created_at, updated_at`
+
+// rowScanner is implemented by *sql.Row, *sqlx.Row and *sqlx.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
 
+// scanProductImage scans a row selected with productImageColumns
+func scanProductImage(row rowScanner) (*domain.ProductImage, error) {
 	var image domain.ProductImage
-	var productID sql.NullInt64
+	var productID, variantID sql.NullInt64
 	var width, height sql.NullInt32
 	var fileSize sql.NullInt64
 	var mimeType, storagePath, thumbnailURL sql.NullString
 
-	err := r.db.QueryRowxContext(ctx, query, imageID).Scan(
+	err := row.Scan(
 		&image.ID,
 		&productID,
+		&variantID,
 		&image.URL,
 		&storagePath,
 		&thumbnailURL,
@@ -43,17 +46,16 @@ func (r *Repository) GetProductImageByID(ctx context.Context, imageID int64) (*d
 		&image.UpdatedAt,
 	)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("product image not found: %w", err)
-		}
-		r.logger.Error().Err(err).Int64("image_id", imageID).Msg("failed to get product image")
-		return nil, fmt.Errorf("failed to get product image: %w", err)
+		return nil, err
 	}
 
 	// Handle nullable fields
 	if productID.Valid {
 		image.ProductID = &productID.Int64
 	}
+	if variantID.Valid {
+		image.VariantID = &variantID.Int64
+	}
 	if storagePath.Valid {
 		image.StoragePath = &storagePath.String
 	}
@@ -76,21 +78,42 @@ func (r *Repository) GetProductImageByID(ctx context.Context, imageID int64) (*d
 	return &image, nil
 }
 
-// AddProductImage adds a new image to a product (B2C or C2C)
+// GetProductImageByID retrieves a single product image by ID
+// Uses listing_images table (unified for both C2C and B2C products)
+func (r *Repository) GetProductImageByID(ctx context.Context, imageID int64) (*domain.ProductImage, error) {
+	query := `SELECT ` + productImageColumns + `
+		FROM listing_images
+		WHERE id = $1
+	`
+
+	image, err := scanProductImage(r.db.QueryRowxContext(ctx, query, imageID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product image not found: %w", err)
+		}
+		r.logger.Error().Err(err).Int64("image_id", imageID).Msg("failed to get product image")
+		return nil, fmt.Errorf("failed to get product image: %w", err)
+	}
+
+	return image, nil
+}
+
+// AddProductImage adds a new image to a product (B2C or C2C), optionally for one of its variants
 // Uses listing_images table (unified for both C2C and B2C products)
 func (r *Repository) AddProductImage(ctx context.Context, image *domain.ProductImage) (*domain.ProductImage, error) {
 	query := `
 		INSERT INTO listing_images (
-			listing_id, url, storage_path, thumbnail_url, display_order,
+			listing_id, variant_id, url, storage_path, thumbnail_url, display_order,
 			is_primary, width, height, file_size, mime_type
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at, updated_at
 	`
 
 	var newImage domain.ProductImage
 	err := r.db.QueryRowxContext(ctx, query,
 		image.ProductID,
+		image.VariantID,
 		image.URL,
 		image.StoragePath,
 		image.ThumbnailURL,
@@ -109,6 +132,7 @@ func (r *Repository) AddProductImage(ctx context.Context, image *domain.ProductI
 
 	// Populate the returned image with input data
 	newImage.ProductID = image.ProductID
+	newImage.VariantID = image.VariantID
 	newImage.URL = image.URL
 	newImage.StoragePath = image.StoragePath
 	newImage.ThumbnailURL = image.ThumbnailURL
@@ -126,14 +150,10 @@ func (r *Repository) AddProductImage(ctx context.Context, image *domain.ProductI
 	return &newImage, nil
 }
 
-// GetProductImages retrieves all images for a product (B2C or C2C)
+// GetProductImages retrieves all images for a product (B2C or C2C), including variant images
 // Uses listing_images table (unified for both C2C and B2C products)
 func (r *Repository) GetProductImages(ctx context.Context, productID int64) ([]*domain.ProductImage, error) {
-	query := `
-		SELECT id, listing_id, url, storage_path,
-		       thumbnail_url, display_order, is_primary,
-		       width, height, file_size,
-		       mime_type, created_at, updated_at
+	query := `SELECT ` + productImageColumns + `
 		FROM listing_images
 		WHERE listing_id = $1
 		ORDER BY is_primary DESC, display_order ASC, id ASC
@@ -148,56 +168,12 @@ func (r *Repository) GetProductImages(ctx context.Context, productID int64) ([]*
 
 	var images []*domain.ProductImage
 	for rows.Next() {
-		var image domain.ProductImage
-		var prodID sql.NullInt64
-		var width, height sql.NullInt32
-		var fileSize sql.NullInt64
-		var mimeType, storagePath, thumbnailURL sql.NullString
-
-		err := rows.Scan(
-			&image.ID,
-			&prodID,
-			&image.URL,
-			&storagePath,
-			&thumbnailURL,
-			&image.DisplayOrder,
-			&image.IsPrimary,
-			&width,
-			&height,
-			&fileSize,
-			&mimeType,
-			&image.CreatedAt,
-			&image.UpdatedAt,
-		)
+		image, err := scanProductImage(rows)
 		if err != nil {
 			r.logger.Error().Err(err).Msg("failed to scan product image")
 			return nil, fmt.Errorf("failed to scan product image: %w", err)
 		}
-
-		// Handle nullable fields
-		if prodID.Valid {
-			image.ProductID = &prodID.Int64
-		}
-		if storagePath.Valid {
-			image.StoragePath = &storagePath.String
-		}
-		if thumbnailURL.Valid {
-			image.ThumbnailURL = &thumbnailURL.String
-		}
-		if width.Valid {
-			image.Width = &width.Int32
-		}
-		if height.Valid {
-			image.Height = &height.Int32
-		}
-		if fileSize.Valid {
-			image.FileSize = &fileSize.Int64
-		}
-		if mimeType.Valid {
-			image.MimeType = &mimeType.String
-		}
-
-		images = append(images, &image)
+		images = append(images, image)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -214,11 +190,7 @@ func (r *Repository) GetProductImagesBatch(ctx context.Context, productIDs []int
 		return make(map[int64][]*domain.ProductImage), nil
 	}
 
-	query := `
-		SELECT id, listing_id, url, storage_path,
-		       thumbnail_url, display_order, is_primary,
-		       width, height, file_size,
-		       mime_type, created_at, updated_at
+	query := `SELECT ` + productImageColumns + `
 		FROM listing_images
 		WHERE listing_id = ANY($1)
 		ORDER BY listing_id, is_primary DESC, display_order ASC, id ASC
@@ -233,62 +205,56 @@ func (r *Repository) GetProductImagesBatch(ctx context.Context, productIDs []int
 
 	result := make(map[int64][]*domain.ProductImage)
 	for rows.Next() {
-		var image domain.ProductImage
-		var prodID sql.NullInt64
-		var width, height sql.NullInt32
-		var fileSize sql.NullInt64
-		var mimeType, storagePath, thumbnailURL sql.NullString
-
-		err := rows.Scan(
-			&image.ID,
-			&prodID,
-			&image.URL,
-			&storagePath,
-			&thumbnailURL,
-			&image.DisplayOrder,
-			&image.IsPrimary,
-			&width,
-			&height,
-			&fileSize,
-			&mimeType,
-			&image.CreatedAt,
-			&image.UpdatedAt,
-		)
+		image, err := scanProductImage(rows)
 		if err != nil {
 			r.logger.Error().Err(err).Msg("failed to scan product image in batch")
 			return nil, fmt.Errorf("failed to scan product image: %w", err)
 		}
 
-		// Handle nullable fields
-		if prodID.Valid {
-			image.ProductID = &prodID.Int64
-		}
-		if storagePath.Valid {
-			image.StoragePath = &storagePath.String
-		}
-		if thumbnailURL.Valid {
-			image.ThumbnailURL = &thumbnailURL.String
-		}
-		if width.Valid {
-			image.Width = &width.Int32
-		}
-		if height.Valid {
-			image.Height = &height.Int32
-		}
-		if fileSize.Valid {
-			image.FileSize = &fileSize.Int64
-		}
-		if mimeType.Valid {
-			image.MimeType = &mimeType.String
+		if image.ProductID != nil {
+			result[*image.ProductID] = append(result[*image.ProductID], image)
 		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product images batch: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetVariantImagesBatch retrieves images for multiple product variants at once
+// Returns a map of variantID -> images slice
+func (r *Repository) GetVariantImagesBatch(ctx context.Context, variantIDs []int64) (map[int64][]*domain.ProductImage, error) {
+	if len(variantIDs) == 0 {
+		return make(map[int64][]*domain.ProductImage), nil
+	}
+
+	query := `SELECT ` + productImageColumns + `
+		FROM listing_images
+		WHERE variant_id = ANY($1)
+		ORDER BY variant_id, is_primary DESC, display_order ASC, id ASC
+	`
+
+	rows, err := r.db.QueryxContext(ctx, query, pq.Array(variantIDs))
+	if err != nil {
+		r.logger.Error().Err(err).Int("variant_count", len(variantIDs)).Msg("failed to get variant images batch")
+		return nil, fmt.Errorf("failed to get variant images batch: %w", err)
+	}
+	defer rows.Close()
 
-		if prodID.Valid {
-			result[prodID.Int64] = append(result[prodID.Int64], &image)
+	result := make(map[int64][]*domain.ProductImage)
+	for rows.Next() {
+		image, err := scanProductImage(rows)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("failed to scan variant image")
+			return nil, fmt.Errorf("failed to scan variant image: %w", err)
 		}
+		result[*image.VariantID] = append(result[*image.VariantID], image)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating product images batch: %w", err)
+		return nil, fmt.Errorf("error iterating variant images batch: %w", err)
 	}
 
 	return result, nil
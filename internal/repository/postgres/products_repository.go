@@ -597,6 +597,14 @@ func (r *Repository) GetVariantByID(ctx context.Context, variantID int64, produc
 		}
 	}
 
+	// Load variant images
+	imagesMap, err := r.GetVariantImagesBatch(ctx, []int64{variant.ID})
+	if err != nil {
+		r.logger.Warn().Err(err).Int64("variant_id", variant.ID).Msg("failed to load variant images")
+	} else {
+		variant.Images = imagesMap[variant.ID]
+	}
+
 	return &variant, nil
 }
 
@@ -701,6 +709,22 @@ func (r *Repository) GetVariantsByProductID(ctx context.Context, productID int64
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
+	// Load images for all variants in batch
+	if len(variants) > 0 {
+		variantIDs := make([]int64, len(variants))
+		for i, v := range variants {
+			variantIDs[i] = v.ID
+		}
+		imagesMap, err := r.GetVariantImagesBatch(ctx, variantIDs)
+		if err != nil {
+			r.logger.Warn().Err(err).Msg("failed to load variant images batch")
+		} else {
+			for _, v := range variants {
+				v.Images = imagesMap[v.ID]
+			}
+		}
+	}
+
 	return variants, nil
 }
 
@@ -2015,7 +2039,9 @@ func (r *Repository) GetProductStats(ctx context.Context, storefrontID int64) (*
 			COUNT(*) FILTER (WHERE stock_status = 'out_of_stock') as out_of_stock,
 			COUNT(*) FILTER (WHERE stock_status = 'low_stock') as low_stock,
 			COALESCE(SUM(price * quantity), 0) as total_value,
-			COALESCE(SUM(sold_count), 0) as total_sold
+			COALESCE(SUM(sold_count), 0) as total_sold,
+			COALESCE(SUM(view_count), 0) as unique_views,
+			COALESCE(SUM(total_view_count), 0) as total_views
 		FROM listings
 		WHERE storefront_id = $1 AND source_type = 'b2c'
 	`
@@ -2031,6 +2057,8 @@ func (r *Repository) GetProductStats(ctx context.Context, storefrontID int64) (*
 		&stats.LowStock,
 		&totalValue,
 		&totalSold,
+		&stats.UniqueViews,
+		&stats.TotalViews,
 	)
 
 	if err != nil {
@@ -2055,6 +2083,56 @@ func (r *Repository) GetProductStats(ctx context.Context, storefrontID int64) (*
 	return &stats, nil
 }
 
+// AddListingViews adds buffered view counts to the unique (view_count) and total
+// (total_view_count) view counters of listings in one statement
+func (r *Repository) AddListingViews(ctx context.Context, counts []domain.ViewCount) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(counts))
+	unique := make([]int64, len(counts))
+	total := make([]int64, len(counts))
+	for i, c := range counts {
+		ids[i] = c.ListingID
+		unique[i] = c.UniqueViews
+		total[i] = c.TotalViews
+	}
+
+	query := `
+		UPDATE listings l
+		SET view_count = l.view_count + v.unique_views,
+		    total_view_count = l.total_view_count + v.total_views
+		FROM unnest($1::bigint[], $2::bigint[], $3::bigint[]) AS v(id, unique_views, total_views)
+		WHERE l.id = v.id AND l.source_type = 'b2c'
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids), pq.Array(unique), pq.Array(total)); err != nil {
+		r.logger.Error().Err(err).Int("listings", len(counts)).Msg("failed to add listing views")
+		return fmt.Errorf("failed to add listing views: %w", err)
+	}
+
+	return nil
+}
+
+// IsViewableProduct reports whether a B2C product exists and is not deleted
+func (r *Repository) IsViewableProduct(ctx context.Context, productID int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM listings
+			WHERE id = $1 AND source_type = 'b2c' AND is_deleted = false
+		)
+	`
+
+	var viewable bool
+	if err := r.db.GetContext(ctx, &viewable, query, productID); err != nil {
+		r.logger.Error().Err(err).Int64("product_id", productID).Msg("failed to check product")
+		return false, fmt.Errorf("failed to check product: %w", err)
+	}
+
+	return viewable, nil
+}
+
 // IncrementProductViews increments the view counter for a product
 func (r *Repository) IncrementProductViews(ctx context.Context, productID int64) error {
 	r.logger.Debug().Int64("product_id", productID).Msg("incrementing product views")
@@ -2065,7 +2143,7 @@ func (r *Repository) IncrementProductViews(ctx context.Context, productID int64)
 
 	query := `
 		UPDATE listings
-		SET view_count = view_count + 1, updated_at = NOW()
+		SET view_count = view_count + 1, total_view_count = total_view_count + 1, updated_at = NOW()
 		WHERE id = $1 AND source_type = 'b2c'
 	`
 
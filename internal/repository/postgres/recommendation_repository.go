@@ -0,0 +1,356 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+// seedDecaySeconds controls how fast old interactions lose weight as recommendation seeds
+// (weight * e^(-age / 30 days))
+const seedDecaySeconds = 30 * 24 * 60 * 60
+
+// recommendationRepository implements repository.RecommendationRepository
+type recommendationRepository struct {
+	db     *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+// NewRecommendationRepository creates a new recommendation repository
+func NewRecommendationRepository(db *pgxpool.Pool, logger zerolog.Logger) repository.RecommendationRepository {
+	return &recommendationRepository{
+		db:     db,
+		logger: logger.With().Str("repository", "recommendations").Logger(),
+	}
+}
+
+// engagementCoOccurrenceQuery stores the engagement neighbors of every active listing.
+// Every visitor (user, or session for anonymous events) contributes the strongest interaction
+// per listing; a pair scores the overlapping weight of both listings summed over visitors,
+// normalized by the total weight of each listing (cosine-like, so popular listings do not
+// become everybody's neighbor).
+// $1 since, $2 top N, $3 min visitors, $4 max listings per visitor,
+// $5 view weight, $6 favorite weight, $7 purchase weight
+const engagementCoOccurrenceQuery = `
+	WITH interactions AS (
+		SELECT COALESCE('u:' || e.user_id::TEXT, 's:' || e.session_id) AS visitor,
+		       e.entity_id AS listing_id,
+		       CASE e.event_type WHEN 'view' THEN $5::FLOAT8 ELSE $6::FLOAT8 END AS weight
+		FROM analytics_events e
+		WHERE e.entity_type = 'listing'
+		  AND e.event_type IN ('view', 'favorite', 'add_to_cart')
+		  AND e.created_at >= $1
+		  AND (e.user_id IS NOT NULL OR e.session_id IS NOT NULL)
+		UNION ALL
+		SELECT 'u:' || f.user_id::TEXT, f.listing_id, $6::FLOAT8
+		FROM listing_favorites f
+		WHERE f.created_at >= $1
+		UNION ALL
+		SELECT 'u:' || o.user_id::TEXT, oi.listing_id, $7::FLOAT8
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id
+		WHERE o.created_at >= $1
+		  AND o.user_id IS NOT NULL
+		  AND o.status NOT IN ('cancelled', 'failed', 'refunded')
+	),
+	visitor_items AS (
+		SELECT i.visitor, i.listing_id, MAX(i.weight) AS weight
+		FROM interactions i
+		JOIN listings l ON l.id = i.listing_id
+		WHERE l.status = 'active' AND l.is_deleted = false
+		GROUP BY i.visitor, i.listing_id
+	),
+	visitors AS (
+		SELECT visitor
+		FROM visitor_items
+		GROUP BY visitor
+		HAVING COUNT(*) BETWEEN 2 AND $4
+	),
+	items AS (
+		SELECT vi.visitor, vi.listing_id, vi.weight
+		FROM visitor_items vi
+		JOIN visitors v ON v.visitor = vi.visitor
+	),
+	totals AS (
+		SELECT listing_id, SUM(weight) AS total
+		FROM items
+		GROUP BY listing_id
+	),
+	pairs AS (
+		SELECT a.listing_id, b.listing_id AS related_listing_id,
+		       SUM(LEAST(a.weight, b.weight)) AS overlap,
+		       COUNT(*) AS support
+		FROM items a
+		JOIN items b ON b.visitor = a.visitor AND b.listing_id <> a.listing_id
+		GROUP BY a.listing_id, b.listing_id
+		HAVING COUNT(*) >= $3
+	),
+	ranked AS (
+		SELECT p.listing_id, p.related_listing_id, p.support,
+		       p.overlap / SQRT(ta.total * tb.total) AS score,
+		       ROW_NUMBER() OVER (
+		           PARTITION BY p.listing_id
+		           ORDER BY p.overlap / SQRT(ta.total * tb.total) DESC, p.related_listing_id
+		       ) AS rank
+		FROM pairs p
+		JOIN totals ta ON ta.listing_id = p.listing_id
+		JOIN totals tb ON tb.listing_id = p.related_listing_id
+	)
+	INSERT INTO listing_co_occurrences (kind, listing_id, related_listing_id, score, support, computed_at)
+	SELECT 'engagement', listing_id, related_listing_id, score, support, NOW()
+	FROM ranked
+	WHERE rank <= $2
+`
+
+// purchaseCoOccurrenceQuery stores the "frequently bought together" neighbors of every active
+// listing: listings in the same orders, normalized by how often each listing is ordered.
+// $1 since, $2 top N, $3 min orders, $4 max listings per order
+const purchaseCoOccurrenceQuery = `
+	WITH order_listings AS (
+		SELECT DISTINCT oi.order_id, oi.listing_id
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		JOIN listings l ON l.id = oi.listing_id
+		WHERE o.created_at >= $1
+		  AND o.status NOT IN ('cancelled', 'failed', 'refunded')
+		  AND l.status = 'active' AND l.is_deleted = false
+	),
+	multi_item_orders AS (
+		SELECT order_id
+		FROM order_listings
+		GROUP BY order_id
+		HAVING COUNT(*) BETWEEN 2 AND $4
+	),
+	items AS (
+		SELECT ol.order_id, ol.listing_id
+		FROM order_listings ol
+		JOIN multi_item_orders m ON m.order_id = ol.order_id
+	),
+	totals AS (
+		SELECT listing_id, COUNT(*) AS total
+		FROM items
+		GROUP BY listing_id
+	),
+	pairs AS (
+		SELECT a.listing_id, b.listing_id AS related_listing_id, COUNT(*) AS support
+		FROM items a
+		JOIN items b ON b.order_id = a.order_id AND b.listing_id <> a.listing_id
+		GROUP BY a.listing_id, b.listing_id
+		HAVING COUNT(*) >= $3
+	),
+	ranked AS (
+		SELECT p.listing_id, p.related_listing_id, p.support,
+		       p.support / SQRT(ta.total * tb.total) AS score,
+		       ROW_NUMBER() OVER (
+		           PARTITION BY p.listing_id
+		           ORDER BY p.support / SQRT(ta.total * tb.total) DESC, p.related_listing_id
+		       ) AS rank
+		FROM pairs p
+		JOIN totals ta ON ta.listing_id = p.listing_id
+		JOIN totals tb ON tb.listing_id = p.related_listing_id
+	)
+	INSERT INTO listing_co_occurrences (kind, listing_id, related_listing_id, score, support, computed_at)
+	SELECT 'purchase', listing_id, related_listing_id, score, support, NOW()
+	FROM ranked
+	WHERE rank <= $2
+`
+
+// RebuildCoOccurrences replaces all stored neighbors in one transaction, so readers keep
+// seeing the previous neighbors until the rebuild commits
+func (r *recommendationRepository) RebuildCoOccurrences(ctx context.Context, opts repository.CoOccurrenceBuildOptions) (int64, error) {
+	if opts.TopN <= 0 {
+		return 0, fmt.Errorf("top N must be positive")
+	}
+	if opts.MaxItemsPerVisitor < 2 {
+		return 0, fmt.Errorf("max items per visitor must be at least 2")
+	}
+
+	start := time.Now()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM listing_co_occurrences`); err != nil {
+		return 0, fmt.Errorf("failed to clear co-occurrences: %w", err)
+	}
+
+	engagement, err := tx.Exec(ctx, engagementCoOccurrenceQuery,
+		opts.Since, opts.TopN, opts.MinVisitorSupport, opts.MaxItemsPerVisitor,
+		domain.InteractionWeightView, domain.InteractionWeightFavorite, domain.InteractionWeightPurchase,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute engagement co-occurrences: %w", err)
+	}
+
+	purchase, err := tx.Exec(ctx, purchaseCoOccurrenceQuery,
+		opts.Since, opts.TopN, opts.MinOrderSupport, opts.MaxItemsPerVisitor,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute purchase co-occurrences: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit co-occurrences: %w", err)
+	}
+
+	total := engagement.RowsAffected() + purchase.RowsAffected()
+	r.logger.Info().
+		Int64("engagement_pairs", engagement.RowsAffected()).
+		Int64("purchase_pairs", purchase.RowsAffected()).
+		Dur("duration", time.Since(start)).
+		Msg("listing co-occurrences rebuilt")
+
+	return total, nil
+}
+
+// GetRelatedListings returns the strongest stored neighbors of each listing
+func (r *recommendationRepository) GetRelatedListings(ctx context.Context, kind string, listingIDs []int64, perListing int) ([]domain.RelatedListing, error) {
+	if len(listingIDs) == 0 {
+		return []domain.RelatedListing{}, nil
+	}
+
+	query := `
+		SELECT listing_id, related_listing_id, score
+		FROM (
+			SELECT c.listing_id, c.related_listing_id, c.score,
+			       ROW_NUMBER() OVER (PARTITION BY c.listing_id ORDER BY c.score DESC) AS rank
+			FROM listing_co_occurrences c
+			JOIN listings l ON l.id = c.related_listing_id
+			WHERE c.kind = $1
+			  AND c.listing_id = ANY($2)
+			  AND l.status = 'active' AND l.is_deleted = false
+		) neighbors
+		WHERE rank <= $3
+		ORDER BY listing_id, score DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, kind, listingIDs, perListing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related listings: %w", err)
+	}
+	defer rows.Close()
+
+	related := []domain.RelatedListing{}
+	for rows.Next() {
+		var item domain.RelatedListing
+		if err := rows.Scan(&item.ListingID, &item.RelatedListingID, &item.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan related listing: %w", err)
+		}
+		related = append(related, item)
+	}
+
+	return related, rows.Err()
+}
+
+// GetRecommendationSeeds returns the listings a visitor interacted with. A user's seeds come
+// from their events, favorites and orders; an anonymous session only has events.
+func (r *recommendationRepository) GetRecommendationSeeds(ctx context.Context, userID *int64, sessionID string, since time.Time, limit int) ([]domain.RecommendationSeed, error) {
+	if userID == nil && sessionID == "" {
+		return []domain.RecommendationSeed{}, nil
+	}
+
+	// $1 visitor, $2 since, $3 limit, $4 view weight, $5 favorite weight, $6 purchase weight (users only)
+	events := `
+		SELECT e.entity_id AS listing_id,
+		       CASE e.event_type WHEN 'view' THEN $4::FLOAT8 ELSE $5::FLOAT8 END AS weight,
+		       e.created_at
+		FROM analytics_events e
+		WHERE e.entity_type = 'listing'
+		  AND e.event_type IN ('view', 'favorite', 'add_to_cart')
+		  AND e.created_at >= $2
+	`
+
+	var interactions string
+	var args []interface{}
+	if userID != nil {
+		args = []interface{}{*userID, since, limit,
+			domain.InteractionWeightView, domain.InteractionWeightFavorite, domain.InteractionWeightPurchase}
+		interactions = events + ` AND e.user_id = $1
+		UNION ALL
+		SELECT f.listing_id, $5::FLOAT8, f.created_at
+		FROM listing_favorites f
+		WHERE f.user_id = $1 AND f.created_at >= $2
+		UNION ALL
+		SELECT oi.listing_id, $6::FLOAT8, o.created_at
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id
+		WHERE o.user_id = $1
+		  AND o.created_at >= $2
+		  AND o.status NOT IN ('cancelled', 'failed', 'refunded')`
+	} else {
+		args = []interface{}{sessionID, since, limit,
+			domain.InteractionWeightView, domain.InteractionWeightFavorite}
+		interactions = events + ` AND e.session_id = $1 AND e.user_id IS NULL`
+	}
+
+	query := fmt.Sprintf(`
+		WITH interactions AS (%s)
+		SELECT listing_id,
+		       MAX(weight * EXP(-EXTRACT(EPOCH FROM (NOW() - created_at)) / %d)) AS weight
+		FROM interactions
+		GROUP BY listing_id
+		ORDER BY weight DESC, listing_id
+		LIMIT $3
+	`, interactions, seedDecaySeconds)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendation seeds: %w", err)
+	}
+	defer rows.Close()
+
+	seeds := []domain.RecommendationSeed{}
+	for rows.Next() {
+		var seed domain.RecommendationSeed
+		if err := rows.Scan(&seed.ListingID, &seed.Weight); err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation seed: %w", err)
+		}
+		seeds = append(seeds, seed)
+	}
+
+	return seeds, rows.Err()
+}
+
+// GetTrendingListings ranks active listings by weighted engagement (views count 1, favorites
+// and cart additions count more)
+func (r *recommendationRepository) GetTrendingListings(ctx context.Context, since time.Time, limit int) ([]domain.Recommendation, error) {
+	query := `
+		SELECT e.entity_id,
+		       SUM(CASE e.event_type WHEN 'view' THEN $3::FLOAT8 ELSE $4::FLOAT8 END) AS score
+		FROM analytics_events e
+		JOIN listings l ON l.id = e.entity_id
+		WHERE e.entity_type = 'listing'
+		  AND e.event_type IN ('view', 'favorite', 'add_to_cart')
+		  AND e.created_at >= $1
+		  AND l.status = 'active' AND l.is_deleted = false
+		GROUP BY e.entity_id
+		ORDER BY score DESC, e.entity_id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, since, limit, domain.InteractionWeightView, domain.InteractionWeightFavorite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending listings: %w", err)
+	}
+	defer rows.Close()
+
+	trending := []domain.Recommendation{}
+	for rows.Next() {
+		item := domain.Recommendation{Source: domain.RecommendationSourceTrending}
+		if err := rows.Scan(&item.ListingID, &item.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan trending listing: %w", err)
+		}
+		trending = append(trending, item)
+	}
+
+	return trending, rows.Err()
+}
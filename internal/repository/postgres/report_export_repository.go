@@ -0,0 +1,398 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+// maxReportExportErrorLength bounds the error text stored per export
+const maxReportExportErrorLength = 2000
+
+// staleReportExportError is recorded for exports whose last attempt never finished
+const staleReportExportError = "report export did not finish"
+
+const reportExportColumns = `
+	id, requested_by, storefront_id, report_type, format, date_from, date_to,
+	status, retry_count, max_retries, error_message,
+	object_key, row_count, file_size,
+	created_at, updated_at, completed_at, expires_at
+`
+
+// reportQueries hold the data query of each report type.
+// Parameters: $1 storefront ID (NULL = platform-wide), $2 period start, $3 period end (exclusive).
+// Money is cast to FLOAT8 so that encoders get plain numbers.
+var reportQueries = map[domain.ReportType]string{
+	domain.ReportTypeOrders: `
+		SELECT o.id AS order_id,
+		       o.order_number,
+		       o.storefront_id,
+		       o.user_id AS buyer_id,
+		       o.status,
+		       o.payment_status,
+		       COALESCE(o.payment_method, '') AS payment_method,
+		       (SELECT COALESCE(SUM(oi.quantity), 0) FROM order_items oi WHERE oi.order_id = o.id) AS items,
+		       o.subtotal::FLOAT8 AS subtotal,
+		       COALESCE(o.tax, 0)::FLOAT8 AS tax,
+		       COALESCE(o.shipping, 0)::FLOAT8 AS shipping,
+		       COALESCE(o.discount, 0)::FLOAT8 AS discount,
+		       o.total::FLOAT8 AS total,
+		       COALESCE(o.commission, 0)::FLOAT8 AS commission,
+		       o.seller_amount::FLOAT8 AS seller_amount,
+		       COALESCE(o.currency, '') AS currency,
+		       COALESCE(o.shipping_provider, '') AS shipping_provider,
+		       COALESCE(o.tracking_number, '') AS tracking_number,
+		       o.created_at,
+		       o.cancelled_at
+		FROM orders o
+		WHERE ($1::BIGINT IS NULL OR o.storefront_id = $1)
+		  AND o.created_at >= $2 AND o.created_at < $3
+		ORDER BY o.created_at, o.id
+	`,
+	domain.ReportTypeProductPerformance: `
+		WITH scoped_listings AS (
+			SELECT id, storefront_id, title, sku, price, quantity, status
+			FROM listings
+			WHERE storefront_id IS NOT NULL
+			  AND ($1::BIGINT IS NULL OR storefront_id = $1)
+			  AND is_deleted = false
+		),
+		event_metrics AS (
+			SELECT entity_id AS listing_id,
+			       COUNT(*) FILTER (WHERE event_type = 'impression') AS impressions,
+			       COUNT(*) FILTER (WHERE event_type = 'view') AS views,
+			       COUNT(*) FILTER (WHERE event_type = 'favorite') AS favorites,
+			       COUNT(*) FILTER (WHERE event_type = 'add_to_cart') AS cart_adds
+			FROM analytics_events
+			WHERE entity_type = 'listing'
+			  AND entity_id IN (SELECT id FROM scoped_listings)
+			  AND created_at >= $2 AND created_at < $3
+			GROUP BY entity_id
+		),
+		sales AS (
+			SELECT oi.listing_id,
+			       COUNT(DISTINCT oi.order_id) AS orders,
+			       SUM(oi.quantity) AS units_sold,
+			       SUM(oi.total) AS revenue
+			FROM order_items oi
+			JOIN orders o ON o.id = oi.order_id
+			WHERE oi.listing_id IN (SELECT id FROM scoped_listings)
+			  AND o.status NOT IN ('cancelled', 'failed', 'refunded')
+			  AND o.created_at >= $2 AND o.created_at < $3
+			GROUP BY oi.listing_id
+		)
+		SELECT l.id AS listing_id,
+		       l.storefront_id,
+		       l.title,
+		       COALESCE(l.sku, '') AS sku,
+		       l.price::FLOAT8 AS price,
+		       l.quantity AS stock,
+		       l.status,
+		       COALESCE(em.impressions, 0) AS impressions,
+		       COALESCE(em.views, 0) AS views,
+		       COALESCE(em.favorites, 0) AS favorites,
+		       COALESCE(em.cart_adds, 0) AS cart_adds,
+		       COALESCE(s.orders, 0) AS orders,
+		       COALESCE(s.units_sold, 0) AS units_sold,
+		       COALESCE(s.revenue, 0)::FLOAT8 AS revenue,
+		       (CASE WHEN COALESCE(em.views, 0) > 0
+		             THEN ROUND(COALESCE(s.orders, 0) * 100.0 / em.views, 2)
+		             ELSE 0 END)::FLOAT8 AS conversion_rate
+		FROM scoped_listings l
+		LEFT JOIN event_metrics em ON em.listing_id = l.id
+		LEFT JOIN sales s ON s.listing_id = l.id
+		ORDER BY COALESCE(s.revenue, 0) DESC, COALESCE(em.views, 0) DESC, l.id
+	`,
+	domain.ReportTypeStockMovements: `
+		SELECT m.id AS movement_id,
+		       m.created_at,
+		       l.storefront_id,
+		       m.listing_id,
+		       l.title,
+		       m.variant_id,
+		       m.movement_type,
+		       m.quantity,
+		       COALESCE(m.reason, '') AS reason,
+		       COALESCE(m.notes, '') AS notes,
+		       m.user_id
+		FROM inventory_movements m
+		JOIN listings l ON l.id = m.listing_id
+		WHERE ($1::BIGINT IS NULL OR l.storefront_id = $1)
+		  AND m.created_at >= $2 AND m.created_at < $3
+		ORDER BY m.created_at, m.id
+	`,
+}
+
+// reportExportRepository implements repository.ReportExportRepository
+type reportExportRepository struct {
+	db     *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+// NewReportExportRepository creates a new report export repository
+func NewReportExportRepository(db *pgxpool.Pool, logger zerolog.Logger) repository.ReportExportRepository {
+	return &reportExportRepository{
+		db:     db,
+		logger: logger.With().Str("repository", "report_exports").Logger(),
+	}
+}
+
+// CreateReportExport queues a new export request
+func (r *reportExportRepository) CreateReportExport(ctx context.Context, input *domain.CreateReportExportInput) (*domain.ReportExport, error) {
+	query := `
+		INSERT INTO report_exports (requested_by, storefront_id, report_type, format, date_from, date_to)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + reportExportColumns
+
+	export, err := scanReportExport(r.db.QueryRow(ctx, query,
+		input.RequestedBy,
+		input.StorefrontID,
+		input.ReportType,
+		input.Format,
+		input.DateFrom,
+		input.DateTo,
+	))
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", input.RequestedBy).Msg("failed to create report export")
+		return nil, fmt.Errorf("failed to create report export: %w", err)
+	}
+
+	return export, nil
+}
+
+// GetReportExport returns an export by ID (nil if not found)
+func (r *reportExportRepository) GetReportExport(ctx context.Context, id int64) (*domain.ReportExport, error) {
+	query := `SELECT ` + reportExportColumns + ` FROM report_exports WHERE id = $1`
+
+	export, err := scanReportExport(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get report export: %w", err)
+	}
+
+	return export, nil
+}
+
+// CountActiveReportExports counts the pending and processing exports of a user
+func (r *reportExportRepository) CountActiveReportExports(ctx context.Context, userID int64) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM report_exports
+		WHERE requested_by = $1 AND status IN ('pending', 'processing')
+	`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count active report exports: %w", err)
+	}
+
+	return count, nil
+}
+
+// ClaimReportExports marks pending exports (oldest first) as processing. Exports stuck in
+// 'processing' for longer than staleAfter (crashed worker) are reclaimed; those without
+// retries left are failed.
+func (r *reportExportRepository) ClaimReportExports(ctx context.Context, limit int, staleAfter time.Duration) ([]*domain.ReportExport, error) {
+	exhaustedQuery := `
+		UPDATE report_exports
+		SET status = 'failed', error_message = $2
+		WHERE status = 'processing'
+		  AND retry_count >= max_retries
+		  AND updated_at < CURRENT_TIMESTAMP - make_interval(secs => $1)
+	`
+	if _, err := r.db.Exec(ctx, exhaustedQuery, staleAfter.Seconds(), staleReportExportError); err != nil {
+		r.logger.Error().Err(err).Msg("failed to fail exhausted report exports")
+		return nil, fmt.Errorf("failed to fail exhausted report exports: %w", err)
+	}
+
+	query := `
+		UPDATE report_exports
+		SET status = 'processing',
+		    -- A reclaimed export counts as a failed attempt
+		    retry_count = retry_count + CASE WHEN status = 'processing' THEN 1 ELSE 0 END
+		WHERE id IN (
+			SELECT id FROM report_exports
+			WHERE retry_count < max_retries
+			  AND (status = 'pending'
+			       OR (status = 'processing' AND updated_at < CURRENT_TIMESTAMP - make_interval(secs => $2)))
+			ORDER BY created_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + reportExportColumns
+
+	rows, err := r.db.Query(ctx, query, limit, staleAfter.Seconds())
+	if err != nil {
+		r.logger.Error().Err(err).Msg("failed to claim report exports")
+		return nil, fmt.Errorf("failed to claim report exports: %w", err)
+	}
+	defer rows.Close()
+
+	return scanReportExports(rows)
+}
+
+// CompleteReportExport stores the result of a rendered export
+func (r *reportExportRepository) CompleteReportExport(ctx context.Context, id int64, objectKey string, rowCount, fileSize int64, expiresAt time.Time) error {
+	query := `
+		UPDATE report_exports
+		SET status = 'completed', object_key = $2, row_count = $3, file_size = $4,
+		    error_message = NULL, completed_at = CURRENT_TIMESTAMP, expires_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, objectKey, rowCount, fileSize, expiresAt)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("export_id", id).Msg("failed to complete report export")
+		return fmt.Errorf("failed to complete report export: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("report export %d not found", id)
+	}
+
+	return nil
+}
+
+// FailReportExport records a failed attempt. The export goes back to 'pending' until
+// max_retries is reached; permanent failures fail immediately.
+func (r *reportExportRepository) FailReportExport(ctx context.Context, id int64, errorMsg string, permanent bool) (bool, error) {
+	errorMsg = truncateText(errorMsg, maxReportExportErrorLength)
+
+	query := `
+		UPDATE report_exports
+		SET retry_count = retry_count + 1,
+		    status = CASE WHEN $3 OR retry_count + 1 >= max_retries THEN 'failed' ELSE 'pending' END,
+		    error_message = $2
+		WHERE id = $1
+		RETURNING status
+	`
+
+	var status string
+	if err := r.db.QueryRow(ctx, query, id, errorMsg, permanent).Scan(&status); err != nil {
+		r.logger.Error().Err(err).Int64("export_id", id).Msg("failed to mark report export as failed")
+		return false, fmt.Errorf("failed to fail report export: %w", err)
+	}
+
+	return status == domain.ReportExportStatusFailed, nil
+}
+
+// StreamReportRows reads the rows of an export's report in order and passes them to w
+func (r *reportExportRepository) StreamReportRows(ctx context.Context, export *domain.ReportExport, w repository.ReportRowWriter) error {
+	query, ok := reportQueries[export.ReportType]
+	if !ok {
+		return fmt.Errorf("unknown report type: %q", export.ReportType)
+	}
+
+	rows, err := r.db.Query(ctx, query, export.StorefrontID, export.DateFrom, export.DateTo)
+	if err != nil {
+		return fmt.Errorf("failed to query %s report: %w", export.ReportType, err)
+	}
+	defer rows.Close()
+
+	fields := rows.FieldDescriptions()
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.Name
+	}
+	if err := w.WriteHeader(columns); err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return fmt.Errorf("failed to read %s report row: %w", export.ReportType, err)
+		}
+		if err := w.WriteRow(values); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s report: %w", export.ReportType, err)
+	}
+
+	return nil
+}
+
+// ListExpiredReportExports returns completed exports past their expiry and failed exports
+// last updated before failedBefore
+func (r *reportExportRepository) ListExpiredReportExports(ctx context.Context, failedBefore time.Time, limit int) ([]*domain.ReportExport, error) {
+	query := `
+		SELECT ` + reportExportColumns + `
+		FROM report_exports
+		WHERE (status = 'completed' AND expires_at < CURRENT_TIMESTAMP)
+		   OR (status = 'failed' AND updated_at < $1)
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, failedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired report exports: %w", err)
+	}
+	defer rows.Close()
+
+	return scanReportExports(rows)
+}
+
+// DeleteReportExport deletes an export record
+func (r *reportExportRepository) DeleteReportExport(ctx context.Context, id int64) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM report_exports WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete report export: %w", err)
+	}
+	return nil
+}
+
+// scanReportExport scans a row selected with reportExportColumns
+func scanReportExport(row pgx.Row) (*domain.ReportExport, error) {
+	var export domain.ReportExport
+	err := row.Scan(
+		&export.ID,
+		&export.RequestedBy,
+		&export.StorefrontID,
+		&export.ReportType,
+		&export.Format,
+		&export.DateFrom,
+		&export.DateTo,
+		&export.Status,
+		&export.RetryCount,
+		&export.MaxRetries,
+		&export.ErrorMessage,
+		&export.ObjectKey,
+		&export.RowCount,
+		&export.FileSize,
+		&export.CreatedAt,
+		&export.UpdatedAt,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// scanReportExports scans all rows selected with reportExportColumns
+func scanReportExports(rows pgx.Rows) ([]*domain.ReportExport, error) {
+	var exports []*domain.ReportExport
+	for rows.Next() {
+		export, err := scanReportExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read report exports: %w", err)
+	}
+	return exports, nil
+}
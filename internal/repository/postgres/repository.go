@@ -319,9 +319,10 @@ func (r *Repository) CreateListing(ctx context.Context, input *domain.CreateList
 func (r *Repository) GetListingByID(ctx context.Context, id int64) (*domain.Listing, error) {
 	query := `
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price, currency, category_id,
-		       status, visibility, quantity, sku, source_type, view_count, favorites_count,
+		       status, visibility, quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
 		       title_translations, description_translations, location_translations, city_translations, country_translations, original_language,
-		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
+		       expires_at, scheduled_publish_at, created_at, updated_at, published_at, deleted_at, is_deleted,
+		       ` + storefrontVerifiedColumn + `
 		FROM listings
 		WHERE id = $1 AND is_deleted = false
 	`
@@ -347,6 +348,8 @@ func (r *Repository) GetListingByID(ctx context.Context, id int64) (*domain.List
 		&listing.SourceType,
 		&listing.ViewsCount,
 		&listing.FavoritesCount,
+		&listing.Rating,
+		&listing.ReviewsCount,
 		&titleTranslationsJSON,
 		&descriptionTranslationsJSON,
 		&locationTranslationsJSON,
@@ -354,11 +357,13 @@ func (r *Repository) GetListingByID(ctx context.Context, id int64) (*domain.List
 		&countryTranslationsJSON,
 		&listing.OriginalLanguage,
 		&listing.ExpiresAt,
+		&listing.ScheduledAt,
 		&listing.CreatedAt,
 		&listing.UpdatedAt,
 		&listing.PublishedAt,
 		&listing.DeletedAt,
 		&listing.IsDeleted,
+		&listing.StorefrontVerified,
 	)
 
 	if err != nil {
@@ -412,7 +417,7 @@ func (r *Repository) GetListingByID(ctx context.Context, id int64) (*domain.List
 func (r *Repository) GetListingByUUID(ctx context.Context, uuid string) (*domain.Listing, error) {
 	query := `
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price, currency, category_id,
-		       status, visibility, quantity, sku, source_type, view_count, favorites_count,
+		       status, visibility, quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
 		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
 		FROM listings
 		WHERE uuid = $1::uuid AND is_deleted = false
@@ -435,7 +440,7 @@ func (r *Repository) GetListingByUUID(ctx context.Context, uuid string) (*domain
 func (r *Repository) GetListingBySlug(ctx context.Context, slug string) (*domain.Listing, error) {
 	query := `
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price, currency, category_id,
-		       status, visibility, quantity, sku, source_type, view_count, favorites_count,
+		       status, visibility, quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
 		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
 		FROM listings
 		WHERE slug = $1 AND is_deleted = false
@@ -480,11 +485,12 @@ func validateUpdateListingInput(input *domain.UpdateListingInput) error {
 
 	if input.Status != nil {
 		validStatuses := map[string]bool{
-			domain.StatusDraft:    true,
-			domain.StatusActive:   true,
-			domain.StatusInactive: true,
-			domain.StatusSold:     true,
-			domain.StatusArchived: true,
+			domain.StatusDraft:         true,
+			domain.StatusPendingReview: true,
+			domain.StatusActive:        true,
+			domain.StatusInactive:      true,
+			domain.StatusSold:          true,
+			domain.StatusArchived:      true,
 		}
 		if !validStatuses[*input.Status] {
 			return fmt.Errorf("invalid status: %s", *input.Status)
@@ -663,8 +669,9 @@ func (r *Repository) ListListings(ctx context.Context, filter *domain.ListListin
 	args = append(args, filter.Limit, filter.Offset)
 	query := fmt.Sprintf(`
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price, currency, category_id,
-		       status, visibility, quantity, sku, source_type, view_count, favorites_count,
-		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
+		       status, visibility, quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
+		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted,
+		       `+storefrontVerifiedColumn+`
 		FROM listings
 		WHERE %s
 		ORDER BY created_at DESC
@@ -731,7 +738,7 @@ func (r *Repository) SearchListings(ctx context.Context, query *domain.SearchLis
 	searchQuery := fmt.Sprintf(`
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price,
 		       currency, category_id, status, visibility,
-		       quantity, sku, source_type, view_count, favorites_count,
+		       quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
 		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
 		FROM listings
 		WHERE %s
@@ -887,7 +894,7 @@ func (r *Repository) GetListingsForReindex(ctx context.Context, limit int) ([]*d
 	query := `
 		SELECT id, uuid, slug, user_id, storefront_id, title, description, price,
 		       currency, category_id, status, visibility,
-		       quantity, sku, source_type, view_count, favorites_count,
+		       quantity, sku, source_type, view_count, favorites_count, rating, reviews_count,
 		       expires_at, created_at, updated_at, published_at, deleted_at, is_deleted
 		FROM listings
 		WHERE status = 'active' AND is_deleted = false
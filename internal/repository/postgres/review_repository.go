@@ -0,0 +1,500 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// FindVerifiedPurchase returns the most recent delivered order of the user that contains the
+// product (or, for storefront reviews, was placed at the storefront), or nil if there is none
+func (r *Repository) FindVerifiedPurchase(ctx context.Context, subjectType string, subjectID, userID int64) (*int64, error) {
+	var query string
+	switch subjectType {
+	case domain.ReviewSubjectProduct:
+		query = `
+			SELECT o.id
+			FROM orders o
+			JOIN order_items oi ON oi.order_id = o.id
+			WHERE o.user_id = $1 AND oi.listing_id = $2 AND o.status = 'delivered'
+			ORDER BY o.delivered_at DESC NULLS LAST, o.id DESC
+			LIMIT 1`
+	case domain.ReviewSubjectStorefront:
+		query = `
+			SELECT id
+			FROM orders
+			WHERE user_id = $1 AND storefront_id = $2 AND status = 'delivered'
+			ORDER BY delivered_at DESC NULLS LAST, id DESC
+			LIMIT 1`
+	default:
+		return nil, fmt.Errorf("invalid subject type: %s", subjectType)
+	}
+
+	var orderID int64
+	if err := r.db.GetContext(ctx, &orderID, query, userID, subjectID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find verified purchase: %w", err)
+	}
+
+	return &orderID, nil
+}
+
+// CreateReview creates a published review and adds its rating to the subject aggregate
+func (r *Repository) CreateReview(ctx context.Context, review *domain.Review) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			INSERT INTO reviews (subject_type, subject_id, storefront_id, user_id, order_id, is_verified_purchase, rating, title, body, status)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 'published')
+			RETURNING *`
+
+		err := tx.GetContext(ctx, review, query,
+			review.SubjectType, review.SubjectID, review.StorefrontID, review.UserID,
+			review.OrderID, review.IsVerifiedPurchase, review.Rating, review.Title, review.Body,
+		)
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+				return fmt.Errorf("review already exists")
+			}
+			return fmt.Errorf("failed to create review: %w", err)
+		}
+
+		var delta domain.RatingDelta
+		delta.Add(review.Rating, 1)
+		return r.applyRatingDelta(ctx, tx, review.SubjectType, review.SubjectID, &delta)
+	})
+}
+
+// GetReview retrieves a review by ID
+func (r *Repository) GetReview(ctx context.Context, id int64) (*domain.Review, error) {
+	query := `SELECT * FROM reviews WHERE id = $1`
+
+	var review domain.Review
+	if err := r.db.GetContext(ctx, &review, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("review not found")
+		}
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+
+	return &review, nil
+}
+
+// ListReviews lists the reviews of a subject and returns the total count matching the filter
+func (r *Repository) ListReviews(ctx context.Context, filter *domain.ReviewFilter) ([]domain.Review, int64, error) {
+	conditions := []string{"subject_type = $1", "subject_id = $2"}
+	args := []interface{}{filter.SubjectType, filter.SubjectID}
+	argIdx := 3
+
+	if !filter.IncludeHidden {
+		conditions = append(conditions, "status = 'published'")
+	}
+	if filter.Rating != nil {
+		conditions = append(conditions, fmt.Sprintf("rating = $%d", argIdx))
+		args = append(args, *filter.Rating)
+		argIdx++
+	}
+	if filter.VerifiedOnly {
+		conditions = append(conditions, "is_verified_purchase = true")
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int64
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM reviews WHERE %s`, whereClause)
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	orderBy := "created_at DESC"
+	switch filter.Sort {
+	case domain.ReviewSortHelpful:
+		orderBy = "helpful_count DESC, created_at DESC"
+	case domain.ReviewSortHighest:
+		orderBy = "rating DESC, created_at DESC"
+	case domain.ReviewSortLowest:
+		orderBy = "rating ASC, created_at DESC"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT * FROM reviews
+		WHERE %s
+		ORDER BY %s, id DESC
+		LIMIT $%d OFFSET $%d`, whereClause, orderBy, argIdx, argIdx+1)
+	args = append(args, filter.Limit, filter.Offset)
+
+	var reviews []domain.Review
+	if err := r.db.SelectContext(ctx, &reviews, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list reviews: %w", err)
+	}
+
+	return reviews, total, nil
+}
+
+// UpdateReview updates a review and moves a changed rating between aggregate buckets
+func (r *Repository) UpdateReview(ctx context.Context, id int64, update *domain.ReviewUpdate) (*domain.Review, error) {
+	var updated domain.Review
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		current, err := lockReview(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			UPDATE reviews
+			SET rating = COALESCE($2, rating),
+			    title = COALESCE($3, title),
+			    body = COALESCE($4, body)
+			WHERE id = $1
+			RETURNING *`
+		if err := tx.GetContext(ctx, &updated, query, id, update.Rating, update.Title, update.Body); err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+
+		if !current.IsPublished() || current.Rating == updated.Rating {
+			return nil
+		}
+		var delta domain.RatingDelta
+		delta.Add(current.Rating, -1)
+		delta.Add(updated.Rating, 1)
+		return r.applyRatingDelta(ctx, tx, current.SubjectType, current.SubjectID, &delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteReview deletes a review and removes its rating from the subject aggregate
+func (r *Repository) DeleteReview(ctx context.Context, id int64) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		current, err := lockReview(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM reviews WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("failed to delete review: %w", err)
+		}
+
+		if !current.IsPublished() {
+			return nil
+		}
+		var delta domain.RatingDelta
+		delta.Add(current.Rating, -1)
+		return r.applyRatingDelta(ctx, tx, current.SubjectType, current.SubjectID, &delta)
+	})
+}
+
+// SetReviewReply sets (or replaces) the seller reply of a review
+func (r *Repository) SetReviewReply(ctx context.Context, id, userID int64, reply string) (*domain.Review, error) {
+	query := `
+		UPDATE reviews
+		SET seller_reply = $2, seller_reply_by = $3, seller_replied_at = NOW()
+		WHERE id = $1
+		RETURNING *`
+
+	var review domain.Review
+	if err := r.db.GetContext(ctx, &review, query, id, reply, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("review not found")
+		}
+		return nil, fmt.Errorf("failed to set review reply: %w", err)
+	}
+
+	return &review, nil
+}
+
+// VoteReview records the user's helpfulness vote (replacing a previous one) and adjusts the
+// review's vote counters
+func (r *Repository) VoteReview(ctx context.Context, reviewID, userID int64, helpful bool) (*domain.Review, error) {
+	var updated domain.Review
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		// The review row lock serializes votes on the review, so the previous vote read below
+		// cannot change before the counters are adjusted
+		if _, err := lockReview(ctx, tx, reviewID); err != nil {
+			return err
+		}
+
+		var previous sql.NullBool
+		prevQuery := `SELECT helpful FROM review_votes WHERE review_id = $1 AND user_id = $2`
+		if err := tx.GetContext(ctx, &previous, prevQuery, reviewID, userID); err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to get review vote: %w", err)
+		}
+
+		upsertQuery := `
+			INSERT INTO review_votes (review_id, user_id, helpful)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (review_id, user_id) DO UPDATE SET helpful = EXCLUDED.helpful, created_at = NOW()`
+		if _, err := tx.ExecContext(ctx, upsertQuery, reviewID, userID, helpful); err != nil {
+			return fmt.Errorf("failed to save review vote: %w", err)
+		}
+
+		var helpfulDelta, notHelpfulDelta int32
+		if previous.Valid {
+			if previous.Bool == helpful {
+				return tx.GetContext(ctx, &updated, `SELECT * FROM reviews WHERE id = $1`, reviewID)
+			}
+			if previous.Bool {
+				helpfulDelta--
+			} else {
+				notHelpfulDelta--
+			}
+		}
+		if helpful {
+			helpfulDelta++
+		} else {
+			notHelpfulDelta++
+		}
+
+		countQuery := `
+			UPDATE reviews
+			SET helpful_count = helpful_count + $2, not_helpful_count = not_helpful_count + $3
+			WHERE id = $1
+			RETURNING *`
+		if err := tx.GetContext(ctx, &updated, countQuery, reviewID, helpfulDelta, notHelpfulDelta); err != nil {
+			return fmt.Errorf("failed to update review votes: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// ReportReview records an abuse report. A published review with autoHideReports or more open
+// reports is hidden and its rating removed from the aggregate. Returns the review after the report.
+func (r *Repository) ReportReview(ctx context.Context, report *domain.ReviewReport, autoHideReports int) (*domain.Review, error) {
+	var updated domain.Review
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		current, err := lockReview(ctx, tx, report.ReviewID)
+		if err != nil {
+			return err
+		}
+
+		insertQuery := `
+			INSERT INTO review_reports (review_id, user_id, reason, comment, status)
+			VALUES ($1, $2, $3, $4, 'open')
+			RETURNING *`
+		if err := tx.GetContext(ctx, report, insertQuery, report.ReviewID, report.UserID, report.Reason, report.Comment); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+				return fmt.Errorf("review already reported")
+			}
+			return fmt.Errorf("failed to create review report: %w", err)
+		}
+
+		var openReports int
+		openQuery := `SELECT COUNT(*) FROM review_reports WHERE review_id = $1 AND status = 'open'`
+		if err := tx.GetContext(ctx, &openReports, openQuery, report.ReviewID); err != nil {
+			return fmt.Errorf("failed to count review reports: %w", err)
+		}
+
+		hide := current.IsPublished() && autoHideReports > 0 && openReports >= autoHideReports
+		status := current.Status
+		if hide {
+			status = domain.ReviewStatusHidden
+		}
+
+		updateQuery := `
+			UPDATE reviews
+			SET reports_count = reports_count + 1, status = $2
+			WHERE id = $1
+			RETURNING *`
+		if err := tx.GetContext(ctx, &updated, updateQuery, report.ReviewID, status); err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+
+		if !hide {
+			return nil
+		}
+		var delta domain.RatingDelta
+		delta.Add(current.Rating, -1)
+		return r.applyRatingDelta(ctx, tx, current.SubjectType, current.SubjectID, &delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// ListReviewReports lists abuse reports, oldest first
+func (r *Repository) ListReviewReports(ctx context.Context, openOnly bool, limit, offset int) ([]domain.ReviewReport, int64, error) {
+	whereClause := "TRUE"
+	if openOnly {
+		whereClause = "status = 'open'"
+	}
+
+	var total int64
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM review_reports WHERE %s`, whereClause)
+	if err := r.db.GetContext(ctx, &total, countQuery); err != nil {
+		return nil, 0, fmt.Errorf("failed to count review reports: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT * FROM review_reports
+		WHERE %s
+		ORDER BY created_at ASC, id ASC
+		LIMIT $1 OFFSET $2`, whereClause)
+
+	var reports []domain.ReviewReport
+	if err := r.db.SelectContext(ctx, &reports, query, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list review reports: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+// SetReviewStatus publishes or hides a review, updates the subject aggregate and resolves the
+// open reports of the review (actioned when hidden, dismissed when published)
+func (r *Repository) SetReviewStatus(ctx context.Context, id int64, status string, moderatorID int64) (*domain.Review, error) {
+	var updated domain.Review
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		current, err := lockReview(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		updateQuery := `UPDATE reviews SET status = $2 WHERE id = $1 RETURNING *`
+		if err := tx.GetContext(ctx, &updated, updateQuery, id, status); err != nil {
+			return fmt.Errorf("failed to update review status: %w", err)
+		}
+
+		reportStatus := domain.ReviewReportStatusDismissed
+		if status == domain.ReviewStatusHidden {
+			reportStatus = domain.ReviewReportStatusActioned
+		}
+		resolveQuery := `
+			UPDATE review_reports
+			SET status = $2, resolved_by = $3, resolved_at = NOW()
+			WHERE review_id = $1 AND status = 'open'`
+		if _, err := tx.ExecContext(ctx, resolveQuery, id, reportStatus, moderatorID); err != nil {
+			return fmt.Errorf("failed to resolve review reports: %w", err)
+		}
+
+		if current.Status == status {
+			return nil
+		}
+		var delta domain.RatingDelta
+		if status == domain.ReviewStatusPublished {
+			delta.Add(current.Rating, 1)
+		} else {
+			delta.Add(current.Rating, -1)
+		}
+		return r.applyRatingDelta(ctx, tx, current.SubjectType, current.SubjectID, &delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// GetReviewSummary returns the rating aggregate of a subject (zero values without reviews)
+func (r *Repository) GetReviewSummary(ctx context.Context, subjectType string, subjectID int64) (*domain.ReviewSummary, error) {
+	query := `
+		SELECT reviews_count, rating_sum, rating_1, rating_2, rating_3, rating_4, rating_5
+		FROM review_aggregates
+		WHERE subject_type = $1 AND subject_id = $2`
+
+	summary := &domain.ReviewSummary{SubjectType: subjectType, SubjectID: subjectID}
+	err := r.db.QueryRowContext(ctx, query, subjectType, subjectID).Scan(
+		&summary.ReviewsCount,
+		&summary.RatingSum,
+		&summary.RatingCounts[0],
+		&summary.RatingCounts[1],
+		&summary.RatingCounts[2],
+		&summary.RatingCounts[3],
+		&summary.RatingCounts[4],
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get review summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// lockReview loads a review with a row lock for the rest of the transaction
+func lockReview(ctx context.Context, tx *sqlx.Tx, id int64) (*domain.Review, error) {
+	var review domain.Review
+	if err := tx.GetContext(ctx, &review, `SELECT * FROM reviews WHERE id = $1 FOR UPDATE`, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("review not found")
+		}
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+	return &review, nil
+}
+
+// applyRatingDelta applies an incremental change to the subject's rating aggregate and copies the
+// resulting average and count onto the listing or storefront. The upsert locks the aggregate row,
+// so concurrent changes to the same subject are applied one after another. Changed products are
+// queued for reindexing.
+func (r *Repository) applyRatingDelta(ctx context.Context, tx *sqlx.Tx, subjectType string, subjectID int64, delta *domain.RatingDelta) error {
+	if delta.IsZero() {
+		return nil
+	}
+
+	upsertQuery := `
+		INSERT INTO review_aggregates (subject_type, subject_id, reviews_count, rating_sum, rating_1, rating_2, rating_3, rating_4, rating_5)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (subject_type, subject_id) DO UPDATE SET
+			reviews_count = review_aggregates.reviews_count + EXCLUDED.reviews_count,
+			rating_sum = review_aggregates.rating_sum + EXCLUDED.rating_sum,
+			rating_1 = review_aggregates.rating_1 + EXCLUDED.rating_1,
+			rating_2 = review_aggregates.rating_2 + EXCLUDED.rating_2,
+			rating_3 = review_aggregates.rating_3 + EXCLUDED.rating_3,
+			rating_4 = review_aggregates.rating_4 + EXCLUDED.rating_4,
+			rating_5 = review_aggregates.rating_5 + EXCLUDED.rating_5,
+			updated_at = NOW()
+		RETURNING reviews_count, rating_sum`
+
+	summary := domain.ReviewSummary{SubjectType: subjectType, SubjectID: subjectID}
+	err := tx.QueryRowContext(ctx, upsertQuery,
+		subjectType, subjectID, delta.Count, delta.Sum,
+		delta.Buckets[0], delta.Buckets[1], delta.Buckets[2], delta.Buckets[3], delta.Buckets[4],
+	).Scan(&summary.ReviewsCount, &summary.RatingSum)
+	if err != nil {
+		return fmt.Errorf("failed to update review aggregate: %w", err)
+	}
+
+	var query string
+	switch subjectType {
+	case domain.ReviewSubjectProduct:
+		query = `UPDATE listings SET rating = $2, reviews_count = $3 WHERE id = $1`
+	case domain.ReviewSubjectStorefront:
+		query = `UPDATE storefronts SET rating = $2, reviews_count = $3 WHERE id = $1`
+	default:
+		return fmt.Errorf("invalid subject type: %s", subjectType)
+	}
+	if _, err := tx.ExecContext(ctx, query, subjectID, summary.Average(), summary.ReviewsCount); err != nil {
+		return fmt.Errorf("failed to update %s rating: %w", subjectType, err)
+	}
+
+	if subjectType != domain.ReviewSubjectProduct {
+		return nil
+	}
+
+	// Reindex the product so search can sort by rating
+	enqueueQuery := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		VALUES ($1, $2, 'pending', 0, 3)
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := tx.ExecContext(ctx, enqueueQuery, subjectID, domain.IndexOpUpdate); err != nil {
+		return fmt.Errorf("failed to enqueue indexing: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+const searchExperimentColumns = `
+	id, description, status, traffic_percent, variants,
+	started_at, stopped_at, created_at, updated_at
+`
+
+// searchExperimentsRepository implements repository.SearchExperimentsRepository
+type searchExperimentsRepository struct {
+	db     *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+// NewSearchExperimentsRepository creates a new search experiments repository
+func NewSearchExperimentsRepository(db *pgxpool.Pool, logger zerolog.Logger) repository.SearchExperimentsRepository {
+	return &searchExperimentsRepository{
+		db:     db,
+		logger: logger.With().Str("repository", "search_experiments").Logger(),
+	}
+}
+
+// CreateExperiment stores a new experiment in draft status (nil if the ID is taken)
+func (r *searchExperimentsRepository) CreateExperiment(
+	ctx context.Context,
+	input *domain.CreateSearchExperimentInput,
+) (*domain.SearchExperiment, error) {
+	variants, err := json.Marshal(input.Variants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode variants: %w", err)
+	}
+
+	query := `
+		INSERT INTO search_experiments (id, description, traffic_percent, variants)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO NOTHING
+		RETURNING ` + searchExperimentColumns
+
+	experiment, err := scanSearchExperiment(r.db.QueryRow(ctx, query,
+		input.ID,
+		input.Description,
+		input.TrafficPercent,
+		variants,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("experiment_id", input.ID).Msg("failed to create search experiment")
+		return nil, fmt.Errorf("failed to create search experiment: %w", err)
+	}
+
+	r.logger.Info().Str("experiment_id", experiment.ID).Int("variants", len(experiment.Variants)).Msg("search experiment created")
+
+	return experiment, nil
+}
+
+// GetExperiment returns an experiment by ID (nil if not found)
+func (r *searchExperimentsRepository) GetExperiment(ctx context.Context, id string) (*domain.SearchExperiment, error) {
+	query := `SELECT ` + searchExperimentColumns + ` FROM search_experiments WHERE id = $1`
+
+	experiment, err := scanSearchExperiment(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get search experiment: %w", err)
+	}
+
+	return experiment, nil
+}
+
+// ListExperiments returns experiments, optionally of one status, oldest started first
+func (r *searchExperimentsRepository) ListExperiments(
+	ctx context.Context,
+	status *domain.SearchExperimentStatus,
+) ([]*domain.SearchExperiment, error) {
+	query := `
+		SELECT ` + searchExperimentColumns + `
+		FROM search_experiments
+		WHERE ($1::TEXT IS NULL OR status = $1)
+		ORDER BY started_at ASC NULLS LAST, created_at ASC, id
+	`
+
+	var statusFilter *string
+	if status != nil {
+		value := string(*status)
+		statusFilter = &value
+	}
+
+	rows, err := r.db.Query(ctx, query, statusFilter)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("failed to list search experiments")
+		return nil, fmt.Errorf("failed to list search experiments: %w", err)
+	}
+	defer rows.Close()
+
+	experiments := []*domain.SearchExperiment{}
+	for rows.Next() {
+		experiment, err := scanSearchExperiment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search experiment: %w", err)
+		}
+		experiments = append(experiments, experiment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read search experiments: %w", err)
+	}
+
+	return experiments, nil
+}
+
+// UpdateExperimentStatus moves an experiment from one status to another. Starting records
+// started_at, stopping records stopped_at. Returns nil if the experiment is not in from.
+func (r *searchExperimentsRepository) UpdateExperimentStatus(
+	ctx context.Context,
+	id string,
+	from, to domain.SearchExperimentStatus,
+) (*domain.SearchExperiment, error) {
+	query := `
+		UPDATE search_experiments
+		SET status = $3,
+		    started_at = CASE WHEN $3 = 'running' THEN CURRENT_TIMESTAMP ELSE started_at END,
+		    stopped_at = CASE WHEN $3 = 'stopped' THEN CURRENT_TIMESTAMP ELSE stopped_at END
+		WHERE id = $1 AND status = $2
+		RETURNING ` + searchExperimentColumns
+
+	experiment, err := scanSearchExperiment(r.db.QueryRow(ctx, query, id, string(from), string(to)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("experiment_id", id).Msg("failed to update search experiment status")
+		return nil, fmt.Errorf("failed to update search experiment status: %w", err)
+	}
+
+	r.logger.Info().
+		Str("experiment_id", id).
+		Str("from", string(from)).
+		Str("to", string(to)).
+		Msg("search experiment status updated")
+
+	return experiment, nil
+}
+
+// GetExperimentArms aggregates the recorded searches of an experiment per variant.
+// A search converts when the same user orders a listing clicked from it within
+// domain.SearchConversionWindow; anonymous (session) searches cannot convert.
+func (r *searchExperimentsRepository) GetExperimentArms(
+	ctx context.Context,
+	id string,
+	from, to time.Time,
+) ([]domain.SearchExperimentArm, error) {
+	query := `
+		WITH searches AS (
+			SELECT id, experiment_variant, user_id
+			FROM search_queries
+			WHERE experiment_id = $1
+			  AND created_at >= $2 AND created_at < $3
+		),
+		clicks AS (
+			SELECT search_query_id, COUNT(*) AS clicks, SUM(position) AS position_sum
+			FROM search_clicks
+			WHERE search_query_id IN (SELECT id FROM searches)
+			GROUP BY search_query_id
+		),
+		conversions AS (
+			SELECT DISTINCT c.search_query_id
+			FROM search_clicks c
+			JOIN searches s ON s.id = c.search_query_id
+			JOIN orders o ON o.user_id = s.user_id
+			             AND o.created_at >= c.created_at
+			             AND o.created_at < c.created_at + make_interval(secs => $4)
+			             AND o.status NOT IN ('cancelled', 'failed', 'refunded')
+			JOIN order_items oi ON oi.order_id = o.id AND oi.listing_id = c.listing_id
+			WHERE s.user_id IS NOT NULL
+		)
+		SELECT
+			s.experiment_variant,
+			COUNT(*) AS searches,
+			COUNT(c.search_query_id) AS clicked_searches,
+			COALESCE(SUM(c.clicks), 0)::BIGINT AS clicks,
+			COUNT(v.search_query_id) AS converted_searches,
+			COALESCE(SUM(c.position_sum)::FLOAT8 / NULLIF(SUM(c.clicks), 0), 0) AS avg_click_position
+		FROM searches s
+		LEFT JOIN clicks c ON c.search_query_id = s.id
+		LEFT JOIN conversions v ON v.search_query_id = s.id
+		WHERE s.experiment_variant IS NOT NULL
+		GROUP BY s.experiment_variant
+	`
+
+	rows, err := r.db.Query(ctx, query, id, from, to, domain.SearchConversionWindow.Seconds())
+	if err != nil {
+		r.logger.Error().Err(err).Str("experiment_id", id).Msg("failed to fetch search experiment results")
+		return nil, fmt.Errorf("failed to fetch search experiment results: %w", err)
+	}
+	defer rows.Close()
+
+	arms := []domain.SearchExperimentArm{}
+	for rows.Next() {
+		var arm domain.SearchExperimentArm
+		err := rows.Scan(
+			&arm.Variant,
+			&arm.Searches,
+			&arm.ClickedSearches,
+			&arm.Clicks,
+			&arm.ConvertedSearches,
+			&arm.AvgClickPosition,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search experiment results: %w", err)
+		}
+		arms = append(arms, arm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search experiment results: %w", err)
+	}
+
+	return arms, nil
+}
+
+// scanSearchExperiment scans a row selected with searchExperimentColumns
+func scanSearchExperiment(row pgx.Row) (*domain.SearchExperiment, error) {
+	var experiment domain.SearchExperiment
+	var variants []byte
+	err := row.Scan(
+		&experiment.ID,
+		&experiment.Description,
+		&experiment.Status,
+		&experiment.TrafficPercent,
+		&variants,
+		&experiment.StartedAt,
+		&experiment.StoppedAt,
+		&experiment.CreatedAt,
+		&experiment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(variants, &experiment.Variants); err != nil {
+		return nil, fmt.Errorf("failed to decode variants of experiment %s: %w", experiment.ID, err)
+	}
+	return &experiment, nil
+}
@@ -48,9 +48,11 @@ func (r *searchQueriesRepository) CreateSearchQuery(
 			user_id,
 			session_id,
 			results_count,
-			clicked_listing_id
+			clicked_listing_id,
+			experiment_id,
+			experiment_variant
 		) VALUES (
-			$1, $2, $3, $4, $5, $6
+			$1, $2, $3, $4, $5, $6, $7, $8
 		)
 		RETURNING id, query_text, category_id, user_id, session_id,
 		          results_count, clicked_listing_id, created_at
@@ -66,6 +68,8 @@ func (r *searchQueriesRepository) CreateSearchQuery(
 		input.SessionID,
 		input.ResultsCount,
 		input.ClickedListingID,
+		input.ExperimentID,
+		input.ExperimentVariant,
 	).Scan(
 		&searchQuery.ID,
 		&searchQuery.QueryText,
@@ -429,7 +433,6 @@ func (r *searchQueriesRepository) GetCTRAnalysis(
 			query_text,
 			COUNT(*) as total_searches,
 			COUNT(clicked_listing_id) as total_clicks,
-			ROUND(100.0 * COUNT(clicked_listing_id) / NULLIF(COUNT(*), 0), 2) as ctr_percent,
 			category_id
 		FROM search_queries
 		WHERE created_at > NOW() - INTERVAL '%d days'
@@ -449,7 +452,7 @@ func (r *searchQueriesRepository) GetCTRAnalysis(
 	// Group and order
 	query += `
 		GROUP BY query_text, category_id
-		ORDER BY total_searches DESC, ctr_percent DESC
+		ORDER BY total_searches DESC, COUNT(clicked_listing_id)::FLOAT8 / COUNT(*) DESC
 		LIMIT $1
 	`
 
@@ -476,7 +479,6 @@ func (r *searchQueriesRepository) GetCTRAnalysis(
 			&ctr.QueryText,
 			&ctr.TotalSearches,
 			&ctr.TotalClicks,
-			&ctr.CTRPercent,
 			&ctr.CategoryID,
 		)
 		if err != nil {
@@ -485,6 +487,7 @@ func (r *searchQueriesRepository) GetCTRAnalysis(
 				Msg("failed to scan CTR analysis row")
 			return nil, fmt.Errorf("failed to scan CTR analysis: %w", err)
 		}
+		ctr.CTRPercent = domain.CalculateCTR(ctr.TotalSearches, ctr.TotalClicks)
 		results = append(results, ctr)
 	}
 
@@ -499,6 +502,199 @@ func (r *searchQueriesRepository) GetCTRAnalysis(
 	return results, nil
 }
 
+// ============================================================================
+// SEARCH CLICKS (Position Tracking)
+// ============================================================================
+
+// RecordSearchClick records a click on a search result with its position and the experiment
+// arm that served the search. The first click also fills search_queries.clicked_listing_id.
+// Returns false if the search is unknown, was run by another user or session, is older than
+// domain.SearchClickWindow, or the listing was already clicked from it.
+func (r *searchQueriesRepository) RecordSearchClick(
+	ctx context.Context,
+	input *domain.RecordSearchClickInput,
+) (bool, error) {
+	if err := input.Validate(); err != nil {
+		return false, fmt.Errorf("invalid input: %w", err)
+	}
+
+	query := `
+		WITH search AS (
+			UPDATE search_queries
+			SET clicked_listing_id = COALESCE(clicked_listing_id, $2)
+			WHERE id = $1
+			  AND created_at > $4
+			  AND (user_id = $5 OR session_id = $6)
+			RETURNING id, experiment_id, experiment_variant
+		)
+		INSERT INTO search_clicks (search_query_id, listing_id, position, experiment_id, experiment_variant)
+		SELECT id, $2, $3, experiment_id, experiment_variant FROM search
+		ON CONFLICT (search_query_id, listing_id) DO NOTHING
+	`
+
+	clickWindowStart := time.Now().Add(-domain.SearchClickWindow)
+	result, err := r.db.Exec(ctx, query, input.SearchQueryID, input.ListingID, input.Position, clickWindowStart, input.UserID, input.SessionID)
+	if err != nil {
+		r.logger.Error().
+			Err(err).
+			Int64("search_query_id", input.SearchQueryID).
+			Int64("listing_id", input.ListingID).
+			Msg("failed to record search click")
+		return false, fmt.Errorf("failed to record search click: %w", err)
+	}
+
+	recorded := result.RowsAffected() > 0
+
+	r.logger.Debug().
+		Int64("search_query_id", input.SearchQueryID).
+		Int64("listing_id", input.ListingID).
+		Int32("position", input.Position).
+		Bool("recorded", recorded).
+		Msg("search click processed")
+
+	return recorded, nil
+}
+
+// ============================================================================
+// SEARCH QUALITY REPORT
+// ============================================================================
+
+// GetSearchQueryReport aggregates searches and clicks per query (case-insensitive) for the
+// zero-result, low-result and top-queries reports
+func (r *searchQueriesRepository) GetSearchQueryReport(
+	ctx context.Context,
+	filter *domain.GetSearchQueryReportFilter,
+) (*domain.SearchQueryReport, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	periodEnd := time.Now()
+	periodStart := periodEnd.AddDate(0, 0, -int(filter.DaysAgo))
+
+	// Zero- and low-result reports only look at the searches at or under the threshold
+	var maxResults *int32
+	switch filter.Type {
+	case domain.SearchQueryReportZeroResults:
+		zero := int32(0)
+		maxResults = &zero
+	case domain.SearchQueryReportLowResults:
+		maxResults = &filter.MaxResults
+	}
+
+	query := `
+		WITH scoped AS (
+			SELECT id, LOWER(TRIM(query_text)) AS query_text, results_count,
+			       clicked_listing_id, created_at
+			FROM search_queries
+			WHERE created_at >= $1
+			  AND ($2::BIGINT IS NULL OR category_id = $2)
+			  AND ($3::INTEGER IS NULL OR results_count <= $3)
+		),
+		clicks AS (
+			SELECT search_query_id, COUNT(*) AS clicks, SUM(position) AS position_sum
+			FROM search_clicks
+			WHERE search_query_id IN (SELECT id FROM scoped)
+			GROUP BY search_query_id
+		)
+		SELECT
+			s.query_text,
+			COUNT(*) AS total_searches,
+			COUNT(*) FILTER (WHERE s.results_count = 0) AS zero_result_searches,
+			AVG(s.results_count)::FLOAT8 AS avg_results_count,
+			COUNT(s.clicked_listing_id) AS clicked_searches,
+			COALESCE(SUM(c.clicks), 0)::BIGINT AS total_clicks,
+			COALESCE(SUM(c.position_sum)::FLOAT8 / NULLIF(SUM(c.clicks), 0), 0) AS avg_click_position,
+			MAX(s.created_at) AS last_searched
+		FROM scoped s
+		LEFT JOIN clicks c ON c.search_query_id = s.id
+		GROUP BY s.query_text
+		HAVING COUNT(*) >= $4
+		ORDER BY total_searches DESC, s.query_text
+		LIMIT $5
+	`
+
+	r.logger.Debug().
+		Str("type", string(filter.Type)).
+		Interface("category_id", filter.CategoryID).
+		Int32("days_ago", filter.DaysAgo).
+		Int32("limit", filter.Limit).
+		Msg("fetching search query report")
+
+	rows, err := r.db.Query(ctx, query, periodStart, filter.CategoryID, maxResults, filter.MinSearches, filter.Limit)
+	if err != nil {
+		r.logger.Error().
+			Err(err).
+			Str("type", string(filter.Type)).
+			Msg("failed to fetch search query report")
+		return nil, fmt.Errorf("failed to fetch search query report: %w", err)
+	}
+	defer rows.Close()
+
+	report := &domain.SearchQueryReport{
+		Type:        filter.Type,
+		Queries:     []domain.SearchQueryPerformance{},
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+	}
+	for rows.Next() {
+		var p domain.SearchQueryPerformance
+		err := rows.Scan(
+			&p.QueryText,
+			&p.TotalSearches,
+			&p.ZeroResultSearches,
+			&p.AvgResultsCount,
+			&p.ClickedSearches,
+			&p.TotalClicks,
+			&p.AvgClickPosition,
+			&p.LastSearched,
+		)
+		if err != nil {
+			r.logger.Error().
+				Err(err).
+				Msg("failed to scan search query report row")
+			return nil, fmt.Errorf("failed to scan search query report: %w", err)
+		}
+		report.Queries = append(report.Queries, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search query report: %w", err)
+	}
+
+	// Totals cover every search of the period so rates can be compared between reports
+	totalsQuery := `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE results_count = 0),
+			COUNT(clicked_listing_id)
+		FROM search_queries
+		WHERE created_at >= $1
+		  AND ($2::BIGINT IS NULL OR category_id = $2)
+	`
+
+	err = r.db.QueryRow(ctx, totalsQuery, periodStart, filter.CategoryID).Scan(
+		&report.TotalSearches,
+		&report.ZeroResultSearches,
+		&report.ClickedSearches,
+	)
+	if err != nil {
+		r.logger.Error().
+			Err(err).
+			Msg("failed to fetch search totals")
+		return nil, fmt.Errorf("failed to fetch search totals: %w", err)
+	}
+
+	report.EnrichWithCalculatedFields()
+
+	r.logger.Info().
+		Str("type", string(filter.Type)).
+		Int("count", len(report.Queries)).
+		Msg("search query report fetched")
+
+	return report, nil
+}
+
 // ============================================================================
 // CLEANUP OLD QUERIES (Retention Policy)
 // ============================================================================
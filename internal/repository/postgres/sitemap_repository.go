@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// sitemapStorefrontFilter selects storefronts with public pages. Sellers opt out of search
+// engines with seo_meta {"noindex": true}.
+const sitemapStorefrontFilter = `s.is_active = true AND s.deleted_at IS NULL
+	AND COALESCE(s.seo_meta->>'noindex', 'false') <> 'true'`
+
+// sitemapListingsFrom selects public listings; storefront products only while their
+// storefront is listed
+const sitemapListingsFrom = `
+	FROM listings l
+	LEFT JOIN storefronts s ON s.id = l.storefront_id
+	WHERE l.status = 'active' AND l.visibility = 'public' AND l.is_deleted = false
+	  AND (l.storefront_id IS NULL OR (` + sitemapStorefrontFilter + `))`
+
+// CountSitemapCategories counts active categories
+func (r *Repository) CountSitemapCategories(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM categories WHERE is_active = true`); err != nil {
+		return 0, fmt.Errorf("failed to count sitemap categories: %w", err)
+	}
+	return count, nil
+}
+
+// ListSitemapCategories lists active categories in id order
+func (r *Repository) ListSitemapCategories(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error) {
+	query := `SELECT id, slug FROM categories WHERE is_active = true ORDER BY id LIMIT $1 OFFSET $2`
+
+	var items []domain.SitemapItem
+	if err := r.db.SelectContext(ctx, &items, query, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list sitemap categories: %w", err)
+	}
+	return items, nil
+}
+
+// CountSitemapStorefronts counts storefronts with public pages
+func (r *Repository) CountSitemapStorefronts(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM storefronts s WHERE ` + sitemapStorefrontFilter
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query); err != nil {
+		return 0, fmt.Errorf("failed to count sitemap storefronts: %w", err)
+	}
+	return count, nil
+}
+
+// ListSitemapStorefronts lists storefronts with public pages in id order
+func (r *Repository) ListSitemapStorefronts(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error) {
+	query := `
+		SELECT s.id, s.slug, s.updated_at
+		FROM storefronts s
+		WHERE ` + sitemapStorefrontFilter + `
+		ORDER BY s.id
+		LIMIT $1 OFFSET $2`
+
+	var items []domain.SitemapItem
+	if err := r.db.SelectContext(ctx, &items, query, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list sitemap storefronts: %w", err)
+	}
+	return items, nil
+}
+
+// CountSitemapListings counts public listings, optionally of one storefront
+func (r *Repository) CountSitemapListings(ctx context.Context, storefrontID *int64) (int, error) {
+	query := `SELECT COUNT(*) ` + sitemapListingsFrom
+	args := []interface{}{}
+	if storefrontID != nil {
+		query += ` AND l.storefront_id = $1`
+		args = append(args, *storefrontID)
+	}
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to count sitemap listings: %w", err)
+	}
+	return count, nil
+}
+
+// ListSitemapListings lists public listings in id order, optionally of one storefront
+func (r *Repository) ListSitemapListings(ctx context.Context, storefrontID *int64, offset, limit int) ([]domain.SitemapItem, error) {
+	args := []interface{}{}
+	storefrontClause := ""
+	if storefrontID != nil {
+		args = append(args, *storefrontID)
+		storefrontClause = fmt.Sprintf(` AND l.storefront_id = $%d`, len(args))
+	}
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+		SELECT l.id, COALESCE(l.slug, '') AS slug, s.slug AS storefront_slug, l.updated_at
+		%s%s
+		ORDER BY l.id
+		LIMIT $%d OFFSET $%d`, sitemapListingsFrom, storefrontClause, len(args)-1, len(args))
+
+	var items []domain.SitemapItem
+	if err := r.db.SelectContext(ctx, &items, query, args...); err != nil {
+		r.logger.Error().Err(err).Msg("failed to list sitemap listings")
+		return nil, fmt.Errorf("failed to list sitemap listings: %w", err)
+	}
+	return items, nil
+}
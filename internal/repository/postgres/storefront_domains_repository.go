@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const storefrontDomainColumns = `id, storefront_id, domain, is_primary, verification_token, verified_at, created_at, updated_at`
+
+// CreateStorefrontDomain adds an unverified custom domain to a storefront. Other storefronts
+// may claim the same domain until one of them verifies it.
+func (r *Repository) CreateStorefrontDomain(ctx context.Context, storefrontID int64, host, token string) (*domain.StorefrontDomain, error) {
+	query := `
+		INSERT INTO storefront_domains (storefront_id, domain, verification_token)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS (
+			SELECT 1 FROM storefront_domains
+			WHERE domain = $2 AND verified_at IS NOT NULL
+		)
+		RETURNING ` + storefrontDomainColumns
+
+	var d domain.StorefrontDomain
+	if err := r.db.GetContext(ctx, &d, query, storefrontID, host, token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("domain '%s' is already in use", host)
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23505" { // unique_violation: already claimed by this storefront
+				return nil, fmt.Errorf("domain '%s' is already in use", host)
+			}
+		}
+		r.logger.Error().Err(err).Msg("failed to create storefront domain")
+		return nil, fmt.Errorf("failed to create storefront domain: %w", err)
+	}
+
+	return &d, nil
+}
+
+// GetStorefrontDomain retrieves a custom domain of a storefront
+func (r *Repository) GetStorefrontDomain(ctx context.Context, storefrontID, domainID int64) (*domain.StorefrontDomain, error) {
+	query := `SELECT ` + storefrontDomainColumns + ` FROM storefront_domains WHERE id = $1 AND storefront_id = $2`
+
+	var d domain.StorefrontDomain
+	if err := r.db.GetContext(ctx, &d, query, domainID, storefrontID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("domain not found")
+		}
+		return nil, fmt.Errorf("failed to get storefront domain: %w", err)
+	}
+
+	return &d, nil
+}
+
+// ListStorefrontDomains lists the custom domains of a storefront, primary first
+func (r *Repository) ListStorefrontDomains(ctx context.Context, storefrontID int64) ([]domain.StorefrontDomain, error) {
+	query := `
+		SELECT ` + storefrontDomainColumns + `
+		FROM storefront_domains
+		WHERE storefront_id = $1
+		ORDER BY is_primary DESC, created_at`
+
+	var domains []domain.StorefrontDomain
+	if err := r.db.SelectContext(ctx, &domains, query, storefrontID); err != nil {
+		return nil, fmt.Errorf("failed to list storefront domains: %w", err)
+	}
+
+	return domains, nil
+}
+
+// MarkStorefrontDomainVerified records DNS verification of a domain. The domain becomes
+// primary when the storefront has no primary domain yet. Unverified claims of the same domain
+// by other storefronts are removed; fails if another storefront verified it first.
+func (r *Repository) MarkStorefrontDomainVerified(ctx context.Context, storefrontID, domainID int64) (*domain.StorefrontDomain, error) {
+	var d domain.StorefrontDomain
+
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			UPDATE storefront_domains
+			SET verified_at = COALESCE(verified_at, CURRENT_TIMESTAMP),
+			    is_primary = is_primary OR NOT EXISTS (
+			        SELECT 1 FROM storefront_domains p
+			        WHERE p.storefront_id = $2 AND p.is_primary AND p.id <> $1
+			    )
+			WHERE id = $1 AND storefront_id = $2
+			RETURNING ` + storefrontDomainColumns
+
+		if err := tx.GetContext(ctx, &d, query, domainID, storefrontID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("domain not found")
+			}
+			if pqErr, ok := err.(*pq.Error); ok {
+				if pqErr.Code == "23505" { // unique_violation: verified by another storefront
+					return fmt.Errorf("domain is already in use")
+				}
+			}
+			return fmt.Errorf("failed to mark domain verified: %w", err)
+		}
+
+		claimsQuery := `DELETE FROM storefront_domains WHERE domain = $1 AND id <> $2 AND verified_at IS NULL`
+		if _, err := tx.ExecContext(ctx, claimsQuery, d.Domain, d.ID); err != nil {
+			return fmt.Errorf("failed to remove competing domain claims: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// DeleteStorefrontDomain removes a custom domain. When the primary domain is removed the
+// oldest remaining verified domain is promoted.
+func (r *Repository) DeleteStorefrontDomain(ctx context.Context, storefrontID, domainID int64) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var wasPrimary bool
+		deleteQuery := `DELETE FROM storefront_domains WHERE id = $1 AND storefront_id = $2 RETURNING is_primary`
+		if err := tx.GetContext(ctx, &wasPrimary, deleteQuery, domainID, storefrontID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("domain not found")
+			}
+			return fmt.Errorf("failed to delete storefront domain: %w", err)
+		}
+
+		if !wasPrimary {
+			return nil
+		}
+
+		promoteQuery := `
+			UPDATE storefront_domains
+			SET is_primary = true
+			WHERE id = (
+				SELECT id FROM storefront_domains
+				WHERE storefront_id = $1 AND verified_at IS NOT NULL
+				ORDER BY verified_at, id
+				LIMIT 1
+			)`
+		if _, err := tx.ExecContext(ctx, promoteQuery, storefrontID); err != nil {
+			return fmt.Errorf("failed to promote primary domain: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ResolveStorefrontDomain finds the active storefront a verified custom domain points to
+func (r *Repository) ResolveStorefrontDomain(ctx context.Context, host string) (*domain.StorefrontDomain, error) {
+	query := `
+		SELECT d.id, d.storefront_id, d.domain, d.is_primary, d.verification_token, d.verified_at,
+		       d.created_at, d.updated_at, s.slug AS storefront_slug
+		FROM storefront_domains d
+		JOIN storefronts s ON s.id = d.storefront_id
+		WHERE d.domain = $1
+		  AND d.verified_at IS NOT NULL
+		  AND s.is_active = true AND s.deleted_at IS NULL`
+
+	var d domain.StorefrontDomain
+	if err := r.db.GetContext(ctx, &d, query, host); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("domain not found")
+		}
+		return nil, fmt.Errorf("failed to resolve storefront domain: %w", err)
+	}
+
+	return &d, nil
+}
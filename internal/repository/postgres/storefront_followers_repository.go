@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// FollowStorefront makes the user follow the storefront and returns the storefront's follower
+// count. Following twice is a no-op: the counter is only incremented when a row is inserted.
+func (r *Repository) FollowStorefront(ctx context.Context, storefrontID, userID int64) (int32, error) {
+	var count int32
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		insertQuery := `
+			INSERT INTO storefront_followers (storefront_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT (storefront_id, user_id) DO NOTHING`
+		result, err := tx.ExecContext(ctx, insertQuery, storefrontID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to follow storefront: %w", err)
+		}
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to follow storefront: %w", err)
+		}
+
+		return adjustFollowersCount(ctx, tx, storefrontID, int32(inserted), &count)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UnfollowStorefront removes the user's follow and returns the storefront's follower count.
+// Unfollowing a storefront that is not followed is a no-op.
+func (r *Repository) UnfollowStorefront(ctx context.Context, storefrontID, userID int64) (int32, error) {
+	var count int32
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		deleteQuery := `DELETE FROM storefront_followers WHERE storefront_id = $1 AND user_id = $2`
+		result, err := tx.ExecContext(ctx, deleteQuery, storefrontID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to unfollow storefront: %w", err)
+		}
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to unfollow storefront: %w", err)
+		}
+
+		return adjustFollowersCount(ctx, tx, storefrontID, -int32(deleted), &count)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// IsFollowingStorefront reports whether the user follows the storefront
+func (r *Repository) IsFollowingStorefront(ctx context.Context, storefrontID, userID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM storefront_followers WHERE storefront_id = $1 AND user_id = $2)`
+
+	var following bool
+	if err := r.db.GetContext(ctx, &following, query, storefrontID, userID); err != nil {
+		return false, fmt.Errorf("failed to check storefront follow: %w", err)
+	}
+
+	return following, nil
+}
+
+// ListStorefrontFollowers lists the followers of a storefront, most recent first
+func (r *Repository) ListStorefrontFollowers(ctx context.Context, storefrontID int64, limit, offset int) ([]domain.StorefrontFollower, int64, error) {
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM storefront_followers WHERE storefront_id = $1`
+	if err := r.db.GetContext(ctx, &total, countQuery, storefrontID); err != nil {
+		return nil, 0, fmt.Errorf("failed to count storefront followers: %w", err)
+	}
+
+	query := `
+		SELECT storefront_id, user_id, created_at
+		FROM storefront_followers
+		WHERE storefront_id = $1
+		ORDER BY created_at DESC, user_id DESC
+		LIMIT $2 OFFSET $3`
+
+	var followers []domain.StorefrontFollower
+	if err := r.db.SelectContext(ctx, &followers, query, storefrontID, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list storefront followers: %w", err)
+	}
+
+	return followers, total, nil
+}
+
+// GetFollowingFeed returns active products of the storefronts the user follows, newest first,
+// starting after the filter's cursor
+func (r *Repository) GetFollowingFeed(ctx context.Context, filter *domain.FollowingFeedFilter) ([]*domain.Listing, error) {
+	args := []interface{}{filter.UserID}
+	cursorClause := ""
+	if filter.After != nil {
+		cursorClause = "AND (l.created_at, l.id) < ($2, $3)"
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+	}
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT l.id, l.uuid, l.slug, l.user_id, l.storefront_id, l.title, l.description, l.price, l.currency, l.category_id,
+		       l.status, l.visibility, l.quantity, l.sku, l.source_type, l.view_count, l.favorites_count, l.rating, l.reviews_count,
+		       l.expires_at, l.created_at, l.updated_at, l.published_at, l.deleted_at, l.is_deleted
+		FROM listings l
+		JOIN storefront_followers f ON f.storefront_id = l.storefront_id
+		WHERE f.user_id = $1
+		  AND l.status = 'active' AND l.visibility = 'public' AND l.is_deleted = false
+		  %s
+		ORDER BY l.created_at DESC, l.id DESC
+		LIMIT $%d`, cursorClause, len(args))
+
+	var listings []*domain.Listing
+	if err := r.db.SelectContext(ctx, &listings, query, args...); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", filter.UserID).Msg("failed to get following feed")
+		return nil, fmt.Errorf("failed to get following feed: %w", err)
+	}
+
+	return listings, nil
+}
+
+// adjustFollowersCount applies a follower count change and reads the resulting count. The
+// relative update is atomic, so concurrent follows and unfollows do not lose increments.
+func adjustFollowersCount(ctx context.Context, tx *sqlx.Tx, storefrontID int64, delta int32, count *int32) error {
+	if delta == 0 {
+		query := `SELECT COALESCE(followers_count, 0) FROM storefronts WHERE id = $1`
+		if err := tx.GetContext(ctx, count, query, storefrontID); err != nil {
+			return fmt.Errorf("failed to get followers count: %w", err)
+		}
+		return nil
+	}
+
+	query := `
+		UPDATE storefronts
+		SET followers_count = GREATEST(COALESCE(followers_count, 0) + $2, 0)
+		WHERE id = $1
+		RETURNING followers_count`
+	if err := tx.GetContext(ctx, count, query, storefrontID, delta); err != nil {
+		return fmt.Errorf("failed to update followers count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// GetStaffMember returns the staff membership of a user, or nil if the user is not a staff member
+func (r *Repository) GetStaffMember(ctx context.Context, storefrontID, userID int64) (*domain.StorefrontStaff, error) {
+	query := `SELECT * FROM storefront_staff WHERE storefront_id = $1 AND user_id = $2`
+
+	var staff domain.StorefrontStaff
+	if err := r.db.GetContext(ctx, &staff, query, storefrontID, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get staff member: %w", err)
+	}
+
+	return &staff, nil
+}
+
+// GetStaffByID retrieves a staff member by ID
+func (r *Repository) GetStaffByID(ctx context.Context, id int64) (*domain.StorefrontStaff, error) {
+	query := `SELECT * FROM storefront_staff WHERE id = $1`
+
+	var staff domain.StorefrontStaff
+	if err := r.db.GetContext(ctx, &staff, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("staff not found")
+		}
+		return nil, fmt.Errorf("failed to get staff: %w", err)
+	}
+
+	return &staff, nil
+}
+
+// CreateStaffInvitation creates a pending invitation. An expired pending invitation for the
+// same user is marked expired first; an open one is reported as a duplicate.
+func (r *Repository) CreateStaffInvitation(ctx context.Context, invitation *domain.StaffInvitation) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	expireQuery := `
+		UPDATE storefront_staff_invitations
+		SET status = 'expired'
+		WHERE storefront_id = $1 AND user_id = $2 AND status = 'pending' AND expires_at <= NOW()`
+	if _, err := tx.ExecContext(ctx, expireQuery, invitation.StorefrontID, invitation.UserID); err != nil {
+		return fmt.Errorf("failed to expire previous invitation: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO storefront_staff_invitations (storefront_id, user_id, role, permissions, invited_by, status, expires_at)
+		VALUES ($1, $2, $3, COALESCE($4, '{}'::JSONB), $5, 'pending', $6)
+		RETURNING id, status, created_at, updated_at`
+
+	err = tx.QueryRowContext(ctx, insertQuery,
+		invitation.StorefrontID, invitation.UserID, invitation.Role, invitation.Permissions,
+		invitation.InvitedBy, invitation.ExpiresAt,
+	).Scan(&invitation.ID, &invitation.Status, &invitation.CreatedAt, &invitation.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+			return fmt.Errorf("staff invitation already pending")
+		}
+		return fmt.Errorf("failed to create staff invitation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetStaffInvitation retrieves an invitation by ID
+func (r *Repository) GetStaffInvitation(ctx context.Context, id int64) (*domain.StaffInvitation, error) {
+	query := `SELECT * FROM storefront_staff_invitations WHERE id = $1`
+
+	var invitation domain.StaffInvitation
+	if err := r.db.GetContext(ctx, &invitation, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("staff invitation not found")
+		}
+		return nil, fmt.Errorf("failed to get staff invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// ListStaffInvitations lists invitations, newest first
+func (r *Repository) ListStaffInvitations(ctx context.Context, filter *domain.StaffInvitationFilter) ([]domain.StaffInvitation, error) {
+	conditions := []string{}
+	args := []interface{}{}
+	argIdx := 1
+
+	if filter.StorefrontID != nil {
+		conditions = append(conditions, fmt.Sprintf("storefront_id = $%d", argIdx))
+		args = append(args, *filter.StorefrontID)
+		argIdx++
+	}
+	if filter.UserID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIdx))
+		args = append(args, *filter.UserID)
+		argIdx++
+	}
+	if filter.PendingOnly {
+		conditions = append(conditions, "status = 'pending' AND expires_at > NOW()")
+	}
+
+	query := `SELECT * FROM storefront_staff_invitations`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+
+	var invitations []domain.StaffInvitation
+	if err := r.db.SelectContext(ctx, &invitations, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list staff invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// AcceptStaffInvitation marks a pending invitation accepted and adds the invited user to the
+// storefront staff with the invitation's role and permissions (one transaction)
+func (r *Repository) AcceptStaffInvitation(ctx context.Context, id int64) (*domain.StorefrontStaff, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var invitation domain.StaffInvitation
+	lockQuery := `SELECT * FROM storefront_staff_invitations WHERE id = $1 FOR UPDATE`
+	if err := tx.GetContext(ctx, &invitation, lockQuery, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("staff invitation not found")
+		}
+		return nil, fmt.Errorf("failed to get staff invitation: %w", err)
+	}
+
+	// Re-checked under the row lock: the invitation may have been answered or revoked concurrently
+	if !invitation.IsOpen(time.Now()) {
+		return nil, fmt.Errorf("staff invitation is no longer pending")
+	}
+
+	staff := &domain.StorefrontStaff{
+		StorefrontID: invitation.StorefrontID,
+		UserID:       invitation.UserID,
+		Role:         invitation.Role,
+		Permissions:  invitation.Permissions,
+	}
+
+	insertQuery := `
+		INSERT INTO storefront_staff (storefront_id, user_id, role, permissions, actions_count)
+		VALUES ($1, $2, $3, $4, 0)
+		RETURNING id, created_at, updated_at`
+
+	err = tx.QueryRowContext(ctx, insertQuery,
+		staff.StorefrontID, staff.UserID, staff.Role, staff.Permissions,
+	).Scan(&staff.ID, &staff.CreatedAt, &staff.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+			return nil, fmt.Errorf("user is already a staff member")
+		}
+		return nil, fmt.Errorf("failed to add staff: %w", err)
+	}
+
+	updateQuery := `
+		UPDATE storefront_staff_invitations
+		SET status = 'accepted', responded_at = NOW()
+		WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, updateQuery, id); err != nil {
+		return nil, fmt.Errorf("failed to update staff invitation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return staff, nil
+}
+
+// CloseStaffInvitation moves a pending invitation to declined or revoked
+func (r *Repository) CloseStaffInvitation(ctx context.Context, id int64, status string) (*domain.StaffInvitation, error) {
+	query := `
+		UPDATE storefront_staff_invitations
+		SET status = $2, responded_at = NOW()
+		WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+		RETURNING *`
+
+	var invitation domain.StaffInvitation
+	if err := r.db.GetContext(ctx, &invitation, query, id, status); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("staff invitation is no longer pending")
+		}
+		return nil, fmt.Errorf("failed to update staff invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SetVacationMode updates the storefront's vacation settings
+func (r *Repository) SetVacationMode(ctx context.Context, storefrontID int64, settings *domain.VacationSettings) error {
+	query := `
+		UPDATE storefronts
+		SET vacation_mode = $2,
+		    vacation_starts_at = $3,
+		    vacation_ends_at = $4,
+		    vacation_message = $5,
+		    vacation_order_policy = $6,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, storefrontID,
+		settings.Enabled, settings.StartsAt, settings.EndsAt, settings.Message, settings.OrderPolicy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set vacation mode: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("storefront not found")
+	}
+
+	return nil
+}
+
+// GetStorefrontVacations returns the storefronts among ids with vacation mode enabled. Only the
+// ID, location and vacation fields are loaded.
+func (r *Repository) GetStorefrontVacations(ctx context.Context, ids []int64) ([]domain.Storefront, error) {
+	query := `
+		SELECT id, country, timezone, vacation_mode, vacation_starts_at, vacation_ends_at,
+		       vacation_message, vacation_order_policy
+		FROM storefronts
+		WHERE id = ANY($1) AND vacation_mode AND deleted_at IS NULL`
+
+	var storefronts []domain.Storefront
+	if err := r.db.SelectContext(ctx, &storefronts, query, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to get storefront vacations: %w", err)
+	}
+
+	return storefronts, nil
+}
+
+// SetHolidays replaces the storefront's holidays
+func (r *Repository) SetHolidays(ctx context.Context, storefrontID int64, holidays []domain.StorefrontHoliday) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		deleteQuery := `DELETE FROM storefront_holidays WHERE storefront_id = $1`
+		if _, err := tx.ExecContext(ctx, deleteQuery, storefrontID); err != nil {
+			return fmt.Errorf("failed to delete existing holidays: %w", err)
+		}
+
+		insertQuery := `
+			INSERT INTO storefront_holidays (storefront_id, name, start_date, end_date, recurring_yearly)
+			VALUES ($1, $2, $3, $4, $5)`
+
+		for _, holiday := range holidays {
+			if _, err := tx.ExecContext(ctx, insertQuery,
+				storefrontID, holiday.Name, holiday.StartDate.Format("2006-01-02"),
+				holiday.EndDate.Format("2006-01-02"), holiday.RecurringYearly,
+			); err != nil {
+				return fmt.Errorf("failed to insert holiday: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetHolidays retrieves the storefront's holidays ordered by start date
+func (r *Repository) GetHolidays(ctx context.Context, storefrontID int64) ([]domain.StorefrontHoliday, error) {
+	query := `SELECT * FROM storefront_holidays WHERE storefront_id = $1 ORDER BY start_date, id`
+
+	var holidays []domain.StorefrontHoliday
+	if err := r.db.SelectContext(ctx, &holidays, query, storefrontID); err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	return holidays, nil
+}
@@ -0,0 +1,342 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// storefrontVerifiedColumn selects whether a listing's storefront is verified. Used by
+// listings queries that do not join storefronts (indexed for the verified sellers filter).
+const storefrontVerifiedColumn = `EXISTS (
+			SELECT 1 FROM storefronts sv WHERE sv.id = listings.storefront_id AND sv.is_verified = true
+		) AS storefront_verified`
+
+const verificationRequestColumns = `id, storefront_id, submitted_by, status, business_name, registration_number, tax_id,
+	notes, reviewed_by, reviewed_at, rejection_reason, created_at, updated_at`
+
+// CreateVerificationRequest stores a pending verification request with its documents and
+// records the submission. Fills in the IDs and timestamps of req.
+func (r *Repository) CreateVerificationRequest(ctx context.Context, req *domain.StorefrontVerificationRequest) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		insertQuery := `
+			INSERT INTO storefront_verification_requests
+				(storefront_id, submitted_by, status, business_name, registration_number, tax_id, notes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, status, created_at, updated_at`
+
+		if err := tx.QueryRowxContext(ctx, insertQuery,
+			req.StorefrontID, req.SubmittedBy, domain.VerificationStatusPending, req.BusinessName,
+			req.RegistrationNumber, req.TaxID, req.Notes,
+		).Scan(&req.ID, &req.Status, &req.CreatedAt, &req.UpdatedAt); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok {
+				if pqErr.Code == "23505" { // unique_violation
+					return fmt.Errorf("verification request already pending")
+				}
+			}
+			return fmt.Errorf("failed to create verification request: %w", err)
+		}
+
+		documentQuery := `
+			INSERT INTO storefront_verification_documents
+				(request_id, document_type, file_name, content_type, file_size, storage_path)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, created_at`
+
+		for i := range req.Documents {
+			doc := &req.Documents[i]
+			doc.RequestID = req.ID
+			if err := tx.QueryRowxContext(ctx, documentQuery,
+				req.ID, doc.DocumentType, doc.FileName, doc.ContentType, doc.FileSize, doc.StoragePath,
+			).Scan(&doc.ID, &doc.CreatedAt); err != nil {
+				return fmt.Errorf("failed to insert verification document: %w", err)
+			}
+		}
+
+		return insertVerificationEvent(ctx, tx, req.StorefrontID, &req.ID, domain.VerificationActionSubmit, req.SubmittedBy, nil)
+	})
+}
+
+// GetVerificationRequest retrieves a verification request with its documents
+func (r *Repository) GetVerificationRequest(ctx context.Context, id int64) (*domain.StorefrontVerificationRequest, error) {
+	query := `SELECT ` + verificationRequestColumns + ` FROM storefront_verification_requests WHERE id = $1`
+
+	var req domain.StorefrontVerificationRequest
+	if err := r.db.GetContext(ctx, &req, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("verification request not found")
+		}
+		return nil, fmt.Errorf("failed to get verification request: %w", err)
+	}
+
+	if err := r.loadVerificationDocuments(ctx, []*domain.StorefrontVerificationRequest{&req}); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// GetLatestVerificationRequest retrieves the most recent verification request of a storefront
+func (r *Repository) GetLatestVerificationRequest(ctx context.Context, storefrontID int64) (*domain.StorefrontVerificationRequest, error) {
+	query := `
+		SELECT ` + verificationRequestColumns + `
+		FROM storefront_verification_requests
+		WHERE storefront_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1`
+
+	var req domain.StorefrontVerificationRequest
+	if err := r.db.GetContext(ctx, &req, query, storefrontID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("verification request not found")
+		}
+		return nil, fmt.Errorf("failed to get verification request: %w", err)
+	}
+
+	if err := r.loadVerificationDocuments(ctx, []*domain.StorefrontVerificationRequest{&req}); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// ListVerificationRequests returns verification requests with the given status, oldest first
+func (r *Repository) ListVerificationRequests(ctx context.Context, status string, limit, offset int) ([]*domain.StorefrontVerificationRequest, int32, error) {
+	var total int32
+	countQuery := `SELECT COUNT(*) FROM storefront_verification_requests WHERE status = $1`
+	if err := r.db.GetContext(ctx, &total, countQuery, status); err != nil {
+		return nil, 0, fmt.Errorf("failed to count verification requests: %w", err)
+	}
+
+	query := `
+		SELECT ` + verificationRequestColumns + `
+		FROM storefront_verification_requests
+		WHERE status = $1
+		ORDER BY created_at, id
+		LIMIT $2 OFFSET $3`
+
+	var requests []*domain.StorefrontVerificationRequest
+	if err := r.db.SelectContext(ctx, &requests, query, status, limit, offset); err != nil {
+		r.logger.Error().Err(err).Msg("failed to list verification requests")
+		return nil, 0, fmt.Errorf("failed to list verification requests: %w", err)
+	}
+
+	if err := r.loadVerificationDocuments(ctx, requests); err != nil {
+		return nil, 0, err
+	}
+
+	return requests, total, nil
+}
+
+// DecideVerificationRequest applies an admin decision to a pending request. Approval marks
+// the storefront verified (and its address verified when proof of address was submitted)
+// and queues its listings for reindexing.
+func (r *Repository) DecideVerificationRequest(ctx context.Context, decision *domain.VerificationDecision) (*domain.StorefrontVerificationRequest, error) {
+	var storefrontID int64
+
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var status string
+		lockQuery := `SELECT storefront_id, status FROM storefront_verification_requests WHERE id = $1 FOR UPDATE`
+		if err := tx.QueryRowxContext(ctx, lockQuery, decision.RequestID).Scan(&storefrontID, &status); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("verification request not found")
+			}
+			return fmt.Errorf("failed to lock verification request: %w", err)
+		}
+		if status != domain.VerificationStatusPending {
+			return fmt.Errorf("verification request is no longer pending (status: %s)", status)
+		}
+
+		var reason *string
+		if decision.Reason != "" {
+			reason = &decision.Reason
+		}
+
+		updateQuery := `
+			UPDATE storefront_verification_requests
+			SET status = $2, reviewed_by = $3, reviewed_at = CURRENT_TIMESTAMP, rejection_reason = $4
+			WHERE id = $1`
+		var rejectionReason *string
+		if !decision.Approve {
+			rejectionReason = reason
+		}
+		if _, err := tx.ExecContext(ctx, updateQuery,
+			decision.RequestID, decision.TargetStatus(), decision.ReviewerID, rejectionReason,
+		); err != nil {
+			return fmt.Errorf("failed to update verification request: %w", err)
+		}
+
+		action := domain.VerificationActionReject
+		if decision.Approve {
+			action = domain.VerificationActionApprove
+
+			storefrontQuery := `
+				UPDATE storefronts
+				SET is_verified = true,
+				    verification_date = CURRENT_TIMESTAMP,
+				    address_verified = address_verified OR EXISTS (
+				        SELECT 1 FROM storefront_verification_documents
+				        WHERE request_id = $2 AND document_type = $3
+				    ),
+				    updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1`
+			if _, err := tx.ExecContext(ctx, storefrontQuery,
+				storefrontID, decision.RequestID, domain.VerificationDocAddressProof,
+			); err != nil {
+				return fmt.Errorf("failed to mark storefront verified: %w", err)
+			}
+
+			if err := enqueueStorefrontReindex(ctx, tx, storefrontID); err != nil {
+				return err
+			}
+		}
+
+		return insertVerificationEvent(ctx, tx, storefrontID, &decision.RequestID, action, decision.ReviewerID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetVerificationRequest(ctx, decision.RequestID)
+}
+
+// CancelVerificationRequest withdraws the pending verification request of a storefront
+func (r *Repository) CancelVerificationRequest(ctx context.Context, storefrontID, actorID int64) (*domain.StorefrontVerificationRequest, error) {
+	var requestID int64
+
+	err := r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			UPDATE storefront_verification_requests
+			SET status = $2
+			WHERE storefront_id = $1 AND status = $3
+			RETURNING id`
+		if err := tx.QueryRowxContext(ctx, query,
+			storefrontID, domain.VerificationStatusCancelled, domain.VerificationStatusPending,
+		).Scan(&requestID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("pending verification request not found")
+			}
+			return fmt.Errorf("failed to cancel verification request: %w", err)
+		}
+
+		return insertVerificationEvent(ctx, tx, storefrontID, &requestID, domain.VerificationActionCancel, actorID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetVerificationRequest(ctx, requestID)
+}
+
+// RevokeStorefrontVerification clears the verified flags of a storefront and queues its
+// listings for reindexing
+func (r *Repository) RevokeStorefrontVerification(ctx context.Context, storefrontID, actorID int64, reason string) error {
+	return r.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			UPDATE storefronts
+			SET is_verified = false, verification_date = NULL, address_verified = false,
+			    updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND is_verified = true AND deleted_at IS NULL`
+
+		result, err := tx.ExecContext(ctx, query, storefrontID)
+		if err != nil {
+			return fmt.Errorf("failed to revoke verification: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("storefront is not verified")
+		}
+
+		if err := enqueueStorefrontReindex(ctx, tx, storefrontID); err != nil {
+			return err
+		}
+
+		return insertVerificationEvent(ctx, tx, storefrontID, nil, domain.VerificationActionRevoke, actorID, &reason)
+	})
+}
+
+// ListVerificationEvents returns the verification audit history of a storefront, newest first
+func (r *Repository) ListVerificationEvents(ctx context.Context, storefrontID int64) ([]domain.StorefrontVerificationEvent, error) {
+	query := `
+		SELECT id, storefront_id, request_id, action, actor_id, reason, created_at
+		FROM storefront_verification_events
+		WHERE storefront_id = $1
+		ORDER BY created_at DESC, id DESC`
+
+	var events []domain.StorefrontVerificationEvent
+	if err := r.db.SelectContext(ctx, &events, query, storefrontID); err != nil {
+		return nil, fmt.Errorf("failed to list verification events: %w", err)
+	}
+
+	return events, nil
+}
+
+// loadVerificationDocuments attaches the documents of the given requests
+func (r *Repository) loadVerificationDocuments(ctx context.Context, requests []*domain.StorefrontVerificationRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(requests))
+	byID := make(map[int64]*domain.StorefrontVerificationRequest, len(requests))
+	for i, req := range requests {
+		ids[i] = req.ID
+		byID[req.ID] = req
+	}
+
+	query := `
+		SELECT id, request_id, document_type, file_name, content_type, file_size, storage_path, created_at
+		FROM storefront_verification_documents
+		WHERE request_id = ANY($1)
+		ORDER BY request_id, id`
+
+	var documents []domain.StorefrontVerificationDocument
+	if err := r.db.SelectContext(ctx, &documents, query, pq.Array(ids)); err != nil {
+		return fmt.Errorf("failed to load verification documents: %w", err)
+	}
+
+	for _, doc := range documents {
+		if req, ok := byID[doc.RequestID]; ok {
+			req.Documents = append(req.Documents, doc)
+		}
+	}
+
+	return nil
+}
+
+func insertVerificationEvent(ctx context.Context, tx *sqlx.Tx, storefrontID int64, requestID *int64, action string, actorID int64, reason *string) error {
+	query := `
+		INSERT INTO storefront_verification_events (storefront_id, request_id, action, actor_id, reason)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	if _, err := tx.ExecContext(ctx, query, storefrontID, requestID, action, actorID, reason); err != nil {
+		return fmt.Errorf("failed to record verification event: %w", err)
+	}
+	return nil
+}
+
+// enqueueStorefrontReindex queues the published listings of a storefront for reindexing
+// (their storefront_verified search field changed)
+func enqueueStorefrontReindex(ctx context.Context, tx *sqlx.Tx, storefrontID int64) error {
+	query := `
+		INSERT INTO indexing_queue (listing_id, operation, status, retry_count, max_retries)
+		SELECT id, $2, 'pending', 0, 3
+		FROM listings
+		WHERE storefront_id = $1 AND status = $3 AND is_deleted = false
+		ON CONFLICT (listing_id) WHERE status = 'pending'
+		DO UPDATE SET operation = EXCLUDED.operation, updated_at = CURRENT_TIMESTAMP`
+
+	if _, err := tx.ExecContext(ctx, query, storefrontID, domain.IndexOpUpdate, domain.StatusPublished); err != nil {
+		return fmt.Errorf("failed to enqueue storefront listings for reindexing: %w", err)
+	}
+	return nil
+}
@@ -108,13 +108,14 @@ func (r *Repository) UpdateStorefront(ctx context.Context, id int64, update *dom
 		args = append(args, *update.IsActive)
 		argIdx++
 	}
+	// A URL set directly replaces an uploaded image: its object is left to image GC
 	if update.LogoURL != nil {
-		sets = append(sets, fmt.Sprintf("logo_url = $%d", argIdx))
+		sets = append(sets, fmt.Sprintf("logo_url = $%d", argIdx), "logo_storage_path = NULL")
 		args = append(args, *update.LogoURL)
 		argIdx++
 	}
 	if update.BannerURL != nil {
-		sets = append(sets, fmt.Sprintf("banner_url = $%d", argIdx))
+		sets = append(sets, fmt.Sprintf("banner_url = $%d", argIdx), "banner_storage_path = NULL")
 		args = append(args, *update.BannerURL)
 		argIdx++
 	}
@@ -198,6 +199,12 @@ func (r *Repository) UpdateStorefront(ctx context.Context, id int64, update *dom
 		args = append(args, *update.GroupBuyingEnabled)
 		argIdx++
 	}
+	if update.Timezone != nil {
+		// An empty timezone resets to the timezone of the storefront country
+		sets = append(sets, fmt.Sprintf("timezone = NULLIF($%d, '')", argIdx))
+		args = append(args, *update.Timezone)
+		argIdx++
+	}
 
 	if len(sets) == 0 {
 		return fmt.Errorf("no fields to update")
@@ -227,6 +234,46 @@ func (r *Repository) UpdateStorefront(ctx context.Context, id int64, update *dom
 	return nil
 }
 
+// SetStorefrontImage stores an uploaded logo or banner and returns the storage key of the
+// image it replaces (nil if there was none or it was an external URL)
+func (r *Repository) SetStorefrontImage(ctx context.Context, id int64, kind, url, storagePath string) (*string, error) {
+	var urlColumn, pathColumn string
+	switch kind {
+	case domain.StorefrontImageLogo:
+		urlColumn, pathColumn = "logo_url", "logo_storage_path"
+	case domain.StorefrontImageBanner:
+		urlColumn, pathColumn = "banner_url", "banner_storage_path"
+	default:
+		return nil, fmt.Errorf("invalid storefront image kind: %s", kind)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE storefronts s
+		SET %[1]s = $2, %[2]s = $3, updated_at = CURRENT_TIMESTAMP
+		FROM (
+			SELECT id, %[2]s AS previous FROM storefronts
+			WHERE id = $1 AND deleted_at IS NULL
+			FOR UPDATE
+		) old
+		WHERE s.id = old.id
+		RETURNING old.previous
+	`, urlColumn, pathColumn)
+
+	var previous sql.NullString
+	if err := r.db.QueryRowContext(ctx, query, id, url, storagePath).Scan(&previous); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("storefront not found")
+		}
+		r.logger.Error().Err(err).Int64("storefront_id", id).Str("kind", kind).Msg("failed to set storefront image")
+		return nil, fmt.Errorf("failed to set storefront image: %w", err)
+	}
+
+	if !previous.Valid {
+		return nil, nil
+	}
+	return &previous.String, nil
+}
+
 // DeleteStorefront soft-deletes or hard-deletes a storefront
 func (r *Repository) DeleteStorefront(ctx context.Context, id int64, hardDelete bool) error {
 	if hardDelete {
@@ -377,18 +424,6 @@ func (r *Repository) ListStorefronts(ctx context.Context, filter *domain.ListSto
 	return storefronts, total, nil
 }
 
-// AddStaff adds a staff member to storefront
-func (r *Repository) AddStaff(ctx context.Context, staff *domain.StorefrontStaff) error {
-	query := `
-		INSERT INTO storefront_staff (storefront_id, user_id, role, permissions, actions_count)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, created_at, updated_at`
-
-	return r.db.QueryRowContext(ctx, query,
-		staff.StorefrontID, staff.UserID, staff.Role, staff.Permissions, staff.ActionsCount,
-	).Scan(&staff.ID, &staff.CreatedAt, &staff.UpdatedAt)
-}
-
 // UpdateStaff updates staff member information
 func (r *Repository) UpdateStaff(ctx context.Context, id int64, update *domain.StaffUpdate) error {
 	sets := []string{}
@@ -512,57 +547,6 @@ func (r *Repository) GetWorkingHours(ctx context.Context, storefrontID int64) ([
 	return hours, nil
 }
 
-// IsOpenNow checks if storefront is currently open
-func (r *Repository) IsOpenNow(ctx context.Context, storefrontID int64) (bool, *time.Time, *time.Time, error) {
-	now := time.Now()
-	currentDay := int32(now.Weekday())
-	currentTime := now.Format("15:04:05")
-	currentDate := now.Format("2006-01-02")
-
-	query := `
-		SELECT open_time, close_time, is_closed
-		FROM storefront_hours
-		WHERE storefront_id = $1
-			AND (
-				(day_of_week = $2 AND special_date IS NULL)
-				OR special_date = $3
-			)
-		ORDER BY special_date DESC NULLS LAST
-		LIMIT 1`
-
-	var openTime, closeTime *string
-	var isClosed bool
-
-	err := r.db.QueryRowContext(ctx, query, storefrontID, currentDay, currentDate).Scan(&openTime, &closeTime, &isClosed)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil, nil, nil
-		}
-		return false, nil, nil, fmt.Errorf("failed to check if open: %w", err)
-	}
-
-	if isClosed {
-		return false, nil, nil, nil
-	}
-
-	if openTime == nil || closeTime == nil {
-		return false, nil, nil, nil
-	}
-
-	isOpen := currentTime >= *openTime && currentTime <= *closeTime
-
-	var nextOpenTime, nextCloseTime *time.Time
-	if !isOpen {
-		nextOpen, _ := time.Parse("15:04:05", *openTime)
-		nextOpenTime = &nextOpen
-	} else {
-		nextClose, _ := time.Parse("15:04:05", *closeTime)
-		nextCloseTime = &nextClose
-	}
-
-	return isOpen, nextOpenTime, nextCloseTime, nil
-}
-
 // SetPaymentMethods sets payment methods for a storefront (replaces existing)
 func (r *Repository) SetPaymentMethods(ctx context.Context, storefrontID int64, methods []domain.PaymentMethod) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
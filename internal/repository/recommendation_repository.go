@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// CoOccurrenceBuildOptions controls a rebuild of the listing co-occurrence table
+type CoOccurrenceBuildOptions struct {
+	Since              time.Time // Only interactions and orders after this time are counted
+	TopN               int       // Neighbors kept per listing and kind
+	MinVisitorSupport  int       // Visitors needed for an engagement pair
+	MinOrderSupport    int       // Orders needed for a purchase pair
+	MaxItemsPerVisitor int       // Visitors (and orders) with more listings are ignored as noise
+}
+
+// RecommendationRepository defines operations for item-to-item recommendations
+type RecommendationRepository interface {
+	// RebuildCoOccurrences recomputes the top related listings of every active listing from
+	// analytics events, favorites and orders, replacing the stored neighbors atomically.
+	// Returns the number of stored pairs.
+	RebuildCoOccurrences(ctx context.Context, opts CoOccurrenceBuildOptions) (int64, error)
+
+	// GetRelatedListings returns up to perListing stored neighbors of each listing
+	// (strongest first), restricted to active listings
+	GetRelatedListings(ctx context.Context, kind string, listingIDs []int64, perListing int) ([]domain.RelatedListing, error)
+
+	// GetRecommendationSeeds returns the listings a user (or, when userID is nil, an
+	// anonymous session) interacted with since the given time, strongest and most recent first
+	GetRecommendationSeeds(ctx context.Context, userID *int64, sessionID string, since time.Time, limit int) ([]domain.RecommendationSeed, error)
+
+	// GetTrendingListings returns the active listings with the most engagement since the
+	// given time, as trending recommendations
+	GetTrendingListings(ctx context.Context, since time.Time, limit int) ([]domain.Recommendation, error)
+}
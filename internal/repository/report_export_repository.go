@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ReportRowWriter receives the rows of a report as they are read
+type ReportRowWriter interface {
+	// WriteHeader is called once with the column names before the first row
+	WriteHeader(columns []string) error
+
+	// WriteRow is called for every row; values are in column order
+	WriteRow(values []interface{}) error
+}
+
+// ReportExportRepository defines operations for report export requests and their data
+type ReportExportRepository interface {
+	// CreateReportExport queues a new export request
+	CreateReportExport(ctx context.Context, input *domain.CreateReportExportInput) (*domain.ReportExport, error)
+
+	// GetReportExport returns an export by ID (nil if not found)
+	GetReportExport(ctx context.Context, id int64) (*domain.ReportExport, error)
+
+	// CountActiveReportExports counts the pending and processing exports of a user
+	CountActiveReportExports(ctx context.Context, userID int64) (int, error)
+
+	// ClaimReportExports marks up to limit pending exports (oldest first) as processing
+	// Exports stuck in processing for longer than staleAfter (crashed worker) are reclaimed,
+	// or failed when they have no retries left
+	ClaimReportExports(ctx context.Context, limit int, staleAfter time.Duration) ([]*domain.ReportExport, error)
+
+	// CompleteReportExport stores the result of a rendered export
+	CompleteReportExport(ctx context.Context, id int64, objectKey string, rowCount, fileSize int64, expiresAt time.Time) error
+
+	// FailReportExport records a failed attempt; the export goes back to pending until
+	// max_retries is reached. Returns true when the export failed for good.
+	FailReportExport(ctx context.Context, id int64, errorMsg string, permanent bool) (bool, error)
+
+	// StreamReportRows reads the rows of an export's report in order and passes them to w
+	// Reading stops with an error when w returns one
+	StreamReportRows(ctx context.Context, export *domain.ReportExport, w ReportRowWriter) error
+
+	// ListExpiredReportExports returns up to limit completed exports past their expiry and
+	// failed exports last updated before failedBefore
+	ListExpiredReportExports(ctx context.Context, failedBefore time.Time, limit int) ([]*domain.ReportExport, error)
+
+	// DeleteReportExport deletes an export record
+	DeleteReportExport(ctx context.Context, id int64) error
+}
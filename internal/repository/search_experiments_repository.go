@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SearchExperimentsRepository defines operations for search ranking experiments
+type SearchExperimentsRepository interface {
+	// CreateExperiment stores a new experiment in draft status (nil if the ID is taken)
+	CreateExperiment(ctx context.Context, input *domain.CreateSearchExperimentInput) (*domain.SearchExperiment, error)
+
+	// GetExperiment returns an experiment by ID (nil if not found)
+	GetExperiment(ctx context.Context, id string) (*domain.SearchExperiment, error)
+
+	// ListExperiments returns experiments, optionally of one status, oldest started first
+	// The search service polls the running ones, so the order decides enrollment priority
+	ListExperiments(ctx context.Context, status *domain.SearchExperimentStatus) ([]*domain.SearchExperiment, error)
+
+	// UpdateExperimentStatus moves an experiment from one status to another and records when
+	// it started or stopped. Returns nil if the experiment is not in the from status.
+	UpdateExperimentStatus(ctx context.Context, id string, from, to domain.SearchExperimentStatus) (*domain.SearchExperiment, error)
+
+	// GetExperimentArms aggregates the searches, clicks and conversions of every variant
+	// that served recorded searches in [from, to)
+	GetExperimentArms(ctx context.Context, id string, from, to time.Time) ([]domain.SearchExperimentArm, error)
+}
@@ -38,6 +38,14 @@ type SearchQueriesRepository interface {
 	// Performance target: < 300ms
 	GetCTRAnalysis(ctx context.Context, filter *domain.GetCTRAnalysisFilter) ([]domain.SearchQueryCTR, error)
 
+	// RecordSearchClick records a click on a search result together with its position
+	// Returns false if the search is unknown or expired, or the listing was already clicked
+	RecordSearchClick(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error)
+
+	// GetSearchQueryReport aggregates searches and clicks per query
+	// Used for the admin zero-result, low-result and top-queries reports
+	GetSearchQueryReport(ctx context.Context, filter *domain.GetSearchQueryReportFilter) (*domain.SearchQueryReport, error)
+
 	// CleanupOldQueries deletes search queries older than retention period
 	// Called by periodic cleanup job (e.g., daily cron)
 	// Default retention: 90 days
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ParseSchedule(expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// Monday
+	base := time.Date(2025, 11, 24, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "every minute", expr: "* * * * *", from: base, want: time.Date(2025, 11, 24, 10, 8, 0, 0, time.UTC)},
+		{name: "every 15 minutes", expr: "*/15 * * * *", from: base, want: time.Date(2025, 11, 24, 10, 15, 0, 0, time.UTC)},
+		{name: "strictly after", expr: "*/15 * * * *", from: time.Date(2025, 11, 24, 10, 15, 0, 0, time.UTC), want: time.Date(2025, 11, 24, 10, 30, 0, 0, time.UTC)},
+		{name: "hourly", expr: "0 * * * *", from: base, want: time.Date(2025, 11, 24, 11, 0, 0, 0, time.UTC)},
+		{name: "daily next day", expr: "30 3 * * *", from: base, want: time.Date(2025, 11, 25, 3, 30, 0, 0, time.UTC)},
+		{name: "list and range", expr: "0 9-17/4,22 * * *", from: base, want: time.Date(2025, 11, 24, 13, 0, 0, 0, time.UTC)},
+		{name: "month rollover", expr: "0 0 1 * *", from: base, want: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "year rollover", expr: "0 0 1 1 *", from: base, want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "0 4 * * 7", from: base, want: time.Date(2025, 11, 30, 4, 0, 0, 0, time.UTC)},
+		{name: "day-of-month or day-of-week", expr: "0 0 1 * 3", from: base, want: time.Date(2025, 11, 26, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 0 29 2 *", from: base, want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(tt.from))
+		})
+	}
+}
+
+func TestSchedule_Next_NeverMatches(t *testing.T) {
+	s, err := ParseSchedule("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(time.Now()).IsZero())
+}
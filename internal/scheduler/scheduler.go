@@ -0,0 +1,227 @@
+// Package scheduler runs periodic maintenance jobs on cron schedules.
+//
+// Every replica runs the scheduler, but each run of a job is guarded by a PostgreSQL
+// advisory lock, so a job executes on a single instance at a time; the other
+// instances skip that tick. Every run is recorded in scheduled_job_runs with its
+// schedule slot, and a slot is run once even when the ticks of the replicas do not
+// overlap.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+// Store defines the locking and run history operations the scheduler needs
+type Store interface {
+	AcquireJobLock(ctx context.Context, jobName string) (release func(), acquired bool, err error)
+	StartJobRun(ctx context.Context, jobName, instance string, scheduledAt time.Time) (runID int64, started bool, err error)
+	FinishJobRun(ctx context.Context, runID int64, runErr error) error
+}
+
+// Job is a periodic task
+type Job struct {
+	Name     string                          // Unique name; also the lock key and metrics label
+	Schedule string                          // 5-field cron expression, evaluated in UTC
+	Timeout  time.Duration                   // Maximum run time (0 = Config.DefaultTimeout)
+	Run      func(ctx context.Context) error // The context is cancelled on timeout and on Stop
+}
+
+// Config holds scheduler settings
+type Config struct {
+	Instance       string        // Name of this replica in the run history (e.g. hostname)
+	DefaultTimeout time.Duration // Run timeout of jobs that do not set their own
+}
+
+type scheduledJob struct {
+	Job
+	schedule *Schedule
+}
+
+// Scheduler runs registered jobs on their schedules
+type Scheduler struct {
+	store   Store
+	metrics *metrics.Metrics
+	config  Config
+	jobs    []*scheduledJob
+	logger  zerolog.Logger
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	started bool
+}
+
+// New creates a new scheduler
+func New(store Store, metrics *metrics.Metrics, cfg Config, logger zerolog.Logger) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Instance == "" {
+		cfg.Instance = "unknown"
+	}
+	if cfg.DefaultTimeout <= 0 {
+		cfg.DefaultTimeout = 10 * time.Minute
+	}
+
+	return &Scheduler{
+		store:   store,
+		metrics: metrics,
+		config:  cfg,
+		logger:  logger.With().Str("component", "scheduler").Logger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Register adds a job. Jobs must be registered before Start.
+func (s *Scheduler) Register(job Job) error {
+	if s.started {
+		return fmt.Errorf("cannot register job %q: scheduler already started", job.Name)
+	}
+	if job.Name == "" || job.Run == nil {
+		return fmt.Errorf("job name and run function are required")
+	}
+	for _, j := range s.jobs {
+		if j.Name == job.Name {
+			return fmt.Errorf("job %q is already registered", job.Name)
+		}
+	}
+
+	schedule, err := ParseSchedule(job.Schedule)
+	if err != nil {
+		return fmt.Errorf("job %q: %w", job.Name, err)
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = s.config.DefaultTimeout
+	}
+
+	s.jobs = append(s.jobs, &scheduledJob{Job: job, schedule: schedule})
+	return nil
+}
+
+// Start begins running the registered jobs
+func (s *Scheduler) Start() error {
+	if s.started {
+		return fmt.Errorf("scheduler already started")
+	}
+	s.started = true
+
+	s.logger.Info().
+		Int("jobs", len(s.jobs)).
+		Str("instance", s.config.Instance).
+		Msg("starting job scheduler")
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+
+	return nil
+}
+
+// Stop cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() error {
+	s.logger.Info().Msg("stopping job scheduler")
+
+	s.cancel()
+	s.wg.Wait()
+
+	s.logger.Info().Msg("job scheduler stopped")
+	return nil
+}
+
+// loop sleeps until the next scheduled time of a job and runs it
+func (s *Scheduler) loop(job *scheduledJob) {
+	defer s.wg.Done()
+
+	for {
+		next := job.schedule.Next(time.Now().UTC())
+		if next.IsZero() {
+			s.logger.Error().Str("job", job.Name).Str("schedule", job.Schedule).Msg("schedule never matches, job disabled")
+			return
+		}
+
+		s.logger.Debug().Str("job", job.Name).Time("next_run", next).Msg("job scheduled")
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(job, next)
+	}
+}
+
+// runOnce runs the job for a schedule slot unless another instance is running it or
+// already ran that slot
+func (s *Scheduler) runOnce(job *scheduledJob, slot time.Time) {
+	release, acquired, err := s.store.AcquireJobLock(s.ctx, job.Name)
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", job.Name).Msg("failed to acquire job lock")
+		s.metrics.RecordError("scheduler", "lock_failed")
+		return
+	}
+	if !acquired {
+		s.logger.Debug().Str("job", job.Name).Msg("job is running on another instance, skipping")
+		s.metrics.RecordScheduledJobSkipped(job.Name)
+		return
+	}
+	defer release()
+
+	// A missing history row must not stop maintenance from running
+	runID, started, err := s.store.StartJobRun(s.ctx, job.Name, s.config.Instance, slot)
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", job.Name).Msg("failed to record job run")
+		s.metrics.RecordError("scheduler", "history_failed")
+	} else if !started {
+		s.logger.Debug().Str("job", job.Name).Time("slot", slot).Msg("job already ran for this slot, skipping")
+		s.metrics.RecordScheduledJobSkipped(job.Name)
+		return
+	}
+
+	start := time.Now()
+	runErr := s.execute(job)
+	duration := time.Since(start)
+
+	s.metrics.RecordScheduledJobRun(job.Name, runErr, duration.Seconds())
+
+	if runErr != nil {
+		s.logger.Error().Err(runErr).Str("job", job.Name).Dur("duration", duration).Msg("job failed")
+	} else {
+		s.logger.Info().Str("job", job.Name).Dur("duration", duration).Msg("job completed")
+	}
+
+	if runID != 0 {
+		// Independent of s.ctx so runs interrupted by Stop are still recorded
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := s.store.FinishJobRun(ctx, runID, runErr); err != nil {
+			s.logger.Error().Err(err).Str("job", job.Name).Int64("run_id", runID).Msg("failed to record job result")
+			s.metrics.RecordError("scheduler", "history_failed")
+		}
+	}
+}
+
+// execute runs the job function with its timeout, turning panics into errors
+func (s *Scheduler) execute(job *scheduledJob) (err error) {
+	ctx, cancel := context.WithTimeout(s.ctx, job.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.Run(ctx)
+}
@@ -0,0 +1,128 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+var testMetrics = metrics.NewMetrics("scheduler_test")
+
+var testSlot = time.Date(2025, 11, 24, 12, 0, 0, 0, time.UTC)
+
+type fakeStore struct {
+	locked   bool
+	released int
+	started  []string
+	slots    map[string]bool
+	finished map[int64]error
+}
+
+func (f *fakeStore) AcquireJobLock(_ context.Context, _ string) (func(), bool, error) {
+	if f.locked {
+		return nil, false, nil
+	}
+	return func() { f.released++ }, true, nil
+}
+
+func (f *fakeStore) StartJobRun(_ context.Context, jobName, _ string, scheduledAt time.Time) (int64, bool, error) {
+	slot := jobName + "@" + scheduledAt.String()
+	if f.slots[slot] {
+		return 0, false, nil
+	}
+	if f.slots == nil {
+		f.slots = map[string]bool{}
+	}
+	f.slots[slot] = true
+
+	f.started = append(f.started, jobName)
+	return int64(len(f.started)), true, nil
+}
+
+func (f *fakeStore) FinishJobRun(_ context.Context, runID int64, runErr error) error {
+	f.finished[runID] = runErr
+	return nil
+}
+
+func newTestScheduler(store Store) *Scheduler {
+	return New(store, testMetrics, Config{Instance: "test"}, zerolog.Nop())
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	store := &fakeStore{finished: map[int64]error{}}
+	s := newTestScheduler(store)
+
+	runs := 0
+	require.NoError(t, s.Register(Job{Name: "ok", Schedule: "* * * * *", Run: func(context.Context) error {
+		runs++
+		return nil
+	}}))
+	require.NoError(t, s.Register(Job{Name: "panics", Schedule: "* * * * *", Run: func(context.Context) error {
+		panic("boom")
+	}}))
+
+	s.runOnce(s.jobs[0], testSlot)
+	s.runOnce(s.jobs[1], testSlot)
+
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, []string{"ok", "panics"}, store.started)
+	assert.NoError(t, store.finished[1])
+	assert.ErrorContains(t, store.finished[2], "boom")
+	assert.Equal(t, 2, store.released)
+}
+
+func TestScheduler_RunOnce_LockedElsewhere(t *testing.T) {
+	store := &fakeStore{locked: true, finished: map[int64]error{}}
+	s := newTestScheduler(store)
+
+	require.NoError(t, s.Register(Job{Name: "job", Schedule: "* * * * *", Run: func(context.Context) error {
+		return errors.New("must not run")
+	}}))
+
+	s.runOnce(s.jobs[0], testSlot)
+
+	assert.Empty(t, store.started)
+	assert.Empty(t, store.finished)
+}
+
+func TestScheduler_RunOnce_SlotAlreadyRun(t *testing.T) {
+	store := &fakeStore{finished: map[int64]error{}}
+	s := newTestScheduler(store)
+
+	runs := 0
+	require.NoError(t, s.Register(Job{Name: "job", Schedule: "* * * * *", Run: func(context.Context) error {
+		runs++
+		return nil
+	}}))
+
+	// The replica whose tick fires after the first run finished must not repeat the slot
+	s.runOnce(s.jobs[0], testSlot)
+	s.runOnce(s.jobs[0], testSlot)
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, 2, store.released)
+
+	s.runOnce(s.jobs[0], testSlot.Add(time.Minute))
+	assert.Equal(t, 2, runs, "the next slot runs")
+}
+
+func TestScheduler_Register(t *testing.T) {
+	s := newTestScheduler(&fakeStore{})
+	run := func(context.Context) error { return nil }
+
+	require.NoError(t, s.Register(Job{Name: "job", Schedule: "0 * * * *", Run: run}))
+	assert.Equal(t, s.config.DefaultTimeout, s.jobs[0].Timeout)
+
+	assert.Error(t, s.Register(Job{Name: "job", Schedule: "0 * * * *", Run: run}), "duplicate name")
+	assert.Error(t, s.Register(Job{Name: "bad", Schedule: "0 * *", Run: run}), "invalid schedule")
+
+	require.NoError(t, s.Start())
+	assert.Error(t, s.Register(Job{Name: "late", Schedule: "0 * * * *", Run: run}), "registered after start")
+	require.NoError(t, s.Stop())
+}
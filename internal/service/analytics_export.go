@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingssvcv1 "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ============================================================================
+// REPORT EXPORTS
+// ============================================================================
+
+// exportDownloadURLTTL is the validity of download URLs returned by GetExportStatus
+const exportDownloadURLTTL = 15 * time.Minute
+
+// reportExportTypes maps proto report types to domain report types
+var reportExportTypes = map[listingssvcv1.ReportExportType]domain.ReportType{
+	listingssvcv1.ReportExportType_REPORT_EXPORT_TYPE_ORDERS:              domain.ReportTypeOrders,
+	listingssvcv1.ReportExportType_REPORT_EXPORT_TYPE_PRODUCT_PERFORMANCE: domain.ReportTypeProductPerformance,
+	listingssvcv1.ReportExportType_REPORT_EXPORT_TYPE_STOCK_MOVEMENTS:     domain.ReportTypeStockMovements,
+}
+
+// reportExportFormats maps proto formats to domain formats (unspecified defaults to CSV)
+var reportExportFormats = map[listingssvcv1.ReportExportFormat]domain.ReportFormat{
+	listingssvcv1.ReportExportFormat_REPORT_EXPORT_FORMAT_UNSPECIFIED: domain.ReportFormatCSV,
+	listingssvcv1.ReportExportFormat_REPORT_EXPORT_FORMAT_CSV:         domain.ReportFormatCSV,
+	listingssvcv1.ReportExportFormat_REPORT_EXPORT_FORMAT_JSONL:       domain.ReportFormatJSONL,
+}
+
+// reportExportStatuses maps domain export statuses to proto statuses
+var reportExportStatuses = map[string]listingssvcv1.ReportExportStatus{
+	domain.ReportExportStatusPending:    listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_PENDING,
+	domain.ReportExportStatusProcessing: listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_PROCESSING,
+	domain.ReportExportStatusCompleted:  listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_COMPLETED,
+	domain.ReportExportStatusFailed:     listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_FAILED,
+}
+
+// RequestReportExport queues a report export. Storefront exports need orders.read (orders)
+// or analytics.read (other reports); platform-wide exports are admin only.
+func (s *analyticsServiceImpl) RequestReportExport(
+	ctx context.Context,
+	req *listingssvcv1.RequestReportExportRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.RequestReportExportResponse, error) {
+	if s.exports == nil || s.urlSigner == nil {
+		return nil, ErrReportExportsDisabled
+	}
+
+	reportType, ok := reportExportTypes[req.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: type is required", ErrInvalidInput)
+	}
+	format, ok := reportExportFormats[req.Format]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown format", ErrInvalidInput)
+	}
+	if req.DateFrom == nil || req.DateTo == nil {
+		return nil, fmt.Errorf("%w: date_from and date_to are required", ErrInvalidInput)
+	}
+
+	input := &domain.CreateReportExportInput{
+		RequestedBy:  userID,
+		StorefrontID: req.StorefrontId,
+		ReportType:   reportType,
+		Format:       format,
+		DateFrom:     req.DateFrom.AsTime(),
+		DateTo:       req.DateTo.AsTime(),
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	if input.StorefrontID == nil {
+		if err := s.requireAdmin(userID, isAdmin); err != nil {
+			s.logger.Warn().Int64("user_id", userID).Msg("unauthorized platform-wide report export")
+			return nil, err
+		}
+	} else {
+		permission := reportType.RequiredPermission()
+		if err := s.requireStorefrontPermission(ctx, userID, isAdmin, *input.StorefrontID, permission, "export this storefront's reports"); err != nil {
+			s.logger.Warn().
+				Int64("user_id", userID).
+				Int64("storefront_id", *input.StorefrontID).
+				Str("permission", permission).
+				Msg("unauthorized report export")
+			return nil, err
+		}
+	}
+
+	active, err := s.exports.CountActiveReportExports(ctx, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("failed to count active report exports")
+		return nil, fmt.Errorf("%w: failed to request report export", ErrInternal)
+	}
+	if active >= domain.MaxActiveReportExports {
+		return nil, fmt.Errorf("%w: at most %d exports can be in progress", ErrTooManyReportExports, domain.MaxActiveReportExports)
+	}
+
+	export, err := s.exports.CreateReportExport(ctx, input)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("failed to create report export")
+		return nil, fmt.Errorf("%w: failed to request report export", ErrInternal)
+	}
+
+	s.logger.Info().
+		Int64("export_id", export.ID).
+		Int64("user_id", userID).
+		Str("report_type", string(export.ReportType)).
+		Str("format", string(export.Format)).
+		Msg("report export requested")
+
+	return &listingssvcv1.RequestReportExportResponse{
+		Export: convertReportExportToProto(export, time.Now()),
+	}, nil
+}
+
+// GetExportStatus returns an export of the caller and, once completed, a download URL
+func (s *analyticsServiceImpl) GetExportStatus(
+	ctx context.Context,
+	req *listingssvcv1.GetExportStatusRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.GetExportStatusResponse, error) {
+	if s.exports == nil || s.urlSigner == nil {
+		return nil, ErrReportExportsDisabled
+	}
+	if req.ExportId <= 0 {
+		return nil, fmt.Errorf("%w: export_id must be greater than 0", ErrInvalidInput)
+	}
+
+	export, err := s.exports.GetReportExport(ctx, req.ExportId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("export_id", req.ExportId).Msg("failed to get report export")
+		return nil, fmt.Errorf("%w: failed to retrieve report export", ErrInternal)
+	}
+	// Exports of other users are reported as missing so that IDs cannot be probed
+	if export == nil || (!isAdmin && export.RequestedBy != userID) {
+		return nil, fmt.Errorf("%w: report export %d", ErrNotFound, req.ExportId)
+	}
+
+	now := time.Now()
+	response := &listingssvcv1.GetExportStatusResponse{
+		Export: convertReportExportToProto(export, now),
+	}
+
+	if response.Export.Status == listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_COMPLETED && export.ObjectKey != nil {
+		// The URL must not outlive the file
+		ttl := exportDownloadURLTTL
+		if export.ExpiresAt != nil {
+			if remaining := export.ExpiresAt.Sub(now); remaining < ttl {
+				ttl = remaining
+			}
+		}
+
+		url, err := s.urlSigner.GetPresignedURL(ctx, *export.ObjectKey, ttl)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("export_id", export.ID).Msg("failed to generate export download URL")
+			return nil, fmt.Errorf("%w: failed to generate download URL", ErrInternal)
+		}
+		response.DownloadUrl = &url
+		response.UrlExpiresAt = timestamppb.New(now.Add(ttl))
+	}
+
+	return response, nil
+}
+
+// convertReportExportToProto converts domain.ReportExport to proto. Completed exports past
+// their expiry are reported as expired.
+func convertReportExportToProto(export *domain.ReportExport, now time.Time) *listingssvcv1.ReportExport {
+	pb := &listingssvcv1.ReportExport{
+		Id:           export.ID,
+		StorefrontId: export.StorefrontID,
+		DateFrom:     timestamppb.New(export.DateFrom),
+		DateTo:       timestamppb.New(export.DateTo),
+		Status:       reportExportStatuses[export.Status],
+		ErrorMessage: export.ErrorMessage,
+		RowCount:     export.RowCount,
+		FileSize:     export.FileSize,
+		CreatedAt:    timestamppb.New(export.CreatedAt),
+	}
+
+	for protoType, reportType := range reportExportTypes {
+		if reportType == export.ReportType {
+			pb.Type = protoType
+		}
+	}
+	pb.Format = listingssvcv1.ReportExportFormat_REPORT_EXPORT_FORMAT_CSV
+	if export.Format == domain.ReportFormatJSONL {
+		pb.Format = listingssvcv1.ReportExportFormat_REPORT_EXPORT_FORMAT_JSONL
+	}
+
+	if export.Status == domain.ReportExportStatusCompleted {
+		fileName := export.FileName()
+		pb.FileName = &fileName
+		if export.IsExpired(now) {
+			pb.Status = listingssvcv1.ReportExportStatus_REPORT_EXPORT_STATUS_EXPIRED
+		}
+	}
+	if export.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+
+	return pb
+}
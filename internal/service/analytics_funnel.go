@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingssvcv1 "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ============================================================================
+// STOREFRONT FUNNELS & COHORTS
+// ============================================================================
+
+const (
+	analyticsCacheKeyFunnel  = "analytics:funnel:%s"  // %s = MD5 hash of request
+	analyticsCacheKeyCohorts = "analytics:cohorts:%s" // %s = MD5 hash of request
+
+	funnelStatsCacheTTL = 15 * time.Minute
+)
+
+// GetStorefrontFunnel retrieves the purchase funnel of a storefront or one of its products
+func (s *analyticsServiceImpl) GetStorefrontFunnel(
+	ctx context.Context,
+	req *listingssvcv1.GetStorefrontFunnelRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.GetStorefrontFunnelResponse, error) {
+	if req.DateFrom == nil || req.DateTo == nil {
+		return nil, fmt.Errorf("%w: date_from and date_to are required", ErrInvalidInput)
+	}
+
+	filter := &domain.GetFunnelFilter{
+		StorefrontID: req.StorefrontId,
+		ListingID:    req.ListingId,
+		StartDate:    req.DateFrom.AsTime(),
+		EndDate:      req.DateTo.AsTime(),
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	if err := s.requireStorefrontAnalyticsAccess(ctx, userID, isAdmin, filter.StorefrontID); err != nil {
+		s.logger.Warn().
+			Int64("user_id", userID).
+			Int64("storefront_id", filter.StorefrontID).
+			Msg("unauthorized access to storefront funnel")
+		return nil, err
+	}
+
+	// A product funnel only makes sense for a product of this storefront
+	if filter.ListingID != nil {
+		info, err := s.repo.GetListingAnalyticsInfo(ctx, *filter.ListingID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to retrieve listing", ErrInternal)
+		}
+		if info == nil || info.StorefrontID == nil || *info.StorefrontID != filter.StorefrontID {
+			return nil, fmt.Errorf("%w: listing %d in storefront %d", ErrNotFound, *filter.ListingID, filter.StorefrontID)
+		}
+	}
+
+	cacheKey := analyticsRequestCacheKey(analyticsCacheKeyFunnel, req)
+	var cached listingssvcv1.GetStorefrontFunnelResponse
+	if hit, _ := s.cache.getJSON(ctx, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	current, err := s.repo.GetFunnelStats(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get funnel stats")
+		return nil, fmt.Errorf("%w: failed to retrieve funnel analytics", ErrInternal)
+	}
+
+	response := &listingssvcv1.GetStorefrontFunnelResponse{
+		StorefrontId: filter.StorefrontID,
+		ListingId:    filter.ListingID,
+		Current:      convertFunnelStatsToProto(current),
+		GeneratedAt:  timestamppb.New(time.Now()),
+	}
+
+	if req.ComparePrevious {
+		previous, err := s.repo.GetFunnelStats(ctx, filter.PreviousPeriod())
+		if err != nil {
+			s.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get previous funnel stats")
+			return nil, fmt.Errorf("%w: failed to retrieve funnel analytics", ErrInternal)
+		}
+
+		cmp := domain.CompareFunnels(previous, current)
+		response.Previous = convertFunnelStatsToProto(previous)
+		response.Comparison = &listingssvcv1.FunnelComparison{
+			ImpressionsGrowthPercent: cmp.ImpressionsGrowth,
+			ViewsGrowthPercent:       cmp.ViewsGrowth,
+			EngagedGrowthPercent:     cmp.EngagedGrowth,
+			OrderedGrowthPercent:     cmp.OrderedGrowth,
+			DeliveredGrowthPercent:   cmp.DeliveredGrowth,
+			ConversionRateChange:     cmp.ConversionRateChange,
+		}
+	}
+
+	if err := s.cache.setJSON(ctx, cacheKey, response, funnelStatsCacheTTL); err != nil {
+		s.logger.Warn().Err(err).Str("cache_key", cacheKey).Msg("failed to cache funnel stats")
+	}
+
+	return response, nil
+}
+
+// GetBuyerCohorts retrieves weekly repeat-buyer cohorts of a storefront
+func (s *analyticsServiceImpl) GetBuyerCohorts(
+	ctx context.Context,
+	req *listingssvcv1.GetBuyerCohortsRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.GetBuyerCohortsResponse, error) {
+	filter := &domain.GetCohortsFilter{
+		StorefrontID: req.StorefrontId,
+		Weeks:        int(req.GetWeeks()),
+	}
+	if req.DateTo != nil {
+		filter.EndDate = req.DateTo.AsTime()
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	if err := s.requireStorefrontAnalyticsAccess(ctx, userID, isAdmin, filter.StorefrontID); err != nil {
+		s.logger.Warn().
+			Int64("user_id", userID).
+			Int64("storefront_id", filter.StorefrontID).
+			Msg("unauthorized access to buyer cohorts")
+		return nil, err
+	}
+
+	// Key on the resolved week rather than the raw request so "now" requests share the cache
+	cacheKey := analyticsRequestCacheKey(analyticsCacheKeyCohorts, struct {
+		StorefrontID    int64
+		Weeks           int
+		FirstWeek       time.Time
+		ComparePrevious bool
+	}{filter.StorefrontID, filter.Weeks, filter.FirstWeek(), req.ComparePrevious})
+	var cached listingssvcv1.GetBuyerCohortsResponse
+	if hit, _ := s.cache.getJSON(ctx, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	stats, err := s.repo.GetBuyerCohorts(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get buyer cohorts")
+		return nil, fmt.Errorf("%w: failed to retrieve cohort analytics", ErrInternal)
+	}
+
+	response := &listingssvcv1.GetBuyerCohortsResponse{
+		StorefrontId: filter.StorefrontID,
+		Cohorts:      make([]*listingssvcv1.BuyerCohort, 0, len(stats.Cohorts)),
+		Summary:      convertCohortSummaryToProto(stats),
+		GeneratedAt:  timestamppb.New(time.Now()),
+	}
+	for _, c := range stats.Cohorts {
+		cohort := &listingssvcv1.BuyerCohort{
+			CohortWeek:       timestamppb.New(c.CohortWeek),
+			Buyers:           int32(c.Buyers),
+			Returning:        make([]int32, len(c.Returning)),
+			RetentionPercent: make([]float64, len(c.Returning)),
+			RepeatBuyers:     int32(c.RepeatBuyers),
+			RepeatRate:       c.RepeatRate(),
+		}
+		for i, returning := range c.Returning {
+			cohort.Returning[i] = int32(returning)
+			if c.Buyers > 0 {
+				cohort.RetentionPercent[i] = float64(returning) / float64(c.Buyers) * 100
+			}
+		}
+		response.Cohorts = append(response.Cohorts, cohort)
+	}
+
+	if req.ComparePrevious {
+		previous, err := s.repo.GetBuyerCohorts(ctx, filter.PreviousPeriod())
+		if err != nil {
+			s.logger.Error().Err(err).Int64("storefront_id", filter.StorefrontID).Msg("failed to get previous buyer cohorts")
+			return nil, fmt.Errorf("%w: failed to retrieve cohort analytics", ErrInternal)
+		}
+
+		change := stats.RepeatBuyerRate - previous.RepeatBuyerRate
+		response.Previous = convertCohortSummaryToProto(previous)
+		response.RepeatBuyerRateChange = &change
+	}
+
+	if err := s.cache.setJSON(ctx, cacheKey, response, funnelStatsCacheTTL); err != nil {
+		s.logger.Warn().Err(err).Str("cache_key", cacheKey).Msg("failed to cache buyer cohorts")
+	}
+
+	return response, nil
+}
+
+// requireStorefrontAnalyticsAccess allows admins, the storefront owner and staff with
+// analytics.read. Without an authorizer only admins pass.
+func (s *analyticsServiceImpl) requireStorefrontAnalyticsAccess(ctx context.Context, userID int64, isAdmin bool, storefrontID int64) error {
+	return s.requireStorefrontPermission(ctx, userID, isAdmin, storefrontID, domain.PermissionAnalyticsRead, "view this storefront's analytics")
+}
+
+// requireStorefrontPermission allows admins, the storefront owner and staff with the given
+// permission; action completes the denial message. Without an authorizer only admins pass.
+func (s *analyticsServiceImpl) requireStorefrontPermission(ctx context.Context, userID int64, isAdmin bool, storefrontID int64, permission, action string) error {
+	if isAdmin {
+		return nil
+	}
+	if userID <= 0 {
+		return fmt.Errorf("%w: authentication required", ErrUnauthorized)
+	}
+	if s.authorizer == nil {
+		return fmt.Errorf("%w: you don't have permission to %s", ErrUnauthorized, action)
+	}
+
+	allowed, err := s.authorizer.HasPermission(ctx, storefrontID, userID, permission)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Int64("storefront_id", storefrontID).
+			Int64("user_id", userID).
+			Str("permission", permission).
+			Msg("failed to check storefront permission")
+		return fmt.Errorf("%w: failed to verify staff permission", ErrInternal)
+	}
+	if !allowed {
+		return fmt.Errorf("%w: you don't have permission to %s", ErrUnauthorized, action)
+	}
+
+	return nil
+}
+
+// convertFunnelStatsToProto converts domain.FunnelStats to proto
+func convertFunnelStatsToProto(stats *domain.FunnelStats) *listingssvcv1.FunnelStages {
+	return &listingssvcv1.FunnelStages{
+		Impressions:    int32(stats.Impressions),
+		Views:          int32(stats.Views),
+		Engaged:        int32(stats.Engaged),
+		Ordered:        int32(stats.Ordered),
+		Delivered:      int32(stats.Delivered),
+		ViewRate:       stats.ViewRate,
+		EngagementRate: stats.EngagementRate,
+		OrderRate:      stats.OrderRate,
+		DeliveryRate:   stats.DeliveryRate,
+		ConversionRate: stats.ConversionRate,
+		DataFrom:       timestamppb.New(stats.PeriodStart),
+		DataTo:         timestamppb.New(stats.PeriodEnd),
+	}
+}
+
+// convertCohortSummaryToProto converts the totals of domain.CohortStats to proto
+func convertCohortSummaryToProto(stats *domain.CohortStats) *listingssvcv1.CohortSummary {
+	return &listingssvcv1.CohortSummary{
+		Buyers:          int32(stats.Buyers),
+		RepeatBuyers:    int32(stats.RepeatBuyers),
+		RepeatBuyerRate: stats.RepeatBuyerRate,
+	}
+}
+
+// analyticsRequestCacheKey builds an MD5-based cache key from the JSON form of keyData
+func analyticsRequestCacheKey(format string, keyData interface{}) string {
+	jsonData, _ := json.Marshal(keyData)
+	return fmt.Sprintf(format, fmt.Sprintf("%x", md5.Sum(jsonData)))
+}
+
+// getJSON loads a cached JSON value into dst; hit is false on a miss or error
+func (c *AnalyticsCache) getJSON(ctx context.Context, key string, dst interface{}) (bool, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		c.logger.Warn().Err(err).Str("key", key).Msg("cache get error")
+		return false, err
+	}
+
+	if err := json.Unmarshal(data, dst); err != nil {
+		c.logger.Error().Err(err).Str("key", key).Msg("failed to unmarshal cached value")
+		return false, err
+	}
+
+	return true, nil
+}
+
+// setJSON stores a value as JSON
+func (c *AnalyticsCache) setJSON(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		c.logger.Warn().Err(err).Str("key", key).Msg("cache set error")
+		return err
+	}
+
+	return nil
+}
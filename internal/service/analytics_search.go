@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingssvcv1 "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ============================================================================
+// SEARCH QUALITY REPORTS
+// ============================================================================
+
+const (
+	analyticsCacheKeySearchReport = "analytics:search_report:%s" // %s = MD5 hash of request
+
+	searchReportCacheTTL = 15 * time.Minute
+)
+
+// searchReportTypes maps proto report types to domain report types
+var searchReportTypes = map[listingssvcv1.SearchQueryReportType]domain.SearchQueryReportType{
+	listingssvcv1.SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_ZERO_RESULTS: domain.SearchQueryReportZeroResults,
+	listingssvcv1.SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_LOW_RESULTS:  domain.SearchQueryReportLowResults,
+	listingssvcv1.SearchQueryReportType_SEARCH_QUERY_REPORT_TYPE_TOP_QUERIES:  domain.SearchQueryReportTopQueries,
+}
+
+// GetSearchQueryReport lists zero-result, low-result or top search queries with their CTR
+func (s *analyticsServiceImpl) GetSearchQueryReport(
+	ctx context.Context,
+	req *listingssvcv1.GetSearchQueryReportRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.GetSearchQueryReportResponse, error) {
+	if err := s.requireAdmin(userID, isAdmin); err != nil {
+		s.logger.Warn().
+			Int64("user_id", userID).
+			Msg("unauthorized access to search query report")
+		return nil, err
+	}
+
+	reportType, ok := searchReportTypes[req.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: type is required", ErrInvalidInput)
+	}
+
+	filter := &domain.GetSearchQueryReportFilter{
+		Type:        reportType,
+		MaxResults:  req.GetMaxResults(),
+		CategoryID:  req.CategoryId,
+		DaysAgo:     req.GetDays(),
+		MinSearches: req.GetMinSearches(),
+		Limit:       req.GetLimit(),
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	if s.searchRepo == nil {
+		return nil, fmt.Errorf("%w: search analytics is not available", ErrInternal)
+	}
+
+	cacheKey := analyticsRequestCacheKey(analyticsCacheKeySearchReport, filter)
+	var cached listingssvcv1.GetSearchQueryReportResponse
+	if hit, _ := s.cache.getJSON(ctx, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	report, err := s.searchRepo.GetSearchQueryReport(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("type", string(filter.Type)).Msg("failed to get search query report")
+		return nil, fmt.Errorf("%w: failed to retrieve search query report", ErrInternal)
+	}
+
+	response := &listingssvcv1.GetSearchQueryReportResponse{
+		Type:               req.Type,
+		Queries:            make([]*listingssvcv1.SearchQueryStats, 0, len(report.Queries)),
+		TotalSearches:      report.TotalSearches,
+		ZeroResultSearches: report.ZeroResultSearches,
+		ZeroResultRate:     report.ZeroResultRate,
+		CtrPercent:         report.CTRPercent,
+		DataFrom:           timestamppb.New(report.PeriodStart),
+		DataTo:             timestamppb.New(report.PeriodEnd),
+		GeneratedAt:        timestamppb.New(time.Now()),
+	}
+	for _, q := range report.Queries {
+		response.Queries = append(response.Queries, &listingssvcv1.SearchQueryStats{
+			QueryText:          q.QueryText,
+			TotalSearches:      q.TotalSearches,
+			ZeroResultSearches: q.ZeroResultSearches,
+			AvgResultsCount:    q.AvgResultsCount,
+			ClickedSearches:    q.ClickedSearches,
+			TotalClicks:        q.TotalClicks,
+			AvgClickPosition:   q.AvgClickPosition,
+			CtrPercent:         q.CTRPercent,
+			LastSearched:       timestamppb.New(q.LastSearched),
+		})
+	}
+
+	if err := s.cache.setJSON(ctx, cacheKey, response, searchReportCacheTTL); err != nil {
+		s.logger.Warn().Err(err).Str("cache_key", cacheKey).Msg("failed to cache search query report")
+	}
+
+	return response, nil
+}
+
+// ============================================================================
+// SEARCH EXPERIMENTS
+// ============================================================================
+
+const (
+	analyticsCacheKeySearchExperimentResults = "analytics:search_experiment:%s" // %s = experiment ID
+
+	searchExperimentResultsCacheTTL = 5 * time.Minute
+)
+
+// searchExperimentStatuses maps proto experiment statuses to domain statuses
+var searchExperimentStatuses = map[listingssvcv1.SearchExperimentStatus]domain.SearchExperimentStatus{
+	listingssvcv1.SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_DRAFT:   domain.SearchExperimentDraft,
+	listingssvcv1.SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_RUNNING: domain.SearchExperimentRunning,
+	listingssvcv1.SearchExperimentStatus_SEARCH_EXPERIMENT_STATUS_STOPPED: domain.SearchExperimentStopped,
+}
+
+// CreateSearchExperiment defines a search ranking experiment in draft status
+func (s *analyticsServiceImpl) CreateSearchExperiment(
+	ctx context.Context,
+	req *listingssvcv1.CreateSearchExperimentRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.SearchExperimentResponse, error) {
+	if err := s.requireSearchExperiments(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	input := &domain.CreateSearchExperimentInput{
+		ID:             req.Id,
+		Description:    req.Description,
+		TrafficPercent: req.TrafficPercent,
+		Variants:       make([]domain.SearchExperimentVariant, 0, len(req.Variants)),
+	}
+	for _, v := range req.Variants {
+		variant := domain.SearchExperimentVariant{Name: v.Name, Weight: v.Weight}
+		if v.Ranking != nil {
+			variant.Ranking = &domain.SearchRanking{
+				TitleBoost:       v.Ranking.TitleBoost,
+				DescriptionBoost: v.Ranking.DescriptionBoost,
+				ViewsWeight:      v.Ranking.ViewsWeight,
+				FavoritesWeight:  v.Ranking.FavoritesWeight,
+				RatingWeight:     v.Ranking.RatingWeight,
+				BoostMode:        v.Ranking.BoostMode,
+			}
+		}
+		input.Variants = append(input.Variants, variant)
+	}
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	experiment, err := s.experiments.CreateExperiment(ctx, input)
+	if err != nil {
+		s.logger.Error().Err(err).Str("experiment_id", input.ID).Msg("failed to create search experiment")
+		return nil, fmt.Errorf("%w: failed to create search experiment", ErrInternal)
+	}
+	if experiment == nil {
+		return nil, fmt.Errorf("%w: search experiment %q already exists", ErrConflict, input.ID)
+	}
+
+	s.logger.Info().Int64("user_id", userID).Str("experiment_id", experiment.ID).Msg("search experiment created")
+
+	return &listingssvcv1.SearchExperimentResponse{Experiment: searchExperimentToProto(experiment)}, nil
+}
+
+// UpdateSearchExperimentStatus starts a draft experiment or stops a draft or running one.
+// Running experiments are picked up by the search service within a minute.
+func (s *analyticsServiceImpl) UpdateSearchExperimentStatus(
+	ctx context.Context,
+	req *listingssvcv1.UpdateSearchExperimentStatusRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.SearchExperimentResponse, error) {
+	if err := s.requireSearchExperiments(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	status, ok := searchExperimentStatuses[req.Status]
+	if !ok || status == domain.SearchExperimentDraft {
+		return nil, fmt.Errorf("%w: status must be RUNNING or STOPPED", ErrInvalidInput)
+	}
+
+	current, err := s.experiments.GetExperiment(ctx, req.Id)
+	if err != nil {
+		s.logger.Error().Err(err).Str("experiment_id", req.Id).Msg("failed to get search experiment")
+		return nil, fmt.Errorf("%w: failed to retrieve search experiment", ErrInternal)
+	}
+	if current == nil {
+		return nil, fmt.Errorf("%w: search experiment %q", ErrNotFound, req.Id)
+	}
+	if !current.CanTransitionTo(status) {
+		return nil, fmt.Errorf("%w: search experiment %q is %s", ErrConflict, req.Id, current.Status)
+	}
+
+	experiment, err := s.experiments.UpdateExperimentStatus(ctx, req.Id, current.Status, status)
+	if err != nil {
+		s.logger.Error().Err(err).Str("experiment_id", req.Id).Msg("failed to update search experiment status")
+		return nil, fmt.Errorf("%w: failed to update search experiment", ErrInternal)
+	}
+	if experiment == nil {
+		// Changed concurrently since it was read
+		return nil, fmt.Errorf("%w: search experiment %q was modified, retry", ErrConflict, req.Id)
+	}
+
+	s.logger.Info().
+		Int64("user_id", userID).
+		Str("experiment_id", experiment.ID).
+		Str("status", string(experiment.Status)).
+		Msg("search experiment status updated")
+
+	return &listingssvcv1.SearchExperimentResponse{Experiment: searchExperimentToProto(experiment)}, nil
+}
+
+// ListSearchExperiments lists search experiments, optionally of one status
+func (s *analyticsServiceImpl) ListSearchExperiments(
+	ctx context.Context,
+	req *listingssvcv1.ListSearchExperimentsRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.ListSearchExperimentsResponse, error) {
+	if err := s.requireSearchExperiments(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	var status *domain.SearchExperimentStatus
+	if req.Status != nil {
+		value, ok := searchExperimentStatuses[req.GetStatus()]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid status", ErrInvalidInput)
+		}
+		status = &value
+	}
+
+	experiments, err := s.experiments.ListExperiments(ctx, status)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list search experiments")
+		return nil, fmt.Errorf("%w: failed to list search experiments", ErrInternal)
+	}
+
+	response := &listingssvcv1.ListSearchExperimentsResponse{
+		Experiments: make([]*listingssvcv1.SearchExperiment, 0, len(experiments)),
+	}
+	for _, experiment := range experiments {
+		response.Experiments = append(response.Experiments, searchExperimentToProto(experiment))
+	}
+
+	return response, nil
+}
+
+// GetSearchExperimentResults compares CTR and conversion between the arms of an experiment
+// over its running period. Every variant is listed, baseline first, even without searches.
+func (s *analyticsServiceImpl) GetSearchExperimentResults(
+	ctx context.Context,
+	req *listingssvcv1.GetSearchExperimentResultsRequest,
+	userID int64,
+	isAdmin bool,
+) (*listingssvcv1.GetSearchExperimentResultsResponse, error) {
+	if err := s.requireSearchExperiments(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if req.ExperimentId == "" {
+		return nil, fmt.Errorf("%w: experiment_id is required", ErrInvalidInput)
+	}
+
+	cacheKey := fmt.Sprintf(analyticsCacheKeySearchExperimentResults, req.ExperimentId)
+	var cached listingssvcv1.GetSearchExperimentResultsResponse
+	if hit, _ := s.cache.getJSON(ctx, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	experiment, err := s.experiments.GetExperiment(ctx, req.ExperimentId)
+	if err != nil {
+		s.logger.Error().Err(err).Str("experiment_id", req.ExperimentId).Msg("failed to get search experiment")
+		return nil, fmt.Errorf("%w: failed to retrieve search experiment", ErrInternal)
+	}
+	if experiment == nil {
+		return nil, fmt.Errorf("%w: search experiment %q", ErrNotFound, req.ExperimentId)
+	}
+	if experiment.StartedAt == nil {
+		return nil, fmt.Errorf("%w: search experiment %q has not been started", ErrConflict, req.ExperimentId)
+	}
+
+	results := &domain.SearchExperimentResults{
+		Experiment:  experiment,
+		PeriodStart: *experiment.StartedAt,
+		PeriodEnd:   time.Now(),
+	}
+	if experiment.StoppedAt != nil {
+		results.PeriodEnd = *experiment.StoppedAt
+	}
+
+	arms, err := s.experiments.GetExperimentArms(ctx, experiment.ID, results.PeriodStart, results.PeriodEnd)
+	if err != nil {
+		s.logger.Error().Err(err).Str("experiment_id", experiment.ID).Msg("failed to get search experiment results")
+		return nil, fmt.Errorf("%w: failed to retrieve search experiment results", ErrInternal)
+	}
+
+	byVariant := make(map[string]domain.SearchExperimentArm, len(arms))
+	for _, arm := range arms {
+		byVariant[arm.Variant] = arm
+	}
+	for _, variant := range experiment.Variants {
+		arm, ok := byVariant[variant.Name]
+		if !ok {
+			arm = domain.SearchExperimentArm{Variant: variant.Name}
+		}
+		results.Arms = append(results.Arms, arm)
+	}
+	results.EnrichWithCalculatedFields()
+
+	response := &listingssvcv1.GetSearchExperimentResultsResponse{
+		Experiment:  searchExperimentToProto(experiment),
+		Arms:        make([]*listingssvcv1.SearchExperimentArmStats, 0, len(results.Arms)),
+		DataFrom:    timestamppb.New(results.PeriodStart),
+		DataTo:      timestamppb.New(results.PeriodEnd),
+		GeneratedAt: timestamppb.New(time.Now()),
+	}
+	for _, arm := range results.Arms {
+		response.Arms = append(response.Arms, &listingssvcv1.SearchExperimentArmStats{
+			Variant:               arm.Variant,
+			Searches:              arm.Searches,
+			ClickedSearches:       arm.ClickedSearches,
+			Clicks:                arm.Clicks,
+			ConvertedSearches:     arm.ConvertedSearches,
+			AvgClickPosition:      arm.AvgClickPosition,
+			CtrPercent:            arm.CTRPercent,
+			ConversionPercent:     arm.ConversionPercent,
+			CtrLiftPercent:        arm.CTRLiftPercent,
+			ConversionLiftPercent: arm.ConversionLiftPercent,
+			CtrPValue:             arm.CTRPValue,
+			ConversionPValue:      arm.ConversionPValue,
+		})
+	}
+
+	if err := s.cache.setJSON(ctx, cacheKey, response, searchExperimentResultsCacheTTL); err != nil {
+		s.logger.Warn().Err(err).Str("cache_key", cacheKey).Msg("failed to cache search experiment results")
+	}
+
+	return response, nil
+}
+
+// requireSearchExperiments checks admin access and that experiments are configured
+func (s *analyticsServiceImpl) requireSearchExperiments(userID int64, isAdmin bool) error {
+	if err := s.requireAdmin(userID, isAdmin); err != nil {
+		s.logger.Warn().
+			Int64("user_id", userID).
+			Msg("unauthorized access to search experiments")
+		return err
+	}
+	if s.experiments == nil {
+		return fmt.Errorf("%w: search experiments are not available", ErrInternal)
+	}
+	return nil
+}
+
+// searchExperimentToProto converts a search experiment to proto
+func searchExperimentToProto(experiment *domain.SearchExperiment) *listingssvcv1.SearchExperiment {
+	pb := &listingssvcv1.SearchExperiment{
+		Id:             experiment.ID,
+		Description:    experiment.Description,
+		TrafficPercent: experiment.TrafficPercent,
+		Variants:       make([]*listingssvcv1.SearchExperimentVariant, 0, len(experiment.Variants)),
+		CreatedAt:      timestamppb.New(experiment.CreatedAt),
+		UpdatedAt:      timestamppb.New(experiment.UpdatedAt),
+	}
+	for status, value := range searchExperimentStatuses {
+		if value == experiment.Status {
+			pb.Status = status
+		}
+	}
+	if experiment.StartedAt != nil {
+		pb.StartedAt = timestamppb.New(*experiment.StartedAt)
+	}
+	if experiment.StoppedAt != nil {
+		pb.StoppedAt = timestamppb.New(*experiment.StoppedAt)
+	}
+
+	for _, v := range experiment.Variants {
+		variant := &listingssvcv1.SearchExperimentVariant{Name: v.Name, Weight: v.Weight}
+		if v.Ranking != nil {
+			variant.Ranking = &listingssvcv1.SearchRanking{
+				TitleBoost:       v.Ranking.TitleBoost,
+				DescriptionBoost: v.Ranking.DescriptionBoost,
+				ViewsWeight:      v.Ranking.ViewsWeight,
+				FavoritesWeight:  v.Ranking.FavoritesWeight,
+				RatingWeight:     v.Ranking.RatingWeight,
+				BoostMode:        v.Ranking.BoostMode,
+			}
+		}
+		pb.Variants = append(pb.Variants, variant)
+	}
+
+	return pb
+}
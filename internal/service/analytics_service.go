@@ -28,8 +28,56 @@ type AnalyticsRepository interface {
 	// GetListingStats retrieves analytics for a specific listing
 	GetListingStats(ctx context.Context, filter *domain.GetListingStatsFilter) (*domain.ListingStats, error)
 
+	// GetListingAnalyticsInfo retrieves the owner and descriptive fields of a listing (nil if not found)
+	GetListingAnalyticsInfo(ctx context.Context, listingID int64) (*domain.ListingAnalyticsInfo, error)
+
+	// GetListingsAnalyticsInfo retrieves the same fields for a batch of listings (missing ones are omitted)
+	GetListingsAnalyticsInfo(ctx context.Context, listingIDs []int64) (map[int64]*domain.ListingAnalyticsInfo, error)
+
 	// GetTrendingStats retrieves platform trending analytics
 	GetTrendingStats(ctx context.Context) (*domain.TrendingStats, error)
+
+	// GetFunnelStats computes the purchase funnel of a storefront or one of its products
+	GetFunnelStats(ctx context.Context, filter *domain.GetFunnelFilter) (*domain.FunnelStats, error)
+
+	// GetBuyerCohorts computes weekly repeat-buyer cohorts of a storefront
+	GetBuyerCohorts(ctx context.Context, filter *domain.GetCohortsFilter) (*domain.CohortStats, error)
+}
+
+// SearchQueryReporter aggregates recorded search queries
+// Implemented by repository.SearchQueriesRepository
+type SearchQueryReporter interface {
+	GetSearchQueryReport(ctx context.Context, filter *domain.GetSearchQueryReportFilter) (*domain.SearchQueryReport, error)
+}
+
+// SearchExperimentStore persists search ranking experiments and aggregates their results
+// Implemented by repository.SearchExperimentsRepository
+type SearchExperimentStore interface {
+	CreateExperiment(ctx context.Context, input *domain.CreateSearchExperimentInput) (*domain.SearchExperiment, error)
+	GetExperiment(ctx context.Context, id string) (*domain.SearchExperiment, error)
+	ListExperiments(ctx context.Context, status *domain.SearchExperimentStatus) ([]*domain.SearchExperiment, error)
+	UpdateExperimentStatus(ctx context.Context, id string, from, to domain.SearchExperimentStatus) (*domain.SearchExperiment, error)
+	GetExperimentArms(ctx context.Context, id string, from, to time.Time) ([]domain.SearchExperimentArm, error)
+}
+
+// ReportExportStore persists report export requests
+// Implemented by repository.ReportExportRepository
+type ReportExportStore interface {
+	CreateReportExport(ctx context.Context, input *domain.CreateReportExportInput) (*domain.ReportExport, error)
+	GetReportExport(ctx context.Context, id int64) (*domain.ReportExport, error)
+	CountActiveReportExports(ctx context.Context, userID int64) (int, error)
+}
+
+// ExportURLSigner creates time-limited download URLs for stored export files
+// Implemented by the MinIO client
+type ExportURLSigner interface {
+	GetPresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
+}
+
+// EventTracker records analytics events without blocking the caller
+// Implemented by the buffered analytics event writer
+type EventTracker interface {
+	Track(events ...*domain.AnalyticsEvent) int
 }
 
 // AnalyticsService defines the service interface for analytics operations
@@ -37,11 +85,56 @@ type AnalyticsService interface {
 	// GetOverviewStats retrieves platform-wide analytics (admin only)
 	GetOverviewStats(ctx context.Context, req *listingssvcv1.GetOverviewStatsRequest, userID int64, isAdmin bool) (*listingssvcv1.GetOverviewStatsResponse, error)
 
-	// GetListingStats retrieves analytics for a specific listing (owner or admin)
+	// GetListingStats retrieves analytics for a specific listing (owner, storefront staff or admin)
 	GetListingStats(ctx context.Context, req *listingssvcv1.GetListingStatsRequest, userID int64, isAdmin bool) (*listingssvcv1.GetListingStatsResponse, error)
 
 	// GetTrendingStats retrieves platform trending analytics (admin only)
 	GetTrendingStats(ctx context.Context, req *listingssvcv1.GetTrendingStatsRequest) (*listingssvcv1.GetTrendingStatsResponse, error)
+
+	// GetStorefrontFunnel retrieves the purchase funnel of a storefront or product (owner, staff or admin)
+	GetStorefrontFunnel(ctx context.Context, req *listingssvcv1.GetStorefrontFunnelRequest, userID int64, isAdmin bool) (*listingssvcv1.GetStorefrontFunnelResponse, error)
+
+	// GetBuyerCohorts retrieves weekly repeat-buyer cohorts of a storefront (owner, staff or admin)
+	GetBuyerCohorts(ctx context.Context, req *listingssvcv1.GetBuyerCohortsRequest, userID int64, isAdmin bool) (*listingssvcv1.GetBuyerCohortsResponse, error)
+
+	// GetSearchQueryReport lists zero-result, low-result or top search queries (admin only)
+	GetSearchQueryReport(ctx context.Context, req *listingssvcv1.GetSearchQueryReportRequest, userID int64, isAdmin bool) (*listingssvcv1.GetSearchQueryReportResponse, error)
+
+	// CreateSearchExperiment defines a search ranking experiment in draft status (admin only)
+	CreateSearchExperiment(ctx context.Context, req *listingssvcv1.CreateSearchExperimentRequest, userID int64, isAdmin bool) (*listingssvcv1.SearchExperimentResponse, error)
+
+	// UpdateSearchExperimentStatus starts or stops a search experiment (admin only)
+	UpdateSearchExperimentStatus(ctx context.Context, req *listingssvcv1.UpdateSearchExperimentStatusRequest, userID int64, isAdmin bool) (*listingssvcv1.SearchExperimentResponse, error)
+
+	// ListSearchExperiments lists search experiments (admin only)
+	ListSearchExperiments(ctx context.Context, req *listingssvcv1.ListSearchExperimentsRequest, userID int64, isAdmin bool) (*listingssvcv1.ListSearchExperimentsResponse, error)
+
+	// GetSearchExperimentResults compares CTR and conversion between experiment arms (admin only)
+	GetSearchExperimentResults(ctx context.Context, req *listingssvcv1.GetSearchExperimentResultsRequest, userID int64, isAdmin bool) (*listingssvcv1.GetSearchExperimentResultsResponse, error)
+
+	// RequestReportExport queues a report export (storefront owner, staff or admin)
+	RequestReportExport(ctx context.Context, req *listingssvcv1.RequestReportExportRequest, userID int64, isAdmin bool) (*listingssvcv1.RequestReportExportResponse, error)
+
+	// GetExportStatus returns an export and its download URL once completed (requester or admin)
+	GetExportStatus(ctx context.Context, req *listingssvcv1.GetExportStatusRequest, userID int64, isAdmin bool) (*listingssvcv1.GetExportStatusResponse, error)
+
+	// TrackEvents validates client-side events and queues them for writing (public)
+	TrackEvents(ctx context.Context, req *listingssvcv1.TrackEventsRequest, userID *int64) (*listingssvcv1.TrackEventsResponse, error)
+
+	// SetEventTracker sets the tracker that receives client-side events
+	SetEventTracker(tracker EventTracker)
+
+	// SetStorefrontAuthorizer lets staff with analytics.read view stats of storefront listings
+	SetStorefrontAuthorizer(authorizer StorefrontAuthorizer)
+
+	// SetSearchQueryReporter sets the source of search quality reports
+	SetSearchQueryReporter(reporter SearchQueryReporter)
+
+	// SetSearchExperiments sets the store of search ranking experiments
+	SetSearchExperiments(store SearchExperimentStore)
+
+	// SetReportExports enables report exports (rendered by the report export worker)
+	SetReportExports(store ReportExportStore, signer ExportURLSigner)
 }
 
 // ============================================================================
@@ -69,9 +162,15 @@ const (
 
 // analyticsServiceImpl implements AnalyticsService interface
 type analyticsServiceImpl struct {
-	repo   AnalyticsRepository
-	cache  *AnalyticsCache
-	logger zerolog.Logger
+	repo        AnalyticsRepository
+	cache       *AnalyticsCache
+	tracker     EventTracker          // Optional: event ingestion (TrackEvents)
+	authorizer  StorefrontAuthorizer  // Optional: staff access to storefront listings
+	searchRepo  SearchQueryReporter   // Optional: search quality reports
+	experiments SearchExperimentStore // Optional: search ranking experiments
+	exports     ReportExportStore     // Optional: report exports
+	urlSigner   ExportURLSigner       // Optional: download URLs of report exports
+	logger      zerolog.Logger
 }
 
 // AnalyticsCache provides caching functionality for analytics
@@ -202,10 +301,21 @@ func (s *analyticsServiceImpl) GetListingStats(
 		return nil, fmt.Errorf("%w: listing_id or product_id is required", ErrInvalidInput)
 	}
 
-	// Authorization: owner or admin only
-	// Note: In production, we'd fetch listing owner from DB and compare
-	// For now, we rely on the caller to pass correct userID and isAdmin
-	if err := s.requireListingAccess(userID, isAdmin, listingID); err != nil {
+	// Load the listing owner before anything else: the cache is shared by all callers
+	info, err := s.repo.GetListingAnalyticsInfo(ctx, listingID)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Int64("listing_id", listingID).
+			Msg("failed to get listing info")
+		return nil, fmt.Errorf("%w: failed to retrieve listing", ErrInternal)
+	}
+	if info == nil {
+		return nil, fmt.Errorf("%w: listing %d", ErrNotFound, listingID)
+	}
+
+	// Authorization: owner, storefront staff or admin
+	if err := s.requireListingAccess(ctx, userID, isAdmin, info); err != nil {
 		s.logger.Warn().
 			Int64("user_id", userID).
 			Bool("is_admin", isAdmin).
@@ -247,7 +357,7 @@ func (s *analyticsServiceImpl) GetListingStats(
 	stats.EnrichWithCalculatedFields()
 
 	// Convert to proto response
-	response := s.convertListingStatsToProto(stats)
+	response := s.convertListingStatsToProto(stats, info)
 
 	// Cache the result
 	if err := s.cache.SetListingStats(ctx, cacheKey, response, listingStatsCacheTTL); err != nil {
@@ -270,6 +380,146 @@ func (s *analyticsServiceImpl) GetListingStats(
 	return response, nil
 }
 
+// SetEventTracker sets the tracker that receives client-side events
+func (s *analyticsServiceImpl) SetEventTracker(tracker EventTracker) {
+	s.tracker = tracker
+}
+
+// SetStorefrontAuthorizer sets the authorizer used for staff access to storefront listings
+func (s *analyticsServiceImpl) SetStorefrontAuthorizer(authorizer StorefrontAuthorizer) {
+	s.authorizer = authorizer
+}
+
+// SetSearchQueryReporter sets the source of search quality reports
+func (s *analyticsServiceImpl) SetSearchQueryReporter(reporter SearchQueryReporter) {
+	s.searchRepo = reporter
+}
+
+// SetSearchExperiments sets the store of search ranking experiments
+func (s *analyticsServiceImpl) SetSearchExperiments(store SearchExperimentStore) {
+	s.experiments = store
+}
+
+// SetReportExports sets the report export store and the signer of download URLs
+func (s *analyticsServiceImpl) SetReportExports(store ReportExportStore, signer ExportURLSigner) {
+	s.exports = store
+	s.urlSigner = signer
+}
+
+// TrackEvents validates client-side events and queues them for writing
+// Invalid events are rejected individually instead of failing the whole batch
+func (s *analyticsServiceImpl) TrackEvents(
+	ctx context.Context,
+	req *listingssvcv1.TrackEventsRequest,
+	userID *int64,
+) (*listingssvcv1.TrackEventsResponse, error) {
+	if s.tracker == nil {
+		return nil, ErrEventTrackingDisabled
+	}
+
+	if len(req.Events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", ErrInvalidInput)
+	}
+	if len(req.Events) > domain.MaxTrackEventsBatch {
+		return nil, fmt.Errorf("%w: at most %d events per batch", ErrInvalidInput, domain.MaxTrackEventsBatch)
+	}
+
+	now := time.Now().UTC()
+	events := make([]*domain.AnalyticsEvent, 0, len(req.Events))
+	rejected := 0
+
+	for _, in := range req.Events {
+		event := &domain.AnalyticsEvent{
+			EventType: in.EventType,
+			EntityID:  in.EntityId,
+			UserID:    userID,
+			SessionID: req.SessionId,
+		}
+		if len(in.Metadata) > 0 {
+			event.Metadata = make(map[string]interface{}, len(in.Metadata))
+			for k, v := range in.Metadata {
+				event.Metadata[k] = v
+			}
+		}
+		if in.OccurredAt != nil {
+			event.CreatedAt = in.OccurredAt.AsTime()
+		}
+
+		if err := event.ValidateClientEvent(now); err != nil {
+			s.logger.Debug().
+				Err(err).
+				Str("event_type", in.EventType).
+				Int64("entity_id", in.EntityId).
+				Msg("rejected analytics event")
+			rejected++
+			continue
+		}
+		events = append(events, event)
+	}
+
+	// Listing attributes in metadata come from the listing, not the client
+	events, unknown, err := s.attachListingMetadata(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+	rejected += unknown
+
+	accepted := 0
+	if len(events) > 0 {
+		accepted = s.tracker.Track(events...)
+		if accepted == 0 {
+			// Nothing fit into the buffer - tell the client to back off and retry later
+			return nil, ErrEventBufferFull
+		}
+	}
+
+	return &listingssvcv1.TrackEventsResponse{
+		Accepted: int32(accepted),
+		Rejected: int32(rejected),
+		Dropped:  int32(len(events) - accepted),
+	}, nil
+}
+
+// attachListingMetadata sets the listing attributes of listing events from the listings and
+// strips them from search events. Events of unknown listings are dropped and counted.
+func (s *analyticsServiceImpl) attachListingMetadata(ctx context.Context, events []*domain.AnalyticsEvent) ([]*domain.AnalyticsEvent, int, error) {
+	listingIDs := make([]int64, 0, len(events))
+	for _, event := range events {
+		if event.EntityType == domain.AnalyticsEntityListing {
+			listingIDs = append(listingIDs, event.EntityID)
+		}
+	}
+
+	var infos map[int64]*domain.ListingAnalyticsInfo
+	if len(listingIDs) > 0 {
+		var err error
+		infos, err = s.repo.GetListingsAnalyticsInfo(ctx, listingIDs)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to load listings of events: %w", err)
+		}
+	}
+
+	known := events[:0]
+	unknown := 0
+	for _, event := range events {
+		if event.EntityType != domain.AnalyticsEntityListing {
+			event.SetListingMetadata(nil)
+			known = append(known, event)
+			continue
+		}
+
+		info, ok := infos[event.EntityID]
+		if !ok {
+			unknown++
+			continue
+		}
+		event.SetListingMetadata(info)
+		known = append(known, event)
+	}
+
+	return known, unknown, nil
+}
+
 // ============================================================================
 // VALIDATION METHODS
 // ============================================================================
@@ -359,20 +609,39 @@ func (s *analyticsServiceImpl) requireAdmin(userID int64, isAdmin bool) error {
 	return nil
 }
 
-// requireListingAccess checks if user has access to listing stats (owner or admin)
-func (s *analyticsServiceImpl) requireListingAccess(userID int64, isAdmin bool, listingID int64) error {
+// requireListingAccess checks if user has access to listing stats. Storefront listings are
+// visible to the storefront owner and staff with analytics.read, C2C listings to their owner.
+func (s *analyticsServiceImpl) requireListingAccess(ctx context.Context, userID int64, isAdmin bool, info *domain.ListingAnalyticsInfo) error {
 	// Admin has access to all listings
 	if isAdmin {
 		return nil
 	}
 
-	// TODO: In production, fetch listing from DB and check if userID matches owner_id
-	// For now, we assume the caller has already verified ownership
-	// This is a placeholder that should be replaced with actual DB lookup
 	if userID <= 0 {
 		return fmt.Errorf("%w: authentication required", ErrUnauthorized)
 	}
 
+	// Without an authorizer, storefront listings fall back to the listing's creator
+	if info.StorefrontID != nil && s.authorizer != nil {
+		allowed, err := s.authorizer.HasPermission(ctx, *info.StorefrontID, userID, domain.PermissionAnalyticsRead)
+		if err != nil {
+			s.logger.Error().
+				Err(err).
+				Int64("storefront_id", *info.StorefrontID).
+				Int64("user_id", userID).
+				Msg("failed to check storefront permission")
+			return fmt.Errorf("%w: failed to verify staff permission", ErrInternal)
+		}
+		if allowed {
+			return nil
+		}
+		return fmt.Errorf("%w: you don't have permission to view this listing's analytics", ErrUnauthorized)
+	}
+
+	if info.UserID != userID {
+		return fmt.Errorf("%w: you don't have permission to view this listing's analytics", ErrUnauthorized)
+	}
+
 	return nil
 }
 
@@ -488,11 +757,14 @@ func (s *analyticsServiceImpl) convertOverviewStatsToProto(stats *domain.Overvie
 }
 
 // convertListingStatsToProto converts domain.ListingStats to proto response
-func (s *analyticsServiceImpl) convertListingStatsToProto(stats *domain.ListingStats) *listingssvcv1.GetListingStatsResponse {
+func (s *analyticsServiceImpl) convertListingStatsToProto(stats *domain.ListingStats, info *domain.ListingAnalyticsInfo) *listingssvcv1.GetListingStatsResponse {
 	response := &listingssvcv1.GetListingStatsResponse{
 		ListingId:      stats.ListingID,
-		ListingName:    "",    // TODO: Fetch from listing table
-		ListingType:    "b2c", // TODO: Determine from listing
+		ListingName:    info.Title,
+		ListingType:    info.SourceType,
+		StorefrontId:   info.StorefrontID,
+		CategoryId:     info.CategoryID,
+		CategoryName:   info.CategoryName,
 		TotalViews:     int32(stats.ViewsCount),
 		FavoriteCount:  int32(stats.FavoritesCount),
 		TotalSales:     int32(stats.OrdersCount),
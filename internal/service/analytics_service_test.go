@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeStorefrontAuthorizer grants permissions per (storefront, user)
+type fakeStorefrontAuthorizer struct {
+	granted map[int64]map[int64][]string
+	err     error
+}
+
+func (f *fakeStorefrontAuthorizer) HasPermission(_ context.Context, storefrontID, userID int64, permission string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	for _, granted := range f.granted[storefrontID][userID] {
+		if granted == permission {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestRequireListingAccess(t *testing.T) {
+	storefrontID := int64(10)
+	authorizer := &fakeStorefrontAuthorizer{granted: map[int64]map[int64][]string{
+		storefrontID: {
+			2: {domain.PermissionAnalyticsRead},
+			3: {domain.PermissionOrdersRead},
+		},
+	}}
+	s := &analyticsServiceImpl{logger: zerolog.Nop()}
+	s.SetStorefrontAuthorizer(authorizer)
+
+	c2c := &domain.ListingAnalyticsInfo{ListingID: 1, SourceType: "c2c", UserID: 1}
+	b2c := &domain.ListingAnalyticsInfo{ListingID: 2, SourceType: "b2c", UserID: 1, StorefrontID: &storefrontID}
+
+	tests := []struct {
+		name    string
+		userID  int64
+		isAdmin bool
+		info    *domain.ListingAnalyticsInfo
+		wantErr error
+	}{
+		{name: "c2c owner", userID: 1, info: c2c},
+		{name: "c2c other user", userID: 4, info: c2c, wantErr: ErrUnauthorized},
+		{name: "staff with analytics.read", userID: 2, info: b2c},
+		{name: "staff without analytics.read", userID: 3, info: b2c, wantErr: ErrUnauthorized},
+		{name: "unrelated user", userID: 4, info: b2c, wantErr: ErrUnauthorized},
+		{name: "admin", userID: 5, isAdmin: true, info: b2c},
+		{name: "anonymous", userID: 0, info: c2c, wantErr: ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.requireListingAccess(context.Background(), tt.userID, tt.isAdmin, tt.info)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRequireListingAccess_AuthorizerError(t *testing.T) {
+	storefrontID := int64(10)
+	s := &analyticsServiceImpl{logger: zerolog.Nop()}
+	s.SetStorefrontAuthorizer(&fakeStorefrontAuthorizer{err: errors.New("connection refused")})
+
+	err := s.requireListingAccess(context.Background(), 2, false, &domain.ListingAnalyticsInfo{UserID: 1, StorefrontID: &storefrontID})
+
+	assert.ErrorIs(t, err, ErrInternal, "a failed permission check is not a permission denial")
+	assert.NotErrorIs(t, err, ErrUnauthorized)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -28,6 +29,9 @@ type CartService interface {
 	MergeSessionCartToUser(ctx context.Context, sessionID string, userID int64) error
 	RecalculateCart(ctx context.Context, cartID int64) (*domain.Cart, error)
 	ValidateCartItems(ctx context.Context, cartID int64) ([]PriceChangeItem, error)
+
+	// SetEventTracker sets the tracker that receives add_to_cart analytics events
+	SetEventTracker(tracker EventTracker)
 }
 
 // AddToCartRequest contains parameters for adding items to cart
@@ -53,6 +57,7 @@ type cartService struct {
 	productsRepo   *postgres.Repository
 	storefrontRepo *postgres.Repository
 	db             *postgres.Repository
+	eventTracker   EventTracker // Optional: add_to_cart analytics events
 	logger         zerolog.Logger
 }
 
@@ -73,6 +78,26 @@ func NewCartService(
 	}
 }
 
+// SetEventTracker sets the tracker that receives add_to_cart analytics events
+func (s *cartService) SetEventTracker(tracker EventTracker) {
+	s.eventTracker = tracker
+}
+
+// trackAddToCart records an add_to_cart event; cart items are deleted at checkout, so the
+// event is what keeps cart additions countable in conversion funnels
+func (s *cartService) trackAddToCart(req *AddToCartRequest) {
+	if s.eventTracker == nil {
+		return
+	}
+	event := domain.NewListingEvent(domain.AnalyticsEventAddToCart, req.ListingID, req.UserID, map[string]interface{}{
+		"storefront_id": req.StorefrontID,
+		"quantity":      req.Quantity,
+		"source_type":   "b2c",
+	})
+	event.SessionID = req.SessionID
+	s.eventTracker.Track(event)
+}
+
 // AddToCart adds an item to the shopping cart
 func (s *cartService) AddToCart(ctx context.Context, req *AddToCartRequest) (*domain.Cart, error) {
 	s.logger.Debug().
@@ -121,6 +146,11 @@ func (s *cartService) AddToCart(ctx context.Context, req *AddToCartRequest) (*do
 		}
 	}
 
+	// Storefronts on vacation with the block policy do not take new items
+	if err := s.checkStorefrontAcceptsOrders(ctx, req.StorefrontID); err != nil {
+		return nil, err
+	}
+
 	// Get or create cart
 	var cart *domain.Cart
 	if req.UserID != nil {
@@ -182,6 +212,8 @@ func (s *cartService) AddToCart(ctx context.Context, req *AddToCartRequest) (*do
 		}
 	}
 
+	s.trackAddToCart(req)
+
 	s.logger.Info().Int64("cart_id", cart.ID).Int64("listing_id", req.ListingID).Msg("item added to cart")
 	return cart, nil
 }
@@ -637,3 +669,18 @@ func (s *cartService) RemoveFromCartByItemID(ctx context.Context, cartItemID int
 	// Call existing RemoveFromCart method
 	return s.RemoveFromCart(ctx, cartItem.CartID, cartItemID)
 }
+
+// checkStorefrontAcceptsOrders returns ErrStorefrontOnVacation when the storefront is on
+// vacation and blocks orders
+func (s *cartService) checkStorefrontAcceptsOrders(ctx context.Context, storefrontID int64) error {
+	storefront, err := s.storefrontRepo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", storefrontID).Msg("failed to get storefront")
+		return fmt.Errorf("failed to get storefront: %w", err)
+	}
+
+	if !storefront.AcceptsOrders(time.Now()) {
+		return &ErrStorefrontOnVacation{StorefrontID: storefrontID, Notice: storefront.VacationNotice()}
+	}
+	return nil
+}
@@ -64,6 +64,16 @@ func (e ErrListingInactive) Error() string {
 	return fmt.Sprintf("listing %d is not active", e.ListingID)
 }
 
+// ErrStorefrontOnVacation indicates that the storefront is on vacation and does not take orders
+type ErrStorefrontOnVacation struct {
+	StorefrontID int64
+	Notice       string
+}
+
+func (e ErrStorefrontOnVacation) Error() string {
+	return fmt.Sprintf("storefront %d does not accept orders: %s", e.StorefrontID, e.Notice)
+}
+
 // PriceChangeItem represents a single item with price change
 type PriceChangeItem struct {
 	ListingID     int64
@@ -349,9 +359,11 @@ func IsConflictError(err error) bool {
 	var orderCannotUpdateStatus *ErrOrderCannotUpdateStatus
 	var orderInvalidStatus *ErrOrderInvalidStatus
 	var orderMissingTrackingNumber *ErrOrderMissingTrackingNumber
+	var storefrontOnVacation *ErrStorefrontOnVacation
 
 	return errors.As(err, &priceChanged) ||
 		errors.As(err, &storefrontMismatch) ||
+		errors.As(err, &storefrontOnVacation) ||
 		errors.As(err, &insufficientStock) ||
 		errors.As(err, &orderCannotCancel) ||
 		errors.As(err, &orderCannotUpdateStatus) ||
@@ -407,3 +419,19 @@ func (e ErrTrackingInfoNotAvailable) Error() string {
 	}
 	return fmt.Sprintf("tracking info not available for order %d", e.OrderID)
 }
+
+// Analytics event ingestion errors
+
+// ErrEventTrackingDisabled indicates that no event tracker is configured
+var ErrEventTrackingDisabled = errors.New("event tracking is disabled")
+
+// ErrEventBufferFull indicates that the event buffer is full and the batch was dropped
+var ErrEventBufferFull = errors.New("event buffer is full")
+
+// Report export errors
+
+// ErrReportExportsDisabled indicates that report exports are not configured (no object storage)
+var ErrReportExportsDisabled = errors.New("report exports are disabled")
+
+// ErrTooManyReportExports indicates that the user already has the maximum number of exports in progress
+var ErrTooManyReportExports = errors.New("too many report exports in progress")
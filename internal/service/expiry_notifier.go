@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ExpiryNotifier sends listing expiry reminders to owners as system chat messages
+type ExpiryNotifier struct {
+	chatService ChatService
+	logger      zerolog.Logger
+}
+
+// NewExpiryNotifier creates a new expiry reminder notifier backed by the chat service
+func NewExpiryNotifier(chatService ChatService, logger zerolog.Logger) *ExpiryNotifier {
+	return &ExpiryNotifier{
+		chatService: chatService,
+		logger:      logger.With().Str("component", "expiry_notifier").Logger(),
+	}
+}
+
+// NotifyListingExpiring reminds the owner that the listing expires soon and can be renewed
+func (n *ExpiryNotifier) NotifyListingExpiring(ctx context.Context, listing *domain.ExpiringListing, daysLeft int) error {
+	when := fmt.Sprintf("in %d days", daysLeft)
+	switch daysLeft {
+	case 0:
+		when = "today"
+	case 1:
+		when = "tomorrow"
+	}
+
+	message := fmt.Sprintf(
+		"⏰ Your listing expires %s\n\n"+
+			"%s\n"+
+			"Expires: %s\n\n"+
+			"Renew it to keep it visible in search.",
+		when,
+		listing.Title,
+		listing.ExpiresAt.Format("02.01.2006 15:04"),
+	)
+
+	req := &SendSystemMessageRequest{
+		ReceiverID:       listing.UserID,
+		Content:          message,
+		OriginalLanguage: "en",
+	}
+
+	if _, err := n.chatService.SendSystemMessage(ctx, req); err != nil {
+		return fmt.Errorf("failed to send expiry reminder: %w", err)
+	}
+
+	n.logger.Debug().
+		Int64("listing_id", listing.ID).
+		Int64("user_id", listing.UserID).
+		Int("days_left", daysLeft).
+		Msg("expiry reminder sent")
+	return nil
+}
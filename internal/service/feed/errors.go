@@ -0,0 +1,14 @@
+package feed
+
+import "errors"
+
+var (
+	// ErrStorefrontNotFound is returned when the requested storefront does not exist
+	ErrStorefrontNotFound = errors.New("storefront not found")
+
+	// ErrStorefrontInactive is returned when the storefront is not active (no public feed)
+	ErrStorefrontInactive = errors.New("storefront is not active")
+
+	// ErrUnsupportedFormat is returned when the requested feed format is unknown
+	ErrUnsupportedFormat = errors.New("unsupported feed format")
+)
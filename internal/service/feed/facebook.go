@@ -0,0 +1,95 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// facebookColumns is the column order of the Facebook catalog CSV feed
+var facebookColumns = []string{
+	"id",
+	"title",
+	"description",
+	"availability",
+	"condition",
+	"price",
+	"sale_price",
+	"link",
+	"image_link",
+	"additional_image_link",
+	"brand",
+	"gtin",
+	"mpn",
+	"item_group_id",
+	"product_type",
+	"color",
+	"size",
+}
+
+// RenderFacebook renders a feed as a Facebook (Meta) catalog CSV
+func RenderFacebook(feed *domain.ProductFeed) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(facebookColumns); err != nil {
+		return nil, fmt.Errorf("failed to write facebook feed header: %w", err)
+	}
+
+	for i := range feed.Items {
+		item := &feed.Items[i]
+
+		salePrice := ""
+		if item.SalePrice != nil {
+			salePrice = formatFeedPrice(*item.SalePrice, item.Currency)
+		}
+
+		record := []string{
+			item.ID,
+			item.Title,
+			item.Description,
+			facebookAvailability(item.Availability),
+			item.Condition,
+			formatFeedPrice(item.Price, item.Currency),
+			salePrice,
+			item.Link,
+			item.ImageLink,
+			strings.Join(item.AdditionalImageLinks, ","),
+			item.Brand,
+			item.GTIN,
+			item.MPN,
+			item.ItemGroupID,
+			item.ProductType,
+			attributeValue(item.Attributes, "color", "colour", "boja"),
+			attributeValue(item.Attributes, "size", "velicina"),
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write facebook feed item %s: %w", item.ID, err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush facebook feed: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// facebookAvailability maps feed availability to Facebook catalog values
+func facebookAvailability(a domain.FeedAvailability) string {
+	switch a {
+	case domain.FeedAvailabilityInStock:
+		return "in stock"
+	case domain.FeedAvailabilityPreOrder:
+		return "preorder"
+	case domain.FeedAvailabilityDiscontinued:
+		return "discontinued"
+	default:
+		return "out of stock"
+	}
+}
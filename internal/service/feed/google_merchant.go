@@ -0,0 +1,147 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// googleNamespace is the Google Merchant Center product namespace
+const googleNamespace = "http://base.google.com/ns/1.0"
+
+// googleRSS is the RSS 2.0 envelope of a Google Merchant feed
+type googleRSS struct {
+	XMLName xml.Name      `xml:"rss"`
+	Version string        `xml:"version,attr"`
+	XMLNSG  string        `xml:"xmlns:g,attr"`
+	Channel googleChannel `xml:"channel"`
+}
+
+type googleChannel struct {
+	Title       string       `xml:"title"`
+	Link        string       `xml:"link"`
+	Description string       `xml:"description"`
+	Items       []googleItem `xml:"item"`
+}
+
+// googleItem is a single product entry (g: attributes per Merchant Center spec)
+type googleItem struct {
+	ID                   string   `xml:"g:id"`
+	Title                string   `xml:"title"`
+	Description          string   `xml:"description"`
+	Link                 string   `xml:"link"`
+	ImageLink            string   `xml:"g:image_link,omitempty"`
+	AdditionalImageLinks []string `xml:"g:additional_image_link,omitempty"`
+	Availability         string   `xml:"g:availability"`
+	Price                string   `xml:"g:price"`
+	SalePrice            string   `xml:"g:sale_price,omitempty"`
+	Brand                string   `xml:"g:brand,omitempty"`
+	Condition            string   `xml:"g:condition"`
+	GTIN                 string   `xml:"g:gtin,omitempty"`
+	MPN                  string   `xml:"g:mpn,omitempty"`
+	IdentifierExists     string   `xml:"g:identifier_exists,omitempty"`
+	ItemGroupID          string   `xml:"g:item_group_id,omitempty"`
+	ProductType          string   `xml:"g:product_type,omitempty"`
+	Color                string   `xml:"g:color,omitempty"`
+	Size                 string   `xml:"g:size,omitempty"`
+	Material             string   `xml:"g:material,omitempty"`
+	Pattern              string   `xml:"g:pattern,omitempty"`
+}
+
+// RenderGoogleMerchant renders a feed as Google Merchant Center RSS 2.0 XML
+func RenderGoogleMerchant(feed *domain.ProductFeed) ([]byte, error) {
+	rss := googleRSS{
+		Version: "2.0",
+		XMLNSG:  googleNamespace,
+		Channel: googleChannel{
+			Title:       feed.StorefrontName,
+			Link:        feed.Link,
+			Description: feed.Description,
+			Items:       make([]googleItem, 0, len(feed.Items)),
+		},
+	}
+	if rss.Channel.Description == "" {
+		rss.Channel.Description = feed.StorefrontName
+	}
+
+	for i := range feed.Items {
+		item := &feed.Items[i]
+
+		gi := googleItem{
+			ID:                   item.ID,
+			Title:                item.Title,
+			Description:          item.Description,
+			Link:                 item.Link,
+			ImageLink:            item.ImageLink,
+			AdditionalImageLinks: item.AdditionalImageLinks,
+			Availability:         googleAvailability(item.Availability),
+			Price:                formatFeedPrice(item.Price, item.Currency),
+			Brand:                item.Brand,
+			Condition:            item.Condition,
+			GTIN:                 item.GTIN,
+			MPN:                  item.MPN,
+			ItemGroupID:          item.ItemGroupID,
+			ProductType:          item.ProductType,
+			Color:                attributeValue(item.Attributes, "color", "colour", "boja"),
+			Size:                 attributeValue(item.Attributes, "size", "velicina"),
+			Material:             attributeValue(item.Attributes, "material", "materijal"),
+			Pattern:              attributeValue(item.Attributes, "pattern"),
+		}
+		if item.SalePrice != nil {
+			gi.SalePrice = formatFeedPrice(*item.SalePrice, item.Currency)
+		}
+		if !item.HasIdentifier() {
+			gi.IdentifierExists = "no"
+		}
+
+		rss.Channel.Items = append(rss.Channel.Items, gi)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(rss); err != nil {
+		return nil, fmt.Errorf("failed to encode google merchant feed: %w", err)
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// googleAvailability maps feed availability to Google Merchant values
+func googleAvailability(a domain.FeedAvailability) string {
+	switch a {
+	case domain.FeedAvailabilityInStock:
+		return "in_stock"
+	case domain.FeedAvailabilityPreOrder:
+		return "preorder"
+	default:
+		// Google has no "discontinued" value
+		return "out_of_stock"
+	}
+}
+
+// formatFeedPrice formats a price as "<amount> <ISO 4217 currency>"
+func formatFeedPrice(price float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", price, strings.ToUpper(currency))
+}
+
+// attributeValue returns the first non-empty attribute matching any of the keys (case-insensitive)
+func attributeValue(attrs map[string]string, keys ...string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	for _, key := range keys {
+		for k, v := range attrs {
+			if strings.EqualFold(k, key) && v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
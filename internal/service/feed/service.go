@@ -0,0 +1,318 @@
+// Package feed generates product feeds for external shopping channels
+// (Google Merchant Center, Facebook catalog) from storefront products.
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const (
+	// feedCacheKey is the Redis key for a rendered feed (%s = storefront slug, %s = format)
+	feedCacheKey = "feed:%s:%s"
+
+	// defaultPageSize is the number of products loaded per repository call
+	defaultPageSize = 100
+
+	// maxAdditionalImages is the Google Merchant limit for additional_image_link
+	maxAdditionalImages = 10
+)
+
+// Repository defines the data access needed to build a feed
+type Repository interface {
+	GetStorefrontBySlug(ctx context.Context, slug string, includes *domain.Includes) (*domain.Storefront, error)
+	ListProducts(ctx context.Context, storefrontID int64, page, pageSize int, isActiveOnly bool) ([]*domain.Product, int, error)
+	GetVariantsByProductID(ctx context.Context, productID int64, isActiveOnly bool) ([]*domain.ProductVariant, error)
+	GetCategoryByID(ctx context.Context, categoryID int64) (*domain.Category, error)
+}
+
+// Config holds feed generation settings
+type Config struct {
+	BaseURL  string        // Public marketplace URL used for product links
+	CacheTTL time.Duration // How long a rendered feed is served from cache
+	PageSize int           // Products loaded per page while building a feed
+}
+
+// Service builds and caches storefront product feeds
+type Service struct {
+	repo   Repository
+	cache  redis.UniversalClient
+	config Config
+	logger zerolog.Logger
+}
+
+// NewService creates a new feed service (cache is optional)
+func NewService(repo Repository, cache redis.UniversalClient, cfg Config, logger zerolog.Logger) *Service {
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = defaultPageSize
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	return &Service{
+		repo:   repo,
+		cache:  cache,
+		config: cfg,
+		logger: logger.With().Str("service", "feed").Logger(),
+	}
+}
+
+// GetFeed returns the rendered feed for a storefront, served from cache when available
+func (s *Service) GetFeed(ctx context.Context, slug string, format domain.FeedFormat) ([]byte, error) {
+	key := fmt.Sprintf(feedCacheKey, slug, format)
+
+	if s.cache != nil {
+		data, err := s.cache.Get(ctx, key).Bytes()
+		if err == nil {
+			s.logger.Debug().Str("slug", slug).Str("format", string(format)).Msg("feed served from cache")
+			return data, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			s.logger.Warn().Err(err).Str("key", key).Msg("feed cache get failed")
+		}
+	}
+
+	feed, err := s.BuildFeed(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := Render(feed, format)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil && s.config.CacheTTL > 0 {
+		if err := s.cache.Set(ctx, key, data, s.config.CacheTTL).Err(); err != nil {
+			s.logger.Warn().Err(err).Str("key", key).Msg("failed to cache feed")
+		}
+	}
+
+	s.logger.Info().
+		Str("slug", slug).
+		Str("format", string(format)).
+		Int("items", len(feed.Items)).
+		Msg("feed generated")
+
+	return data, nil
+}
+
+// InvalidateFeed removes all cached formats of a storefront feed
+func (s *Service) InvalidateFeed(ctx context.Context, slug string) error {
+	if s.cache == nil {
+		return nil
+	}
+
+	keys := []string{
+		fmt.Sprintf(feedCacheKey, slug, domain.FeedFormatGoogleMerchant),
+		fmt.Sprintf(feedCacheKey, slug, domain.FeedFormatFacebook),
+	}
+	if err := s.cache.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate feed cache: %w", err)
+	}
+	return nil
+}
+
+// Render renders a feed in the requested format
+func Render(feed *domain.ProductFeed, format domain.FeedFormat) ([]byte, error) {
+	switch format {
+	case domain.FeedFormatGoogleMerchant:
+		return RenderGoogleMerchant(feed)
+	case domain.FeedFormatFacebook:
+		return RenderFacebook(feed)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+// BuildFeed loads the active products of a storefront and converts them to feed items
+func (s *Service) BuildFeed(ctx context.Context, slug string) (*domain.ProductFeed, error) {
+	storefront, err := s.repo.GetStorefrontBySlug(ctx, slug, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, fmt.Errorf("%w: %s", ErrStorefrontNotFound, slug)
+		}
+		return nil, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if !storefront.IsActive {
+		return nil, fmt.Errorf("%w: %s", ErrStorefrontInactive, slug)
+	}
+
+	feed := &domain.ProductFeed{
+		StorefrontID:   storefront.ID,
+		StorefrontSlug: storefront.Slug,
+		StorefrontName: storefront.Name,
+		Link:           s.storefrontLink(storefront.Slug),
+		Items:          []domain.FeedItem{},
+	}
+	if storefront.Description != nil {
+		feed.Description = *storefront.Description
+	}
+
+	categoryNames := make(map[int64]string)
+
+	for page := 1; ; page++ {
+		products, total, err := s.repo.ListProducts(ctx, storefront.ID, page, s.config.PageSize, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+
+		for _, product := range products {
+			productType := s.categoryName(ctx, product.CategoryID, categoryNames)
+
+			var variants []*domain.ProductVariant
+			if product.HasVariants {
+				variants, err = s.repo.GetVariantsByProductID(ctx, product.ID, true)
+				if err != nil {
+					s.logger.Warn().Err(err).Int64("product_id", product.ID).Msg("failed to load variants, exporting product only")
+					variants = nil
+				}
+			}
+
+			feed.Items = append(feed.Items, s.productItems(storefront, product, variants, productType)...)
+		}
+
+		if len(products) == 0 || page*s.config.PageSize >= total {
+			break
+		}
+	}
+
+	return feed, nil
+}
+
+// productItems converts a product (and its variants, if any) to feed items
+func (s *Service) productItems(
+	storefront *domain.Storefront,
+	product *domain.Product,
+	variants []*domain.ProductVariant,
+	productType string,
+) []domain.FeedItem {
+	base := domain.FeedItem{
+		ID:          fmt.Sprintf("%d", product.ID),
+		Title:       product.Name,
+		Description: product.Description,
+		Link:        s.productLink(storefront.Slug, product.ID),
+		Currency:    product.Currency,
+		Brand:       storefront.Name,
+		Condition:   domain.FeedConditionNew,
+		ProductType: productType,
+		GTIN:        domain.NormalizeGTIN(product.Barcode),
+	}
+	if base.Description == "" {
+		base.Description = product.Name
+	}
+	if product.SKU != nil {
+		base.MPN = *product.SKU
+	}
+	base.ImageLink, base.AdditionalImageLinks = imageLinks(product.Images)
+
+	if len(variants) == 0 {
+		item := base
+		item.Price, item.SalePrice = domain.FeedPricing(product.Price, nil)
+		item.Availability = domain.FeedAvailabilityFromStock(product.StockStatus, product.StockQuantity)
+		return []domain.FeedItem{item}
+	}
+
+	items := make([]domain.FeedItem, 0, len(variants))
+	for _, variant := range variants {
+		item := base
+		item.ID = fmt.Sprintf("%d-%d", product.ID, variant.ID)
+		item.ItemGroupID = fmt.Sprintf("%d", product.ID)
+		item.Link = fmt.Sprintf("%s?variant=%d", base.Link, variant.ID)
+		item.Price, item.SalePrice = domain.FeedPricing(variant.GetEffectivePrice(product.Price), variant.CompareAtPrice)
+		item.Availability = domain.FeedAvailabilityFromStock(variant.StockStatus, variant.StockQuantity)
+		item.Attributes = variantAttributes(variant.VariantAttributes)
+
+		if gtin := domain.NormalizeGTIN(variant.Barcode); gtin != "" {
+			item.GTIN = gtin
+		}
+		if variant.SKU != nil {
+			item.MPN = *variant.SKU
+		}
+		if len(variant.Images) > 0 {
+			item.ImageLink, item.AdditionalImageLinks = imageLinks(variant.Images)
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// categoryName resolves a category name, memoizing lookups for the duration of a build
+func (s *Service) categoryName(ctx context.Context, categoryID int64, cache map[int64]string) string {
+	if name, ok := cache[categoryID]; ok {
+		return name
+	}
+
+	name := ""
+	category, err := s.repo.GetCategoryByID(ctx, categoryID)
+	if err != nil {
+		s.logger.Debug().Err(err).Int64("category_id", categoryID).Msg("failed to resolve category for feed")
+	} else {
+		name = category.Name
+	}
+
+	cache[categoryID] = name
+	return name
+}
+
+// storefrontLink returns the public URL of a storefront
+func (s *Service) storefrontLink(slug string) string {
+	return fmt.Sprintf("%s/storefronts/%s", s.config.BaseURL, slug)
+}
+
+// productLink returns the public URL of a storefront product
+func (s *Service) productLink(slug string, productID int64) string {
+	return fmt.Sprintf("%s/storefronts/%s/products/%d", s.config.BaseURL, slug, productID)
+}
+
+// imageLinks returns the primary image URL and up to maxAdditionalImages additional URLs
+func imageLinks(images []*domain.ProductImage) (string, []string) {
+	if len(images) == 0 {
+		return "", nil
+	}
+
+	sorted := make([]*domain.ProductImage, len(images))
+	copy(sorted, images)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].IsPrimary != sorted[j].IsPrimary {
+			return sorted[i].IsPrimary
+		}
+		return sorted[i].DisplayOrder < sorted[j].DisplayOrder
+	})
+
+	var additional []string
+	for _, img := range sorted[1:] {
+		if len(additional) == maxAdditionalImages {
+			break
+		}
+		additional = append(additional, img.URL)
+	}
+
+	return sorted[0].URL, additional
+}
+
+// variantAttributes flattens variant attributes to strings for feed output
+func variantAttributes(attrs map[string]interface{}) map[string]string {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		if v == nil {
+			continue
+		}
+		result[k] = fmt.Sprintf("%v", v)
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package feed
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeRepository is an in-memory Repository for feed tests
+type fakeRepository struct {
+	storefront *domain.Storefront
+	products   []*domain.Product
+	variants   map[int64][]*domain.ProductVariant
+	categories map[int64]*domain.Category
+}
+
+func (f *fakeRepository) GetStorefrontBySlug(ctx context.Context, slug string, includes *domain.Includes) (*domain.Storefront, error) {
+	if f.storefront == nil || f.storefront.Slug != slug {
+		return nil, errors.New("storefront not found")
+	}
+	return f.storefront, nil
+}
+
+func (f *fakeRepository) ListProducts(ctx context.Context, storefrontID int64, page, pageSize int, isActiveOnly bool) ([]*domain.Product, int, error) {
+	start := (page - 1) * pageSize
+	if start >= len(f.products) {
+		return nil, len(f.products), nil
+	}
+	end := start + pageSize
+	if end > len(f.products) {
+		end = len(f.products)
+	}
+	return f.products[start:end], len(f.products), nil
+}
+
+func (f *fakeRepository) GetVariantsByProductID(ctx context.Context, productID int64, isActiveOnly bool) ([]*domain.ProductVariant, error) {
+	return f.variants[productID], nil
+}
+
+func (f *fakeRepository) GetCategoryByID(ctx context.Context, categoryID int64) (*domain.Category, error) {
+	if c, ok := f.categories[categoryID]; ok {
+		return c, nil
+	}
+	return nil, errors.New("category not found")
+}
+
+func strPtr(s string) *string     { return &s }
+func floatPtr(f float64) *float64 { return &f }
+
+func newTestRepository() *fakeRepository {
+	return &fakeRepository{
+		storefront: &domain.Storefront{ID: 7, Slug: "tech-shop", Name: "Tech Shop", IsActive: true},
+		products: []*domain.Product{
+			{
+				ID:            101,
+				StorefrontID:  7,
+				Name:          "USB-C Cable",
+				Description:   "1m braided cable",
+				Price:         990,
+				Currency:      "rsd",
+				CategoryID:    5,
+				SKU:           strPtr("CBL-1"),
+				Barcode:       strPtr("4006381333931"),
+				StockQuantity: 12,
+				StockStatus:   domain.StockStatusInStock,
+				Images: []*domain.ProductImage{
+					{URL: "https://cdn/2.jpg", DisplayOrder: 2},
+					{URL: "https://cdn/1.jpg", DisplayOrder: 1, IsPrimary: true},
+				},
+			},
+			{
+				ID:          102,
+				Name:        "T-Shirt",
+				Price:       1500,
+				Currency:    "RSD",
+				CategoryID:  6,
+				HasVariants: true,
+				StockStatus: domain.StockStatusInStock,
+			},
+		},
+		variants: map[int64][]*domain.ProductVariant{
+			102: {
+				{
+					ID:                1,
+					ProductID:         102,
+					Price:             floatPtr(1200),
+					CompareAtPrice:    floatPtr(1500),
+					StockQuantity:     0,
+					StockStatus:       domain.StockStatusOutOfStock,
+					VariantAttributes: map[string]interface{}{"Color": "red", "size": "M"},
+				},
+			},
+		},
+		categories: map[int64]*domain.Category{5: {ID: 5, Name: "Electronics"}},
+	}
+}
+
+func TestBuildFeed(t *testing.T) {
+	svc := NewService(newTestRepository(), nil, Config{BaseURL: "https://svetu.rs/", PageSize: 1}, zerolog.Nop())
+
+	feed, err := svc.BuildFeed(context.Background(), "tech-shop")
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 2)
+
+	simple := feed.Items[0]
+	assert.Equal(t, "101", simple.ID)
+	assert.Equal(t, "https://svetu.rs/storefronts/tech-shop/products/101", simple.Link)
+	assert.Equal(t, "4006381333931", simple.GTIN)
+	assert.Equal(t, "CBL-1", simple.MPN)
+	assert.Equal(t, "https://cdn/1.jpg", simple.ImageLink)
+	assert.Equal(t, []string{"https://cdn/2.jpg"}, simple.AdditionalImageLinks)
+	assert.Equal(t, domain.FeedAvailabilityInStock, simple.Availability)
+	assert.Equal(t, "Electronics", simple.ProductType)
+	assert.Nil(t, simple.SalePrice)
+
+	variant := feed.Items[1]
+	assert.Equal(t, "102-1", variant.ID)
+	assert.Equal(t, "102", variant.ItemGroupID)
+	assert.Equal(t, 1500.0, variant.Price)
+	require.NotNil(t, variant.SalePrice)
+	assert.Equal(t, 1200.0, *variant.SalePrice)
+	assert.Equal(t, domain.FeedAvailabilityOutOfStock, variant.Availability)
+	assert.Equal(t, "T-Shirt", variant.Description)
+}
+
+func TestBuildFeed_Errors(t *testing.T) {
+	repo := newTestRepository()
+	svc := NewService(repo, nil, Config{}, zerolog.Nop())
+
+	_, err := svc.BuildFeed(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrStorefrontNotFound)
+
+	repo.storefront.IsActive = false
+	_, err = svc.BuildFeed(context.Background(), "tech-shop")
+	assert.ErrorIs(t, err, ErrStorefrontInactive)
+}
+
+func TestRenderGoogleMerchant(t *testing.T) {
+	svc := NewService(newTestRepository(), nil, Config{BaseURL: "https://svetu.rs"}, zerolog.Nop())
+	feed, err := svc.BuildFeed(context.Background(), "tech-shop")
+	require.NoError(t, err)
+
+	data, err := RenderGoogleMerchant(feed)
+	require.NoError(t, err)
+
+	out := string(data)
+	assert.True(t, strings.HasPrefix(out, "<?xml"))
+	assert.Contains(t, out, `<rss version="2.0" xmlns:g="http://base.google.com/ns/1.0">`)
+	assert.Contains(t, out, "<g:id>101</g:id>")
+	assert.Contains(t, out, "<g:gtin>4006381333931</g:gtin>")
+	assert.Contains(t, out, "<g:price>990.00 RSD</g:price>")
+	assert.Contains(t, out, "<g:availability>in_stock</g:availability>")
+	assert.Contains(t, out, "<g:price>1500.00 RSD</g:price>")
+	assert.Contains(t, out, "<g:sale_price>1200.00 RSD</g:sale_price>")
+	assert.Contains(t, out, "<g:item_group_id>102</g:item_group_id>")
+	assert.Contains(t, out, "<g:color>red</g:color>")
+	assert.Contains(t, out, "<g:identifier_exists>no</g:identifier_exists>")
+}
+
+func TestRenderFacebook(t *testing.T) {
+	svc := NewService(newTestRepository(), nil, Config{BaseURL: "https://svetu.rs"}, zerolog.Nop())
+	feed, err := svc.BuildFeed(context.Background(), "tech-shop")
+	require.NoError(t, err)
+
+	data, err := RenderFacebook(feed)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, facebookColumns, records[0])
+
+	row := make(map[string]string)
+	for i, col := range records[2] {
+		row[records[0][i]] = col
+	}
+	assert.Equal(t, "102-1", row["id"])
+	assert.Equal(t, "out of stock", row["availability"])
+	assert.Equal(t, "1500.00 RSD", row["price"])
+	assert.Equal(t, "1200.00 RSD", row["sale_price"])
+	assert.Equal(t, "M", row["size"])
+}
+
+func TestRender_UnsupportedFormat(t *testing.T) {
+	_, err := Render(&domain.ProductFeed{}, domain.FeedFormat("amazon"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
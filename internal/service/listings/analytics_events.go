@@ -0,0 +1,22 @@
+package listings
+
+import (
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// EventTracker records analytics events without blocking the caller
+type EventTracker interface {
+	Track(events ...*domain.AnalyticsEvent) int
+}
+
+// SetEventTracker sets the tracker that receives analytics events (optional)
+func (s *Service) SetEventTracker(tracker EventTracker) {
+	s.eventTracker = tracker
+}
+
+// trackEvent hands an analytics event to the tracker, if one is configured
+func (s *Service) trackEvent(event *domain.AnalyticsEvent) {
+	if s.eventTracker != nil {
+		s.eventTracker.Track(event)
+	}
+}
@@ -0,0 +1,202 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sveturs/listings/internal/dedup"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// DuplicateDetectionConfig configures near-duplicate checks of listings and images
+type DuplicateDetectionConfig struct {
+	Mode             string  // off, report, flag, block
+	TextThreshold    float64 // Minimum estimated text similarity (0..1)
+	ImageMaxDistance int     // Maximum image hash Hamming distance
+	MaxCandidates    int     // Candidates compared per check
+}
+
+// SetDuplicateDetection enables duplicate checks on create/update and image upload (disabled by default)
+func (s *Service) SetDuplicateDetection(cfg DuplicateDetectionConfig) {
+	if cfg.TextThreshold <= 0 || cfg.TextThreshold > 1 {
+		cfg.TextThreshold = domain.DefaultDuplicateTextThreshold
+	}
+	if cfg.ImageMaxDistance <= 0 {
+		cfg.ImageMaxDistance = domain.DefaultDuplicateImageMaxDistance
+	}
+	if cfg.MaxCandidates <= 0 {
+		cfg.MaxCandidates = domain.DefaultDuplicateMaxCandidates
+	}
+	s.duplicates = cfg
+}
+
+// duplicateChecksEnabled reports whether duplicate detection is on
+func (s *Service) duplicateChecksEnabled() bool {
+	switch s.duplicates.Mode {
+	case domain.DuplicateModeReport, domain.DuplicateModeFlag, domain.DuplicateModeBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// listingText returns the text used for a listing's MinHash fingerprint
+func listingText(title string, description *string) string {
+	if description == nil {
+		return title
+	}
+	return title + " " + *description
+}
+
+// findTextDuplicates compares the signature with candidate listings sharing an LSH band.
+// Errors are logged and treated as "no duplicates" so that checks never break listing writes.
+func (s *Service) findTextDuplicates(ctx context.Context, sig dedup.Signature, listingID, userID int64) []*domain.DuplicateMatch {
+	if sig == nil {
+		return nil
+	}
+
+	candidates, err := s.repo.FindDuplicateCandidates(ctx, sig.BandKeys(), listingID, s.duplicates.MaxCandidates)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to find duplicate candidates (non-critical)")
+		return nil
+	}
+
+	var matches []*domain.DuplicateMatch
+	for _, candidate := range candidates {
+		similarity := sig.Similarity(dedup.SignatureFromInt64s(candidate.MinHash))
+		if similarity < s.duplicates.TextThreshold {
+			continue
+		}
+		matches = append(matches, &domain.DuplicateMatch{
+			ListingID:      candidate.ListingID,
+			UserID:         candidate.UserID,
+			Title:          candidate.Title,
+			Status:         candidate.Status,
+			TextSimilarity: similarity,
+			SameOwner:      candidate.UserID == userID,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].TextSimilarity > matches[j].TextSimilarity
+	})
+	return matches
+}
+
+// checkTextDuplicates runs the text check for a listing being created (listingID = 0) or updated.
+// Returns the report and the signature to store once the listing is saved.
+func (s *Service) checkTextDuplicates(ctx context.Context, listingID, userID int64, title string, description *string) (*domain.DuplicateReport, dedup.Signature) {
+	if !s.duplicateChecksEnabled() {
+		return nil, nil
+	}
+
+	sig := dedup.NewSignature(listingText(title, description))
+	report := &domain.DuplicateReport{
+		Matches: s.findTextDuplicates(ctx, sig, listingID, userID),
+		Action:  domain.DuplicateActionNone,
+	}
+	if report.HasDuplicates() && s.duplicates.Mode == domain.DuplicateModeBlock {
+		report.Action = domain.DuplicateActionBlocked
+	}
+	return report, sig
+}
+
+// storeDuplicateResults saves the listing fingerprint and flags matches (flag mode).
+// Failures are non-critical.
+func (s *Service) storeDuplicateResults(ctx context.Context, listingID int64, sig dedup.Signature, report *domain.DuplicateReport) {
+	if sig != nil {
+		fingerprint := &domain.ListingFingerprint{
+			ListingID: listingID,
+			MinHash:   sig.Int64s(),
+			BandKeys:  sig.BandKeys(),
+		}
+		if err := s.repo.SaveListingFingerprint(ctx, fingerprint); err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to save listing fingerprint (non-critical)")
+		}
+	}
+
+	s.flagDuplicates(ctx, listingID, report)
+}
+
+// flagDuplicates records the report matches for moderators when running in flag mode
+func (s *Service) flagDuplicates(ctx context.Context, listingID int64, report *domain.DuplicateReport) {
+	if s.duplicates.Mode != domain.DuplicateModeFlag || !report.HasDuplicates() {
+		return
+	}
+
+	if err := s.repo.FlagDuplicates(ctx, listingID, report.Matches); err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to flag duplicates (non-critical)")
+		return
+	}
+	report.Action = domain.DuplicateActionFlagged
+
+	s.logger.Info().
+		Int64("listing_id", listingID).
+		Int64("duplicate_of", report.Matches[0].ListingID).
+		Int("matches", len(report.Matches)).
+		Msg("listing flagged as possible duplicate")
+}
+
+// CheckImageDuplicates compares an uploaded image hash with images of other listings.
+// In block mode the returned report has action "blocked" and the upload must be rejected.
+func (s *Service) CheckImageDuplicates(ctx context.Context, listingID, userID int64, hash uint64) (*domain.DuplicateReport, error) {
+	if !s.duplicateChecksEnabled() {
+		return nil, nil
+	}
+
+	matches, err := s.repo.FindSimilarImages(ctx, int64(hash), s.duplicates.ImageMaxDistance, listingID, s.duplicates.MaxCandidates)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to find similar images (non-critical)")
+		return nil, nil
+	}
+	for _, match := range matches {
+		match.SameOwner = match.UserID == userID
+	}
+
+	report := &domain.DuplicateReport{Matches: matches, Action: domain.DuplicateActionNone}
+	if !report.HasDuplicates() {
+		return report, nil
+	}
+
+	if s.duplicates.Mode == domain.DuplicateModeBlock {
+		report.Action = domain.DuplicateActionBlocked
+		return report, nil
+	}
+
+	s.flagDuplicates(ctx, listingID, report)
+	return report, nil
+}
+
+// ListDuplicateFlags retrieves duplicate matches flagged for moderation (admin operation)
+func (s *Service) ListDuplicateFlags(ctx context.Context, limit, offset int) ([]*domain.DuplicateFlag, int32, error) {
+	if limit <= 0 {
+		limit = domain.DefaultModerationQueueLimit
+	}
+	if limit > domain.MaxModerationQueueLimit {
+		limit = domain.MaxModerationQueueLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	flags, total, err := s.repo.ListDuplicateFlags(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list duplicate flags")
+		return nil, 0, fmt.Errorf("failed to list duplicate flags: %w", err)
+	}
+
+	return flags, total, nil
+}
+
+// textChanged reports whether an update modifies the fingerprinted text
+func textChanged(existing *domain.Listing, input *domain.UpdateListingInput) bool {
+	if input.Title != nil && strings.TrimSpace(*input.Title) != strings.TrimSpace(existing.Title) {
+		return true
+	}
+	if input.Description != nil && (existing.Description == nil || *input.Description != *existing.Description) {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package listings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/dedup"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const bikeText = "Selling my mountain bike, 21 speed, aluminium frame, barely used, pickup in Novi Sad"
+
+func TestCheckTextDuplicates_Disabled(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+
+	report, sig := service.checkTextDuplicates(TestContext(), 0, 100, bikeText, nil)
+
+	assert.Nil(t, report)
+	assert.Nil(t, sig)
+	mockRepo.AssertNotCalled(t, "FindDuplicateCandidates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCheckTextDuplicates_FindsRepost(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	service.SetDuplicateDetection(DuplicateDetectionConfig{Mode: domain.DuplicateModeReport})
+	ctx := TestContext()
+
+	existing := dedup.NewSignature(bikeText)
+	other := dedup.NewSignature("Vintage leather sofa in excellent condition, three seats, delivery possible")
+
+	mockRepo.On("FindDuplicateCandidates", ctx, existing.BandKeys(), int64(0), domain.DefaultDuplicateMaxCandidates).
+		Return([]*domain.ListingFingerprint{
+			{ListingID: 5, UserID: 200, Title: "Sofa", Status: domain.StatusActive, MinHash: other.Int64s()},
+			{ListingID: 7, UserID: 100, Title: "Mountain bike", Status: domain.StatusExpired, MinHash: existing.Int64s()},
+		}, nil)
+
+	description := "pickup in Novi Sad"
+	report, sig := service.checkTextDuplicates(ctx, 0, 100, "Selling my mountain bike 21 speed aluminium frame barely used", &description)
+
+	require.NotNil(t, report)
+	require.Len(t, report.Matches, 1)
+	assert.Equal(t, int64(7), report.Matches[0].ListingID)
+	assert.True(t, report.Matches[0].SameOwner)
+	assert.Equal(t, domain.DuplicateActionNone, report.Action)
+	assert.Equal(t, existing, sig)
+}
+
+func TestCreateListing_BlockedDuplicate(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	service.SetDuplicateDetection(DuplicateDetectionConfig{Mode: domain.DuplicateModeBlock})
+	ctx := TestContext()
+	SetupDefaultCreateListingMocks(mockRepo, ctx)
+
+	existing := dedup.NewSignature(bikeText)
+	mockRepo.On("FindDuplicateCandidates", ctx, mock.Anything, int64(0), mock.Anything).
+		Return([]*domain.ListingFingerprint{{ListingID: 7, UserID: 100, MinHash: existing.Int64s()}}, nil)
+
+	description := "21 speed, aluminium frame, barely used, pickup in Novi Sad"
+	input := NewCreateListingInput(100, "Selling my mountain bike")
+	input.Description = &description
+
+	_, err := service.CreateListing(ctx, input)
+
+	assert.ErrorContains(t, err, "duplicate listing: similar to listing 7")
+	mockRepo.AssertNotCalled(t, "CreateListing", mock.Anything, mock.Anything)
+}
+
+func TestCheckImageDuplicates_FlagMode(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	service.SetDuplicateDetection(DuplicateDetectionConfig{Mode: domain.DuplicateModeFlag, ImageMaxDistance: 6})
+	ctx := TestContext()
+
+	distance := 2
+	matches := []*domain.DuplicateMatch{{ListingID: 9, UserID: 300, ImageDistance: &distance}}
+	mockRepo.On("FindSimilarImages", ctx, int64(42), 6, int64(1), domain.DefaultDuplicateMaxCandidates).Return(matches, nil)
+	mockRepo.On("FlagDuplicates", ctx, int64(1), matches).Return(nil)
+
+	report, err := service.CheckImageDuplicates(ctx, 1, 100, 42)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DuplicateActionFlagged, report.Action)
+	assert.False(t, report.Matches[0].SameOwner)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCheckImageDuplicates_BlockMode(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	service.SetDuplicateDetection(DuplicateDetectionConfig{Mode: domain.DuplicateModeBlock})
+	ctx := TestContext()
+
+	mockRepo.On("FindSimilarImages", ctx, int64(42), domain.DefaultDuplicateImageMaxDistance, int64(1), mock.Anything).
+		Return([]*domain.DuplicateMatch{{ListingID: 9, UserID: 100}}, nil)
+
+	report, err := service.CheckImageDuplicates(ctx, 1, 100, 42)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DuplicateActionBlocked, report.Action)
+	assert.True(t, report.Matches[0].SameOwner)
+	mockRepo.AssertNotCalled(t, "FlagDuplicates", mock.Anything, mock.Anything, mock.Anything)
+}
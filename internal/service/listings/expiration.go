@@ -0,0 +1,94 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SetDefaultRenewalDays sets the listing lifetime used when a category has no renewal period
+func (s *Service) SetDefaultRenewalDays(days int) {
+	s.defaultRenewalDays = days
+}
+
+// RenewListing extends the lifetime of a C2C listing by its category renewal period.
+// Expired listings are reactivated and re-indexed.
+func (s *Service) RenewListing(ctx context.Context, id int64, userID int64) (*domain.Listing, error) {
+	existing, err := s.repo.GetListingByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", id).Msg("failed to get listing for renewal")
+		return nil, fmt.Errorf("listing not found: %w", err)
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("listing not found")
+	}
+
+	if existing.UserID != userID {
+		s.logger.Warn().
+			Int64("listing_id", id).
+			Int64("user_id", userID).
+			Int64("owner_id", existing.UserID).
+			Msg("unauthorized renew attempt")
+		return nil, fmt.Errorf("unauthorized: user does not own this listing")
+	}
+
+	if !existing.IsRenewable() {
+		return nil, fmt.Errorf("listing cannot be renewed: status '%s', source type '%s'", existing.Status, existing.SourceType)
+	}
+
+	days := s.renewalPeriodDays(ctx, existing.CategoryID)
+	expiresAt := domain.RenewalExpiry(time.Now(), days)
+
+	if err := s.repo.RenewListing(ctx, id, expiresAt); err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", id).Msg("failed to renew listing")
+		return nil, fmt.Errorf("failed to renew listing: %w", err)
+	}
+
+	// Invalidate cache (if available)
+	if s.cache != nil {
+		cacheKey := fmt.Sprintf("listing:%d", id)
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", id).Msg("failed to invalidate cache")
+		}
+	}
+
+	// Expired listings were removed from the index - add them back
+	operation := domain.IndexOpUpdate
+	if existing.Status == domain.StatusExpired {
+		operation = domain.IndexOpIndex
+	}
+	if err := s.repo.EnqueueIndexing(ctx, id, operation); err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", id).Msg("failed to enqueue indexing (non-critical)")
+	}
+
+	renewed, err := s.repo.GetListingByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get renewed listing: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("listing_id", id).
+		Int64("user_id", userID).
+		Int("renewal_days", days).
+		Time("expires_at", expiresAt).
+		Msg("listing renewed successfully")
+
+	return renewed, nil
+}
+
+// renewalPeriodDays resolves the renewal period of a category, falling back to the service default
+func (s *Service) renewalPeriodDays(ctx context.Context, categoryID int64) int {
+	days, err := s.repo.GetCategoryRenewalDays(ctx, categoryID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("category_id", categoryID).Msg("failed to get category renewal period, using default")
+	}
+	if days > 0 {
+		return days
+	}
+	if s.defaultRenewalDays > 0 {
+		return s.defaultRenewalDays
+	}
+	return domain.DefaultRenewalPeriodDays
+}
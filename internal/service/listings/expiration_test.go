@@ -0,0 +1,96 @@
+package listings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+func TestRenewListing_ExpiredListing(t *testing.T) {
+	service, mockRepo, mockCache, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	listing := NewTestListing(1, 100, "Bike")
+	listing.SourceType = domain.SourceTypeC2C
+	listing.Status = domain.StatusExpired
+
+	renewed := NewTestListing(1, 100, "Bike")
+	renewed.SourceType = domain.SourceTypeC2C
+	renewed.Status = domain.StatusActive
+
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil).Once()
+	mockRepo.On("GetCategoryRenewalDays", ctx, listing.CategoryID).Return(60, nil)
+	mockRepo.On("RenewListing", ctx, int64(1), mock.MatchedBy(func(expiresAt time.Time) bool {
+		days := time.Until(expiresAt).Hours() / 24
+		return days > 59 && days <= 60
+	})).Return(nil)
+	mockCache.On("Delete", ctx, "listing:1").Return(nil)
+	mockRepo.On("EnqueueIndexing", ctx, int64(1), domain.IndexOpIndex).Return(nil)
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(renewed, nil).Once()
+
+	result, err := service.RenewListing(ctx, 1, 100)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusActive, result.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenewListing_DefaultPeriod(t *testing.T) {
+	service, mockRepo, mockCache, _ := SetupServiceTest(t)
+	service.SetDefaultRenewalDays(45)
+	ctx := TestContext()
+
+	listing := NewTestListing(1, 100, "Bike")
+	listing.SourceType = domain.SourceTypeC2C
+	listing.Status = domain.StatusActive
+
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+	mockRepo.On("GetCategoryRenewalDays", ctx, listing.CategoryID).Return(0, nil)
+	mockRepo.On("RenewListing", ctx, int64(1), mock.MatchedBy(func(expiresAt time.Time) bool {
+		days := time.Until(expiresAt).Hours() / 24
+		return days > 44 && days <= 45
+	})).Return(nil)
+	mockCache.On("Delete", ctx, "listing:1").Return(nil)
+	mockRepo.On("EnqueueIndexing", ctx, int64(1), domain.IndexOpUpdate).Return(nil)
+
+	_, err := service.RenewListing(ctx, 1, 100)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenewListing_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     int64
+		sourceType string
+		status     string
+		wantErr    string
+	}{
+		{name: "not owner", userID: 999, sourceType: domain.SourceTypeC2C, status: domain.StatusExpired, wantErr: "unauthorized"},
+		{name: "b2c product", userID: 100, sourceType: domain.SourceTypeB2C, status: domain.StatusActive, wantErr: "cannot be renewed"},
+		{name: "sold listing", userID: 100, sourceType: domain.SourceTypeC2C, status: domain.StatusSold, wantErr: "cannot be renewed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := SetupServiceTest(t)
+			ctx := TestContext()
+
+			listing := NewTestListing(1, 100, "Bike")
+			listing.SourceType = tt.sourceType
+			listing.Status = tt.status
+			mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+
+			_, err := service.RenewListing(ctx, 1, tt.userID)
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "RenewListing", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
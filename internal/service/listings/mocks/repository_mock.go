@@ -4,6 +4,7 @@ package mocks
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/mock"
@@ -191,6 +192,93 @@ func (m *MockRepository) GetFavoritedUsers(ctx context.Context, listingID int64)
 	return args.Get(0).([]int64), args.Error(1)
 }
 
+// GetPriceHistory mocks getting price history of a listing
+func (m *MockRepository) GetPriceHistory(ctx context.Context, filter *domain.PriceHistoryFilter) ([]*domain.PriceHistoryEntry, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.PriceHistoryEntry), args.Error(1)
+}
+
+// Expiration operations
+
+// GetCategoryRenewalDays mocks getting the renewal period of a category
+func (m *MockRepository) GetCategoryRenewalDays(ctx context.Context, categoryID int64) (int, error) {
+	args := m.Called(ctx, categoryID)
+	return args.Int(0), args.Error(1)
+}
+
+// SetListingExpiration mocks setting the expiration date of a listing
+func (m *MockRepository) SetListingExpiration(ctx context.Context, listingID int64, expiresAt time.Time) error {
+	args := m.Called(ctx, listingID, expiresAt)
+	return args.Error(0)
+}
+
+// RenewListing mocks renewing a listing
+func (m *MockRepository) RenewListing(ctx context.Context, listingID int64, expiresAt time.Time) error {
+	args := m.Called(ctx, listingID, expiresAt)
+	return args.Error(0)
+}
+
+// Moderation workflow operations
+
+// TransitionListingStatus mocks a workflow status change
+func (m *MockRepository) TransitionListingStatus(ctx context.Context, event *domain.ListingModerationEvent, scheduledAt *time.Time) error {
+	args := m.Called(ctx, event, scheduledAt)
+	return args.Error(0)
+}
+
+// GetModerationQueue mocks getting listings awaiting review
+func (m *MockRepository) GetModerationQueue(ctx context.Context, limit, offset int) ([]*domain.Listing, int32, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Listing), args.Get(1).(int32), args.Error(2)
+}
+
+// Duplicate detection operations
+
+// SaveListingFingerprint mocks saving a listing text fingerprint
+func (m *MockRepository) SaveListingFingerprint(ctx context.Context, fingerprint *domain.ListingFingerprint) error {
+	args := m.Called(ctx, fingerprint)
+	return args.Error(0)
+}
+
+// FindDuplicateCandidates mocks finding listings sharing LSH band keys
+func (m *MockRepository) FindDuplicateCandidates(ctx context.Context, bandKeys []int64, excludeListingID int64, limit int) ([]*domain.ListingFingerprint, error) {
+	args := m.Called(ctx, bandKeys, excludeListingID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ListingFingerprint), args.Error(1)
+}
+
+// FindSimilarImages mocks finding listings with perceptually similar images
+func (m *MockRepository) FindSimilarImages(ctx context.Context, hash int64, maxDistance int, excludeListingID int64, limit int) ([]*domain.DuplicateMatch, error) {
+	args := m.Called(ctx, hash, maxDistance, excludeListingID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DuplicateMatch), args.Error(1)
+}
+
+// FlagDuplicates mocks recording duplicate matches
+func (m *MockRepository) FlagDuplicates(ctx context.Context, listingID int64, matches []*domain.DuplicateMatch) error {
+	args := m.Called(ctx, listingID, matches)
+	return args.Error(0)
+}
+
+// ListDuplicateFlags mocks listing flagged duplicates
+func (m *MockRepository) ListDuplicateFlags(ctx context.Context, limit, offset int) ([]*domain.DuplicateFlag, int32, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.DuplicateFlag), args.Get(1).(int32), args.Error(2)
+}
+
 // AddToFavorites mocks adding to favorites
 func (m *MockRepository) AddToFavorites(ctx context.Context, userID, listingID int64) error {
 	args := m.Called(ctx, userID, listingID)
@@ -445,6 +533,12 @@ func (m *MockRepository) IncrementProductViews(ctx context.Context, productID in
 	return args.Error(0)
 }
 
+// IsViewableProduct mocks checking that a B2C product exists
+func (m *MockRepository) IsViewableProduct(ctx context.Context, productID int64) (bool, error) {
+	args := m.Called(ctx, productID)
+	return args.Bool(0), args.Error(1)
+}
+
 // BatchUpdateStock mocks batch updating stock
 func (m *MockRepository) BatchUpdateStock(ctx context.Context, storefrontID int64, items []domain.StockUpdateItem, reason string, userID int64) (int32, int32, []domain.StockUpdateResult, error) {
 	args := m.Called(ctx, storefrontID, items, reason, userID)
@@ -0,0 +1,195 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ModerationNotifier informs listing owners about review decisions
+type ModerationNotifier interface {
+	NotifyListingModerated(ctx context.Context, listing *domain.Listing, decision *domain.ModerationDecision) error
+}
+
+// SetModerationNotifier sets the notifier used for review decisions (optional)
+func (s *Service) SetModerationNotifier(notifier ModerationNotifier) {
+	s.moderationNotifier = notifier
+}
+
+// SubmitListingForReview moves a draft listing to the moderation queue.
+// If publishAt is set, an approved listing stays scheduled until that time.
+func (s *Service) SubmitListingForReview(ctx context.Context, id int64, userID int64, publishAt *time.Time) (*domain.Listing, error) {
+	existing, err := s.repo.GetListingByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", id).Msg("failed to get listing for review submission")
+		return nil, fmt.Errorf("listing not found: %w", err)
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("listing not found")
+	}
+
+	if existing.UserID != userID {
+		s.logger.Warn().
+			Int64("listing_id", id).
+			Int64("user_id", userID).
+			Int64("owner_id", existing.UserID).
+			Msg("unauthorized review submission attempt")
+		return nil, fmt.Errorf("unauthorized: user does not own this listing")
+	}
+
+	if err := s.validator.ValidateStatusTransition(existing.Status, domain.StatusPendingReview); err != nil {
+		return nil, fmt.Errorf("invalid status transition: %w", err)
+	}
+
+	if publishAt != nil && !publishAt.After(time.Now()) {
+		return nil, fmt.Errorf("validation failed: publish_at must be in the future")
+	}
+
+	event := &domain.ListingModerationEvent{
+		ListingID:  id,
+		Action:     domain.ModerationActionSubmit,
+		FromStatus: existing.Status,
+		ToStatus:   domain.StatusPendingReview,
+		ActorID:    &userID,
+	}
+
+	if err := s.repo.TransitionListingStatus(ctx, event, publishAt); err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", id).Msg("failed to submit listing for review")
+		return nil, fmt.Errorf("failed to submit listing for review: %w", err)
+	}
+
+	s.invalidateListingCache(ctx, id)
+
+	s.logger.Info().
+		Int64("listing_id", id).
+		Int64("user_id", userID).
+		Msg("listing submitted for review")
+
+	return s.repo.GetListingByID(ctx, id)
+}
+
+// GetModerationQueue retrieves listings awaiting review (admin operation)
+func (s *Service) GetModerationQueue(ctx context.Context, limit, offset int) ([]*domain.Listing, int32, error) {
+	if limit <= 0 {
+		limit = domain.DefaultModerationQueueLimit
+	}
+	if limit > domain.MaxModerationQueueLimit {
+		limit = domain.MaxModerationQueueLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	listings, total, err := s.repo.GetModerationQueue(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to get moderation queue")
+		return nil, 0, fmt.Errorf("failed to get moderation queue: %w", err)
+	}
+
+	return listings, total, nil
+}
+
+// ModerateListing approves or rejects a listing awaiting review (admin operation).
+// Approved listings are published immediately or scheduled for their requested publish time.
+func (s *Service) ModerateListing(ctx context.Context, decision *domain.ModerationDecision) (*domain.Listing, error) {
+	if decision == nil {
+		return nil, fmt.Errorf("decision is required")
+	}
+	if err := decision.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	existing, err := s.repo.GetListingByID(ctx, decision.ListingID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", decision.ListingID).Msg("failed to get listing for moderation")
+		return nil, fmt.Errorf("listing not found: %w", err)
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("listing not found")
+	}
+
+	if existing.Status != domain.StatusPendingReview {
+		return nil, fmt.Errorf("invalid status transition: listing is '%s', not awaiting review", existing.Status)
+	}
+
+	action := domain.ModerationActionReject
+	if decision.Approve {
+		action = domain.ModerationActionApprove
+	}
+
+	event := &domain.ListingModerationEvent{
+		ListingID:  decision.ListingID,
+		Action:     action,
+		FromStatus: existing.Status,
+		ToStatus:   decision.TargetStatus(existing.ScheduledAt, time.Now()),
+		ActorID:    &decision.ModeratorID,
+	}
+	if decision.Reason != "" {
+		event.Reason = &decision.Reason
+	}
+
+	var scheduledAt *time.Time
+	if event.ToStatus == domain.StatusScheduled {
+		scheduledAt = existing.ScheduledAt
+	}
+
+	if err := s.repo.TransitionListingStatus(ctx, event, scheduledAt); err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", decision.ListingID).Msg("failed to moderate listing")
+		return nil, fmt.Errorf("failed to moderate listing: %w", err)
+	}
+
+	s.invalidateListingCache(ctx, decision.ListingID)
+
+	moderated, err := s.repo.GetListingByID(ctx, decision.ListingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderated listing: %w", err)
+	}
+
+	s.notifyModerationDecision(moderated, decision)
+
+	s.logger.Info().
+		Int64("listing_id", decision.ListingID).
+		Int64("moderator_id", decision.ModeratorID).
+		Str("action", action).
+		Str("status", event.ToStatus).
+		Msg("listing moderated")
+
+	return moderated, nil
+}
+
+// notifyModerationDecision informs the owner about the review outcome (non-blocking)
+func (s *Service) notifyModerationDecision(listing *domain.Listing, decision *domain.ModerationDecision) {
+	if s.moderationNotifier == nil {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Warn().Interface("panic", r).Int64("listing_id", listing.ID).
+					Msg("recovered from panic during moderation notification")
+			}
+		}()
+
+		// Create new context since parent context may be cancelled
+		notifyCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := s.moderationNotifier.NotifyListingModerated(notifyCtx, listing, decision); err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", listing.ID).Msg("failed to send moderation notification")
+		}
+	}()
+}
+
+// invalidateListingCache removes a listing from cache (if available)
+func (s *Service) invalidateListingCache(ctx context.Context, id int64) {
+	if s.cache == nil {
+		return
+	}
+	cacheKey := fmt.Sprintf("listing:%d", id)
+	if err := s.cache.Delete(ctx, cacheKey); err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", id).Msg("failed to invalidate cache")
+	}
+}
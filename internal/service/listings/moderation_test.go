@@ -0,0 +1,140 @@
+package listings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+func TestSubmitListingForReview_Success(t *testing.T) {
+	service, mockRepo, mockCache, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	listing := NewTestListing(1, 100, "Bike")
+	listing.Status = domain.StatusDraft
+	publishAt := time.Now().Add(24 * time.Hour)
+
+	submitted := NewTestListing(1, 100, "Bike")
+	submitted.Status = domain.StatusPendingReview
+
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil).Once()
+	mockRepo.On("TransitionListingStatus", ctx, mock.MatchedBy(func(e *domain.ListingModerationEvent) bool {
+		return e.Action == domain.ModerationActionSubmit &&
+			e.FromStatus == domain.StatusDraft &&
+			e.ToStatus == domain.StatusPendingReview &&
+			*e.ActorID == 100
+	}), &publishAt).Return(nil)
+	mockCache.On("Delete", ctx, "listing:1").Return(nil)
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(submitted, nil).Once()
+
+	result, err := service.SubmitListingForReview(ctx, 1, 100, &publishAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusPendingReview, result.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSubmitListingForReview_Errors(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		userID    int64
+		status    string
+		publishAt *time.Time
+		wantErr   string
+	}{
+		{name: "not owner", userID: 999, status: domain.StatusDraft, wantErr: "unauthorized"},
+		{name: "already published", userID: 100, status: domain.StatusActive, wantErr: "invalid status transition"},
+		{name: "publish time in the past", userID: 100, status: domain.StatusDraft, publishAt: &past, wantErr: "publish_at must be in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := SetupServiceTest(t)
+			ctx := TestContext()
+
+			listing := NewTestListing(1, 100, "Bike")
+			listing.Status = tt.status
+			mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+
+			_, err := service.SubmitListingForReview(ctx, 1, tt.userID, tt.publishAt)
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "TransitionListingStatus", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestModerateListing_ApproveScheduled(t *testing.T) {
+	service, mockRepo, mockCache, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	publishAt := time.Now().Add(24 * time.Hour)
+	listing := NewTestListing(1, 100, "Bike")
+	listing.Status = domain.StatusPendingReview
+	listing.ScheduledAt = &publishAt
+
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+	mockRepo.On("TransitionListingStatus", ctx, mock.MatchedBy(func(e *domain.ListingModerationEvent) bool {
+		return e.Action == domain.ModerationActionApprove && e.ToStatus == domain.StatusScheduled && e.Reason == nil
+	}), &publishAt).Return(nil)
+	mockCache.On("Delete", ctx, "listing:1").Return(nil)
+
+	_, err := service.ModerateListing(ctx, &domain.ModerationDecision{ListingID: 1, ModeratorID: 7, Approve: true})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModerateListing_Reject(t *testing.T) {
+	service, mockRepo, mockCache, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	listing := NewTestListing(1, 100, "Bike")
+	listing.Status = domain.StatusPendingReview
+
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+	mockRepo.On("TransitionListingStatus", ctx, mock.MatchedBy(func(e *domain.ListingModerationEvent) bool {
+		return e.Action == domain.ModerationActionReject &&
+			e.ToStatus == domain.StatusRejected &&
+			e.Reason != nil && *e.Reason == "prohibited item"
+	}), (*time.Time)(nil)).Return(nil)
+	mockCache.On("Delete", ctx, "listing:1").Return(nil)
+
+	_, err := service.ModerateListing(ctx, &domain.ModerationDecision{ListingID: 1, ModeratorID: 7, Reason: " prohibited item "})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModerateListing_NotPending(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	listing := NewTestListing(1, 100, "Bike")
+	listing.Status = domain.StatusDraft
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(listing, nil)
+
+	_, err := service.ModerateListing(ctx, &domain.ModerationDecision{ListingID: 1, ModeratorID: 7, Approve: true})
+
+	assert.ErrorContains(t, err, "not awaiting review")
+	mockRepo.AssertNotCalled(t, "TransitionListingStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetModerationQueue_ClampsLimit(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	mockRepo.On("GetModerationQueue", ctx, domain.MaxModerationQueueLimit, 0).Return([]*domain.Listing{}, int32(0), nil)
+
+	_, _, err := service.GetModerationQueue(ctx, 10000, -5)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
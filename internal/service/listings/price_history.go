@@ -0,0 +1,131 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// PriceDropNotifier delivers price-drop alerts to users who favorited a listing
+type PriceDropNotifier interface {
+	NotifyPriceDrop(ctx context.Context, userID int64, drop *domain.PriceDrop) error
+}
+
+// SetPriceDropNotifier sets the notifier used for price-drop alerts (optional)
+func (s *Service) SetPriceDropNotifier(notifier PriceDropNotifier) {
+	s.priceDropNotifier = notifier
+}
+
+// GetPriceHistory retrieves price changes of a listing/product or one of its variants
+func (s *Service) GetPriceHistory(ctx context.Context, filter *domain.PriceHistoryFilter) ([]*domain.PriceHistoryEntry, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("filter is required")
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	entries, err := s.repo.GetPriceHistory(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", filter.ListingID).Msg("failed to get price history")
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// notifyPriceDrop alerts users who favorited the listing about a significant price drop
+// This is a non-blocking async operation; ownerID (if known) is never notified.
+func (s *Service) notifyPriceDrop(drop *domain.PriceDrop, ownerID int64) {
+	if s.priceDropNotifier == nil || !drop.IsSignificant() {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Warn().Interface("panic", r).Int64("listing_id", drop.ListingID).
+					Msg("recovered from panic during price-drop notification")
+			}
+		}()
+
+		// Create new context since parent context may be cancelled
+		notifyCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		userIDs, err := s.repo.GetFavoritedUsers(notifyCtx, drop.ListingID)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("listing_id", drop.ListingID).
+				Msg("failed to get favorited users for price-drop notification")
+			return
+		}
+
+		sent := 0
+		for _, userID := range userIDs {
+			if userID == ownerID {
+				continue
+			}
+			if err := s.priceDropNotifier.NotifyPriceDrop(notifyCtx, userID, drop); err != nil {
+				s.logger.Warn().Err(err).
+					Int64("listing_id", drop.ListingID).
+					Int64("user_id", userID).
+					Msg("failed to send price-drop notification")
+				continue
+			}
+			sent++
+		}
+
+		s.logger.Info().
+			Int64("listing_id", drop.ListingID).
+			Float64("old_price", drop.OldPrice).
+			Float64("new_price", drop.NewPrice).
+			Int("notified", sent).
+			Msg("price-drop notifications sent")
+	}()
+}
+
+// notifyVariantPriceDrop resolves the parent product and alerts its favoriters about a variant price drop
+func (s *Service) notifyVariantPriceDrop(ctx context.Context, variant *domain.ProductVariant, oldPrice float64) {
+	product, err := s.repo.GetProductByID(ctx, variant.ProductID, nil)
+	if err != nil || product == nil {
+		s.logger.Warn().Err(err).Int64("variant_id", variant.ID).Msg("failed to get product for price-drop notification")
+		return
+	}
+
+	variantID := variant.ID
+	s.notifyPriceDrop(&domain.PriceDrop{
+		ListingID: product.ID,
+		VariantID: &variantID,
+		Title:     product.Name,
+		OldPrice:  oldPrice,
+		NewPrice:  *variant.Price,
+		Currency:  product.Currency,
+	}, 0)
+}
+
+// currentProductPrices returns current prices of products whose price is being updated
+func (s *Service) currentProductPrices(ctx context.Context, storefrontID int64, updates []*domain.BulkUpdateProductInput) map[int64]float64 {
+	var productIDs []int64
+	for _, update := range updates {
+		if update.Price != nil {
+			productIDs = append(productIDs, update.ProductID)
+		}
+	}
+
+	prices := make(map[int64]float64, len(productIDs))
+	if len(productIDs) == 0 || s.priceDropNotifier == nil {
+		return prices
+	}
+
+	products, err := s.repo.GetProductsByIDs(ctx, productIDs, &storefrontID)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to load current prices for price-drop detection")
+		return prices
+	}
+	for _, product := range products {
+		prices[product.ID] = product.Price
+	}
+	return prices
+}
@@ -0,0 +1,93 @@
+package listings
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakePriceDropNotifier records price-drop notifications
+type fakePriceDropNotifier struct {
+	mu    sync.Mutex
+	users []int64
+	done  chan struct{}
+}
+
+func (f *fakePriceDropNotifier) NotifyPriceDrop(_ context.Context, userID int64, _ *domain.PriceDrop) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.users = append(f.users, userID)
+	if len(f.users) == cap(f.done) {
+		close(f.done)
+	}
+	return nil
+}
+
+func TestGetPriceHistory_Success(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	oldPrice := 100.0
+	entries := []*domain.PriceHistoryEntry{
+		{ID: 2, ListingID: 1, OldPrice: &oldPrice, NewPrice: 80, Currency: "RSD"},
+		{ID: 1, ListingID: 1, NewPrice: 100, Currency: "RSD"},
+	}
+	mockRepo.On("GetPriceHistory", ctx, mock.MatchedBy(func(f *domain.PriceHistoryFilter) bool {
+		return f.ListingID == 1 && f.Limit == domain.DefaultPriceHistoryLimit
+	})).Return(entries, nil)
+
+	result, err := service.GetPriceHistory(ctx, &domain.PriceHistoryFilter{ListingID: 1})
+
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetPriceHistory_InvalidFilter(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	_, err := service.GetPriceHistory(ctx, &domain.PriceHistoryFilter{ListingID: 0})
+	assert.ErrorContains(t, err, "validation failed")
+
+	_, err = service.GetPriceHistory(ctx, nil)
+	assert.Error(t, err)
+
+	mockRepo.AssertNotCalled(t, "GetPriceHistory", mock.Anything, mock.Anything)
+}
+
+func TestNotifyPriceDrop_SkipsOwner(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	notifier := &fakePriceDropNotifier{done: make(chan struct{}, 2)}
+	service.SetPriceDropNotifier(notifier)
+
+	mockRepo.On("GetFavoritedUsers", mock.Anything, int64(1)).Return([]int64{10, 100, 20}, nil)
+
+	service.notifyPriceDrop(&domain.PriceDrop{ListingID: 1, Title: "Bike", OldPrice: 100, NewPrice: 80, Currency: "RSD"}, 100)
+
+	select {
+	case <-notifier.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("price-drop notifications were not sent")
+	}
+
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	assert.ElementsMatch(t, []int64{10, 20}, notifier.users)
+}
+
+func TestNotifyPriceDrop_InsignificantDrop(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	service.SetPriceDropNotifier(&fakePriceDropNotifier{done: make(chan struct{}, 1)})
+
+	service.notifyPriceDrop(&domain.PriceDrop{ListingID: 1, OldPrice: 1000, NewPrice: 999.5}, 0)
+
+	mockRepo.AssertNotCalled(t, "GetFavoritedUsers", mock.Anything, mock.Anything)
+}
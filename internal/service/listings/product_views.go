@@ -0,0 +1,123 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const (
+	// viewableProductTTL is how long the existence check of a viewed product is reused
+	viewableProductTTL = 5 * time.Minute
+
+	// maxViewableProducts bounds the checked products kept in memory; the cache starts
+	// over when it is full, so requests for random IDs cannot grow it without limit
+	maxViewableProducts = 10000
+)
+
+// viewableProduct is the cached existence check of a viewed product
+type viewableProduct struct {
+	viewable  bool
+	checkedAt time.Time
+}
+
+// ViewDeduplicator decides whether a view is the viewer's first within a window
+type ViewDeduplicator interface {
+	FirstView(ctx context.Context, listingID int64, viewerKey string) (bool, error)
+}
+
+// ViewCounter buffers view counter increments and writes them in batches
+type ViewCounter interface {
+	Add(listingID int64, unique bool)
+}
+
+// SetViewCounting enables deduplicated, buffered view counting (optional). Without it every
+// human view updates the counters directly; dedup may be nil to count every view as unique.
+func (s *Service) SetViewCounting(dedup ViewDeduplicator, counter ViewCounter) {
+	s.viewDedup = dedup
+	s.viewCounter = counter
+}
+
+// RecordProductView counts a view of a listing or product and returns its outcome
+// (domain.ProductViewUnique, ProductViewRepeat or ProductViewBot). Crawlers are not counted
+// and views of unknown or non-B2C products fail with products.not_found. Repeat views of the
+// same user, session or IP within the window only add to the total views and emit no
+// analytics event, so they no longer inflate trending and analytics.
+func (s *Service) RecordProductView(ctx context.Context, view *domain.ProductView) (string, error) {
+	if view.ProductID <= 0 {
+		return "", fmt.Errorf("product_id must be greater than 0")
+	}
+
+	if domain.IsBotUserAgent(view.UserAgent) {
+		s.logger.Debug().Int64("product_id", view.ProductID).Str("user_agent", view.UserAgent).Msg("ignoring crawler view")
+		return domain.ProductViewBot, nil
+	}
+
+	viewable, err := s.isViewableProduct(ctx, view.ProductID)
+	if err != nil {
+		return "", err
+	}
+	if !viewable {
+		return "", fmt.Errorf("products.not_found")
+	}
+
+	if s.viewCounter == nil {
+		if err := s.IncrementProductViews(ctx, view.ProductID); err != nil {
+			return "", err
+		}
+		return domain.ProductViewUnique, nil
+	}
+
+	unique := true
+	if viewerKey := view.ViewerKey(); viewerKey != "" && s.viewDedup != nil {
+		first, err := s.viewDedup.FirstView(ctx, view.ProductID, viewerKey)
+		if err != nil {
+			// Counting a repeat once more is better than losing a first view
+			s.logger.Warn().Err(err).Int64("product_id", view.ProductID).Msg("failed to deduplicate view, counting as unique")
+		} else {
+			unique = first
+		}
+	}
+
+	s.viewCounter.Add(view.ProductID, unique)
+	if !unique {
+		return domain.ProductViewRepeat, nil
+	}
+
+	event := domain.NewListingEvent(domain.AnalyticsEventView, view.ProductID, view.UserID, map[string]interface{}{
+		"source_type": "b2c",
+	})
+	if view.UserID == nil && view.SessionID != "" {
+		event.SessionID = &view.SessionID
+	}
+	s.trackEvent(event)
+
+	return domain.ProductViewUnique, nil
+}
+
+// isViewableProduct reports whether a B2C product exists, reusing checks younger than
+// viewableProductTTL so counting views does not query the product on every request
+func (s *Service) isViewableProduct(ctx context.Context, productID int64) (bool, error) {
+	s.viewableMu.Lock()
+	cached, ok := s.viewableProducts[productID]
+	s.viewableMu.Unlock()
+	if ok && time.Since(cached.checkedAt) < viewableProductTTL {
+		return cached.viewable, nil
+	}
+
+	viewable, err := s.repo.IsViewableProduct(ctx, productID)
+	if err != nil {
+		return false, err
+	}
+
+	s.viewableMu.Lock()
+	if s.viewableProducts == nil || len(s.viewableProducts) >= maxViewableProducts {
+		s.viewableProducts = make(map[int64]viewableProduct)
+	}
+	s.viewableProducts[productID] = viewableProduct{viewable: viewable, checkedAt: time.Now()}
+	s.viewableMu.Unlock()
+
+	return viewable, nil
+}
@@ -0,0 +1,121 @@
+package listings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeViewDeduplicator reports a view as first once per (listing, viewer)
+type fakeViewDeduplicator struct {
+	seen map[string]bool
+	err  error
+}
+
+func (f *fakeViewDeduplicator) FirstView(_ context.Context, _ int64, viewerKey string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	if f.seen[viewerKey] {
+		return false, nil
+	}
+	f.seen[viewerKey] = true
+	return true, nil
+}
+
+// fakeViewCounter records counted views
+type fakeViewCounter struct {
+	unique int
+	total  int
+}
+
+func (f *fakeViewCounter) Add(_ int64, unique bool) {
+	f.total++
+	if unique {
+		f.unique++
+	}
+}
+
+func TestRecordProductView_Deduplicates(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	mockRepo.On("IsViewableProduct", ctx, int64(1)).Return(true, nil).Once()
+
+	counter := &fakeViewCounter{}
+	service.SetViewCounting(&fakeViewDeduplicator{seen: map[string]bool{}}, counter)
+
+	browser := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	view := &domain.ProductView{ProductID: 1, SessionID: "sess-1", UserAgent: browser}
+
+	result, err := service.RecordProductView(ctx, view)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductViewUnique, result)
+
+	result, err = service.RecordProductView(ctx, view)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductViewRepeat, result, "refresh within the window")
+
+	result, err = service.RecordProductView(ctx, &domain.ProductView{ProductID: 1, UserAgent: "Googlebot/2.1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductViewBot, result)
+
+	assert.Equal(t, 1, counter.unique)
+	assert.Equal(t, 2, counter.total, "crawler views are not counted")
+	mockRepo.AssertExpectations(t) // The product check is cached between views
+}
+
+func TestRecordProductView_DeduplicationFailureCountsUnique(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	mockRepo.On("IsViewableProduct", ctx, int64(1)).Return(true, nil)
+
+	counter := &fakeViewCounter{}
+	service.SetViewCounting(&fakeViewDeduplicator{err: errors.New("redis down")}, counter)
+
+	result, err := service.RecordProductView(ctx, &domain.ProductView{ProductID: 1, IP: "10.0.0.1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductViewUnique, result)
+	assert.Equal(t, 1, counter.unique)
+}
+
+func TestRecordProductView_WithoutViewCounting(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	mockRepo.On("IsViewableProduct", ctx, int64(1)).Return(true, nil)
+	mockRepo.On("IncrementProductViews", ctx, int64(1)).Return(nil)
+
+	result, err := service.RecordProductView(ctx, &domain.ProductView{ProductID: 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductViewUnique, result)
+	mockRepo.AssertExpectations(t)
+
+	_, err = service.RecordProductView(ctx, &domain.ProductView{ProductID: 0})
+	assert.ErrorContains(t, err, "product_id must be greater than 0")
+}
+
+func TestRecordProductView_UnknownProduct(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	counter := &fakeViewCounter{}
+	service.SetViewCounting(&fakeViewDeduplicator{seen: map[string]bool{}}, counter)
+	mockRepo.On("IsViewableProduct", ctx, int64(404)).Return(false, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		_, err := service.RecordProductView(ctx, &domain.ProductView{ProductID: 404, IP: "10.0.0.1"})
+		assert.EqualError(t, err, "products.not_found")
+	}
+
+	assert.Zero(t, counter.total, "views of unknown products are not counted")
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,113 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// RecentlyViewedStore keeps the bounded recently viewed lists of users and anonymous sessions
+type RecentlyViewedStore interface {
+	Record(ctx context.Context, userID *int64, sessionID string, listingID int64, viewedAt time.Time) error
+	List(ctx context.Context, userID *int64, sessionID string, limit int) ([]domain.RecentlyViewedItem, error)
+	Merge(ctx context.Context, sessionID string, userID int64) error
+	Clear(ctx context.Context, userID *int64, sessionID string, listingID *int64) error
+}
+
+// SetRecentlyViewedStore sets the store of recently viewed lists (optional)
+func (s *Service) SetRecentlyViewedStore(store RecentlyViewedStore) {
+	s.recentlyViewed = store
+}
+
+// RecordRecentlyViewed adds a listing to the recently viewed list of a user (or, when userID
+// is nil, of an anonymous session). Failures are logged only: the list is a convenience and
+// must never fail the view itself.
+func (s *Service) RecordRecentlyViewed(ctx context.Context, userID *int64, sessionID string, listingID int64) {
+	if s.recentlyViewed == nil || (userID == nil && sessionID == "") {
+		return
+	}
+
+	if userID != nil && sessionID != "" {
+		s.mergeRecentlyViewed(ctx, sessionID, *userID)
+	}
+
+	if err := s.recentlyViewed.Record(ctx, userID, sessionID, listingID, time.Now()); err != nil {
+		s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to record recently viewed listing")
+	}
+}
+
+// GetRecentlyViewed returns the recently viewed listings of a user (or anonymous session),
+// most recent first. When an authenticated user still carries the session they browsed with
+// before logging in, the session's list is merged into theirs first (like carts are).
+// Listings that are gone or no longer active are skipped.
+func (s *Service) GetRecentlyViewed(ctx context.Context, userID *int64, sessionID string, limit int32) ([]*domain.RecentlyViewedListing, error) {
+	if s.recentlyViewed == nil {
+		return nil, fmt.Errorf("recently viewed listings are not configured")
+	}
+
+	n := int(limit)
+	if n <= 0 {
+		n = domain.DefaultRecentlyViewedLimit
+	}
+	if n > domain.MaxRecentlyViewedLimit {
+		n = domain.MaxRecentlyViewedLimit
+	}
+
+	if userID != nil && sessionID != "" {
+		s.mergeRecentlyViewed(ctx, sessionID, *userID)
+	}
+
+	items, err := s.recentlyViewed.List(ctx, userID, sessionID, n)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list recently viewed listings")
+		return nil, fmt.Errorf("failed to get recently viewed listings: %w", err)
+	}
+
+	viewed := make([]*domain.RecentlyViewedListing, 0, len(items))
+	for _, item := range items {
+		listing, err := s.repo.GetListingByID(ctx, item.ListingID)
+		if err != nil {
+			s.logger.Debug().Err(err).Int64("listing_id", item.ListingID).Msg("skipping unavailable recently viewed listing")
+			continue
+		}
+		if listing.Status != domain.StatusActive || listing.IsDeleted {
+			continue
+		}
+
+		images, err := s.repo.GetImages(ctx, listing.ID)
+		if err != nil {
+			// Log error but don't fail the request
+			s.logger.Warn().Err(err).Int64("listing_id", listing.ID).Msg("failed to load images for recently viewed listing")
+		} else {
+			listing.Images = images
+		}
+
+		viewed = append(viewed, &domain.RecentlyViewedListing{Listing: listing, ViewedAt: item.ViewedAt})
+	}
+
+	return viewed, nil
+}
+
+// ClearRecentlyViewed removes one listing (or, when listingID is nil, the whole history) from
+// the recently viewed lists of a user and of their session
+func (s *Service) ClearRecentlyViewed(ctx context.Context, userID *int64, sessionID string, listingID *int64) error {
+	if s.recentlyViewed == nil {
+		return fmt.Errorf("recently viewed listings are not configured")
+	}
+
+	if err := s.recentlyViewed.Clear(ctx, userID, sessionID, listingID); err != nil {
+		s.logger.Error().Err(err).Msg("failed to clear recently viewed listings")
+		return fmt.Errorf("failed to clear recently viewed listings: %w", err)
+	}
+
+	return nil
+}
+
+// mergeRecentlyViewed moves a session's list into the user's list (errors are logged only)
+func (s *Service) mergeRecentlyViewed(ctx context.Context, sessionID string, userID int64) {
+	if err := s.recentlyViewed.Merge(ctx, sessionID, userID); err != nil {
+		s.logger.Warn().Err(err).Int64("user_id", userID).Msg("failed to merge session recently viewed list")
+	}
+}
@@ -0,0 +1,108 @@
+package listings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeRecentlyViewedStore keeps recently viewed lists in memory
+type fakeRecentlyViewedStore struct {
+	items    []domain.RecentlyViewedItem
+	recorded []int64
+	merged   []string
+	cleared  int
+}
+
+func (f *fakeRecentlyViewedStore) Record(_ context.Context, _ *int64, _ string, listingID int64, _ time.Time) error {
+	f.recorded = append(f.recorded, listingID)
+	return nil
+}
+
+func (f *fakeRecentlyViewedStore) List(_ context.Context, _ *int64, _ string, limit int) ([]domain.RecentlyViewedItem, error) {
+	if len(f.items) > limit {
+		return f.items[:limit], nil
+	}
+	return f.items, nil
+}
+
+func (f *fakeRecentlyViewedStore) Merge(_ context.Context, sessionID string, _ int64) error {
+	f.merged = append(f.merged, sessionID)
+	return nil
+}
+
+func (f *fakeRecentlyViewedStore) Clear(_ context.Context, _ *int64, _ string, _ *int64) error {
+	f.cleared++
+	return nil
+}
+
+func TestGetRecentlyViewed_MergesSessionAndSkipsUnavailable(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	viewedAt := time.Date(2025, 11, 24, 10, 0, 0, 0, time.UTC)
+	store := &fakeRecentlyViewedStore{items: []domain.RecentlyViewedItem{
+		{ListingID: 1, ViewedAt: viewedAt},
+		{ListingID: 2, ViewedAt: viewedAt.Add(-time.Hour)},
+		{ListingID: 3, ViewedAt: viewedAt.Add(-2 * time.Hour)},
+	}}
+	service.SetRecentlyViewedStore(store)
+
+	archived := NewTestListing(2, 100, "Archived")
+	archived.Status = domain.StatusArchived
+	mockRepo.On("GetListingByID", ctx, int64(1)).Return(NewTestListing(1, 100, "Active"), nil)
+	mockRepo.On("GetListingByID", ctx, int64(2)).Return(archived, nil)
+	mockRepo.On("GetListingByID", ctx, int64(3)).Return(nil, errors.New("listing not found"))
+	mockRepo.On("GetImages", ctx, int64(1)).Return([]*domain.ListingImage{}, nil)
+
+	userID := int64(100)
+	viewed, err := service.GetRecentlyViewed(ctx, &userID, "sess-1", 0)
+
+	require.NoError(t, err)
+	require.Len(t, viewed, 1)
+	assert.Equal(t, int64(1), viewed[0].Listing.ID)
+	assert.Equal(t, viewedAt, viewed[0].ViewedAt)
+	assert.Equal(t, []string{"sess-1"}, store.merged, "session list is merged into the user's")
+	mockRepo.AssertNotCalled(t, "GetImages", ctx, int64(2))
+}
+
+func TestRecordRecentlyViewed(t *testing.T) {
+	service, _, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	// Without a store, recording is a no-op
+	service.RecordRecentlyViewed(ctx, nil, "sess-1", 1)
+
+	store := &fakeRecentlyViewedStore{}
+	service.SetRecentlyViewedStore(store)
+
+	service.RecordRecentlyViewed(ctx, nil, "", 1)
+	assert.Empty(t, store.recorded, "anonymous viewer without a session has no list")
+
+	service.RecordRecentlyViewed(ctx, nil, "sess-1", 2)
+	userID := int64(100)
+	service.RecordRecentlyViewed(ctx, &userID, "", 3)
+
+	assert.Equal(t, []int64{2, 3}, store.recorded)
+	assert.Empty(t, store.merged)
+}
+
+func TestRecentlyViewed_NotConfigured(t *testing.T) {
+	service, mockRepo, _, _ := SetupServiceTest(t)
+	ctx := TestContext()
+
+	_, err := service.GetRecentlyViewed(ctx, nil, "sess-1", 10)
+	assert.Error(t, err)
+
+	err = service.ClearRecentlyViewed(ctx, nil, "sess-1", nil)
+	assert.Error(t, err)
+
+	mockRepo.AssertNotCalled(t, "GetListingByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,214 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const (
+	// recommendationHistoryWindow bounds the interactions used as seeds
+	recommendationHistoryWindow = 90 * 24 * time.Hour
+
+	// trendingWindow is the period trending listings are ranked over
+	trendingWindow = 7 * 24 * time.Hour
+
+	// trendingPoolSize is the number of trending listings fetched (and cached) at once
+	trendingPoolSize = 100
+
+	// neighborsPerSeed bounds the stored neighbors read per seed listing
+	neighborsPerSeed = 10
+
+	trendingCacheKey = "recommendations:trending"
+)
+
+// RecommendationStore reads precomputed listing neighbors and visitor history
+type RecommendationStore interface {
+	GetRelatedListings(ctx context.Context, kind string, listingIDs []int64, perListing int) ([]domain.RelatedListing, error)
+	GetRecommendationSeeds(ctx context.Context, userID *int64, sessionID string, since time.Time, limit int) ([]domain.RecommendationSeed, error)
+	GetTrendingListings(ctx context.Context, since time.Time, limit int) ([]domain.Recommendation, error)
+}
+
+// SetRecommendationStore sets the store behind the recommendation methods (optional)
+func (s *Service) SetRecommendationStore(store RecommendationStore) {
+	s.recommendations = store
+}
+
+// GetRecommendationsForUser recommends listings related to what a user (or, when userID is
+// nil, an anonymous session) recently viewed, favorited, carted or bought. Listings the visitor
+// already interacted with are not recommended; missing slots are filled with trending listings.
+func (s *Service) GetRecommendationsForUser(ctx context.Context, userID *int64, sessionID string, limit int32) ([]*domain.RecommendedListing, error) {
+	if s.recommendations == nil {
+		return nil, fmt.Errorf("recommendations are not configured")
+	}
+	n := recommendationLimit(limit)
+
+	// History problems degrade to trending listings rather than failing the request
+	seeds, err := s.recommendations.GetRecommendationSeeds(ctx, userID, sessionID, time.Now().Add(-recommendationHistoryWindow), domain.MaxRecommendationSeeds)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to get recommendation seeds")
+		seeds = nil
+	}
+
+	exclude := make(map[int64]bool, len(seeds))
+	weights := make(map[int64]float64, len(seeds))
+	seedIDs := make([]int64, len(seeds))
+	for i, seed := range seeds {
+		exclude[seed.ListingID] = true
+		weights[seed.ListingID] = seed.Weight
+		seedIDs[i] = seed.ListingID
+	}
+
+	var personalized []domain.Recommendation
+	if len(seedIDs) > 0 {
+		related, err := s.recommendations.GetRelatedListings(ctx, domain.CoOccurrenceEngagement, seedIDs, neighborsPerSeed)
+		if err != nil {
+			s.logger.Warn().Err(err).Int("seeds", len(seedIDs)).Msg("failed to get related listings")
+		}
+		personalized = domain.RankRelatedListings(related, weights, exclude, domain.RecommendationSourcePersonalized)
+	}
+
+	var trending []domain.Recommendation
+	if len(personalized) < n {
+		trending = s.getTrendingRecommendations(ctx)
+	}
+
+	recommended := s.resolveRecommendations(ctx, domain.MergeRecommendations(n, exclude, personalized, trending))
+
+	s.logger.Debug().
+		Int("seeds", len(seeds)).
+		Int("personalized", len(personalized)).
+		Int("count", len(recommended)).
+		Msg("user recommendations computed")
+
+	return recommended, nil
+}
+
+// GetFrequentlyBoughtTogether recommends listings bought in the same orders as a listing,
+// then listings engaged with by the same visitors, then trending listings
+func (s *Service) GetFrequentlyBoughtTogether(ctx context.Context, listingID int64, limit int32) ([]*domain.RecommendedListing, error) {
+	if s.recommendations == nil {
+		return nil, fmt.Errorf("recommendations are not configured")
+	}
+	n := recommendationLimit(limit)
+
+	// Neighbors change only when the batch job runs, so results are cached like similar listings
+	cacheKey := fmt.Sprintf("bought_together:%d:%d", listingID, n)
+	if s.cache != nil {
+		var cached []*domain.RecommendedListing
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	seed := []int64{listingID}
+	exclude := map[int64]bool{listingID: true}
+
+	var groups [][]domain.Recommendation
+	for _, kind := range []struct {
+		name   string
+		source string
+	}{
+		{domain.CoOccurrencePurchase, domain.RecommendationSourceBoughtTogether},
+		{domain.CoOccurrenceEngagement, domain.RecommendationSourceAlsoEngaged},
+	} {
+		related, err := s.recommendations.GetRelatedListings(ctx, kind.name, seed, n)
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", listingID).Str("kind", kind.name).Msg("failed to get related listings")
+			continue
+		}
+		groups = append(groups, domain.RankRelatedListings(related, nil, exclude, kind.source))
+	}
+
+	candidates := 0
+	for _, group := range groups {
+		candidates += len(group)
+	}
+	if candidates < n {
+		groups = append(groups, s.getTrendingRecommendations(ctx))
+	}
+
+	recommended := s.resolveRecommendations(ctx, domain.MergeRecommendations(n, exclude, groups...))
+
+	if s.cache != nil {
+		go func() {
+			if err := s.cache.Set(context.Background(), cacheKey, recommended); err != nil {
+				s.logger.Warn().Err(err).Int64("listing_id", listingID).Msg("failed to cache frequently bought together")
+			}
+		}()
+	}
+
+	return recommended, nil
+}
+
+// getTrendingRecommendations returns the trending pool (cached); errors yield no fallback
+func (s *Service) getTrendingRecommendations(ctx context.Context) []domain.Recommendation {
+	if s.cache != nil {
+		var cached []domain.Recommendation
+		if err := s.cache.Get(ctx, trendingCacheKey, &cached); err == nil {
+			return cached
+		}
+	}
+
+	trending, err := s.recommendations.GetTrendingListings(ctx, time.Now().Add(-trendingWindow), trendingPoolSize)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to get trending listings")
+		return nil
+	}
+
+	if s.cache != nil {
+		go func() {
+			if err := s.cache.Set(context.Background(), trendingCacheKey, trending); err != nil {
+				s.logger.Warn().Err(err).Msg("failed to cache trending listings")
+			}
+		}()
+	}
+
+	return trending
+}
+
+// resolveRecommendations loads the listings of ranked candidates (with images), dropping
+// listings that are gone or no longer active
+func (s *Service) resolveRecommendations(ctx context.Context, candidates []domain.Recommendation) []*domain.RecommendedListing {
+	recommended := make([]*domain.RecommendedListing, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		listing, err := s.repo.GetListingByID(ctx, candidate.ListingID)
+		if err != nil {
+			s.logger.Debug().Err(err).Int64("listing_id", candidate.ListingID).Msg("skipping unavailable recommended listing")
+			continue
+		}
+		if listing.Status != domain.StatusActive || listing.IsDeleted {
+			continue
+		}
+
+		images, err := s.repo.GetImages(ctx, listing.ID)
+		if err != nil {
+			// Log error but don't fail the request
+			s.logger.Warn().Err(err).Int64("listing_id", listing.ID).Msg("failed to load images for recommended listing")
+		} else {
+			listing.Images = images
+		}
+
+		recommended = append(recommended, &domain.RecommendedListing{
+			Listing: listing,
+			Score:   candidate.Score,
+			Source:  candidate.Source,
+		})
+	}
+
+	return recommended
+}
+
+// recommendationLimit applies the default and maximum number of recommendations
+func recommendationLimit(limit int32) int {
+	if limit <= 0 {
+		return domain.DefaultRecommendationLimit
+	}
+	if limit > domain.MaxRecommendationLimit {
+		return domain.MaxRecommendationLimit
+	}
+	return int(limit)
+}
@@ -0,0 +1,354 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ReviewRepository defines data access for reviews
+type ReviewRepository interface {
+	GetListingByID(ctx context.Context, id int64) (*domain.Listing, error)
+	GetStorefrontByID(ctx context.Context, id int64, includes *domain.Includes) (*domain.Storefront, error)
+	GetStaffMember(ctx context.Context, storefrontID, userID int64) (*domain.StorefrontStaff, error)
+	FindVerifiedPurchase(ctx context.Context, subjectType string, subjectID, userID int64) (*int64, error)
+	CreateReview(ctx context.Context, review *domain.Review) error
+	GetReview(ctx context.Context, id int64) (*domain.Review, error)
+	ListReviews(ctx context.Context, filter *domain.ReviewFilter) ([]domain.Review, int64, error)
+	UpdateReview(ctx context.Context, id int64, update *domain.ReviewUpdate) (*domain.Review, error)
+	DeleteReview(ctx context.Context, id int64) error
+	SetReviewReply(ctx context.Context, id, userID int64, reply string) (*domain.Review, error)
+	VoteReview(ctx context.Context, reviewID, userID int64, helpful bool) (*domain.Review, error)
+	ReportReview(ctx context.Context, report *domain.ReviewReport, autoHideReports int) (*domain.Review, error)
+	ListReviewReports(ctx context.Context, openOnly bool, limit, offset int) ([]domain.ReviewReport, int64, error)
+	SetReviewStatus(ctx context.Context, id int64, status string, moderatorID int64) (*domain.Review, error)
+	GetReviewSummary(ctx context.Context, subjectType string, subjectID int64) (*domain.ReviewSummary, error)
+}
+
+// StorefrontAuthorizer checks storefront staff permissions (implemented by StorefrontService)
+type StorefrontAuthorizer interface {
+	Authorize(ctx context.Context, storefrontID, userID int64, permission string) error
+}
+
+// ReviewService handles product and storefront reviews
+type ReviewService struct {
+	repo       ReviewRepository
+	authorizer StorefrontAuthorizer
+	logger     *zerolog.Logger
+}
+
+// NewReviewService creates a new review service
+func NewReviewService(repo ReviewRepository, authorizer StorefrontAuthorizer, logger *zerolog.Logger) *ReviewService {
+	return &ReviewService{
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// CreateReview publishes the user's review of a storefront product or storefront. Reviews backed
+// by a delivered order get the verified-purchase badge; the storefront's owner and staff cannot
+// review it.
+func (s *ReviewService) CreateReview(ctx context.Context, userID int64, input *domain.ReviewInput) (*domain.Review, error) {
+	if userID <= 0 {
+		return nil, fmt.Errorf("validation failed: user_id is required")
+	}
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	storefrontID, err := s.subjectStorefront(ctx, input.SubjectType, input.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotSeller(ctx, storefrontID, userID); err != nil {
+		return nil, err
+	}
+
+	orderID, err := s.repo.FindVerifiedPurchase(ctx, input.SubjectType, input.SubjectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &domain.Review{
+		SubjectType:        input.SubjectType,
+		SubjectID:          input.SubjectID,
+		StorefrontID:       storefrontID,
+		UserID:             userID,
+		OrderID:            orderID,
+		IsVerifiedPurchase: orderID != nil,
+		Rating:             input.Rating,
+		Title:              trimOptional(input.Title),
+		Body:               trimOptional(input.Body),
+	}
+	if err := s.repo.CreateReview(ctx, review); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("review_id", review.ID).
+		Str("subject_type", review.SubjectType).
+		Int64("subject_id", review.SubjectID).
+		Int64("user_id", userID).
+		Int32("rating", review.Rating).
+		Bool("verified_purchase", review.IsVerifiedPurchase).
+		Msg("review created")
+
+	return review, nil
+}
+
+// GetReview retrieves a published review. Hidden reviews are visible to their author only.
+func (s *ReviewService) GetReview(ctx context.Context, id, userID int64) (*domain.Review, error) {
+	review, err := s.repo.GetReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !review.IsPublished() && review.UserID != userID {
+		return nil, fmt.Errorf("review not found")
+	}
+	return review, nil
+}
+
+// ListReviews lists the reviews of a subject with the subject's rating summary
+func (s *ReviewService) ListReviews(ctx context.Context, filter *domain.ReviewFilter) ([]domain.Review, int64, *domain.ReviewSummary, error) {
+	if !domain.IsValidReviewSubject(filter.SubjectType) {
+		return nil, 0, nil, fmt.Errorf("validation failed: invalid subject type: %s", filter.SubjectType)
+	}
+	if filter.SubjectID <= 0 {
+		return nil, 0, nil, fmt.Errorf("validation failed: subject_id is required")
+	}
+	filter.Normalize()
+
+	reviews, total, err := s.repo.ListReviews(ctx, filter)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	summary, err := s.repo.GetReviewSummary(ctx, filter.SubjectType, filter.SubjectID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return reviews, total, summary, nil
+}
+
+// GetReviewSummary returns the rating summary of a subject
+func (s *ReviewService) GetReviewSummary(ctx context.Context, subjectType string, subjectID int64) (*domain.ReviewSummary, error) {
+	if !domain.IsValidReviewSubject(subjectType) {
+		return nil, fmt.Errorf("validation failed: invalid subject type: %s", subjectType)
+	}
+	return s.repo.GetReviewSummary(ctx, subjectType, subjectID)
+}
+
+// UpdateReview lets the author change the rating and text of their review
+func (s *ReviewService) UpdateReview(ctx context.Context, userID, id int64, update *domain.ReviewUpdate) (*domain.Review, error) {
+	if err := update.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	review, err := s.repo.GetReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: only the author can edit the review")
+	}
+
+	update.Title = trimOptional(update.Title)
+	update.Body = trimOptional(update.Body)
+
+	updated, err := s.repo.UpdateReview(ctx, id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().Int64("review_id", id).Int64("user_id", userID).Msg("review updated")
+	return updated, nil
+}
+
+// DeleteReview deletes a review (author or admin)
+func (s *ReviewService) DeleteReview(ctx context.Context, userID, id int64, isAdmin bool) error {
+	review, err := s.repo.GetReview(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && review.UserID != userID {
+		return fmt.Errorf("unauthorized: only the author can delete the review")
+	}
+
+	if err := s.repo.DeleteReview(ctx, id); err != nil {
+		return err
+	}
+
+	s.logger.Info().Int64("review_id", id).Int64("user_id", userID).Bool("admin", isAdmin).Msg("review deleted")
+	return nil
+}
+
+// ReplyToReview sets the seller reply of a review. Requires reviews.reply on the storefront.
+func (s *ReviewService) ReplyToReview(ctx context.Context, actorID, id int64, reply string) (*domain.Review, error) {
+	reply = strings.TrimSpace(reply)
+	if err := domain.ValidateReviewReply(reply); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	review, err := s.repo.GetReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(ctx, review.StorefrontID, actorID, domain.PermissionReviewsReply); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.SetReviewReply(ctx, id, actorID, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("review_id", id).
+		Int64("storefront_id", review.StorefrontID).
+		Int64("user_id", actorID).
+		Msg("review reply saved")
+
+	return updated, nil
+}
+
+// VoteReview records whether the user found a published review helpful
+func (s *ReviewService) VoteReview(ctx context.Context, userID, id int64, helpful bool) (*domain.Review, error) {
+	review, err := s.GetReview(ctx, id, 0)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID == userID {
+		return nil, fmt.Errorf("validation failed: authors cannot vote on their own review")
+	}
+
+	return s.repo.VoteReview(ctx, id, userID, helpful)
+}
+
+// ReportReview records an abuse report. A review reaching domain.ReviewAutoHideReports open
+// reports is hidden until a moderator restores it.
+func (s *ReviewService) ReportReview(ctx context.Context, userID, id int64, reason string, comment *string) (*domain.Review, error) {
+	if !domain.IsValidReviewReportReason(reason) {
+		return nil, fmt.Errorf("validation failed: invalid report reason: %s", reason)
+	}
+
+	review, err := s.GetReview(ctx, id, 0)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID == userID {
+		return nil, fmt.Errorf("validation failed: authors cannot report their own review")
+	}
+
+	report := &domain.ReviewReport{
+		ReviewID: id,
+		UserID:   userID,
+		Reason:   reason,
+		Comment:  trimOptional(comment),
+	}
+	updated, err := s.repo.ReportReview(ctx, report, domain.ReviewAutoHideReports)
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated.IsPublished() {
+		s.logger.Warn().
+			Int64("review_id", id).
+			Int32("reports_count", updated.ReportsCount).
+			Msg("review hidden after abuse reports")
+	}
+
+	return updated, nil
+}
+
+// ListReviewReports lists abuse reports for moderators
+func (s *ReviewService) ListReviewReports(ctx context.Context, openOnly bool, limit, offset int) ([]domain.ReviewReport, int64, error) {
+	if limit <= 0 {
+		limit = domain.DefaultReviewsLimit
+	}
+	if limit > domain.MaxReviewsLimit {
+		limit = domain.MaxReviewsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.repo.ListReviewReports(ctx, openOnly, limit, offset)
+}
+
+// ModerateReview hides or restores a review and resolves its open reports
+func (s *ReviewService) ModerateReview(ctx context.Context, moderatorID, id int64, hide bool) (*domain.Review, error) {
+	status := domain.ReviewStatusPublished
+	if hide {
+		status = domain.ReviewStatusHidden
+	}
+
+	review, err := s.repo.SetReviewStatus(ctx, id, status, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("review_id", id).
+		Int64("moderator_id", moderatorID).
+		Str("status", status).
+		Msg("review moderated")
+
+	return review, nil
+}
+
+// subjectStorefront returns the storefront of a review subject. Only storefront products can be
+// reviewed as products.
+func (s *ReviewService) subjectStorefront(ctx context.Context, subjectType string, subjectID int64) (int64, error) {
+	if subjectType == domain.ReviewSubjectStorefront {
+		storefront, err := s.repo.GetStorefrontByID(ctx, subjectID, nil)
+		if err != nil {
+			return 0, err
+		}
+		return storefront.ID, nil
+	}
+
+	listing, err := s.repo.GetListingByID(ctx, subjectID)
+	if err != nil {
+		return 0, fmt.Errorf("product not found")
+	}
+	if listing.StorefrontID == nil {
+		return 0, fmt.Errorf("validation failed: only storefront products can be reviewed")
+	}
+	return *listing.StorefrontID, nil
+}
+
+// checkNotSeller rejects reviews by the storefront's owner and staff
+func (s *ReviewService) checkNotSeller(ctx context.Context, storefrontID, userID int64) error {
+	storefront, err := s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		return err
+	}
+	if storefront.UserID == userID {
+		return fmt.Errorf("validation failed: sellers cannot review their own storefront")
+	}
+
+	staff, err := s.repo.GetStaffMember(ctx, storefrontID, userID)
+	if err != nil {
+		return err
+	}
+	if staff != nil {
+		return fmt.Errorf("validation failed: storefront staff cannot review their storefront")
+	}
+	return nil
+}
+
+// trimOptional trims an optional text field, dropping it when empty
+func trimOptional(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
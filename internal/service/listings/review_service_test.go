@@ -0,0 +1,257 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeReviewRepo keeps reviews in memory and maintains the rating aggregate and the
+// auto-hide threshold the way the postgres repository does
+type fakeReviewRepo struct {
+	ReviewRepository // Methods the tests do not use panic
+
+	storefront  *domain.Storefront
+	reviews     map[int64]*domain.Review
+	openReports map[int64]int
+	aggregates  map[string]*domain.ReviewSummary
+	autoHideArg int
+}
+
+func newFakeReviewRepo() *fakeReviewRepo {
+	return &fakeReviewRepo{
+		storefront:  &domain.Storefront{ID: 10, UserID: 100},
+		reviews:     map[int64]*domain.Review{},
+		openReports: map[int64]int{},
+		aggregates:  map[string]*domain.ReviewSummary{},
+	}
+}
+
+func (f *fakeReviewRepo) GetStorefrontByID(_ context.Context, id int64, _ *domain.Includes) (*domain.Storefront, error) {
+	if id != f.storefront.ID {
+		return nil, fmt.Errorf("storefront not found")
+	}
+	return f.storefront, nil
+}
+
+func (f *fakeReviewRepo) GetStaffMember(_ context.Context, _, _ int64) (*domain.StorefrontStaff, error) {
+	return nil, nil
+}
+
+func (f *fakeReviewRepo) FindVerifiedPurchase(_ context.Context, _ string, _, _ int64) (*int64, error) {
+	return nil, nil
+}
+
+func (f *fakeReviewRepo) CreateReview(_ context.Context, review *domain.Review) error {
+	review.ID = int64(len(f.reviews) + 1)
+	review.Status = domain.ReviewStatusPublished
+	f.reviews[review.ID] = review
+	f.applyDelta(review, 1)
+	return nil
+}
+
+func (f *fakeReviewRepo) GetReview(_ context.Context, id int64) (*domain.Review, error) {
+	review, ok := f.reviews[id]
+	if !ok {
+		return nil, fmt.Errorf("review not found")
+	}
+	copied := *review
+	return &copied, nil
+}
+
+func (f *fakeReviewRepo) ListReviews(_ context.Context, filter *domain.ReviewFilter) ([]domain.Review, int64, error) {
+	var reviews []domain.Review
+	for _, review := range f.reviews {
+		if review.SubjectType == filter.SubjectType && review.SubjectID == filter.SubjectID && review.IsPublished() {
+			reviews = append(reviews, *review)
+		}
+	}
+	return reviews, int64(len(reviews)), nil
+}
+
+func (f *fakeReviewRepo) ReportReview(_ context.Context, report *domain.ReviewReport, autoHideReports int) (*domain.Review, error) {
+	f.autoHideArg = autoHideReports
+	review, ok := f.reviews[report.ReviewID]
+	if !ok {
+		return nil, fmt.Errorf("review not found")
+	}
+
+	f.openReports[review.ID]++
+	review.ReportsCount++
+	if review.IsPublished() && autoHideReports > 0 && f.openReports[review.ID] >= autoHideReports {
+		review.Status = domain.ReviewStatusHidden
+		f.applyDelta(review, -1)
+	}
+	copied := *review
+	return &copied, nil
+}
+
+func (f *fakeReviewRepo) SetReviewStatus(_ context.Context, id int64, status string, _ int64) (*domain.Review, error) {
+	review, ok := f.reviews[id]
+	if !ok {
+		return nil, fmt.Errorf("review not found")
+	}
+	if review.Status != status {
+		sign := int32(1)
+		if status == domain.ReviewStatusHidden {
+			sign = -1
+		}
+		review.Status = status
+		f.applyDelta(review, sign)
+	}
+	f.openReports[id] = 0
+	copied := *review
+	return &copied, nil
+}
+
+func (f *fakeReviewRepo) GetReviewSummary(_ context.Context, subjectType string, subjectID int64) (*domain.ReviewSummary, error) {
+	summary := domain.ReviewSummary{SubjectType: subjectType, SubjectID: subjectID}
+	if aggregate, ok := f.aggregates[fmt.Sprintf("%s:%d", subjectType, subjectID)]; ok {
+		summary = *aggregate
+	}
+	return &summary, nil
+}
+
+func (f *fakeReviewRepo) applyDelta(review *domain.Review, sign int32) {
+	var delta domain.RatingDelta
+	delta.Add(review.Rating, sign)
+
+	key := fmt.Sprintf("%s:%d", review.SubjectType, review.SubjectID)
+	aggregate, ok := f.aggregates[key]
+	if !ok {
+		aggregate = &domain.ReviewSummary{SubjectType: review.SubjectType, SubjectID: review.SubjectID}
+		f.aggregates[key] = aggregate
+	}
+	aggregate.ReviewsCount += delta.Count
+	aggregate.RatingSum += delta.Sum
+	for i := range aggregate.RatingCounts {
+		aggregate.RatingCounts[i] += delta.Buckets[i]
+	}
+}
+
+func setupReviewServiceTest(t *testing.T) (*ReviewService, *fakeReviewRepo) {
+	t.Helper()
+	logger := zerolog.Nop()
+	repo := newFakeReviewRepo()
+	return NewReviewService(repo, nil, &logger), repo
+}
+
+func createStorefrontReview(t *testing.T, service *ReviewService, userID int64, rating int32) *domain.Review {
+	t.Helper()
+	review, err := service.CreateReview(context.Background(), userID, &domain.ReviewInput{
+		SubjectType: domain.ReviewSubjectStorefront,
+		SubjectID:   10,
+		Rating:      rating,
+	})
+	require.NoError(t, err)
+	return review
+}
+
+func TestReportReview_AutoHidesAfterThreshold(t *testing.T) {
+	service, repo := setupReviewServiceTest(t)
+	ctx := context.Background()
+
+	review := createStorefrontReview(t, service, 1, 5)
+
+	for i := 1; i < domain.ReviewAutoHideReports; i++ {
+		updated, err := service.ReportReview(ctx, int64(100+i), review.ID, domain.ReviewReportSpam, nil)
+		require.NoError(t, err)
+		assert.True(t, updated.IsPublished(), "review stays published below the threshold")
+	}
+	assert.Equal(t, domain.ReviewAutoHideReports, repo.autoHideArg)
+
+	updated, err := service.ReportReview(ctx, 200, review.ID, domain.ReviewReportFake, nil)
+	require.NoError(t, err)
+	assert.False(t, updated.IsPublished())
+	assert.Equal(t, int32(domain.ReviewAutoHideReports), updated.ReportsCount)
+
+	// Hidden reviews are only visible to their author and cannot be reported again
+	_, err = service.GetReview(ctx, review.ID, 0)
+	assert.EqualError(t, err, "review not found")
+	own, err := service.GetReview(ctx, review.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, review.ID, own.ID)
+
+	_, err = service.ReportReview(ctx, 201, review.ID, domain.ReviewReportSpam, nil)
+	assert.EqualError(t, err, "review not found")
+}
+
+func TestReportReview_Validation(t *testing.T) {
+	service, _ := setupReviewServiceTest(t)
+	ctx := context.Background()
+
+	review := createStorefrontReview(t, service, 1, 4)
+
+	_, err := service.ReportReview(ctx, 2, review.ID, "boring", nil)
+	assert.ErrorContains(t, err, "invalid report reason")
+
+	_, err = service.ReportReview(ctx, 1, review.ID, domain.ReviewReportSpam, nil)
+	assert.ErrorContains(t, err, "authors cannot report their own review")
+}
+
+func TestReviewSummary_TracksPublishedRatings(t *testing.T) {
+	service, _ := setupReviewServiceTest(t)
+	ctx := context.Background()
+
+	createStorefrontReview(t, service, 1, 5)
+	createStorefrontReview(t, service, 2, 4)
+	hidden := createStorefrontReview(t, service, 3, 1)
+
+	summary, err := service.GetReviewSummary(ctx, domain.ReviewSubjectStorefront, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), summary.ReviewsCount)
+	assert.Equal(t, 3.33, summary.Average())
+
+	// Reaching the report threshold removes the rating from the aggregate
+	for i := 0; i < domain.ReviewAutoHideReports; i++ {
+		_, err := service.ReportReview(ctx, int64(100+i), hidden.ID, domain.ReviewReportOffensive, nil)
+		require.NoError(t, err)
+	}
+
+	reviews, total, summary, err := service.ListReviews(ctx, &domain.ReviewFilter{
+		SubjectType: domain.ReviewSubjectStorefront,
+		SubjectID:   10,
+	})
+	require.NoError(t, err)
+	assert.Len(t, reviews, 2)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, int32(2), summary.ReviewsCount)
+	assert.Equal(t, int64(9), summary.RatingSum)
+	assert.Equal(t, [5]int32{0, 0, 0, 1, 1}, summary.RatingCounts)
+	assert.Equal(t, 4.5, summary.Average())
+
+	// Restoring the review by a moderator adds the rating back
+	_, err = service.ModerateReview(ctx, 999, hidden.ID, false)
+	require.NoError(t, err)
+	summary, err = service.GetReviewSummary(ctx, domain.ReviewSubjectStorefront, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), summary.ReviewsCount)
+	assert.Equal(t, [5]int32{1, 0, 0, 1, 1}, summary.RatingCounts)
+}
+
+func TestReviewSummary_InvalidSubject(t *testing.T) {
+	service, _ := setupReviewServiceTest(t)
+
+	_, err := service.GetReviewSummary(context.Background(), "seller", 10)
+	assert.ErrorContains(t, err, "invalid subject type")
+
+	_, _, _, err = service.ListReviews(context.Background(), &domain.ReviewFilter{SubjectType: domain.ReviewSubjectProduct})
+	assert.ErrorContains(t, err, "subject_id is required")
+}
+
+func TestCreateReview_SellerCannotReviewOwnStorefront(t *testing.T) {
+	service, _ := setupReviewServiceTest(t)
+
+	_, err := service.CreateReview(context.Background(), 100, &domain.ReviewInput{
+		SubjectType: domain.ReviewSubjectStorefront,
+		SubjectID:   10,
+		Rating:      5,
+	})
+	assert.ErrorContains(t, err, "sellers cannot review their own storefront")
+}
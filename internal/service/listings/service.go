@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog"
 
+	"github.com/sveturs/listings/internal/dedup"
 	"github.com/sveturs/listings/internal/domain"
 	"github.com/sveturs/listings/internal/repository/postgres"
 )
@@ -47,6 +49,25 @@ type Repository interface {
 	GetUserFavorites(ctx context.Context, userID int64) ([]int64, error)
 	IsFavorite(ctx context.Context, userID, listingID int64) (bool, error)
 
+	// Price history operations
+	GetPriceHistory(ctx context.Context, filter *domain.PriceHistoryFilter) ([]*domain.PriceHistoryEntry, error)
+
+	// Expiration operations
+	GetCategoryRenewalDays(ctx context.Context, categoryID int64) (int, error)
+	SetListingExpiration(ctx context.Context, listingID int64, expiresAt time.Time) error
+	RenewListing(ctx context.Context, listingID int64, expiresAt time.Time) error
+
+	// Moderation workflow operations
+	TransitionListingStatus(ctx context.Context, event *domain.ListingModerationEvent, scheduledAt *time.Time) error
+	GetModerationQueue(ctx context.Context, limit, offset int) ([]*domain.Listing, int32, error)
+
+	// Duplicate detection operations
+	SaveListingFingerprint(ctx context.Context, fingerprint *domain.ListingFingerprint) error
+	FindDuplicateCandidates(ctx context.Context, bandKeys []int64, excludeListingID int64, limit int) ([]*domain.ListingFingerprint, error)
+	FindSimilarImages(ctx context.Context, hash int64, maxDistance int, excludeListingID int64, limit int) ([]*domain.DuplicateMatch, error)
+	FlagDuplicates(ctx context.Context, listingID int64, matches []*domain.DuplicateMatch) error
+	ListDuplicateFlags(ctx context.Context, limit, offset int) ([]*domain.DuplicateFlag, int32, error)
+
 	// Variant operations (old ListingVariant - deprecated)
 	CreateVariants(ctx context.Context, variants []*domain.ListingVariant) error
 	GetVariants(ctx context.Context, listingID int64) ([]*domain.ListingVariant, error)
@@ -90,6 +111,7 @@ type Repository interface {
 	UpdateProductInventory(ctx context.Context, storefrontID, productID, variantID int64, movementType string, quantity int32, reason, notes string, userID int64) (int32, int32, error)
 	GetProductStats(ctx context.Context, storefrontID int64) (*domain.ProductStats, error)
 	IncrementProductViews(ctx context.Context, productID int64) error
+	IsViewableProduct(ctx context.Context, productID int64) (bool, error)
 	BatchUpdateStock(ctx context.Context, storefrontID int64, items []domain.StockUpdateItem, reason string, userID int64) (int32, int32, []domain.StockUpdateResult, error)
 
 	// Product Images operations (B2C)
@@ -128,6 +150,19 @@ type Service struct {
 	slugGenerator *SlugGenerator
 	stdValidator  *validator.Validate
 	logger        zerolog.Logger
+
+	priceDropNotifier  PriceDropNotifier        // Optional: price-drop alerts for favorites
+	defaultRenewalDays int                      // Listing lifetime when the category defines none
+	moderationNotifier ModerationNotifier       // Optional: review decision notifications for owners
+	duplicates         DuplicateDetectionConfig // Near-duplicate checks (off unless configured)
+	eventTracker       EventTracker             // Optional: analytics event ingestion
+	recommendations    RecommendationStore      // Optional: precomputed item-to-item neighbors
+	recentlyViewed     RecentlyViewedStore      // Optional: per-user/session recently viewed lists
+	viewDedup          ViewDeduplicator         // Optional: repeat view detection
+	viewCounter        ViewCounter              // Optional: buffered view counter writes
+
+	viewableMu       sync.Mutex
+	viewableProducts map[int64]viewableProduct // Products checked before counting their views
 }
 
 // NewService creates a new listings service
@@ -175,13 +210,23 @@ func (s *Service) CreateListing(ctx context.Context, input *domain.CreateListing
 		Visibility:   domain.VisibilityPublic,
 	}
 
-	// 4. Set expiration date for C2C listings (30 days)
+	// 4. Check for near-duplicates of existing listings
+	duplicates, signature := s.checkTextDuplicates(ctx, 0, input.UserID, input.Title, input.Description)
+	if duplicates != nil && duplicates.Action == domain.DuplicateActionBlocked {
+		s.logger.Warn().
+			Int64("user_id", input.UserID).
+			Int64("duplicate_of", duplicates.Matches[0].ListingID).
+			Msg("listing creation blocked as duplicate")
+		return nil, duplicates.BlockError()
+	}
+
+	// 5. Set expiration date for C2C listings (category renewal period)
 	if input.SourceType == domain.SourceTypeC2C {
-		expiresAt := time.Now().AddDate(0, 0, 30) // 30 days from now
+		expiresAt := domain.RenewalExpiry(time.Now(), s.renewalPeriodDays(ctx, input.CategoryID))
 		listing.ExpiresAt = &expiresAt
 	}
 
-	// 5. Create in database (using CreateListingInput)
+	// 6. Create in database (using CreateListingInput)
 	created, err := s.repo.CreateListing(ctx, input)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("failed to create listing in repository")
@@ -192,10 +237,18 @@ func (s *Service) CreateListing(ctx context.Context, input *domain.CreateListing
 	// This is a temporary workaround until we update the repository layer
 	created.Slug = slug
 	if listing.ExpiresAt != nil {
-		created.ExpiresAt = listing.ExpiresAt
+		if err := s.repo.SetListingExpiration(ctx, created.ID, *listing.ExpiresAt); err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", created.ID).Msg("failed to set listing expiration (non-critical)")
+		} else {
+			created.ExpiresAt = listing.ExpiresAt
+		}
+	}
+	if duplicates != nil {
+		s.storeDuplicateResults(ctx, created.ID, signature, duplicates)
+		created.Duplicates = duplicates
 	}
 
-	// 6. Enqueue for async indexing
+	// 7. Enqueue for async indexing
 	if err := s.repo.EnqueueIndexing(ctx, created.ID, domain.IndexOpIndex); err != nil {
 		s.logger.Warn().Err(err).Int64("listing_id", created.ID).Msg("failed to enqueue indexing (non-critical)")
 	}
@@ -319,14 +372,40 @@ func (s *Service) UpdateListing(ctx context.Context, id int64, userID int64, inp
 		}
 	}
 
-	// 5. Update listing in database
+	// 5. Check for near-duplicates if the text changes
+	var duplicates *domain.DuplicateReport
+	var signature dedup.Signature
+	if textChanged(existing, input) {
+		title, description := existing.Title, existing.Description
+		if input.Title != nil {
+			title = *input.Title
+		}
+		if input.Description != nil {
+			description = input.Description
+		}
+		duplicates, signature = s.checkTextDuplicates(ctx, id, existing.UserID, title, description)
+		if duplicates != nil && duplicates.Action == domain.DuplicateActionBlocked {
+			s.logger.Warn().
+				Int64("listing_id", id).
+				Int64("duplicate_of", duplicates.Matches[0].ListingID).
+				Msg("listing update blocked as duplicate")
+			return nil, duplicates.BlockError()
+		}
+	}
+
+	// 6. Update listing in database
 	updated, err := s.repo.UpdateListing(ctx, id, input)
 	if err != nil {
 		s.logger.Error().Err(err).Int64("listing_id", id).Msg("failed to update listing")
 		return nil, fmt.Errorf("failed to update listing: %w", err)
 	}
 
-	// 6. Load images
+	if duplicates != nil {
+		s.storeDuplicateResults(ctx, id, signature, duplicates)
+		updated.Duplicates = duplicates
+	}
+
+	// 7. Load images
 	images, err := s.repo.GetImages(ctx, id)
 	if err != nil {
 		// Log error but don't fail the request
@@ -335,7 +414,7 @@ func (s *Service) UpdateListing(ctx context.Context, id int64, userID int64, inp
 		updated.Images = images
 	}
 
-	// 7. Invalidate cache (if available)
+	// 8. Invalidate cache (if available)
 	if s.cache != nil {
 		cacheKey := fmt.Sprintf("listing:%d", id)
 		if err := s.cache.Delete(ctx, cacheKey); err != nil {
@@ -343,11 +422,22 @@ func (s *Service) UpdateListing(ctx context.Context, id int64, userID int64, inp
 		}
 	}
 
-	// 8. Enqueue for async re-indexing
+	// 9. Enqueue for async re-indexing
 	if err := s.repo.EnqueueIndexing(ctx, updated.ID, domain.IndexOpUpdate); err != nil {
 		s.logger.Warn().Err(err).Int64("listing_id", updated.ID).Msg("failed to enqueue indexing (non-critical)")
 	}
 
+	// 10. Notify users who favorited the listing about a price drop
+	if input.Price != nil && updated.Price < existing.Price {
+		s.notifyPriceDrop(&domain.PriceDrop{
+			ListingID: updated.ID,
+			Title:     updated.Title,
+			OldPrice:  existing.Price,
+			NewPrice:  updated.Price,
+			Currency:  updated.Currency,
+		}, existing.UserID)
+	}
+
 	s.logger.Info().
 		Int64("listing_id", updated.ID).
 		Int64("user_id", userID).
@@ -950,7 +1040,7 @@ func (s *Service) AddToFavorites(ctx context.Context, userID, listingID int64) e
 	}
 
 	// Check if listing exists
-	_, err := s.repo.GetListingByID(ctx, listingID)
+	listing, err := s.repo.GetListingByID(ctx, listingID)
 	if err != nil {
 		s.logger.Error().Err(err).Int64("listing_id", listingID).Msg("listing not found")
 		return fmt.Errorf("listing not found: %w", err)
@@ -984,6 +1074,8 @@ func (s *Service) AddToFavorites(ctx context.Context, userID, listingID int64) e
 		}
 	}
 
+	s.trackEvent(domain.NewListingEvent(domain.AnalyticsEventFavorite, listingID, &userID, listing.AnalyticsMetadata()))
+
 	s.logger.Info().Int64("user_id", userID).Int64("listing_id", listingID).Msg("added to favorites")
 	return nil
 }
@@ -1401,6 +1493,14 @@ func (s *Service) UpdateProduct(ctx context.Context, productID int64, storefront
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Remember the current price to detect price drops
+	var oldPrice *float64
+	if input.Price != nil && s.priceDropNotifier != nil {
+		if existing, err := s.repo.GetProductByID(ctx, productID, &storefrontID); err == nil && existing != nil {
+			oldPrice = &existing.Price
+		}
+	}
+
 	// Update product in repository
 	product, err := s.repo.UpdateProduct(ctx, productID, storefrontID, input)
 	if err != nil {
@@ -1408,6 +1508,16 @@ func (s *Service) UpdateProduct(ctx context.Context, productID int64, storefront
 		return nil, err // Return as-is to preserve error placeholders
 	}
 
+	if oldPrice != nil && product.Price < *oldPrice {
+		s.notifyPriceDrop(&domain.PriceDrop{
+			ListingID: product.ID,
+			Title:     product.Name,
+			OldPrice:  *oldPrice,
+			NewPrice:  product.Price,
+			Currency:  product.Currency,
+		}, 0)
+	}
+
 	// Async re-indexing in OpenSearch (non-blocking, graceful degradation)
 	if s.indexer != nil {
 		go func() {
@@ -1493,6 +1603,9 @@ func (s *Service) BulkUpdateProducts(ctx context.Context, storefrontID int64, up
 		}
 	}
 
+	// Remember current prices to detect price drops
+	oldPrices := s.currentProductPrices(ctx, storefrontID, updates)
+
 	// Call repository bulk update
 	result, err := s.repo.BulkUpdateProducts(ctx, storefrontID, updates)
 	if err != nil {
@@ -1500,6 +1613,18 @@ func (s *Service) BulkUpdateProducts(ctx context.Context, storefrontID int64, up
 		return nil, err // Return as-is to preserve error placeholders
 	}
 
+	for _, product := range result.SuccessfulProducts {
+		if oldPrice, ok := oldPrices[product.ID]; ok && product.Price < oldPrice {
+			s.notifyPriceDrop(&domain.PriceDrop{
+				ListingID: product.ID,
+				Title:     product.Name,
+				OldPrice:  oldPrice,
+				NewPrice:  product.Price,
+				Currency:  product.Currency,
+			}, 0)
+		}
+	}
+
 	s.logger.Info().
 		Int("successful_count", len(result.SuccessfulProducts)).
 		Int("failed_count", len(result.FailedUpdates)).
@@ -1714,6 +1839,14 @@ func (s *Service) UpdateProductVariant(ctx context.Context, variantID int64, pro
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Remember the current variant price to detect price drops
+	var oldPrice *float64
+	if input.Price != nil && s.priceDropNotifier != nil {
+		if existing, err := s.repo.GetVariantByID(ctx, variantID, &productID); err == nil && existing != nil {
+			oldPrice = existing.Price
+		}
+	}
+
 	// Update variant in repository
 	variant, err := s.repo.UpdateProductVariant(ctx, variantID, productID, input)
 	if err != nil {
@@ -1721,6 +1854,10 @@ func (s *Service) UpdateProductVariant(ctx context.Context, variantID int64, pro
 		return nil, err // Return as-is to preserve error placeholders
 	}
 
+	if oldPrice != nil && variant.Price != nil && *variant.Price < *oldPrice {
+		s.notifyVariantPriceDrop(ctx, variant, *oldPrice)
+	}
+
 	s.logger.Info().Int64("variant_id", variant.ID).Msg("product variant updated successfully")
 	return variant, nil
 }
@@ -1883,6 +2020,11 @@ func (s *Service) IncrementProductViews(ctx context.Context, productID int64) er
 		return err
 	}
 
+	// The repository only counts views of B2C products
+	s.trackEvent(domain.NewListingEvent(domain.AnalyticsEventView, productID, nil, map[string]interface{}{
+		"source_type": "b2c",
+	}))
+
 	s.logger.Debug().Int64("product_id", productID).Msg("product views incremented successfully")
 	return nil
 }
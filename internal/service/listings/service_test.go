@@ -924,6 +924,7 @@ func TestCreateListing_Success_MinimalFields(t *testing.T) {
 	mockRepo.On("GetListingBySlug", ctx, mock.AnythingOfType("string")).
 		Return(nil, errors.New("not found"))
 
+	SetupListingExpirationMocks(mockRepo, ctx)
 	mockRepo.On("CreateListing", ctx, input).
 		Return(expectedListing, nil)
 	mockRepo.On("EnqueueIndexing", ctx, expectedListing.ID, domain.IndexOpIndex).
@@ -959,6 +960,7 @@ func TestCreateListing_Success_WithStorefront(t *testing.T) {
 	mockRepo.On("GetListingBySlug", ctx, mock.AnythingOfType("string")).
 		Return(nil, errors.New("not found"))
 
+	SetupListingExpirationMocks(mockRepo, ctx)
 	mockRepo.On("CreateListing", ctx, input).
 		Return(expectedListing, nil)
 	mockRepo.On("EnqueueIndexing", ctx, expectedListing.ID, domain.IndexOpIndex).
@@ -987,6 +989,7 @@ func TestCreateListing_Success_WithAllFields(t *testing.T) {
 
 	expectedListing := NewTestListing(1, userID, "Complete Test Listing")
 
+	SetupListingExpirationMocks(mockRepo, ctx)
 	mockRepo.On("CreateListing", ctx, input).
 		Return(expectedListing, nil)
 	mockRepo.On("EnqueueIndexing", ctx, expectedListing.ID, domain.IndexOpIndex).
@@ -1099,6 +1102,7 @@ func TestCreateListing_Success_EnqueueIndexing_Success(t *testing.T) {
 	mockRepo.On("GetListingBySlug", ctx, mock.AnythingOfType("string")).
 		Return(nil, errors.New("not found"))
 
+	SetupListingExpirationMocks(mockRepo, ctx)
 	mockRepo.On("CreateListing", ctx, input).
 		Return(expectedListing, nil)
 	mockRepo.On("EnqueueIndexing", ctx, expectedListing.ID, domain.IndexOpIndex).
@@ -1126,6 +1130,7 @@ func TestCreateListing_Success_EnqueueIndexing_Failure_NonCritical(t *testing.T)
 	mockRepo.On("GetListingBySlug", ctx, mock.AnythingOfType("string")).
 		Return(nil, errors.New("not found"))
 
+	SetupListingExpirationMocks(mockRepo, ctx)
 	mockRepo.On("CreateListing", ctx, input).
 		Return(expectedListing, nil)
 	mockRepo.On("EnqueueIndexing", ctx, expectedListing.ID, domain.IndexOpIndex).
@@ -0,0 +1,132 @@
+package listings
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// lookupTXT resolves DNS TXT records
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// AddStorefrontDomain registers a custom domain for a storefront. The domain resolves only
+// after VerifyStorefrontDomain found the returned token in DNS.
+func (s *StorefrontService) AddStorefrontDomain(ctx context.Context, storefrontID int64, host string) (*domain.StorefrontDomain, error) {
+	normalized, err := domain.NormalizeStorefrontDomain(host)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	existing, err := s.repo.ListStorefrontDomains(ctx, storefrontID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxStorefrontDomains {
+		return nil, fmt.Errorf("validation failed: at most %d domains are allowed", domain.MaxStorefrontDomains)
+	}
+
+	token, err := generateDomainToken()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreateStorefrontDomain(ctx, storefrontID, normalized, token)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Str("domain", normalized).
+		Msg("storefront domain added")
+
+	return created, nil
+}
+
+// VerifyStorefrontDomain checks the domain's TXT record for the verification token
+func (s *StorefrontService) VerifyStorefrontDomain(ctx context.Context, storefrontID, domainID int64) (*domain.StorefrontDomain, error) {
+	d, err := s.repo.GetStorefrontDomain(ctx, storefrontID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if d.IsVerified() {
+		return d, nil
+	}
+
+	records, err := lookupTXT(ctx, d.VerificationRecord())
+	if err != nil {
+		s.logger.Warn().Err(err).Str("domain", d.Domain).Msg("verification TXT lookup failed")
+		return nil, fmt.Errorf("verification failed: TXT record %s not found", d.VerificationRecord())
+	}
+
+	found := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == d.VerificationToken {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("verification failed: TXT record %s does not contain the verification token", d.VerificationRecord())
+	}
+
+	verified, err := s.repo.MarkStorefrontDomainVerified(ctx, storefrontID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Str("domain", verified.Domain).
+		Bool("is_primary", verified.IsPrimary).
+		Msg("storefront domain verified")
+
+	return verified, nil
+}
+
+// RemoveStorefrontDomain removes a custom domain from a storefront
+func (s *StorefrontService) RemoveStorefrontDomain(ctx context.Context, storefrontID, domainID int64) error {
+	if err := s.repo.DeleteStorefrontDomain(ctx, storefrontID, domainID); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("domain_id", domainID).
+		Msg("storefront domain removed")
+
+	return nil
+}
+
+// ListStorefrontDomains lists the custom domains of a storefront
+func (s *StorefrontService) ListStorefrontDomains(ctx context.Context, storefrontID int64) ([]domain.StorefrontDomain, error) {
+	domains, err := s.repo.ListStorefrontDomains(ctx, storefrontID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains: %w", err)
+	}
+
+	return domains, nil
+}
+
+// ResolveStorefrontDomain maps a host name to the storefront it was verified for
+func (s *StorefrontService) ResolveStorefrontDomain(ctx context.Context, host string) (*domain.StorefrontDomain, error) {
+	normalized, err := domain.NormalizeStorefrontDomain(host)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return s.repo.ResolveStorefrontDomain(ctx, normalized)
+}
+
+func generateDomainToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate verification token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
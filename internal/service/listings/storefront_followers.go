@@ -0,0 +1,99 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// FollowStorefront makes the user follow an active storefront. Returns the follower count.
+func (s *StorefrontService) FollowStorefront(ctx context.Context, userID, storefrontID int64) (int32, error) {
+	storefront, err := s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		return 0, err
+	}
+	if !storefront.IsActive {
+		return 0, fmt.Errorf("validation failed: storefront is not active")
+	}
+	if storefront.UserID == userID {
+		return 0, fmt.Errorf("validation failed: owners cannot follow their own storefront")
+	}
+
+	count, err := s.repo.FollowStorefront(ctx, storefrontID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("user_id", userID).
+		Int32("followers_count", count).
+		Msg("storefront followed")
+
+	return count, nil
+}
+
+// UnfollowStorefront removes the user's follow. Returns the follower count.
+func (s *StorefrontService) UnfollowStorefront(ctx context.Context, userID, storefrontID int64) (int32, error) {
+	count, err := s.repo.UnfollowStorefront(ctx, storefrontID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("user_id", userID).
+		Int32("followers_count", count).
+		Msg("storefront unfollowed")
+
+	return count, nil
+}
+
+// IsFollowingStorefront reports whether the user follows the storefront
+func (s *StorefrontService) IsFollowingStorefront(ctx context.Context, userID, storefrontID int64) (bool, error) {
+	return s.repo.IsFollowingStorefront(ctx, storefrontID, userID)
+}
+
+// ListFollowers lists the followers of a storefront, most recent first
+func (s *StorefrontService) ListFollowers(ctx context.Context, storefrontID int64, limit, offset int) ([]domain.StorefrontFollower, int64, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.ListStorefrontFollowers(ctx, storefrontID, limit, offset)
+}
+
+// GetFollowingFeed returns a page of new products from the storefronts the user follows, newest
+// first. The returned cursor is nil on the last page.
+func (s *StorefrontService) GetFollowingFeed(ctx context.Context, filter *domain.FollowingFeedFilter) ([]*domain.Listing, *domain.FollowingFeedCursor, error) {
+	filter.Normalize()
+
+	listings, err := s.repo.GetFollowingFeed(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, listing := range listings {
+		images, err := s.repo.GetImages(ctx, listing.ID)
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("listing_id", listing.ID).Msg("failed to load images for listing")
+			continue
+		}
+		listing.Images = images
+	}
+
+	var next *domain.FollowingFeedCursor
+	if len(listings) == filter.Limit {
+		last := listings[len(listings)-1]
+		next = &domain.FollowingFeedCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	return listings, next, nil
+}
@@ -18,15 +18,39 @@ type StorefrontRepository interface {
 	GetStorefrontByID(ctx context.Context, id int64, includes *domain.Includes) (*domain.Storefront, error)
 	GetStorefrontBySlug(ctx context.Context, slug string, includes *domain.Includes) (*domain.Storefront, error)
 	UpdateStorefront(ctx context.Context, id int64, update *domain.StorefrontUpdate) error
+	SetStorefrontImage(ctx context.Context, id int64, kind, url, storagePath string) (*string, error)
 	DeleteStorefront(ctx context.Context, id int64, hardDelete bool) error
 	ListStorefronts(ctx context.Context, filter *domain.ListStorefrontsFilter) ([]domain.Storefront, int, error)
-	AddStaff(ctx context.Context, staff *domain.StorefrontStaff) error
 	UpdateStaff(ctx context.Context, id int64, update *domain.StaffUpdate) error
 	RemoveStaff(ctx context.Context, storefrontID, userID int64) error
 	GetStaff(ctx context.Context, storefrontID int64) ([]domain.StorefrontStaff, error)
+	GetStaffByID(ctx context.Context, id int64) (*domain.StorefrontStaff, error)
+	GetStaffMember(ctx context.Context, storefrontID, userID int64) (*domain.StorefrontStaff, error)
+	CreateStaffInvitation(ctx context.Context, invitation *domain.StaffInvitation) error
+	GetStaffInvitation(ctx context.Context, id int64) (*domain.StaffInvitation, error)
+	ListStaffInvitations(ctx context.Context, filter *domain.StaffInvitationFilter) ([]domain.StaffInvitation, error)
+	AcceptStaffInvitation(ctx context.Context, id int64) (*domain.StorefrontStaff, error)
+	CloseStaffInvitation(ctx context.Context, id int64, status string) (*domain.StaffInvitation, error)
 	SetWorkingHours(ctx context.Context, storefrontID int64, hours []domain.StorefrontHours) error
 	GetWorkingHours(ctx context.Context, storefrontID int64) ([]domain.StorefrontHours, error)
-	IsOpenNow(ctx context.Context, storefrontID int64) (bool, *time.Time, *time.Time, error)
+	SetVacationMode(ctx context.Context, storefrontID int64, settings *domain.VacationSettings) error
+	GetStorefrontVacations(ctx context.Context, ids []int64) ([]domain.Storefront, error)
+	SetHolidays(ctx context.Context, storefrontID int64, holidays []domain.StorefrontHoliday) error
+	GetHolidays(ctx context.Context, storefrontID int64) ([]domain.StorefrontHoliday, error)
+	CreateStorefrontDomain(ctx context.Context, storefrontID int64, host, token string) (*domain.StorefrontDomain, error)
+	GetStorefrontDomain(ctx context.Context, storefrontID, domainID int64) (*domain.StorefrontDomain, error)
+	ListStorefrontDomains(ctx context.Context, storefrontID int64) ([]domain.StorefrontDomain, error)
+	MarkStorefrontDomainVerified(ctx context.Context, storefrontID, domainID int64) (*domain.StorefrontDomain, error)
+	DeleteStorefrontDomain(ctx context.Context, storefrontID, domainID int64) error
+	ResolveStorefrontDomain(ctx context.Context, host string) (*domain.StorefrontDomain, error)
+	CreateVerificationRequest(ctx context.Context, req *domain.StorefrontVerificationRequest) error
+	GetVerificationRequest(ctx context.Context, id int64) (*domain.StorefrontVerificationRequest, error)
+	GetLatestVerificationRequest(ctx context.Context, storefrontID int64) (*domain.StorefrontVerificationRequest, error)
+	ListVerificationRequests(ctx context.Context, status string, limit, offset int) ([]*domain.StorefrontVerificationRequest, int32, error)
+	DecideVerificationRequest(ctx context.Context, decision *domain.VerificationDecision) (*domain.StorefrontVerificationRequest, error)
+	CancelVerificationRequest(ctx context.Context, storefrontID, actorID int64) (*domain.StorefrontVerificationRequest, error)
+	RevokeStorefrontVerification(ctx context.Context, storefrontID, actorID int64, reason string) error
+	ListVerificationEvents(ctx context.Context, storefrontID int64) ([]domain.StorefrontVerificationEvent, error)
 	SetPaymentMethods(ctx context.Context, storefrontID int64, methods []domain.PaymentMethod) error
 	GetPaymentMethods(ctx context.Context, storefrontID int64) ([]domain.PaymentMethod, error)
 	SetDeliveryOptions(ctx context.Context, storefrontID int64, options []domain.StorefrontDeliveryOption) error
@@ -35,6 +59,12 @@ type StorefrontRepository interface {
 	GetStorefrontDashboardStats(ctx context.Context, storefrontID int64, from, to *time.Time) (*domain.StorefrontDashboardStats, error)
 	IsSlugTaken(ctx context.Context, slug string, excludeID *int64) (bool, error)
 	IncrementViewsCount(ctx context.Context, storefrontID int64) error
+	FollowStorefront(ctx context.Context, storefrontID, userID int64) (int32, error)
+	UnfollowStorefront(ctx context.Context, storefrontID, userID int64) (int32, error)
+	IsFollowingStorefront(ctx context.Context, storefrontID, userID int64) (bool, error)
+	ListStorefrontFollowers(ctx context.Context, storefrontID int64, limit, offset int) ([]domain.StorefrontFollower, int64, error)
+	GetFollowingFeed(ctx context.Context, filter *domain.FollowingFeedFilter) ([]*domain.Listing, error)
+	GetImages(ctx context.Context, listingID int64) ([]*domain.ListingImage, error)
 }
 
 // CreateStorefrontRequest represents storefront creation request
@@ -81,6 +111,7 @@ type UpdateStorefrontRequest struct {
 	AIAgentEnabled      *bool
 	LiveShoppingEnabled *bool
 	GroupBuyingEnabled  *bool
+	Timezone            *string // IANA name; empty resets to the country timezone
 }
 
 // StorefrontService handles storefront business logic
@@ -216,6 +247,12 @@ func (s *StorefrontService) UpdateStorefront(ctx context.Context, id int64, req
 		}
 	}
 
+	if req.Timezone != nil && *req.Timezone != "" {
+		if err := domain.ValidateTimezone(*req.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
 	update := &domain.StorefrontUpdate{
 		Name:                req.Name,
 		Description:         req.Description,
@@ -231,6 +268,7 @@ func (s *StorefrontService) UpdateStorefront(ctx context.Context, id int64, req
 		AIAgentEnabled:      req.AIAgentEnabled,
 		LiveShoppingEnabled: req.LiveShoppingEnabled,
 		GroupBuyingEnabled:  req.GroupBuyingEnabled,
+		Timezone:            req.Timezone,
 	}
 
 	if req.Location != nil {
@@ -259,6 +297,31 @@ func (s *StorefrontService) UpdateStorefront(ctx context.Context, id int64, req
 	return storefront, nil
 }
 
+// SetStorefrontImage replaces the storefront logo or banner with an uploaded image.
+// Returns the updated storefront and the storage key of the replaced image (if any).
+func (s *StorefrontService) SetStorefrontImage(ctx context.Context, id int64, kind, url, storagePath string) (*domain.Storefront, *string, error) {
+	if kind != domain.StorefrontImageLogo && kind != domain.StorefrontImageBanner {
+		return nil, nil, fmt.Errorf("invalid image kind: %s (allowed: logo, banner)", kind)
+	}
+
+	previous, err := s.repo.SetStorefrontImage(ctx, id, kind, url, storagePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to set storefront %s: %w", kind, err)
+	}
+
+	storefront, err := s.repo.GetStorefrontByID(ctx, id, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get updated storefront: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", id).
+		Str("kind", kind).
+		Msg("storefront image updated")
+
+	return storefront, previous, nil
+}
+
 // DeleteStorefront deletes a storefront
 func (s *StorefrontService) DeleteStorefront(ctx context.Context, id int64, hardDelete bool) error {
 	if err := s.repo.DeleteStorefront(ctx, id, hardDelete); err != nil {
@@ -283,66 +346,6 @@ func (s *StorefrontService) ListStorefronts(ctx context.Context, filter *domain.
 	return storefronts, total, nil
 }
 
-// AddStaff adds a staff member to a storefront
-func (s *StorefrontService) AddStaff(ctx context.Context, storefrontID, userID int64, role string, permissions domain.JSONB) (*domain.StorefrontStaff, error) {
-	if err := validateStaffRole(role); err != nil {
-		return nil, err
-	}
-
-	staff := &domain.StorefrontStaff{
-		StorefrontID: storefrontID,
-		UserID:       userID,
-		Role:         role,
-		Permissions:  permissions,
-		ActionsCount: 0,
-	}
-
-	if err := s.repo.AddStaff(ctx, staff); err != nil {
-		return nil, fmt.Errorf("failed to add staff: %w", err)
-	}
-
-	s.logger.Info().
-		Int64("storefront_id", storefrontID).
-		Int64("user_id", userID).
-		Str("role", role).
-		Msg("staff member added successfully")
-
-	return staff, nil
-}
-
-// UpdateStaff updates a staff member
-func (s *StorefrontService) UpdateStaff(ctx context.Context, staffID int64, update *domain.StaffUpdate) (*domain.StorefrontStaff, error) {
-	if update.Role != nil {
-		if err := validateStaffRole(*update.Role); err != nil {
-			return nil, err
-		}
-	}
-
-	if err := s.repo.UpdateStaff(ctx, staffID, update); err != nil {
-		return nil, fmt.Errorf("failed to update staff: %w", err)
-	}
-
-	s.logger.Info().
-		Int64("staff_id", staffID).
-		Msg("staff member updated successfully")
-
-	return nil, nil
-}
-
-// RemoveStaff removes a staff member from a storefront
-func (s *StorefrontService) RemoveStaff(ctx context.Context, storefrontID, userID int64) error {
-	if err := s.repo.RemoveStaff(ctx, storefrontID, userID); err != nil {
-		return fmt.Errorf("failed to remove staff: %w", err)
-	}
-
-	s.logger.Info().
-		Int64("storefront_id", storefrontID).
-		Int64("user_id", userID).
-		Msg("staff member removed successfully")
-
-	return nil
-}
-
 // GetStaff retrieves all staff members for a storefront
 func (s *StorefrontService) GetStaff(ctx context.Context, storefrontID int64) ([]domain.StorefrontStaff, error) {
 	staff, err := s.repo.GetStaff(ctx, storefrontID)
@@ -383,11 +386,6 @@ func (s *StorefrontService) GetWorkingHours(ctx context.Context, storefrontID in
 	return hours, nil
 }
 
-// IsOpenNow checks if a storefront is currently open
-func (s *StorefrontService) IsOpenNow(ctx context.Context, storefrontID int64) (bool, *time.Time, *time.Time, error) {
-	return s.repo.IsOpenNow(ctx, storefrontID)
-}
-
 // SetPaymentMethods sets payment methods for a storefront
 func (s *StorefrontService) SetPaymentMethods(ctx context.Context, storefrontID int64, methods []domain.PaymentMethod) error {
 	for _, method := range methods {
@@ -517,14 +515,7 @@ func validateCommissionRate(rate float64) error {
 }
 
 func validateStaffRole(role string) error {
-	validRoles := map[string]bool{
-		"owner":     true,
-		"manager":   true,
-		"cashier":   true,
-		"support":   true,
-		"moderator": true,
-	}
-	if !validRoles[role] {
+	if !domain.IsValidStaffRole(role) {
 		return fmt.Errorf("invalid staff role: %s", role)
 	}
 	return nil
@@ -0,0 +1,301 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// HasPermission reports whether the user holds the permission on the storefront. The owner
+// holds every permission; staff members hold their role preset adjusted by their overrides.
+func (s *StorefrontService) HasPermission(ctx context.Context, storefrontID, userID int64, permission string) (bool, error) {
+	storefront, err := s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if storefront.UserID == userID {
+		return true, nil
+	}
+
+	staff, err := s.repo.GetStaffMember(ctx, storefrontID, userID)
+	if err != nil {
+		return false, err
+	}
+	return staff != nil && staff.HasPermission(permission), nil
+}
+
+// Authorize is the storefront authorizer used by all storefront-scoped operations: it returns
+// an "unauthorized" error unless the user holds the permission on the storefront
+func (s *StorefrontService) Authorize(ctx context.Context, storefrontID, userID int64, permission string) error {
+	allowed, err := s.HasPermission(ctx, storefrontID, userID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		s.logger.Warn().
+			Int64("storefront_id", storefrontID).
+			Int64("user_id", userID).
+			Str("permission", permission).
+			Msg("storefront permission denied")
+		return fmt.Errorf("unauthorized: missing permission %s", permission)
+	}
+	return nil
+}
+
+// InviteStaff invites a user to the storefront staff. The user becomes a staff member
+// after accepting the invitation.
+func (s *StorefrontService) InviteStaff(ctx context.Context, actorID int64, invitation *domain.StaffInvitation) (*domain.StaffInvitation, error) {
+	if invitation.UserID <= 0 {
+		return nil, fmt.Errorf("validation failed: user_id is required")
+	}
+	if invitation.Role == domain.StaffRoleOwner {
+		return nil, fmt.Errorf("validation failed: the owner role cannot be granted by invitation")
+	}
+	if err := validateStaffRole(invitation.Role); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := domain.ValidateStaffPermissions(invitation.Permissions); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := s.Authorize(ctx, invitation.StorefrontID, actorID, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+	if err := s.checkGrantable(ctx, invitation.StorefrontID, actorID, invitation.Role, invitation.Permissions); err != nil {
+		return nil, err
+	}
+
+	storefront, err := s.repo.GetStorefrontByID(ctx, invitation.StorefrontID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if storefront.UserID == invitation.UserID {
+		return nil, fmt.Errorf("validation failed: the owner cannot be invited to their own storefront")
+	}
+	existing, err := s.repo.GetStaffMember(ctx, invitation.StorefrontID, invitation.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("user is already a staff member")
+	}
+
+	invitation.InvitedBy = actorID
+	invitation.ExpiresAt = time.Now().Add(domain.DefaultStaffInvitationTTL)
+
+	if err := s.repo.CreateStaffInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("invitation_id", invitation.ID).
+		Int64("storefront_id", invitation.StorefrontID).
+		Int64("user_id", invitation.UserID).
+		Int64("invited_by", actorID).
+		Str("role", invitation.Role).
+		Msg("staff invitation created")
+
+	return invitation, nil
+}
+
+// AcceptStaffInvitation accepts an invitation addressed to the user and adds them to the staff
+func (s *StorefrontService) AcceptStaffInvitation(ctx context.Context, userID, invitationID int64) (*domain.StorefrontStaff, error) {
+	if _, err := s.getOpenInvitationForUser(ctx, userID, invitationID); err != nil {
+		return nil, err
+	}
+
+	staff, err := s.repo.AcceptStaffInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("invitation_id", invitationID).
+		Int64("storefront_id", staff.StorefrontID).
+		Int64("user_id", userID).
+		Str("role", staff.Role).
+		Msg("staff invitation accepted")
+
+	return staff, nil
+}
+
+// DeclineStaffInvitation declines an invitation addressed to the user
+func (s *StorefrontService) DeclineStaffInvitation(ctx context.Context, userID, invitationID int64) (*domain.StaffInvitation, error) {
+	if _, err := s.getOpenInvitationForUser(ctx, userID, invitationID); err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.repo.CloseStaffInvitation(ctx, invitationID, domain.StaffInvitationDeclined)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("invitation_id", invitationID).
+		Int64("user_id", userID).
+		Msg("staff invitation declined")
+
+	return invitation, nil
+}
+
+// RevokeStaffInvitation withdraws a pending invitation
+func (s *StorefrontService) RevokeStaffInvitation(ctx context.Context, actorID, invitationID int64) (*domain.StaffInvitation, error) {
+	invitation, err := s.repo.GetStaffInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, invitation.StorefrontID, actorID, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+
+	invitation, err = s.repo.CloseStaffInvitation(ctx, invitationID, domain.StaffInvitationRevoked)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("invitation_id", invitationID).
+		Int64("revoked_by", actorID).
+		Msg("staff invitation revoked")
+
+	return invitation, nil
+}
+
+// ListStaffInvitations lists the invitations of a storefront (requires staff.manage) or,
+// without a storefront, the invitations addressed to the user
+func (s *StorefrontService) ListStaffInvitations(ctx context.Context, actorID int64, storefrontID *int64, pendingOnly bool) ([]domain.StaffInvitation, error) {
+	filter := &domain.StaffInvitationFilter{PendingOnly: pendingOnly}
+	if storefrontID != nil {
+		if err := s.Authorize(ctx, *storefrontID, actorID, domain.PermissionStaffManage); err != nil {
+			return nil, err
+		}
+		filter.StorefrontID = storefrontID
+	} else {
+		filter.UserID = &actorID
+	}
+
+	return s.repo.ListStaffInvitations(ctx, filter)
+}
+
+// UpdateStaff changes a staff member's role and/or permission overrides
+func (s *StorefrontService) UpdateStaff(ctx context.Context, actorID, staffID int64, update *domain.StaffUpdate) (*domain.StorefrontStaff, error) {
+	if update.Role != nil {
+		if err := validateStaffRole(*update.Role); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+	}
+	if err := domain.ValidateStaffPermissions(update.Permissions); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	staff, err := s.repo.GetStaffByID(ctx, staffID)
+	if err != nil {
+		return nil, err
+	}
+	if staff.UserID == actorID {
+		return nil, fmt.Errorf("unauthorized: staff members cannot change their own role or permissions")
+	}
+	if err := s.Authorize(ctx, staff.StorefrontID, actorID, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+
+	// Both the current and the new permissions must be within the actor's own
+	role, permissions := staff.Role, staff.Permissions
+	if err := s.checkGrantable(ctx, staff.StorefrontID, actorID, role, permissions); err != nil {
+		return nil, err
+	}
+	if update.Role != nil {
+		role = *update.Role
+	}
+	if update.Permissions != nil {
+		permissions = update.Permissions
+	}
+	if err := s.checkGrantable(ctx, staff.StorefrontID, actorID, role, permissions); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStaff(ctx, staffID, update); err != nil {
+		return nil, fmt.Errorf("failed to update staff: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("staff_id", staffID).
+		Int64("updated_by", actorID).
+		Msg("staff member updated successfully")
+
+	return s.repo.GetStaffByID(ctx, staffID)
+}
+
+// RemoveStaff removes a staff member from a storefront. Staff members may always leave.
+func (s *StorefrontService) RemoveStaff(ctx context.Context, actorID, storefrontID, userID int64) error {
+	if actorID != userID {
+		if err := s.Authorize(ctx, storefrontID, actorID, domain.PermissionStaffManage); err != nil {
+			return err
+		}
+
+		staff, err := s.repo.GetStaffMember(ctx, storefrontID, userID)
+		if err != nil {
+			return err
+		}
+		if staff == nil {
+			return fmt.Errorf("staff not found")
+		}
+		if err := s.checkGrantable(ctx, storefrontID, actorID, staff.Role, staff.Permissions); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.RemoveStaff(ctx, storefrontID, userID); err != nil {
+		return fmt.Errorf("failed to remove staff: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("user_id", userID).
+		Int64("removed_by", actorID).
+		Msg("staff member removed successfully")
+
+	return nil
+}
+
+// checkGrantable prevents privilege escalation: staff managers can only grant (or manage
+// members holding) permissions they hold themselves. The owner can grant any.
+func (s *StorefrontService) checkGrantable(ctx context.Context, storefrontID, actorID int64, role string, permissions domain.JSONB) error {
+	storefront, err := s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if storefront.UserID == actorID {
+		return nil
+	}
+
+	actor, err := s.repo.GetStaffMember(ctx, storefrontID, actorID)
+	if err != nil {
+		return err
+	}
+
+	target := &domain.StorefrontStaff{Role: role, Permissions: permissions}
+	for _, permission := range target.EffectivePermissions() {
+		if actor == nil || !actor.HasPermission(permission) {
+			return fmt.Errorf("unauthorized: cannot grant permission %s", permission)
+		}
+	}
+	return nil
+}
+
+// getOpenInvitationForUser returns an invitation that the user can still answer
+func (s *StorefrontService) getOpenInvitationForUser(ctx context.Context, userID, invitationID int64) (*domain.StaffInvitation, error) {
+	invitation, err := s.repo.GetStaffInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: invitation is addressed to another user")
+	}
+	if !invitation.IsOpen(time.Now()) {
+		return nil, fmt.Errorf("staff invitation is no longer pending")
+	}
+	return invitation, nil
+}
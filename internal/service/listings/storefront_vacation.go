@@ -0,0 +1,104 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SetVacationMode updates a storefront's vacation settings and returns the storefront.
+// Disabling vacation clears the vacation window and message.
+func (s *StorefrontService) SetVacationMode(ctx context.Context, storefrontID int64, settings *domain.VacationSettings) (*domain.Storefront, error) {
+	if settings.Message != nil {
+		message := strings.TrimSpace(*settings.Message)
+		settings.Message = &message
+		if message == "" {
+			settings.Message = nil
+		}
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if !settings.Enabled {
+		settings.StartsAt, settings.EndsAt, settings.Message = nil, nil, nil
+	}
+
+	if err := s.repo.SetVacationMode(ctx, storefrontID, settings); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Bool("enabled", settings.Enabled).
+		Str("order_policy", settings.OrderPolicy).
+		Msg("vacation mode updated")
+
+	return s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+}
+
+// GetStorefrontVacations returns the storefronts among ids with vacation mode enabled, with
+// their vacation settings. Used to mark products of storefronts on vacation in one query.
+func (s *StorefrontService) GetStorefrontVacations(ctx context.Context, ids []int64) ([]domain.Storefront, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.repo.GetStorefrontVacations(ctx, ids)
+}
+
+// SetHolidays replaces a storefront's holiday calendar
+func (s *StorefrontService) SetHolidays(ctx context.Context, storefrontID int64, holidays []domain.StorefrontHoliday) error {
+	if len(holidays) > domain.MaxStorefrontHolidays {
+		return fmt.Errorf("validation failed: at most %d holidays are allowed", domain.MaxStorefrontHolidays)
+	}
+	for i := range holidays {
+		holidays[i].Name = strings.TrimSpace(holidays[i].Name)
+		if err := holidays[i].Validate(); err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+	}
+
+	if err := s.repo.SetHolidays(ctx, storefrontID, holidays); err != nil {
+		return fmt.Errorf("failed to set holidays: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int("holidays_count", len(holidays)).
+		Msg("holidays set successfully")
+
+	return nil
+}
+
+// GetHolidays retrieves a storefront's holiday calendar
+func (s *StorefrontService) GetHolidays(ctx context.Context, storefrontID int64) ([]domain.StorefrontHoliday, error) {
+	holidays, err := s.repo.GetHolidays(ctx, storefrontID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	return holidays, nil
+}
+
+// IsOpenNow evaluates a storefront's working hours, holidays and vacation in its timezone
+func (s *StorefrontService) IsOpenNow(ctx context.Context, storefrontID int64) (*domain.OpenStatus, error) {
+	storefront, err := s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := s.repo.GetWorkingHours(ctx, storefrontID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working hours: %w", err)
+	}
+
+	holidays, err := s.repo.GetHolidays(ctx, storefrontID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	status := domain.EvaluateOpenStatus(storefront, hours, holidays, time.Now())
+	return &status, nil
+}
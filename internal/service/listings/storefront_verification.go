@@ -0,0 +1,154 @@
+package listings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+var validVerificationStatuses = map[string]bool{
+	domain.VerificationStatusPending:   true,
+	domain.VerificationStatusApproved:  true,
+	domain.VerificationStatusRejected:  true,
+	domain.VerificationStatusCancelled: true,
+}
+
+// SubmitVerification stores a seller's verification request for admin review. The documents
+// must already be uploaded (StoragePath set).
+func (s *StorefrontService) SubmitVerification(ctx context.Context, req *domain.StorefrontVerificationRequest) (*domain.StorefrontVerificationRequest, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	storefront, err := s.repo.GetStorefrontByID(ctx, req.StorefrontID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if storefront.IsVerified {
+		return nil, fmt.Errorf("storefront is already verified")
+	}
+
+	if err := s.repo.CreateVerificationRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", req.StorefrontID).
+		Int64("request_id", req.ID).
+		Int("documents", len(req.Documents)).
+		Msg("verification request submitted")
+
+	return req, nil
+}
+
+// GetVerification returns the latest verification request of a storefront (nil if it never
+// applied) and its verification audit history
+func (s *StorefrontService) GetVerification(ctx context.Context, storefrontID int64) (*domain.StorefrontVerificationRequest, []domain.StorefrontVerificationEvent, error) {
+	latest, err := s.repo.GetLatestVerificationRequest(ctx, storefrontID)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, nil, err
+		}
+		latest = nil
+	}
+
+	events, err := s.repo.ListVerificationEvents(ctx, storefrontID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return latest, events, nil
+}
+
+// CancelVerification withdraws the storefront's pending verification request
+func (s *StorefrontService) CancelVerification(ctx context.Context, storefrontID, actorID int64) (*domain.StorefrontVerificationRequest, error) {
+	req, err := s.repo.CancelVerificationRequest(ctx, storefrontID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("request_id", req.ID).
+		Msg("verification request cancelled")
+
+	return req, nil
+}
+
+// GetVerificationRequest retrieves a verification request with its documents
+func (s *StorefrontService) GetVerificationRequest(ctx context.Context, id int64) (*domain.StorefrontVerificationRequest, error) {
+	return s.repo.GetVerificationRequest(ctx, id)
+}
+
+// ListVerificationQueue retrieves verification requests by status, oldest first (admin
+// operation; defaults to pending requests)
+func (s *StorefrontService) ListVerificationQueue(ctx context.Context, status string, limit, offset int) ([]*domain.StorefrontVerificationRequest, int32, error) {
+	if status == "" {
+		status = domain.VerificationStatusPending
+	}
+	if !validVerificationStatuses[status] {
+		return nil, 0, fmt.Errorf("validation failed: invalid status %s", status)
+	}
+	if limit <= 0 {
+		limit = domain.DefaultModerationQueueLimit
+	}
+	if limit > domain.MaxModerationQueueLimit {
+		limit = domain.MaxModerationQueueLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	requests, total, err := s.repo.ListVerificationRequests(ctx, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list verification requests: %w", err)
+	}
+
+	return requests, total, nil
+}
+
+// ReviewVerification approves or rejects a pending verification request (admin operation).
+// Approval marks the storefront verified.
+func (s *StorefrontService) ReviewVerification(ctx context.Context, decision *domain.VerificationDecision) (*domain.StorefrontVerificationRequest, error) {
+	if err := decision.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	req, err := s.repo.DecideVerificationRequest(ctx, decision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", req.StorefrontID).
+		Int64("request_id", req.ID).
+		Int64("reviewer_id", decision.ReviewerID).
+		Str("status", req.Status).
+		Msg("verification request reviewed")
+
+	return req, nil
+}
+
+// RevokeVerification removes the verified status of a storefront (admin operation)
+func (s *StorefrontService) RevokeVerification(ctx context.Context, storefrontID, actorID int64, reason string) (*domain.Storefront, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("validation failed: reason is required when revoking verification")
+	}
+	if len(reason) > domain.MaxVerificationReasonLength {
+		return nil, fmt.Errorf("validation failed: reason must be at most %d characters", domain.MaxVerificationReasonLength)
+	}
+
+	if err := s.repo.RevokeStorefrontVerification(ctx, storefrontID, actorID, reason); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", storefrontID).
+		Int64("actor_id", actorID).
+		Msg("storefront verification revoked")
+
+	return s.repo.GetStorefrontByID(ctx, storefrontID, nil)
+}
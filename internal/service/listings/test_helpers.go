@@ -48,6 +48,19 @@ func SetupDefaultCreateListingMocks(mockRepo *mocks.MockRepository, ctx context.
 	mockRepo.On("GetListingBySlug", ctx, mock.AnythingOfType("string")).
 		Return(nil, errors.New("not found")).
 		Maybe() // Allow but don't require this call
+
+	SetupListingExpirationMocks(mockRepo, ctx)
+}
+
+// SetupListingExpirationMocks sets up mocks for C2C listing expiration on create
+// The category has no renewal period, so the service default applies
+func SetupListingExpirationMocks(mockRepo *mocks.MockRepository, ctx context.Context) {
+	mockRepo.On("GetCategoryRenewalDays", ctx, mock.AnythingOfType("int64")).
+		Return(0, nil).
+		Maybe()
+	mockRepo.On("SetListingExpiration", ctx, mock.AnythingOfType("int64"), mock.AnythingOfType("time.Time")).
+		Return(nil).
+		Maybe()
 }
 
 // Helper functions for creating test data
@@ -217,25 +217,42 @@ func (v *Validator) ValidateImages(images []*domain.ListingImage) error {
 	return nil
 }
 
-// ValidateStatusTransition checks if a status transition is allowed
+// ValidateStatusTransition checks if a status transition is allowed for the listing owner.
+// Publishing requires moderator approval (pending_review -> active/scheduled/rejected),
+// so those transitions are handled by ModerateListing and are not allowed here.
 func (v *Validator) ValidateStatusTransition(from, to string) error {
 	// Status transition rules
 	allowedTransitions := map[string][]string{
 		domain.StatusDraft: {
-			domain.StatusActive,
-			domain.StatusInactive,
+			domain.StatusPendingReview, // Submit for review
+			domain.StatusArchived,
+		},
+		domain.StatusPendingReview: {
+			domain.StatusDraft, // Withdraw from review
+		},
+		domain.StatusScheduled: {
+			domain.StatusDraft, // Cancel scheduled publication
+		},
+		domain.StatusRejected: {
+			domain.StatusDraft, // Rework and resubmit
+			domain.StatusArchived,
 		},
-		domain.StatusActive: {
+		domain.StatusPublished: {
 			domain.StatusSold,
-			domain.StatusInactive,
+			domain.StatusPaused,
 			domain.StatusArchived,
 		},
-		domain.StatusInactive: {
-			domain.StatusActive,
+		domain.StatusPaused: {
+			domain.StatusPublished,
 			domain.StatusDraft,
+			domain.StatusArchived,
 		},
 		domain.StatusSold: {
-			domain.StatusActive, // Allow re-listing
+			domain.StatusPublished, // Allow re-listing
+			domain.StatusArchived,
+		},
+		domain.StatusExpired: {
+			domain.StatusArchived, // Reactivation goes through RenewListing
 		},
 	}
 
@@ -360,15 +377,20 @@ func (v *Validator) ValidateUpdateInput(input *domain.UpdateListingInput) error
 	// Status (if being updated)
 	if input.Status != nil {
 		validStatuses := map[string]bool{
-			domain.StatusDraft:    true,
-			domain.StatusActive:   true,
-			domain.StatusInactive: true,
-			domain.StatusSold:     true,
-			domain.StatusArchived: true,
+			domain.StatusDraft:         true,
+			domain.StatusPendingReview: true,
+			domain.StatusActive:        true,
+			domain.StatusInactive:      true,
+			domain.StatusSold:          true,
+			domain.StatusArchived:      true,
 		}
 		if !validStatuses[*input.Status] {
 			errs.Add("status", "invalid status value")
 		}
+		// Review submissions record a moderation event and go through SubmitListingForReview
+		if *input.Status == domain.StatusPendingReview {
+			errs.Add("status", "use SubmitListingForReview to submit a listing for review")
+		}
 	}
 
 	if errs.HasErrors() {
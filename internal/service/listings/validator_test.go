@@ -208,12 +208,18 @@ func TestValidator_ValidateStatusTransition(t *testing.T) {
 		to      string
 		wantErr bool
 	}{
-		{"draft to active", domain.StatusDraft, domain.StatusActive, false},
-		{"draft to inactive", domain.StatusDraft, domain.StatusInactive, false},
+		{"draft to pending_review", domain.StatusDraft, domain.StatusPendingReview, false},
+		{"pending_review to draft", domain.StatusPendingReview, domain.StatusDraft, false},
+		{"rejected to draft", domain.StatusRejected, domain.StatusDraft, false},
 		{"active to sold", domain.StatusActive, domain.StatusSold, false},
 		{"active to inactive", domain.StatusActive, domain.StatusInactive, false},
 		{"inactive to active", domain.StatusInactive, domain.StatusActive, false},
+		{"inactive to archived", domain.StatusInactive, domain.StatusArchived, false},
 		{"sold to active", domain.StatusSold, domain.StatusActive, false},
+		{"invalid: draft to active (requires review)", domain.StatusDraft, domain.StatusActive, true},
+		{"invalid: pending_review to active (moderator only)", domain.StatusPendingReview, domain.StatusActive, true},
+		{"invalid: rejected to active", domain.StatusRejected, domain.StatusActive, true},
+		{"invalid: expired to active", domain.StatusExpired, domain.StatusActive, true},
 		{"invalid: draft to sold", domain.StatusDraft, domain.StatusSold, true},
 		{"invalid: sold to inactive", domain.StatusSold, domain.StatusInactive, true},
 	}
@@ -230,6 +236,17 @@ func TestValidator_ValidateStatusTransition(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateUpdateInput_RejectsReviewSubmission(t *testing.T) {
+	validator := NewValidator(new(mocks.MockRepository))
+
+	pendingReview := domain.StatusPendingReview
+	err := validator.ValidateUpdateInput(&domain.UpdateListingInput{Status: &pendingReview})
+	assert.ErrorContains(t, err, "SubmitListingForReview")
+
+	draft := domain.StatusDraft
+	assert.NoError(t, validator.ValidateUpdateInput(&domain.UpdateListingInput{Status: &draft}))
+}
+
 func TestValidator_ValidateImages(t *testing.T) {
 	mockRepo := new(mocks.MockRepository)
 	validator := NewValidator(mockRepo)
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ModerationNotifier sends listing review decisions to owners as system chat messages
+type ModerationNotifier struct {
+	chatService ChatService
+	logger      zerolog.Logger
+}
+
+// NewModerationNotifier creates a new review decision notifier backed by the chat service
+func NewModerationNotifier(chatService ChatService, logger zerolog.Logger) *ModerationNotifier {
+	return &ModerationNotifier{
+		chatService: chatService,
+		logger:      logger.With().Str("component", "moderation_notifier").Logger(),
+	}
+}
+
+// NotifyListingModerated tells the owner whether the listing was approved or rejected (with the reason)
+func (n *ModerationNotifier) NotifyListingModerated(ctx context.Context, listing *domain.Listing, decision *domain.ModerationDecision) error {
+	var message string
+	switch {
+	case !decision.Approve:
+		message = fmt.Sprintf(
+			"❌ Your listing was rejected\n\n"+
+				"%s\n"+
+				"Reason: %s\n\n"+
+				"Edit the listing and submit it for review again.",
+			listing.Title,
+			decision.Reason,
+		)
+	case listing.Status == domain.StatusScheduled && listing.ScheduledAt != nil:
+		message = fmt.Sprintf(
+			"✅ Your listing was approved\n\n"+
+				"%s\n"+
+				"It will be published on %s.",
+			listing.Title,
+			listing.ScheduledAt.Format("02.01.2006 15:04"),
+		)
+	default:
+		message = fmt.Sprintf(
+			"✅ Your listing was approved\n\n"+
+				"%s\n"+
+				"It is now visible in search.",
+			listing.Title,
+		)
+	}
+
+	req := &SendSystemMessageRequest{
+		ReceiverID:       listing.UserID,
+		Content:          message,
+		OriginalLanguage: "en",
+	}
+
+	if _, err := n.chatService.SendSystemMessage(ctx, req); err != nil {
+		return fmt.Errorf("failed to send moderation notification: %w", err)
+	}
+
+	n.logger.Debug().
+		Int64("listing_id", listing.ID).
+		Int64("user_id", listing.UserID).
+		Bool("approved", decision.Approve).
+		Msg("moderation notification sent")
+	return nil
+}
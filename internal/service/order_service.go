@@ -39,6 +39,8 @@ type OrderService interface {
 	// Configuration
 	SetChatService(chatService ChatService)
 	SetDeliveryClient(client DeliveryClient)
+	SetStorefrontAuthorizer(authorizer StorefrontAuthorizer)
+	SetEventTracker(tracker EventTracker)
 }
 
 // OrderItemInput represents a single item for direct checkout
@@ -152,6 +154,11 @@ type DeliveryClient interface {
 	CalculateRate(ctx context.Context, req *DeliveryCalculateRateRequest) (*DeliveryRateInfo, error)
 }
 
+// StorefrontAuthorizer checks storefront staff permissions (see domain.Permission* constants)
+type StorefrontAuthorizer interface {
+	HasPermission(ctx context.Context, storefrontID, userID int64, permission string) (bool, error)
+}
+
 // orderService implements OrderService
 type orderService struct {
 	orderRepo       postgres.OrderRepository
@@ -161,8 +168,10 @@ type orderService struct {
 	pool            *pgxpool.Pool
 	config          *FinancialConfig
 	logger          zerolog.Logger
-	chatService     ChatService    // For sending order notifications
-	deliveryClient  DeliveryClient // For delivery microservice integration
+	chatService     ChatService          // For sending order notifications
+	deliveryClient  DeliveryClient       // For delivery microservice integration
+	authorizer      StorefrontAuthorizer // Staff permissions; without it only owners act as sellers
+	eventTracker    EventTracker         // Optional: order analytics events
 }
 
 // NewOrderService creates a new order service
@@ -202,6 +211,24 @@ func (s *orderService) SetDeliveryClient(client DeliveryClient) {
 	s.deliveryClient = client
 }
 
+// SetStorefrontAuthorizer sets the authorizer that lets storefront staff act as sellers
+func (s *orderService) SetStorefrontAuthorizer(authorizer StorefrontAuthorizer) {
+	s.authorizer = authorizer
+}
+
+// SetEventTracker sets the tracker that receives order analytics events
+func (s *orderService) SetEventTracker(tracker EventTracker) {
+	s.eventTracker = tracker
+}
+
+// trackOrderEvent records an analytics event for every item of the order
+func (s *orderService) trackOrderEvent(order *domain.Order, eventType string) {
+	if s.eventTracker == nil || order == nil {
+		return
+	}
+	s.eventTracker.Track(domain.OrderAnalyticsEvents(order, eventType)...)
+}
+
 // CreateOrder creates a new order from a cart OR direct items (ACID transaction)
 func (s *orderService) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*domain.Order, error) {
 	s.logger.Info().
@@ -239,6 +266,16 @@ func (s *orderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		return nil, ErrCartEmpty
 	}
 
+	// Storefronts on vacation either block orders or accept them with a delayed shipping notice
+	storefront, err := s.productsRepo.GetStorefrontByID(ctx, cart.StorefrontID, nil)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", cart.StorefrontID).Msg("failed to get storefront")
+		return nil, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if !storefront.AcceptsOrders(time.Now()) {
+		return nil, &ErrStorefrontOnVacation{StorefrontID: cart.StorefrontID, Notice: storefront.VacationNotice()}
+	}
+
 	// 3. Extract listing IDs and sort (ORDER BY id ASC to prevent deadlocks)
 	listingIDs := make([]int64, 0, len(cart.Items))
 	for _, item := range cart.Items {
@@ -339,6 +376,11 @@ func (s *orderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		order.CustomerNotes = req.CustomerNotes
 	}
 
+	if storefront.IsOnVacation(time.Now()) {
+		notice := storefront.VacationNotice()
+		order.ShippingNotice = &notice
+	}
+
 	// Set customer contact info (for seller display on sales page)
 	// First try from explicit fields, then fallback to shipping_address
 	if req.CustomerName != nil && *req.CustomerName != "" {
@@ -439,6 +481,8 @@ func (s *orderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 	// Send system notification to storefront owner about new order
 	s.notifyStorefrontOwnerAboutOrder(ctx, order)
 
+	s.trackOrderEvent(order, domain.AnalyticsEventOrderCreated)
+
 	// Auto-confirm order for cash-on-delivery (COD) orders
 	// COD orders should immediately move to "confirmed" status since payment
 	// will be collected upon delivery, not upfront.
@@ -624,6 +668,10 @@ func (s *orderService) UpdateOrderStatus(ctx context.Context, orderID int64, sta
 		return nil, fmt.Errorf("failed to reload order: %w", err)
 	}
 
+	if status == domain.OrderStatusDelivered {
+		s.trackOrderEvent(order, domain.AnalyticsEventOrderCompleted)
+	}
+
 	s.logger.Info().Int64("order_id", orderID).Str("new_status", string(status)).Msg("order status updated")
 	return order, nil
 }
@@ -833,7 +881,7 @@ func (s *orderService) notifyStorefrontOwnerAboutOrder(ctx context.Context, orde
 
 // AcceptOrder handles seller accepting an order
 // Flow: confirmed -> accepted
-// Validates: order status, seller is storefront owner
+// Validates: order status, seller is storefront owner or staff with orders.fulfill
 func (s *orderService) AcceptOrder(ctx context.Context, orderID int64, sellerID int64, sellerNotes string) (*domain.Order, error) {
 	s.logger.Info().
 		Int64("order_id", orderID).
@@ -849,18 +897,10 @@ func (s *orderService) AcceptOrder(ctx context.Context, orderID int64, sellerID
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
 
-	// Verify seller is storefront owner
-	storefront, err := s.productsRepo.GetStorefrontByID(ctx, order.StorefrontID, nil)
+	// Verify seller is storefront owner or staff allowed to fulfil orders
+	storefront, err := s.authorizeSeller(ctx, order, sellerID, domain.PermissionOrdersFulfill)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get storefront: %w", err)
-	}
-	if storefront.UserID != sellerID {
-		s.logger.Warn().
-			Int64("order_id", orderID).
-			Int64("seller_id", sellerID).
-			Int64("storefront_owner_id", storefront.UserID).
-			Msg("seller is not storefront owner")
-		return nil, ErrUnauthorized
+		return nil, err
 	}
 
 	// Check if order can be accepted
@@ -900,7 +940,7 @@ func (s *orderService) AcceptOrder(ctx context.Context, orderID int64, sellerID
 
 // CreateOrderShipment creates shipment via Delivery Service
 // Flow: accepted -> processing
-// Validates: order status, seller is storefront owner, package info
+// Validates: order status, seller is storefront owner or staff with orders.fulfill, package info
 func (s *orderService) CreateOrderShipment(ctx context.Context, req *CreateShipmentRequest) (*CreateShipmentResult, error) {
 	s.logger.Info().
 		Int64("order_id", req.OrderID).
@@ -917,18 +957,10 @@ func (s *orderService) CreateOrderShipment(ctx context.Context, req *CreateShipm
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
 
-	// Verify seller is storefront owner
-	storefront, err := s.productsRepo.GetStorefrontByID(ctx, order.StorefrontID, nil)
+	// Verify seller is storefront owner or staff allowed to fulfil orders
+	storefront, err := s.authorizeSeller(ctx, order, req.SellerID, domain.PermissionOrdersFulfill)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get storefront: %w", err)
-	}
-	if storefront.UserID != req.SellerID {
-		s.logger.Warn().
-			Int64("order_id", req.OrderID).
-			Int64("seller_id", req.SellerID).
-			Int64("storefront_owner_id", storefront.UserID).
-			Msg("seller is not storefront owner")
-		return nil, ErrUnauthorized
+		return nil, err
 	}
 
 	// Check if order can have shipment created
@@ -1064,18 +1096,10 @@ func (s *orderService) MarkOrderShipped(ctx context.Context, orderID int64, sell
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
 
-	// Verify seller is storefront owner
-	storefront, err := s.productsRepo.GetStorefrontByID(ctx, order.StorefrontID, nil)
+	// Verify seller is storefront owner or staff allowed to fulfil orders
+	storefront, err := s.authorizeSeller(ctx, order, sellerID, domain.PermissionOrdersFulfill)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get storefront: %w", err)
-	}
-	if storefront.UserID != sellerID {
-		s.logger.Warn().
-			Int64("order_id", orderID).
-			Int64("seller_id", sellerID).
-			Int64("storefront_owner_id", storefront.UserID).
-			Msg("seller is not storefront owner")
-		return nil, ErrUnauthorized
+		return nil, err
 	}
 
 	// Check if order can be marked as shipped
@@ -1145,12 +1169,8 @@ func (s *orderService) GetOrderTracking(ctx context.Context, orderID int64, user
 	hasAccess := false
 	if order.UserID != nil && *order.UserID == userID {
 		hasAccess = true
-	} else {
-		// Check if user is storefront owner
-		storefront, err := s.productsRepo.GetStorefrontByID(ctx, order.StorefrontID, nil)
-		if err == nil && storefront.UserID == userID {
-			hasAccess = true
-		}
+	} else if _, err := s.authorizeSeller(ctx, order, userID, domain.PermissionOrdersRead); err == nil {
+		hasAccess = true
 	}
 
 	if !hasAccess {
@@ -1328,6 +1348,36 @@ func (s *orderService) confirmCODOrder(ctx context.Context, order *domain.Order)
 // SHIPMENT WORKFLOW HELPER METHODS
 // ============================================================================
 
+// authorizeSeller checks that the user is the storefront owner or, when an authorizer is
+// configured, a staff member holding the permission. Returns the order's storefront.
+func (s *orderService) authorizeSeller(ctx context.Context, order *domain.Order, userID int64, permission string) (*domain.Storefront, error) {
+	storefront, err := s.productsRepo.GetStorefrontByID(ctx, order.StorefrontID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if storefront.UserID == userID {
+		return storefront, nil
+	}
+
+	if s.authorizer != nil {
+		allowed, err := s.authorizer.HasPermission(ctx, order.StorefrontID, userID, permission)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check staff permission: %w", err)
+		}
+		if allowed {
+			return storefront, nil
+		}
+	}
+
+	s.logger.Warn().
+		Int64("order_id", order.ID).
+		Int64("seller_id", userID).
+		Int64("storefront_owner_id", storefront.UserID).
+		Str("permission", permission).
+		Msg("seller is neither storefront owner nor permitted staff")
+	return nil, ErrUnauthorized
+}
+
 // parseDeliveryProvider converts provider code string to DeliveryProvider enum
 func (s *orderService) parseDeliveryProvider(code string) (DeliveryProvider, error) {
 	switch code {
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// PriceDropNotifier sends price-drop alerts to users as system chat messages
+type PriceDropNotifier struct {
+	chatService ChatService
+	logger      zerolog.Logger
+}
+
+// NewPriceDropNotifier creates a new price-drop notifier backed by the chat service
+func NewPriceDropNotifier(chatService ChatService, logger zerolog.Logger) *PriceDropNotifier {
+	return &PriceDropNotifier{
+		chatService: chatService,
+		logger:      logger.With().Str("component", "price_drop_notifier").Logger(),
+	}
+}
+
+// NotifyPriceDrop sends a price-drop system message to a user who favorited the listing
+func (n *PriceDropNotifier) NotifyPriceDrop(ctx context.Context, userID int64, drop *domain.PriceDrop) error {
+	message := fmt.Sprintf(
+		"📉 Price drop on your favorite!\n\n"+
+			"%s\n"+
+			"Was: %.2f %s\n"+
+			"Now: %.2f %s (-%.0f%%)",
+		drop.Title,
+		drop.OldPrice, drop.Currency,
+		drop.NewPrice, drop.Currency,
+		drop.DropPercent(),
+	)
+
+	req := &SendSystemMessageRequest{
+		ReceiverID:       userID,
+		Content:          message,
+		OriginalLanguage: "en",
+	}
+
+	if _, err := n.chatService.SendSystemMessage(ctx, req); err != nil {
+		return fmt.Errorf("failed to send price-drop message: %w", err)
+	}
+
+	n.logger.Debug().
+		Int64("user_id", userID).
+		Int64("listing_id", drop.ListingID).
+		Msg("price-drop notification sent")
+	return nil
+}
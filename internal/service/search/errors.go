@@ -51,7 +51,7 @@ var (
 	ErrTooManyAttributeValues = errors.New("too many values for attribute: maximum 20 allowed per attribute")
 
 	// ErrInvalidSortField is returned when sort field is invalid
-	ErrInvalidSortField = errors.New("invalid sort field: must be 'price', 'created_at', 'relevance', 'views_count', or 'favorites_count'")
+	ErrInvalidSortField = errors.New("invalid sort field: must be 'price', 'created_at', 'relevance', 'views_count', 'favorites_count', 'rating', or 'reviews_count'")
 
 	// ErrInvalidSortOrder is returned when sort order is invalid
 	ErrInvalidSortOrder = errors.New("invalid sort order: must be 'asc' or 'desc'")
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ExperimentSource lists search ranking experiments
+// Implemented by repository.SearchExperimentsRepository
+type ExperimentSource interface {
+	ListExperiments(ctx context.Context, status *domain.SearchExperimentStatus) ([]*domain.SearchExperiment, error)
+}
+
+const (
+	// experimentsRefreshInterval is how long the running experiments are kept in memory
+	experimentsRefreshInterval = time.Minute
+
+	// experimentsLoadTimeout bounds the reload of running experiments on the search path
+	experimentsLoadTimeout = 500 * time.Millisecond
+)
+
+// SetExperimentSource enables search ranking experiments (optional)
+func (s *Service) SetExperimentSource(source ExperimentSource) {
+	s.experimentSource = source
+}
+
+// assignExperiment returns the experiment arm serving a searcher, or nil for the default
+// ranking. Anonymous searches without a session are never enrolled.
+func (s *Service) assignExperiment(ctx context.Context, userID *int64, sessionID *string) *domain.SearchExperimentAssignment {
+	if s.experimentSource == nil {
+		return nil
+	}
+
+	subject := domain.SearchExperimentSubject(userID, sessionID)
+	if subject == "" {
+		return nil
+	}
+
+	return domain.AssignSearchExperiment(s.runningExperiments(ctx), subject)
+}
+
+// runningExperiments returns the running experiments, reloading them once the cached list is
+// older than experimentsRefreshInterval. On failure the previous list is kept until the next
+// refresh so an unavailable database does not slow down every search.
+func (s *Service) runningExperiments(ctx context.Context) []domain.SearchExperiment {
+	s.experimentsMu.Lock()
+	defer s.experimentsMu.Unlock()
+
+	if time.Since(s.experimentsLoadedAt) < experimentsRefreshInterval {
+		return s.experiments
+	}
+	s.experimentsLoadedAt = time.Now()
+
+	loadCtx, cancel := context.WithTimeout(ctx, experimentsLoadTimeout)
+	defer cancel()
+
+	status := domain.SearchExperimentRunning
+	loaded, err := s.experimentSource.ListExperiments(loadCtx, &status)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to load search experiments, keeping previous")
+		return s.experiments
+	}
+
+	experiments := make([]domain.SearchExperiment, 0, len(loaded))
+	for _, experiment := range loaded {
+		experiments = append(experiments, *experiment)
+	}
+	s.experiments = experiments
+
+	return s.experiments
+}
+
+// experimentFields returns the experiment ID and variant reported with search results
+func experimentFields(experiment *domain.SearchExperimentAssignment) (*string, *string) {
+	if experiment == nil {
+		return nil, nil
+	}
+	return &experiment.ExperimentID, &experiment.Variant
+}
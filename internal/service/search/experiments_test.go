@@ -0,0 +1,110 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeExperimentSource returns fixed experiments and counts the loads
+type fakeExperimentSource struct {
+	experiments []*domain.SearchExperiment
+	err         error
+	loads       int
+}
+
+func (f *fakeExperimentSource) ListExperiments(_ context.Context, _ *domain.SearchExperimentStatus) ([]*domain.SearchExperiment, error) {
+	f.loads++
+	return f.experiments, f.err
+}
+
+func TestBuildSearchQuery_ExperimentRanking(t *testing.T) {
+	svc := &Service{}
+	ranking := &domain.SearchRanking{TitleBoost: 5, ViewsWeight: 1, RatingWeight: 0.5}
+	arm := &domain.SearchExperimentAssignment{ExperimentID: "title-boost", Variant: "title5", Ranking: ranking}
+
+	query := svc.buildSearchQuery(&SearchRequest{Query: "laptop", Limit: 20}, arm)
+
+	functionScore := query["query"].(map[string]interface{})["function_score"].(map[string]interface{})
+	require.NotNil(t, functionScore, "popularity weights wrap the query in a function_score")
+	assert.Len(t, functionScore["functions"], 3, "base weight, views and rating")
+	assert.Equal(t, "multiply", functionScore["boost_mode"])
+
+	boolQuery := functionScore["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	mustClauses := boolQuery["must"].([]map[string]interface{})
+	multiMatch := mustClauses[1]["multi_match"].(map[string]interface{})
+	assert.Equal(t, []string{"title^5", "description"}, multiMatch["fields"])
+
+	sort := query["sort"].([]map[string]interface{})
+	assert.Contains(t, sort[0], "_score", "ranked text searches are ordered by score")
+
+	control := svc.buildSearchQuery(&SearchRequest{Query: "laptop", Limit: 20},
+		&domain.SearchExperimentAssignment{ExperimentID: "title-boost", Variant: "control"})
+	assert.Contains(t, control["query"], "bool", "control arms keep the default ranking")
+	assert.Contains(t, control["sort"].([]map[string]interface{})[0], "_score", "all arms are ordered by score")
+
+	notEnrolled := svc.buildSearchQuery(&SearchRequest{Query: "laptop", Limit: 20}, nil)
+	assert.Contains(t, notEnrolled["sort"].([]map[string]interface{})[0], "created_at")
+}
+
+func TestBuildFilteredSearchQuery_ExperimentRanking(t *testing.T) {
+	req := &SearchFiltersRequest{Query: "phone", Limit: 20}
+
+	query := BuildFilteredSearchQuery(req, &domain.SearchRanking{FavoritesWeight: 2, BoostMode: "sum"})
+
+	functionScore := query["query"].(map[string]interface{})["function_score"].(map[string]interface{})
+	assert.Equal(t, "sum", functionScore["boost_mode"])
+	assert.NoError(t, ValidateQuery(query))
+
+	fieldsOnly := BuildFilteredSearchQuery(req, &domain.SearchRanking{DescriptionBoost: 2})
+	boolQuery := fieldsOnly["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	multiMatch := boolQuery["must"].([]map[string]interface{})[1]["multi_match"].(map[string]interface{})
+	assert.Equal(t, []string{"title^3", "description^2"}, multiMatch["fields"])
+}
+
+func TestAssignExperiment(t *testing.T) {
+	source := &fakeExperimentSource{
+		experiments: []*domain.SearchExperiment{{
+			ID:             "title-boost",
+			Status:         domain.SearchExperimentRunning,
+			TrafficPercent: 100,
+			Variants: []domain.SearchExperimentVariant{
+				{Name: "title5", Weight: 1, Ranking: &domain.SearchRanking{TitleBoost: 5}},
+			},
+		}},
+	}
+	svc := &Service{logger: zerolog.Nop()}
+	ctx := context.Background()
+	userID := int64(42)
+
+	assert.Nil(t, svc.assignExperiment(ctx, &userID, nil), "experiments are disabled without a source")
+
+	svc.SetExperimentSource(source)
+
+	assignment := svc.assignExperiment(ctx, &userID, nil)
+	require.NotNil(t, assignment)
+	assert.Equal(t, "title-boost", assignment.ExperimentID)
+	assert.Equal(t, "title5", assignment.Variant)
+
+	assert.Nil(t, svc.assignExperiment(ctx, nil, nil), "anonymous searches are not enrolled")
+
+	svc.assignExperiment(ctx, &userID, nil)
+	assert.Equal(t, 1, source.loads, "running experiments are cached")
+}
+
+func TestAssignExperiment_SourceFailure(t *testing.T) {
+	source := &fakeExperimentSource{err: errors.New("db down")}
+	svc := &Service{logger: zerolog.Nop()}
+	svc.SetExperimentSource(source)
+	userID := int64(42)
+
+	assert.Nil(t, svc.assignExperiment(context.Background(), &userID, nil))
+	assert.Nil(t, svc.assignExperiment(context.Background(), &userID, nil))
+	assert.Equal(t, 1, source.loads, "failed loads are not retried on every search")
+}
@@ -2,6 +2,8 @@ package search
 
 import (
 	"fmt"
+
+	"github.com/sveturs/listings/internal/domain"
 )
 
 // ============================================================================
@@ -112,21 +114,16 @@ func buildAggregations() map[string]interface{} {
 	}
 }
 
-// BuildFilteredSearchQuery builds a complex query with filters, sorting, and optional facets
-func BuildFilteredSearchQuery(req *SearchFiltersRequest) map[string]interface{} {
+// BuildFilteredSearchQuery builds a complex query with filters, sorting, and optional facets.
+// ranking is the search experiment variant of the searcher (nil = default ranking).
+func BuildFilteredSearchQuery(req *SearchFiltersRequest, ranking *domain.SearchRanking) map[string]interface{} {
 	mustClauses := []map[string]interface{}{
 		{"term": map[string]interface{}{"status": "active"}},
 	}
 
 	// Text search
 	if req.Query != "" {
-		mustClauses = append(mustClauses, map[string]interface{}{
-			"multi_match": map[string]interface{}{
-				"query":  req.Query,
-				"fields": []string{"title^3", "description"},
-				"type":   "best_fields",
-			},
-		})
+		mustClauses = append(mustClauses, buildTextQuery(req.Query, ranking))
 	}
 
 	// Category filter
@@ -143,11 +140,11 @@ func BuildFilteredSearchQuery(req *SearchFiltersRequest) map[string]interface{}
 	}
 
 	query := map[string]interface{}{
-		"query": map[string]interface{}{
+		"query": applyPopularityBoost(map[string]interface{}{
 			"bool": map[string]interface{}{
 				"must": mustClauses,
 			},
-		},
+		}, ranking),
 		"size": req.Limit,
 		"from": req.Offset,
 	}
@@ -178,6 +175,73 @@ func BuildFilteredSearchQuery(req *SearchFiltersRequest) map[string]interface{}
 	return query
 }
 
+// buildTextQuery builds the multi_match clause of the search text with the field boosts of
+// the ranking (title^3 and description by default)
+func buildTextQuery(text string, ranking *domain.SearchRanking) map[string]interface{} {
+	titleBoost, descriptionBoost := ranking.FieldBoosts()
+
+	return map[string]interface{}{
+		"multi_match": map[string]interface{}{
+			"query":  text,
+			"fields": []string{boostedField("title", titleBoost), boostedField("description", descriptionBoost)},
+			"type":   "best_fields",
+		},
+	}
+}
+
+// boostedField formats a multi_match field with its boost ("title^3"; no suffix for 1)
+func boostedField(field string, boost float64) string {
+	if boost == 1 {
+		return field
+	}
+	return fmt.Sprintf("%s^%g", field, boost)
+}
+
+// applyPopularityBoost wraps a query in a function_score that adds the weighted popularity
+// of the listing (log1p of views and favorites, rating) to a base of 1, so listings without
+// any engagement keep their text relevance. The query is returned as is without weights.
+func applyPopularityBoost(query map[string]interface{}, ranking *domain.SearchRanking) map[string]interface{} {
+	if !ranking.HasPopularityBoost() {
+		return query
+	}
+
+	functions := []map[string]interface{}{
+		{"weight": 1},
+	}
+	if ranking.ViewsWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"field_value_factor": map[string]interface{}{"field": "views_count", "modifier": "log1p", "missing": 0},
+			"weight":             ranking.ViewsWeight,
+		})
+	}
+	if ranking.FavoritesWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"field_value_factor": map[string]interface{}{"field": "favorites_count", "modifier": "log1p", "missing": 0},
+			"weight":             ranking.FavoritesWeight,
+		})
+	}
+	if ranking.RatingWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"field_value_factor": map[string]interface{}{"field": "rating", "missing": 0},
+			"weight":             ranking.RatingWeight,
+		})
+	}
+
+	boostMode := ranking.BoostMode
+	if boostMode == "" {
+		boostMode = "multiply"
+	}
+
+	return map[string]interface{}{
+		"function_score": map[string]interface{}{
+			"query":      query,
+			"functions":  functions,
+			"score_mode": "sum",
+			"boost_mode": boostMode,
+		},
+	}
+}
+
 // buildFilterClauses constructs filter clauses from SearchFilters
 func buildFilterClauses(filters *SearchFilters) []map[string]interface{} {
 	clauses := []map[string]interface{}{}
@@ -246,6 +310,13 @@ func buildFilterClauses(filters *SearchFilters) []map[string]interface{} {
 		})
 	}
 
+	// Verified sellers filter
+	if filters.VerifiedSellersOnly {
+		clauses = append(clauses, map[string]interface{}{
+			"term": map[string]interface{}{"storefront_verified": true},
+		})
+	}
+
 	return clauses
 }
 
@@ -174,7 +174,7 @@ func TestBuildFilteredSearchQuery(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := BuildFilteredSearchQuery(tt.req)
+			query := BuildFilteredSearchQuery(tt.req, nil)
 
 			// Check sort presence
 			if _, ok := query["sort"]; ok != tt.expectSort {
@@ -400,6 +400,13 @@ func TestBuildFilterClauses(t *testing.T) {
 			},
 			expectClauses: 2,
 		},
+		{
+			name: "verified sellers only",
+			filters: &SearchFilters{
+				VerifiedSellersOnly: true,
+			},
+			expectClauses: 1,
+		},
 		{
 			name: "all filters combined",
 			filters: &SearchFilters{
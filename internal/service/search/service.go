@@ -3,6 +3,9 @@ package search
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -18,7 +21,19 @@ type Service struct {
 	searchClient      *opensearch.SearchClient
 	cache             *cache.SearchCache
 	searchQueriesRepo repository.SearchQueriesRepository
+	eventTracker      EventTracker
+	experimentSource  ExperimentSource // Optional: search ranking experiments
 	logger            zerolog.Logger
+
+	// Running experiments, reloaded every experimentsRefreshInterval
+	experimentsMu       sync.Mutex
+	experiments         []domain.SearchExperiment
+	experimentsLoadedAt time.Time
+}
+
+// EventTracker records analytics events without blocking the caller
+type EventTracker interface {
+	Track(events ...*domain.AnalyticsEvent) int
 }
 
 // NewService creates a new search service
@@ -40,6 +55,101 @@ func (s *Service) SetSearchQueriesRepo(repo repository.SearchQueriesRepository)
 	s.searchQueriesRepo = repo
 }
 
+// SetEventTracker sets the tracker that receives search analytics events (optional)
+func (s *Service) SetEventTracker(tracker EventTracker) {
+	s.eventTracker = tracker
+}
+
+// trackSearch records a search analytics event. Only the first page counts as a search;
+// paging through results is not a new query.
+func (s *Service) trackSearch(
+	query string,
+	categoryID *int64,
+	offset int32,
+	total int64,
+	experiment *domain.SearchExperimentAssignment,
+) {
+	if s.eventTracker == nil || offset > 0 {
+		return
+	}
+
+	// entity_id carries the category (0 = all categories)
+	var entityID int64
+	if categoryID != nil {
+		entityID = *categoryID
+	}
+
+	metadata := map[string]interface{}{
+		"query":         query,
+		"results_count": total,
+	}
+	if experiment != nil {
+		metadata["experiment_id"] = experiment.ExperimentID
+		metadata["experiment_variant"] = experiment.Variant
+	}
+
+	s.eventTracker.Track(&domain.AnalyticsEvent{
+		EventType:  domain.AnalyticsEventSearch,
+		EntityType: domain.AnalyticsEntitySearch,
+		EntityID:   entityID,
+		Metadata:   metadata,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+// searchQueryRecordTimeout bounds the search_queries insert on the search path
+const searchQueryRecordTimeout = 500 * time.Millisecond
+
+// recordSearchQuery stores a first-page search of an identified searcher in search_queries,
+// together with the experiment arm that served it, and returns its ID for click tracking.
+// Failures are logged and never fail the search.
+func (s *Service) recordSearchQuery(
+	ctx context.Context,
+	query string,
+	categoryID *int64,
+	offset int32,
+	total int64,
+	userID *int64,
+	sessionID *string,
+	experiment *domain.SearchExperimentAssignment,
+) *int64 {
+	if s.searchQueriesRepo == nil || offset > 0 || strings.TrimSpace(query) == "" {
+		return nil
+	}
+	if userID == nil && sessionID == nil {
+		return nil
+	}
+
+	resultsCount := total
+	if resultsCount > math.MaxInt32 {
+		resultsCount = math.MaxInt32
+	}
+
+	recordCtx, cancel := context.WithTimeout(ctx, searchQueryRecordTimeout)
+	defer cancel()
+
+	experimentID, experimentVariant := experimentFields(experiment)
+
+	recorded, err := s.searchQueriesRepo.CreateSearchQuery(recordCtx, &domain.CreateSearchQueryInput{
+		QueryText:         query,
+		CategoryID:        categoryID,
+		UserID:            userID,
+		SessionID:         sessionID,
+		ResultsCount:      int32(resultsCount),
+		ExperimentID:      experimentID,
+		ExperimentVariant: experimentVariant,
+	})
+	if err != nil {
+		s.logger.Warn().
+			Err(err).
+			Str("query", query).
+			Msg("failed to record search query")
+		return nil
+	}
+
+	return &recorded.ID
+}
+
 // SearchListings searches for listings based on query and filters
 func (s *Service) SearchListings(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
 	start := time.Now()
@@ -57,8 +167,13 @@ func (s *Service) SearchListings(ctx context.Context, req *SearchRequest) (*Sear
 		Bool("use_cache", req.UseCache).
 		Msg("searching listings")
 
+	// Enrolled searches bypass the cache: cache keys do not carry the variant, and every arm
+	// (control included) is ordered by score unlike the cached default results
+	experiment := s.assignExperiment(ctx, req.UserID, req.SessionID)
+	useCache := req.UseCache && s.cache != nil && experiment == nil
+
 	// Try cache first (if enabled)
-	if req.UseCache && s.cache != nil {
+	if useCache {
 		cacheReq := &cache.SearchRequest{
 			Query:      req.Query,
 			CategoryID: req.CategoryID,
@@ -74,19 +189,23 @@ func (s *Service) SearchListings(ctx context.Context, req *SearchRequest) (*Sear
 				TookMs:   cached.TookMs,
 				Cached:   true,
 			}
+			response.ExperimentID, response.ExperimentVariant = experimentFields(experiment)
 
 			s.logger.Debug().
 				Dur("duration", time.Since(start)).
 				Int64("total", response.Total).
 				Msg("returned cached search results")
 
+			s.trackSearch(req.Query, req.CategoryID, req.Offset, response.Total, experiment)
+			response.SearchQueryID = s.recordSearchQuery(ctx, req.Query, req.CategoryID, req.Offset, response.Total, req.UserID, req.SessionID, experiment)
+
 			return response, nil
 		}
 		// Cache miss is fine, continue to OpenSearch
 	}
 
 	// Build OpenSearch query
-	query := s.buildSearchQuery(req)
+	query := s.buildSearchQuery(req, experiment)
 
 	// Execute search
 	searchResp, err := s.searchClient.Search(ctx, query)
@@ -106,9 +225,10 @@ func (s *Service) SearchListings(ctx context.Context, req *SearchRequest) (*Sear
 		TookMs:   int32(searchResp.Took),
 		Cached:   false,
 	}
+	response.ExperimentID, response.ExperimentVariant = experimentFields(experiment)
 
 	// Cache result (async, non-blocking)
-	if req.UseCache && s.cache != nil {
+	if useCache {
 		go func() {
 			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
@@ -137,13 +257,25 @@ func (s *Service) SearchListings(ctx context.Context, req *SearchRequest) (*Sear
 		Int64("total", response.Total).
 		Int32("took_ms", response.TookMs).
 		Int("results", len(response.Listings)).
+		Interface("experiment_id", response.ExperimentID).
+		Interface("experiment_variant", response.ExperimentVariant).
 		Msg("search completed")
 
+	s.trackSearch(req.Query, req.CategoryID, req.Offset, response.Total, experiment)
+	response.SearchQueryID = s.recordSearchQuery(ctx, req.Query, req.CategoryID, req.Offset, response.Total, req.UserID, req.SessionID, experiment)
+
 	return response, nil
 }
 
-// buildSearchQuery constructs OpenSearch query from request
-func (s *Service) buildSearchQuery(req *SearchRequest) map[string]interface{} {
+// buildSearchQuery constructs OpenSearch query from request. experiment is the experiment arm
+// of the searcher (nil = not enrolled). Text searches of enrolled searchers are ordered by score
+// in every arm, control included, so the arms differ only in their ranking.
+func (s *Service) buildSearchQuery(req *SearchRequest, experiment *domain.SearchExperimentAssignment) map[string]interface{} {
+	var ranking *domain.SearchRanking
+	if experiment != nil {
+		ranking = experiment.Ranking
+	}
+
 	// Build bool query
 	mustClauses := []map[string]interface{}{
 		// Filter by active status
@@ -156,13 +288,7 @@ func (s *Service) buildSearchQuery(req *SearchRequest) map[string]interface{} {
 
 	// Add text search if query provided
 	if req.Query != "" {
-		mustClauses = append(mustClauses, map[string]interface{}{
-			"multi_match": map[string]interface{}{
-				"query":  req.Query,
-				"fields": []string{"title^3", "description"},
-				"type":   "best_fields",
-			},
-		})
+		mustClauses = append(mustClauses, buildTextQuery(req.Query, ranking))
 	}
 
 	// Add category filter if provided
@@ -174,22 +300,30 @@ func (s *Service) buildSearchQuery(req *SearchRequest) map[string]interface{} {
 		})
 	}
 
+	sort := []map[string]interface{}{
+		{
+			"created_at": map[string]interface{}{
+				"order": "desc",
+			},
+		},
+	}
+	// Ranking variants only change the order when it follows the score
+	if experiment != nil && req.Query != "" {
+		sort = append([]map[string]interface{}{
+			{"_score": map[string]interface{}{"order": "desc"}},
+		}, sort...)
+	}
+
 	// Build final query
 	query := map[string]interface{}{
-		"query": map[string]interface{}{
+		"query": applyPopularityBoost(map[string]interface{}{
 			"bool": map[string]interface{}{
 				"must": mustClauses,
 			},
-		},
+		}, ranking),
 		"size": req.Limit,
 		"from": req.Offset,
-		"sort": []map[string]interface{}{
-			{
-				"created_at": map[string]interface{}{
-					"order": "desc",
-				},
-			},
-		},
+		"sort": sort,
 	}
 
 	return query
@@ -248,6 +382,9 @@ func (s *Service) parseListingFromHit(source map[string]interface{}) ListingSear
 	if stockStatus, ok := source["stock_status"].(string); ok {
 		listing.StockStatus = stockStatus
 	}
+	if verified, ok := source["storefront_verified"].(bool); ok {
+		listing.StorefrontVerified = verified
+	}
 
 	// Parse optional fields
 	if desc, ok := source["description"].(string); ok && desc != "" {
@@ -329,6 +466,10 @@ func (s *Service) convertListingsForCache(listings []ListingSearchResult) []map[
 			"stock_status": listing.StockStatus,
 		}
 
+		if listing.StorefrontVerified {
+			item["storefront_verified"] = true
+		}
+
 		if listing.Description != nil {
 			item["description"] = *listing.Description
 		}
@@ -388,6 +529,9 @@ func (s *Service) GetSearchFacets(ctx context.Context, req *FacetsRequest) (*Fac
 			if req.Filters.StockStatus != nil {
 				filters["stock_status"] = *req.Filters.StockStatus
 			}
+			if req.Filters.VerifiedSellersOnly {
+				filters["verified_sellers_only"] = true
+			}
 		}
 		cacheKey = s.cache.GenerateFacetsKey(req.Query, req.CategoryID, filters)
 
@@ -464,9 +608,16 @@ func (s *Service) SearchWithFilters(ctx context.Context, req *SearchFiltersReque
 		Bool("include_facets", req.IncludeFacets).
 		Msg("searching with filters")
 
+	// Experiment arms with their own ranking bypass the cache (see SearchListings)
+	experiment := s.assignExperiment(ctx, req.UserID, req.SessionID)
+	var ranking *domain.SearchRanking
+	if experiment != nil {
+		ranking = experiment.Ranking
+	}
+
 	// Generate cache key
 	cacheKey := ""
-	if req.UseCache && s.cache != nil {
+	if req.UseCache && s.cache != nil && ranking == nil {
 		filters := make(map[string]interface{})
 		if req.Filters != nil {
 			if req.Filters.Price != nil {
@@ -484,6 +635,9 @@ func (s *Service) SearchWithFilters(ctx context.Context, req *SearchFiltersReque
 			if req.Filters.StockStatus != nil {
 				filters["stock_status"] = *req.Filters.StockStatus
 			}
+			if req.Filters.VerifiedSellersOnly {
+				filters["verified_sellers_only"] = true
+			}
 		}
 
 		sort := make(map[string]string)
@@ -497,12 +651,16 @@ func (s *Service) SearchWithFilters(ctx context.Context, req *SearchFiltersReque
 		// Check cache
 		if cached, err := s.cache.GetFiltered(ctx, cacheKey); err == nil && cached != nil {
 			s.logger.Debug().Msg("filtered search cache hit")
-			return s.convertCachedFilteredSearch(cached, true), nil
+			response := s.convertCachedFilteredSearch(cached, true)
+			response.ExperimentID, response.ExperimentVariant = experimentFields(experiment)
+			s.trackSearch(req.Query, req.CategoryID, req.Offset, response.Total, experiment)
+			response.SearchQueryID = s.recordSearchQuery(ctx, req.Query, req.CategoryID, req.Offset, response.Total, req.UserID, req.SessionID, experiment)
+			return response, nil
 		}
 	}
 
 	// Build OpenSearch query
-	query := BuildFilteredSearchQuery(req)
+	query := BuildFilteredSearchQuery(req, ranking)
 
 	// Execute search
 	result, err := s.searchClient.Search(ctx, query)
@@ -522,6 +680,7 @@ func (s *Service) SearchWithFilters(ctx context.Context, req *SearchFiltersReque
 		TookMs:   int32(result.Took),
 		Cached:   false,
 	}
+	response.ExperimentID, response.ExperimentVariant = experimentFields(experiment)
 
 	// Parse facets if requested
 	if req.IncludeFacets {
@@ -555,8 +714,13 @@ func (s *Service) SearchWithFilters(ctx context.Context, req *SearchFiltersReque
 		Int64("total", response.Total).
 		Int32("took_ms", response.TookMs).
 		Int("results", len(response.Listings)).
+		Interface("experiment_id", response.ExperimentID).
+		Interface("experiment_variant", response.ExperimentVariant).
 		Msg("filtered search completed")
 
+	s.trackSearch(req.Query, req.CategoryID, req.Offset, response.Total, experiment)
+	response.SearchQueryID = s.recordSearchQuery(ctx, req.Query, req.CategoryID, req.Offset, response.Total, req.UserID, req.SessionID, experiment)
+
 	return response, nil
 }
 
@@ -1403,6 +1567,31 @@ func (s *Service) convertTrendingSearchesForCache(response *TrendingSearchesResp
 // PHASE 28: Search Analytics - Personal Search History
 // ============================================================================
 
+// RecordSearchClick records a click on a search result for CTR analytics. Returns false
+// if the click was not counted (unknown or expired search, repeated click).
+func (s *Service) RecordSearchClick(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error) {
+	if err := input.Validate(); err != nil {
+		return false, fmt.Errorf("invalid search click: %w", err)
+	}
+
+	// Check if repository is available
+	if s.searchQueriesRepo == nil {
+		s.logger.Warn().Msg("search queries repository not available, click not recorded")
+		return false, nil
+	}
+
+	recorded, err := s.searchQueriesRepo.RecordSearchClick(ctx, input)
+	if err != nil {
+		s.logger.Error().
+			Err(err).
+			Int64("search_query_id", input.SearchQueryID).
+			Msg("failed to record search click")
+		return false, fmt.Errorf("failed to record search click: %w", err)
+	}
+
+	return recorded, nil
+}
+
 // GetSearchHistory returns user's personal search history
 func (s *Service) GetSearchHistory(ctx context.Context, req *SearchHistoryRequest) (*SearchHistoryResponse, error) {
 	start := time.Now()
@@ -195,7 +195,7 @@ func TestBuildSearchQuery(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := svc.buildSearchQuery(tt.req)
+			query := svc.buildSearchQuery(tt.req, nil)
 			tt.validate(t, query)
 		})
 	}
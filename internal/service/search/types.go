@@ -12,6 +12,10 @@ type SearchRequest struct {
 	Limit      int32  // Results per page (1-100)
 	Offset     int32  // Pagination offset
 	UseCache   bool   // Whether to use cache
+
+	// Searcher identity; when set, first-page searches are recorded for analytics
+	UserID    *int64
+	SessionID *string
 }
 
 // Validate validates search request parameters
@@ -39,10 +43,15 @@ func (r *SearchRequest) Validate() error {
 
 // SearchResponse represents search result
 type SearchResponse struct {
-	Listings []ListingSearchResult `json:"listings"`
-	Total    int64                 `json:"total"`
-	TookMs   int32                 `json:"took_ms"`
-	Cached   bool                  `json:"cached"`
+	Listings      []ListingSearchResult `json:"listings"`
+	Total         int64                 `json:"total"`
+	TookMs        int32                 `json:"took_ms"`
+	Cached        bool                  `json:"cached"`
+	SearchQueryID *int64                `json:"search_query_id,omitempty"` // Recorded search (for click tracking)
+
+	// Search experiment arm that ranked the results (nil = not enrolled)
+	ExperimentID      *string `json:"experiment_id,omitempty"`
+	ExperimentVariant *string `json:"experiment_variant,omitempty"`
 }
 
 // ListingSearchResult represents a single listing in search results
@@ -63,6 +72,8 @@ type ListingSearchResult struct {
 	SKU          *string              `json:"sku,omitempty"`
 	SourceType   string               `json:"source_type"`
 	StockStatus  string               `json:"stock_status"`
+
+	StorefrontVerified bool `json:"storefront_verified,omitempty"`
 }
 
 // ListingImageResult represents an image in search results
@@ -154,6 +165,10 @@ type SearchFiltersRequest struct {
 	Sort          *SortConfig    // Sort configuration
 	UseCache      bool           // Whether to use cache
 	IncludeFacets bool           // Return facets with results
+
+	// Searcher identity; when set, first-page searches are recorded for analytics
+	UserID    *int64
+	SessionID *string
 }
 
 // Validate validates search filters request parameters
@@ -200,6 +215,8 @@ type SearchFilters struct {
 	Location    *LocationFilter     // Geo location filter
 	SourceType  *string             // "c2c" | "b2c"
 	StockStatus *string             // "in_stock" | "out_of_stock" | "low_stock"
+
+	VerifiedSellersOnly bool // Only listings of verified storefronts
 }
 
 // Validate validates search filters
@@ -302,7 +319,7 @@ func (l *LocationFilter) Validate() error {
 
 // SortConfig defines sorting parameters
 type SortConfig struct {
-	Field string `json:"field"` // "price" | "created_at" | "relevance" | "views_count" | "favorites_count"
+	Field string `json:"field"` // "price" | "created_at" | "relevance" | "views_count" | "favorites_count" | "rating" | "reviews_count"
 	Order string `json:"order"` // "asc" | "desc"
 }
 
@@ -315,6 +332,8 @@ func (s *SortConfig) Validate() error {
 		"relevance":       true,
 		"views_count":     true,
 		"favorites_count": true,
+		"rating":          true,
+		"reviews_count":   true,
 	}
 	if !validFields[s.Field] {
 		return ErrInvalidSortField
@@ -331,11 +350,16 @@ func (s *SortConfig) Validate() error {
 
 // SearchFiltersResponse - search results + optional facets
 type SearchFiltersResponse struct {
-	Listings []ListingSearchResult `json:"listings"`
-	Total    int64                 `json:"total"`
-	TookMs   int32                 `json:"took_ms"`
-	Cached   bool                  `json:"cached"`
-	Facets   *FacetsResponse       `json:"facets,omitempty"` // If IncludeFacets=true
+	Listings      []ListingSearchResult `json:"listings"`
+	Total         int64                 `json:"total"`
+	TookMs        int32                 `json:"took_ms"`
+	Cached        bool                  `json:"cached"`
+	Facets        *FacetsResponse       `json:"facets,omitempty"`          // If IncludeFacets=true
+	SearchQueryID *int64                `json:"search_query_id,omitempty"` // Recorded search (for click tracking)
+
+	// Search experiment arm that ranked the results (nil = not enrolled)
+	ExperimentID      *string `json:"experiment_id,omitempty"`
+	ExperimentVariant *string `json:"experiment_variant,omitempty"`
 }
 
 // SuggestionsRequest - autocomplete request
@@ -0,0 +1,11 @@
+package sitemap
+
+import "errors"
+
+var (
+	// ErrStorefrontNotFound is returned when the storefront does not exist or has no public pages
+	ErrStorefrontNotFound = errors.New("storefront not found")
+
+	// ErrPageNotFound is returned when a sitemap page beyond the last one is requested
+	ErrPageNotFound = errors.New("sitemap page not found")
+)
@@ -0,0 +1,94 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// sitemapNamespace is the sitemaps.org protocol namespace
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// robotsDisallow are marketplace paths crawlers should skip
+var robotsDisallow = []string{"/api/", "/cart", "/checkout", "/account"}
+
+type xmlURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	XMLNS   string   `xml:"xmlns,attr"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+type xmlSitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	Sitemaps []xmlSitemap `xml:"sitemap"`
+}
+
+type xmlSitemap struct {
+	Loc string `xml:"loc"`
+}
+
+// RenderURLSet renders a sitemap file (<urlset>)
+func RenderURLSet(urls []domain.SitemapURL) ([]byte, error) {
+	set := xmlURLSet{XMLNS: sitemapNamespace, URLs: make([]xmlURL, 0, len(urls))}
+	for _, u := range urls {
+		entry := xmlURL{Loc: u.Loc, ChangeFreq: u.ChangeFreq}
+		if u.LastMod != nil {
+			entry.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		if u.Priority > 0 {
+			entry.Priority = strconv.FormatFloat(u.Priority, 'f', 1, 64)
+		}
+		set.URLs = append(set.URLs, entry)
+	}
+
+	return encode(set, "sitemap")
+}
+
+// RenderIndex renders a sitemap index (<sitemapindex>) listing the given sitemap files
+func RenderIndex(locs []string) ([]byte, error) {
+	index := xmlSitemapIndex{XMLNS: sitemapNamespace, Sitemaps: make([]xmlSitemap, 0, len(locs))}
+	for _, loc := range locs {
+		index.Sitemaps = append(index.Sitemaps, xmlSitemap{Loc: loc})
+	}
+
+	return encode(index, "sitemap index")
+}
+
+// RenderRobots renders robots.txt pointing crawlers at the given sitemap
+func RenderRobots(sitemapURL string) []byte {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	for _, path := range robotsDisallow {
+		b.WriteString("Disallow: " + path + "\n")
+	}
+	b.WriteString("Allow: /\n\n")
+	b.WriteString("Sitemap: " + sitemapURL + "\n")
+	return []byte(b.String())
+}
+
+func encode(v interface{}, what string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", what, err)
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,381 @@
+// Package sitemap generates sitemap.xml files and robots.txt for the marketplace and for
+// storefronts, including storefronts served from their own custom domains.
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+const (
+	// sitemapCacheKey is the Redis key for a rendered sitemap file (%s = scope, %d = page)
+	sitemapCacheKey = "sitemap:%s:%d"
+
+	// marketplaceScope is the cache scope of the marketplace-wide sitemap
+	marketplaceScope = "marketplace"
+)
+
+// Repository defines the data access needed to build sitemaps
+type Repository interface {
+	GetStorefrontBySlug(ctx context.Context, slug string, includes *domain.Includes) (*domain.Storefront, error)
+	ResolveStorefrontDomain(ctx context.Context, host string) (*domain.StorefrontDomain, error)
+	CountSitemapCategories(ctx context.Context) (int, error)
+	ListSitemapCategories(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error)
+	CountSitemapStorefronts(ctx context.Context) (int, error)
+	ListSitemapStorefronts(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error)
+	CountSitemapListings(ctx context.Context, storefrontID *int64) (int, error)
+	ListSitemapListings(ctx context.Context, storefrontID *int64, offset, limit int) ([]domain.SitemapItem, error)
+}
+
+// Config holds sitemap generation settings
+type Config struct {
+	BaseURL     string        // Public marketplace URL used for page links
+	CacheTTL    time.Duration // How long a rendered sitemap file is served from cache
+	URLsPerFile int           // URLs per sitemap file before splitting behind an index
+}
+
+// Service builds and caches sitemaps
+type Service struct {
+	repo            Repository
+	cache           redis.UniversalClient
+	config          Config
+	marketplaceHost string
+	logger          zerolog.Logger
+}
+
+// NewService creates a new sitemap service (cache is optional)
+func NewService(repo Repository, cache redis.UniversalClient, cfg Config, logger zerolog.Logger) *Service {
+	if cfg.URLsPerFile <= 0 || cfg.URLsPerFile > domain.MaxSitemapURLs {
+		cfg.URLsPerFile = domain.MaxSitemapURLs
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	var marketplaceHost string
+	if u, err := url.Parse(cfg.BaseURL); err == nil {
+		marketplaceHost = strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	}
+
+	return &Service{
+		repo:            repo,
+		cache:           cache,
+		config:          cfg,
+		marketplaceHost: marketplaceHost,
+		logger:          logger.With().Str("service", "sitemap").Logger(),
+	}
+}
+
+// scope is the set of pages covered by one sitemap, with the URLs it is published under
+type scope struct {
+	key         string // Cache scope
+	sitemapBase string // URL prefix of sitemap.xml / sitemap-N.xml
+	sources     []source
+}
+
+// source is one kind of page (categories, listings, ...) paged by offset
+type source struct {
+	count func(ctx context.Context) (int, error)
+	load  func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error)
+}
+
+// ResolveHost returns the verified custom domain a request host belongs to, or nil when the
+// host is the marketplace itself (or unknown)
+func (s *Service) ResolveHost(ctx context.Context, host string) (*domain.StorefrontDomain, error) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	normalized, err := domain.NormalizeStorefrontDomain(host)
+	if err != nil || strings.TrimPrefix(normalized, "www.") == s.marketplaceHost {
+		return nil, nil
+	}
+
+	d, err := s.repo.ResolveStorefrontDomain(ctx, normalized)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve domain: %w", err)
+	}
+
+	return d, nil
+}
+
+// HostSitemap returns a sitemap file for the request host: the storefront's sitemap on a
+// custom domain, the marketplace sitemap otherwise. Page 0 is sitemap.xml.
+func (s *Service) HostSitemap(ctx context.Context, host string, page int) ([]byte, error) {
+	d, err := s.ResolveHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return s.render(ctx, s.marketplaceScope(), page)
+	}
+
+	sc, err := s.storefrontScope(ctx, d.StorefrontSlug, "https://"+d.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return s.render(ctx, sc, page)
+}
+
+// StorefrontSitemap returns a sitemap file of a storefront under the marketplace URL
+func (s *Service) StorefrontSitemap(ctx context.Context, slug string, page int) ([]byte, error) {
+	sc, err := s.storefrontScope(ctx, slug, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.render(ctx, sc, page)
+}
+
+// Robots returns robots.txt for the request host
+func (s *Service) Robots(ctx context.Context, host string) ([]byte, error) {
+	d, err := s.ResolveHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return RenderRobots(s.config.BaseURL + "/sitemap.xml"), nil
+	}
+	return RenderRobots("https://" + d.Domain + "/sitemap.xml"), nil
+}
+
+// render returns page of a scope: 0 is the root (a urlset when everything fits in one file,
+// a sitemap index otherwise), 1..N are the split files
+func (s *Service) render(ctx context.Context, sc *scope, page int) ([]byte, error) {
+	if page < 0 {
+		return nil, ErrPageNotFound
+	}
+
+	key := fmt.Sprintf(sitemapCacheKey, sc.key, page)
+	if s.cache != nil {
+		data, err := s.cache.Get(ctx, key).Bytes()
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			s.logger.Warn().Err(err).Str("key", key).Msg("sitemap cache get failed")
+		}
+	}
+
+	counts := make([]int, len(sc.sources))
+	total := 0
+	for i, src := range sc.sources {
+		n, err := src.count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = n
+		total += n
+	}
+
+	perFile := s.config.URLsPerFile
+	pages := domain.SitemapPageCount(total, perFile)
+	if page > pages {
+		return nil, ErrPageNotFound
+	}
+
+	var data []byte
+	var err error
+	if page == 0 && pages > 1 {
+		locs := make([]string, pages)
+		for i := range locs {
+			locs[i] = fmt.Sprintf("%s/sitemap-%d.xml", sc.sitemapBase, i+1)
+		}
+		data, err = RenderIndex(locs)
+	} else {
+		offset := 0
+		if page > 0 {
+			offset = (page - 1) * perFile
+		}
+		var urls []domain.SitemapURL
+		urls, err = collect(ctx, sc.sources, counts, offset, perFile)
+		if err != nil {
+			return nil, err
+		}
+		data, err = RenderURLSet(urls)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil && s.config.CacheTTL > 0 {
+		if err := s.cache.Set(ctx, key, data, s.config.CacheTTL).Err(); err != nil {
+			s.logger.Warn().Err(err).Str("key", key).Msg("failed to cache sitemap")
+		}
+	}
+
+	s.logger.Debug().Str("scope", sc.key).Int("page", page).Int("total_urls", total).Msg("sitemap generated")
+
+	return data, nil
+}
+
+// collect loads limit URLs starting at offset across the sources, in source order
+func collect(ctx context.Context, sources []source, counts []int, offset, limit int) ([]domain.SitemapURL, error) {
+	urls := make([]domain.SitemapURL, 0, limit)
+	for i, src := range sources {
+		if len(urls) >= limit {
+			break
+		}
+		if offset >= counts[i] {
+			offset -= counts[i]
+			continue
+		}
+
+		loaded, err := src.load(ctx, offset, limit-len(urls))
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, loaded...)
+		offset = 0
+	}
+	return urls, nil
+}
+
+// marketplaceScope covers the home page, categories, storefronts and all public listings
+func (s *Service) marketplaceScope() *scope {
+	base := s.config.BaseURL
+
+	return &scope{
+		key:         marketplaceScope,
+		sitemapBase: base,
+		sources: []source{
+			staticSource(domain.SitemapURL{Loc: base + "/", ChangeFreq: domain.SitemapChangeDaily, Priority: 1.0}),
+			{
+				count: s.repo.CountSitemapCategories,
+				load: func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error) {
+					items, err := s.repo.ListSitemapCategories(ctx, offset, limit)
+					return toURLs(items, err, func(item domain.SitemapItem) domain.SitemapURL {
+						return domain.SitemapURL{
+							Loc:        fmt.Sprintf("%s/categories/%s", base, url.PathEscape(item.Slug)),
+							ChangeFreq: domain.SitemapChangeWeekly,
+							Priority:   0.8,
+						}
+					})
+				},
+			},
+			{
+				count: s.repo.CountSitemapStorefronts,
+				load: func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error) {
+					items, err := s.repo.ListSitemapStorefronts(ctx, offset, limit)
+					return toURLs(items, err, func(item domain.SitemapItem) domain.SitemapURL {
+						return domain.SitemapURL{
+							Loc:        fmt.Sprintf("%s/storefronts/%s", base, url.PathEscape(item.Slug)),
+							LastMod:    item.UpdatedAt,
+							ChangeFreq: domain.SitemapChangeWeekly,
+							Priority:   0.7,
+						}
+					})
+				},
+			},
+			{
+				count: func(ctx context.Context) (int, error) {
+					return s.repo.CountSitemapListings(ctx, nil)
+				},
+				load: func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error) {
+					items, err := s.repo.ListSitemapListings(ctx, nil, offset, limit)
+					return toURLs(items, err, func(item domain.SitemapItem) domain.SitemapURL {
+						return domain.SitemapURL{
+							Loc:        s.listingLink(item),
+							LastMod:    item.UpdatedAt,
+							ChangeFreq: domain.SitemapChangeDaily,
+							Priority:   0.6,
+						}
+					})
+				},
+			},
+		},
+	}
+}
+
+// storefrontScope covers a storefront's home page and products. host is the custom domain
+// URL ("https://shop.example.rs") or empty for the storefront's marketplace pages.
+func (s *Service) storefrontScope(ctx context.Context, slug, host string) (*scope, error) {
+	storefront, err := s.repo.GetStorefrontBySlug(ctx, slug, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, fmt.Errorf("%w: %s", ErrStorefrontNotFound, slug)
+		}
+		return nil, fmt.Errorf("failed to get storefront: %w", err)
+	}
+	if !storefront.IsActive || storefront.IsDeleted() || storefront.IsNoIndex() {
+		return nil, fmt.Errorf("%w: %s", ErrStorefrontNotFound, slug)
+	}
+
+	base, home, key := host, host+"/", "domain:"+host
+	if host == "" {
+		base = fmt.Sprintf("%s/storefronts/%s", s.config.BaseURL, url.PathEscape(storefront.Slug))
+		home, key = base, "storefront:"+storefront.Slug
+	}
+	storefrontID := storefront.ID
+
+	return &scope{
+		key:         key,
+		sitemapBase: base,
+		sources: []source{
+			staticSource(domain.SitemapURL{
+				Loc:        home,
+				LastMod:    &storefront.UpdatedAt,
+				ChangeFreq: domain.SitemapChangeDaily,
+				Priority:   1.0,
+			}),
+			{
+				count: func(ctx context.Context) (int, error) {
+					return s.repo.CountSitemapListings(ctx, &storefrontID)
+				},
+				load: func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error) {
+					items, err := s.repo.ListSitemapListings(ctx, &storefrontID, offset, limit)
+					return toURLs(items, err, func(item domain.SitemapItem) domain.SitemapURL {
+						return domain.SitemapURL{
+							Loc:        fmt.Sprintf("%s/products/%d", base, item.ID),
+							LastMod:    item.UpdatedAt,
+							ChangeFreq: domain.SitemapChangeDaily,
+							Priority:   0.8,
+						}
+					})
+				},
+			},
+		},
+	}, nil
+}
+
+// listingLink returns the marketplace URL of a listing: storefront products live under their
+// storefront, C2C listings under /listings by slug (id when the slug is missing)
+func (s *Service) listingLink(item domain.SitemapItem) string {
+	if item.StorefrontSlug != nil && *item.StorefrontSlug != "" {
+		return fmt.Sprintf("%s/storefronts/%s/products/%d", s.config.BaseURL, url.PathEscape(*item.StorefrontSlug), item.ID)
+	}
+	if item.Slug != "" {
+		return fmt.Sprintf("%s/listings/%s", s.config.BaseURL, url.PathEscape(item.Slug))
+	}
+	return fmt.Sprintf("%s/listings/%d", s.config.BaseURL, item.ID)
+}
+
+// staticSource is a source of a single fixed URL
+func staticSource(u domain.SitemapURL) source {
+	return source{
+		count: func(ctx context.Context) (int, error) { return 1, nil },
+		load: func(ctx context.Context, offset, limit int) ([]domain.SitemapURL, error) {
+			return []domain.SitemapURL{u}, nil
+		},
+	}
+}
+
+func toURLs(items []domain.SitemapItem, err error, convert func(domain.SitemapItem) domain.SitemapURL) ([]domain.SitemapURL, error) {
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]domain.SitemapURL, len(items))
+	for i, item := range items {
+		urls[i] = convert(item)
+	}
+	return urls, nil
+}
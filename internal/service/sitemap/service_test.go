@@ -0,0 +1,232 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeRepository is an in-memory Repository for sitemap tests
+type fakeRepository struct {
+	storefronts []domain.Storefront
+	domains     []domain.StorefrontDomain
+	categories  []domain.SitemapItem
+	listings    map[int64][]domain.SitemapItem // by storefront id, 0 = C2C
+}
+
+func (f *fakeRepository) GetStorefrontBySlug(ctx context.Context, slug string, includes *domain.Includes) (*domain.Storefront, error) {
+	for i := range f.storefronts {
+		if f.storefronts[i].Slug == slug {
+			return &f.storefronts[i], nil
+		}
+	}
+	return nil, errors.New("storefront not found")
+}
+
+func (f *fakeRepository) ResolveStorefrontDomain(ctx context.Context, host string) (*domain.StorefrontDomain, error) {
+	for i := range f.domains {
+		if f.domains[i].Domain == host && f.domains[i].IsVerified() {
+			return &f.domains[i], nil
+		}
+	}
+	return nil, errors.New("domain not found")
+}
+
+func (f *fakeRepository) CountSitemapCategories(ctx context.Context) (int, error) {
+	return len(f.categories), nil
+}
+
+func (f *fakeRepository) ListSitemapCategories(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error) {
+	return page(f.categories, offset, limit), nil
+}
+
+func (f *fakeRepository) CountSitemapStorefronts(ctx context.Context) (int, error) {
+	return len(f.storefrontItems()), nil
+}
+
+func (f *fakeRepository) ListSitemapStorefronts(ctx context.Context, offset, limit int) ([]domain.SitemapItem, error) {
+	return page(f.storefrontItems(), offset, limit), nil
+}
+
+func (f *fakeRepository) CountSitemapListings(ctx context.Context, storefrontID *int64) (int, error) {
+	return len(f.listingItems(storefrontID)), nil
+}
+
+func (f *fakeRepository) ListSitemapListings(ctx context.Context, storefrontID *int64, offset, limit int) ([]domain.SitemapItem, error) {
+	return page(f.listingItems(storefrontID), offset, limit), nil
+}
+
+func (f *fakeRepository) storefrontItems() []domain.SitemapItem {
+	var items []domain.SitemapItem
+	for _, sf := range f.storefronts {
+		if sf.IsActive && !sf.IsNoIndex() {
+			items = append(items, domain.SitemapItem{ID: sf.ID, Slug: sf.Slug})
+		}
+	}
+	return items
+}
+
+func (f *fakeRepository) listingItems(storefrontID *int64) []domain.SitemapItem {
+	if storefrontID != nil {
+		return f.listings[*storefrontID]
+	}
+	var items []domain.SitemapItem
+	items = append(items, f.listings[0]...)
+	for _, sf := range f.storefronts {
+		if sf.ID != 0 {
+			items = append(items, f.listings[sf.ID]...)
+		}
+	}
+	return items
+}
+
+func page(items []domain.SitemapItem, offset, limit int) []domain.SitemapItem {
+	if offset >= len(items) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+func strPtr(s string) *string { return &s }
+
+func newTestRepository() *fakeRepository {
+	verifiedAt := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	return &fakeRepository{
+		storefronts: []domain.Storefront{
+			{ID: 7, Slug: "tech-shop", IsActive: true},
+			{ID: 8, Slug: "hidden-shop", IsActive: true, SeoMeta: domain.JSONB(`{"noindex": true}`)},
+		},
+		domains: []domain.StorefrontDomain{
+			{ID: 1, StorefrontID: 7, Domain: "shop.example.rs", StorefrontSlug: "tech-shop", VerifiedAt: &verifiedAt},
+			{ID: 2, StorefrontID: 7, Domain: "pending.example.rs", StorefrontSlug: "tech-shop"},
+		},
+		categories: []domain.SitemapItem{{ID: 1, Slug: "electronics"}},
+		listings: map[int64][]domain.SitemapItem{
+			0: {{ID: 100, Slug: "old-bike"}, {ID: 101}},
+			7: {{ID: 200, StorefrontSlug: strPtr("tech-shop")}, {ID: 201, StorefrontSlug: strPtr("tech-shop")}},
+		},
+	}
+}
+
+func newTestService(repo Repository, perFile int) *Service {
+	return NewService(repo, nil, Config{BaseURL: "https://svetu.rs/", URLsPerFile: perFile}, zerolog.Nop())
+}
+
+func parseURLSet(t *testing.T, data []byte) []string {
+	t.Helper()
+	var set xmlURLSet
+	require.NoError(t, xml.Unmarshal(data, &set))
+	locs := make([]string, len(set.URLs))
+	for i, u := range set.URLs {
+		locs[i] = u.Loc
+	}
+	return locs
+}
+
+func TestHostSitemap_Marketplace(t *testing.T) {
+	svc := newTestService(newTestRepository(), 0)
+
+	data, err := svc.HostSitemap(context.Background(), "svetu.rs", 0)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data), `xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"`)
+	assert.Equal(t, []string{
+		"https://svetu.rs/",
+		"https://svetu.rs/categories/electronics",
+		"https://svetu.rs/storefronts/tech-shop",
+		"https://svetu.rs/listings/old-bike",
+		"https://svetu.rs/listings/101",
+		"https://svetu.rs/storefronts/tech-shop/products/200",
+		"https://svetu.rs/storefronts/tech-shop/products/201",
+	}, parseURLSet(t, data))
+}
+
+func TestHostSitemap_SplitsIntoIndex(t *testing.T) {
+	svc := newTestService(newTestRepository(), 3)
+	ctx := context.Background()
+
+	data, err := svc.HostSitemap(ctx, "www.svetu.rs", 0)
+	require.NoError(t, err)
+
+	var index xmlSitemapIndex
+	require.NoError(t, xml.Unmarshal(data, &index))
+	require.Len(t, index.Sitemaps, 3)
+	assert.Equal(t, "https://svetu.rs/sitemap-1.xml", index.Sitemaps[0].Loc)
+	assert.Equal(t, "https://svetu.rs/sitemap-3.xml", index.Sitemaps[2].Loc)
+
+	var all []string
+	for p := 1; p <= 3; p++ {
+		data, err := svc.HostSitemap(ctx, "svetu.rs", p)
+		require.NoError(t, err, fmt.Sprintf("page %d", p))
+		all = append(all, parseURLSet(t, data)...)
+	}
+	assert.Len(t, all, 7)
+	assert.Equal(t, "https://svetu.rs/storefronts/tech-shop", all[2])
+	assert.Equal(t, "https://svetu.rs/storefronts/tech-shop/products/201", all[6])
+
+	_, err = svc.HostSitemap(ctx, "svetu.rs", 4)
+	assert.ErrorIs(t, err, ErrPageNotFound)
+}
+
+func TestHostSitemap_CustomDomain(t *testing.T) {
+	svc := newTestService(newTestRepository(), 0)
+
+	data, err := svc.HostSitemap(context.Background(), "Shop.Example.rs:443", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://shop.example.rs/",
+		"https://shop.example.rs/products/200",
+		"https://shop.example.rs/products/201",
+	}, parseURLSet(t, data))
+
+	// Unverified domains fall back to the marketplace
+	data, err = svc.HostSitemap(context.Background(), "pending.example.rs", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "https://svetu.rs/", parseURLSet(t, data)[0])
+}
+
+func TestStorefrontSitemap(t *testing.T) {
+	svc := newTestService(newTestRepository(), 0)
+	ctx := context.Background()
+
+	data, err := svc.StorefrontSitemap(ctx, "tech-shop", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://svetu.rs/storefronts/tech-shop",
+		"https://svetu.rs/storefronts/tech-shop/products/200",
+		"https://svetu.rs/storefronts/tech-shop/products/201",
+	}, parseURLSet(t, data))
+
+	_, err = svc.StorefrontSitemap(ctx, "hidden-shop", 0)
+	assert.ErrorIs(t, err, ErrStorefrontNotFound)
+
+	_, err = svc.StorefrontSitemap(ctx, "missing", 0)
+	assert.ErrorIs(t, err, ErrStorefrontNotFound)
+}
+
+func TestRobots(t *testing.T) {
+	svc := newTestService(newTestRepository(), 0)
+	ctx := context.Background()
+
+	data, err := svc.Robots(ctx, "svetu.rs")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "User-agent: *\nDisallow: /api/\n")
+	assert.Contains(t, string(data), "Sitemap: https://svetu.rs/sitemap.xml\n")
+
+	data, err = svc.Robots(ctx, "shop.example.rs")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "Sitemap: https://shop.example.rs/sitemap.xml\n")
+}
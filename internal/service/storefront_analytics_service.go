@@ -36,6 +36,9 @@ type StorefrontAnalyticsRepository interface {
 type StorefrontAnalyticsService interface {
 	// GetStorefrontStats retrieves analytics for a storefront (owner or admin)
 	GetStorefrontStats(ctx context.Context, req *listingssvcv1.GetStorefrontStatsRequest) (*listingssvcv1.GetStorefrontStatsResponse, error)
+
+	// SetStorefrontAuthorizer lets staff with analytics.read view the stats
+	SetStorefrontAuthorizer(authorizer StorefrontAuthorizer)
 }
 
 // ============================================================================
@@ -59,9 +62,10 @@ const (
 
 // storefrontAnalyticsServiceImpl implements StorefrontAnalyticsService interface
 type storefrontAnalyticsServiceImpl struct {
-	repo   StorefrontAnalyticsRepository
-	cache  *StorefrontAnalyticsCache
-	logger zerolog.Logger
+	repo       StorefrontAnalyticsRepository
+	cache      *StorefrontAnalyticsCache
+	authorizer StorefrontAuthorizer // Optional: staff permissions
+	logger     zerolog.Logger
 }
 
 // StorefrontAnalyticsCache provides caching functionality for storefront analytics
@@ -95,6 +99,11 @@ func NewStorefrontAnalyticsService(
 // PUBLIC SERVICE METHODS
 // ============================================================================
 
+// SetStorefrontAuthorizer sets the authorizer used for staff access
+func (s *storefrontAnalyticsServiceImpl) SetStorefrontAuthorizer(authorizer StorefrontAuthorizer) {
+	s.authorizer = authorizer
+}
+
 // GetStorefrontStats retrieves analytics for a storefront with authorization
 func (s *storefrontAnalyticsServiceImpl) GetStorefrontStats(
 	ctx context.Context,
@@ -192,7 +201,7 @@ func (s *storefrontAnalyticsServiceImpl) GetStorefrontStats(
 // AUTHORIZATION
 // ============================================================================
 
-// authorizeStorefrontAccess checks if user is admin OR storefront owner
+// authorizeStorefrontAccess checks if user is admin, storefront owner or staff with analytics.read
 func (s *storefrontAnalyticsServiceImpl) authorizeStorefrontAccess(
 	ctx context.Context,
 	req *domain.StorefrontStatsRequest,
@@ -216,6 +225,20 @@ func (s *storefrontAnalyticsServiceImpl) authorizeStorefrontAccess(
 		return fmt.Errorf("%w: failed to verify storefront ownership", ErrInternal)
 	}
 
+	if req.UserID != ownerID && s.authorizer != nil {
+		allowed, err := s.authorizer.HasPermission(ctx, req.StorefrontID, req.UserID, domain.PermissionAnalyticsRead)
+		if err != nil {
+			return fmt.Errorf("%w: failed to verify staff permission", ErrInternal)
+		}
+		if allowed {
+			s.logger.Debug().
+				Int64("user_id", req.UserID).
+				Int64("storefront_id", req.StorefrontID).
+				Msg("staff access granted")
+			return nil
+		}
+	}
+
 	if req.UserID != ownerID {
 		s.logger.Warn().
 			Int64("user_id", req.UserID).
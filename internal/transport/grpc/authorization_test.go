@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveCaller(t *testing.T) {
+	server := &Server{logger: zerolog.Nop()}
+	gatewayCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user_id", "7", "is_admin", "true"))
+
+	userID, isAdmin, err := server.resolveCaller(contextWithUserID(42))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+	assert.False(t, isAdmin)
+
+	_, _, err = server.resolveCaller(gatewayCtx)
+	require.Error(t, err, "metadata set by clients is not trusted by default")
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+
+	server.SetTrustedMetadata(true)
+	userID, isAdmin, err = server.resolveCaller(gatewayCtx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), userID)
+	assert.True(t, isAdmin)
+
+	_, _, err = server.resolveCaller(context.Background())
+	assert.Error(t, err)
+}
@@ -29,6 +29,8 @@ func DomainToProtoListing(listing *domain.Listing) *listingspb.Listing {
 		Quantity:         listing.Quantity,
 		ViewsCount:       listing.ViewsCount,
 		FavoritesCount:   listing.FavoritesCount,
+		Rating:           listing.Rating,
+		ReviewsCount:     listing.ReviewsCount,
 		CreatedAt:        listing.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:        listing.UpdatedAt.Format(time.RFC3339),
 		IsDeleted:        listing.IsDeleted,
@@ -53,6 +55,16 @@ func DomainToProtoListing(listing *domain.Listing) *listingspb.Listing {
 		pbListing.PublishedAt = &publishedStr
 	}
 
+	if listing.ExpiresAt != nil {
+		expiresStr := listing.ExpiresAt.Format(time.RFC3339)
+		pbListing.ExpiresAt = &expiresStr
+	}
+
+	if listing.ScheduledAt != nil {
+		scheduledStr := listing.ScheduledAt.Format(time.RFC3339)
+		pbListing.ScheduledPublishAt = &scheduledStr
+	}
+
 	if listing.DeletedAt != nil {
 		deletedStr := listing.DeletedAt.Format(time.RFC3339)
 		pbListing.DeletedAt = &deletedStr
@@ -94,13 +106,16 @@ func DomainToProtoImage(img *domain.ListingImage) *listingspb.ListingImage {
 	}
 
 	pbImage := &listingspb.ListingImage{
-		Id:           img.ID,
-		ListingId:    img.ListingID,
-		Url:          img.URL,
-		DisplayOrder: img.DisplayOrder,
-		IsPrimary:    img.IsPrimary,
-		CreatedAt:    img.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    img.UpdatedAt.Format(time.RFC3339),
+		Id:               img.ID,
+		ListingId:        img.ListingID,
+		Url:              img.URL,
+		DisplayOrder:     img.DisplayOrder,
+		IsPrimary:        img.IsPrimary,
+		ProcessingStatus: img.ProcessingStatus,
+		ProcessingError:  img.ProcessingError,
+		VariantId:        img.VariantID,
+		CreatedAt:        img.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        img.UpdatedAt.Format(time.RFC3339),
 	}
 
 	if img.StoragePath != nil {
@@ -127,6 +142,20 @@ func DomainToProtoImage(img *domain.ListingImage) *listingspb.ListingImage {
 		pbImage.MimeType = img.MimeType
 	}
 
+	if img.BlurHash != nil {
+		pbImage.Blurhash = img.BlurHash
+	}
+
+	for _, rendition := range img.Renditions {
+		pbImage.Renditions = append(pbImage.Renditions, &listingspb.ImageRendition{
+			Width:       rendition.Width,
+			Height:      rendition.Height,
+			Url:         rendition.URL,
+			StoragePath: rendition.StoragePath,
+			FileSize:    rendition.FileSize,
+		})
+	}
+
 	return pbImage
 }
 
@@ -671,6 +700,11 @@ func ProductVariantToProto(v *domain.ProductVariant) *listingspb.ProductVariant
 		}
 	}
 
+	// Convert variant images
+	for _, img := range v.Images {
+		pbVariant.Images = append(pbVariant.Images, domainToProtoProductImage(img))
+	}
+
 	return pbVariant
 }
 
@@ -137,6 +137,9 @@ func ProtoToSearchFiltersRequest(req *searchv1.SearchWithFiltersRequest) *search
 		domainReq.Sort = ProtoToSortConfig(req.Sort)
 	}
 
+	// Searcher identity (search analytics); the user is taken from the token by the handler
+	domainReq.SessionID = req.SessionId
+
 	// Set defaults
 	if domainReq.Limit == 0 {
 		domainReq.Limit = 20
@@ -148,9 +151,13 @@ func ProtoToSearchFiltersRequest(req *searchv1.SearchWithFiltersRequest) *search
 // SearchFiltersResponseToProto converts domain SearchFiltersResponse to proto SearchWithFiltersResponse
 func SearchFiltersResponseToProto(resp *search.SearchFiltersResponse) *searchv1.SearchWithFiltersResponse {
 	protoResp := &searchv1.SearchWithFiltersResponse{
-		Total:  resp.Total,
-		TookMs: resp.TookMs,
-		Cached: resp.Cached,
+		Total:         resp.Total,
+		TookMs:        resp.TookMs,
+		Cached:        resp.Cached,
+		SearchQueryId: resp.SearchQueryID,
+
+		ExperimentId:      resp.ExperimentID,
+		ExperimentVariant: resp.ExperimentVariant,
 	}
 
 	// Convert listings (reuse existing converter logic)
@@ -217,6 +224,11 @@ func ProtoToSearchFilters(filters *searchv1.Filters) *search.SearchFilters {
 		domainFilters.StockStatus = &stockStatus
 	}
 
+	// Verified sellers
+	if filters.VerifiedSellersOnly != nil {
+		domainFilters.VerifiedSellersOnly = *filters.VerifiedSellersOnly
+	}
+
 	return domainFilters
 }
 
@@ -352,6 +364,8 @@ func ListingSearchResultToProto(listing *search.ListingSearchResult) *searchv1.L
 		Quantity:    listing.Quantity,
 		SourceType:  listing.SourceType,
 		StockStatus: listing.StockStatus,
+
+		StorefrontVerified: listing.StorefrontVerified,
 	}
 
 	// Add optional fields
@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -42,9 +44,12 @@ type Server struct {
 	chatService                service.ChatService
 	analyticsService           service.AnalyticsService
 	storefrontAnalyticsService service.StorefrontAnalyticsService
+	reviewService              *listings.ReviewService
 	minioClient                *minioclient.Client
 	metrics                    *metrics.Metrics
 	logger                     zerolog.Logger
+	trustMetadata              bool         // Caller metadata is set by a trusted gateway (see SetTrustedMetadata)
+	trustedProxies             []*net.IPNet // Proxies whose X-Forwarded-For is honoured (see SetTrustedProxies)
 }
 
 // NewServer creates a new gRPC server instance
@@ -58,6 +63,7 @@ func NewServer(
 	chatService service.ChatService,
 	analyticsService service.AnalyticsService,
 	storefrontAnalyticsService service.StorefrontAnalyticsService,
+	reviewService *listings.ReviewService,
 	minioClient *minioclient.Client,
 	m *metrics.Metrics,
 	logger zerolog.Logger,
@@ -72,6 +78,7 @@ func NewServer(
 		chatService:                chatService,
 		analyticsService:           analyticsService,
 		storefrontAnalyticsService: storefrontAnalyticsService,
+		reviewService:              reviewService,
 		minioClient:                minioClient,
 		metrics:                    m,
 		logger:                     logger.With().Str("component", "grpc_handler").Logger(),
@@ -138,6 +145,7 @@ func (s *Server) GetListing(ctx context.Context, req *listingspb.GetListingReque
 
 	// Convert to proto
 	pbListing := DomainToProtoListing(listing)
+	s.applyVacationAvailability(ctx, pbListing)
 
 	return &listingspb.GetListingResponse{
 		Listing: pbListing,
@@ -169,6 +177,9 @@ func (s *Server) CreateListing(ctx context.Context, req *listingspb.CreateListin
 		if contains(errMsg, "validation failed") {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if contains(errMsg, "duplicate listing") {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create listing: %v", err))
 	}
@@ -178,7 +189,8 @@ func (s *Server) CreateListing(ctx context.Context, req *listingspb.CreateListin
 
 	s.logger.Info().Int64("listing_id", listing.ID).Msg("listing created successfully")
 	return &listingspb.CreateListingResponse{
-		Listing: pbListing,
+		Listing:    pbListing,
+		Duplicates: DomainToProtoDuplicateReport(listing.Duplicates),
 	}, nil
 }
 
@@ -207,6 +219,12 @@ func (s *Server) UpdateListing(ctx context.Context, req *listingspb.UpdateListin
 		if contains(errMsg, "listing not found") || contains(errMsg, "sql: no rows in result set") {
 			return nil, status.Error(codes.NotFound, "listing not found")
 		}
+		if contains(errMsg, "invalid status transition") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if contains(errMsg, "duplicate listing") {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update listing: %v", err))
 	}
@@ -216,7 +234,44 @@ func (s *Server) UpdateListing(ctx context.Context, req *listingspb.UpdateListin
 
 	s.logger.Info().Int64("listing_id", listing.ID).Msg("listing updated successfully")
 	return &listingspb.UpdateListingResponse{
-		Listing: pbListing,
+		Listing:    pbListing,
+		Duplicates: DomainToProtoDuplicateReport(listing.Duplicates),
+	}, nil
+}
+
+// RenewListing extends the lifetime of a C2C listing
+func (s *Server) RenewListing(ctx context.Context, req *listingspb.RenewListingRequest) (*listingspb.RenewListingResponse, error) {
+	s.logger.Debug().Int64("listing_id", req.Id).Int64("user_id", req.UserId).Msg("RenewListing called")
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing ID must be greater than 0")
+	}
+
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user ID must be greater than 0")
+	}
+
+	listing, err := s.service.RenewListing(ctx, req.Id, req.UserId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.Id).Msg("failed to renew listing")
+
+		errMsg := err.Error()
+		if errMsg == "unauthorized: user does not own this listing" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if contains(errMsg, "listing not found") || contains(errMsg, "sql: no rows in result set") {
+			return nil, status.Error(codes.NotFound, "listing not found")
+		}
+		if contains(errMsg, "cannot be renewed") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to renew listing: %v", err))
+	}
+
+	s.logger.Info().Int64("listing_id", listing.ID).Msg("listing renewed successfully")
+	return &listingspb.RenewListingResponse{
+		Listing: DomainToProtoListing(listing),
 	}, nil
 }
 
@@ -321,6 +376,7 @@ func (s *Server) SearchListings(ctx context.Context, req *listingspb.SearchListi
 	for i, listing := range listings {
 		pbListings[i] = DomainToProtoListing(listing)
 	}
+	s.applyVacationAvailability(ctx, pbListings...)
 
 	s.logger.Debug().Int("count", len(listings)).Int32("total", total).Msg("search completed")
 	return &listingspb.SearchListingsResponse{
@@ -368,6 +424,7 @@ func (s *Server) GetSimilarListings(ctx context.Context, req *listingspb.GetSimi
 	for i, listing := range listings {
 		pbListings[i] = DomainToProtoListing(listing)
 	}
+	s.applyVacationAvailability(ctx, pbListings...)
 
 	s.logger.Debug().
 		Int64("listing_id", req.ListingId).
@@ -405,6 +462,7 @@ func (s *Server) ListListings(ctx context.Context, req *listingspb.ListListingsR
 	for i, listing := range listings {
 		pbListings[i] = DomainToProtoListing(listing)
 	}
+	s.applyVacationAvailability(ctx, pbListings...)
 
 	s.logger.Debug().Int("count", len(listings)).Int32("total", total).Msg("listings retrieved")
 	return &listingspb.ListListingsResponse{
@@ -493,11 +551,12 @@ func (s *Server) validateUpdateListingRequest(req *listingspb.UpdateListingReque
 
 	if req.Status != nil {
 		validStatuses := map[string]bool{
-			"draft":    true,
-			"active":   true,
-			"inactive": true,
-			"sold":     true,
-			"archived": true,
+			"draft":          true,
+			"pending_review": true,
+			"active":         true,
+			"inactive":       true,
+			"sold":           true,
+			"archived":       true,
 		}
 		status := *req.Status
 		if !validStatuses[status] {
@@ -674,8 +733,8 @@ func (s *Server) GetListingStats(
 	// Call service
 	response, err := s.analyticsService.GetListingStats(ctx, req, userID, isAdmin)
 	if err != nil {
-		// Check error type to determine appropriate gRPC status code
-		if contains(err.Error(), "permission") || contains(err.Error(), "unauthorized") {
+		switch {
+		case errors.Is(err, service.ErrUnauthorized):
 			s.logger.Warn().
 				Err(err).
 				Int64("user_id", userID).
@@ -683,14 +742,14 @@ func (s *Server) GetListingStats(
 				Int64("listing_id", req.GetListingId()).
 				Msg("permission denied for listing stats")
 			return nil, status.Error(codes.PermissionDenied, "access denied to this listing")
-		}
-
-		if contains(err.Error(), "invalid") || contains(err.Error(), "required") {
+		case errors.Is(err, service.ErrInvalidInput):
 			s.logger.Warn().
 				Err(err).
 				Int64("user_id", userID).
 				Msg("service validation error for listing stats")
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid input: %v", err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "listing not found")
 		}
 
 		s.logger.Error().
@@ -844,6 +903,177 @@ func (s *Server) GetTrendingStats(
 	return response, nil
 }
 
+// TrackEvents records a batch of client-side analytics events (public, optional auth)
+func (s *Server) TrackEvents(
+	ctx context.Context,
+	req *listingspb.TrackEventsRequest,
+) (*listingspb.TrackEventsResponse, error) {
+	// Callers authenticated by the JWT are attributed to their user, others to their session
+	response, err := s.analyticsService.TrackEvents(ctx, req, authenticatedUserID(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEventTrackingDisabled):
+			return nil, status.Error(codes.Unavailable, "event tracking is disabled")
+		case errors.Is(err, service.ErrEventBufferFull):
+			return nil, status.Error(codes.ResourceExhausted, "event buffer is full, retry later")
+		case errors.Is(err, service.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		s.logger.Error().Err(err).Int("events", len(req.Events)).Msg("failed to track events")
+		return nil, status.Error(codes.Internal, "failed to track events")
+	}
+
+	s.logger.Debug().
+		Int32("accepted", response.Accepted).
+		Int32("rejected", response.Rejected).
+		Int32("dropped", response.Dropped).
+		Msg("TrackEvents completed")
+
+	return response, nil
+}
+
+// GetStorefrontFunnel retrieves the purchase funnel of a storefront or product (owner, staff or admin)
+func (s *Server) GetStorefrontFunnel(
+	ctx context.Context,
+	req *listingspb.GetStorefrontFunnelRequest,
+) (*listingspb.GetStorefrontFunnelResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.GetStorefrontFunnel(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapStorefrontAnalyticsError(err, "GetStorefrontFunnel", userID, req.StorefrontId)
+	}
+
+	return response, nil
+}
+
+// GetBuyerCohorts retrieves weekly repeat-buyer cohorts of a storefront (owner, staff or admin)
+func (s *Server) GetBuyerCohorts(
+	ctx context.Context,
+	req *listingspb.GetBuyerCohortsRequest,
+) (*listingspb.GetBuyerCohortsResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.GetBuyerCohorts(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapStorefrontAnalyticsError(err, "GetBuyerCohorts", userID, req.StorefrontId)
+	}
+
+	return response, nil
+}
+
+// GetSearchQueryReport lists zero-result, low-result or top search queries (admin only)
+func (s *Server) GetSearchQueryReport(
+	ctx context.Context,
+	req *listingspb.GetSearchQueryReportRequest,
+) (*listingspb.GetSearchQueryReportResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.GetSearchQueryReport(ctx, req, userID, isAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrUnauthorized):
+			return nil, status.Error(codes.PermissionDenied, "admin access required")
+		}
+
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("failed to get search query report")
+		return nil, status.Error(codes.Internal, "failed to retrieve search query report")
+	}
+
+	return response, nil
+}
+
+// RequestReportExport queues a report export (storefront owner, staff or admin)
+func (s *Server) RequestReportExport(
+	ctx context.Context,
+	req *listingspb.RequestReportExportRequest,
+) (*listingspb.RequestReportExportResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.RequestReportExport(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapReportExportError(err, "RequestReportExport", userID)
+	}
+
+	return response, nil
+}
+
+// GetExportStatus returns a report export and its download URL once completed (requester or admin)
+func (s *Server) GetExportStatus(
+	ctx context.Context,
+	req *listingspb.GetExportStatusRequest,
+) (*listingspb.GetExportStatusResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.GetExportStatus(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapReportExportError(err, "GetExportStatus", userID)
+	}
+
+	return response, nil
+}
+
+// mapReportExportError maps report export service errors to gRPC status codes
+func (s *Server) mapReportExportError(err error, operation string, userID int64) error {
+	switch {
+	case errors.Is(err, service.ErrReportExportsDisabled):
+		return status.Error(codes.Unavailable, "report exports are disabled")
+	case errors.Is(err, service.ErrTooManyReportExports):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, "report export not found")
+	}
+
+	s.logger.Error().
+		Err(err).
+		Int64("user_id", userID).
+		Str("operation", operation).
+		Msg("report export request failed")
+	return status.Error(codes.Internal, "failed to process report export request")
+}
+
+// mapStorefrontAnalyticsError maps funnel and cohort service errors to gRPC status codes
+func (s *Server) mapStorefrontAnalyticsError(err error, operation string, userID, storefrontID int64) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, "access denied to this storefront's analytics")
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	s.logger.Error().
+		Err(err).
+		Int64("user_id", userID).
+		Int64("storefront_id", storefrontID).
+		Str("operation", operation).
+		Msg("storefront analytics request failed")
+	return status.Error(codes.Internal, "failed to retrieve storefront analytics")
+}
+
 // validateOverviewStatsRequest validates GetOverviewStatsRequest
 func (s *Server) validateOverviewStatsRequest(req *listingspb.GetOverviewStatsRequest) error {
 	if req == nil {
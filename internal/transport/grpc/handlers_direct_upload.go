@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
+	"github.com/sveturs/listings/internal/repository/minio"
+)
+
+const uploadURLTTL = 15 * time.Minute // Presigned PUT URL expiry
+
+// RequestImageUploadURLs returns presigned PUT URLs for uploading images directly to MinIO.
+// Objects are registered with ConfirmImageUpload once uploaded.
+func (s *Server) RequestImageUploadURLs(ctx context.Context, req *listingspb.RequestImageUploadURLsRequest) (*listingspb.RequestImageUploadURLsResponse, error) {
+	s.logger.Info().
+		Int64("listing_id", req.ListingId).
+		Int64("user_id", req.UserId).
+		Int("files", len(req.Files)).
+		Msg("RequestImageUploadURLs called")
+
+	if s.minioClient == nil {
+		return nil, status.Error(codes.Unavailable, "image storage is not configured")
+	}
+
+	// ============================================================================
+	// VALIDATION
+	// ============================================================================
+
+	if len(req.Files) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one file is required")
+	}
+	if len(req.Files) > maxFiles {
+		s.logger.Warn().Int("file_count", len(req.Files)).Msg("too many files")
+		return nil, status.Errorf(codes.InvalidArgument, "too many files: maximum %d allowed", maxFiles)
+	}
+
+	var totalSize int64
+	for _, file := range req.Files {
+		metadata := &listingspb.UploadImageMetadata{
+			ListingId:   req.ListingId,
+			UserId:      req.UserId,
+			Filename:    file.Filename,
+			ContentType: file.ContentType,
+			FileSize:    file.FileSize,
+		}
+		if err := s.validateImageMetadata(metadata); err != nil {
+			s.logger.Warn().Err(err).Str("filename", file.Filename).Msg("invalid file")
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", file.Filename, err)
+		}
+		if !imaging.ProcessableContentTypes[file.ContentType] {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: unsupported content_type %s (allowed: image/jpeg, image/png, image/gif)", file.Filename, file.ContentType)
+		}
+
+		totalSize += file.FileSize
+		if totalSize > maxTotalSize {
+			return nil, status.Errorf(codes.InvalidArgument, "total size exceeds %dMB limit", maxTotalSize/(1024*1024))
+		}
+	}
+
+	// ============================================================================
+	// AUTHORIZATION
+	// ============================================================================
+
+	if err := s.authorizeImageUpload(ctx, req.ListingId, req.UserId, req.StorefrontId); err != nil {
+		return nil, err
+	}
+
+	// ============================================================================
+	// PRESIGN
+	// ============================================================================
+
+	expiresAt := time.Now().Add(uploadURLTTL)
+	uploads := make([]*listingspb.PresignedImageUpload, 0, len(req.Files))
+
+	for _, file := range req.Files {
+		key := imaging.NewObjectKey(imaging.OwnerListings, req.ListingId, "", filepath.Ext(file.Filename))
+
+		url, err := s.minioClient.GetPresignedPutURL(ctx, key, uploadURLTTL)
+		if err != nil {
+			s.logger.Error().Err(err).Str("key", key).Msg("failed to presign upload")
+			return nil, status.Error(codes.Internal, "failed to generate upload URL")
+		}
+
+		uploads = append(uploads, &listingspb.PresignedImageUpload{
+			Filename:   file.Filename,
+			StorageKey: key,
+			UploadUrl:  url,
+			ExpiresAt:  timestamppb.New(expiresAt),
+		})
+	}
+
+	s.logger.Info().Int64("listing_id", req.ListingId).Int("uploads", len(uploads)).Msg("upload URLs generated")
+
+	return &listingspb.RequestImageUploadURLsResponse{Uploads: uploads}, nil
+}
+
+// ConfirmImageUpload verifies a directly uploaded object (size, content type and magic bytes)
+// and registers it as a pending image; the image worker generates thumbnail and renditions.
+// Images that fail processing for good stay listed as failed; the image GC reclaims the object.
+func (s *Server) ConfirmImageUpload(ctx context.Context, req *listingspb.ConfirmImageUploadRequest) (*listingspb.ImageResponse, error) {
+	s.logger.Info().
+		Int64("listing_id", req.ListingId).
+		Int64("user_id", req.UserId).
+		Str("storage_key", req.StorageKey).
+		Msg("ConfirmImageUpload called")
+
+	if s.minioClient == nil {
+		return nil, status.Error(codes.Unavailable, "image storage is not configured")
+	}
+
+	// ============================================================================
+	// VALIDATION
+	// ============================================================================
+
+	if req.ListingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing_id must be positive")
+	}
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id must be positive")
+	}
+	if !strings.HasPrefix(req.StorageKey, imaging.KeyPrefix(imaging.OwnerListings, req.ListingId)) || strings.Contains(req.StorageKey, "..") {
+		s.logger.Warn().Str("storage_key", req.StorageKey).Msg("storage key does not belong to listing")
+		return nil, status.Error(codes.InvalidArgument, "invalid storage_key")
+	}
+	if req.DisplayOrder < 0 {
+		return nil, status.Error(codes.InvalidArgument, "display_order must be non-negative")
+	}
+	if req.VariantId != nil && req.StorefrontId == nil {
+		return nil, status.Error(codes.InvalidArgument, "variant_id requires storefront_id")
+	}
+
+	// ============================================================================
+	// AUTHORIZATION
+	// ============================================================================
+
+	if err := s.authorizeImageUpload(ctx, req.ListingId, req.UserId, req.StorefrontId); err != nil {
+		return nil, err
+	}
+	if req.VariantId != nil {
+		if err := s.checkImageVariant(ctx, req.ListingId, *req.VariantId); err != nil {
+			return nil, err
+		}
+	}
+
+	// A key can only be registered once
+	images, err := s.service.GetImages(ctx, req.ListingId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.ListingId).Msg("failed to get images")
+		return nil, status.Error(codes.Internal, "failed to get listing images")
+	}
+	for _, image := range images {
+		if image.StoragePath != nil && *image.StoragePath == req.StorageKey {
+			return nil, status.Error(codes.AlreadyExists, "image already confirmed")
+		}
+	}
+
+	// ============================================================================
+	// VERIFY UPLOADED OBJECT
+	// ============================================================================
+
+	info, err := s.minioClient.StatImage(ctx, req.StorageKey)
+	if err != nil {
+		if errors.Is(err, minio.ErrObjectNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "object has not been uploaded")
+		}
+		s.logger.Error().Err(err).Str("storage_key", req.StorageKey).Msg("failed to stat uploaded object")
+		return nil, status.Error(codes.Internal, "failed to verify upload")
+	}
+
+	contentType, err := s.verifyUploadedImage(ctx, req.StorageKey, info)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("storage_key", req.StorageKey).Msg("uploaded object rejected")
+		// Rejected objects are not kept
+		_ = s.minioClient.DeleteImage(ctx, req.StorageKey)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upload: %v", err)
+	}
+
+	// ============================================================================
+	// REGISTER IMAGE
+	// ============================================================================
+
+	// No URL until the worker has replaced the original: it still carries its EXIF metadata
+	storageKey := req.StorageKey
+	fileSize := info.Size
+	saved, err := s.service.AddImage(ctx, &domain.ListingImage{
+		ListingID:        req.ListingId,
+		VariantID:        req.VariantId,
+		StoragePath:      &storageKey,
+		DisplayOrder:     req.DisplayOrder,
+		IsPrimary:        req.IsPrimary,
+		FileSize:         &fileSize,
+		MimeType:         &contentType,
+		ProcessingStatus: domain.ImageStatusPending,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.ListingId).Msg("failed to save image")
+		return nil, status.Error(codes.Internal, "failed to save image")
+	}
+
+	s.logger.Info().
+		Int64("image_id", saved.ID).
+		Int64("listing_id", req.ListingId).
+		Msg("direct upload confirmed and queued for processing")
+
+	return &listingspb.ImageResponse{Image: DomainToProtoImage(saved)}, nil
+}
+
+// verifyUploadedImage checks size, content type and magic bytes of an uploaded object
+// and returns its content type
+func (s *Server) verifyUploadedImage(ctx context.Context, key string, info *minio.ObjectInfo) (string, error) {
+	if info.Size <= 0 {
+		return "", fmt.Errorf("object is empty")
+	}
+	if info.Size > maxImageSize {
+		return "", fmt.Errorf("file size exceeds %dMB limit", maxImageSize/(1024*1024))
+	}
+	if !imaging.ProcessableContentTypes[info.ContentType] {
+		return "", fmt.Errorf("unsupported content type: %s", info.ContentType)
+	}
+
+	header, err := s.minioClient.ReadImageHeader(ctx, key, imaging.HeaderSize)
+	if err != nil {
+		return "", err
+	}
+
+	format := imaging.DetectFormat(header)
+	if format == "" {
+		return "", fmt.Errorf("content is not a supported image")
+	}
+	if imaging.FormatContentTypes[format] != info.ContentType {
+		return "", fmt.Errorf("content type %s does not match %s content", info.ContentType, format)
+	}
+
+	return info.ContentType, nil
+}
+
+// authorizeImageUpload checks that the user owns the listing or, when storefrontID is set,
+// that the product belongs to the storefront and the user holds products.write on it
+func (s *Server) authorizeImageUpload(ctx context.Context, listingID, userID int64, storefrontID *int64) error {
+	if listingID <= 0 {
+		return status.Error(codes.InvalidArgument, "listing_id must be positive")
+	}
+
+	if storefrontID != nil {
+		product, err := s.service.GetProduct(ctx, listingID, nil)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("product_id", listingID).Msg("product not found")
+			return status.Error(codes.NotFound, "product not found")
+		}
+		if product.StorefrontID != *storefrontID {
+			s.logger.Warn().
+				Int64("product_storefront_id", product.StorefrontID).
+				Int64("requested_storefront_id", *storefrontID).
+				Msg("product does not belong to storefront")
+			return status.Error(codes.PermissionDenied, "product does not belong to this storefront")
+		}
+		_, err = s.authorizeStorefront(ctx, *storefrontID, domain.PermissionProductsWrite)
+		return err
+	}
+
+	listing, err := s.service.GetListing(ctx, listingID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", listingID).Msg("listing not found")
+		return status.Error(codes.NotFound, "listing not found")
+	}
+	if listing.UserID != userID {
+		s.logger.Warn().
+			Int64("user_id", userID).
+			Int64("listing_user_id", listing.UserID).
+			Int64("listing_id", listingID).
+			Msg("user does not own listing")
+		return status.Error(codes.PermissionDenied, "you do not own this listing")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// ListDuplicateFlags returns listings flagged as possible duplicates (admin only)
+func (s *Server) ListDuplicateFlags(ctx context.Context, req *listingspb.ListDuplicateFlagsRequest) (*listingspb.ListDuplicateFlagsResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Msg("non-admin attempted to list duplicate flags")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	flags, total, err := s.service.ListDuplicateFlags(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list duplicate flags")
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list duplicate flags: %v", err))
+	}
+
+	resp := &listingspb.ListDuplicateFlagsResponse{
+		Flags: make([]*listingspb.DuplicateFlag, 0, len(flags)),
+		Total: total,
+	}
+	for _, flag := range flags {
+		resp.Flags = append(resp.Flags, &listingspb.DuplicateFlag{
+			ListingId:          flag.ListingID,
+			DuplicateListingId: flag.DuplicateListingID,
+			TextSimilarity:     flag.TextSimilarity,
+			ImageDistance:      intToInt32Ptr(flag.ImageDistance),
+			DetectedAt:         timestamppb.New(flag.DetectedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// DomainToProtoDuplicateReport converts a duplicate report (nil if no check was made)
+func DomainToProtoDuplicateReport(report *domain.DuplicateReport) *listingspb.DuplicateReport {
+	if report == nil {
+		return nil
+	}
+
+	pb := &listingspb.DuplicateReport{
+		Matches: make([]*listingspb.DuplicateMatch, 0, len(report.Matches)),
+		Action:  report.Action,
+	}
+	for _, match := range report.Matches {
+		pb.Matches = append(pb.Matches, &listingspb.DuplicateMatch{
+			ListingId:      match.ListingID,
+			UserId:         match.UserID,
+			Title:          match.Title,
+			Status:         match.Status,
+			TextSimilarity: match.TextSimilarity,
+			ImageDistance:  intToInt32Ptr(match.ImageDistance),
+			SameOwner:      match.SameOwner,
+		})
+	}
+	return pb
+}
+
+func intToInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
@@ -3,9 +3,13 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -56,6 +60,12 @@ func (s *Server) RecordInventoryMovement(ctx context.Context, req *listingspb.Re
 		return nil, status.Error(codes.InvalidArgument, "user ID must be greater than 0")
 	}
 
+	// Authorization: storefront owner or staff with inventory.adjust
+	userID, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionInventoryAdjust)
+	if err != nil {
+		return nil, err
+	}
+
 	// Prepare optional variant ID (0 means product-level stock update)
 	var variantID int64
 	if req.VariantId != nil {
@@ -83,7 +93,7 @@ func (s *Server) RecordInventoryMovement(ctx context.Context, req *listingspb.Re
 		req.Quantity,
 		reason,
 		notes,
-		req.UserId,
+		userID,
 	)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("failed to record inventory movement")
@@ -188,6 +198,12 @@ func (s *Server) BatchUpdateStock(ctx context.Context, req *listingspb.BatchUpda
 		}
 	}
 
+	// Authorization: storefront owner or staff with inventory.adjust
+	userID, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionInventoryAdjust)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert proto items to domain models
 	domainItems := make([]domain.StockUpdateItem, len(req.Items))
 	for i, item := range req.Items {
@@ -219,7 +235,7 @@ func (s *Server) BatchUpdateStock(ctx context.Context, req *listingspb.BatchUpda
 		req.StorefrontId,
 		domainItems,
 		reason,
-		req.UserId,
+		userID,
 	)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("failed to batch update stock")
@@ -281,6 +297,11 @@ func (s *Server) GetProductStats(ctx context.Context, req *listingspb.GetProduct
 		return nil, status.Error(codes.InvalidArgument, "storefront ID must be greater than 0")
 	}
 
+	// Authorization: storefront owner or staff with analytics.read
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionAnalyticsRead); err != nil {
+		return nil, err
+	}
+
 	// Call service to get product stats
 	stats, err := s.service.GetProductStats(ctx, req.StorefrontId)
 	if err != nil {
@@ -296,6 +317,8 @@ func (s *Server) GetProductStats(ctx context.Context, req *listingspb.GetProduct
 		LowStock:       stats.LowStock,
 		TotalValue:     stats.TotalValue,
 		TotalSold:      stats.TotalSold,
+		UniqueViews:    stats.UniqueViews,
+		TotalViews:     stats.TotalViews,
 	}
 
 	s.logger.Info().
@@ -318,8 +341,27 @@ func (s *Server) IncrementProductViews(ctx context.Context, req *listingspb.Incr
 		return nil, status.Error(codes.InvalidArgument, "product ID must be greater than 0")
 	}
 
-	// Call service to increment views
-	if err := s.service.IncrementProductViews(ctx, req.ProductId); err != nil {
+	if len(req.GetSessionId()) > domain.MaxAnalyticsSessionIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "session_id must be at most %d characters", domain.MaxAnalyticsSessionIDLength)
+	}
+
+	view := &domain.ProductView{
+		ProductID: req.ProductId,
+		SessionID: req.GetSessionId(),
+		IP:        s.clientIP(ctx),
+		UserAgent: req.GetUserAgent(),
+		UserID:    authenticatedUserID(ctx),
+	}
+	if view.UserAgent == "" {
+		view.UserAgent = metadataValue(ctx, "user-agent")
+	}
+
+	// Call service to count the view (deduplicated per viewer, crawlers ignored)
+	result, err := s.service.RecordProductView(ctx, view)
+	if err != nil {
+		if err.Error() == "products.not_found" {
+			return nil, status.Error(codes.NotFound, "products.not_found")
+		}
 		s.logger.Error().Err(err).Int64("product_id", req.ProductId).Msg("failed to increment product views")
 
 		// Record error metric
@@ -332,9 +374,109 @@ func (s *Server) IncrementProductViews(ctx context.Context, req *listingspb.Incr
 
 	// Record success metric
 	if s.metrics != nil {
-		s.metrics.RecordInventoryProductView(fmt.Sprintf("%d", req.ProductId))
+		s.metrics.RecordProductView(result)
+		if result != domain.ProductViewBot {
+			s.metrics.RecordInventoryProductView(fmt.Sprintf("%d", req.ProductId))
+		}
+	}
+
+	// Keep the viewer's recently viewed list (anonymous viewers without a session are skipped)
+	if result != domain.ProductViewBot {
+		s.service.RecordRecentlyViewed(ctx, view.UserID, view.SessionID, req.ProductId)
 	}
 
 	s.logger.Debug().Int64("product_id", req.ProductId).Msg("product views incremented successfully")
 	return &emptypb.Empty{}, nil
 }
+
+// SetTrustedProxies sets the proxies (IPs or CIDRs) whose X-Forwarded-For entries identify the
+// client. Without trusted proxies the client is the peer address.
+func (s *Server) SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy: %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %s: %w", proxy, err)
+		}
+		nets = append(nets, ipNet)
+	}
+	s.trustedProxies = nets
+	return nil
+}
+
+// clientIP returns the client address (empty when unknown). X-Forwarded-For is only honoured
+// when the peer is a trusted proxy: its entries are walked from the right, skipping trusted
+// proxies, and the first other address is the client. Entries further left are set by the
+// client and ignored.
+func (s *Server) clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		addr = p.Addr.String()
+	}
+	if !s.isTrustedProxy(addr) {
+		return addr
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		addr = hop
+		if !s.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return addr
+}
+
+// isTrustedProxy reports whether the address belongs to a trusted proxy
+func (s *Server) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range s.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataValue returns the first value of an incoming metadata key (empty when absent)
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -3,12 +3,16 @@ package grpc
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -1255,3 +1259,25 @@ func TestGetProductStats_EmptyStorefront(t *testing.T) {
 
 	mockService.AssertExpectations(t)
 }
+
+func TestClientIP(t *testing.T) {
+	server := &Server{logger: zerolog.Nop()}
+	request := func(peerAddr, xff string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerAddr), Port: 443}})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", xff))
+	}
+
+	// Without trusted proxies X-Forwarded-For is ignored
+	assert.Equal(t, "10.0.0.5", server.clientIP(request("10.0.0.5", "1.2.3.4")))
+
+	require.NoError(t, server.SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"}))
+
+	// The right-most untrusted entry is the client; entries the client prepended are ignored
+	assert.Equal(t, "203.0.113.7", server.clientIP(request("10.0.0.5", "1.2.3.4, 203.0.113.7")))
+	assert.Equal(t, "203.0.113.7", server.clientIP(request("10.0.0.5", "1.2.3.4, 203.0.113.7, 192.168.1.1")))
+
+	// Untrusted peers cannot choose their address
+	assert.Equal(t, "198.51.100.9", server.clientIP(request("198.51.100.9", "1.2.3.4")))
+
+	assert.Error(t, server.SetTrustedProxies([]string{"not-an-ip"}))
+}
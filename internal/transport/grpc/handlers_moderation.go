@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// SubmitListingForReview moves a draft listing to the moderation queue
+func (s *Server) SubmitListingForReview(ctx context.Context, req *listingspb.SubmitListingForReviewRequest) (*listingspb.SubmitListingForReviewResponse, error) {
+	s.logger.Debug().Int64("listing_id", req.Id).Int64("user_id", req.UserId).Msg("SubmitListingForReview called")
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing ID must be greater than 0")
+	}
+
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user ID must be greater than 0")
+	}
+
+	var publishAt *time.Time
+	if req.PublishAt != nil {
+		t := req.PublishAt.AsTime()
+		publishAt = &t
+	}
+
+	listing, err := s.service.SubmitListingForReview(ctx, req.Id, req.UserId, publishAt)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.Id).Msg("failed to submit listing for review")
+		return nil, moderationError(err, "failed to submit listing for review")
+	}
+
+	return &listingspb.SubmitListingForReviewResponse{
+		Listing: DomainToProtoListing(listing),
+	}, nil
+}
+
+// GetModerationQueue returns listings awaiting review (admin only)
+func (s *Server) GetModerationQueue(ctx context.Context, req *listingspb.GetModerationQueueRequest) (*listingspb.GetModerationQueueResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Msg("non-admin attempted to read moderation queue")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	listings, total, err := s.service.GetModerationQueue(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to get moderation queue")
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get moderation queue: %v", err))
+	}
+
+	resp := &listingspb.GetModerationQueueResponse{
+		Listings: make([]*listingspb.Listing, 0, len(listings)),
+		Total:    total,
+	}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, DomainToProtoListing(listing))
+	}
+
+	return resp, nil
+}
+
+// ModerateListing approves or rejects a listing awaiting review (admin only)
+func (s *Server) ModerateListing(ctx context.Context, req *listingspb.ModerateListingRequest) (*listingspb.ModerateListingResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Int64("listing_id", req.ListingId).Msg("non-admin attempted to moderate listing")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.ListingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing ID must be greater than 0")
+	}
+
+	listing, err := s.service.ModerateListing(ctx, &domain.ModerationDecision{
+		ListingID:   req.ListingId,
+		ModeratorID: userID,
+		Approve:     req.Approve,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.ListingId).Msg("failed to moderate listing")
+		return nil, moderationError(err, "failed to moderate listing")
+	}
+
+	s.logger.Info().
+		Int64("listing_id", listing.ID).
+		Int64("moderator_id", userID).
+		Str("status", listing.Status).
+		Msg("listing moderated")
+
+	return &listingspb.ModerateListingResponse{
+		Listing: DomainToProtoListing(listing),
+	}, nil
+}
+
+// moderationError maps workflow service errors to gRPC status codes
+func moderationError(err error, msg string) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "validation failed"):
+		return status.Error(codes.InvalidArgument, errMsg)
+	case strings.HasPrefix(errMsg, "unauthorized"):
+		return status.Error(codes.PermissionDenied, errMsg)
+	case strings.Contains(errMsg, "listing not found"), strings.Contains(errMsg, "sql: no rows in result set"):
+		return status.Error(codes.NotFound, "listing not found")
+	case strings.Contains(errMsg, "invalid status transition"), strings.Contains(errMsg, "status changed concurrently"):
+		return status.Error(codes.FailedPrecondition, errMsg)
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
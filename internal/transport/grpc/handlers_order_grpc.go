@@ -302,10 +302,15 @@ func (s *Server) CreateOrder(ctx context.Context, req *listingspb.CreateOrderReq
 	// Convert domain.Order to proto Order
 	pbOrder := domainOrderToProtoOrder(order)
 
-	return &listingspb.CreateOrderResponse{
+	resp := &listingspb.CreateOrderResponse{
 		Order:   pbOrder,
 		Message: "Order created successfully",
-	}, nil
+	}
+	if order.ShippingNotice != nil {
+		resp.Warnings = append(resp.Warnings, "Shipping is delayed. "+*order.ShippingNotice)
+	}
+
+	return resp, nil
 }
 
 // GetOrder retrieves a single order by ID
@@ -347,7 +352,8 @@ func (s *Server) GetOrder(ctx context.Context, req *listingspb.GetOrderRequest)
 
 // ListOrders retrieves orders with filters and pagination
 // Admin: can see all orders
-// User: can only see own orders
+// User: can only see own orders (user_id defaults to the caller)
+// Seller: storefront orders (any request with storefront_id) require orders.read
 func (s *Server) ListOrders(ctx context.Context, req *listingspb.ListOrdersRequest) (*listingspb.ListOrdersResponse, error) {
 	s.logger.Debug().
 		Interface("user_id", req.UserId).
@@ -356,6 +362,28 @@ func (s *Server) ListOrders(ctx context.Context, req *listingspb.ListOrdersReque
 		Int32("page_size", req.PageSize).
 		Msg("ListOrders called")
 
+	if req.StorefrontId != nil {
+		// Storefront order list (seller view), optionally narrowed to one buyer
+		if _, err := s.authorizeStorefront(ctx, *req.StorefrontId, domain.PermissionOrdersRead); err != nil {
+			return nil, err
+		}
+	} else {
+		callerID, isAdmin, err := s.resolveCaller(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if req.UserId == nil && !isAdmin {
+			req.UserId = &callerID
+		}
+		if req.UserId != nil && *req.UserId != callerID && !isAdmin {
+			s.logger.Warn().
+				Int64("caller_id", callerID).
+				Int64("requested_user_id", *req.UserId).
+				Msg("unauthorized order list attempt")
+			return nil, status.Error(codes.PermissionDenied, "you can only list your own orders")
+		}
+	}
+
 	// Validate pagination
 	page := req.Page
 	if page <= 0 {
@@ -483,13 +511,20 @@ func (s *Server) UpdateOrderStatus(ctx context.Context, req *listingspb.UpdateOr
 	}, nil
 }
 
-// GetOrderStats retrieves order statistics (admin)
+// GetOrderStats retrieves order statistics (admin, or storefront owner/staff with analytics.read)
 // Returns: aggregated stats, status breakdown, daily trends
 func (s *Server) GetOrderStats(ctx context.Context, req *listingspb.GetOrderStatsRequest) (*listingspb.GetOrderStatsResponse, error) {
 	s.logger.Debug().
 		Interface("storefront_id", req.StorefrontId).
 		Msg("GetOrderStats called")
 
+	// Storefront stats require analytics.read
+	if req.StorefrontId != nil {
+		if _, err := s.authorizeStorefront(ctx, *req.StorefrontId, domain.PermissionAnalyticsRead); err != nil {
+			return nil, err
+		}
+	}
+
 	// Call service layer
 	stats, err := s.orderService.GetOrderStats(ctx, nil, req.StorefrontId)
 	if err != nil {
@@ -921,7 +956,7 @@ func mapToProtoStruct(m map[string]interface{}) *structpb.Struct {
 
 // AcceptOrder handles seller accepting an order
 // Flow: confirmed -> accepted
-// Validates: order status, seller is storefront owner
+// Validates: order status, seller is storefront owner or staff with orders.fulfill
 func (s *Server) AcceptOrder(ctx context.Context, req *listingspb.AcceptOrderRequest) (*listingspb.AcceptOrderResponse, error) {
 	s.logger.Info().
 		Int64("order_id", req.OrderId).
@@ -959,7 +994,7 @@ func (s *Server) AcceptOrder(ctx context.Context, req *listingspb.AcceptOrderReq
 
 // CreateOrderShipment creates shipment via Delivery Service
 // Flow: accepted -> processing
-// Validates: order status, seller is storefront owner, package info
+// Validates: order status, seller is storefront owner or staff with orders.fulfill, package info
 func (s *Server) CreateOrderShipment(ctx context.Context, req *listingspb.CreateOrderShipmentRequest) (*listingspb.CreateOrderShipmentResponse, error) {
 	s.logger.Info().
 		Int64("order_id", req.OrderId).
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// GetPriceHistory retrieves price changes of a listing/product (optionally a single variant)
+func (s *Server) GetPriceHistory(ctx context.Context, req *listingspb.GetPriceHistoryRequest) (*listingspb.GetPriceHistoryResponse, error) {
+	s.logger.Debug().Int64("listing_id", req.ListingId).Msg("GetPriceHistory called")
+
+	if req.ListingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing ID must be greater than 0")
+	}
+
+	filter := &domain.PriceHistoryFilter{
+		ListingID: req.ListingId,
+		VariantID: req.VariantId,
+		Limit:     int(req.Limit),
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	entries, err := s.service.GetPriceHistory(ctx, filter)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation failed") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Int64("listing_id", req.ListingId).Msg("failed to get price history")
+		return nil, status.Error(codes.Internal, "failed to get price history")
+	}
+
+	resp := &listingspb.GetPriceHistoryResponse{
+		Entries: make([]*listingspb.PriceHistoryEntry, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &listingspb.PriceHistoryEntry{
+			Id:        e.ID,
+			ListingId: e.ListingID,
+			VariantId: e.VariantID,
+			OldPrice:  e.OldPrice,
+			NewPrice:  e.NewPrice,
+			Currency:  e.Currency,
+			ChangedAt: timestamppb.New(e.ChangedAt),
+		})
+
+		if resp.LowestPrice == nil || e.NewPrice < *resp.LowestPrice {
+			price := e.NewPrice
+			resp.LowestPrice = &price
+		}
+		if resp.HighestPrice == nil || e.NewPrice > *resp.HighestPrice {
+			price := e.NewPrice
+			resp.HighestPrice = &price
+		}
+	}
+
+	s.logger.Debug().Int("count", len(resp.Entries)).Msg("price history retrieved")
+	return resp, nil
+}
@@ -40,7 +40,7 @@ func (s *Server) AddProductImage(ctx context.Context, req *listingspb.AddProduct
 	}
 
 	// ============================================================================
-	// AUTHORIZATION: Verify product belongs to storefront
+	// AUTHORIZATION: Verify product belongs to storefront and caller holds products.write
 	// ============================================================================
 
 	product, err := s.service.GetProduct(ctx, req.ProductId, nil)
@@ -57,6 +57,16 @@ func (s *Server) AddProductImage(ctx context.Context, req *listingspb.AddProduct
 		return nil, status.Error(codes.PermissionDenied, "product does not belong to this storefront")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
+	if req.VariantId != nil {
+		if err := s.checkImageVariant(ctx, req.ProductId, *req.VariantId); err != nil {
+			return nil, err
+		}
+	}
+
 	// ============================================================================
 	// CREATE IMAGE
 	// ============================================================================
@@ -64,6 +74,7 @@ func (s *Server) AddProductImage(ctx context.Context, req *listingspb.AddProduct
 	productID := req.ProductId
 	image := &domain.ProductImage{
 		ProductID:    &productID,
+		VariantID:    req.VariantId,
 		URL:          req.Url,
 		DisplayOrder: req.DisplayOrder,
 		IsPrimary:    req.IsPrimary,
@@ -160,7 +171,7 @@ func (s *Server) DeleteProductImage(ctx context.Context, req *listingspb.DeleteP
 	}
 
 	// ============================================================================
-	// AUTHORIZATION: Verify product belongs to storefront
+	// AUTHORIZATION: Verify product belongs to storefront and caller holds products.write
 	// ============================================================================
 
 	product, err := s.service.GetProduct(ctx, req.ProductId, nil)
@@ -177,6 +188,10 @@ func (s *Server) DeleteProductImage(ctx context.Context, req *listingspb.DeleteP
 		return nil, status.Error(codes.PermissionDenied, "product does not belong to this storefront")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// ============================================================================
 	// GET IMAGE: Verify it belongs to this product
 	// ============================================================================
@@ -260,7 +275,7 @@ func (s *Server) ReorderProductImages(ctx context.Context, req *listingspb.Reord
 	}
 
 	// ============================================================================
-	// AUTHORIZATION: Verify product belongs to storefront
+	// AUTHORIZATION: Verify product belongs to storefront and caller holds products.write
 	// ============================================================================
 
 	product, err := s.service.GetProduct(ctx, req.ProductId, nil)
@@ -277,6 +292,10 @@ func (s *Server) ReorderProductImages(ctx context.Context, req *listingspb.Reord
 		return nil, status.Error(codes.PermissionDenied, "product does not belong to this storefront")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// ============================================================================
 	// VERIFY ALL IMAGE IDs BELONG TO THIS PRODUCT
 	// ============================================================================
@@ -326,6 +345,20 @@ func (s *Server) ReorderProductImages(ctx context.Context, req *listingspb.Reord
 	}, nil
 }
 
+// checkImageVariant verifies that a variant an image is attached to belongs to the product
+func (s *Server) checkImageVariant(ctx context.Context, productID, variantID int64) error {
+	if variantID <= 0 {
+		return status.Error(codes.InvalidArgument, "variant_id must be positive")
+	}
+
+	if _, err := s.service.GetVariant(ctx, variantID, &productID); err != nil {
+		s.logger.Warn().Err(err).Int64("product_id", productID).Int64("variant_id", variantID).Msg("variant not found for product")
+		return status.Error(codes.NotFound, "variant not found for this product")
+	}
+
+	return nil
+}
+
 // domainToProtoProductImage converts domain.ProductImage to proto ProductImage
 func domainToProtoProductImage(img *domain.ProductImage) *listingspb.ProductImage {
 	if img == nil {
@@ -362,6 +395,9 @@ func domainToProtoProductImage(img *domain.ProductImage) *listingspb.ProductImag
 	if img.MimeType != nil {
 		pb.MimeType = img.MimeType
 	}
+	if img.VariantID != nil {
+		pb.VariantId = img.VariantID
+	}
 
 	return pb
 }
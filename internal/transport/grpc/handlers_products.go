@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
 )
 
 // GetProduct retrieves a single product by ID
@@ -45,6 +46,7 @@ func (s *Server) GetProduct(ctx context.Context, req *listingspb.GetProductReque
 
 	// Convert to proto
 	protoProduct := ProductToProto(product)
+	s.applyProductVacationAvailability(ctx, protoProduct)
 
 	return &listingspb.ProductResponse{Product: protoProduct}, nil
 }
@@ -80,6 +82,7 @@ func (s *Server) GetProductsBySKUs(ctx context.Context, req *listingspb.GetProdu
 	for _, p := range products {
 		protoProducts = append(protoProducts, ProductToProto(p))
 	}
+	s.applyProductVacationAvailability(ctx, protoProducts...)
 
 	s.logger.Debug().Int("found_count", len(products)).Msg("products retrieved by SKUs")
 	return &listingspb.ProductsResponse{
@@ -125,6 +128,7 @@ func (s *Server) GetProductsByIDs(ctx context.Context, req *listingspb.GetProduc
 	for _, p := range products {
 		protoProducts = append(protoProducts, ProductToProto(p))
 	}
+	s.applyProductVacationAvailability(ctx, protoProducts...)
 
 	s.logger.Debug().Int("found_count", len(products)).Msg("products retrieved by IDs")
 	return &listingspb.ProductsResponse{
@@ -168,6 +172,7 @@ func (s *Server) ListProducts(ctx context.Context, req *listingspb.ListProductsR
 	for _, p := range products {
 		protoProducts = append(protoProducts, ProductToProto(p))
 	}
+	s.applyProductVacationAvailability(ctx, protoProducts...)
 
 	s.logger.Debug().Int("count", len(products)).Int("total", totalCount).Msg("products listed")
 	return &listingspb.ProductsResponse{
@@ -273,6 +278,11 @@ func (s *Server) CreateProduct(ctx context.Context, req *listingspb.CreateProduc
 		return nil, status.Error(codes.InvalidArgument, "stock quantity must be non-negative")
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain input
 	input := ProtoToCreateProductInput(req)
 
@@ -330,6 +340,11 @@ func (s *Server) BulkCreateProducts(ctx context.Context, req *listingspb.BulkCre
 		}
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain inputs
 	inputs := ProtoToBulkProductInputs(req.Products, req.StorefrontId)
 
@@ -435,6 +450,11 @@ func (s *Server) UpdateProduct(ctx context.Context, req *listingspb.UpdateProduc
 		return nil, status.Error(codes.InvalidArgument, "price must be non-negative")
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain input
 	input := ProtoToUpdateProductInput(req)
 
@@ -480,6 +500,11 @@ func (s *Server) DeleteProduct(ctx context.Context, req *listingspb.DeleteProduc
 		return nil, status.Error(codes.InvalidArgument, "storefront ID must be greater than 0")
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Delete product via service
 	variantsDeleted, err := s.service.DeleteProduct(ctx, req.ProductId, req.StorefrontId, req.HardDelete)
 	if err != nil {
@@ -530,6 +555,11 @@ func (s *Server) CreateProductVariant(ctx context.Context, req *listingspb.Creat
 		return nil, status.Error(codes.InvalidArgument, "stock quantity must be non-negative")
 	}
 
+	// Authorization: owner or staff with products.write on the product's storefront
+	if _, err := s.authorizeProduct(ctx, req.ProductId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain input
 	input := ProtoToCreateVariantInput(req)
 
@@ -580,6 +610,11 @@ func (s *Server) UpdateProductVariant(ctx context.Context, req *listingspb.Updat
 		return nil, status.Error(codes.InvalidArgument, "product ID must be greater than 0")
 	}
 
+	// Authorization: owner or staff with products.write on the product's storefront
+	if _, err := s.authorizeProduct(ctx, req.ProductId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain input
 	input := ProtoToUpdateVariantInput(req)
 
@@ -630,6 +665,11 @@ func (s *Server) DeleteProductVariant(ctx context.Context, req *listingspb.Delet
 		return nil, status.Error(codes.InvalidArgument, "product ID must be greater than 0")
 	}
 
+	// Authorization: owner or staff with products.write on the product's storefront
+	if _, err := s.authorizeProduct(ctx, req.ProductId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Delete variant via service
 	err := s.service.DeleteProductVariant(ctx, req.VariantId, req.ProductId)
 	if err != nil {
@@ -699,6 +739,11 @@ func (s *Server) BulkCreateProductVariants(ctx context.Context, req *listingspb.
 		return nil, status.Error(codes.InvalidArgument, "variants.multiple_defaults")
 	}
 
+	// Authorization: owner or staff with products.write on the product's storefront
+	if _, err := s.authorizeProduct(ctx, req.ProductId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain inputs
 	inputs := ProtoToBulkVariantInputs(req.ProductId, req.Variants)
 
@@ -788,6 +833,11 @@ func (s *Server) BulkUpdateProducts(ctx context.Context, req *listingspb.BulkUpd
 		}
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Convert proto to domain inputs
 	updates := ProtoToBulkUpdateInputs(req.Updates)
 
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
 	"github.com/sveturs/listings/internal/timeout"
 )
 
@@ -55,6 +56,11 @@ func (s *Server) BulkDeleteProducts(ctx context.Context, req *listingspb.BulkDel
 		}
 	}
 
+	// Authorization: storefront owner or staff with products.write
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+
 	// Call service method
 	successCount, failedCount, variantsDeleted, errorMap, err := s.service.BulkDeleteProducts(ctx, req.StorefrontId, req.ProductIds, req.HardDelete)
 	if err != nil {
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// GetRecentlyViewed returns the caller's recently viewed listings
+func (s *Server) GetRecentlyViewed(ctx context.Context, req *listingspb.GetRecentlyViewedRequest) (*listingspb.GetRecentlyViewedResponse, error) {
+	userID, err := s.resolveViewer(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	viewed, err := s.service.GetRecentlyViewed(ctx, userID, req.GetSessionId(), req.GetLimit())
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to get recently viewed listings")
+		return nil, status.Error(codes.Internal, "failed to get recently viewed listings")
+	}
+
+	items := make([]*listingspb.RecentlyViewedListing, len(viewed))
+	listings := make([]*listingspb.Listing, len(viewed))
+	for i, v := range viewed {
+		listings[i] = DomainToProtoListing(v.Listing)
+		items[i] = &listingspb.RecentlyViewedListing{
+			Listing:  listings[i],
+			ViewedAt: timestamppb.New(v.ViewedAt),
+		}
+	}
+	s.applyVacationAvailability(ctx, listings...)
+
+	return &listingspb.GetRecentlyViewedResponse{Items: items}, nil
+}
+
+// ClearRecentlyViewed removes one listing or the whole recently viewed history of the caller
+func (s *Server) ClearRecentlyViewed(ctx context.Context, req *listingspb.ClearRecentlyViewedRequest) (*emptypb.Empty, error) {
+	userID, err := s.resolveViewer(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	if req.ListingId != nil && *req.ListingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing_id must be positive")
+	}
+
+	if err := s.service.ClearRecentlyViewed(ctx, userID, req.GetSessionId(), req.ListingId); err != nil {
+		s.logger.Error().Err(err).Msg("failed to clear recently viewed listings")
+		return nil, status.Error(codes.Internal, "failed to clear recently viewed listings")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// resolveViewer returns the caller authenticated by the JWT (nil for anonymous callers, who
+// must send a session_id instead)
+func (s *Server) resolveViewer(ctx context.Context, sessionID string) (*int64, error) {
+	if len(sessionID) > domain.MaxAnalyticsSessionIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "session_id must be at most %d characters", domain.MaxAnalyticsSessionIDLength)
+	}
+	if userID := authenticatedUserID(ctx); userID != nil {
+		return userID, nil
+	}
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required for anonymous callers")
+	}
+	return nil, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// GetRecommendationsForUser recommends listings from the caller's history (users
+// authenticated by the JWT by user, anonymous callers by session_id)
+func (s *Server) GetRecommendationsForUser(ctx context.Context, req *listingspb.GetRecommendationsForUserRequest) (*listingspb.GetRecommendationsForUserResponse, error) {
+	userID := authenticatedUserID(ctx)
+
+	sessionID := req.GetSessionId()
+	if userID == nil && len(sessionID) > domain.MaxAnalyticsSessionIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "session_id must be at most %d characters", domain.MaxAnalyticsSessionIDLength)
+	}
+
+	recommended, err := s.service.GetRecommendationsForUser(ctx, userID, sessionID, req.GetLimit())
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to get user recommendations")
+		// Graceful degradation: return empty array on error
+		return &listingspb.GetRecommendationsForUserResponse{
+			Recommendations: []*listingspb.RecommendedListing{},
+		}, nil
+	}
+
+	return &listingspb.GetRecommendationsForUserResponse{
+		Recommendations: s.recommendedListingsToProto(ctx, recommended),
+	}, nil
+}
+
+// GetFrequentlyBoughtTogether recommends listings bought together with a listing
+func (s *Server) GetFrequentlyBoughtTogether(ctx context.Context, req *listingspb.GetFrequentlyBoughtTogetherRequest) (*listingspb.GetFrequentlyBoughtTogetherResponse, error) {
+	if req.ListingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "listing_id must be positive")
+	}
+
+	recommended, err := s.service.GetFrequentlyBoughtTogether(ctx, req.ListingId, req.GetLimit())
+	if err != nil {
+		s.logger.Error().Err(err).Int64("listing_id", req.ListingId).Msg("failed to get frequently bought together")
+		// Graceful degradation: return empty array on error
+		return &listingspb.GetFrequentlyBoughtTogetherResponse{
+			Recommendations: []*listingspb.RecommendedListing{},
+		}, nil
+	}
+
+	return &listingspb.GetFrequentlyBoughtTogetherResponse{
+		Recommendations: s.recommendedListingsToProto(ctx, recommended),
+	}, nil
+}
+
+// recommendationSources maps domain recommendation sources to proto
+var recommendationSources = map[string]listingspb.RecommendationSource{
+	domain.RecommendationSourcePersonalized:   listingspb.RecommendationSource_RECOMMENDATION_SOURCE_PERSONALIZED,
+	domain.RecommendationSourceBoughtTogether: listingspb.RecommendationSource_RECOMMENDATION_SOURCE_BOUGHT_TOGETHER,
+	domain.RecommendationSourceAlsoEngaged:    listingspb.RecommendationSource_RECOMMENDATION_SOURCE_ALSO_ENGAGED,
+	domain.RecommendationSourceTrending:       listingspb.RecommendationSource_RECOMMENDATION_SOURCE_TRENDING,
+}
+
+// recommendedListingsToProto converts recommended listings to proto with their availability
+func (s *Server) recommendedListingsToProto(ctx context.Context, recommended []*domain.RecommendedListing) []*listingspb.RecommendedListing {
+	pb := make([]*listingspb.RecommendedListing, len(recommended))
+	listings := make([]*listingspb.Listing, len(recommended))
+	for i, r := range recommended {
+		listings[i] = DomainToProtoListing(r.Listing)
+		pb[i] = &listingspb.RecommendedListing{
+			Listing: listings[i],
+			Source:  recommendationSources[r.Source],
+			Score:   r.Score,
+		}
+	}
+	s.applyVacationAvailability(ctx, listings...)
+	return pb
+}
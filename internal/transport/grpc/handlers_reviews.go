@@ -0,0 +1,374 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// CreateReview rates a storefront product or storefront
+func (s *Server) CreateReview(ctx context.Context, req *listingspb.CreateReviewRequest) (*listingspb.Review, error) {
+	s.logger.Info().
+		Str("subject_type", req.SubjectType.String()).
+		Int64("subject_id", req.SubjectId).
+		Msg("CreateReview called")
+
+	userID, err := authenticatedCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.CreateReview(ctx, userID, &domain.ReviewInput{
+		SubjectType: mapProtoReviewSubjectToDomain(req.SubjectType),
+		SubjectID:   req.SubjectId,
+		Rating:      req.Rating,
+		Title:       req.Title,
+		Body:        req.Body,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("subject_id", req.SubjectId).Msg("failed to create review")
+		return nil, reviewError(err, "failed to create review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// GetReview retrieves a review
+func (s *Server) GetReview(ctx context.Context, req *listingspb.GetReviewRequest) (*listingspb.Review, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	// Anonymous callers can read published reviews; hidden reviews only by their author
+	var userID int64
+	if id := authenticatedUserID(ctx); id != nil {
+		userID = *id
+	}
+
+	review, err := s.reviewService.GetReview(ctx, req.Id, userID)
+	if err != nil {
+		return nil, reviewError(err, "failed to get review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// ListReviews lists the published reviews of a subject with its rating summary
+func (s *Server) ListReviews(ctx context.Context, req *listingspb.ListReviewsRequest) (*listingspb.ListReviewsResponse, error) {
+	s.logger.Debug().
+		Str("subject_type", req.SubjectType.String()).
+		Int64("subject_id", req.SubjectId).
+		Msg("ListReviews called")
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	reviews, total, summary, err := s.reviewService.ListReviews(ctx, &domain.ReviewFilter{
+		SubjectType:  mapProtoReviewSubjectToDomain(req.SubjectType),
+		SubjectID:    req.SubjectId,
+		Rating:       req.Rating,
+		VerifiedOnly: req.VerifiedOnly,
+		Sort:         req.Sort,
+		Limit:        int(req.Limit),
+		Offset:       int(req.Offset),
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("subject_id", req.SubjectId).Msg("failed to list reviews")
+		return nil, reviewError(err, "failed to list reviews")
+	}
+
+	resp := &listingspb.ListReviewsResponse{
+		Reviews: make([]*listingspb.Review, 0, len(reviews)),
+		Total:   total,
+		Summary: mapDomainReviewSummaryToProto(summary),
+	}
+	for i := range reviews {
+		resp.Reviews = append(resp.Reviews, mapDomainReviewToProto(&reviews[i]))
+	}
+
+	return resp, nil
+}
+
+// UpdateReview changes the rating or text of the caller's review
+func (s *Server) UpdateReview(ctx context.Context, req *listingspb.UpdateReviewRequest) (*listingspb.Review, error) {
+	s.logger.Info().Int64("review_id", req.Id).Msg("UpdateReview called")
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	userID, err := authenticatedCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.UpdateReview(ctx, userID, req.Id, &domain.ReviewUpdate{
+		Rating: req.Rating,
+		Title:  req.Title,
+		Body:   req.Body,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.Id).Msg("failed to update review")
+		return nil, reviewError(err, "failed to update review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// DeleteReview deletes the caller's review (admins can delete any review)
+func (s *Server) DeleteReview(ctx context.Context, req *listingspb.DeleteReviewRequest) (*listingspb.DeleteReviewResponse, error) {
+	s.logger.Info().Int64("review_id", req.Id).Msg("DeleteReview called")
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewService.DeleteReview(ctx, userID, req.Id, isAdmin); err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.Id).Msg("failed to delete review")
+		return nil, reviewError(err, "failed to delete review")
+	}
+
+	return &listingspb.DeleteReviewResponse{Success: true}, nil
+}
+
+// GetReviewSummary returns the average rating and star distribution of a subject
+func (s *Server) GetReviewSummary(ctx context.Context, req *listingspb.GetReviewSummaryRequest) (*listingspb.ReviewSummary, error) {
+	if req.SubjectId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "subject ID must be greater than 0")
+	}
+
+	summary, err := s.reviewService.GetReviewSummary(ctx, mapProtoReviewSubjectToDomain(req.SubjectType), req.SubjectId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("subject_id", req.SubjectId).Msg("failed to get review summary")
+		return nil, reviewError(err, "failed to get review summary")
+	}
+
+	return mapDomainReviewSummaryToProto(summary), nil
+}
+
+// ReplyToReview sets the seller reply of a review (requires reviews.reply on the storefront)
+func (s *Server) ReplyToReview(ctx context.Context, req *listingspb.ReplyToReviewRequest) (*listingspb.Review, error) {
+	s.logger.Info().Int64("review_id", req.ReviewId).Msg("ReplyToReview called")
+
+	if req.ReviewId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.ReplyToReview(ctx, userID, req.ReviewId, req.Reply)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.ReviewId).Msg("failed to reply to review")
+		return nil, reviewError(err, "failed to reply to review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// VoteReview records whether the caller found a review helpful
+func (s *Server) VoteReview(ctx context.Context, req *listingspb.VoteReviewRequest) (*listingspb.Review, error) {
+	if req.ReviewId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	userID, err := authenticatedCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.VoteReview(ctx, userID, req.ReviewId, req.Helpful)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.ReviewId).Msg("failed to vote on review")
+		return nil, reviewError(err, "failed to vote on review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// ReportReview reports an abusive review
+func (s *Server) ReportReview(ctx context.Context, req *listingspb.ReportReviewRequest) (*listingspb.ReportReviewResponse, error) {
+	s.logger.Info().Int64("review_id", req.ReviewId).Str("reason", req.Reason).Msg("ReportReview called")
+
+	if req.ReviewId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	userID, err := authenticatedCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.ReportReview(ctx, userID, req.ReviewId, req.Reason, req.Comment)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.ReviewId).Msg("failed to report review")
+		return nil, reviewError(err, "failed to report review")
+	}
+
+	return &listingspb.ReportReviewResponse{
+		Success:      true,
+		ReviewHidden: !review.IsPublished(),
+	}, nil
+}
+
+// ListReviewReports returns abuse reports, oldest first (admin only)
+func (s *Server) ListReviewReports(ctx context.Context, req *listingspb.ListReviewReportsRequest) (*listingspb.ListReviewReportsResponse, error) {
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Msg("non-admin attempted to list review reports")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	reports, total, err := s.reviewService.ListReviewReports(ctx, req.OpenOnly, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list review reports")
+		return nil, reviewError(err, "failed to list review reports")
+	}
+
+	resp := &listingspb.ListReviewReportsResponse{
+		Reports: make([]*listingspb.ReviewReport, 0, len(reports)),
+		Total:   total,
+	}
+	for _, r := range reports {
+		resp.Reports = append(resp.Reports, &listingspb.ReviewReport{
+			Id:        r.ID,
+			ReviewId:  r.ReviewID,
+			UserId:    r.UserID,
+			Reason:    r.Reason,
+			Comment:   r.Comment,
+			Status:    r.Status,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// ModerateReview hides or restores a review and resolves its reports (admin only)
+func (s *Server) ModerateReview(ctx context.Context, req *listingspb.ModerateReviewRequest) (*listingspb.Review, error) {
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Int64("review_id", req.ReviewId).Msg("non-admin attempted to moderate review")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.ReviewId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "review ID must be greater than 0")
+	}
+
+	review, err := s.reviewService.ModerateReview(ctx, userID, req.ReviewId, req.Hide)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("review_id", req.ReviewId).Msg("failed to moderate review")
+		return nil, reviewError(err, "failed to moderate review")
+	}
+
+	return mapDomainReviewToProto(review), nil
+}
+
+// reviewError maps review service errors to gRPC status codes
+func reviewError(err error, msg string) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "validation failed"):
+		return status.Error(codes.InvalidArgument, errMsg)
+	case strings.HasPrefix(errMsg, "unauthorized"):
+		return status.Error(codes.PermissionDenied, errMsg)
+	case strings.Contains(errMsg, "not found"):
+		return status.Error(codes.NotFound, errMsg)
+	case strings.Contains(errMsg, "review already exists"), strings.Contains(errMsg, "review already reported"):
+		return status.Error(codes.AlreadyExists, errMsg)
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+// mapProtoReviewSubjectToDomain maps proto ReviewSubjectType to the domain subject type
+func mapProtoReviewSubjectToDomain(subjectType listingspb.ReviewSubjectType) string {
+	switch subjectType {
+	case listingspb.ReviewSubjectType_REVIEW_SUBJECT_TYPE_PRODUCT:
+		return domain.ReviewSubjectProduct
+	case listingspb.ReviewSubjectType_REVIEW_SUBJECT_TYPE_STOREFRONT:
+		return domain.ReviewSubjectStorefront
+	default:
+		return ""
+	}
+}
+
+// mapDomainReviewSubjectToProto maps a domain subject type to proto ReviewSubjectType
+func mapDomainReviewSubjectToProto(subjectType string) listingspb.ReviewSubjectType {
+	switch subjectType {
+	case domain.ReviewSubjectProduct:
+		return listingspb.ReviewSubjectType_REVIEW_SUBJECT_TYPE_PRODUCT
+	case domain.ReviewSubjectStorefront:
+		return listingspb.ReviewSubjectType_REVIEW_SUBJECT_TYPE_STOREFRONT
+	default:
+		return listingspb.ReviewSubjectType_REVIEW_SUBJECT_TYPE_UNSPECIFIED
+	}
+}
+
+// mapDomainReviewToProto maps domain.Review to proto Review
+func mapDomainReviewToProto(r *domain.Review) *listingspb.Review {
+	if r == nil {
+		return nil
+	}
+
+	return &listingspb.Review{
+		Id:                 r.ID,
+		SubjectType:        mapDomainReviewSubjectToProto(r.SubjectType),
+		SubjectId:          r.SubjectID,
+		StorefrontId:       r.StorefrontID,
+		UserId:             r.UserID,
+		IsVerifiedPurchase: r.IsVerifiedPurchase,
+		OrderId:            r.OrderID,
+		Rating:             r.Rating,
+		Title:              r.Title,
+		Body:               r.Body,
+		Status:             r.Status,
+		SellerReply:        r.SellerReply,
+		SellerRepliedAt:    mapTimeToProtoTimestamp(r.SellerRepliedAt),
+		HelpfulCount:       r.HelpfulCount,
+		NotHelpfulCount:    r.NotHelpfulCount,
+		CreatedAt:          timestamppb.New(r.CreatedAt),
+		UpdatedAt:          timestamppb.New(r.UpdatedAt),
+	}
+}
+
+// mapDomainReviewSummaryToProto maps domain.ReviewSummary to proto ReviewSummary
+func mapDomainReviewSummaryToProto(s *domain.ReviewSummary) *listingspb.ReviewSummary {
+	if s == nil {
+		return nil
+	}
+
+	return &listingspb.ReviewSummary{
+		SubjectType:   mapDomainReviewSubjectToProto(s.SubjectType),
+		SubjectId:     s.SubjectID,
+		AverageRating: s.Average(),
+		ReviewsCount:  s.ReviewsCount,
+		RatingCounts:  s.RatingCounts[:],
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	searchv1 "github.com/sveturs/listings/api/proto/search/v1"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/middleware"
+	"github.com/sveturs/listings/internal/service/listings"
 	"github.com/sveturs/listings/internal/service/search"
 )
 
@@ -23,21 +26,27 @@ type SearchServiceInterface interface {
 	GetSimilarListings(ctx context.Context, listingID int64, limit int32) ([]search.ListingSearchResult, int64, error)
 	GetTrendingSearches(ctx context.Context, req *search.TrendingSearchesRequest) (*search.TrendingSearchesResponse, error)
 	GetSearchHistory(ctx context.Context, req *search.SearchHistoryRequest) (*search.SearchHistoryResponse, error)
+	RecordSearchClick(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error)
 }
 
 // SearchHandler implements SearchService gRPC service
 type SearchHandler struct {
 	searchv1.UnimplementedSearchServiceServer
-	service SearchServiceInterface
-	logger  zerolog.Logger
+	service     SearchServiceInterface
+	storefronts storefrontVacationLoader // Optional: marks results of storefronts on vacation
+	logger      zerolog.Logger
 }
 
-// NewSearchHandler creates a new search handler
-func NewSearchHandler(service *search.Service, logger zerolog.Logger) *SearchHandler {
-	return &SearchHandler{
+// NewSearchHandler creates a new search handler (storefront service is optional)
+func NewSearchHandler(service *search.Service, storefrontService *listings.StorefrontService, logger zerolog.Logger) *SearchHandler {
+	h := &SearchHandler{
 		service: SearchServiceInterface(service),
 		logger:  logger.With().Str("handler", "search").Logger(),
 	}
+	if storefrontService != nil {
+		h.storefronts = storefrontService
+	}
+	return h
 }
 
 // SearchListings implements SearchService.SearchListings RPC
@@ -64,6 +73,7 @@ func (h *SearchHandler) SearchListings(
 
 	// Convert proto to domain
 	domainReq := h.protoToDomainRequest(req)
+	domainReq.UserID = h.searcherUserID(ctx, req.UserId)
 
 	// Execute search
 	result, err := h.service.SearchListings(ctx, domainReq)
@@ -76,6 +86,7 @@ func (h *SearchHandler) SearchListings(
 
 	// Convert domain to proto
 	protoResp := h.domainToProtoResponse(result)
+	h.applyVacationAvailability(ctx, protoResp.Listings)
 
 	h.logger.Info().
 		Int64("total", protoResp.Total).
@@ -156,8 +167,16 @@ func (h *SearchHandler) SearchWithFilters(
 		Bool("include_facets", req.IncludeFacets).
 		Msg("SearchWithFilters RPC called")
 
+	if err := validateSearcherIdentity(req.UserId, req.SessionId); err != nil {
+		h.logger.Warn().
+			Err(err).
+			Msg("invalid filtered search request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Convert proto to domain
 	domainReq := ProtoToSearchFiltersRequest(req)
+	domainReq.UserID = h.searcherUserID(ctx, req.UserId)
 
 	// Validate
 	if err := domainReq.Validate(); err != nil {
@@ -183,6 +202,7 @@ func (h *SearchHandler) SearchWithFilters(
 
 	// Convert domain to proto
 	resp := SearchFiltersResponseToProto(result)
+	h.applyVacationAvailability(ctx, resp.Listings)
 
 	h.logger.Info().
 		Int("count", len(resp.Listings)).
@@ -545,6 +565,85 @@ func (h *SearchHandler) searchHistoryResponseToProto(result *search.SearchHistor
 	}
 }
 
+// ============================================================================
+// Search Analytics - Click Tracking
+// ============================================================================
+
+// RecordSearchClick records a click on a search result for CTR analytics. The click is only
+// counted when the token user or session_id ran the search.
+func (h *SearchHandler) RecordSearchClick(
+	ctx context.Context,
+	req *searchv1.RecordSearchClickRequest,
+) (*searchv1.RecordSearchClickResponse, error) {
+	input := &domain.RecordSearchClickInput{
+		SearchQueryID: req.SearchQueryId,
+		ListingID:     req.ListingId,
+		Position:      req.Position,
+		UserID:        authenticatedUserID(ctx),
+		SessionID:     req.SessionId,
+	}
+
+	// Validate request
+	if err := validateSearcherIdentity(nil, req.SessionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := input.Validate(); err != nil {
+		h.logger.Warn().
+			Err(err).
+			Msg("invalid search click request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call service
+	recorded, err := h.service.RecordSearchClick(ctx, input)
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Int64("search_query_id", req.SearchQueryId).
+			Msg("search click service failed")
+
+		// Map service errors to gRPC status codes
+		if containsError(err, "invalid") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to record search click")
+	}
+
+	return &searchv1.RecordSearchClickResponse{Recorded: recorded}, nil
+}
+
+// validateSearcherIdentity validates the optional searcher identity of search requests
+func validateSearcherIdentity(userID *int64, sessionID *string) error {
+	if userID != nil && *userID <= 0 {
+		return fmt.Errorf("user_id must be greater than 0")
+	}
+	if sessionID != nil && len(*sessionID) != 36 {
+		return fmt.Errorf("session_id must be a valid UUID (36 chars)")
+	}
+	return nil
+}
+
+// searcherUserID returns the authenticated user a search is recorded under. The user_id of
+// the request is only accepted from the token: a differing or unauthenticated user_id is
+// ignored, so searches cannot be recorded or enrolled in experiments for another user.
+func (h *SearchHandler) searcherUserID(ctx context.Context, requested *int64) *int64 {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok || userID <= 0 {
+		if requested != nil {
+			h.logger.Debug().Int64("user_id", *requested).Msg("ignoring user_id of unauthenticated search")
+		}
+		return nil
+	}
+
+	if requested != nil && *requested != userID {
+		h.logger.Warn().
+			Int64("user_id", userID).
+			Int64("requested_user_id", *requested).
+			Msg("ignoring user_id of search that does not match the token")
+	}
+	return &userID
+}
+
 // containsError checks if error message contains substring (case-insensitive)
 func containsError(err error, substr string) bool {
 	if err == nil || substr == "" {
@@ -574,7 +673,7 @@ func (h *SearchHandler) validateSearchRequest(req *searchv1.SearchListingsReques
 		return fmt.Errorf("query too long (max 500 characters)")
 	}
 
-	return nil
+	return validateSearcherIdentity(req.UserId, req.SessionId)
 }
 
 // protoToDomainRequest converts proto request to domain request
@@ -592,6 +691,9 @@ func (h *SearchHandler) protoToDomainRequest(req *searchv1.SearchListingsRequest
 		domainReq.CategoryID = &categoryID
 	}
 
+	// Searcher identity (search analytics); the user is taken from the token by the handler
+	domainReq.SessionID = req.SessionId
+
 	// Set defaults
 	if domainReq.Limit == 0 {
 		domainReq.Limit = 20
@@ -600,6 +702,28 @@ func (h *SearchHandler) protoToDomainRequest(req *searchv1.SearchListingsRequest
 	return domainReq
 }
 
+// applyVacationAvailability marks results of storefronts on vacation that block orders as
+// unavailable and attaches the vacation notice. The index has no vacation state: vacations
+// start and end on their own, so availability is resolved when the results are served.
+func (h *SearchHandler) applyVacationAvailability(ctx context.Context, results []*searchv1.Listing) {
+	if h.storefronts == nil || len(results) == 0 {
+		return
+	}
+
+	storefrontIDs := make([]int64, 0, len(results))
+	for _, result := range results {
+		storefrontIDs = append(storefrontIDs, result.GetStorefrontId())
+	}
+
+	vacations := storefrontVacations(ctx, h.storefronts, h.logger, storefrontIDs)
+	for _, result := range results {
+		if vacation, ok := vacations[result.GetStorefrontId()]; ok {
+			result.Unavailable = vacation.unavailable
+			result.AvailabilityNotice = getStringPtr(vacation.notice)
+		}
+	}
+}
+
 // domainToProtoResponse converts domain response to proto response
 func (h *SearchHandler) domainToProtoResponse(result *search.SearchResponse) *searchv1.SearchListingsResponse {
 	protoListings := make([]*searchv1.Listing, 0, len(result.Listings))
@@ -618,6 +742,8 @@ func (h *SearchHandler) domainToProtoResponse(result *search.SearchResponse) *se
 			Quantity:    listing.Quantity,
 			SourceType:  listing.SourceType,
 			StockStatus: listing.StockStatus,
+
+			StorefrontVerified: listing.StorefrontVerified,
 		}
 
 		// Add optional fields
@@ -649,9 +775,13 @@ func (h *SearchHandler) domainToProtoResponse(result *search.SearchResponse) *se
 	}
 
 	return &searchv1.SearchListingsResponse{
-		Listings: protoListings,
-		Total:    result.Total,
-		TookMs:   result.TookMs,
-		Cached:   result.Cached,
+		Listings:      protoListings,
+		Total:         result.Total,
+		TookMs:        result.TookMs,
+		Cached:        result.Cached,
+		SearchQueryId: result.SearchQueryID,
+
+		ExperimentId:      result.ExperimentID,
+		ExperimentVariant: result.ExperimentVariant,
 	}
 }
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/service"
+)
+
+// CreateSearchExperiment defines a search ranking experiment in draft status (admin only)
+func (s *Server) CreateSearchExperiment(
+	ctx context.Context,
+	req *listingspb.CreateSearchExperimentRequest,
+) (*listingspb.SearchExperimentResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.CreateSearchExperiment(ctx, req, userID, isAdmin)
+	if err != nil {
+		if errors.Is(err, service.ErrConflict) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, s.mapSearchExperimentError(err, "CreateSearchExperiment", userID)
+	}
+
+	return response, nil
+}
+
+// UpdateSearchExperimentStatus starts or stops a search experiment (admin only)
+func (s *Server) UpdateSearchExperimentStatus(
+	ctx context.Context,
+	req *listingspb.UpdateSearchExperimentStatusRequest,
+) (*listingspb.SearchExperimentResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.UpdateSearchExperimentStatus(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapSearchExperimentError(err, "UpdateSearchExperimentStatus", userID)
+	}
+
+	return response, nil
+}
+
+// ListSearchExperiments lists search experiments (admin only)
+func (s *Server) ListSearchExperiments(
+	ctx context.Context,
+	req *listingspb.ListSearchExperimentsRequest,
+) (*listingspb.ListSearchExperimentsResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.ListSearchExperiments(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapSearchExperimentError(err, "ListSearchExperiments", userID)
+	}
+
+	return response, nil
+}
+
+// GetSearchExperimentResults compares CTR and conversion between experiment arms (admin only)
+func (s *Server) GetSearchExperimentResults(
+	ctx context.Context,
+	req *listingspb.GetSearchExperimentResultsRequest,
+) (*listingspb.GetSearchExperimentResultsResponse, error) {
+	userID, isAdmin, err := s.extractAuthFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	response, err := s.analyticsService.GetSearchExperimentResults(ctx, req, userID, isAdmin)
+	if err != nil {
+		return nil, s.mapSearchExperimentError(err, "GetSearchExperimentResults", userID)
+	}
+
+	return response, nil
+}
+
+// mapSearchExperimentError maps search experiment service errors to gRPC status codes
+func (s *Server) mapSearchExperimentError(err error, operation string, userID int64) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, "admin access required")
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	s.logger.Error().
+		Err(err).
+		Int64("user_id", userID).
+		Str("operation", operation).
+		Msg("search experiment request failed")
+	return status.Error(codes.Internal, "failed to process search experiment request")
+}
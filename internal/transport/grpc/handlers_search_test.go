@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	searchv1 "github.com/sveturs/listings/api/proto/search/v1"
+	"github.com/sveturs/listings/internal/domain"
 	"github.com/sveturs/listings/internal/service/search"
 )
 
@@ -29,6 +30,7 @@ type mockSearchService struct {
 	getSimilarFunc        func(ctx context.Context, listingID int64, limit int32) ([]search.ListingSearchResult, int64, error)
 	getTrendingFunc       func(ctx context.Context, req *search.TrendingSearchesRequest) (*search.TrendingSearchesResponse, error)
 	getHistoryFunc        func(ctx context.Context, req *search.SearchHistoryRequest) (*search.SearchHistoryResponse, error)
+	recordClickFunc       func(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error)
 }
 
 func (m *mockSearchService) SearchListings(ctx context.Context, req *search.SearchRequest) (*search.SearchResponse, error) {
@@ -87,6 +89,13 @@ func (m *mockSearchService) GetSearchHistory(ctx context.Context, req *search.Se
 	return &search.SearchHistoryResponse{}, nil
 }
 
+func (m *mockSearchService) RecordSearchClick(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error) {
+	if m.recordClickFunc != nil {
+		return m.recordClickFunc(ctx, input)
+	}
+	return true, nil
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
@@ -1180,3 +1189,159 @@ func TestGetSearchHistory_DefaultLimit(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+// ============================================================================
+// Search Analytics - Click Tracking Tests
+// ============================================================================
+
+func TestRecordSearchClick_Success(t *testing.T) {
+	// Arrange
+	sessionID := "550e8400-e29b-41d4-a716-446655440000"
+	mockSvc := &mockSearchService{
+		recordClickFunc: func(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error) {
+			assert.Equal(t, int64(42), input.SearchQueryID)
+			assert.Equal(t, int64(1001), input.ListingID)
+			assert.Equal(t, int32(3), input.Position)
+			assert.Equal(t, sessionID, *input.SessionID)
+			assert.Nil(t, input.UserID)
+			return true, nil
+		},
+	}
+	handler := newTestSearchHandler(mockSvc)
+
+	// Act
+	resp, err := handler.RecordSearchClick(context.Background(), &searchv1.RecordSearchClickRequest{
+		SearchQueryId: 42,
+		ListingId:     1001,
+		Position:      3,
+		SessionId:     &sessionID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, resp.Recorded)
+}
+
+func TestRecordSearchClick_InvalidPosition(t *testing.T) {
+	// Arrange
+	mockSvc := &mockSearchService{
+		recordClickFunc: func(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error) {
+			t.Fatal("service should not be called")
+			return false, nil
+		},
+	}
+	handler := newTestSearchHandler(mockSvc)
+
+	// Act
+	resp, err := handler.RecordSearchClick(context.Background(), &searchv1.RecordSearchClickRequest{
+		SearchQueryId: 42,
+		ListingId:     1001,
+		Position:      0,
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestRecordSearchClick_RequiresSearcher(t *testing.T) {
+	// Arrange
+	mockSvc := &mockSearchService{
+		recordClickFunc: func(ctx context.Context, input *domain.RecordSearchClickInput) (bool, error) {
+			t.Fatal("service should not be called")
+			return false, nil
+		},
+	}
+	handler := newTestSearchHandler(mockSvc)
+
+	// Act: anonymous click without the session that ran the search
+	resp, err := handler.RecordSearchClick(context.Background(), &searchv1.RecordSearchClickRequest{
+		SearchQueryId: 42,
+		ListingId:     1001,
+		Position:      1,
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestSearchListings_PassesSearcherIdentity(t *testing.T) {
+	// Arrange
+	sessionID := "550e8400-e29b-41d4-a716-446655440000"
+	queryID := int64(77)
+	mockSvc := &mockSearchService{
+		searchListingsFunc: func(ctx context.Context, req *search.SearchRequest) (*search.SearchResponse, error) {
+			require.NotNil(t, req.SessionID)
+			assert.Equal(t, sessionID, *req.SessionID)
+			assert.Nil(t, req.UserID)
+			return &search.SearchResponse{SearchQueryID: &queryID}, nil
+		},
+	}
+	handler := newTestSearchHandler(mockSvc)
+
+	// Act
+	resp, err := handler.SearchListings(context.Background(), &searchv1.SearchListingsRequest{
+		Query:     "iphone",
+		SessionId: &sessionID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, resp.SearchQueryId)
+	assert.Equal(t, queryID, *resp.SearchQueryId)
+}
+
+func TestSearchListings_RecordsTokenUser(t *testing.T) {
+	// Arrange
+	requestedUserID := int64(99)
+	var recorded []*int64
+	mockSvc := &mockSearchService{
+		searchListingsFunc: func(ctx context.Context, req *search.SearchRequest) (*search.SearchResponse, error) {
+			recorded = append(recorded, req.UserID)
+			return &search.SearchResponse{}, nil
+		},
+	}
+	handler := newTestSearchHandler(mockSvc)
+	req := &searchv1.SearchListingsRequest{Query: "iphone", UserId: &requestedUserID}
+
+	// Act
+	_, err := handler.SearchListings(contextWithUserID(42), req)
+	require.NoError(t, err)
+	_, err = handler.SearchListings(context.Background(), req)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, recorded, 2)
+	require.NotNil(t, recorded[0])
+	assert.Equal(t, int64(42), *recorded[0], "the token user wins over the requested user_id")
+	assert.Nil(t, recorded[1], "user_id of unauthenticated searches is ignored")
+}
+
+func TestSearchListings_InvalidSessionID(t *testing.T) {
+	// Arrange
+	sessionID := "not-a-uuid"
+	handler := newTestSearchHandler(&mockSearchService{})
+
+	// Act
+	resp, err := handler.SearchListings(context.Background(), &searchv1.SearchListingsRequest{
+		Query:     "iphone",
+		SessionId: &sessionID,
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// AddStorefrontDomain registers a custom domain for a storefront
+func (s *Server) AddStorefrontDomain(ctx context.Context, req *listingspb.AddStorefrontDomainRequest) (*listingspb.StorefrontDomain, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Str("domain", req.Domain).Msg("AddStorefrontDomain called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	d, err := s.storefrontService.AddStorefrontDomain(ctx, req.StorefrontId, req.Domain)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to add storefront domain")
+		return nil, staffError(err, "failed to add domain")
+	}
+
+	return mapDomainStorefrontDomainToProto(d), nil
+}
+
+// VerifyStorefrontDomain checks a custom domain's verification TXT record
+func (s *Server) VerifyStorefrontDomain(ctx context.Context, req *listingspb.StorefrontDomainRequest) (*listingspb.StorefrontDomain, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Int64("domain_id", req.DomainId).Msg("VerifyStorefrontDomain called")
+
+	if req.StorefrontId == 0 || req.DomainId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id and domain_id are required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	d, err := s.storefrontService.VerifyStorefrontDomain(ctx, req.StorefrontId, req.DomainId)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("domain_id", req.DomainId).Msg("Failed to verify storefront domain")
+		return nil, staffError(err, "failed to verify domain")
+	}
+
+	return mapDomainStorefrontDomainToProto(d), nil
+}
+
+// RemoveStorefrontDomain removes a custom domain from a storefront
+func (s *Server) RemoveStorefrontDomain(ctx context.Context, req *listingspb.StorefrontDomainRequest) (*emptypb.Empty, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Int64("domain_id", req.DomainId).Msg("RemoveStorefrontDomain called")
+
+	if req.StorefrontId == 0 || req.DomainId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id and domain_id are required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	if err := s.storefrontService.RemoveStorefrontDomain(ctx, req.StorefrontId, req.DomainId); err != nil {
+		s.logger.Error().Err(err).Int64("domain_id", req.DomainId).Msg("Failed to remove storefront domain")
+		return nil, staffError(err, "failed to remove domain")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListStorefrontDomains lists a storefront's custom domains
+func (s *Server) ListStorefrontDomains(ctx context.Context, req *listingspb.ListStorefrontDomainsRequest) (*listingspb.ListStorefrontDomainsResponse, error) {
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	domains, err := s.storefrontService.ListStorefrontDomains(ctx, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to list storefront domains")
+		return nil, status.Error(codes.Internal, "failed to list domains")
+	}
+
+	resp := &listingspb.ListStorefrontDomainsResponse{
+		Domains: make([]*listingspb.StorefrontDomain, 0, len(domains)),
+	}
+	for i := range domains {
+		resp.Domains = append(resp.Domains, mapDomainStorefrontDomainToProto(&domains[i]))
+	}
+
+	return resp, nil
+}
+
+// ResolveStorefrontDomain maps a verified custom domain to its storefront (public; used by
+// the frontend to route custom-domain requests)
+func (s *Server) ResolveStorefrontDomain(ctx context.Context, req *listingspb.ResolveStorefrontDomainRequest) (*listingspb.ResolveStorefrontDomainResponse, error) {
+	if req.Domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+
+	d, err := s.storefrontService.ResolveStorefrontDomain(ctx, req.Domain)
+	if err != nil {
+		return nil, staffError(err, "failed to resolve domain")
+	}
+
+	return &listingspb.ResolveStorefrontDomainResponse{
+		StorefrontId:   d.StorefrontID,
+		StorefrontSlug: d.StorefrontSlug,
+		Domain:         d.Domain,
+		IsPrimary:      d.IsPrimary,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// FollowStorefront makes the caller follow a storefront
+func (s *Server) FollowStorefront(ctx context.Context, req *listingspb.FollowStorefrontRequest) (*listingspb.FollowStorefrontResponse, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("FollowStorefront called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.storefrontService.FollowStorefront(ctx, userID, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to follow storefront")
+		return nil, staffError(err, "failed to follow storefront")
+	}
+
+	return &listingspb.FollowStorefrontResponse{
+		Following:      true,
+		FollowersCount: count,
+	}, nil
+}
+
+// UnfollowStorefront removes the caller's follow
+func (s *Server) UnfollowStorefront(ctx context.Context, req *listingspb.FollowStorefrontRequest) (*listingspb.FollowStorefrontResponse, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("UnfollowStorefront called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.storefrontService.UnfollowStorefront(ctx, userID, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to unfollow storefront")
+		return nil, staffError(err, "failed to unfollow storefront")
+	}
+
+	return &listingspb.FollowStorefrontResponse{
+		Following:      false,
+		FollowersCount: count,
+	}, nil
+}
+
+// GetStorefrontFollowers lists a storefront's followers (owner, staff with analytics.read or admin)
+func (s *Server) GetStorefrontFollowers(ctx context.Context, req *listingspb.GetStorefrontFollowersRequest) (*listingspb.GetStorefrontFollowersResponse, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("GetStorefrontFollowers called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionAnalyticsRead); err != nil {
+		return nil, err
+	}
+
+	followers, total, err := s.storefrontService.ListFollowers(ctx, req.StorefrontId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to list followers")
+		return nil, staffError(err, "failed to list followers")
+	}
+
+	resp := &listingspb.GetStorefrontFollowersResponse{
+		Followers: make([]*listingspb.StorefrontFollower, 0, len(followers)),
+		Total:     total,
+	}
+	for _, f := range followers {
+		resp.Followers = append(resp.Followers, &listingspb.StorefrontFollower{
+			UserId:     f.UserID,
+			FollowedAt: timestamppb.New(f.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetFollowingFeed returns new products from the storefronts the caller follows, newest first
+func (s *Server) GetFollowingFeed(ctx context.Context, req *listingspb.GetFollowingFeedRequest) (*listingspb.GetFollowingFeedResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &domain.FollowingFeedFilter{
+		UserID: userID,
+		Limit:  int(req.Limit),
+	}
+	if req.Cursor != nil && *req.Cursor != "" {
+		cursor, err := domain.ParseFollowingFeedCursor(*req.Cursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = cursor
+	}
+
+	listings, next, err := s.storefrontService.GetFollowingFeed(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("Failed to get following feed")
+		return nil, status.Error(codes.Internal, "failed to get following feed")
+	}
+
+	resp := &listingspb.GetFollowingFeedResponse{
+		Listings: make([]*listingspb.Listing, 0, len(listings)),
+	}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, DomainToProtoListing(listing))
+	}
+	s.applyVacationAvailability(ctx, resp.Listings...)
+	if next != nil {
+		cursor := next.String()
+		resp.NextCursor = &cursor
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"path/filepath"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
+)
+
+// UploadStorefrontImage uploads a storefront logo or banner. The image is cropped (to the
+// requested rectangle or a centered one with the target aspect ratio), scaled down to the
+// branding size and re-encoded without metadata.
+func (s *Server) UploadStorefrontImage(ctx context.Context, req *listingspb.UploadStorefrontImageRequest) (*listingspb.StorefrontFull, error) {
+	s.logger.Info().
+		Int64("storefront_id", req.StorefrontId).
+		Int64("user_id", req.UserId).
+		Str("kind", req.Kind).
+		Int("size", len(req.Data)).
+		Msg("UploadStorefrontImage called")
+
+	if s.minioClient == nil {
+		return nil, status.Error(codes.Unavailable, "image storage is not configured")
+	}
+
+	// ============================================================================
+	// VALIDATION
+	// ============================================================================
+
+	if req.StorefrontId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id must be positive")
+	}
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id must be positive")
+	}
+
+	var maxWidth, maxHeight int
+	switch req.Kind {
+	case domain.StorefrontImageLogo:
+		maxWidth, maxHeight = imaging.LogoSize, imaging.LogoSize
+	case domain.StorefrontImageBanner:
+		maxWidth, maxHeight = imaging.BannerWidth, imaging.BannerHeight
+	default:
+		return nil, status.Error(codes.InvalidArgument, "kind must be logo or banner")
+	}
+
+	if err := imaging.ValidateFile(req.Filename, req.ContentType, int64(len(req.Data))); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid image: %v", err)
+	}
+	if !imaging.ProcessableContentTypes[req.ContentType] {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported content_type %s (allowed: image/jpeg, image/png, image/gif)", req.ContentType)
+	}
+
+	// ============================================================================
+	// AUTHORIZATION
+	// ============================================================================
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	// ============================================================================
+	// CROP AND ENCODE
+	// ============================================================================
+
+	processed, err := imaging.Process(req.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid image: %v", err)
+	}
+
+	rect := imaging.CenterCrop(processed.Image, maxWidth, maxHeight)
+	if req.Crop != nil {
+		rect = image.Rect(
+			int(req.Crop.X),
+			int(req.Crop.Y),
+			int(req.Crop.X+req.Crop.Width),
+			int(req.Crop.Y+req.Crop.Height),
+		)
+	}
+
+	cropped, err := imaging.CropAndFit(processed.Image, rect, maxWidth, maxHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid crop: %v", err)
+	}
+
+	// PNG keeps logo transparency; everything else (including GIF) is stored as JPEG
+	data, contentType, ext, err := imaging.Encode(cropped, processed.Format)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to encode storefront image")
+		return nil, status.Error(codes.Internal, "failed to encode image")
+	}
+
+	// ============================================================================
+	// STORE
+	// ============================================================================
+
+	key := imaging.NewObjectKey(imaging.OwnerStorefronts, req.StorefrontId, req.Kind+"_", ext)
+	if err := s.minioClient.UploadImage(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		s.logger.Error().Err(err).Str("key", key).Msg("failed to upload storefront image")
+		return nil, status.Error(codes.Internal, "failed to upload image")
+	}
+
+	url, err := s.minioClient.GetPresignedURL(ctx, key, minioPresignedTTL)
+	if err != nil {
+		_ = s.minioClient.DeleteImage(ctx, key)
+		s.logger.Error().Err(err).Str("key", key).Msg("failed to presign storefront image")
+		return nil, status.Error(codes.Internal, "failed to generate image URL")
+	}
+
+	updated, previous, err := s.storefrontService.SetStorefrontImage(ctx, req.StorefrontId, req.Kind, url, key)
+	if err != nil {
+		// Compensating transaction: delete the uploaded object
+		_ = s.minioClient.DeleteImage(ctx, key)
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("failed to save storefront image")
+		return nil, status.Error(codes.Internal, "failed to save storefront image")
+	}
+
+	// The replaced object is no longer referenced; image GC removes it if this fails
+	if previous != nil && *previous != key {
+		if err := s.minioClient.DeleteImage(ctx, *previous); err != nil {
+			s.logger.Warn().Err(err).Str("key", *previous).Msg("failed to delete previous storefront image (non-critical)")
+		}
+	}
+
+	s.logger.Info().
+		Int64("storefront_id", req.StorefrontId).
+		Str("kind", req.Kind).
+		Str("key", key).
+		Str("filename", filepath.Base(req.Filename)).
+		Msg("storefront image uploaded")
+
+	return mapDomainStorefrontToProto(updated), nil
+}
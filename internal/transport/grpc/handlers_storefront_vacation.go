@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// IsOpenNow checks if a storefront is currently open, in the storefront's timezone
+func (s *Server) IsOpenNow(ctx context.Context, req *listingspb.IsOpenNowRequest) (*listingspb.IsOpenNowResponse, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("IsOpenNow called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	openStatus, err := s.storefrontService.IsOpenNow(ctx, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to check if open")
+		return nil, staffError(err, "failed to check if open")
+	}
+
+	response := &listingspb.IsOpenNowResponse{
+		IsOpen:   openStatus.IsOpen,
+		Timezone: openStatus.Location.String(),
+	}
+
+	if openStatus.NextOpen != nil {
+		response.NextOpenTime = getStringPtr(openStatus.NextOpen.In(openStatus.Location).Format("15:04:05"))
+		response.NextOpenAt = timestamppb.New(*openStatus.NextOpen)
+	}
+	if openStatus.NextClose != nil {
+		response.NextCloseTime = getStringPtr(openStatus.NextClose.In(openStatus.Location).Format("15:04:05"))
+		response.NextCloseAt = timestamppb.New(*openStatus.NextClose)
+	}
+	if !openStatus.IsOpen {
+		response.ClosedReason = getStringPtr(openStatus.ClosedReason)
+		if openStatus.Note != "" {
+			response.Note = getStringPtr(openStatus.Note)
+		}
+	}
+
+	s.logger.Info().Bool("is_open", openStatus.IsOpen).Msg("Open status checked successfully")
+
+	return response, nil
+}
+
+// SetVacationMode turns a storefront's vacation mode on or off
+func (s *Server) SetVacationMode(ctx context.Context, req *listingspb.SetVacationModeRequest) (*listingspb.StorefrontFull, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Bool("enabled", req.Enabled).Msg("SetVacationMode called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	settings := &domain.VacationSettings{
+		Enabled:     req.Enabled,
+		Message:     req.Message,
+		OrderPolicy: req.OrderPolicy,
+	}
+	if req.StartsAt != nil {
+		startsAt := req.StartsAt.AsTime()
+		settings.StartsAt = &startsAt
+	}
+	if req.EndsAt != nil {
+		endsAt := req.EndsAt.AsTime()
+		settings.EndsAt = &endsAt
+	}
+
+	storefront, err := s.storefrontService.SetVacationMode(ctx, req.StorefrontId, settings)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to set vacation mode")
+		return nil, staffError(err, "failed to set vacation mode")
+	}
+
+	return mapDomainStorefrontToProto(storefront), nil
+}
+
+// SetHolidays replaces a storefront's holiday calendar
+func (s *Server) SetHolidays(ctx context.Context, req *listingspb.SetHolidaysRequest) (*listingspb.GetHolidaysResponse, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("SetHolidays called")
+
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	holidays := make([]domain.StorefrontHoliday, len(req.Holidays))
+	for i, h := range req.Holidays {
+		holiday, err := mapProtoHolidayToDomain(h)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		holidays[i] = *holiday
+	}
+
+	if err := s.storefrontService.SetHolidays(ctx, req.StorefrontId, holidays); err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to set holidays")
+		return nil, staffError(err, "failed to set holidays")
+	}
+
+	return s.GetHolidays(ctx, &listingspb.GetHolidaysRequest{StorefrontId: req.StorefrontId})
+}
+
+// GetHolidays retrieves a storefront's holiday calendar
+func (s *Server) GetHolidays(ctx context.Context, req *listingspb.GetHolidaysRequest) (*listingspb.GetHolidaysResponse, error) {
+	if req.StorefrontId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
+	}
+
+	holidays, err := s.storefrontService.GetHolidays(ctx, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("Failed to get holidays")
+		return nil, status.Error(codes.Internal, "failed to get holidays")
+	}
+
+	resp := &listingspb.GetHolidaysResponse{
+		Holidays: make([]*listingspb.StorefrontHoliday, 0, len(holidays)),
+	}
+	for i := range holidays {
+		resp.Holidays = append(resp.Holidays, mapDomainHolidayToProto(&holidays[i]))
+	}
+
+	return resp, nil
+}
+
+// storefrontVacationLoader loads the vacation settings of storefronts for product availability
+// Implemented by listings.StorefrontService
+type storefrontVacationLoader interface {
+	GetStorefrontVacations(ctx context.Context, ids []int64) ([]domain.Storefront, error)
+}
+
+// vacationAvailability is how a storefront's vacation shows on its products
+type vacationAvailability struct {
+	unavailable bool
+	notice      string
+}
+
+// storefrontVacations returns the availability of the given storefronts that are on vacation,
+// loading them in one query. A lookup failure leaves all products unmarked.
+func storefrontVacations(ctx context.Context, storefronts storefrontVacationLoader, logger zerolog.Logger, storefrontIDs []int64) map[int64]vacationAvailability {
+	vacations := make(map[int64]vacationAvailability)
+	seen := make(map[int64]bool, len(storefrontIDs))
+	ids := make([]int64, 0, len(storefrontIDs))
+	for _, id := range storefrontIDs {
+		if id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return vacations
+	}
+
+	enabled, err := storefronts.GetStorefrontVacations(ctx, ids)
+	if err != nil {
+		logger.Warn().Err(err).Int("storefronts", len(ids)).Msg("failed to load storefront vacations for availability")
+		return vacations
+	}
+
+	now := time.Now()
+	for i := range enabled {
+		storefront := &enabled[i]
+		if !storefront.IsOnVacation(now) {
+			continue
+		}
+		vacations[storefront.ID] = vacationAvailability{
+			unavailable: !storefront.AcceptsOrders(now),
+			notice:      storefront.VacationNotice(),
+		}
+	}
+
+	return vacations
+}
+
+// applyVacationAvailability marks storefront listings unavailable while their storefront is on
+// vacation and blocks orders, and attaches the vacation notice
+func (s *Server) applyVacationAvailability(ctx context.Context, listings ...*listingspb.Listing) {
+	if s.storefrontService == nil || len(listings) == 0 {
+		return
+	}
+
+	storefrontIDs := make([]int64, 0, len(listings))
+	for _, listing := range listings {
+		storefrontIDs = append(storefrontIDs, listing.GetStorefrontId())
+	}
+
+	vacations := storefrontVacations(ctx, s.storefrontService, s.logger, storefrontIDs)
+	for _, listing := range listings {
+		if vacation, ok := vacations[listing.GetStorefrontId()]; ok {
+			listing.Unavailable = vacation.unavailable
+			listing.AvailabilityNotice = getStringPtr(vacation.notice)
+		}
+	}
+}
+
+// applyProductVacationAvailability is applyVacationAvailability for storefront products
+func (s *Server) applyProductVacationAvailability(ctx context.Context, products ...*listingspb.Product) {
+	if s.storefrontService == nil || len(products) == 0 {
+		return
+	}
+
+	storefrontIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		storefrontIDs = append(storefrontIDs, product.StorefrontId)
+	}
+
+	vacations := storefrontVacations(ctx, s.storefrontService, s.logger, storefrontIDs)
+	for _, product := range products {
+		if vacation, ok := vacations[product.StorefrontId]; ok {
+			product.Unavailable = vacation.unavailable
+			product.AvailabilityNotice = getStringPtr(vacation.notice)
+		}
+	}
+}
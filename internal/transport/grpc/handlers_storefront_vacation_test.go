@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sveturs/listings/internal/domain"
+)
+
+// fakeVacationLoader returns fixed storefronts and records its calls
+type fakeVacationLoader struct {
+	storefronts []domain.Storefront
+	err         error
+	calls       [][]int64
+}
+
+func (f *fakeVacationLoader) GetStorefrontVacations(_ context.Context, ids []int64) ([]domain.Storefront, error) {
+	f.calls = append(f.calls, ids)
+	return f.storefronts, f.err
+}
+
+func TestStorefrontVacations_LoadsOnce(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	loader := &fakeVacationLoader{storefronts: []domain.Storefront{
+		{ID: 1, VacationMode: true, VacationOrderPolicy: domain.VacationPolicyBlock},
+		{ID: 2, VacationMode: true, VacationOrderPolicy: domain.VacationPolicyDelay},
+		{ID: 3, VacationMode: true, VacationStartsAt: &future, VacationOrderPolicy: domain.VacationPolicyBlock},
+	}}
+
+	vacations := storefrontVacations(context.Background(), loader, zerolog.Nop(), []int64{1, 2, 1, 0, 3, 4, 2})
+
+	assert.Equal(t, [][]int64{{1, 2, 3, 4}}, loader.calls, "storefronts are loaded in one call without duplicates")
+	assert.Len(t, vacations, 2, "scheduled vacations do not apply yet")
+	assert.True(t, vacations[1].unavailable)
+	assert.False(t, vacations[2].unavailable, "the delay policy keeps products orderable")
+	assert.NotEmpty(t, vacations[2].notice)
+}
+
+func TestStorefrontVacations_LookupFailure(t *testing.T) {
+	loader := &fakeVacationLoader{err: errors.New("connection refused")}
+
+	assert.Empty(t, storefrontVacations(context.Background(), loader, zerolog.Nop(), []int64{1}))
+	assert.Empty(t, storefrontVacations(context.Background(), loader, zerolog.Nop(), []int64{0}))
+	assert.Len(t, loader.calls, 1, "no lookup without storefronts")
+}
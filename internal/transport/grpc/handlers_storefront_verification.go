@@ -0,0 +1,299 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
+)
+
+// verificationDocumentURLTTL is the lifetime of presigned document URLs. Documents are private,
+// so links are only handed out to storefront managers and admins and expire quickly.
+const verificationDocumentURLTTL = 15 * time.Minute
+
+// SubmitStorefrontVerification uploads business documents and submits a verification request
+func (s *Server) SubmitStorefrontVerification(ctx context.Context, req *listingspb.SubmitStorefrontVerificationRequest) (*listingspb.StorefrontVerification, error) {
+	s.logger.Info().
+		Int64("storefront_id", req.StorefrontId).
+		Int("documents", len(req.Documents)).
+		Msg("SubmitStorefrontVerification called")
+
+	if s.minioClient == nil {
+		return nil, status.Error(codes.Unavailable, "document storage is not configured")
+	}
+
+	// ============================================================================
+	// VALIDATION
+	// ============================================================================
+
+	if req.StorefrontId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id must be positive")
+	}
+
+	request := &domain.StorefrontVerificationRequest{
+		StorefrontID:       req.StorefrontId,
+		BusinessName:       req.BusinessName,
+		RegistrationNumber: req.RegistrationNumber,
+		TaxID:              req.TaxId,
+		Notes:              req.Notes,
+		Documents:          make([]domain.StorefrontVerificationDocument, len(req.Documents)),
+	}
+	for i, doc := range req.Documents {
+		// The declared content type must match the file contents
+		if detected := http.DetectContentType(doc.Data); detected != doc.ContentType {
+			return nil, status.Errorf(codes.InvalidArgument, "document %s: content does not match content_type %s", doc.Filename, doc.ContentType)
+		}
+		request.Documents[i] = domain.StorefrontVerificationDocument{
+			DocumentType: doc.DocumentType,
+			FileName:     filepath.Base(doc.Filename),
+			ContentType:  doc.ContentType,
+			FileSize:     int64(len(doc.Data)),
+		}
+	}
+	if err := request.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	// ============================================================================
+	// AUTHORIZATION
+	// ============================================================================
+
+	userID, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite)
+	if err != nil {
+		return nil, err
+	}
+	request.SubmittedBy = userID
+
+	// ============================================================================
+	// STORE
+	// ============================================================================
+
+	uploaded := make([]string, 0, len(req.Documents))
+	cleanup := func() {
+		for _, key := range uploaded {
+			_ = s.minioClient.DeleteImage(ctx, key)
+		}
+	}
+
+	for i, doc := range req.Documents {
+		stored := &request.Documents[i]
+		key := imaging.NewObjectKey(imaging.OwnerVerification, req.StorefrontId, stored.DocumentType+"_", domain.VerificationDocumentExt(stored.ContentType))
+		if err := s.minioClient.UploadImage(ctx, key, bytes.NewReader(doc.Data), stored.FileSize, stored.ContentType); err != nil {
+			cleanup()
+			s.logger.Error().Err(err).Str("key", key).Msg("failed to upload verification document")
+			return nil, status.Error(codes.Internal, "failed to upload document")
+		}
+		uploaded = append(uploaded, key)
+		stored.StoragePath = key
+	}
+
+	created, err := s.storefrontService.SubmitVerification(ctx, request)
+	if err != nil {
+		// Compensating transaction: delete the uploaded documents
+		cleanup()
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("failed to submit verification request")
+		return nil, staffError(err, "failed to submit verification request")
+	}
+
+	return s.mapDomainVerificationToProto(ctx, created), nil
+}
+
+// GetStorefrontVerification returns a storefront's verification status, latest request and history
+func (s *Server) GetStorefrontVerification(ctx context.Context, req *listingspb.GetStorefrontVerificationRequest) (*listingspb.GetStorefrontVerificationResponse, error) {
+	if req.StorefrontId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id must be positive")
+	}
+
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
+	storefront, err := s.storefrontService.GetStorefront(ctx, &req.StorefrontId, nil, nil)
+	if err != nil {
+		return nil, staffError(err, "failed to get storefront")
+	}
+
+	latest, events, err := s.storefrontService.GetVerification(ctx, req.StorefrontId)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("failed to get verification")
+		return nil, staffError(err, "failed to get verification")
+	}
+
+	resp := &listingspb.GetStorefrontVerificationResponse{
+		IsVerified:       storefront.IsVerified,
+		VerificationDate: mapTimeToProtoTimestamp(storefront.VerificationDate),
+		AddressVerified:  storefront.AddressVerified,
+		History:          make([]*listingspb.StorefrontVerificationEvent, 0, len(events)),
+	}
+	if latest != nil {
+		resp.LatestRequest = s.mapDomainVerificationToProto(ctx, latest)
+	}
+	for _, e := range events {
+		resp.History = append(resp.History, &listingspb.StorefrontVerificationEvent{
+			Id:        e.ID,
+			RequestId: e.RequestID,
+			Action:    e.Action,
+			ActorId:   e.ActorID,
+			Reason:    e.Reason,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// CancelStorefrontVerification withdraws a storefront's pending verification request
+func (s *Server) CancelStorefrontVerification(ctx context.Context, req *listingspb.GetStorefrontVerificationRequest) (*listingspb.StorefrontVerification, error) {
+	if req.StorefrontId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id must be positive")
+	}
+
+	userID, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.storefrontService.CancelVerification(ctx, req.StorefrontId, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("failed to cancel verification request")
+		return nil, staffError(err, "failed to cancel verification request")
+	}
+
+	return s.mapDomainVerificationToProto(ctx, cancelled), nil
+}
+
+// ListVerificationRequests returns the verification review queue (admin only)
+func (s *Server) ListVerificationRequests(ctx context.Context, req *listingspb.ListVerificationRequestsRequest) (*listingspb.ListVerificationRequestsResponse, error) {
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Msg("non-admin attempted to list verification requests")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	requests, total, err := s.storefrontService.ListVerificationQueue(ctx, req.Status, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list verification requests")
+		return nil, staffError(err, "failed to list verification requests")
+	}
+
+	resp := &listingspb.ListVerificationRequestsResponse{
+		Requests: make([]*listingspb.StorefrontVerification, 0, len(requests)),
+		Total:    total,
+	}
+	for _, r := range requests {
+		resp.Requests = append(resp.Requests, s.mapDomainVerificationToProto(ctx, r))
+	}
+
+	return resp, nil
+}
+
+// ReviewStorefrontVerification approves or rejects a pending verification request (admin only)
+func (s *Server) ReviewStorefrontVerification(ctx context.Context, req *listingspb.ReviewStorefrontVerificationRequest) (*listingspb.StorefrontVerification, error) {
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Int64("request_id", req.RequestId).Msg("non-admin attempted to review verification request")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.RequestId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "request ID must be greater than 0")
+	}
+
+	reviewed, err := s.storefrontService.ReviewVerification(ctx, &domain.VerificationDecision{
+		RequestID:  req.RequestId,
+		ReviewerID: userID,
+		Approve:    req.Approve,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("request_id", req.RequestId).Msg("failed to review verification request")
+		return nil, staffError(err, "failed to review verification request")
+	}
+
+	return s.mapDomainVerificationToProto(ctx, reviewed), nil
+}
+
+// RevokeStorefrontVerification removes the verified status of a storefront (admin only)
+func (s *Server) RevokeStorefrontVerification(ctx context.Context, req *listingspb.RevokeStorefrontVerificationRequest) (*listingspb.StorefrontFull, error) {
+	userID, isAdmin, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		s.logger.Warn().Int64("user_id", userID).Int64("storefront_id", req.StorefrontId).Msg("non-admin attempted to revoke verification")
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	if req.StorefrontId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id must be positive")
+	}
+
+	storefront, err := s.storefrontService.RevokeVerification(ctx, req.StorefrontId, userID, req.Reason)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("storefront_id", req.StorefrontId).Msg("failed to revoke verification")
+		return nil, staffError(err, "failed to revoke verification")
+	}
+
+	return mapDomainStorefrontToProto(storefront), nil
+}
+
+// mapDomainVerificationToProto maps a verification request, presigning its document URLs
+func (s *Server) mapDomainVerificationToProto(ctx context.Context, r *domain.StorefrontVerificationRequest) *listingspb.StorefrontVerification {
+	pb := &listingspb.StorefrontVerification{
+		Id:                 r.ID,
+		StorefrontId:       r.StorefrontID,
+		SubmittedBy:        r.SubmittedBy,
+		Status:             r.Status,
+		BusinessName:       r.BusinessName,
+		RegistrationNumber: r.RegistrationNumber,
+		TaxId:              r.TaxID,
+		Notes:              r.Notes,
+		ReviewedBy:         r.ReviewedBy,
+		ReviewedAt:         mapTimeToProtoTimestamp(r.ReviewedAt),
+		RejectionReason:    r.RejectionReason,
+		Documents:          make([]*listingspb.StorefrontVerificationDocument, 0, len(r.Documents)),
+		CreatedAt:          timestamppb.New(r.CreatedAt),
+		UpdatedAt:          timestamppb.New(r.UpdatedAt),
+	}
+
+	for _, doc := range r.Documents {
+		docPb := &listingspb.StorefrontVerificationDocument{
+			Id:           doc.ID,
+			DocumentType: doc.DocumentType,
+			FileName:     doc.FileName,
+			ContentType:  doc.ContentType,
+			FileSize:     doc.FileSize,
+			CreatedAt:    timestamppb.New(doc.CreatedAt),
+		}
+		if s.minioClient != nil {
+			url, err := s.minioClient.GetPresignedURL(ctx, doc.StoragePath, verificationDocumentURLTTL)
+			if err != nil {
+				s.logger.Warn().Err(err).Int64("document_id", doc.ID).Msg("failed to presign verification document")
+			} else {
+				docPb.Url = url
+			}
+		}
+		pb.Documents = append(pb.Documents, docPb)
+	}
+
+	return pb
+}
@@ -108,6 +108,10 @@ func (s *Server) UpdateStorefront(ctx context.Context, req *listingspb.UpdateSto
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.Id, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
 	serviceReq := &listings.UpdateStorefrontRequest{
 		Name:                getOptionalStringPtr(req.Name),
 		Description:         getOptionalStringPtr(req.Description),
@@ -124,6 +128,7 @@ func (s *Server) UpdateStorefront(ctx context.Context, req *listingspb.UpdateSto
 		AIAgentEnabled:      getOptionalBoolPtr(req.AiAgentEnabled),
 		LiveShoppingEnabled: getOptionalBoolPtr(req.LiveShoppingEnabled),
 		GroupBuyingEnabled:  getOptionalBoolPtr(req.GroupBuyingEnabled),
+		Timezone:            getOptionalStringPtr(req.Timezone),
 	}
 
 	storefront, err := s.storefrontService.UpdateStorefront(ctx, req.Id, serviceReq)
@@ -145,6 +150,10 @@ func (s *Server) DeleteStorefront(ctx context.Context, req *listingspb.DeleteSto
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.Id, domain.PermissionStorefrontDelete); err != nil {
+		return nil, err
+	}
+
 	err := s.storefrontService.DeleteStorefront(ctx, req.Id, req.HardDelete)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Failed to delete storefront")
@@ -189,26 +198,11 @@ func (s *Server) GetMyStorefronts(ctx context.Context, req *listingspb.ListStore
 	return s.ListStorefronts(ctx, req)
 }
 
-// AddStaff adds a staff member to a storefront
+// AddStaff is deprecated: staff members join by accepting an invitation (InviteStaff)
 func (s *Server) AddStaff(ctx context.Context, req *listingspb.AddStaffRequest) (*listingspb.StorefrontStaff, error) {
-	s.logger.Info().Int64("storefront_id", req.StorefrontId).Int64("user_id", req.UserId).Msg("AddStaff called")
-
-	if req.StorefrontId == 0 || req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "storefront_id and user_id are required")
-	}
-
-	role := mapProtoStaffRoleToDomain(req.Role)
-	permissions := mapProtoStructToJSONB(req.Permissions)
+	s.logger.Warn().Int64("storefront_id", req.StorefrontId).Int64("user_id", req.UserId).Msg("deprecated AddStaff called")
 
-	staff, err := s.storefrontService.AddStaff(ctx, req.StorefrontId, req.UserId, role, permissions)
-	if err != nil {
-		s.logger.Error().Err(err).Msg("Failed to add staff")
-		return nil, status.Errorf(codes.Internal, "failed to add staff: %v", err)
-	}
-
-	s.logger.Info().Int64("staff_id", staff.ID).Msg("Staff added successfully")
-
-	return mapDomainStaffToProto(staff), nil
+	return nil, status.Error(codes.FailedPrecondition, "staff members are added by invitation: use InviteStaff")
 }
 
 // UpdateStaff updates a staff member
@@ -219,6 +213,11 @@ func (s *Server) UpdateStaff(ctx context.Context, req *listingspb.UpdateStaffReq
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	actorID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	update := &domain.StaffUpdate{}
 	if req.Role != nil {
 		role := mapProtoStaffRoleToDomain(*req.Role)
@@ -228,15 +227,15 @@ func (s *Server) UpdateStaff(ctx context.Context, req *listingspb.UpdateStaffReq
 		update.Permissions = mapProtoStructToJSONB(req.Permissions)
 	}
 
-	_, err := s.storefrontService.UpdateStaff(ctx, req.Id, update)
+	staff, err := s.storefrontService.UpdateStaff(ctx, actorID, req.Id, update)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Failed to update staff")
-		return nil, status.Errorf(codes.Internal, "failed to update staff: %v", err)
+		return nil, staffError(err, "failed to update staff")
 	}
 
 	s.logger.Info().Int64("staff_id", req.Id).Msg("Staff updated successfully")
 
-	return &listingspb.StorefrontStaff{Id: req.Id}, nil
+	return mapDomainStaffToProto(staff), nil
 }
 
 // RemoveStaff removes a staff member from a storefront
@@ -247,10 +246,15 @@ func (s *Server) RemoveStaff(ctx context.Context, req *listingspb.RemoveStaffReq
 		return nil, status.Error(codes.InvalidArgument, "storefront_id and user_id are required")
 	}
 
-	err := s.storefrontService.RemoveStaff(ctx, req.StorefrontId, req.UserId)
+	actorID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storefrontService.RemoveStaff(ctx, actorID, req.StorefrontId, req.UserId)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Failed to remove staff")
-		return nil, status.Errorf(codes.Internal, "failed to remove staff: %v", err)
+		return nil, staffError(err, "failed to remove staff")
 	}
 
 	s.logger.Info().Msg("Staff removed successfully")
@@ -269,6 +273,10 @@ func (s *Server) GetStaff(ctx context.Context, req *listingspb.GetStaffRequest)
 		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+
 	staff, err := s.storefrontService.GetStaff(ctx, req.StorefrontId)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Failed to get staff")
@@ -287,6 +295,137 @@ func (s *Server) GetStaff(ctx context.Context, req *listingspb.GetStaffRequest)
 	}, nil
 }
 
+// InviteStaff invites a user to join the storefront staff
+func (s *Server) InviteStaff(ctx context.Context, req *listingspb.InviteStaffRequest) (*listingspb.StaffInvitation, error) {
+	s.logger.Info().Int64("storefront_id", req.StorefrontId).Int64("user_id", req.UserId).Msg("InviteStaff called")
+
+	if req.StorefrontId == 0 || req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "storefront_id and user_id are required")
+	}
+	if req.Role == listingspb.StaffRole_STAFF_ROLE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	actorID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.storefrontService.InviteStaff(ctx, actorID, &domain.StaffInvitation{
+		StorefrontID: req.StorefrontId,
+		UserID:       req.UserId,
+		Role:         mapProtoStaffRoleToDomain(req.Role),
+		Permissions:  mapProtoStructToJSONB(req.Permissions),
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to invite staff")
+		return nil, staffError(err, "failed to invite staff")
+	}
+
+	s.logger.Info().Int64("invitation_id", invitation.ID).Msg("Staff invitation created successfully")
+
+	return mapDomainStaffInvitationToProto(invitation), nil
+}
+
+// AcceptStaffInvitation accepts an invitation addressed to the caller
+func (s *Server) AcceptStaffInvitation(ctx context.Context, req *listingspb.StaffInvitationActionRequest) (*listingspb.StorefrontStaff, error) {
+	s.logger.Info().Int64("invitation_id", req.InvitationId).Msg("AcceptStaffInvitation called")
+
+	if req.InvitationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invitation_id is required")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staff, err := s.storefrontService.AcceptStaffInvitation(ctx, userID, req.InvitationId)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to accept staff invitation")
+		return nil, staffError(err, "failed to accept staff invitation")
+	}
+
+	s.logger.Info().Int64("staff_id", staff.ID).Msg("Staff invitation accepted successfully")
+
+	return mapDomainStaffToProto(staff), nil
+}
+
+// DeclineStaffInvitation declines an invitation addressed to the caller
+func (s *Server) DeclineStaffInvitation(ctx context.Context, req *listingspb.StaffInvitationActionRequest) (*listingspb.StaffInvitation, error) {
+	s.logger.Info().Int64("invitation_id", req.InvitationId).Msg("DeclineStaffInvitation called")
+
+	if req.InvitationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invitation_id is required")
+	}
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.storefrontService.DeclineStaffInvitation(ctx, userID, req.InvitationId)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to decline staff invitation")
+		return nil, staffError(err, "failed to decline staff invitation")
+	}
+
+	s.logger.Info().Int64("invitation_id", req.InvitationId).Msg("Staff invitation declined successfully")
+
+	return mapDomainStaffInvitationToProto(invitation), nil
+}
+
+// RevokeStaffInvitation withdraws a pending invitation
+func (s *Server) RevokeStaffInvitation(ctx context.Context, req *listingspb.StaffInvitationActionRequest) (*listingspb.StaffInvitation, error) {
+	s.logger.Info().Int64("invitation_id", req.InvitationId).Msg("RevokeStaffInvitation called")
+
+	if req.InvitationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invitation_id is required")
+	}
+
+	actorID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.storefrontService.RevokeStaffInvitation(ctx, actorID, req.InvitationId)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to revoke staff invitation")
+		return nil, staffError(err, "failed to revoke staff invitation")
+	}
+
+	s.logger.Info().Int64("invitation_id", req.InvitationId).Msg("Staff invitation revoked successfully")
+
+	return mapDomainStaffInvitationToProto(invitation), nil
+}
+
+// ListStaffInvitations lists a storefront's invitations or the invitations addressed to the caller
+func (s *Server) ListStaffInvitations(ctx context.Context, req *listingspb.ListStaffInvitationsRequest) (*listingspb.ListStaffInvitationsResponse, error) {
+	s.logger.Info().Msg("ListStaffInvitations called")
+
+	userID, _, err := s.resolveCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.storefrontService.ListStaffInvitations(ctx, userID, req.StorefrontId, req.PendingOnly)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to list staff invitations")
+		return nil, staffError(err, "failed to list staff invitations")
+	}
+
+	protoInvitations := make([]*listingspb.StaffInvitation, len(invitations))
+	for i := range invitations {
+		protoInvitations[i] = mapDomainStaffInvitationToProto(&invitations[i])
+	}
+
+	s.logger.Info().Int("count", len(invitations)).Msg("Staff invitations listed successfully")
+
+	return &listingspb.ListStaffInvitationsResponse{
+		Invitations: protoInvitations,
+	}, nil
+}
+
 // SetWorkingHours sets working hours for a storefront
 func (s *Server) SetWorkingHours(ctx context.Context, req *listingspb.SetWorkingHoursRequest) (*listingspb.GetWorkingHoursResponse, error) {
 	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("SetWorkingHours called")
@@ -295,6 +434,10 @@ func (s *Server) SetWorkingHours(ctx context.Context, req *listingspb.SetWorking
 		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
 	hours := make([]domain.StorefrontHours, len(req.Hours))
 	for i, h := range req.Hours {
 		hours[i] = *mapProtoHoursToDomain(h)
@@ -349,36 +492,6 @@ func (s *Server) GetWorkingHours(ctx context.Context, req *listingspb.GetWorking
 	}, nil
 }
 
-// IsOpenNow checks if a storefront is currently open
-func (s *Server) IsOpenNow(ctx context.Context, req *listingspb.IsOpenNowRequest) (*listingspb.IsOpenNowResponse, error) {
-	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("IsOpenNow called")
-
-	if req.StorefrontId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
-	}
-
-	isOpen, nextOpen, nextClose, err := s.storefrontService.IsOpenNow(ctx, req.StorefrontId)
-	if err != nil {
-		s.logger.Error().Err(err).Msg("Failed to check if open")
-		return nil, status.Errorf(codes.Internal, "failed to check if open: %v", err)
-	}
-
-	response := &listingspb.IsOpenNowResponse{
-		IsOpen: isOpen,
-	}
-
-	if nextOpen != nil {
-		response.NextOpenTime = getStringPtr(nextOpen.Format("15:04:05"))
-	}
-	if nextClose != nil {
-		response.NextCloseTime = getStringPtr(nextClose.Format("15:04:05"))
-	}
-
-	s.logger.Info().Bool("is_open", isOpen).Msg("Open status checked successfully")
-
-	return response, nil
-}
-
 // SetPaymentMethods sets payment methods for a storefront
 func (s *Server) SetPaymentMethods(ctx context.Context, req *listingspb.SetPaymentMethodsRequest) (*listingspb.GetPaymentMethodsResponse, error) {
 	s.logger.Info().Int64("storefront_id", req.StorefrontId).Msg("SetPaymentMethods called")
@@ -387,6 +500,10 @@ func (s *Server) SetPaymentMethods(ctx context.Context, req *listingspb.SetPayme
 		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
 	methods := make([]domain.PaymentMethod, len(req.Methods))
 	for i, m := range req.Methods {
 		methods[i] = *mapProtoPaymentMethodToDomain(m)
@@ -449,6 +566,10 @@ func (s *Server) SetDeliveryOptions(ctx context.Context, req *listingspb.SetDeli
 		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionSettingsWrite); err != nil {
+		return nil, err
+	}
+
 	options := make([]domain.StorefrontDeliveryOption, len(req.Options))
 	for i, o := range req.Options {
 		options[i] = *mapProtoDeliveryOptionToDomain(o)
@@ -545,6 +666,10 @@ func (s *Server) GetDashboardStats(ctx context.Context, req *listingspb.Dashboar
 		return nil, status.Error(codes.InvalidArgument, "storefront_id is required")
 	}
 
+	if _, err := s.authorizeStorefront(ctx, req.StorefrontId, domain.PermissionAnalyticsRead); err != nil {
+		return nil, err
+	}
+
 	var fromTime, toTime *time.Time
 	if req.DateFrom != nil {
 		t := req.DateFrom.AsTime()
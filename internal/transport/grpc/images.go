@@ -13,7 +13,7 @@ import (
 )
 
 // DeleteListingImage implements TRUE MICROSERVICE pattern for image deletion
-// Includes: Authorization, MinIO cleanup (original + thumbnail), DB deletion, compensating transactions
+// Includes: Authorization, MinIO cleanup (original + thumbnail + renditions), DB deletion, compensating transactions
 func (s *Server) DeleteListingImage(ctx context.Context, req *listingspb.DeleteListingImageRequest) (*listingspb.DeleteListingImageResponse, error) {
 	s.logger.Info().
 		Int64("listing_id", req.ListingId).
@@ -82,7 +82,7 @@ func (s *Server) DeleteListingImage(ctx context.Context, req *listingspb.DeleteL
 	}
 
 	// ============================================================================
-	// MINIO DELETION: Delete original + thumbnail
+	// MINIO DELETION: Delete original + thumbnail + renditions
 	// ============================================================================
 
 	if s.minioClient == nil {
@@ -114,6 +114,13 @@ func (s *Server) DeleteListingImage(ctx context.Context, req *listingspb.DeleteL
 		} else {
 			s.logger.Debug().Str("key", thumbnailKey).Msg("thumbnail deleted from MinIO")
 		}
+
+		// Delete renditions (non-critical, same as thumbnail)
+		for _, rendition := range image.Renditions {
+			if err := s.minioClient.DeleteImage(ctx, rendition.StoragePath); err != nil {
+				s.logger.Warn().Err(err).Str("key", rendition.StoragePath).Msg("failed to delete rendition from MinIO (non-critical)")
+			}
+		}
 	} else {
 		s.logger.Warn().Int64("image_id", req.ImageId).Msg("no storage_path found, skipping MinIO deletion")
 	}
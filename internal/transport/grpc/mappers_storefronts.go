@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -62,6 +63,13 @@ func mapDomainStorefrontToProto(s *domain.Storefront) *listingspb.StorefrontFull
 		FollowersCount:        s.FollowersCount,
 		CreatedAt:             timestamppb.New(s.CreatedAt),
 		UpdatedAt:             timestamppb.New(s.UpdatedAt),
+		VacationMode:          s.VacationMode,
+		VacationStartsAt:      mapTimeToProtoTimestamp(s.VacationStartsAt),
+		VacationEndsAt:        mapTimeToProtoTimestamp(s.VacationEndsAt),
+		VacationMessage:       getOptionalString(s.VacationMessage),
+		VacationOrderPolicy:   s.VacationOrderPolicy,
+		OnVacation:            s.IsOnVacation(time.Now()),
+		Timezone:              s.Location().String(),
 	}
 
 	if len(s.Staff) > 0 {
@@ -102,15 +110,36 @@ func mapDomainStaffToProto(s *domain.StorefrontStaff) *listingspb.StorefrontStaf
 	}
 
 	return &listingspb.StorefrontStaff{
-		Id:           s.ID,
-		StorefrontId: s.StorefrontID,
-		UserId:       s.UserID,
-		Role:         mapDomainStaffRoleToProto(s.Role),
-		Permissions:  mapJSONBToProtoStruct(s.Permissions),
-		LastActiveAt: mapTimeToProtoTimestamp(s.LastActiveAt),
-		ActionsCount: s.ActionsCount,
-		CreatedAt:    timestamppb.New(s.CreatedAt),
-		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+		Id:                   s.ID,
+		StorefrontId:         s.StorefrontID,
+		UserId:               s.UserID,
+		Role:                 mapDomainStaffRoleToProto(s.Role),
+		Permissions:          mapJSONBToProtoStruct(s.Permissions),
+		LastActiveAt:         mapTimeToProtoTimestamp(s.LastActiveAt),
+		ActionsCount:         s.ActionsCount,
+		CreatedAt:            timestamppb.New(s.CreatedAt),
+		UpdatedAt:            timestamppb.New(s.UpdatedAt),
+		EffectivePermissions: s.EffectivePermissions(),
+	}
+}
+
+// mapDomainStaffInvitationToProto maps domain.StaffInvitation to proto StaffInvitation
+func mapDomainStaffInvitationToProto(i *domain.StaffInvitation) *listingspb.StaffInvitation {
+	if i == nil {
+		return nil
+	}
+
+	return &listingspb.StaffInvitation{
+		Id:           i.ID,
+		StorefrontId: i.StorefrontID,
+		UserId:       i.UserID,
+		Role:         mapDomainStaffRoleToProto(i.Role),
+		Permissions:  mapJSONBToProtoStruct(i.Permissions),
+		InvitedBy:    i.InvitedBy,
+		Status:       mapDomainStaffInvitationStatusToProto(i.EffectiveStatus(time.Now())),
+		ExpiresAt:    timestamppb.New(i.ExpiresAt),
+		RespondedAt:  mapTimeToProtoTimestamp(i.RespondedAt),
+		CreatedAt:    timestamppb.New(i.CreatedAt),
 	}
 }
 
@@ -132,6 +161,64 @@ func mapDomainHoursToProto(h *domain.StorefrontHours) *listingspb.StorefrontHour
 	}
 }
 
+// mapDomainHolidayToProto maps domain.StorefrontHoliday to proto StorefrontHoliday
+func mapDomainHolidayToProto(h *domain.StorefrontHoliday) *listingspb.StorefrontHoliday {
+	if h == nil {
+		return nil
+	}
+
+	return &listingspb.StorefrontHoliday{
+		Id:              h.ID,
+		StorefrontId:    h.StorefrontID,
+		Name:            h.Name,
+		StartDate:       h.StartDate.Format("2006-01-02"),
+		EndDate:         h.EndDate.Format("2006-01-02"),
+		RecurringYearly: h.RecurringYearly,
+	}
+}
+
+// mapProtoHolidayToDomain maps proto StorefrontHoliday to domain.StorefrontHoliday
+func mapProtoHolidayToDomain(h *listingspb.StorefrontHoliday) (*domain.StorefrontHoliday, error) {
+	startDate, err := time.Parse("2006-01-02", h.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_date %q: expected YYYY-MM-DD", h.StartDate)
+	}
+	endDate, err := time.Parse("2006-01-02", h.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_date %q: expected YYYY-MM-DD", h.EndDate)
+	}
+
+	return &domain.StorefrontHoliday{
+		Name:            h.Name,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		RecurringYearly: h.RecurringYearly,
+	}, nil
+}
+
+// mapDomainStorefrontDomainToProto maps domain.StorefrontDomain to proto StorefrontDomain
+func mapDomainStorefrontDomainToProto(d *domain.StorefrontDomain) *listingspb.StorefrontDomain {
+	if d == nil {
+		return nil
+	}
+
+	pb := &listingspb.StorefrontDomain{
+		Id:                 d.ID,
+		StorefrontId:       d.StorefrontID,
+		Domain:             d.Domain,
+		IsPrimary:          d.IsPrimary,
+		IsVerified:         d.IsVerified(),
+		VerificationRecord: d.VerificationRecord(),
+		VerificationToken:  d.VerificationToken,
+		CreatedAt:          timestamppb.New(d.CreatedAt),
+	}
+	if d.VerifiedAt != nil {
+		pb.VerifiedAt = timestamppb.New(*d.VerifiedAt)
+	}
+
+	return pb
+}
+
 // mapProtoHoursToDomain maps proto StorefrontHours to domain.StorefrontHours
 func mapProtoHoursToDomain(h *listingspb.StorefrontHours) *domain.StorefrontHours {
 	if h == nil {
@@ -465,6 +552,23 @@ func mapDomainStaffRoleToProto(role string) listingspb.StaffRole {
 	}
 }
 
+func mapDomainStaffInvitationStatusToProto(status string) listingspb.StaffInvitationStatus {
+	switch status {
+	case domain.StaffInvitationPending:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_PENDING
+	case domain.StaffInvitationAccepted:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_ACCEPTED
+	case domain.StaffInvitationDeclined:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_DECLINED
+	case domain.StaffInvitationRevoked:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_REVOKED
+	case domain.StaffInvitationExpired:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_EXPIRED
+	default:
+		return listingspb.StaffInvitationStatus_STAFF_INVITATION_STATUS_UNSPECIFIED
+	}
+}
+
 func mapProtoStaffRoleToDomain(role listingspb.StaffRole) string {
 	switch role {
 	case listingspb.StaffRole_STAFF_ROLE_OWNER:
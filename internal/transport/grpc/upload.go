@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"image"
 	_ "image/gif"
-	"image/jpeg"
 	_ "image/jpeg"
 	_ "image/png"
 	"io"
@@ -14,32 +13,24 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/nfnt/resize"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
 	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
 )
 
 const (
-	maxImageSize      = 10 * 1024 * 1024 // 10MB per image
-	maxTotalSize      = 50 * 1024 * 1024 // 50MB total per upload batch
-	maxFiles          = 10               // Maximum files per upload
-	thumbnailSize     = 200              // Thumbnail dimensions (200x200px)
-	thumbnailQuality  = 85               // JPEG quality for thumbnails
-	chunkSize         = 1024 * 1024      // 1MB chunks
-	minioPresignedTTL = 24 * time.Hour   // Presigned URL expiry
+	maxImageSize      = imaging.MaxImageSize // 10MB per image
+	maxTotalSize      = imaging.MaxTotalSize // 50MB total per upload batch
+	maxFiles          = imaging.MaxFiles     // Maximum files per upload
+	chunkSize         = 1024 * 1024          // 1MB chunks
+	minioPresignedTTL = 24 * time.Hour       // Presigned URL expiry
 )
 
-var allowedExtensions = map[string]bool{
-	".jpg":  true,
-	".jpeg": true,
-	".png":  true,
-	".gif":  true,
-	".webp": true,
-}
+// allowedExtensions are the accepted file extensions (shared with all image uploads)
+var allowedExtensions = imaging.AllowedExtensions
 
 // UploadListingImages implements streaming image upload with authorization and thumbnail generation
 func (s *Server) UploadListingImages(stream listingspb.ListingsService_UploadListingImagesServer) error {
@@ -195,14 +186,18 @@ func (s *Server) UploadListingImages(stream listingspb.ListingsService_UploadLis
 	return stream.SendAndClose(response)
 }
 
-// processImageUpload processes a single image: decode, generate thumbnail, upload to MinIO, save to DB
+// processImageUpload stores a single image and queues it for processing: the original is
+// uploaded to MinIO as received and saved with status "pending". Decoding, EXIF stripping,
+// duplicate checks, thumbnail and renditions are done by the image processing worker.
+// The raw original still carries its EXIF metadata (e.g. GPS), so the image has no URL
+// until the worker has replaced it.
 func (s *Server) processImageUpload(ctx context.Context, metadata *listingspb.UploadImageMetadata, fileBuffer *bytes.Buffer) (*listingspb.ListingImage, error) {
 	if s.minioClient == nil {
 		return nil, fmt.Errorf("MinIO client not configured")
 	}
 
-	// Validate file is actually an image by decoding
-	img, format, err := image.Decode(bytes.NewReader(fileBuffer.Bytes()))
+	// Cheap header check; the worker fully decodes the image
+	config, format, err := image.DecodeConfig(bytes.NewReader(fileBuffer.Bytes()))
 	if err != nil {
 		return nil, fmt.Errorf("invalid image format: %w", err)
 	}
@@ -210,33 +205,17 @@ func (s *Server) processImageUpload(ctx context.Context, metadata *listingspb.Up
 	s.logger.Debug().
 		Str("filename", metadata.Filename).
 		Str("format", format).
-		Int("width", img.Bounds().Dx()).
-		Int("height", img.Bounds().Dy()).
-		Msg("image decoded successfully")
-
-	// Generate thumbnail (200x200px, maintain aspect ratio)
-	thumbnailImg := resize.Thumbnail(thumbnailSize, thumbnailSize, img, resize.Lanczos3)
-	var thumbnailBuf bytes.Buffer
-	if err := jpeg.Encode(&thumbnailBuf, thumbnailImg, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
-		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
-	}
-
-	s.logger.Debug().
-		Str("filename", metadata.Filename).
-		Int("thumbnail_size", thumbnailBuf.Len()).
-		Msg("thumbnail generated")
+		Int("width", config.Width).
+		Int("height", config.Height).
+		Msg("image header validated")
 
-	// Generate MinIO object keys
+	// Generate MinIO object key (thumbnail and renditions are stored next to it)
 	ext := strings.ToLower(filepath.Ext(metadata.Filename))
 	if ext == "" {
 		ext = "." + format // Use detected format if no extension
 	}
 
-	timestamp := time.Now().UnixNano()
-	uniqueID := uuid.New().String()[:8]
-
-	originalKey := fmt.Sprintf("listings/%d/%d_%s%s", metadata.ListingId, timestamp, uniqueID, ext)
-	thumbnailKey := fmt.Sprintf("listings/%d/%d_%s_thumb.jpg", metadata.ListingId, timestamp, uniqueID)
+	originalKey := imaging.NewObjectKey(imaging.OwnerListings, metadata.ListingId, "", ext)
 
 	// Upload original image to MinIO
 	if err := s.minioClient.UploadImage(ctx, originalKey, bytes.NewReader(fileBuffer.Bytes()), int64(fileBuffer.Len()), metadata.ContentType); err != nil {
@@ -245,58 +224,28 @@ func (s *Server) processImageUpload(ctx context.Context, metadata *listingspb.Up
 
 	s.logger.Debug().Str("key", originalKey).Msg("original image uploaded to MinIO")
 
-	// Upload thumbnail to MinIO
-	if err := s.minioClient.UploadImage(ctx, thumbnailKey, &thumbnailBuf, int64(thumbnailBuf.Len()), "image/jpeg"); err != nil {
-		// Compensating transaction: Delete original image
-		if delErr := s.minioClient.DeleteImage(ctx, originalKey); delErr != nil {
-			s.logger.Error().Err(delErr).Str("key", originalKey).Msg("failed to cleanup original image after thumbnail upload failure")
-		}
-		return nil, fmt.Errorf("failed to upload thumbnail: %w", err)
-	}
-
-	s.logger.Debug().Str("key", thumbnailKey).Msg("thumbnail uploaded to MinIO")
-
-	// Generate presigned URLs (24h expiry)
-	originalURL, err := s.minioClient.GetPresignedURL(ctx, originalKey, minioPresignedTTL)
-	if err != nil {
-		// Compensating transaction: Delete both images
-		_ = s.minioClient.DeleteImage(ctx, originalKey)
-		_ = s.minioClient.DeleteImage(ctx, thumbnailKey)
-		return nil, fmt.Errorf("failed to generate presigned URL for original: %w", err)
-	}
-
-	thumbnailURL, err := s.minioClient.GetPresignedURL(ctx, thumbnailKey, minioPresignedTTL)
-	if err != nil {
-		// Compensating transaction: Delete both images
-		_ = s.minioClient.DeleteImage(ctx, originalKey)
-		_ = s.minioClient.DeleteImage(ctx, thumbnailKey)
-		return nil, fmt.Errorf("failed to generate presigned URL for thumbnail: %w", err)
-	}
-
-	// Save image metadata to database
-	width := int32(img.Bounds().Dx())
-	height := int32(img.Bounds().Dy())
+	// Save image metadata to database and enqueue the processing job
+	width := int32(config.Width)
+	height := int32(config.Height)
 	fileSize := int64(fileBuffer.Len())
 	mimeType := metadata.ContentType
 
 	dbImage := &domain.ListingImage{
-		ListingID:    metadata.ListingId,
-		URL:          originalURL,
-		StoragePath:  &originalKey,
-		ThumbnailURL: &thumbnailURL,
-		DisplayOrder: metadata.DisplayOrder,
-		IsPrimary:    metadata.IsPrimary,
-		Width:        &width,
-		Height:       &height,
-		FileSize:     &fileSize,
-		MimeType:     &mimeType,
+		ListingID:        metadata.ListingId,
+		StoragePath:      &originalKey,
+		DisplayOrder:     metadata.DisplayOrder,
+		IsPrimary:        metadata.IsPrimary,
+		Width:            &width,
+		Height:           &height,
+		FileSize:         &fileSize,
+		MimeType:         &mimeType,
+		ProcessingStatus: domain.ImageStatusPending,
 	}
 
 	savedImage, err := s.service.AddImage(ctx, dbImage)
 	if err != nil {
-		// Compensating transaction: Delete both images from MinIO
+		// Compensating transaction: Delete original from MinIO
 		_ = s.minioClient.DeleteImage(ctx, originalKey)
-		_ = s.minioClient.DeleteImage(ctx, thumbnailKey)
 		return nil, fmt.Errorf("failed to save image to database: %w", err)
 	}
 
@@ -304,23 +253,9 @@ func (s *Server) processImageUpload(ctx context.Context, metadata *listingspb.Up
 		Int64("image_id", savedImage.ID).
 		Int64("listing_id", metadata.ListingId).
 		Str("filename", metadata.Filename).
-		Msg("image uploaded and saved successfully")
-
-	// Convert to proto response
-	pbImage := &listingspb.ListingImage{
-		Id:           savedImage.ID,
-		ListingId:    savedImage.ListingID,
-		Url:          savedImage.URL,
-		ThumbnailUrl: savedImage.ThumbnailURL,
-		DisplayOrder: savedImage.DisplayOrder,
-		IsPrimary:    savedImage.IsPrimary,
-		Width:        savedImage.Width,
-		Height:       savedImage.Height,
-		FileSize:     savedImage.FileSize,
-		MimeType:     savedImage.MimeType,
-	}
+		Msg("image uploaded and queued for processing")
 
-	return pbImage, nil
+	return DomainToProtoImage(savedImage), nil
 }
 
 // validateImageMetadata validates upload metadata
@@ -331,32 +266,6 @@ func (s *Server) validateImageMetadata(metadata *listingspb.UploadImageMetadata)
 	if metadata.UserId <= 0 {
 		return fmt.Errorf("invalid user_id: must be positive")
 	}
-	if metadata.Filename == "" {
-		return fmt.Errorf("filename is required")
-	}
-	if metadata.FileSize <= 0 {
-		return fmt.Errorf("file_size must be positive")
-	}
-	if metadata.FileSize > maxImageSize {
-		return fmt.Errorf("file_size exceeds %dMB limit", maxImageSize/(1024*1024))
-	}
-
-	// Validate extension
-	ext := strings.ToLower(filepath.Ext(metadata.Filename))
-	if ext == "" {
-		return fmt.Errorf("filename must have an extension")
-	}
-	if !allowedExtensions[ext] {
-		return fmt.Errorf("unsupported file extension: %s (allowed: jpg, jpeg, png, gif, webp)", ext)
-	}
-
-	// Validate content type
-	if metadata.ContentType == "" {
-		return fmt.Errorf("content_type is required")
-	}
-	if !strings.HasPrefix(metadata.ContentType, "image/") {
-		return fmt.Errorf("invalid content_type: must be image/*")
-	}
 
-	return nil
+	return imaging.ValidateFile(metadata.Filename, metadata.ContentType, metadata.FileSize)
 }
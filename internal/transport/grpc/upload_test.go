@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	listingspb "github.com/sveturs/listings/api/proto/listings/v1"
+	"github.com/sveturs/listings/internal/imaging"
 )
 
 // TestValidateImageMetadata tests metadata validation
@@ -153,10 +154,10 @@ func TestConstants(t *testing.T) {
 	if maxFiles != 10 {
 		t.Errorf("maxFiles = %d, want 10", maxFiles)
 	}
-	if thumbnailSize != 200 {
-		t.Errorf("thumbnailSize = %d, want 200", thumbnailSize)
+	if imaging.ThumbnailSize != 200 {
+		t.Errorf("ThumbnailSize = %d, want 200", imaging.ThumbnailSize)
 	}
-	if thumbnailQuality != 85 {
-		t.Errorf("thumbnailQuality = %d, want 85", thumbnailQuality)
+	if imaging.ThumbnailQuality != 85 {
+		t.Errorf("ThumbnailQuality = %d, want 85", imaging.ThumbnailQuality)
 	}
 }
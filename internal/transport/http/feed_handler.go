@@ -0,0 +1,66 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/service/feed"
+)
+
+// FeedService defines the feed operations used by the HTTP handler
+type FeedService interface {
+	GetFeed(ctx context.Context, slug string, format domain.FeedFormat) ([]byte, error)
+}
+
+// FeedHandler serves product feeds for external shopping channels
+type FeedHandler struct {
+	service FeedService
+	maxAge  time.Duration
+	logger  zerolog.Logger
+}
+
+// NewFeedHandler creates a new product feed handler (maxAge controls Cache-Control)
+func NewFeedHandler(service FeedService, maxAge time.Duration, logger zerolog.Logger) *FeedHandler {
+	return &FeedHandler{
+		service: service,
+		maxAge:  maxAge,
+		logger:  logger.With().Str("component", "feed_handler").Logger(),
+	}
+}
+
+// RegisterRoutes registers product feed routes on the given Fiber app
+func (h *FeedHandler) RegisterRoutes(app *fiber.App) {
+	app.Get("/feeds/:slug/google.xml", h.handle(domain.FeedFormatGoogleMerchant))
+	app.Get("/feeds/:slug/facebook.csv", h.handle(domain.FeedFormatFacebook))
+}
+
+// handle returns a handler serving the storefront feed in the given format
+func (h *FeedHandler) handle(format domain.FeedFormat) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		slug := c.Params("slug")
+		if slug == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "storefront slug is required"})
+		}
+
+		data, err := h.service.GetFeed(c.Context(), slug, format)
+		if err != nil {
+			if errors.Is(err, feed.ErrStorefrontNotFound) || errors.Is(err, feed.ErrStorefrontInactive) {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "storefront not found"})
+			}
+			h.logger.Error().Err(err).Str("slug", slug).Str("format", string(format)).Msg("failed to generate feed")
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to generate feed"})
+		}
+
+		c.Set(fiber.HeaderContentType, format.ContentType())
+		if h.maxAge > 0 {
+			c.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds())))
+		}
+		return c.Send(data)
+	}
+}
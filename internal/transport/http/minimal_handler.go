@@ -104,7 +104,7 @@ func (h *MinimalHandler) ListListings(c *fiber.Ctx) error {
 }
 
 // StartMinimalServer starts minimal HTTP server
-func StartMinimalServer(host string, port int, handler *MinimalHandler, healthHandler *HealthHandler, wsHandler *ChatWebSocketHandler, logger zerolog.Logger) (*fiber.App, error) {
+func StartMinimalServer(host string, port int, handler *MinimalHandler, healthHandler *HealthHandler, wsHandler *ChatWebSocketHandler, feedHandler *FeedHandler, sitemapHandler *SitemapHandler, logger zerolog.Logger) (*fiber.App, error) {
 	app := fiber.New(fiber.Config{
 		AppName:      "Listings Service",
 		ReadTimeout:  30 * time.Second,
@@ -124,6 +124,16 @@ func StartMinimalServer(host string, port int, handler *MinimalHandler, healthHa
 		wsHandler.RegisterWebSocketRoute(app)
 	}
 
+	// Register product feed routes (Google Merchant, Facebook catalog)
+	if feedHandler != nil {
+		feedHandler.RegisterRoutes(app)
+	}
+
+	// Register sitemap.xml and robots.txt routes
+	if sitemapHandler != nil {
+		sitemapHandler.RegisterRoutes(app)
+	}
+
 	addr := fmt.Sprintf("%s:%d", host, port)
 	go func() {
 		if err := app.Listen(addr); err != nil {
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/service/sitemap"
+)
+
+// SitemapService defines the sitemap operations used by the HTTP handler
+type SitemapService interface {
+	HostSitemap(ctx context.Context, host string, page int) ([]byte, error)
+	StorefrontSitemap(ctx context.Context, slug string, page int) ([]byte, error)
+	Robots(ctx context.Context, host string) ([]byte, error)
+}
+
+// SitemapHandler serves sitemap.xml and robots.txt for the marketplace and for storefront
+// custom domains (the host header selects which)
+type SitemapHandler struct {
+	service SitemapService
+	maxAge  time.Duration
+	logger  zerolog.Logger
+}
+
+// NewSitemapHandler creates a new sitemap handler (maxAge controls Cache-Control)
+func NewSitemapHandler(service SitemapService, maxAge time.Duration, logger zerolog.Logger) *SitemapHandler {
+	return &SitemapHandler{
+		service: service,
+		maxAge:  maxAge,
+		logger:  logger.With().Str("component", "sitemap_handler").Logger(),
+	}
+}
+
+// RegisterRoutes registers sitemap and robots.txt routes on the given Fiber app
+func (h *SitemapHandler) RegisterRoutes(app *fiber.App) {
+	app.Get("/robots.txt", h.robots)
+	app.Get("/sitemap.xml", h.hostSitemap)
+	app.Get("/sitemap-:page.xml", h.hostSitemap)
+	app.Get("/storefronts/:slug/sitemap.xml", h.storefrontSitemap)
+	app.Get("/storefronts/:slug/sitemap-:page.xml", h.storefrontSitemap)
+}
+
+func (h *SitemapHandler) robots(c *fiber.Ctx) error {
+	host := requestHost(c)
+
+	data, err := h.service.Robots(c.Context(), host)
+	if err != nil {
+		h.logger.Error().Err(err).Str("host", host).Msg("failed to generate robots.txt")
+		return c.Status(fiber.StatusInternalServerError).SendString("failed to generate robots.txt")
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	h.setCacheControl(c)
+	return c.Send(data)
+}
+
+func (h *SitemapHandler) hostSitemap(c *fiber.Ctx) error {
+	page, ok := sitemapPage(c)
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "sitemap not found"})
+	}
+	host := requestHost(c)
+
+	data, err := h.service.HostSitemap(c.Context(), host, page)
+	return h.send(c, data, err, host)
+}
+
+func (h *SitemapHandler) storefrontSitemap(c *fiber.Ctx) error {
+	page, ok := sitemapPage(c)
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "sitemap not found"})
+	}
+	slug := c.Params("slug")
+
+	data, err := h.service.StorefrontSitemap(c.Context(), slug, page)
+	return h.send(c, data, err, slug)
+}
+
+func (h *SitemapHandler) send(c *fiber.Ctx, data []byte, err error, target string) error {
+	if err != nil {
+		if errors.Is(err, sitemap.ErrStorefrontNotFound) || errors.Is(err, sitemap.ErrPageNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "sitemap not found"})
+		}
+		h.logger.Error().Err(err).Str("target", target).Msg("failed to generate sitemap")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to generate sitemap"})
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationXMLCharsetUTF8)
+	h.setCacheControl(c)
+	return c.Send(data)
+}
+
+func (h *SitemapHandler) setCacheControl(c *fiber.Ctx) {
+	if h.maxAge > 0 {
+		c.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds())))
+	}
+}
+
+// sitemapPage parses the :page route parameter; sitemap.xml itself is page 0
+func sitemapPage(c *fiber.Ctx) (int, bool) {
+	raw := c.Params("page")
+	if raw == "" {
+		return 0, true
+	}
+	page, err := strconv.Atoi(raw)
+	if err != nil || page < 1 {
+		return 0, false
+	}
+	return page, true
+}
+
+// requestHost returns the host the client requested, preferring the proxy's X-Forwarded-Host
+func requestHost(c *fiber.Ctx) string {
+	if forwarded := c.Get(fiber.HeaderXForwardedHost); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	return c.Hostname()
+}
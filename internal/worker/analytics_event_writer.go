@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+// AnalyticsEventRepository defines repository interface for the analytics event writer
+type AnalyticsEventRepository interface {
+	LogEvents(ctx context.Context, events []*domain.AnalyticsEvent) (int64, error)
+}
+
+// AnalyticsEventConfig holds analytics event writer settings
+type AnalyticsEventConfig struct {
+	BufferSize    int           // Events held in memory; further events are dropped until the buffer drains
+	BatchSize     int           // Events written per COPY
+	FlushInterval time.Duration // Longest time an event waits in the buffer
+	WriteTimeout  time.Duration // Timeout of a single COPY
+}
+
+// Drop reasons reported in analytics_events_dropped_total
+const (
+	dropReasonBufferFull  = "buffer_full"
+	dropReasonWriteFailed = "write_failed"
+	dropReasonStopped     = "stopped"
+)
+
+// AnalyticsEventWriter buffers analytics events in memory and writes them in batches.
+// Track never blocks: when the buffer is full (the database is slow or down) events are
+// dropped and counted, so analytics can never slow down the request path.
+type AnalyticsEventWriter struct {
+	repo    AnalyticsEventRepository
+	metrics *metrics.Metrics
+	config  AnalyticsEventConfig
+	events  chan *domain.AnalyticsEvent
+	logger  zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewAnalyticsEventWriter creates a new buffered analytics event writer
+func NewAnalyticsEventWriter(repo AnalyticsEventRepository, metrics *metrics.Metrics, cfg AnalyticsEventConfig, logger zerolog.Logger) *AnalyticsEventWriter {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 10000
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	if cfg.BatchSize > cfg.BufferSize {
+		cfg.BatchSize = cfg.BufferSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 2 * time.Second
+	}
+	if cfg.WriteTimeout <= 0 {
+		cfg.WriteTimeout = 10 * time.Second
+	}
+
+	return &AnalyticsEventWriter{
+		repo:    repo,
+		metrics: metrics,
+		config:  cfg,
+		events:  make(chan *domain.AnalyticsEvent, cfg.BufferSize),
+		logger:  logger.With().Str("component", "analytics_event_writer").Logger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins flushing buffered events
+func (w *AnalyticsEventWriter) Start() error {
+	w.logger.Info().
+		Int("buffer_size", w.config.BufferSize).
+		Int("batch_size", w.config.BatchSize).
+		Dur("flush_interval", w.config.FlushInterval).
+		Msg("starting analytics event writer")
+
+	w.wg.Add(1)
+	go w.loop()
+
+	return nil
+}
+
+// Stop flushes the remaining events and shuts down the writer
+func (w *AnalyticsEventWriter) Stop() error {
+	w.logger.Info().Msg("stopping analytics event writer")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("analytics event writer stopped")
+	return nil
+}
+
+// Track queues events for writing without blocking and returns how many were accepted.
+// Events that do not fit into the buffer are dropped.
+func (w *AnalyticsEventWriter) Track(events ...*domain.AnalyticsEvent) int {
+	if w.ctx.Err() != nil {
+		w.metrics.RecordAnalyticsEventsDropped(dropReasonStopped, len(events))
+		return 0
+	}
+
+	accepted := 0
+	for _, e := range events {
+		select {
+		case w.events <- e:
+			accepted++
+			w.metrics.RecordAnalyticsEventAccepted(e.EventType)
+		default:
+			// Not logged per event: under sustained pressure this would flood the logs
+			w.metrics.RecordAnalyticsEventsDropped(dropReasonBufferFull, 1)
+		}
+	}
+
+	w.metrics.UpdateAnalyticsEventsBuffered(len(w.events))
+
+	return accepted
+}
+
+// loop collects events into batches and writes them when a batch is full or on every tick
+func (w *AnalyticsEventWriter) loop() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*domain.AnalyticsEvent, 0, w.config.BatchSize)
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			w.drain(batch)
+			return
+		case e := <-w.events:
+			batch = append(batch, e)
+			if len(batch) >= w.config.BatchSize {
+				batch = w.flush(batch)
+			}
+		case <-ticker.C:
+			batch = w.flush(batch)
+		}
+	}
+}
+
+// drain writes everything still buffered on shutdown
+func (w *AnalyticsEventWriter) drain(batch []*domain.AnalyticsEvent) {
+	for {
+		select {
+		case e := <-w.events:
+			batch = append(batch, e)
+			if len(batch) >= w.config.BatchSize {
+				batch = w.flush(batch)
+			}
+		default:
+			w.flush(batch)
+			return
+		}
+	}
+}
+
+// flush writes a batch and returns the emptied batch for reuse. Failed batches are
+// dropped rather than retried: retrying would hold the buffer and drop newer events instead.
+func (w *AnalyticsEventWriter) flush(batch []*domain.AnalyticsEvent) []*domain.AnalyticsEvent {
+	defer func() { w.metrics.UpdateAnalyticsEventsBuffered(len(w.events)) }()
+
+	if len(batch) == 0 {
+		return batch
+	}
+
+	// Independent of w.ctx so the final flush on shutdown still completes
+	ctx, cancel := context.WithTimeout(context.Background(), w.config.WriteTimeout)
+	defer cancel()
+
+	start := time.Now()
+	written, err := w.repo.LogEvents(ctx, batch)
+	if err != nil {
+		w.logger.Error().Err(err).Int("events", len(batch)).Msg("failed to write analytics events")
+		w.metrics.RecordAnalyticsEventsDropped(dropReasonWriteFailed, len(batch))
+		w.metrics.RecordError("analytics_event_writer", "write_failed")
+	} else {
+		w.metrics.RecordAnalyticsFlush(written, time.Since(start).Seconds())
+	}
+
+	return batch[:0]
+}
@@ -0,0 +1,182 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+// ExpirationRepository defines repository interface for the expiration worker
+type ExpirationRepository interface {
+	ExpireListings(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	GetListingsForExpiryReminder(ctx context.Context, now, before time.Time, limit int) ([]*domain.ExpiringListing, error)
+	ClaimExpiryReminder(ctx context.Context, listingID int64, expiresAt time.Time) (bool, error)
+}
+
+// ExpiryNotifier sends expiry reminders to listing owners
+type ExpiryNotifier interface {
+	NotifyListingExpiring(ctx context.Context, listing *domain.ExpiringListing, daysLeft int) error
+}
+
+// ExpirationConfig holds expiration worker settings
+type ExpirationConfig struct {
+	Interval     time.Duration // How often expired listings are processed
+	ReminderDays int           // Days before expiration to send a reminder (0 = disabled)
+	BatchSize    int           // Listings processed per batch
+}
+
+// ExpirationWorker moves expired C2C listings to 'expired' and sends expiry reminders
+type ExpirationWorker struct {
+	repo     ExpirationRepository
+	notifier ExpiryNotifier
+	metrics  *metrics.Metrics
+	config   ExpirationConfig
+	logger   zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewExpirationWorker creates a new listing expiration worker (notifier is optional)
+func NewExpirationWorker(repo ExpirationRepository, notifier ExpiryNotifier, metrics *metrics.Metrics, cfg ExpirationConfig, logger zerolog.Logger) *ExpirationWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 15 * time.Minute
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+
+	return &ExpirationWorker{
+		repo:     repo,
+		notifier: notifier,
+		metrics:  metrics,
+		config:   cfg,
+		logger:   logger.With().Str("component", "expiration_worker").Logger(),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start begins periodic expiration processing
+func (w *ExpirationWorker) Start() error {
+	w.logger.Info().
+		Dur("interval", w.config.Interval).
+		Int("reminder_days", w.config.ReminderDays).
+		Msg("starting expiration worker")
+
+	w.wg.Add(1)
+	go w.loop()
+
+	return nil
+}
+
+// Stop gracefully shuts down the worker
+func (w *ExpirationWorker) Stop() error {
+	w.logger.Info().Msg("stopping expiration worker")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("expiration worker stopped")
+	return nil
+}
+
+// loop runs expiration on start and then on every tick
+func (w *ExpirationWorker) loop() {
+	defer w.wg.Done()
+
+	w.RunOnce(w.ctx)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.RunOnce(w.ctx)
+		}
+	}
+}
+
+// RunOnce expires due listings and sends pending reminders
+func (w *ExpirationWorker) RunOnce(ctx context.Context) {
+	now := time.Now()
+
+	w.expireListings(ctx, now)
+
+	if w.config.ReminderDays > 0 && w.notifier != nil {
+		w.sendReminders(ctx, now)
+	}
+}
+
+// expireListings expires listings in batches until none are left
+func (w *ExpirationWorker) expireListings(ctx context.Context, now time.Time) {
+	total := 0
+
+	for {
+		batchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		ids, err := w.repo.ExpireListings(batchCtx, now, w.config.BatchSize)
+		cancel()
+
+		if err != nil {
+			w.logger.Error().Err(err).Msg("failed to expire listings")
+			w.metrics.RecordError("expiration_worker", "expire_failed")
+			return
+		}
+
+		total += len(ids)
+		if len(ids) < w.config.BatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Info().Int("count", total).Msg("expired listings removed from search")
+	}
+}
+
+// sendReminders notifies owners of listings expiring within the reminder window
+func (w *ExpirationWorker) sendReminders(ctx context.Context, now time.Time) {
+	before := now.AddDate(0, 0, w.config.ReminderDays)
+
+	listings, err := w.repo.GetListingsForExpiryReminder(ctx, now, before, w.config.BatchSize)
+	if err != nil {
+		w.logger.Error().Err(err).Msg("failed to get listings for expiry reminder")
+		w.metrics.RecordError("expiration_worker", "reminder_fetch_failed")
+		return
+	}
+
+	sent := 0
+	for _, listing := range listings {
+		// Claim first so that concurrent instances never send the same reminder twice
+		claimed, err := w.repo.ClaimExpiryReminder(ctx, listing.ID, listing.ExpiresAt)
+		if err != nil {
+			w.logger.Error().Err(err).Int64("listing_id", listing.ID).Msg("failed to claim expiry reminder")
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := w.notifier.NotifyListingExpiring(ctx, listing, listing.DaysLeft(now)); err != nil {
+			w.logger.Warn().Err(err).Int64("listing_id", listing.ID).Msg("failed to send expiry reminder")
+			w.metrics.RecordError("expiration_worker", "reminder_failed")
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		w.logger.Info().Int("count", sent).Msg("expiry reminders sent")
+	}
+}
@@ -0,0 +1,246 @@
+package worker
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/imaging"
+	"github.com/sveturs/listings/internal/metrics"
+	"github.com/sveturs/listings/internal/repository/minio"
+)
+
+// ImageGCRepository returns the image keys referenced by database rows
+type ImageGCRepository interface {
+	GetReferencedImageKeys(ctx context.Context, keys []string) ([]string, error)
+}
+
+// ImageGCStorage is the object storage scanned for unreferenced images
+type ImageGCStorage interface {
+	WalkImages(ctx context.Context, prefix string, fn func(minio.StoredObject) error) error
+	DeleteImage(ctx context.Context, objectName string) error
+}
+
+// ImageGCConfig holds image garbage collection settings
+type ImageGCConfig struct {
+	Interval    time.Duration // How often storage is scanned
+	GracePeriod time.Duration // Objects younger than this are never deleted
+	DryRun      bool          // Only log objects that would be deleted
+}
+
+// imageGCOwners are the storage prefixes scanned by the garbage collector
+var imageGCOwners = []string{imaging.OwnerListings, imaging.OwnerStorefronts}
+
+// ImageGCWorker deletes image objects (originals, thumbnails, renditions, branding) that are
+// no longer referenced by any row, e.g. after a listing was deleted or an upload was abandoned
+type ImageGCWorker struct {
+	repo    ImageGCRepository
+	storage ImageGCStorage
+	metrics *metrics.Metrics
+	config  ImageGCConfig
+	logger  zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewImageGCWorker creates a new image garbage collection worker
+func NewImageGCWorker(repo ImageGCRepository, storage ImageGCStorage, metrics *metrics.Metrics, cfg ImageGCConfig, logger zerolog.Logger) *ImageGCWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 6 * time.Hour
+	}
+	if cfg.GracePeriod <= 0 {
+		cfg.GracePeriod = 24 * time.Hour
+	}
+
+	return &ImageGCWorker{
+		repo:    repo,
+		storage: storage,
+		metrics: metrics,
+		config:  cfg,
+		logger:  logger.With().Str("component", "image_gc_worker").Logger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins periodic garbage collection
+func (w *ImageGCWorker) Start() error {
+	w.logger.Info().
+		Dur("interval", w.config.Interval).
+		Dur("grace_period", w.config.GracePeriod).
+		Bool("dry_run", w.config.DryRun).
+		Msg("starting image GC worker")
+
+	w.wg.Add(1)
+	go w.loop()
+
+	return nil
+}
+
+// Stop gracefully shuts down the worker
+func (w *ImageGCWorker) Stop() error {
+	w.logger.Info().Msg("stopping image GC worker")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("image GC worker stopped")
+	return nil
+}
+
+// loop runs garbage collection on every tick (not on start: a full scan is expensive)
+func (w *ImageGCWorker) loop() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.RunOnce(w.ctx)
+		}
+	}
+}
+
+// RunOnce scans all image prefixes and deletes unreferenced objects
+func (w *ImageGCWorker) RunOnce(ctx context.Context) {
+	start := time.Now()
+	cutoff := start.Add(-w.config.GracePeriod)
+
+	scanned, deleted := 0, 0
+	for _, owner := range imageGCOwners {
+		s, d, err := w.collect(ctx, owner, cutoff)
+		scanned += s
+		deleted += d
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			w.logger.Error().Err(err).Str("owner", owner).Msg("image GC failed")
+			w.metrics.RecordError("image_gc_worker", "collect_failed")
+		}
+	}
+
+	w.logger.Info().
+		Int("scanned", scanned).
+		Int("deleted", deleted).
+		Dur("duration", time.Since(start)).
+		Msg("image GC completed")
+}
+
+// collect walks all objects of one owner kind. Objects are listed in key order, so the
+// objects of one directory ("listings/123/...") are contiguous and checked together.
+func (w *ImageGCWorker) collect(ctx context.Context, owner string, cutoff time.Time) (int, int, error) {
+	scanned, deleted := 0, 0
+
+	var (
+		currentID int64
+		pending   []minio.StoredObject
+	)
+
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		n, err := w.collectOwner(ctx, owner, currentID, pending, cutoff)
+		deleted += n
+		pending = pending[:0]
+		return err
+	}
+
+	prefix := owner + "/"
+	err := w.storage.WalkImages(ctx, prefix, func(object minio.StoredObject) error {
+		scanned++
+
+		ownerID, ok := parseOwnerID(prefix, object.Key)
+		if !ok {
+			return nil // Not part of the image layout
+		}
+		if ownerID != currentID {
+			if err := flush(); err != nil {
+				return err
+			}
+			currentID = ownerID
+		}
+
+		pending = append(pending, object)
+		return nil
+	})
+	if err != nil {
+		return scanned, deleted, err
+	}
+
+	return scanned, deleted, flush()
+}
+
+// collectOwner deletes the objects of one directory that are older than cutoff and that no
+// row references. References are looked up by key, whatever row owns them, and all objects
+// of the directory are looked up so that derived objects keep a newer original alive.
+func (w *ImageGCWorker) collectOwner(ctx context.Context, owner string, ownerID int64, objects []minio.StoredObject, cutoff time.Time) (int, error) {
+	keys := make([]string, len(objects))
+	for i, object := range objects {
+		keys[i] = object.Key
+	}
+
+	referencedKeys, err := w.repo.GetReferencedImageKeys(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	// Thumbnails and renditions share the base key of their original
+	referenced := make(map[string]bool, len(referencedKeys))
+	for _, key := range referencedKeys {
+		referenced[imaging.BaseKey(key)] = true
+	}
+
+	deleted := 0
+	for _, object := range objects {
+		if !object.LastModified.Before(cutoff) || referenced[imaging.BaseKey(object.Key)] {
+			continue
+		}
+
+		if w.config.DryRun {
+			w.logger.Info().Str("key", object.Key).Int64("size", object.Size).Msg("unreferenced image (dry run)")
+			continue
+		}
+
+		if err := w.storage.DeleteImage(ctx, object.Key); err != nil {
+			w.logger.Warn().Err(err).Str("key", object.Key).Msg("failed to delete unreferenced image")
+			continue
+		}
+		deleted++
+	}
+
+	if deleted > 0 {
+		w.metrics.RecordImageGCDeleted(deleted)
+		w.logger.Debug().Str("owner", owner).Int64("owner_id", ownerID).Int("deleted", deleted).Msg("unreferenced images deleted")
+	}
+
+	return deleted, nil
+}
+
+// parseOwnerID extracts the owner ID from a key like "listings/123/..."
+func parseOwnerID(prefix, key string) (int64, bool) {
+	rest := strings.TrimPrefix(key, prefix)
+	idPart, _, found := strings.Cut(rest, "/")
+	if !found {
+		return 0, false
+	}
+
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
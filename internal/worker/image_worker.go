@@ -0,0 +1,363 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/dedup"
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/imaging"
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+const (
+	maxStoredImageSize = 50 * 1024 * 1024 // Originals larger than this are rejected
+	imageURLTTL        = 24 * time.Hour   // Presigned URL expiry (same as the upload path)
+)
+
+// ImageJobRepository defines repository interface for the image processing worker
+type ImageJobRepository interface {
+	GetPendingImageJobs(ctx context.Context, limit int, staleAfter time.Duration) ([]*domain.ImageProcessingJob, error)
+	GetImageByID(ctx context.Context, imageID int64) (*domain.ListingImage, error)
+	GetListingByID(ctx context.Context, id int64) (*domain.Listing, error)
+	CompleteImageJob(ctx context.Context, jobID int64, image *domain.ListingImage) error
+	FailImageJob(ctx context.Context, jobID, imageID int64, errorMsg string, permanent bool) (bool, error)
+}
+
+// ImageStorage is the object storage used for originals, thumbnails and renditions
+type ImageStorage interface {
+	imaging.ObjectStore
+	DownloadImage(ctx context.Context, objectName string) (io.ReadCloser, error)
+}
+
+// ImageDuplicateChecker compares image hashes with images of other listings
+type ImageDuplicateChecker interface {
+	CheckImageDuplicates(ctx context.Context, listingID, userID int64, hash uint64) (*domain.DuplicateReport, error)
+}
+
+// ListingCache is the cache invalidated when a listing's images change
+type ListingCache interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// ImageProcessingConfig holds image processing worker settings
+type ImageProcessingConfig struct {
+	Concurrency  int           // Number of worker goroutines
+	PollInterval time.Duration // How often each worker polls for jobs
+	BatchSize    int           // Jobs claimed per poll
+	JobTimeout   time.Duration // Max time per job; jobs stuck longer than a batch are reclaimed
+}
+
+// permanentError marks failures that retrying cannot fix (invalid or rejected image)
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// ImageWorker processes uploaded images in the background: validation, EXIF stripping,
+// hashing, duplicate checks, thumbnail and renditions
+type ImageWorker struct {
+	repo       ImageJobRepository
+	storage    ImageStorage
+	duplicates ImageDuplicateChecker
+	cache      ListingCache
+	metrics    *metrics.Metrics
+	config     ImageProcessingConfig
+	logger     zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewImageWorker creates a new image processing worker (duplicate checker and cache are optional)
+func NewImageWorker(repo ImageJobRepository, storage ImageStorage, duplicates ImageDuplicateChecker, cache ListingCache, metrics *metrics.Metrics, cfg ImageProcessingConfig, logger zerolog.Logger) *ImageWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 2
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 5
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = 2 * time.Minute
+	}
+
+	return &ImageWorker{
+		repo:       repo,
+		storage:    storage,
+		duplicates: duplicates,
+		cache:      cache,
+		metrics:    metrics,
+		config:     cfg,
+		logger:     logger.With().Str("component", "image_worker").Logger(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Start begins processing image jobs
+func (w *ImageWorker) Start() error {
+	w.logger.Info().
+		Int("concurrency", w.config.Concurrency).
+		Dur("poll_interval", w.config.PollInterval).
+		Msg("starting image worker")
+
+	for i := 0; i < w.config.Concurrency; i++ {
+		w.wg.Add(1)
+		go w.workerLoop(i)
+	}
+
+	return nil
+}
+
+// Stop gracefully shuts down the worker
+func (w *ImageWorker) Stop() error {
+	w.logger.Info().Msg("stopping image worker")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("image worker stopped")
+	return nil
+}
+
+// workerLoop polls for jobs until the worker is stopped
+func (w *ImageWorker) workerLoop(workerID int) {
+	defer w.wg.Done()
+
+	logger := w.logger.With().Int("worker_id", workerID).Logger()
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.processBatch(logger)
+		}
+	}
+}
+
+// processBatch claims and processes a batch of image jobs. The jobs of a batch run one after
+// another, so a job only counts as stale once the whole batch could have been processed.
+func (w *ImageWorker) processBatch(logger zerolog.Logger) {
+	staleAfter := time.Duration(w.config.BatchSize+1) * w.config.JobTimeout
+
+	ctx, cancel := context.WithTimeout(w.ctx, 30*time.Second)
+	jobs, err := w.repo.GetPendingImageJobs(ctx, w.config.BatchSize, staleAfter)
+	cancel()
+
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to fetch pending image jobs")
+		w.metrics.RecordError("image_worker", "fetch_jobs_failed")
+		return
+	}
+
+	for _, job := range jobs {
+		if w.ctx.Err() != nil {
+			return
+		}
+		w.processJob(job, logger)
+	}
+}
+
+// processJob processes a single job and records its outcome
+func (w *ImageWorker) processJob(job *domain.ImageProcessingJob, logger zerolog.Logger) {
+	start := time.Now()
+
+	logger = logger.With().Int64("job_id", job.ID).Int64("image_id", job.ImageID).Logger()
+
+	ctx, cancel := context.WithTimeout(w.ctx, w.config.JobTimeout)
+	defer cancel()
+
+	err := w.processImage(ctx, job)
+	duration := time.Since(start).Seconds()
+
+	if err == nil {
+		logger.Debug().Float64("duration_seconds", duration).Msg("image processed")
+		w.metrics.RecordImageJob("success", duration)
+		return
+	}
+
+	var permanent *permanentError
+	isPermanent := errors.As(err, &permanent)
+
+	// Record the failure even when the worker is shutting down
+	failCtx, failCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer failCancel()
+
+	failed, failErr := w.repo.FailImageJob(failCtx, job.ID, job.ImageID, err.Error(), isPermanent)
+	if failErr != nil {
+		logger.Error().Err(failErr).Msg("failed to mark image job as failed")
+	}
+	if failed {
+		w.invalidateListingCache(failCtx, job.ImageID, logger)
+	}
+
+	if isPermanent {
+		logger.Warn().Err(err).Msg("image rejected")
+		w.metrics.RecordImageJob("rejected", duration)
+		return
+	}
+
+	logger.Error().Err(err).Bool("retries_exhausted", failed).Msg("failed to process image")
+	w.metrics.RecordImageJob("failed", duration)
+	w.metrics.RecordError("image_worker", "job_failed")
+}
+
+// processImage replaces the uploaded original with an upright, metadata-free version and
+// stores thumbnail, renditions and hashes
+func (w *ImageWorker) processImage(ctx context.Context, job *domain.ImageProcessingJob) error {
+	image, err := w.repo.GetImageByID(ctx, job.ImageID)
+	if err != nil {
+		return fmt.Errorf("failed to get image: %w", err)
+	}
+	if image.StoragePath == nil || *image.StoragePath == "" {
+		return &permanentError{err: fmt.Errorf("image has no stored original")}
+	}
+	originalKey := *image.StoragePath
+
+	data, err := w.download(ctx, originalKey)
+	if err != nil {
+		return err
+	}
+
+	// Full decode validates the upload
+	processed, err := imaging.Process(data)
+	if err != nil {
+		return &permanentError{err: err}
+	}
+	img := processed.Image
+
+	// Duplicate check: compare the perceptual hash with images of other listings
+	imageHash := dedup.ImageHash(img)
+	if w.duplicates != nil {
+		listing, err := w.repo.GetListingByID(ctx, image.ListingID)
+		if err != nil {
+			return fmt.Errorf("failed to get listing: %w", err)
+		}
+
+		duplicates, err := w.duplicates.CheckImageDuplicates(ctx, image.ListingID, listing.UserID, imageHash)
+		if err != nil {
+			return fmt.Errorf("failed to check image duplicates: %w", err)
+		}
+		if duplicates != nil && duplicates.Action == domain.DuplicateActionBlocked {
+			return &permanentError{err: fmt.Errorf("duplicate image: matches an image of listing %d", duplicates.Matches[0].ListingID)}
+		}
+	}
+
+	thumbnail, err := imaging.Thumbnail(img)
+	if err != nil {
+		return err
+	}
+	renditions, err := imaging.Renditions(img)
+	if err != nil {
+		return fmt.Errorf("failed to generate renditions: %w", err)
+	}
+
+	// Object keys are derived from the original key, so a retried job overwrites its own objects
+	if err := w.storage.UploadImage(ctx, originalKey, bytes.NewReader(processed.Data), int64(len(processed.Data)), processed.ContentType); err != nil {
+		return fmt.Errorf("failed to upload processed original: %w", err)
+	}
+
+	thumbnailKey := imaging.ThumbnailKey(originalKey)
+	if err := w.storage.UploadImage(ctx, thumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+		return fmt.Errorf("failed to upload thumbnail: %w", err)
+	}
+
+	storedRenditions, err := imaging.StoreRenditions(ctx, w.storage, originalKey, renditions, imageURLTTL)
+	if err != nil {
+		return err
+	}
+
+	originalURL, err := w.storage.GetPresignedURL(ctx, originalKey, imageURLTTL)
+	if err != nil {
+		return fmt.Errorf("failed to generate presigned URL for original: %w", err)
+	}
+	thumbnailURL, err := w.storage.GetPresignedURL(ctx, thumbnailKey, imageURLTTL)
+	if err != nil {
+		return fmt.Errorf("failed to generate presigned URL for thumbnail: %w", err)
+	}
+
+	width := int32(img.Bounds().Dx())
+	height := int32(img.Bounds().Dy())
+	fileSize := int64(len(processed.Data))
+	mimeType := processed.ContentType
+	phash := int64(imageHash)
+	blurHash := processed.BlurHash
+
+	image.URL = originalURL
+	image.ThumbnailURL = &thumbnailURL
+	image.Width = &width
+	image.Height = &height
+	image.FileSize = &fileSize
+	image.MimeType = &mimeType
+	image.PHash = &phash
+	image.BlurHash = &blurHash
+	image.Renditions = storedRenditions
+
+	if err := w.repo.CompleteImageJob(ctx, job.ID, image); err != nil {
+		return err
+	}
+
+	// Cached listings embed their images
+	if w.cache != nil {
+		if err := w.cache.Delete(ctx, fmt.Sprintf("listing:%d", image.ListingID)); err != nil {
+			w.logger.Warn().Err(err).Int64("listing_id", image.ListingID).Msg("failed to invalidate listing cache (non-critical)")
+		}
+	}
+
+	return nil
+}
+
+// invalidateListingCache drops the cached listing of an image that failed for good, so that
+// the failed status shows up. Its stored objects are left to the image GC worker.
+func (w *ImageWorker) invalidateListingCache(ctx context.Context, imageID int64, logger zerolog.Logger) {
+	if w.cache == nil {
+		return
+	}
+
+	image, err := w.repo.GetImageByID(ctx, imageID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to get failed image for cache invalidation")
+		return
+	}
+	if err := w.cache.Delete(ctx, fmt.Sprintf("listing:%d", image.ListingID)); err != nil {
+		logger.Warn().Err(err).Int64("listing_id", image.ListingID).Msg("failed to invalidate listing cache (non-critical)")
+	}
+}
+
+// download reads a stored original
+func (w *ImageWorker) download(ctx context.Context, key string) ([]byte, error) {
+	reader, err := w.storage.DownloadImage(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxStoredImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read original: %w", err)
+	}
+	if len(data) > maxStoredImageSize {
+		return nil, &permanentError{err: fmt.Errorf("image exceeds %dMB", maxStoredImageSize/(1024*1024))}
+	}
+
+	return data, nil
+}
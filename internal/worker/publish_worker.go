@@ -0,0 +1,122 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+// PublishRepository defines repository interface for the scheduled publish worker
+type PublishRepository interface {
+	PublishScheduledListings(ctx context.Context, now time.Time, limit int) ([]int64, error)
+}
+
+// PublishConfig holds scheduled publish worker settings
+type PublishConfig struct {
+	Interval  time.Duration // How often due listings are published
+	BatchSize int           // Listings published per batch
+}
+
+// PublishWorker publishes approved listings once their scheduled publish time has come
+type PublishWorker struct {
+	repo    PublishRepository
+	metrics *metrics.Metrics
+	config  PublishConfig
+	logger  zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPublishWorker creates a new scheduled publish worker
+func NewPublishWorker(repo PublishRepository, metrics *metrics.Metrics, cfg PublishConfig, logger zerolog.Logger) *PublishWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 200
+	}
+
+	return &PublishWorker{
+		repo:    repo,
+		metrics: metrics,
+		config:  cfg,
+		logger:  logger.With().Str("component", "publish_worker").Logger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins periodic scheduled publishing
+func (w *PublishWorker) Start() error {
+	w.logger.Info().Dur("interval", w.config.Interval).Msg("starting publish worker")
+
+	w.wg.Add(1)
+	go w.loop()
+
+	return nil
+}
+
+// Stop gracefully shuts down the worker
+func (w *PublishWorker) Stop() error {
+	w.logger.Info().Msg("stopping publish worker")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("publish worker stopped")
+	return nil
+}
+
+// loop runs publishing on start and then on every tick
+func (w *PublishWorker) loop() {
+	defer w.wg.Done()
+
+	w.RunOnce(w.ctx)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.RunOnce(w.ctx)
+		}
+	}
+}
+
+// RunOnce publishes due listings in batches until none are left
+func (w *PublishWorker) RunOnce(ctx context.Context) {
+	now := time.Now()
+	total := 0
+
+	for {
+		batchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		ids, err := w.repo.PublishScheduledListings(batchCtx, now, w.config.BatchSize)
+		cancel()
+
+		if err != nil {
+			w.logger.Error().Err(err).Msg("failed to publish scheduled listings")
+			w.metrics.RecordError("publish_worker", "publish_failed")
+			return
+		}
+
+		total += len(ids)
+		if len(ids) < w.config.BatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Info().Int("count", total).Msg("scheduled listings published")
+	}
+}
@@ -0,0 +1,403 @@
+package worker
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/metrics"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+// reportPurgeBatchSize bounds the exports purged per repository call
+const reportPurgeBatchSize = 100
+
+// ReportExportStorage is the object storage that receives rendered report files
+type ReportExportStorage interface {
+	UploadImage(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) error
+	DeleteImage(ctx context.Context, objectName string) error
+}
+
+// ReportExportConfig holds report export worker settings
+type ReportExportConfig struct {
+	Concurrency  int           // Number of worker goroutines
+	PollInterval time.Duration // How often each worker polls for exports
+	JobTimeout   time.Duration // Max time per export; exports stuck longer are reclaimed
+	Retention    time.Duration // How long completed files (and failed exports) are kept
+}
+
+// ReportExportWorker renders requested report exports to CSV or JSON Lines files in the
+// background and stores them in object storage
+type ReportExportWorker struct {
+	repo    repository.ReportExportRepository
+	storage ReportExportStorage
+	metrics *metrics.Metrics
+	config  ReportExportConfig
+	logger  zerolog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReportExportWorker creates a new report export worker
+func NewReportExportWorker(repo repository.ReportExportRepository, storage ReportExportStorage, metrics *metrics.Metrics, cfg ReportExportConfig, logger zerolog.Logger) *ReportExportWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = 10 * time.Minute
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 7 * 24 * time.Hour
+	}
+
+	return &ReportExportWorker{
+		repo:    repo,
+		storage: storage,
+		metrics: metrics,
+		config:  cfg,
+		logger:  logger.With().Str("component", "report_export_worker").Logger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins processing report exports
+func (w *ReportExportWorker) Start() error {
+	w.logger.Info().
+		Int("concurrency", w.config.Concurrency).
+		Dur("poll_interval", w.config.PollInterval).
+		Dur("retention", w.config.Retention).
+		Msg("starting report export worker")
+
+	for i := 0; i < w.config.Concurrency; i++ {
+		w.wg.Add(1)
+		go w.workerLoop(i)
+	}
+
+	return nil
+}
+
+// Stop gracefully shuts down the worker
+func (w *ReportExportWorker) Stop() error {
+	w.logger.Info().Msg("stopping report export worker")
+
+	w.cancel()
+	w.wg.Wait()
+
+	w.logger.Info().Msg("report export worker stopped")
+	return nil
+}
+
+// workerLoop polls for exports until the worker is stopped
+func (w *ReportExportWorker) workerLoop(workerID int) {
+	defer w.wg.Done()
+
+	logger := w.logger.With().Int("worker_id", workerID).Logger()
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.processBatch(logger)
+		}
+	}
+}
+
+// processBatch claims and renders one export at a time (exports are large and slow)
+func (w *ReportExportWorker) processBatch(logger zerolog.Logger) {
+	ctx, cancel := context.WithTimeout(w.ctx, 30*time.Second)
+	exports, err := w.repo.ClaimReportExports(ctx, 1, w.config.JobTimeout)
+	cancel()
+
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to claim report exports")
+		w.metrics.RecordError("report_export_worker", "claim_exports_failed")
+		return
+	}
+
+	for _, export := range exports {
+		if w.ctx.Err() != nil {
+			return
+		}
+		w.processExport(export, logger)
+	}
+}
+
+// processExport renders a single export and records its outcome
+func (w *ReportExportWorker) processExport(export *domain.ReportExport, logger zerolog.Logger) {
+	start := time.Now()
+
+	logger = logger.With().
+		Int64("export_id", export.ID).
+		Str("report_type", string(export.ReportType)).
+		Logger()
+
+	ctx, cancel := context.WithTimeout(w.ctx, w.config.JobTimeout)
+	defer cancel()
+
+	rowCount, err := w.renderExport(ctx, export)
+	duration := time.Since(start).Seconds()
+
+	if err == nil {
+		logger.Info().
+			Int64("rows", rowCount).
+			Float64("duration_seconds", duration).
+			Msg("report export completed")
+		w.metrics.RecordReportExport(string(export.ReportType), "success", duration)
+		return
+	}
+
+	// An export interrupted by shutdown is not a failed attempt: it stays 'processing' and is
+	// reclaimed once stale
+	if w.ctx.Err() != nil {
+		logger.Warn().Err(err).Msg("report export interrupted by shutdown")
+		return
+	}
+
+	var permanent *permanentError
+	isPermanent := errors.As(err, &permanent)
+
+	failCtx, failCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer failCancel()
+
+	failed, failErr := w.repo.FailReportExport(failCtx, export.ID, err.Error(), isPermanent)
+	if failErr != nil {
+		logger.Error().Err(failErr).Msg("failed to mark report export as failed")
+	}
+
+	if isPermanent {
+		logger.Warn().Err(err).Msg("report export rejected")
+		w.metrics.RecordReportExport(string(export.ReportType), "rejected", duration)
+		return
+	}
+
+	logger.Error().Err(err).Bool("retries_exhausted", failed).Msg("failed to render report export")
+	w.metrics.RecordReportExport(string(export.ReportType), "failed", duration)
+	w.metrics.RecordError("report_export_worker", "export_failed")
+}
+
+// renderExport writes the report to a temporary file, uploads it and completes the export
+func (w *ReportExportWorker) renderExport(ctx context.Context, export *domain.ReportExport) (int64, error) {
+	file, err := os.CreateTemp("", "report-export-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	buffered := bufio.NewWriter(file)
+	encoder := newReportEncoder(export.Format, buffered)
+
+	if err := w.repo.StreamReportRows(ctx, export, encoder); err != nil {
+		return 0, err
+	}
+	if err := encoder.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to write report: %w", err)
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get report size: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to rewind report: %w", err)
+	}
+
+	// The key is derived from the export ID, so a retried export overwrites its own file
+	objectKey := export.ObjectName()
+	if err := w.storage.UploadImage(ctx, objectKey, file, size, export.Format.ContentType()); err != nil {
+		return 0, fmt.Errorf("failed to upload report: %w", err)
+	}
+
+	rows := encoder.Rows()
+	expiresAt := time.Now().Add(w.config.Retention)
+	if err := w.repo.CompleteReportExport(ctx, export.ID, objectKey, rows, size, expiresAt); err != nil {
+		return 0, err
+	}
+
+	return rows, nil
+}
+
+// PurgeExpired deletes expired export files and the records of expired and old failed exports
+func (w *ReportExportWorker) PurgeExpired(ctx context.Context) error {
+	failedBefore := time.Now().Add(-w.config.Retention)
+	purged := 0
+
+	for {
+		exports, err := w.repo.ListExpiredReportExports(ctx, failedBefore, reportPurgeBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, export := range exports {
+			if export.ObjectKey != nil {
+				if err := w.storage.DeleteImage(ctx, *export.ObjectKey); err != nil {
+					return fmt.Errorf("failed to delete report file of export %d: %w", export.ID, err)
+				}
+			}
+			if err := w.repo.DeleteReportExport(ctx, export.ID); err != nil {
+				return err
+			}
+			purged++
+		}
+
+		if len(exports) < reportPurgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		w.logger.Info().Int("purged", purged).Msg("expired report exports purged")
+	}
+	return nil
+}
+
+// ============================================================================
+// ENCODERS
+// ============================================================================
+
+// reportEncoder writes report rows in a file format and enforces domain.MaxReportRows
+type reportEncoder interface {
+	repository.ReportRowWriter
+	Flush() error
+	Rows() int64
+}
+
+// newReportEncoder returns the encoder of a report format
+func newReportEncoder(format domain.ReportFormat, w io.Writer) reportEncoder {
+	if format == domain.ReportFormatJSONL {
+		return &jsonlReportEncoder{w: w}
+	}
+	return &csvReportEncoder{w: csv.NewWriter(w)}
+}
+
+// errReportTooLarge is returned once a report exceeds domain.MaxReportRows
+var errReportTooLarge = &permanentError{err: fmt.Errorf("report exceeds %d rows, choose a shorter period", domain.MaxReportRows)}
+
+// csvReportEncoder writes a header line followed by one line per row
+type csvReportEncoder struct {
+	w      *csv.Writer
+	record []string
+	rows   int64
+}
+
+func (e *csvReportEncoder) WriteHeader(columns []string) error {
+	e.record = make([]string, len(columns))
+	return e.w.Write(columns)
+}
+
+func (e *csvReportEncoder) WriteRow(values []interface{}) error {
+	if e.rows >= domain.MaxReportRows {
+		return errReportTooLarge
+	}
+	for i, value := range values {
+		e.record[i] = formatReportValue(value)
+	}
+	e.rows++
+	return e.w.Write(e.record)
+}
+
+func (e *csvReportEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvReportEncoder) Rows() int64 { return e.rows }
+
+// jsonlReportEncoder writes one JSON object per row, keys in column order
+type jsonlReportEncoder struct {
+	w       io.Writer
+	columns [][]byte // JSON-encoded column names
+	line    []byte
+	rows    int64
+}
+
+func (e *jsonlReportEncoder) WriteHeader(columns []string) error {
+	e.columns = make([][]byte, len(columns))
+	for i, column := range columns {
+		encoded, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		e.columns[i] = encoded
+	}
+	return nil
+}
+
+func (e *jsonlReportEncoder) WriteRow(values []interface{}) error {
+	if e.rows >= domain.MaxReportRows {
+		return errReportTooLarge
+	}
+
+	e.line = append(e.line[:0], '{')
+	for i, value := range values {
+		if i > 0 {
+			e.line = append(e.line, ',')
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode column %s: %w", e.columns[i], err)
+		}
+		e.line = append(e.line, e.columns[i]...)
+		e.line = append(e.line, ':')
+		e.line = append(e.line, encoded...)
+	}
+	e.line = append(e.line, '}', '\n')
+
+	e.rows++
+	_, err := e.w.Write(e.line)
+	return err
+}
+
+func (e *jsonlReportEncoder) Flush() error { return nil }
+
+func (e *jsonlReportEncoder) Rows() int64 { return e.rows }
+
+// formatReportValue formats a column value for CSV (NULL becomes an empty field)
+func formatReportValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/metrics"
+	"github.com/sveturs/listings/internal/repository"
+)
+
+var reportTestMetrics = metrics.NewMetrics("report_export_worker_test")
+
+// fakeReportExportRepo streams fixed rows and records how exports end
+type fakeReportExportRepo struct {
+	repository.ReportExportRepository // Methods the tests do not use panic
+
+	columns   []string
+	rows      [][]interface{}
+	streamErr error
+
+	completedRows int64
+	completedSize int64
+	failed        []string
+	failPermanent bool
+}
+
+func (f *fakeReportExportRepo) StreamReportRows(_ context.Context, _ *domain.ReportExport, w repository.ReportRowWriter) error {
+	if err := w.WriteHeader(f.columns); err != nil {
+		return err
+	}
+	for _, row := range f.rows {
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return f.streamErr
+}
+
+func (f *fakeReportExportRepo) CompleteReportExport(_ context.Context, _ int64, _ string, rowCount, fileSize int64, _ time.Time) error {
+	f.completedRows = rowCount
+	f.completedSize = fileSize
+	return nil
+}
+
+func (f *fakeReportExportRepo) FailReportExport(_ context.Context, _ int64, errorMsg string, permanent bool) (bool, error) {
+	f.failed = append(f.failed, errorMsg)
+	f.failPermanent = permanent
+	return permanent, nil
+}
+
+// fakeReportStorage keeps uploaded files in memory
+type fakeReportStorage struct {
+	files map[string][]byte
+}
+
+func (f *fakeReportStorage) UploadImage(_ context.Context, objectName string, reader io.Reader, _ int64, _ string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	f.files[objectName] = data
+	return nil
+}
+
+func (f *fakeReportStorage) DeleteImage(_ context.Context, objectName string) error {
+	delete(f.files, objectName)
+	return nil
+}
+
+func newTestReportExportWorker(repo *fakeReportExportRepo) (*ReportExportWorker, *fakeReportStorage) {
+	storage := &fakeReportStorage{files: map[string][]byte{}}
+	return NewReportExportWorker(repo, storage, reportTestMetrics, ReportExportConfig{}, zerolog.Nop()), storage
+}
+
+func testReportExport(format domain.ReportFormat) *domain.ReportExport {
+	return &domain.ReportExport{
+		ID:         1,
+		ReportType: domain.ReportTypeOrders,
+		Format:     format,
+		DateFrom:   time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:     time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCSVReportEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := newReportEncoder(domain.ReportFormatCSV, &buf)
+
+	require.NoError(t, encoder.WriteHeader([]string{"order_id", "buyer", "total", "paid", "created_at"}))
+	require.NoError(t, encoder.WriteRow([]interface{}{int64(1), "Ana, Novi Sad", 12.5, true, time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)}))
+	require.NoError(t, encoder.WriteRow([]interface{}{int32(2), nil, float64(3), false, nil}))
+	require.NoError(t, encoder.Flush())
+
+	expected := "order_id,buyer,total,paid,created_at\n" +
+		"1,\"Ana, Novi Sad\",12.5,true,2025-11-03T10:00:00Z\n" +
+		"2,,3,false,\n"
+	assert.Equal(t, expected, buf.String())
+	assert.Equal(t, int64(2), encoder.Rows())
+}
+
+func TestJSONLReportEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := newReportEncoder(domain.ReportFormatJSONL, &buf)
+
+	require.NoError(t, encoder.WriteHeader([]string{"order_id", "buyer", "total"}))
+	require.NoError(t, encoder.WriteRow([]interface{}{int64(1), "Ana \"A\"", 12.5}))
+	require.NoError(t, encoder.WriteRow([]interface{}{int64(2), nil, 3.0}))
+	require.NoError(t, encoder.Flush())
+
+	expected := `{"order_id":1,"buyer":"Ana \"A\"","total":12.5}` + "\n" +
+		`{"order_id":2,"buyer":null,"total":3}` + "\n"
+	assert.Equal(t, expected, buf.String())
+	assert.Equal(t, int64(2), encoder.Rows())
+}
+
+func TestReportEncoders_RowCap(t *testing.T) {
+	for _, format := range []domain.ReportFormat{domain.ReportFormatCSV, domain.ReportFormatJSONL} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			encoder := newReportEncoder(format, &buf)
+			require.NoError(t, encoder.WriteHeader([]string{"id"}))
+
+			switch e := encoder.(type) {
+			case *csvReportEncoder:
+				e.rows = domain.MaxReportRows
+			case *jsonlReportEncoder:
+				e.rows = domain.MaxReportRows
+			}
+
+			err := encoder.WriteRow([]interface{}{int64(1)})
+			var permanent *permanentError
+			assert.True(t, errors.As(err, &permanent), "an oversized report fails permanently")
+			assert.Equal(t, int64(domain.MaxReportRows), encoder.Rows())
+		})
+	}
+}
+
+func TestProcessExport_UploadsAndCompletes(t *testing.T) {
+	repo := &fakeReportExportRepo{
+		columns: []string{"order_id", "total"},
+		rows:    [][]interface{}{{int64(1), 10.0}, {int64(2), 20.5}},
+	}
+	w, storage := newTestReportExportWorker(repo)
+	export := testReportExport(domain.ReportFormatCSV)
+
+	w.processExport(export, zerolog.Nop())
+
+	file, ok := storage.files[export.ObjectName()]
+	require.True(t, ok, "the report is uploaded under the export's object name")
+	assert.Equal(t, "order_id,total\n1,10\n2,20.5\n", string(file))
+	assert.Equal(t, int64(2), repo.completedRows)
+	assert.Equal(t, int64(len(file)), repo.completedSize)
+	assert.Empty(t, repo.failed)
+}
+
+func TestProcessExport_RecordsFailures(t *testing.T) {
+	repo := &fakeReportExportRepo{columns: []string{"id"}, streamErr: fmt.Errorf("connection reset")}
+	w, storage := newTestReportExportWorker(repo)
+
+	w.processExport(testReportExport(domain.ReportFormatJSONL), zerolog.Nop())
+
+	require.Len(t, repo.failed, 1)
+	assert.True(t, strings.Contains(repo.failed[0], "connection reset"))
+	assert.False(t, repo.failPermanent, "transient errors are retried")
+	assert.Empty(t, storage.files)
+
+	repo = &fakeReportExportRepo{columns: []string{"id"}, streamErr: errReportTooLarge}
+	w, _ = newTestReportExportWorker(repo)
+
+	w.processExport(testReportExport(domain.ReportFormatCSV), zerolog.Nop())
+
+	require.Len(t, repo.failed, 1)
+	assert.True(t, repo.failPermanent, "oversized reports are not retried")
+}
+
+func TestProcessExport_ShutdownIsNotAFailure(t *testing.T) {
+	repo := &fakeReportExportRepo{columns: []string{"id"}, streamErr: context.Canceled}
+	w, _ := newTestReportExportWorker(repo)
+	require.NoError(t, w.Stop())
+
+	w.processExport(testReportExport(domain.ReportFormatCSV), zerolog.Nop())
+
+	assert.Empty(t, repo.failed, "an interrupted export is reclaimed instead of failed")
+	assert.Zero(t, repo.completedRows)
+}
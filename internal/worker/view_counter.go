@@ -0,0 +1,163 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/sveturs/listings/internal/domain"
+	"github.com/sveturs/listings/internal/metrics"
+)
+
+// ViewCountRepository defines repository interface for the view counter
+type ViewCountRepository interface {
+	AddListingViews(ctx context.Context, counts []domain.ViewCount) error
+}
+
+// ViewCounterConfig holds view counter settings
+type ViewCounterConfig struct {
+	FlushInterval time.Duration // How often buffered counts are written
+	WriteTimeout  time.Duration // Timeout of a single write
+}
+
+// ViewCounter aggregates listing view increments in memory and writes them in one statement
+// per flush, instead of one UPDATE per view. Counts of a failed write stay in the buffer and
+// are retried on the next flush; the buffer is bounded by the number of viewed listings.
+type ViewCounter struct {
+	repo    ViewCountRepository
+	metrics *metrics.Metrics
+	config  ViewCounterConfig
+	logger  zerolog.Logger
+
+	mu      sync.Mutex
+	pending map[int64]*domain.ViewCount
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewViewCounter creates a new buffered view counter
+func NewViewCounter(repo ViewCountRepository, metrics *metrics.Metrics, cfg ViewCounterConfig, logger zerolog.Logger) *ViewCounter {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 10 * time.Second
+	}
+	if cfg.WriteTimeout <= 0 {
+		cfg.WriteTimeout = 10 * time.Second
+	}
+
+	return &ViewCounter{
+		repo:    repo,
+		metrics: metrics,
+		config:  cfg,
+		logger:  logger.With().Str("component", "view_counter").Logger(),
+		pending: make(map[int64]*domain.ViewCount),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins flushing buffered counts
+func (c *ViewCounter) Start() error {
+	c.logger.Info().Dur("flush_interval", c.config.FlushInterval).Msg("starting view counter")
+
+	c.wg.Add(1)
+	go c.loop()
+
+	return nil
+}
+
+// Stop writes the remaining counts and shuts down the counter
+func (c *ViewCounter) Stop() error {
+	c.logger.Info().Msg("stopping view counter")
+
+	c.cancel()
+	c.wg.Wait()
+
+	c.logger.Info().Msg("view counter stopped")
+	return nil
+}
+
+// Add counts a view of a listing; unique views also increment the deduplicated counter
+func (c *ViewCounter) Add(listingID int64, unique bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count, ok := c.pending[listingID]
+	if !ok {
+		count = &domain.ViewCount{ListingID: listingID}
+		c.pending[listingID] = count
+	}
+	count.TotalViews++
+	if unique {
+		count.UniqueViews++
+	}
+}
+
+// loop flushes on every tick and once more on shutdown
+func (c *ViewCounter) loop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			c.flush()
+			return
+		case <-ticker.C:
+			c.flush()
+		}
+	}
+}
+
+// flush swaps the buffer out and writes it; a failed batch is merged back for the next flush
+func (c *ViewCounter) flush() {
+	c.mu.Lock()
+	if len(c.pending) == 0 {
+		c.mu.Unlock()
+		return
+	}
+	batch := c.pending
+	c.pending = make(map[int64]*domain.ViewCount, len(batch))
+	c.mu.Unlock()
+
+	counts := make([]domain.ViewCount, 0, len(batch))
+	for _, count := range batch {
+		counts = append(counts, *count)
+	}
+
+	// Independent of c.ctx so the final flush on shutdown still completes
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.WriteTimeout)
+	defer cancel()
+
+	if err := c.repo.AddListingViews(ctx, counts); err != nil {
+		c.logger.Error().Err(err).Int("listings", len(counts)).Msg("failed to write view counts, retrying on next flush")
+		c.metrics.RecordError("view_counter", "write_failed")
+		c.requeue(counts)
+		return
+	}
+
+	c.logger.Debug().Int("listings", len(counts)).Msg("view counts written")
+}
+
+// requeue merges counts of a failed write back into the buffer
+func (c *ViewCounter) requeue(counts []domain.ViewCount) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, failed := range counts {
+		count, ok := c.pending[failed.ListingID]
+		if !ok {
+			count = &domain.ViewCount{ListingID: failed.ListingID}
+			c.pending[failed.ListingID] = count
+		}
+		count.UniqueViews += failed.UniqueViews
+		count.TotalViews += failed.TotalViews
+	}
+}
@@ -184,6 +184,15 @@ func (w *Worker) handleIndexJob(ctx context.Context, job *domain.IndexingQueueIt
 		return fmt.Errorf("failed to fetch listing: %w", err)
 	}
 
+	// Only published listings are searchable - drafts, listings in review,
+	// paused or archived ones are removed from the index instead
+	if !listing.IsPublished() {
+		if err := w.indexer.DeleteListing(ctx, listing.ID); err != nil {
+			return fmt.Errorf("failed to remove unpublished listing from index: %w", err)
+		}
+		return nil
+	}
+
 	// Index listing in OpenSearch
 	if job.Operation == domain.IndexOpIndex {
 		if err := w.indexer.IndexListing(ctx, listing); err != nil {
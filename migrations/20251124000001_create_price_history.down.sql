@@ -0,0 +1,10 @@
+-- Migration: Drop price_history table and price change triggers
+-- Date: 2025-11-24
+
+DROP TRIGGER IF EXISTS trigger_b2c_product_variants_price_history ON b2c_product_variants;
+DROP TRIGGER IF EXISTS trigger_listings_price_history ON listings;
+
+DROP FUNCTION IF EXISTS record_variant_price_change();
+DROP FUNCTION IF EXISTS record_listing_price_change();
+
+DROP TABLE IF EXISTS price_history;
@@ -0,0 +1,111 @@
+-- Migration: Create price_history table with automatic price change tracking
+-- Date: 2025-11-24
+-- Purpose: Record every price change of listings, B2C products (stored in listings)
+--          and B2C product variants. Used by GetPriceHistory and price-drop alerts.
+
+-- =====================================================
+-- TABLE: price_history
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS price_history (
+    id BIGSERIAL PRIMARY KEY,
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    variant_id BIGINT,
+
+    -- Price change (old_price is NULL for the initial price)
+    old_price NUMERIC(15, 2),
+    new_price NUMERIC(15, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'RSD',
+
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_listing_changed
+    ON price_history(listing_id, changed_at DESC)
+    WHERE variant_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_price_history_variant_changed
+    ON price_history(variant_id, changed_at DESC)
+    WHERE variant_id IS NOT NULL;
+
+-- =====================================================
+-- TRIGGER: listings / B2C products price changes
+-- =====================================================
+
+CREATE OR REPLACE FUNCTION record_listing_price_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO price_history (listing_id, old_price, new_price, currency)
+        VALUES (NEW.id, NULL, NEW.price, NEW.currency);
+    ELSIF NEW.price IS DISTINCT FROM OLD.price THEN
+        INSERT INTO price_history (listing_id, old_price, new_price, currency)
+        VALUES (NEW.id, OLD.price, NEW.price, NEW.currency);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_listings_price_history
+AFTER INSERT OR UPDATE OF price ON listings
+FOR EACH ROW
+EXECUTE FUNCTION record_listing_price_change();
+
+-- =====================================================
+-- TRIGGER: B2C product variant price changes
+-- =====================================================
+
+-- Variant price is an optional override; only explicit prices are tracked
+CREATE OR REPLACE FUNCTION record_variant_price_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_currency VARCHAR(3);
+BEGIN
+    IF NEW.price IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND NEW.price IS NOT DISTINCT FROM OLD.price THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT currency INTO v_currency FROM listings WHERE id = NEW.product_id;
+
+    INSERT INTO price_history (listing_id, variant_id, old_price, new_price, currency)
+    VALUES (
+        NEW.product_id,
+        NEW.id,
+        CASE WHEN TG_OP = 'UPDATE' THEN OLD.price ELSE NULL END,
+        NEW.price,
+        COALESCE(v_currency, 'RSD')
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_b2c_product_variants_price_history
+AFTER INSERT OR UPDATE OF price ON b2c_product_variants
+FOR EACH ROW
+EXECUTE FUNCTION record_variant_price_change();
+
+-- =====================================================
+-- BACKFILL: current prices as initial history entries
+-- =====================================================
+
+INSERT INTO price_history (listing_id, old_price, new_price, currency, changed_at)
+SELECT id, NULL, price, currency, COALESCE(updated_at, created_at, CURRENT_TIMESTAMP)
+FROM listings
+WHERE is_deleted = false;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE price_history IS
+    'Price change log for listings, B2C products and variants. Populated by triggers on listings and b2c_product_variants.';
+
+COMMENT ON COLUMN price_history.variant_id IS
+    'B2C product variant ID. NULL for listing/product level price changes.';
+
+COMMENT ON COLUMN price_history.old_price IS
+    'Price before the change. NULL for the initial price (insert or backfill).';
@@ -0,0 +1,20 @@
+-- Migration: Revert listing expiration and renewal lifecycle
+-- Date: 2025-11-24
+--
+-- Expired listings are moved back to 'archived' so the original constraint can be restored
+
+DROP TABLE IF EXISTS listing_expiry_reminders;
+
+DROP TRIGGER IF EXISTS trigger_category_renewal_periods_updated_at ON category_renewal_periods;
+DROP FUNCTION IF EXISTS update_category_renewal_periods_updated_at();
+DROP TABLE IF EXISTS category_renewal_periods;
+
+DROP INDEX IF EXISTS idx_listings_c2c_active_expires_at;
+
+UPDATE listings SET status = 'archived' WHERE status = 'expired';
+
+ALTER TABLE listings DROP CONSTRAINT IF EXISTS listings_status_check;
+ALTER TABLE listings ADD CONSTRAINT listings_status_check
+CHECK (status IN ('draft', 'active', 'inactive', 'sold', 'archived'));
+
+COMMENT ON COLUMN listings.expires_at IS 'Optional expiration timestamp for C2C listings (auto-archive after this time)';
@@ -0,0 +1,66 @@
+-- Migration: Listing expiration and renewal lifecycle
+-- Date: 2025-11-24
+-- Purpose: Add 'expired' listing status, per-category renewal periods and
+--          expiry reminder tracking for the listing expiration job.
+
+-- =====================================================
+-- LISTING STATUS: add 'expired'
+-- =====================================================
+
+ALTER TABLE listings DROP CONSTRAINT IF EXISTS listings_status_check;
+ALTER TABLE listings ADD CONSTRAINT listings_status_check
+CHECK (status IN ('draft', 'active', 'inactive', 'sold', 'archived', 'expired'));
+
+-- Expiration job scans active C2C listings by expires_at
+CREATE INDEX IF NOT EXISTS idx_listings_c2c_active_expires_at
+ON listings(expires_at)
+WHERE source_type = 'c2c' AND status = 'active' AND is_deleted = false AND expires_at IS NOT NULL;
+
+-- =====================================================
+-- TABLE: category_renewal_periods
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS category_renewal_periods (
+    category_id BIGINT PRIMARY KEY REFERENCES categories(id) ON DELETE CASCADE,
+    renewal_days INTEGER NOT NULL CHECK (renewal_days > 0 AND renewal_days <= 365),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE OR REPLACE FUNCTION update_category_renewal_periods_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_category_renewal_periods_updated_at
+BEFORE UPDATE ON category_renewal_periods
+FOR EACH ROW
+EXECUTE FUNCTION update_category_renewal_periods_updated_at();
+
+-- =====================================================
+-- TABLE: listing_expiry_reminders
+-- =====================================================
+
+-- One reminder per listing per expiration date (renewal resets it naturally)
+CREATE TABLE IF NOT EXISTS listing_expiry_reminders (
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (listing_id, expires_at)
+);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE category_renewal_periods IS
+    'Renewal period (days) for C2C listings per category. Inherited by child categories; service default applies when not set.';
+
+COMMENT ON TABLE listing_expiry_reminders IS
+    'Expiry reminders sent to listing owners. Keyed by expires_at so a renewed listing gets a new reminder.';
+
+COMMENT ON COLUMN listings.expires_at IS
+    'Expiration timestamp for C2C listings. Expiration job moves active listings to ''expired'' after this time.';
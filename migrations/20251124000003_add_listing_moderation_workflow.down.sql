@@ -0,0 +1,17 @@
+-- Migration: Revert listing moderation workflow
+-- Date: 2025-11-24
+--
+-- Listings in review statuses are moved back to 'draft' so the previous constraint can be restored
+
+DROP TABLE IF EXISTS listing_moderation_events;
+
+DROP INDEX IF EXISTS idx_listings_scheduled_publish_at;
+DROP INDEX IF EXISTS idx_listings_pending_review;
+
+UPDATE listings SET status = 'draft' WHERE status IN ('pending_review', 'scheduled', 'rejected');
+
+ALTER TABLE listings DROP COLUMN IF EXISTS scheduled_publish_at;
+
+ALTER TABLE listings DROP CONSTRAINT IF EXISTS listings_status_check;
+ALTER TABLE listings ADD CONSTRAINT listings_status_check
+CHECK (status IN ('draft', 'active', 'inactive', 'sold', 'archived', 'expired'));
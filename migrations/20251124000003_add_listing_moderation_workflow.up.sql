@@ -0,0 +1,58 @@
+-- Migration: Listing moderation workflow
+-- Date: 2025-11-24
+-- Purpose: Add review statuses (pending_review, scheduled, rejected), scheduled publishing
+--          and an audit trail of moderation decisions.
+--
+-- Workflow: draft -> pending_review -> published ('active') -> paused ('inactive') -> archived,
+--           pending_review -> rejected | scheduled -> published
+
+-- =====================================================
+-- LISTING STATUS: add review statuses
+-- =====================================================
+
+ALTER TABLE listings DROP CONSTRAINT IF EXISTS listings_status_check;
+ALTER TABLE listings ADD CONSTRAINT listings_status_check
+CHECK (status IN ('draft', 'pending_review', 'scheduled', 'rejected', 'active', 'inactive', 'sold', 'archived', 'expired'));
+
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS scheduled_publish_at TIMESTAMP WITH TIME ZONE;
+
+-- Moderation queue is read oldest-first
+CREATE INDEX IF NOT EXISTS idx_listings_pending_review
+ON listings(updated_at)
+WHERE status = 'pending_review' AND is_deleted = false;
+
+-- Scheduled publish job scans approved listings by publish time
+CREATE INDEX IF NOT EXISTS idx_listings_scheduled_publish_at
+ON listings(scheduled_publish_at)
+WHERE status = 'scheduled' AND is_deleted = false;
+
+-- =====================================================
+-- TABLE: listing_moderation_events
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS listing_moderation_events (
+    id BIGSERIAL PRIMARY KEY,
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('submit', 'approve', 'reject', 'publish')),
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor_id BIGINT,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_listing_moderation_events_listing
+ON listing_moderation_events(listing_id, created_at DESC);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE listing_moderation_events IS
+    'Audit trail of listing review submissions, moderator decisions and scheduled publications.';
+
+COMMENT ON COLUMN listing_moderation_events.actor_id IS
+    'User who performed the action (owner or moderator). NULL for the scheduled publish job.';
+
+COMMENT ON COLUMN listings.scheduled_publish_at IS
+    'Requested publish time. Approved listings stay ''scheduled'' until this time, then become ''active''.';
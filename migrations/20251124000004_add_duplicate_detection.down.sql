@@ -0,0 +1,9 @@
+-- Migration: Revert duplicate listing detection
+-- Date: 2025-11-24
+
+DROP TABLE IF EXISTS listing_duplicate_flags;
+
+DROP INDEX IF EXISTS idx_listing_images_phash;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS phash;
+
+DROP TABLE IF EXISTS listing_fingerprints;
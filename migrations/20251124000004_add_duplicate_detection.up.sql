@@ -0,0 +1,59 @@
+-- Migration: Duplicate listing detection
+-- Date: 2025-11-24
+-- Purpose: Store MinHash text fingerprints with LSH band keys, perceptual hashes of
+--          listing images and duplicate matches flagged for moderation.
+
+-- =====================================================
+-- TABLE: listing_fingerprints
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS listing_fingerprints (
+    listing_id BIGINT PRIMARY KEY REFERENCES listings(id) ON DELETE CASCADE,
+    minhash BIGINT[] NOT NULL,
+    band_keys BIGINT[] NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Candidate lookup: listings sharing at least one LSH band key (band_keys && $1)
+CREATE INDEX IF NOT EXISTS idx_listing_fingerprints_band_keys
+ON listing_fingerprints USING GIN (band_keys);
+
+-- =====================================================
+-- LISTING IMAGES: perceptual hash
+-- =====================================================
+
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS phash BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_listing_images_phash
+ON listing_images(phash)
+WHERE phash IS NOT NULL;
+
+-- =====================================================
+-- TABLE: listing_duplicate_flags
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS listing_duplicate_flags (
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    duplicate_listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    text_similarity NUMERIC(4,3) NOT NULL DEFAULT 0,
+    image_distance SMALLINT,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (listing_id, duplicate_listing_id),
+    CHECK (listing_id <> duplicate_listing_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_listing_duplicate_flags_detected_at
+ON listing_duplicate_flags(detected_at DESC);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE listing_fingerprints IS
+    'MinHash signature of listing title+description (64 values) and its 16 LSH band keys for near-duplicate lookup.';
+
+COMMENT ON COLUMN listing_images.phash IS
+    '64-bit difference hash (dHash) of the image. Near-identical images differ in few bits.';
+
+COMMENT ON TABLE listing_duplicate_flags IS
+    'Duplicate matches recorded in flag mode for moderator review.';
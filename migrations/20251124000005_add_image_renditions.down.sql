@@ -0,0 +1,6 @@
+-- Migration: Revert responsive image renditions
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_listing_images_missing_renditions;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS renditions;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS blurhash;
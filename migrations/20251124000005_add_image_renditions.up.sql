@@ -0,0 +1,26 @@
+-- Migration: Responsive image renditions
+-- Date: 2025-11-24
+-- Purpose: Store blurhash placeholders and the resized renditions (200/400/800/1600 wide)
+--          generated for every listing image.
+
+-- =====================================================
+-- LISTING IMAGES: blurhash and renditions
+-- =====================================================
+
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS blurhash VARCHAR(64);
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS renditions JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- Backfill lookup: images stored in MinIO that have no renditions yet
+CREATE INDEX IF NOT EXISTS idx_listing_images_missing_renditions
+ON listing_images(id)
+WHERE storage_path IS NOT NULL AND renditions = '[]'::jsonb;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN listing_images.blurhash IS
+    'BlurHash placeholder (4x3 components) shown while the image loads.';
+
+COMMENT ON COLUMN listing_images.renditions IS
+    'Resized JPEG renditions, smallest first: [{"width", "height", "storage_path", "url", "file_size"}].';
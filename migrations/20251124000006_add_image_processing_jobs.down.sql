@@ -0,0 +1,7 @@
+-- Migration: Revert asynchronous image processing
+-- Date: 2025-11-24
+
+DROP TABLE IF EXISTS image_processing_jobs;
+
+ALTER TABLE listing_images DROP COLUMN IF EXISTS processing_error;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS processing_status;
@@ -0,0 +1,51 @@
+-- Migration: Asynchronous image processing
+-- Date: 2025-11-24
+-- Purpose: Uploaded originals are stored immediately and processed (validation, EXIF
+--          stripping, hashing, thumbnail and renditions) by background workers.
+--          Tracks per-image processing status and the job queue.
+
+-- =====================================================
+-- LISTING IMAGES: processing status
+-- =====================================================
+
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS processing_status VARCHAR(20) NOT NULL DEFAULT 'ready'
+    CHECK (processing_status IN ('pending', 'processing', 'ready', 'failed'));
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS processing_error TEXT;
+
+-- =====================================================
+-- TABLE: image_processing_jobs
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS image_processing_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    image_id BIGINT NOT NULL UNIQUE REFERENCES listing_images(id) ON DELETE CASCADE,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'completed', 'failed')),
+
+    retry_count INTEGER NOT NULL DEFAULT 0,
+    max_retries INTEGER NOT NULL DEFAULT 3,
+
+    error_message TEXT,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Job polling: pending jobs in FIFO order, stale 'processing' jobs are reclaimed
+CREATE INDEX IF NOT EXISTS idx_image_processing_jobs_status
+ON image_processing_jobs(status, created_at)
+WHERE status IN ('pending', 'processing');
+
+CREATE TRIGGER update_image_processing_jobs_updated_at BEFORE UPDATE ON image_processing_jobs
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN listing_images.processing_status IS
+    'pending/processing: original stored, renditions not ready yet; ready: processed; failed: rejected or retries exhausted.';
+
+COMMENT ON TABLE image_processing_jobs IS
+    'Queue of uploaded images awaiting processing. Failed jobs are retried until max_retries.';
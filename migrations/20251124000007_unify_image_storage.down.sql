@@ -0,0 +1,8 @@
+-- Migration: Revert unified image storage
+-- Date: 2025-11-24
+
+ALTER TABLE storefronts DROP COLUMN IF EXISTS banner_storage_path;
+ALTER TABLE storefronts DROP COLUMN IF EXISTS logo_storage_path;
+
+DROP INDEX IF EXISTS idx_listing_images_variant_id;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS variant_id;
@@ -0,0 +1,36 @@
+-- Migration: Unified image storage
+-- Date: 2025-11-24
+-- Purpose: Variant-level images (stored in listing_images next to the product images)
+--          and storage keys for storefront logo/banner, so that every stored object
+--          is referenced by a row and unreferenced objects can be garbage collected.
+
+-- =====================================================
+-- LISTING IMAGES: variant images
+-- =====================================================
+
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS variant_id BIGINT
+    REFERENCES b2c_product_variants(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_listing_images_variant_id
+ON listing_images(variant_id)
+WHERE variant_id IS NOT NULL;
+
+-- =====================================================
+-- STOREFRONTS: branding storage keys
+-- =====================================================
+
+ALTER TABLE storefronts ADD COLUMN IF NOT EXISTS logo_storage_path TEXT;
+ALTER TABLE storefronts ADD COLUMN IF NOT EXISTS banner_storage_path TEXT;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN listing_images.variant_id IS
+'Product variant the image belongs to (NULL for product/listing-level images)';
+
+COMMENT ON COLUMN storefronts.logo_storage_path IS
+'MinIO object key of the uploaded logo (NULL when logo_url points to an external image)';
+
+COMMENT ON COLUMN storefronts.banner_storage_path IS
+'MinIO object key of the uploaded banner (NULL when banner_url points to an external image)';
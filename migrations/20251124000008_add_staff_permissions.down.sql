@@ -0,0 +1,5 @@
+-- Migration: Revert storefront staff permissions and invitations
+-- Date: 2025-11-24
+
+DROP TRIGGER IF EXISTS update_storefront_staff_invitations_updated_at ON storefront_staff_invitations;
+DROP TABLE IF EXISTS storefront_staff_invitations;
@@ -0,0 +1,55 @@
+-- Migration: Storefront staff permissions and invitations
+-- Date: 2025-11-24
+-- Purpose: Staff members are added by invitation (accepted or declined by the invited
+--          user) instead of directly by user ID. Staff permissions are the role preset
+--          adjusted by the per-member permissions object.
+
+-- =====================================================
+-- TABLE: storefront_staff_invitations
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_staff_invitations (
+    id BIGSERIAL PRIMARY KEY,
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+
+    role VARCHAR(50) NOT NULL CHECK (role IN ('manager', 'cashier', 'support', 'moderator')),
+    permissions JSONB NOT NULL DEFAULT '{}'::JSONB,
+
+    invited_by BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'revoked', 'expired')),
+
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One open invitation per user and storefront (expired ones are replaced on re-invite)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_staff_invitations_pending
+ON storefront_staff_invitations(storefront_id, user_id)
+WHERE status = 'pending';
+
+-- "My invitations" lookup
+CREATE INDEX IF NOT EXISTS idx_storefront_staff_invitations_user
+ON storefront_staff_invitations(user_id, status);
+
+CREATE TRIGGER update_storefront_staff_invitations_updated_at BEFORE UPDATE ON storefront_staff_invitations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE storefront_staff_invitations IS
+    'Invitations to join a storefront staff. Accepting creates the storefront_staff row.';
+
+COMMENT ON COLUMN storefront_staff_invitations.permissions IS
+    'Permission overrides applied on top of the role preset, e.g. {"orders.fulfill": true}';
+
+COMMENT ON COLUMN storefront_staff_invitations.status IS
+    'pending until answered; a pending invitation past expires_at can no longer be accepted and is marked expired when the user is invited again.';
+
+COMMENT ON COLUMN storefront_staff.permissions IS
+    'Permission overrides applied on top of the role preset, e.g. {"inventory.adjust": false}';
@@ -0,0 +1,12 @@
+-- Migration: Revert product and storefront reviews
+-- Date: 2025-11-24
+
+ALTER TABLE listings
+    DROP COLUMN IF EXISTS reviews_count,
+    DROP COLUMN IF EXISTS rating;
+
+DROP TABLE IF EXISTS review_aggregates;
+DROP TABLE IF EXISTS review_reports;
+DROP TABLE IF EXISTS review_votes;
+DROP TRIGGER IF EXISTS update_reviews_updated_at ON reviews;
+DROP TABLE IF EXISTS reviews;
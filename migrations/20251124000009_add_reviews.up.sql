@@ -0,0 +1,152 @@
+-- Migration: Product and storefront reviews
+-- Date: 2025-11-24
+-- Purpose: Buyers rate storefront products and storefronts (1-5 stars). Reviews backed by a
+--          delivered order carry a verified-purchase badge. Sellers reply, users vote on
+--          helpfulness and report abuse. Rating aggregates are maintained incrementally and
+--          copied onto listings/storefronts for display and search sorting.
+
+-- =====================================================
+-- TABLE: reviews
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- What is reviewed: a storefront product (listings.id) or a storefront (storefronts.id)
+    subject_type VARCHAR(20) NOT NULL CHECK (subject_type IN ('product', 'storefront')),
+    subject_id BIGINT NOT NULL,
+    storefront_id BIGINT NOT NULL,
+
+    user_id BIGINT NOT NULL,
+
+    -- Verified purchase: delivered order backing the review
+    order_id BIGINT REFERENCES orders(id) ON DELETE SET NULL,
+    is_verified_purchase BOOLEAN NOT NULL DEFAULT false,
+
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    title VARCHAR(200),
+    body TEXT,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'published' CHECK (status IN ('published', 'hidden')),
+
+    -- Seller reply
+    seller_reply TEXT,
+    seller_reply_by BIGINT,
+    seller_replied_at TIMESTAMP WITH TIME ZONE,
+
+    -- Denormalized counters
+    helpful_count INTEGER NOT NULL DEFAULT 0 CHECK (helpful_count >= 0),
+    not_helpful_count INTEGER NOT NULL DEFAULT 0 CHECK (not_helpful_count >= 0),
+    reports_count INTEGER NOT NULL DEFAULT 0 CHECK (reports_count >= 0),
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- One review per user and subject
+    CONSTRAINT uq_reviews_subject_user UNIQUE (subject_type, subject_id, user_id)
+);
+
+-- Review listing by subject (newest first)
+CREATE INDEX IF NOT EXISTS idx_reviews_subject
+ON reviews(subject_type, subject_id, status, created_at DESC);
+
+-- Seller-side view of all reviews of a storefront and its products
+CREATE INDEX IF NOT EXISTS idx_reviews_storefront
+ON reviews(storefront_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_user
+ON reviews(user_id);
+
+CREATE TRIGGER update_reviews_updated_at BEFORE UPDATE ON reviews
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- TABLE: review_votes
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (review_id, user_id)
+);
+
+-- =====================================================
+-- TABLE: review_reports
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS review_reports (
+    id BIGSERIAL PRIMARY KEY,
+    review_id BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('spam', 'offensive', 'fake', 'off_topic', 'other')),
+    comment TEXT,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'dismissed', 'actioned')),
+    resolved_by BIGINT,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- One report per user and review
+    CONSTRAINT uq_review_reports_review_user UNIQUE (review_id, user_id)
+);
+
+-- Moderation queue
+CREATE INDEX IF NOT EXISTS idx_review_reports_open
+ON review_reports(created_at)
+WHERE status = 'open';
+
+-- =====================================================
+-- TABLE: review_aggregates
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS review_aggregates (
+    subject_type VARCHAR(20) NOT NULL CHECK (subject_type IN ('product', 'storefront')),
+    subject_id BIGINT NOT NULL,
+
+    reviews_count INTEGER NOT NULL DEFAULT 0 CHECK (reviews_count >= 0),
+    rating_sum BIGINT NOT NULL DEFAULT 0 CHECK (rating_sum >= 0),
+    rating_1 INTEGER NOT NULL DEFAULT 0,
+    rating_2 INTEGER NOT NULL DEFAULT 0,
+    rating_3 INTEGER NOT NULL DEFAULT 0,
+    rating_4 INTEGER NOT NULL DEFAULT 0,
+    rating_5 INTEGER NOT NULL DEFAULT 0,
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (subject_type, subject_id)
+);
+
+-- =====================================================
+-- LISTINGS: rating columns for display and sorting
+-- =====================================================
+
+ALTER TABLE listings
+    ADD COLUMN IF NOT EXISTS rating NUMERIC(3,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reviews_count INTEGER NOT NULL DEFAULT 0;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE reviews IS
+    'Buyer reviews of storefront products and storefronts. Hidden reviews are excluded from aggregates.';
+
+COMMENT ON COLUMN reviews.is_verified_purchase IS
+    'True when the reviewer has a delivered order containing the product (or from the storefront); order_id references it.';
+
+COMMENT ON COLUMN reviews.reports_count IS
+    'Number of abuse reports. The review is hidden automatically once the threshold is reached.';
+
+COMMENT ON TABLE review_aggregates IS
+    'Incrementally maintained rating totals and star distribution of published reviews per subject.';
+
+COMMENT ON COLUMN listings.rating IS
+    'Average rating of published reviews (copied from review_aggregates)';
+
+COMMENT ON COLUMN listings.reviews_count IS
+    'Number of published reviews (copied from review_aggregates)';
@@ -0,0 +1,7 @@
+-- Migration: Revert storefront followers
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_listings_storefront_feed;
+DROP TABLE IF EXISTS storefront_followers;
+
+UPDATE storefronts SET followers_count = 0;
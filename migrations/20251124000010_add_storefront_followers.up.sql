@@ -0,0 +1,37 @@
+-- Migration: Storefront followers
+-- Date: 2025-11-24
+-- Purpose: Users follow storefronts to get their new products in a "following" feed.
+--          storefronts.followers_count is maintained in the same transaction as each
+--          follow/unfollow, so it stays consistent under concurrent requests.
+
+-- =====================================================
+-- TABLE: storefront_followers
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_followers (
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (storefront_id, user_id)
+);
+
+-- Follower list for sellers (newest first)
+CREATE INDEX IF NOT EXISTS idx_storefront_followers_storefront
+ON storefront_followers(storefront_id, created_at DESC);
+
+-- Storefronts followed by a user (following feed)
+CREATE INDEX IF NOT EXISTS idx_storefront_followers_user
+ON storefront_followers(user_id);
+
+-- Following feed: newest active products of a storefront
+CREATE INDEX IF NOT EXISTS idx_listings_storefront_feed
+ON listings(storefront_id, created_at DESC, id DESC)
+WHERE status = 'active' AND is_deleted = false;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE storefront_followers IS
+    'Users following storefronts. Each row is counted in storefronts.followers_count.';
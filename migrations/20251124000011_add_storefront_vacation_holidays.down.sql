@@ -0,0 +1,15 @@
+-- Migration: Revert storefront vacation mode and holiday calendars
+-- Date: 2025-11-24
+
+DROP TABLE IF EXISTS storefront_holidays;
+
+ALTER TABLE storefronts DROP CONSTRAINT IF EXISTS chk_storefronts_vacation_period;
+ALTER TABLE storefronts DROP CONSTRAINT IF EXISTS chk_storefronts_vacation_order_policy;
+
+ALTER TABLE storefronts
+    DROP COLUMN IF EXISTS timezone,
+    DROP COLUMN IF EXISTS vacation_order_policy,
+    DROP COLUMN IF EXISTS vacation_message,
+    DROP COLUMN IF EXISTS vacation_ends_at,
+    DROP COLUMN IF EXISTS vacation_starts_at,
+    DROP COLUMN IF EXISTS vacation_mode;
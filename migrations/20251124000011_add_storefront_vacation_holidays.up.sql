@@ -0,0 +1,58 @@
+-- Migration: Storefront vacation mode and holiday calendars
+-- Date: 2025-11-24
+-- Purpose: Sellers can put a storefront on vacation (blocking orders or accepting them
+--          with a delayed shipping notice) and declare date-range closures that repeat
+--          yearly. Opening hours are evaluated in the storefront's timezone, which
+--          defaults to the timezone of its country.
+
+-- =====================================================
+-- STOREFRONTS: vacation mode and timezone
+-- =====================================================
+
+ALTER TABLE storefronts
+    ADD COLUMN IF NOT EXISTS vacation_mode BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS vacation_starts_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS vacation_ends_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS vacation_message TEXT,
+    ADD COLUMN IF NOT EXISTS vacation_order_policy VARCHAR(20) NOT NULL DEFAULT 'block',
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64);
+
+ALTER TABLE storefronts
+    ADD CONSTRAINT chk_storefronts_vacation_order_policy
+    CHECK (vacation_order_policy IN ('block', 'delay'));
+
+ALTER TABLE storefronts
+    ADD CONSTRAINT chk_storefronts_vacation_period
+    CHECK (vacation_ends_at IS NULL OR vacation_starts_at IS NULL OR vacation_ends_at > vacation_starts_at);
+
+-- =====================================================
+-- TABLE: storefront_holidays
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_holidays (
+    id BIGSERIAL PRIMARY KEY,
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    recurring_yearly BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_storefront_holidays_dates CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_storefront_holidays_storefront
+ON storefront_holidays(storefront_id, start_date);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN storefronts.vacation_mode IS
+    'Vacation switch. The storefront is on vacation while enabled and inside the optional vacation_starts_at/vacation_ends_at window.';
+COMMENT ON COLUMN storefronts.vacation_order_policy IS
+    'block: cart additions and checkout are rejected; delay: orders are accepted with a delayed shipping notice';
+COMMENT ON COLUMN storefronts.timezone IS
+    'IANA timezone for opening hours. NULL means the timezone of the storefront country.';
+COMMENT ON TABLE storefront_holidays IS
+    'Date-range closures. Recurring holidays repeat every year on the same month/day range.';
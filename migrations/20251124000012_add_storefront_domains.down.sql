@@ -0,0 +1,7 @@
+-- Migration: Revert storefront custom domains and sitemap support
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_listings_sitemap;
+
+DROP TRIGGER IF EXISTS update_storefront_domains_updated_at ON storefront_domains;
+DROP TABLE IF EXISTS storefront_domains;
@@ -0,0 +1,56 @@
+-- Migration: Storefront custom domains and sitemap support
+-- Date: 2025-11-24
+-- Purpose: Sellers map their own domains (e.g. shop.example.rs) to a storefront. A domain
+--          is proven with a DNS TXT record before it resolves; the first verified domain
+--          becomes the storefront's primary domain. Also adds the indexes used to page
+--          through public pages when generating sitemaps.
+
+-- =====================================================
+-- TABLE: storefront_domains
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_domains (
+    id BIGSERIAL PRIMARY KEY,
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    verification_token VARCHAR(64) NOT NULL,
+    verified_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_storefront_domains_lowercase CHECK (domain = lower(domain)),
+    CONSTRAINT chk_storefront_domains_primary_verified CHECK (NOT is_primary OR verified_at IS NOT NULL)
+);
+
+-- A domain belongs to one storefront
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_domains_domain
+ON storefront_domains(domain);
+
+-- At most one primary domain per storefront
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_domains_primary
+ON storefront_domains(storefront_id) WHERE is_primary;
+
+CREATE INDEX IF NOT EXISTS idx_storefront_domains_storefront
+ON storefront_domains(storefront_id, created_at);
+
+CREATE TRIGGER update_storefront_domains_updated_at BEFORE UPDATE ON storefront_domains
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- SITEMAP INDEXES
+-- =====================================================
+
+-- Public listings in id order (sitemap pages)
+CREATE INDEX IF NOT EXISTS idx_listings_sitemap
+ON listings(id)
+WHERE status = 'active' AND visibility = 'public' AND is_deleted = false;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE storefront_domains IS
+    'Custom domains of storefronts. Verified by a TXT record on _svetu-verify.<domain> containing verification_token.';
+COMMENT ON COLUMN storefront_domains.is_primary IS
+    'Canonical domain of the storefront; only verified domains can be primary';
@@ -0,0 +1,8 @@
+-- Migration: Revert storefront verification workflow
+-- Date: 2025-11-24
+
+DROP TABLE IF EXISTS storefront_verification_events;
+DROP TABLE IF EXISTS storefront_verification_documents;
+
+DROP TRIGGER IF EXISTS update_storefront_verification_requests_updated_at ON storefront_verification_requests;
+DROP TABLE IF EXISTS storefront_verification_requests;
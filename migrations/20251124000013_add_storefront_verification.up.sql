@@ -0,0 +1,91 @@
+-- Migration: Storefront verification workflow
+-- Date: 2025-11-24
+-- Purpose: Sellers submit business documents (stored in MinIO) to get their storefront
+--          verified. Admins approve or reject pending requests from a review queue; an
+--          approval sets storefronts.is_verified / verification_date (and address_verified
+--          when proof of address was submitted). Every workflow step is recorded in
+--          storefront_verification_events.
+
+-- =====================================================
+-- TABLE: storefront_verification_requests
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_verification_requests (
+    id BIGSERIAL PRIMARY KEY,
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    submitted_by BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    business_name VARCHAR(255) NOT NULL,
+    registration_number VARCHAR(20),     -- Company registration number (MB)
+    tax_id VARCHAR(20),                  -- Tax identification number (PIB)
+    notes TEXT,
+    reviewed_by BIGINT,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    rejection_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_sf_verification_rejection_reason CHECK (status <> 'rejected' OR rejection_reason IS NOT NULL)
+);
+
+-- At most one pending request per storefront
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sf_verification_requests_pending
+ON storefront_verification_requests(storefront_id) WHERE status = 'pending';
+
+-- Review queue (oldest first)
+CREATE INDEX IF NOT EXISTS idx_sf_verification_requests_queue
+ON storefront_verification_requests(status, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_sf_verification_requests_storefront
+ON storefront_verification_requests(storefront_id, created_at DESC);
+
+CREATE TRIGGER update_storefront_verification_requests_updated_at BEFORE UPDATE ON storefront_verification_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- TABLE: storefront_verification_documents
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_verification_documents (
+    id BIGSERIAL PRIMARY KEY,
+    request_id BIGINT NOT NULL REFERENCES storefront_verification_requests(id) ON DELETE CASCADE,
+    document_type VARCHAR(30) NOT NULL
+        CHECK (document_type IN ('business_registration', 'tax_certificate', 'identity', 'address_proof', 'other')),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    file_size BIGINT NOT NULL CHECK (file_size > 0),
+    storage_path VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sf_verification_documents_request
+ON storefront_verification_documents(request_id);
+
+-- =====================================================
+-- TABLE: storefront_verification_events (audit history)
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS storefront_verification_events (
+    id BIGSERIAL PRIMARY KEY,
+    storefront_id INTEGER NOT NULL REFERENCES storefronts(id) ON DELETE CASCADE,
+    request_id BIGINT REFERENCES storefront_verification_requests(id) ON DELETE SET NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('submit', 'approve', 'reject', 'cancel', 'revoke')),
+    actor_id BIGINT NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sf_verification_events_storefront
+ON storefront_verification_events(storefront_id, created_at DESC);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE storefront_verification_requests IS
+    'Seller requests to verify a storefront; reviewed by admins';
+COMMENT ON TABLE storefront_verification_documents IS
+    'Business documents of a verification request. storage_path is a private MinIO key under verification/{storefront_id}/';
+COMMENT ON TABLE storefront_verification_events IS
+    'Audit history of the storefront verification workflow (submit, approve, reject, cancel, revoke)';
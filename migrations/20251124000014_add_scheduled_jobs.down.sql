@@ -0,0 +1,24 @@
+-- Migration: Revert job scheduler run history and analytics event archival
+-- Date: 2025-11-24
+
+DROP FUNCTION IF EXISTS archive_old_analytics_events(INTERVAL, INTEGER);
+
+-- Restore the original function
+CREATE OR REPLACE FUNCTION archive_old_analytics_events()
+RETURNS INTEGER AS $$
+DECLARE
+    v_deleted_count INTEGER;
+BEGIN
+    -- Delete events older than 90 days (after materialized views are refreshed)
+    DELETE FROM analytics_events
+    WHERE created_at < NOW() - INTERVAL '90 days'
+    RETURNING COUNT(*) INTO v_deleted_count;
+
+    RETURN v_deleted_count;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON FUNCTION archive_old_analytics_events IS 'Archive events older than 90 days to manage table size';
+
+DROP TABLE IF EXISTS analytics_events_archive;
+DROP TABLE IF EXISTS scheduled_job_runs;
@@ -0,0 +1,81 @@
+-- Migration: In-process job scheduler run history and analytics event archival
+-- Date: 2025-11-24
+-- Purpose: The scheduler runs periodic jobs (materialized view refresh, event archival, ...)
+--          on a single replica at a time (PostgreSQL advisory lock) and records every run
+--          in scheduled_job_runs. archive_old_analytics_events() is rewritten: the original
+--          used an aggregate in RETURNING (invalid) and deleted events instead of archiving
+--          them; it now moves old events to analytics_events_archive in bounded batches.
+
+-- =====================================================
+-- TABLE: scheduled_job_runs
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS scheduled_job_runs (
+    id BIGSERIAL PRIMARY KEY,
+    job_name VARCHAR(100) NOT NULL,
+    instance VARCHAR(255) NOT NULL,          -- Replica that ran the job (hostname)
+    status VARCHAR(20) NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running', 'succeeded', 'failed')),
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    duration_ms BIGINT,
+    error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_job_runs_job_started
+    ON scheduled_job_runs (job_name, started_at DESC);
+
+-- =====================================================
+-- TABLE: analytics_events_archive
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS analytics_events_archive (LIKE analytics_events);
+
+ALTER TABLE analytics_events_archive ADD PRIMARY KEY (id);
+
+CREATE INDEX IF NOT EXISTS idx_analytics_events_archive_created_at_brin
+    ON analytics_events_archive USING BRIN (created_at)
+    WITH (pages_per_range = 128);
+
+-- =====================================================
+-- FUNCTION: archive_old_analytics_events
+-- =====================================================
+
+DROP FUNCTION IF EXISTS archive_old_analytics_events();
+
+-- Moves up to p_batch_size events older than p_retention to the archive.
+-- Returns the number of moved events; callers repeat until it is below p_batch_size.
+CREATE OR REPLACE FUNCTION archive_old_analytics_events(
+    p_retention INTERVAL DEFAULT INTERVAL '90 days',
+    p_batch_size INTEGER DEFAULT 50000
+)
+RETURNS INTEGER AS $$
+DECLARE
+    v_moved_count INTEGER;
+BEGIN
+    WITH moved AS (
+        DELETE FROM analytics_events
+        WHERE id IN (
+            SELECT id FROM analytics_events
+            WHERE created_at < NOW() - p_retention
+            ORDER BY id
+            LIMIT p_batch_size
+        )
+        RETURNING *
+    )
+    INSERT INTO analytics_events_archive
+    SELECT * FROM moved;
+
+    GET DIAGNOSTICS v_moved_count = ROW_COUNT;
+    RETURN v_moved_count;
+END;
+$$ LANGUAGE plpgsql;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE scheduled_job_runs IS 'Run history of in-process scheduled jobs';
+COMMENT ON COLUMN scheduled_job_runs.status IS 'running (also left behind by crashed runs), succeeded, failed';
+COMMENT ON TABLE analytics_events_archive IS 'Analytics events moved out of analytics_events after the retention period';
+COMMENT ON FUNCTION archive_old_analytics_events(INTERVAL, INTEGER) IS 'Move a batch of events older than the retention period to analytics_events_archive';
@@ -0,0 +1,7 @@
+-- Migration: Revert search result click tracking
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_search_clicks_listing;
+DROP INDEX IF EXISTS idx_search_queries_zero_results;
+
+DROP TABLE IF EXISTS search_clicks;
@@ -0,0 +1,49 @@
+-- Migration: Search result click tracking and zero-result query reporting
+-- Date: 2025-11-24
+-- Purpose: search_queries.clicked_listing_id only keeps the first clicked listing and not
+--          where it was shown. search_clicks records every distinct listing clicked from a
+--          search together with its position in the results, so CTR and click position can
+--          be reported per query. A partial index supports the zero-result query report.
+
+-- =====================================================
+-- TABLE: search_clicks
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS search_clicks (
+    id BIGSERIAL PRIMARY KEY,
+    search_query_id BIGINT NOT NULL,
+    listing_id BIGINT NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 1),  -- 1-based rank across pages
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Clicks are removed together with their query (retention cleanup)
+    CONSTRAINT fk_search_clicks_query FOREIGN KEY (search_query_id)
+        REFERENCES search_queries(id) ON DELETE CASCADE,
+
+    -- Repeated clicks on the same result count once
+    CONSTRAINT uq_search_clicks_query_listing UNIQUE (search_query_id, listing_id)
+);
+
+-- =====================================================
+-- INDEXES
+-- =====================================================
+
+-- Zero-result report
+-- Query: SELECT LOWER(query_text), COUNT(*) FROM search_queries
+--        WHERE results_count = 0 AND created_at > NOW() - INTERVAL '7 days' GROUP BY 1
+CREATE INDEX IF NOT EXISTS idx_search_queries_zero_results
+    ON search_queries(created_at DESC, query_text)
+    WHERE results_count = 0;
+
+CREATE INDEX IF NOT EXISTS idx_search_clicks_listing
+    ON search_clicks(listing_id, created_at DESC);
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE search_clicks IS
+    'Listings clicked from search results. One row per (search, listing); position is the 1-based rank in the results.';
+
+COMMENT ON COLUMN search_clicks.position IS
+    'Rank of the clicked listing in the search results (1 = first result of the first page).';
@@ -0,0 +1,5 @@
+-- Migration: Revert asynchronous report exports
+-- Date: 2025-11-24
+
+DROP TRIGGER IF EXISTS update_report_exports_updated_at ON report_exports;
+DROP TABLE IF EXISTS report_exports;
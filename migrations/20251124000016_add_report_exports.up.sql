@@ -0,0 +1,66 @@
+-- Migration: Asynchronous report exports
+-- Date: 2025-11-24
+-- Purpose: Sellers and admins request downloadable reports (orders, product performance,
+--          stock movements) for a date range. Background workers render the report as CSV
+--          or JSON Lines, store it in object storage and mark the export as completed; the
+--          requester polls the export and downloads the file through a presigned URL.
+
+-- =====================================================
+-- TABLE: report_exports
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS report_exports (
+    id BIGSERIAL PRIMARY KEY,
+    requested_by BIGINT NOT NULL,            -- User who requested the export
+    storefront_id BIGINT REFERENCES storefronts(id) ON DELETE CASCADE, -- NULL = platform-wide (admin only)
+
+    report_type VARCHAR(30) NOT NULL
+        CHECK (report_type IN ('orders', 'product_performance', 'stock_movements')),
+    format VARCHAR(10) NOT NULL DEFAULT 'csv' CHECK (format IN ('csv', 'jsonl')),
+    date_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    date_to TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'processing', 'completed', 'failed')),
+    retry_count INTEGER NOT NULL DEFAULT 0,
+    max_retries INTEGER NOT NULL DEFAULT 3,
+    error_message TEXT,
+
+    -- Result (set when completed)
+    object_key VARCHAR(500),
+    row_count BIGINT,
+    file_size BIGINT,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,     -- File is deleted after this time
+
+    CONSTRAINT chk_report_exports_date_range CHECK (date_to > date_from)
+);
+
+-- Job polling: pending exports in FIFO order, stale 'processing' exports are reclaimed
+CREATE INDEX IF NOT EXISTS idx_report_exports_status
+ON report_exports(status, created_at)
+WHERE status IN ('pending', 'processing');
+
+-- Export history of a user, active export limit
+CREATE INDEX IF NOT EXISTS idx_report_exports_requested_by
+ON report_exports(requested_by, created_at DESC);
+
+-- Purge of expired files
+CREATE INDEX IF NOT EXISTS idx_report_exports_expires_at
+ON report_exports(expires_at)
+WHERE expires_at IS NOT NULL;
+
+CREATE TRIGGER update_report_exports_updated_at BEFORE UPDATE ON report_exports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE report_exports IS
+    'Requested report exports. Failed exports are retried until max_retries; expired exports are purged with their files.';
+COMMENT ON COLUMN report_exports.object_key IS 'Object storage key of the rendered file (completed exports)';
+COMMENT ON COLUMN report_exports.expires_at IS 'Completed exports are deleted with their file after this time';
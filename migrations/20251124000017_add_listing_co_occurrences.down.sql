@@ -0,0 +1,5 @@
+-- Migration: Revert item-to-item recommendations
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_analytics_events_session_time;
+DROP TABLE IF EXISTS listing_co_occurrences;
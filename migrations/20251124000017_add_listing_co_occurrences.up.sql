@@ -0,0 +1,44 @@
+-- Migration: Item-to-item recommendations
+-- Date: 2025-11-24
+-- Purpose: Store the top related listings of every listing ("users who viewed, favorited or
+--          bought X also engaged with Y"). A periodic batch job rebuilds the table from
+--          analytics_events, listing_favorites and order_items; the recommendation RPCs only
+--          read it. Two kinds are kept: 'engagement' (any interaction by the same visitor)
+--          and 'purchase' (bought in the same order).
+
+-- =====================================================
+-- TABLE: listing_co_occurrences
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS listing_co_occurrences (
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('engagement', 'purchase')),
+    listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    related_listing_id BIGINT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    score DOUBLE PRECISION NOT NULL,        -- Normalized co-occurrence strength (higher = closer)
+    support INTEGER NOT NULL,               -- Visitors (engagement) or orders (purchase) behind the pair
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (kind, listing_id, related_listing_id),
+    CONSTRAINT chk_listing_co_occurrences_distinct CHECK (listing_id <> related_listing_id)
+);
+
+-- =====================================================
+-- INDEXES
+-- =====================================================
+
+-- Neighbors of a listing, strongest first
+CREATE INDEX IF NOT EXISTS idx_listing_co_occurrences_neighbors
+    ON listing_co_occurrences (kind, listing_id, score DESC);
+
+-- Recent interactions of anonymous visitors (recommendation seeds)
+CREATE INDEX IF NOT EXISTS idx_analytics_events_session_time
+    ON analytics_events (session_id, created_at DESC)
+    WHERE session_id IS NOT NULL AND entity_type = 'listing';
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE listing_co_occurrences IS 'Top-N related listings per listing, rebuilt by the rebuild_recommendations job';
+COMMENT ON COLUMN listing_co_occurrences.score IS 'Weighted co-occurrence normalized by the popularity of both listings';
+COMMENT ON COLUMN listing_co_occurrences.support IS 'Number of visitors or orders in which both listings occur';
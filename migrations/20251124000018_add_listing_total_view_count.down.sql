@@ -0,0 +1,5 @@
+-- Migration: Revert deduplicated view counting
+-- Date: 2025-11-24
+
+COMMENT ON COLUMN listings.view_count IS NULL;
+ALTER TABLE listings DROP COLUMN IF EXISTS total_view_count;
@@ -0,0 +1,22 @@
+-- Migration: Deduplicated view counting
+-- Date: 2025-11-24
+-- Purpose: view_count now counts deduplicated views (one per user, session or IP within a
+--          window; crawlers excluded), so refreshes no longer inflate trending and analytics.
+--          total_view_count keeps every human view including repeats. Both counters are
+--          incremented in batches by the view counter buffer.
+
+-- =====================================================
+-- COLUMN: listings.total_view_count
+-- =====================================================
+
+ALTER TABLE listings ADD COLUMN IF NOT EXISTS total_view_count BIGINT NOT NULL DEFAULT 0;
+
+-- Views counted so far were not deduplicated
+UPDATE listings SET total_view_count = view_count WHERE total_view_count = 0 AND view_count > 0;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN listings.view_count IS 'Deduplicated views: one per viewer (user, session or IP) per deduplication window';
+COMMENT ON COLUMN listings.total_view_count IS 'All human views including repeats (crawlers excluded)';
@@ -0,0 +1,15 @@
+-- Migration: Revert search ranking A/B experiments
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_search_queries_experiment;
+
+ALTER TABLE search_clicks
+    DROP COLUMN IF EXISTS experiment_variant,
+    DROP COLUMN IF EXISTS experiment_id;
+
+ALTER TABLE search_queries
+    DROP COLUMN IF EXISTS experiment_variant,
+    DROP COLUMN IF EXISTS experiment_id;
+
+DROP TRIGGER IF EXISTS update_search_experiments_updated_at ON search_experiments;
+DROP TABLE IF EXISTS search_experiments;
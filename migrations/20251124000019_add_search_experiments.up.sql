@@ -0,0 +1,58 @@
+-- Migration: Search ranking A/B experiments
+-- Date: 2025-11-24
+-- Purpose: Ranking changes to the search query builders are rolled out as experiments.
+--          search_experiments holds the experiment definitions with their variants (query
+--          builder ranking parameters); searchers are bucketed deterministically by user or
+--          session. Every recorded search and click stores the experiment and variant that
+--          served it, so CTR and conversion can be compared between the arms.
+
+-- =====================================================
+-- TABLE: search_experiments
+-- =====================================================
+
+CREATE TABLE IF NOT EXISTS search_experiments (
+    id VARCHAR(64) PRIMARY KEY,              -- Slug, e.g. 'popularity-boost-v1'
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'running', 'stopped')),
+    traffic_percent SMALLINT NOT NULL CHECK (traffic_percent BETWEEN 1 AND 100),
+    variants JSONB NOT NULL,                 -- [{name, weight, ranking}], baseline first
+    started_at TIMESTAMP WITH TIME ZONE,
+    stopped_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_search_experiments_updated_at BEFORE UPDATE ON search_experiments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =====================================================
+-- COLUMNS: experiment arm of searches and clicks
+-- =====================================================
+
+ALTER TABLE search_queries
+    ADD COLUMN IF NOT EXISTS experiment_id VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS experiment_variant VARCHAR(64);
+
+ALTER TABLE search_clicks
+    ADD COLUMN IF NOT EXISTS experiment_id VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS experiment_variant VARCHAR(64);
+
+-- =====================================================
+-- INDEXES
+-- =====================================================
+
+-- Experiment results
+-- Query: SELECT experiment_variant, COUNT(*) FROM search_queries
+--        WHERE experiment_id = $1 AND created_at >= $2 GROUP BY 1
+CREATE INDEX IF NOT EXISTS idx_search_queries_experiment
+    ON search_queries(experiment_id, experiment_variant, created_at)
+    WHERE experiment_id IS NOT NULL;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON TABLE search_experiments IS 'Search ranking A/B experiments and their query builder variants';
+COMMENT ON COLUMN search_experiments.variants IS 'Variants with traffic weight and ranking parameters; a variant without ranking is a control';
+COMMENT ON COLUMN search_queries.experiment_id IS 'Search experiment that served the results (NULL = not enrolled)';
+COMMENT ON COLUMN search_clicks.experiment_id IS 'Search experiment that served the clicked results';
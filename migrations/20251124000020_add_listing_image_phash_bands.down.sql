@@ -0,0 +1,9 @@
+-- Migration: Revert multi-index hashing of image perceptual hashes
+-- Date: 2025-11-24
+
+CREATE INDEX IF NOT EXISTS idx_listing_images_phash
+ON listing_images(phash)
+WHERE phash IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_listing_images_phash_bands;
+ALTER TABLE listing_images DROP COLUMN IF EXISTS phash_bands;
@@ -0,0 +1,34 @@
+-- Migration: Multi-index hashing of image perceptual hashes
+-- Date: 2025-11-24
+-- Purpose: The btree index on listing_images.phash cannot serve Hamming distance lookups,
+--          so every image duplicate check scanned all images. phash_bands splits the hash
+--          into four 16-bit bands keyed by band position; hashes within d bits share a band
+--          within d/4 bits, so a GIN lookup on the enumerated band keys finds all candidates.
+
+-- =====================================================
+-- COLUMN: listing_images.phash_bands
+-- =====================================================
+
+-- Key of band b is b * 65536 + band value, most significant band first
+-- (must match dedup.ImageHashBandKeys)
+ALTER TABLE listing_images ADD COLUMN IF NOT EXISTS phash_bands BIGINT[]
+GENERATED ALWAYS AS (
+    CASE WHEN phash IS NULL THEN NULL ELSE ARRAY[
+        (phash >> 48) & 65535,
+        65536 + ((phash >> 32) & 65535),
+        131072 + ((phash >> 16) & 65535),
+        196608 + (phash & 65535)
+    ] END
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_listing_images_phash_bands
+ON listing_images USING GIN (phash_bands);
+
+DROP INDEX IF EXISTS idx_listing_images_phash;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN listing_images.phash_bands IS
+    'Multi-index hashing keys of phash: four 16-bit bands keyed by position, for near-duplicate lookup (phash_bands && keys)';
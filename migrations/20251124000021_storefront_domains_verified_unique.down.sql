@@ -0,0 +1,18 @@
+-- Migration: Revert unique storefront domains only once verified
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_storefront_domains_storefront_domain;
+DROP INDEX IF EXISTS idx_storefront_domains_verified_domain;
+
+-- Keep one claim per domain: the verified one, otherwise the oldest
+DELETE FROM storefront_domains d
+WHERE EXISTS (
+    SELECT 1 FROM storefront_domains o
+    WHERE o.domain = d.domain
+      AND o.id <> d.id
+      AND (o.verified_at IS NOT NULL AND d.verified_at IS NULL
+           OR (o.verified_at IS NULL) = (d.verified_at IS NULL) AND o.id < d.id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_domains_domain
+ON storefront_domains(domain);
@@ -0,0 +1,20 @@
+-- Migration: Unique storefront domains only once verified
+-- Date: 2025-11-24
+-- Purpose: The unique index on storefront_domains.domain also covered unverified claims, so
+--          a seller could block a domain they do not own by adding it first. A domain is now
+--          unique among verified rows only; several storefronts may claim it until one proves
+--          ownership, which removes the competing unverified claims.
+
+-- =====================================================
+-- INDEXES: storefront_domains
+-- =====================================================
+
+DROP INDEX IF EXISTS idx_storefront_domains_domain;
+
+-- A verified domain belongs to one storefront
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_domains_verified_domain
+ON storefront_domains(domain) WHERE verified_at IS NOT NULL;
+
+-- A storefront claims a domain once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_storefront_domains_storefront_domain
+ON storefront_domains(storefront_id, domain);
@@ -0,0 +1,5 @@
+-- Migration: Revert recording the schedule slot of job runs
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_scheduled_job_runs_job_slot;
+ALTER TABLE scheduled_job_runs DROP COLUMN IF EXISTS scheduled_at;
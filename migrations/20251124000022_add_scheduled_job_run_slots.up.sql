@@ -0,0 +1,23 @@
+-- Migration: Record the schedule slot of job runs
+-- Date: 2025-11-24
+-- Purpose: The advisory lock only keeps runs of a job from overlapping. A replica whose
+--          tick fires after another replica already finished the run of the same slot
+--          took the lock and ran the job again. Runs now record the slot they belong to
+--          and a slot can be started once.
+
+-- =====================================================
+-- COLUMN: scheduled_job_runs.scheduled_at
+-- =====================================================
+
+ALTER TABLE scheduled_job_runs ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMP WITH TIME ZONE;
+
+-- One run per job and slot (runs recorded before this migration have no slot)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scheduled_job_runs_job_slot
+    ON scheduled_job_runs (job_name, scheduled_at)
+    WHERE scheduled_at IS NOT NULL;
+
+-- =====================================================
+-- COMMENTS
+-- =====================================================
+
+COMMENT ON COLUMN scheduled_job_runs.scheduled_at IS 'Schedule slot (cron time) the run belongs to';
@@ -0,0 +1,4 @@
+-- Migration: Revert indexing image storage keys
+-- Date: 2025-11-24
+
+DROP INDEX IF EXISTS idx_listing_images_storage_path;
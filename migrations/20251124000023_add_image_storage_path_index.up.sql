@@ -0,0 +1,14 @@
+-- Migration: Index image storage keys
+-- Date: 2025-11-24
+-- Purpose: The image garbage collector looks up the rows referencing stored objects by
+--          their storage key. The key prefix does not identify the owning row: migrated
+--          C2C images keep their old key under a new listing_id, and images can be
+--          registered with a client-supplied storage path.
+
+-- =====================================================
+-- INDEX: listing_images.storage_path
+-- =====================================================
+
+CREATE INDEX IF NOT EXISTS idx_listing_images_storage_path
+    ON listing_images (storage_path)
+    WHERE storage_path IS NOT NULL;